@@ -2114,6 +2114,339 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type TaskQueueTaskFilter to the protobuf v3 wire format
+func (val *TaskQueueTaskFilter) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueTaskFilter from the protobuf v3 wire format
+func (val *TaskQueueTaskFilter) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueTaskFilter) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueTaskFilter values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueTaskFilter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueTaskFilter
+	switch t := that.(type) {
+	case *TaskQueueTaskFilter:
+		that1 = t
+	case TaskQueueTaskFilter:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteTaskQueueTasksRequest to the protobuf v3 wire format
+func (val *DeleteTaskQueueTasksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteTaskQueueTasksRequest from the protobuf v3 wire format
+func (val *DeleteTaskQueueTasksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteTaskQueueTasksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteTaskQueueTasksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteTaskQueueTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteTaskQueueTasksRequest
+	switch t := that.(type) {
+	case *DeleteTaskQueueTasksRequest:
+		that1 = t
+	case DeleteTaskQueueTasksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteTaskQueueTasksResponse to the protobuf v3 wire format
+func (val *DeleteTaskQueueTasksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteTaskQueueTasksResponse from the protobuf v3 wire format
+func (val *DeleteTaskQueueTasksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteTaskQueueTasksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteTaskQueueTasksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteTaskQueueTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteTaskQueueTasksResponse
+	switch t := that.(type) {
+	case *DeleteTaskQueueTasksResponse:
+		that1 = t
+	case DeleteTaskQueueTasksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartTaskQueueBacklogMigrationRequest to the protobuf v3 wire format
+func (val *StartTaskQueueBacklogMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartTaskQueueBacklogMigrationRequest from the protobuf v3 wire format
+func (val *StartTaskQueueBacklogMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartTaskQueueBacklogMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartTaskQueueBacklogMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartTaskQueueBacklogMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartTaskQueueBacklogMigrationRequest
+	switch t := that.(type) {
+	case *StartTaskQueueBacklogMigrationRequest:
+		that1 = t
+	case StartTaskQueueBacklogMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartTaskQueueBacklogMigrationResponse to the protobuf v3 wire format
+func (val *StartTaskQueueBacklogMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartTaskQueueBacklogMigrationResponse from the protobuf v3 wire format
+func (val *StartTaskQueueBacklogMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartTaskQueueBacklogMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartTaskQueueBacklogMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartTaskQueueBacklogMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartTaskQueueBacklogMigrationResponse
+	switch t := that.(type) {
+	case *StartTaskQueueBacklogMigrationResponse:
+		that1 = t
+	case StartTaskQueueBacklogMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogMigrationRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogMigrationRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogMigrationRequest
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogMigrationRequest:
+		that1 = t
+	case DescribeTaskQueueBacklogMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueBacklogMigrationResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueBacklogMigrationResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueueBacklogMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueBacklogMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueBacklogMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueBacklogMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueBacklogMigrationResponse
+	switch t := that.(type) {
+	case *DescribeTaskQueueBacklogMigrationResponse:
+		that1 = t
+	case DescribeTaskQueueBacklogMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelTaskQueueBacklogMigrationRequest to the protobuf v3 wire format
+func (val *CancelTaskQueueBacklogMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelTaskQueueBacklogMigrationRequest from the protobuf v3 wire format
+func (val *CancelTaskQueueBacklogMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelTaskQueueBacklogMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelTaskQueueBacklogMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelTaskQueueBacklogMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelTaskQueueBacklogMigrationRequest
+	switch t := that.(type) {
+	case *CancelTaskQueueBacklogMigrationRequest:
+		that1 = t
+	case CancelTaskQueueBacklogMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelTaskQueueBacklogMigrationResponse to the protobuf v3 wire format
+func (val *CancelTaskQueueBacklogMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelTaskQueueBacklogMigrationResponse from the protobuf v3 wire format
+func (val *CancelTaskQueueBacklogMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelTaskQueueBacklogMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelTaskQueueBacklogMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelTaskQueueBacklogMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelTaskQueueBacklogMigrationResponse
+	switch t := that.(type) {
+	case *CancelTaskQueueBacklogMigrationResponse:
+		that1 = t
+	case CancelTaskQueueBacklogMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type DeleteWorkflowExecutionRequest to the protobuf v3 wire format
 func (val *DeleteWorkflowExecutionRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -3297,3 +3630,2038 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type UpdateTaskQueueFairnessWeightsRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueueFairnessWeightsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueFairnessWeightsRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueueFairnessWeightsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueFairnessWeightsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueFairnessWeightsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueFairnessWeightsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueFairnessWeightsRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueueFairnessWeightsRequest:
+		that1 = t
+	case UpdateTaskQueueFairnessWeightsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueFairnessWeightsResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueueFairnessWeightsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueFairnessWeightsResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueueFairnessWeightsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueFairnessWeightsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueFairnessWeightsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueFairnessWeightsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueFairnessWeightsResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueueFairnessWeightsResponse:
+		that1 = t
+	case UpdateTaskQueueFairnessWeightsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueFairnessKeysRequest to the protobuf v3 wire format
+func (val *DescribeTaskQueueFairnessKeysRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueFairnessKeysRequest from the protobuf v3 wire format
+func (val *DescribeTaskQueueFairnessKeysRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueFairnessKeysRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueFairnessKeysRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueFairnessKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueFairnessKeysRequest
+	switch t := that.(type) {
+	case *DescribeTaskQueueFairnessKeysRequest:
+		that1 = t
+	case DescribeTaskQueueFairnessKeysRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeTaskQueueFairnessKeysResponse to the protobuf v3 wire format
+func (val *DescribeTaskQueueFairnessKeysResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeTaskQueueFairnessKeysResponse from the protobuf v3 wire format
+func (val *DescribeTaskQueueFairnessKeysResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeTaskQueueFairnessKeysResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeTaskQueueFairnessKeysResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeTaskQueueFairnessKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeTaskQueueFairnessKeysResponse
+	switch t := that.(type) {
+	case *DescribeTaskQueueFairnessKeysResponse:
+		that1 = t
+	case DescribeTaskQueueFairnessKeysResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueuePauseRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePauseRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePauseRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePauseRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePauseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePauseRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueuePauseRequest:
+		that1 = t
+	case UpdateTaskQueuePauseRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueuePauseResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePauseResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePauseResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePauseResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePauseResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePauseResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueuePauseResponse:
+		that1 = t
+	case UpdateTaskQueuePauseResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueBlockedPollersRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueueBlockedPollersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueBlockedPollersRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueueBlockedPollersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueBlockedPollersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueBlockedPollersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueBlockedPollersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueBlockedPollersRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueueBlockedPollersRequest:
+		that1 = t
+	case UpdateTaskQueueBlockedPollersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueueBlockedPollersResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueueBlockedPollersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueueBlockedPollersResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueueBlockedPollersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueueBlockedPollersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueueBlockedPollersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueueBlockedPollersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueueBlockedPollersResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueueBlockedPollersResponse:
+		that1 = t
+	case UpdateTaskQueueBlockedPollersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CountWorkersRequest to the protobuf v3 wire format
+func (val *CountWorkersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CountWorkersRequest from the protobuf v3 wire format
+func (val *CountWorkersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CountWorkersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CountWorkersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CountWorkersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CountWorkersRequest
+	switch t := that.(type) {
+	case *CountWorkersRequest:
+		that1 = t
+	case CountWorkersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CountWorkersResponse to the protobuf v3 wire format
+func (val *CountWorkersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CountWorkersResponse from the protobuf v3 wire format
+func (val *CountWorkersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CountWorkersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CountWorkersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CountWorkersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CountWorkersResponse
+	switch t := that.(type) {
+	case *CountWorkersResponse:
+		that1 = t
+	case CountWorkersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewScheduleRequest to the protobuf v3 wire format
+func (val *PreviewScheduleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewScheduleRequest from the protobuf v3 wire format
+func (val *PreviewScheduleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewScheduleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewScheduleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewScheduleRequest
+	switch t := that.(type) {
+	case *PreviewScheduleRequest:
+		that1 = t
+	case PreviewScheduleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PreviewScheduleResponse to the protobuf v3 wire format
+func (val *PreviewScheduleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PreviewScheduleResponse from the protobuf v3 wire format
+func (val *PreviewScheduleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PreviewScheduleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PreviewScheduleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PreviewScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PreviewScheduleResponse
+	switch t := that.(type) {
+	case *PreviewScheduleResponse:
+		that1 = t
+	case PreviewScheduleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleActionsRequest to the protobuf v3 wire format
+func (val *ListScheduleActionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleActionsRequest from the protobuf v3 wire format
+func (val *ListScheduleActionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleActionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleActionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleActionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleActionsRequest
+	switch t := that.(type) {
+	case *ListScheduleActionsRequest:
+		that1 = t
+	case ListScheduleActionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleActionsResponse to the protobuf v3 wire format
+func (val *ListScheduleActionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleActionsResponse from the protobuf v3 wire format
+func (val *ListScheduleActionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleActionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleActionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleActionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleActionsResponse
+	switch t := that.(type) {
+	case *ListScheduleActionsResponse:
+		that1 = t
+	case ListScheduleActionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateScheduleCalendarSetRequest to the protobuf v3 wire format
+func (val *UpdateScheduleCalendarSetRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateScheduleCalendarSetRequest from the protobuf v3 wire format
+func (val *UpdateScheduleCalendarSetRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateScheduleCalendarSetRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateScheduleCalendarSetRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateScheduleCalendarSetRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateScheduleCalendarSetRequest
+	switch t := that.(type) {
+	case *UpdateScheduleCalendarSetRequest:
+		that1 = t
+	case UpdateScheduleCalendarSetRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateScheduleCalendarSetResponse to the protobuf v3 wire format
+func (val *UpdateScheduleCalendarSetResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateScheduleCalendarSetResponse from the protobuf v3 wire format
+func (val *UpdateScheduleCalendarSetResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateScheduleCalendarSetResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateScheduleCalendarSetResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateScheduleCalendarSetResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateScheduleCalendarSetResponse
+	switch t := that.(type) {
+	case *UpdateScheduleCalendarSetResponse:
+		that1 = t
+	case UpdateScheduleCalendarSetResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleCalendarSetsRequest to the protobuf v3 wire format
+func (val *ListScheduleCalendarSetsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleCalendarSetsRequest from the protobuf v3 wire format
+func (val *ListScheduleCalendarSetsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleCalendarSetsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleCalendarSetsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleCalendarSetsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleCalendarSetsRequest
+	switch t := that.(type) {
+	case *ListScheduleCalendarSetsRequest:
+		that1 = t
+	case ListScheduleCalendarSetsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleCalendarSetsResponse to the protobuf v3 wire format
+func (val *ListScheduleCalendarSetsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleCalendarSetsResponse from the protobuf v3 wire format
+func (val *ListScheduleCalendarSetsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleCalendarSetsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleCalendarSetsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleCalendarSetsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleCalendarSetsResponse
+	switch t := that.(type) {
+	case *ListScheduleCalendarSetsResponse:
+		that1 = t
+	case ListScheduleCalendarSetsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListCallbacksRequest to the protobuf v3 wire format
+func (val *ListCallbacksRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListCallbacksRequest from the protobuf v3 wire format
+func (val *ListCallbacksRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListCallbacksRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListCallbacksRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListCallbacksRequest
+	switch t := that.(type) {
+	case *ListCallbacksRequest:
+		that1 = t
+	case ListCallbacksRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListCallbacksResponse to the protobuf v3 wire format
+func (val *ListCallbacksResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListCallbacksResponse from the protobuf v3 wire format
+func (val *ListCallbacksResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListCallbacksResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListCallbacksResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListCallbacksResponse
+	switch t := that.(type) {
+	case *ListCallbacksResponse:
+		that1 = t
+	case ListCallbacksResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RetryCallbackRequest to the protobuf v3 wire format
+func (val *RetryCallbackRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RetryCallbackRequest from the protobuf v3 wire format
+func (val *RetryCallbackRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RetryCallbackRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RetryCallbackRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RetryCallbackRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RetryCallbackRequest
+	switch t := that.(type) {
+	case *RetryCallbackRequest:
+		that1 = t
+	case RetryCallbackRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RetryCallbackResponse to the protobuf v3 wire format
+func (val *RetryCallbackResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RetryCallbackResponse from the protobuf v3 wire format
+func (val *RetryCallbackResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RetryCallbackResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RetryCallbackResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RetryCallbackResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RetryCallbackResponse
+	switch t := that.(type) {
+	case *RetryCallbackResponse:
+		that1 = t
+	case RetryCallbackResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusEndpointHealth to the protobuf v3 wire format
+func (val *NexusEndpointHealth) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointHealth from the protobuf v3 wire format
+func (val *NexusEndpointHealth) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointHealth) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointHealth values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointHealth
+	switch t := that.(type) {
+	case *NexusEndpointHealth:
+		that1 = t
+	case NexusEndpointHealth:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNexusEndpointRequest to the protobuf v3 wire format
+func (val *GetNexusEndpointRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNexusEndpointRequest from the protobuf v3 wire format
+func (val *GetNexusEndpointRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNexusEndpointRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNexusEndpointRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNexusEndpointRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNexusEndpointRequest
+	switch t := that.(type) {
+	case *GetNexusEndpointRequest:
+		that1 = t
+	case GetNexusEndpointRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetNexusEndpointResponse to the protobuf v3 wire format
+func (val *GetNexusEndpointResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetNexusEndpointResponse from the protobuf v3 wire format
+func (val *GetNexusEndpointResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetNexusEndpointResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetNexusEndpointResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetNexusEndpointResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetNexusEndpointResponse
+	switch t := that.(type) {
+	case *GetNexusEndpointResponse:
+		that1 = t
+	case GetNexusEndpointResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNexusEndpointsRequest to the protobuf v3 wire format
+func (val *ListNexusEndpointsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNexusEndpointsRequest from the protobuf v3 wire format
+func (val *ListNexusEndpointsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNexusEndpointsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNexusEndpointsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNexusEndpointsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNexusEndpointsRequest
+	switch t := that.(type) {
+	case *ListNexusEndpointsRequest:
+		that1 = t
+	case ListNexusEndpointsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListNexusEndpointsResponse to the protobuf v3 wire format
+func (val *ListNexusEndpointsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListNexusEndpointsResponse from the protobuf v3 wire format
+func (val *ListNexusEndpointsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListNexusEndpointsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListNexusEndpointsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListNexusEndpointsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListNexusEndpointsResponse
+	switch t := that.(type) {
+	case *ListNexusEndpointsResponse:
+		that1 = t
+	case ListNexusEndpointsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetNexusEndpointCircuitBreakerRequest to the protobuf v3 wire format
+func (val *SetNexusEndpointCircuitBreakerRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetNexusEndpointCircuitBreakerRequest from the protobuf v3 wire format
+func (val *SetNexusEndpointCircuitBreakerRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetNexusEndpointCircuitBreakerRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetNexusEndpointCircuitBreakerRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetNexusEndpointCircuitBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetNexusEndpointCircuitBreakerRequest
+	switch t := that.(type) {
+	case *SetNexusEndpointCircuitBreakerRequest:
+		that1 = t
+	case SetNexusEndpointCircuitBreakerRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetNexusEndpointCircuitBreakerResponse to the protobuf v3 wire format
+func (val *SetNexusEndpointCircuitBreakerResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetNexusEndpointCircuitBreakerResponse from the protobuf v3 wire format
+func (val *SetNexusEndpointCircuitBreakerResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetNexusEndpointCircuitBreakerResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetNexusEndpointCircuitBreakerResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetNexusEndpointCircuitBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetNexusEndpointCircuitBreakerResponse
+	switch t := that.(type) {
+	case *SetNexusEndpointCircuitBreakerResponse:
+		that1 = t
+	case SetNexusEndpointCircuitBreakerResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetNexusEndpointAccessPolicyRequest to the protobuf v3 wire format
+func (val *SetNexusEndpointAccessPolicyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetNexusEndpointAccessPolicyRequest from the protobuf v3 wire format
+func (val *SetNexusEndpointAccessPolicyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetNexusEndpointAccessPolicyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetNexusEndpointAccessPolicyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetNexusEndpointAccessPolicyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetNexusEndpointAccessPolicyRequest
+	switch t := that.(type) {
+	case *SetNexusEndpointAccessPolicyRequest:
+		that1 = t
+	case SetNexusEndpointAccessPolicyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetNexusEndpointAccessPolicyResponse to the protobuf v3 wire format
+func (val *SetNexusEndpointAccessPolicyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetNexusEndpointAccessPolicyResponse from the protobuf v3 wire format
+func (val *SetNexusEndpointAccessPolicyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetNexusEndpointAccessPolicyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetNexusEndpointAccessPolicyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetNexusEndpointAccessPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetNexusEndpointAccessPolicyResponse
+	switch t := that.(type) {
+	case *SetNexusEndpointAccessPolicyResponse:
+		that1 = t
+	case SetNexusEndpointAccessPolicyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsRequest:
+		that1 = t
+	case AggregateWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type AggregateWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type AggregateWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *AggregateWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *AggregateWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two AggregateWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *AggregateWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *AggregateWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *AggregateWorkflowExecutionsResponse:
+		that1 = t
+	case AggregateWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityReindexRequest to the protobuf v3 wire format
+func (val *StartVisibilityReindexRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityReindexRequest from the protobuf v3 wire format
+func (val *StartVisibilityReindexRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityReindexRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityReindexRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityReindexRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityReindexRequest
+	switch t := that.(type) {
+	case *StartVisibilityReindexRequest:
+		that1 = t
+	case StartVisibilityReindexRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartVisibilityReindexResponse to the protobuf v3 wire format
+func (val *StartVisibilityReindexResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartVisibilityReindexResponse from the protobuf v3 wire format
+func (val *StartVisibilityReindexResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartVisibilityReindexResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartVisibilityReindexResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartVisibilityReindexResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartVisibilityReindexResponse
+	switch t := that.(type) {
+	case *StartVisibilityReindexResponse:
+		that1 = t
+	case StartVisibilityReindexResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityReindexRequest to the protobuf v3 wire format
+func (val *DescribeVisibilityReindexRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityReindexRequest from the protobuf v3 wire format
+func (val *DescribeVisibilityReindexRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityReindexRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityReindexRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityReindexRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityReindexRequest
+	switch t := that.(type) {
+	case *DescribeVisibilityReindexRequest:
+		that1 = t
+	case DescribeVisibilityReindexRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeVisibilityReindexResponse to the protobuf v3 wire format
+func (val *DescribeVisibilityReindexResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeVisibilityReindexResponse from the protobuf v3 wire format
+func (val *DescribeVisibilityReindexResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeVisibilityReindexResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeVisibilityReindexResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeVisibilityReindexResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeVisibilityReindexResponse
+	switch t := that.(type) {
+	case *DescribeVisibilityReindexResponse:
+		that1 = t
+	case DescribeVisibilityReindexResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelVisibilityReindexRequest to the protobuf v3 wire format
+func (val *CancelVisibilityReindexRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelVisibilityReindexRequest from the protobuf v3 wire format
+func (val *CancelVisibilityReindexRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelVisibilityReindexRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelVisibilityReindexRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelVisibilityReindexRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelVisibilityReindexRequest
+	switch t := that.(type) {
+	case *CancelVisibilityReindexRequest:
+		that1 = t
+	case CancelVisibilityReindexRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CancelVisibilityReindexResponse to the protobuf v3 wire format
+func (val *CancelVisibilityReindexResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CancelVisibilityReindexResponse from the protobuf v3 wire format
+func (val *CancelVisibilityReindexResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CancelVisibilityReindexResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CancelVisibilityReindexResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CancelVisibilityReindexResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CancelVisibilityReindexResponse
+	switch t := that.(type) {
+	case *CancelVisibilityReindexResponse:
+		that1 = t
+	case CancelVisibilityReindexResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RenameSearchAttributeRequest to the protobuf v3 wire format
+func (val *RenameSearchAttributeRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RenameSearchAttributeRequest from the protobuf v3 wire format
+func (val *RenameSearchAttributeRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RenameSearchAttributeRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RenameSearchAttributeRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RenameSearchAttributeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RenameSearchAttributeRequest
+	switch t := that.(type) {
+	case *RenameSearchAttributeRequest:
+		that1 = t
+	case RenameSearchAttributeRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RenameSearchAttributeResponse to the protobuf v3 wire format
+func (val *RenameSearchAttributeResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RenameSearchAttributeResponse from the protobuf v3 wire format
+func (val *RenameSearchAttributeResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RenameSearchAttributeResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RenameSearchAttributeResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RenameSearchAttributeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RenameSearchAttributeResponse
+	switch t := that.(type) {
+	case *RenameSearchAttributeResponse:
+		that1 = t
+	case RenameSearchAttributeResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartSearchAttributeMigrationRequest to the protobuf v3 wire format
+func (val *StartSearchAttributeMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartSearchAttributeMigrationRequest from the protobuf v3 wire format
+func (val *StartSearchAttributeMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartSearchAttributeMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartSearchAttributeMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartSearchAttributeMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartSearchAttributeMigrationRequest
+	switch t := that.(type) {
+	case *StartSearchAttributeMigrationRequest:
+		that1 = t
+	case StartSearchAttributeMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartSearchAttributeMigrationResponse to the protobuf v3 wire format
+func (val *StartSearchAttributeMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartSearchAttributeMigrationResponse from the protobuf v3 wire format
+func (val *StartSearchAttributeMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartSearchAttributeMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartSearchAttributeMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartSearchAttributeMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartSearchAttributeMigrationResponse
+	switch t := that.(type) {
+	case *StartSearchAttributeMigrationResponse:
+		that1 = t
+	case StartSearchAttributeMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeSearchAttributeMigrationRequest to the protobuf v3 wire format
+func (val *DescribeSearchAttributeMigrationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeSearchAttributeMigrationRequest from the protobuf v3 wire format
+func (val *DescribeSearchAttributeMigrationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeSearchAttributeMigrationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeSearchAttributeMigrationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeSearchAttributeMigrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeSearchAttributeMigrationRequest
+	switch t := that.(type) {
+	case *DescribeSearchAttributeMigrationRequest:
+		that1 = t
+	case DescribeSearchAttributeMigrationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeSearchAttributeMigrationResponse to the protobuf v3 wire format
+func (val *DescribeSearchAttributeMigrationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeSearchAttributeMigrationResponse from the protobuf v3 wire format
+func (val *DescribeSearchAttributeMigrationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeSearchAttributeMigrationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeSearchAttributeMigrationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeSearchAttributeMigrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeSearchAttributeMigrationResponse
+	switch t := that.(type) {
+	case *DescribeSearchAttributeMigrationResponse:
+		that1 = t
+	case DescribeSearchAttributeMigrationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PutSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *PutSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PutSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *PutSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PutSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PutSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PutSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PutSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *PutSavedVisibilityQueryRequest:
+		that1 = t
+	case PutSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PutSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *PutSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PutSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *PutSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PutSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PutSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PutSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PutSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *PutSavedVisibilityQueryResponse:
+		that1 = t
+	case PutSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *DeleteSavedVisibilityQueryRequest:
+		that1 = t
+	case DeleteSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *DeleteSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *DeleteSavedVisibilityQueryResponse:
+		that1 = t
+	case DeleteSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListSavedVisibilityQueriesRequest to the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListSavedVisibilityQueriesRequest from the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListSavedVisibilityQueriesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListSavedVisibilityQueriesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListSavedVisibilityQueriesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListSavedVisibilityQueriesRequest
+	switch t := that.(type) {
+	case *ListSavedVisibilityQueriesRequest:
+		that1 = t
+	case ListSavedVisibilityQueriesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListSavedVisibilityQueriesResponse to the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListSavedVisibilityQueriesResponse from the protobuf v3 wire format
+func (val *ListSavedVisibilityQueriesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListSavedVisibilityQueriesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListSavedVisibilityQueriesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListSavedVisibilityQueriesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListSavedVisibilityQueriesResponse
+	switch t := that.(type) {
+	case *ListSavedVisibilityQueriesResponse:
+		that1 = t
+	case ListSavedVisibilityQueriesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunSavedVisibilityQueryRequest to the protobuf v3 wire format
+func (val *RunSavedVisibilityQueryRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunSavedVisibilityQueryRequest from the protobuf v3 wire format
+func (val *RunSavedVisibilityQueryRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunSavedVisibilityQueryRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunSavedVisibilityQueryRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunSavedVisibilityQueryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunSavedVisibilityQueryRequest
+	switch t := that.(type) {
+	case *RunSavedVisibilityQueryRequest:
+		that1 = t
+	case RunSavedVisibilityQueryRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RunSavedVisibilityQueryResponse to the protobuf v3 wire format
+func (val *RunSavedVisibilityQueryResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RunSavedVisibilityQueryResponse from the protobuf v3 wire format
+func (val *RunSavedVisibilityQueryResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RunSavedVisibilityQueryResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RunSavedVisibilityQueryResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RunSavedVisibilityQueryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RunSavedVisibilityQueryResponse
+	switch t := that.(type) {
+	case *RunSavedVisibilityQueryResponse:
+		that1 = t
+	case RunSavedVisibilityQueryResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainWorkflowExecutionsRequest to the protobuf v3 wire format
+func (val *ExplainWorkflowExecutionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainWorkflowExecutionsRequest from the protobuf v3 wire format
+func (val *ExplainWorkflowExecutionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainWorkflowExecutionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainWorkflowExecutionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainWorkflowExecutionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainWorkflowExecutionsRequest
+	switch t := that.(type) {
+	case *ExplainWorkflowExecutionsRequest:
+		that1 = t
+	case ExplainWorkflowExecutionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainWorkflowExecutionsResponse to the protobuf v3 wire format
+func (val *ExplainWorkflowExecutionsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainWorkflowExecutionsResponse from the protobuf v3 wire format
+func (val *ExplainWorkflowExecutionsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainWorkflowExecutionsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainWorkflowExecutionsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainWorkflowExecutionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainWorkflowExecutionsResponse
+	switch t := that.(type) {
+	case *ExplainWorkflowExecutionsResponse:
+		that1 = t
+	case ExplainWorkflowExecutionsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
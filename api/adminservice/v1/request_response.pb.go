@@ -15,6 +15,7 @@ import (
 	v16 "go.temporal.io/api/enums/v1"
 	v110 "go.temporal.io/api/namespace/v1"
 	v111 "go.temporal.io/api/replication/v1"
+	v115 "go.temporal.io/api/schedule/v1"
 	v114 "go.temporal.io/api/taskqueue/v1"
 	v19 "go.temporal.io/api/version/v1"
 	v17 "go.temporal.io/api/workflow/v1"
@@ -25,6 +26,7 @@ import (
 	v13 "go.temporal.io/server/api/namespace/v1"
 	v12 "go.temporal.io/server/api/persistence/v1"
 	v15 "go.temporal.io/server/api/replication/v1"
+	v116 "go.temporal.io/server/api/schedule/v1"
 	v113 "go.temporal.io/server/api/taskqueue/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -3302,6 +3304,9 @@ type GetTaskQueueTasksRequest struct {
 	BatchSize     int32                  `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	NextPageToken []byte                 `protobuf:"bytes,7,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	Subqueue      int32                  `protobuf:"varint,8,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	// Only tasks matching the filter are returned. When a filter is set a page may contain
+	// fewer than batch_size tasks even if next_page_token is not empty.
+	Filter        *TaskQueueTaskFilter `protobuf:"bytes,10,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3399,6 +3404,13 @@ func (x *GetTaskQueueTasksRequest) GetSubqueue() int32 {
 	return 0
 }
 
+func (x *GetTaskQueueTasksRequest) GetFilter() *TaskQueueTaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type GetTaskQueueTasksResponse struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Tasks         []*v12.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
@@ -3451,28 +3463,36 @@ func (x *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
 	return nil
 }
 
-type DeleteWorkflowExecutionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+// TaskQueueTaskFilter selects persisted task queue tasks. A task matches if it matches all set fields.
+type TaskQueueTaskFilter struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId      string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Workflow and activity types are not persisted with the task. Filtering by them
+	// requires loading the mutable state of each distinct workflow execution in the page.
+	WorkflowType string `protobuf:"bytes,3,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	ActivityType string `protobuf:"bytes,4,opt,name=activity_type,json=activityType,proto3" json:"activity_type,omitempty"`
+	// Zero matches any priority key.
+	PriorityKey   int32  `protobuf:"varint,5,opt,name=priority_key,json=priorityKey,proto3" json:"priority_key,omitempty"`
+	FairnessKey   string `protobuf:"bytes,6,opt,name=fairness_key,json=fairnessKey,proto3" json:"fairness_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionRequest) Reset() {
-	*x = DeleteWorkflowExecutionRequest{}
+func (x *TaskQueueTaskFilter) Reset() {
+	*x = TaskQueueTaskFilter{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionRequest) String() string {
+func (x *TaskQueueTaskFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*TaskQueueTaskFilter) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskQueueTaskFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3484,46 +3504,92 @@ func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueueTaskFilter.ProtoReflect.Descriptor instead.
+func (*TaskQueueTaskFilter) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
+func (x *TaskQueueTaskFilter) GetWorkflowId() string {
 	if x != nil {
-		return x.Namespace
+		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+func (x *TaskQueueTaskFilter) GetRunId() string {
 	if x != nil {
-		return x.Execution
+		return x.RunId
 	}
-	return nil
+	return ""
 }
 
-type DeleteWorkflowExecutionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+func (x *TaskQueueTaskFilter) GetWorkflowType() string {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return ""
+}
+
+func (x *TaskQueueTaskFilter) GetActivityType() string {
+	if x != nil {
+		return x.ActivityType
+	}
+	return ""
+}
+
+func (x *TaskQueueTaskFilter) GetPriorityKey() int32 {
+	if x != nil {
+		return x.PriorityKey
+	}
+	return 0
+}
+
+func (x *TaskQueueTaskFilter) GetFairnessKey() string {
+	if x != nil {
+		return x.FairnessKey
+	}
+	return ""
+}
+
+type DeleteTaskQueueTasksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name of the persisted task queue partition, same as GetTaskQueueTasksRequest.task_queue.
+	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	Subqueue      int32             `protobuf:"varint,4,opt,name=subqueue,proto3" json:"subqueue,omitempty"`
+	// Non-zero selects the fairness task table, same as GetTaskQueueTasksRequest.min_pass.
+	MinPass   int64 `protobuf:"varint,5,opt,name=min_pass,json=minPass,proto3" json:"min_pass,omitempty"`
+	MinTaskId int64 `protobuf:"varint,6,opt,name=min_task_id,json=minTaskId,proto3" json:"min_task_id,omitempty"`
+	MaxTaskId int64 `protobuf:"varint,7,opt,name=max_task_id,json=maxTaskId,proto3" json:"max_task_id,omitempty"`
+	// Required unless task_ids is set. An empty filter matches every task.
+	Filter *TaskQueueTaskFilter `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// If set, only tasks with these ids are considered.
+	TaskIds []int64 `protobuf:"varint,9,rep,packed,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	// If set, matching tasks are added to this task queue (of the same type) before they are deleted.
+	DestinationTaskQueue string `protobuf:"bytes,10,opt,name=destination_task_queue,json=destinationTaskQueue,proto3" json:"destination_task_queue,omitempty"`
+	// Return the tasks that would be deleted without deleting them.
+	DryRun        bool   `protobuf:"varint,11,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	BatchSize     int32  `protobuf:"varint,12,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,13,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteWorkflowExecutionResponse) Reset() {
-	*x = DeleteWorkflowExecutionResponse{}
+func (x *DeleteTaskQueueTasksRequest) Reset() {
+	*x = DeleteTaskQueueTasksRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteWorkflowExecutionResponse) String() string {
+func (x *DeleteTaskQueueTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteTaskQueueTasksRequest) ProtoMessage() {}
 
-func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTaskQueueTasksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3535,42 +3601,125 @@ func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTaskQueueTasksRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+func (x *DeleteTaskQueueTasksRequest) GetNamespace() string {
 	if x != nil {
-		return x.Warnings
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetSubqueue() int32 {
+	if x != nil {
+		return x.Subqueue
+	}
+	return 0
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetMinPass() int64 {
+	if x != nil {
+		return x.MinPass
+	}
+	return 0
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetMinTaskId() int64 {
+	if x != nil {
+		return x.MinTaskId
+	}
+	return 0
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetMaxTaskId() int64 {
+	if x != nil {
+		return x.MaxTaskId
+	}
+	return 0
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetFilter() *TaskQueueTaskFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type StreamWorkflowReplicationMessagesRequest struct {
+func (x *DeleteTaskQueueTasksRequest) GetTaskIds() []int64 {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetDestinationTaskQueue() string {
+	if x != nil {
+		return x.DestinationTaskQueue
+	}
+	return ""
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *DeleteTaskQueueTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type DeleteTaskQueueTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
-	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+	// Tasks deleted (or moved) from this page, or that would have been if dry_run is set.
+	Tasks         []*v12.AllocatedTaskInfo `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken []byte                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
-	*x = StreamWorkflowReplicationMessagesRequest{}
+func (x *DeleteTaskQueueTasksResponse) Reset() {
+	*x = DeleteTaskQueueTasksResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) String() string {
+func (x *DeleteTaskQueueTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+func (*DeleteTaskQueueTasksResponse) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTaskQueueTasksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3582,62 +3731,55 @@ func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTaskQueueTasksResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
+func (x *DeleteTaskQueueTasksResponse) GetTasks() []*v12.AllocatedTaskInfo {
 	if x != nil {
-		return x.Attributes
+		return x.Tasks
 	}
 	return nil
 }
 
-func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
+func (x *DeleteTaskQueueTasksResponse) GetNextPageToken() []byte {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
-			return x.SyncReplicationState
-		}
+		return x.NextPageToken
 	}
 	return nil
 }
 
-type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
-	isStreamWorkflowReplicationMessagesRequest_Attributes()
-}
-
-type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
-	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
-}
-
-func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
-}
-
-type StreamWorkflowReplicationMessagesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*StreamWorkflowReplicationMessagesResponse_Messages
-	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StartTaskQueueBacklogMigrationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Task queue whose backlog is migrated. The workflow and activity backlogs of every
+	// unversioned partition are migrated, including fairness tasks.
+	SourceTaskQueue      string `protobuf:"bytes,2,opt,name=source_task_queue,json=sourceTaskQueue,proto3" json:"source_task_queue,omitempty"`
+	DestinationTaskQueue string `protobuf:"bytes,3,opt,name=destination_task_queue,json=destinationTaskQueue,proto3" json:"destination_task_queue,omitempty"`
+	// Number of tasks read per page. Zero uses the default.
+	BatchSize int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Maximum number of tasks moved per second. Zero means no limit.
+	TasksPerSecond float32 `protobuf:"fixed32,5,opt,name=tasks_per_second,json=tasksPerSecond,proto3" json:"tasks_per_second,omitempty"`
+	Reason         string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
-	*x = StreamWorkflowReplicationMessagesResponse{}
+func (x *StartTaskQueueBacklogMigrationRequest) Reset() {
+	*x = StartTaskQueueBacklogMigrationRequest{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+func (x *StartTaskQueueBacklogMigrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+func (*StartTaskQueueBacklogMigrationRequest) ProtoMessage() {}
 
-func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+func (x *StartTaskQueueBacklogMigrationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3649,63 +3791,75 @@ func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
-func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartTaskQueueBacklogMigrationRequest.ProtoReflect.Descriptor instead.
+func (*StartTaskQueueBacklogMigrationRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
+func (x *StartTaskQueueBacklogMigrationRequest) GetNamespace() string {
 	if x != nil {
-		return x.Attributes
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v15.WorkflowReplicationMessages {
+func (x *StartTaskQueueBacklogMigrationRequest) GetSourceTaskQueue() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
-			return x.Messages
-		}
+		return x.SourceTaskQueue
 	}
-	return nil
+	return ""
 }
 
-type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
-	isStreamWorkflowReplicationMessagesResponse_Attributes()
+func (x *StartTaskQueueBacklogMigrationRequest) GetDestinationTaskQueue() string {
+	if x != nil {
+		return x.DestinationTaskQueue
+	}
+	return ""
 }
 
-type StreamWorkflowReplicationMessagesResponse_Messages struct {
-	Messages *v15.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
+func (x *StartTaskQueueBacklogMigrationRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
 }
 
-func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+func (x *StartTaskQueueBacklogMigrationRequest) GetTasksPerSecond() float32 {
+	if x != nil {
+		return x.TasksPerSecond
+	}
+	return 0
 }
 
-type GetNamespaceRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Attributes:
-	//
-	//	*GetNamespaceRequest_Namespace
-	//	*GetNamespaceRequest_Id
-	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+func (x *StartTaskQueueBacklogMigrationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StartTaskQueueBacklogMigrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNamespaceRequest) Reset() {
-	*x = GetNamespaceRequest{}
+func (x *StartTaskQueueBacklogMigrationResponse) Reset() {
+	*x = StartTaskQueueBacklogMigrationResponse{}
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceRequest) String() string {
+func (x *StartTaskQueueBacklogMigrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceRequest) ProtoMessage() {}
+func (*StartTaskQueueBacklogMigrationResponse) ProtoMessage() {}
 
-func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+func (x *StartTaskQueueBacklogMigrationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3717,80 +3871,107 @@ func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
-func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartTaskQueueBacklogMigrationResponse.ProtoReflect.Descriptor instead.
+func (*StartTaskQueueBacklogMigrationResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
-	if x != nil {
-		return x.Attributes
-	}
-	return nil
-}
-
-func (x *GetNamespaceRequest) GetNamespace() string {
+func (x *StartTaskQueueBacklogMigrationResponse) GetWorkflowId() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
-			return x.Namespace
-		}
+		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *GetNamespaceRequest) GetId() string {
+func (x *StartTaskQueueBacklogMigrationResponse) GetRunId() string {
 	if x != nil {
-		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
-			return x.Id
-		}
+		return x.RunId
 	}
 	return ""
 }
 
-type isGetNamespaceRequest_Attributes interface {
-	isGetNamespaceRequest_Attributes()
+type DescribeTaskQueueBacklogMigrationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SourceTaskQueue string                 `protobuf:"bytes,2,opt,name=source_task_queue,json=sourceTaskQueue,proto3" json:"source_task_queue,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-type GetNamespaceRequest_Namespace struct {
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
+func (x *DescribeTaskQueueBacklogMigrationRequest) Reset() {
+	*x = DescribeTaskQueueBacklogMigrationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetNamespaceRequest_Id struct {
-	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
+func (x *DescribeTaskQueueBacklogMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
+func (*DescribeTaskQueueBacklogMigrationRequest) ProtoMessage() {}
 
-func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
+func (x *DescribeTaskQueueBacklogMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type GetNamespaceResponse struct {
-	state             protoimpl.MessageState           `protogen:"open.v1"`
-	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
-	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
-	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
-	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
-	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Deprecated: Use DescribeTaskQueueBacklogMigrationRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueueBacklogMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetNamespaceResponse) Reset() {
-	*x = GetNamespaceResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *DescribeTaskQueueBacklogMigrationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeTaskQueueBacklogMigrationRequest) GetSourceTaskQueue() string {
+	if x != nil {
+		return x.SourceTaskQueue
+	}
+	return ""
+}
+
+type DescribeTaskQueueBacklogMigrationResponse struct {
+	state                protoimpl.MessageState      `protogen:"open.v1"`
+	DestinationTaskQueue string                      `protobuf:"bytes,1,opt,name=destination_task_queue,json=destinationTaskQueue,proto3" json:"destination_task_queue,omitempty"`
+	Status               v16.WorkflowExecutionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	TasksMigrated        int64                       `protobuf:"varint,3,opt,name=tasks_migrated,json=tasksMigrated,proto3" json:"tasks_migrated,omitempty"`
+	// Number of backlogs (one per partition, task type, task table and subqueue) found in the
+	// source task queue, and how many of them have been drained.
+	BacklogsTotal   int32                  `protobuf:"varint,4,opt,name=backlogs_total,json=backlogsTotal,proto3" json:"backlogs_total,omitempty"`
+	BacklogsDrained int32                  `protobuf:"varint,5,opt,name=backlogs_drained,json=backlogsDrained,proto3" json:"backlogs_drained,omitempty"`
+	StartTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeTaskQueueBacklogMigrationResponse) Reset() {
+	*x = DescribeTaskQueueBacklogMigrationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNamespaceResponse) String() string {
+func (x *DescribeTaskQueueBacklogMigrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNamespaceResponse) ProtoMessage() {}
+func (*DescribeTaskQueueBacklogMigrationResponse) ProtoMessage() {}
 
-func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[62]
+func (x *DescribeTaskQueueBacklogMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3801,85 +3982,84 @@ func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
-func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use DescribeTaskQueueBacklogMigrationResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueueBacklogMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetDestinationTaskQueue() string {
 	if x != nil {
-		return x.Info
+		return x.DestinationTaskQueue
 	}
-	return nil
+	return ""
 }
 
-func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetStatus() v16.WorkflowExecutionStatus {
 	if x != nil {
-		return x.Config
+		return x.Status
 	}
-	return nil
+	return v16.WorkflowExecutionStatus(0)
 }
 
-func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetTasksMigrated() int64 {
 	if x != nil {
-		return x.ReplicationConfig
+		return x.TasksMigrated
 	}
-	return nil
+	return 0
 }
 
-func (x *GetNamespaceResponse) GetConfigVersion() int64 {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetBacklogsTotal() int32 {
 	if x != nil {
-		return x.ConfigVersion
+		return x.BacklogsTotal
 	}
 	return 0
 }
 
-func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetBacklogsDrained() int32 {
 	if x != nil {
-		return x.FailoverVersion
+		return x.BacklogsDrained
 	}
 	return 0
 }
 
-func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FailoverHistory
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+func (x *DescribeTaskQueueBacklogMigrationResponse) GetCloseTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.IsGlobalNamespace
+		return x.CloseTime
 	}
-	return false
+	return nil
 }
 
-type GetDLQTasksRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	// page_size must be positive. Up to this many tasks will be returned.
-	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CancelTaskQueueBacklogMigrationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Namespace       string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	SourceTaskQueue string                 `protobuf:"bytes,2,opt,name=source_task_queue,json=sourceTaskQueue,proto3" json:"source_task_queue,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksRequest) Reset() {
-	*x = GetDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *CancelTaskQueueBacklogMigrationRequest) Reset() {
+	*x = CancelTaskQueueBacklogMigrationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksRequest) String() string {
+func (x *CancelTaskQueueBacklogMigrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksRequest) ProtoMessage() {}
+func (*CancelTaskQueueBacklogMigrationRequest) ProtoMessage() {}
 
-func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[63]
+func (x *CancelTaskQueueBacklogMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3890,58 +4070,54 @@ func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use CancelTaskQueueBacklogMigrationRequest.ProtoReflect.Descriptor instead.
+func (*CancelTaskQueueBacklogMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *CancelTaskQueueBacklogMigrationRequest) GetNamespace() string {
 	if x != nil {
-		return x.DlqKey
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDLQTasksRequest) GetPageSize() int32 {
+func (x *CancelTaskQueueBacklogMigrationRequest) GetSourceTaskQueue() string {
 	if x != nil {
-		return x.PageSize
+		return x.SourceTaskQueue
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
+func (x *CancelTaskQueueBacklogMigrationRequest) GetReason() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type GetDLQTasksResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
-	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
-	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
-	// there are more results.
-	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+type CancelTaskQueueBacklogMigrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canceled      bool                   `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDLQTasksResponse) Reset() {
-	*x = GetDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *CancelTaskQueueBacklogMigrationResponse) Reset() {
+	*x = CancelTaskQueueBacklogMigrationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDLQTasksResponse) String() string {
+func (x *CancelTaskQueueBacklogMigrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDLQTasksResponse) ProtoMessage() {}
+func (*CancelTaskQueueBacklogMigrationResponse) ProtoMessage() {}
 
-func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[64]
+func (x *CancelTaskQueueBacklogMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3952,48 +4128,41 @@ func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
-	if x != nil {
-		return x.DlqTasks
-	}
-	return nil
+// Deprecated: Use CancelTaskQueueBacklogMigrationResponse.ProtoReflect.Descriptor instead.
+func (*CancelTaskQueueBacklogMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+func (x *CancelTaskQueueBacklogMigrationResponse) GetCanceled() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.Canceled
 	}
-	return nil
+	return false
 }
 
-type PurgeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type DeleteWorkflowExecutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksRequest) Reset() {
-	*x = PurgeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *DeleteWorkflowExecutionRequest) Reset() {
+	*x = DeleteWorkflowExecutionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksRequest) String() string {
+func (x *DeleteWorkflowExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
 
-func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[65]
+func (x *DeleteWorkflowExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4004,48 +4173,47 @@ func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use DeleteWorkflowExecutionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *DeleteWorkflowExecutionRequest) GetNamespace() string {
 	if x != nil {
-		return x.DlqKey
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.Execution
 	}
 	return nil
 }
 
-type PurgeDLQTasksResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// job_token is a token that can be used to query the status of the purge operation.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+type DeleteWorkflowExecutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warnings      []string               `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurgeDLQTasksResponse) Reset() {
-	*x = PurgeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *DeleteWorkflowExecutionResponse) Reset() {
+	*x = DeleteWorkflowExecutionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurgeDLQTasksResponse) String() string {
+func (x *DeleteWorkflowExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurgeDLQTasksResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
 
-func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[66]
+func (x *DeleteWorkflowExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,42 +4224,43 @@ func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use DeleteWorkflowExecutionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
+func (x *DeleteWorkflowExecutionResponse) GetWarnings() []string {
 	if x != nil {
-		return x.JobToken
+		return x.Warnings
 	}
 	return nil
 }
 
-// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
-type DLQJobToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+type StreamWorkflowReplicationMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
+	Attributes    isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DLQJobToken) Reset() {
-	*x = DLQJobToken{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *StreamWorkflowReplicationMessagesRequest) Reset() {
+	*x = StreamWorkflowReplicationMessagesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DLQJobToken) String() string {
+func (x *StreamWorkflowReplicationMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DLQJobToken) ProtoMessage() {}
+func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
 
-func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[67]
+func (x *StreamWorkflowReplicationMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4102,54 +4271,4333 @@ func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
-func (*DLQJobToken) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use StreamWorkflowReplicationMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *DLQJobToken) GetWorkflowId() string {
+func (x *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
 	if x != nil {
-		return x.WorkflowId
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-func (x *DLQJobToken) GetRunId() string {
+func (x *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
+			return x.SyncReplicationState
+		}
+	}
+	return nil
+}
+
+type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
+	isStreamWorkflowReplicationMessagesRequest_Attributes()
+}
+
+type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
+	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof"`
+}
+
+func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+}
+
+type StreamWorkflowReplicationMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*StreamWorkflowReplicationMessagesResponse_Messages
+	Attributes    isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) Reset() {
+	*x = StreamWorkflowReplicationMessagesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
+
+func (x *StreamWorkflowReplicationMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkflowReplicationMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetAttributes() isStreamWorkflowReplicationMessagesResponse_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StreamWorkflowReplicationMessagesResponse) GetMessages() *v15.WorkflowReplicationMessages {
+	if x != nil {
+		if x, ok := x.Attributes.(*StreamWorkflowReplicationMessagesResponse_Messages); ok {
+			return x.Messages
+		}
+	}
+	return nil
+}
+
+type isStreamWorkflowReplicationMessagesResponse_Attributes interface {
+	isStreamWorkflowReplicationMessagesResponse_Attributes()
+}
+
+type StreamWorkflowReplicationMessagesResponse_Messages struct {
+	Messages *v15.WorkflowReplicationMessages `protobuf:"bytes,1,opt,name=messages,proto3,oneof"`
+}
+
+func (*StreamWorkflowReplicationMessagesResponse_Messages) isStreamWorkflowReplicationMessagesResponse_Attributes() {
+}
+
+type GetNamespaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Attributes:
+	//
+	//	*GetNamespaceRequest_Namespace
+	//	*GetNamespaceRequest_Id
+	Attributes    isGetNamespaceRequest_Attributes `protobuf_oneof:"attributes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetNamespaceRequest) GetAttributes() isGetNamespaceRequest_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Namespace); ok {
+			return x.Namespace
+		}
+	}
+	return ""
+}
+
+func (x *GetNamespaceRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Attributes.(*GetNamespaceRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+type isGetNamespaceRequest_Attributes interface {
+	isGetNamespaceRequest_Attributes()
+}
+
+type GetNamespaceRequest_Namespace struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof"`
+}
+
+type GetNamespaceRequest_Id struct {
+	Id string `protobuf:"bytes,2,opt,name=id,proto3,oneof"`
+}
+
+func (*GetNamespaceRequest_Namespace) isGetNamespaceRequest_Attributes() {}
+
+func (*GetNamespaceRequest_Id) isGetNamespaceRequest_Attributes() {}
+
+type GetNamespaceResponse struct {
+	state             protoimpl.MessageState           `protogen:"open.v1"`
+	Info              *v110.NamespaceInfo              `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Config            *v110.NamespaceConfig            `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	ReplicationConfig *v111.NamespaceReplicationConfig `protobuf:"bytes,5,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ConfigVersion     int64                            `protobuf:"varint,6,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	FailoverVersion   int64                            `protobuf:"varint,7,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
+	FailoverHistory   []*v111.FailoverStatus           `protobuf:"bytes,8,rep,name=failover_history,json=failoverHistory,proto3" json:"failover_history,omitempty"`
+	IsGlobalNamespace bool                             `protobuf:"varint,9,opt,name=is_global_namespace,json=isGlobalNamespace,proto3" json:"is_global_namespace,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceResponse) ProtoMessage() {}
+
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetNamespaceResponse) GetInfo() *v110.NamespaceInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetConfig() *v110.NamespaceConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetReplicationConfig() *v111.NamespaceReplicationConfig {
+	if x != nil {
+		return x.ReplicationConfig
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetConfigVersion() int64 {
+	if x != nil {
+		return x.ConfigVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverVersion() int64 {
+	if x != nil {
+		return x.FailoverVersion
+	}
+	return 0
+}
+
+func (x *GetNamespaceResponse) GetFailoverHistory() []*v111.FailoverStatus {
+	if x != nil {
+		return x.FailoverHistory
+	}
+	return nil
+}
+
+func (x *GetNamespaceResponse) GetIsGlobalNamespace() bool {
+	if x != nil {
+		return x.IsGlobalNamespace
+	}
+	return false
+}
+
+type GetDLQTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	// page_size must be positive. Up to this many tasks will be returned.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQTasksRequest) Reset() {
+	*x = GetDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQTasksRequest) ProtoMessage() {}
+
+func (x *GetDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *GetDLQTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDLQTasksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type GetDLQTasksResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DlqTasks []*v112.HistoryDLQTask `protobuf:"bytes,1,rep,name=dlq_tasks,json=dlqTasks,proto3" json:"dlq_tasks,omitempty"`
+	// next_page_token is empty if there are no more results. However, the converse is not true. If there are no more
+	// results, this field may still be non-empty. This is to avoid having to do a count query to determine whether
+	// there are more results.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDLQTasksResponse) Reset() {
+	*x = GetDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDLQTasksResponse) ProtoMessage() {}
+
+func (x *GetDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*GetDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetDLQTasksResponse) GetDlqTasks() []*v112.HistoryDLQTask {
+	if x != nil {
+		return x.DlqTasks
+	}
+	return nil
+}
+
+func (x *GetDLQTasksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type PurgeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *PurgeDLQTasksRequest) Reset() {
+	*x = PurgeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQTasksRequest) ProtoMessage() {}
+
+func (x *PurgeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PurgeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *PurgeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.InclusiveMaxTaskMetadata
+	}
+	return nil
+}
+
+type PurgeDLQTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// job_token is a token that can be used to query the status of the purge operation.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDLQTasksResponse) Reset() {
+	*x = PurgeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDLQTasksResponse) ProtoMessage() {}
+
+func (x *PurgeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PurgeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+// DLQJobToken identifies a DLQ job. This proto is for internal use only and clients should not use it.
+type DLQJobToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DLQJobToken) Reset() {
+	*x = DLQJobToken{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DLQJobToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DLQJobToken) ProtoMessage() {}
+
+func (x *DLQJobToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DLQJobToken.ProtoReflect.Descriptor instead.
+func (*DLQJobToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DLQJobToken) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *DLQJobToken) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type MergeDLQTasksRequest struct {
+	state                    protoimpl.MessageState       `protogen:"open.v1"`
+	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
+	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
+	// - If this is negative, an error will be returned.
+	// - If this is 0, the default will be used.
+	// - If this is greater than the maximum allowed batch size, an error will be returned.
+	// - Otherwise, the specified batch size will be used.
+	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksRequest) Reset() {
+	*x = MergeDLQTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQTasksRequest) ProtoMessage() {}
+
+func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+	if x != nil {
+		return x.InclusiveMaxTaskMetadata
+	}
+	return nil
+}
+
+func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type MergeDLQTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeDLQTasksResponse) Reset() {
+	*x = MergeDLQTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeDLQTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeDLQTasksResponse) ProtoMessage() {}
+
+func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
+func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+type DescribeDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job.
+	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeDLQJobRequest) Reset() {
+	*x = DescribeDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDLQJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDLQJobRequest) ProtoMessage() {}
+
+func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+type DescribeDLQJobResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
+	OperationType  v14.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
+	OperationState v14.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
+	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
+	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
+	// last_processed_message_id is the ID of the last message that has been processed.
+	// For PurgeDLQTasks job, it the ID of the last message that was purged.
+	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
+	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
+	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
+	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeDLQJobResponse) Reset() {
+	*x = DescribeDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeDLQJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeDLQJobResponse) ProtoMessage() {}
+
+func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
+	if x != nil {
+		return x.DlqKey
+	}
+	return nil
+}
+
+func (x *DescribeDLQJobResponse) GetOperationType() v14.DLQOperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return v14.DLQOperationType(0)
+}
+
+func (x *DescribeDLQJobResponse) GetOperationState() v14.DLQOperationState {
+	if x != nil {
+		return x.OperationState
+	}
+	return v14.DLQOperationState(0)
+}
+
+func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
+	if x != nil {
+		return x.MaxMessageId
+	}
+	return 0
+}
+
+func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
+	if x != nil {
+		return x.LastProcessedMessageId
+	}
+	return 0
+}
+
+func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
+	if x != nil {
+		return x.MessagesProcessed
+	}
+	return 0
+}
+
+type CancelDLQJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
+	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	// The reason for cancellation.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDLQJobRequest) Reset() {
+	*x = CancelDLQJobRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDLQJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDLQJobRequest) ProtoMessage() {}
+
+func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CancelDLQJobRequest) GetJobToken() []byte {
+	if x != nil {
+		return x.JobToken
+	}
+	return nil
+}
+
+func (x *CancelDLQJobRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelDLQJobResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is true if the workflow was successfully terminated by this request and false if
+	// the workflow was already completed or terminated.
+	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelDLQJobResponse) Reset() {
+	*x = CancelDLQJobResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelDLQJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDLQJobResponse) ProtoMessage() {}
+
+func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CancelDLQJobResponse) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
+// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
+// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
+type AddTasksRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTasksRequest) Reset() {
+	*x = AddTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTasksRequest) ProtoMessage() {}
+
+func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AddTasksRequest) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type AddTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTasksResponse) Reset() {
+	*x = AddTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTasksResponse) ProtoMessage() {}
+
+func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
+func (*AddTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
+}
+
+type ListQueuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesRequest) ProtoMessage() {}
+
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListQueuesRequest) GetQueueType() int32 {
+	if x != nil {
+		return x.QueueType
+	}
+	return 0
+}
+
+func (x *ListQueuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQueuesRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse) ProtoMessage() {}
+
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+func (x *ListQueuesResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type DeepHealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeepHealthCheckRequest) Reset() {
+	*x = DeepHealthCheckRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeepHealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeepHealthCheckRequest) ProtoMessage() {}
+
+func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
+}
+
+type DeepHealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         v14.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeepHealthCheckResponse) Reset() {
+	*x = DeepHealthCheckResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeepHealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeepHealthCheckResponse) ProtoMessage() {}
+
+func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DeepHealthCheckResponse) GetState() v14.HealthState {
+	if x != nil {
+		return x.State
+	}
+	return v14.HealthState(0)
+}
+
+type SyncWorkflowStateRequest struct {
+	state               protoimpl.MessageState   `protogen:"open.v1"`
+	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	VersionedTransition *v12.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
+	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
+	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SyncWorkflowStateRequest) Reset() {
+	*x = SyncWorkflowStateRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncWorkflowStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncWorkflowStateRequest) ProtoMessage() {}
+
+func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v12.VersionedTransition {
+	if x != nil {
+		return x.VersionedTransition
+	}
+	return nil
+}
+
+func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+	if x != nil {
+		return x.VersionHistories
+	}
+	return nil
+}
+
+func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
+	if x != nil {
+		return x.TargetClusterId
+	}
+	return 0
+}
+
+type SyncWorkflowStateResponse struct {
+	state                       protoimpl.MessageState           `protogen:"open.v1"`
+	VersionedTransitionArtifact *v15.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *SyncWorkflowStateResponse) Reset() {
+	*x = SyncWorkflowStateResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncWorkflowStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncWorkflowStateResponse) ProtoMessage() {}
+
+func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
+func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v15.VersionedTransitionArtifact {
+	if x != nil {
+		return x.VersionedTransitionArtifact
+	}
+	return nil
+}
+
+type GenerateLastHistoryReplicationTasksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	TargetClusters []string               `protobuf:"bytes,3,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
+	*x = GenerateLastHistoryReplicationTasksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *GenerateLastHistoryReplicationTasksRequest) GetTargetClusters() []string {
+	if x != nil {
+		return x.TargetClusters
+	}
+	return nil
+}
+
+type GenerateLastHistoryReplicationTasksResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
+	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
+	*x = GenerateLastHistoryReplicationTasksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
+
+func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
+	if x != nil {
+		return x.StateTransitionCount
+	}
+	return 0
+}
+
+func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
+	if x != nil {
+		return x.HistoryLength
+	}
+	return 0
+}
+
+type DescribeTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Absent means unversioned queue. Ignored for sticky partitions.
+	BuildIds      *v114.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeTaskQueuePartitionRequest) Reset() {
+	*x = DescribeTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeTaskQueuePartitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
+
+func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
+	}
+	return nil
+}
+
+func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v114.TaskQueueVersionSelection {
+	if x != nil {
+		return x.BuildIds
+	}
+	return nil
+}
+
+type InternalTaskQueueStatus struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReadLevel        int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
+	AckLevel         int64                  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	TaskIdBlock      *v114.TaskIdBlock      `protobuf:"bytes,3,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
+	ReadBufferLength int64                  `protobuf:"varint,4,opt,name=read_buffer_length,json=readBufferLength,proto3" json:"read_buffer_length,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalTaskQueueStatus) Reset() {
+	*x = InternalTaskQueueStatus{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalTaskQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalTaskQueueStatus) ProtoMessage() {}
+
+func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
+func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
+	if x != nil {
+		return x.ReadLevel
+	}
+	return 0
+}
+
+func (x *InternalTaskQueueStatus) GetAckLevel() int64 {
+	if x != nil {
+		return x.AckLevel
+	}
+	return 0
+}
+
+func (x *InternalTaskQueueStatus) GetTaskIdBlock() *v114.TaskIdBlock {
+	if x != nil {
+		return x.TaskIdBlock
+	}
+	return nil
+}
+
+func (x *InternalTaskQueueStatus) GetReadBufferLength() int64 {
+	if x != nil {
+		return x.ReadBufferLength
+	}
+	return 0
+}
+
+type DescribeTaskQueuePartitionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
+	VersionsInfoInternal map[string]*v113.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DescribeTaskQueuePartitionResponse) Reset() {
+	*x = DescribeTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeTaskQueuePartitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
+
+func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v113.TaskQueueVersionInfoInternal {
+	if x != nil {
+		return x.VersionsInfoInternal
+	}
+	return nil
+}
+
+type ForceUnloadTaskQueuePartitionRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
+	*x = ForceUnloadTaskQueuePartitionRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
+	}
+	return nil
+}
+
+type ForceUnloadTaskQueuePartitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
+	*x = ForceUnloadTaskQueuePartitionResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
+
+func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
+func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
+	if x != nil {
+		return x.WasLoaded
+	}
+	return false
+}
+
+type UpdateTaskQueueFairnessWeightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// Weight overrides to add or replace, by fairness key. Weights must be positive.
+	SetOverrides map[string]float32 `protobuf:"bytes,4,rep,name=set_overrides,json=setOverrides,proto3" json:"set_overrides,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	// Fairness keys whose overrides are removed.
+	UnsetOverrides []string `protobuf:"bytes,5,rep,name=unset_overrides,json=unsetOverrides,proto3" json:"unset_overrides,omitempty"`
+	// If set, default_weight replaces the current default weight.
+	UpdateDefaultWeight bool `protobuf:"varint,6,opt,name=update_default_weight,json=updateDefaultWeight,proto3" json:"update_default_weight,omitempty"`
+	// Zero resets the default weight to 1.0.
+	DefaultWeight float32 `protobuf:"fixed32,7,opt,name=default_weight,json=defaultWeight,proto3" json:"default_weight,omitempty"`
+	Identity      string  `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) Reset() {
+	*x = UpdateTaskQueueFairnessWeightsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskQueueFairnessWeightsRequest) ProtoMessage() {}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskQueueFairnessWeightsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskQueueFairnessWeightsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetSetOverrides() map[string]float32 {
+	if x != nil {
+		return x.SetOverrides
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetUnsetOverrides() []string {
+	if x != nil {
+		return x.UnsetOverrides
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetUpdateDefaultWeight() bool {
+	if x != nil {
+		return x.UpdateDefaultWeight
+	}
+	return false
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetDefaultWeight() float32 {
+	if x != nil {
+		return x.DefaultWeight
+	}
+	return 0
+}
+
+func (x *UpdateTaskQueueFairnessWeightsRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type UpdateTaskQueueFairnessWeightsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FairnessWeights *v12.FairnessWeights   `protobuf:"bytes,1,opt,name=fairness_weights,json=fairnessWeights,proto3" json:"fairness_weights,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskQueueFairnessWeightsResponse) Reset() {
+	*x = UpdateTaskQueueFairnessWeightsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskQueueFairnessWeightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskQueueFairnessWeightsResponse) ProtoMessage() {}
+
+func (x *UpdateTaskQueueFairnessWeightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskQueueFairnessWeightsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskQueueFairnessWeightsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *UpdateTaskQueueFairnessWeightsResponse) GetFairnessWeights() *v12.FairnessWeights {
+	if x != nil {
+		return x.FairnessWeights
+	}
+	return nil
+}
+
+type DescribeTaskQueueFairnessKeysRequest struct {
+	state              protoimpl.MessageState   `protogen:"open.v1"`
+	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
+	// Maximum number of keys in each list of the response. Defaults to 10.
+	TopN          int32 `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeTaskQueueFairnessKeysRequest) Reset() {
+	*x = DescribeTaskQueueFairnessKeysRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeTaskQueueFairnessKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTaskQueueFairnessKeysRequest) ProtoMessage() {}
+
+func (x *DescribeTaskQueueFairnessKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTaskQueueFairnessKeysRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueueFairnessKeysRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DescribeTaskQueueFairnessKeysRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeTaskQueueFairnessKeysRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+	if x != nil {
+		return x.TaskQueuePartition
+	}
+	return nil
+}
+
+func (x *DescribeTaskQueueFairnessKeysRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+type DescribeTaskQueueFairnessKeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keys with the largest backlog, largest first.
+	TopByBacklog []*v113.FairnessKeyStats `protobuf:"bytes,1,rep,name=top_by_backlog,json=topByBacklog,proto3" json:"top_by_backlog,omitempty"`
+	// Keys with the highest dispatch rate, highest first.
+	TopByDispatchRate []*v113.FairnessKeyStats `protobuf:"bytes,2,rep,name=top_by_dispatch_rate,json=topByDispatchRate,proto3" json:"top_by_dispatch_rate,omitempty"`
+	FairnessWeights   *v12.FairnessWeights     `protobuf:"bytes,3,opt,name=fairness_weights,json=fairnessWeights,proto3" json:"fairness_weights,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DescribeTaskQueueFairnessKeysResponse) Reset() {
+	*x = DescribeTaskQueueFairnessKeysResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeTaskQueueFairnessKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTaskQueueFairnessKeysResponse) ProtoMessage() {}
+
+func (x *DescribeTaskQueueFairnessKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTaskQueueFairnessKeysResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTaskQueueFairnessKeysResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DescribeTaskQueueFairnessKeysResponse) GetTopByBacklog() []*v113.FairnessKeyStats {
+	if x != nil {
+		return x.TopByBacklog
+	}
+	return nil
+}
+
+func (x *DescribeTaskQueueFairnessKeysResponse) GetTopByDispatchRate() []*v113.FairnessKeyStats {
+	if x != nil {
+		return x.TopByDispatchRate
+	}
+	return nil
+}
+
+func (x *DescribeTaskQueueFairnessKeysResponse) GetFairnessWeights() *v12.FairnessWeights {
+	if x != nil {
+		return x.FairnessWeights
+	}
+	return nil
+}
+
+type UpdateTaskQueuePauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// Unspecified removes the current pause.
+	Mode v14.TaskQueuePauseMode `protobuf:"varint,4,opt,name=mode,proto3,enum=temporal.server.api.enums.v1.TaskQueuePauseMode" json:"mode,omitempty"`
+	// Optional time at which the pause takes effect.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Optional time at which the pause ends.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Identity      string                 `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskQueuePauseRequest) Reset() {
+	*x = UpdateTaskQueuePauseRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskQueuePauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskQueuePauseRequest) ProtoMessage() {}
+
+func (x *UpdateTaskQueuePauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskQueuePauseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskQueuePauseRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetMode() v14.TaskQueuePauseMode {
+	if x != nil {
+		return x.Mode
+	}
+	return v14.TaskQueuePauseMode(0)
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueuePauseRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type UpdateTaskQueuePauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pause         *v12.TaskQueuePause    `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskQueuePauseResponse) Reset() {
+	*x = UpdateTaskQueuePauseResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskQueuePauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskQueuePauseResponse) ProtoMessage() {}
+
+func (x *UpdateTaskQueuePauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskQueuePauseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskQueuePauseResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateTaskQueuePauseResponse) GetPause() *v12.TaskQueuePause {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+type UpdateTaskQueueBlockedPollersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskQueue     string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v16.TaskQueueType      `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// Poller identities to block, or whose block is extended.
+	BlockIdentities []string `protobuf:"bytes,4,rep,name=block_identities,json=blockIdentities,proto3" json:"block_identities,omitempty"`
+	// How long the identities in block_identities are blocked.
+	BlockDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=block_duration,json=blockDuration,proto3" json:"block_duration,omitempty"`
+	Reason        string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Poller identities to unblock.
+	UnblockIdentities []string `protobuf:"bytes,7,rep,name=unblock_identities,json=unblockIdentities,proto3" json:"unblock_identities,omitempty"`
+	Identity          string   `protobuf:"bytes,8,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) Reset() {
+	*x = UpdateTaskQueueBlockedPollersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskQueueBlockedPollersRequest) ProtoMessage() {}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskQueueBlockedPollersRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskQueueBlockedPollersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetTaskQueueType() v16.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v16.TaskQueueType(0)
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetBlockIdentities() []string {
+	if x != nil {
+		return x.BlockIdentities
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetBlockDuration() *durationpb.Duration {
+	if x != nil {
+		return x.BlockDuration
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetUnblockIdentities() []string {
+	if x != nil {
+		return x.UnblockIdentities
+	}
+	return nil
+}
+
+func (x *UpdateTaskQueueBlockedPollersRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type UpdateTaskQueueBlockedPollersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BlockedPollers []*v12.BlockedPoller   `protobuf:"bytes,1,rep,name=blocked_pollers,json=blockedPollers,proto3" json:"blocked_pollers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTaskQueueBlockedPollersResponse) Reset() {
+	*x = UpdateTaskQueueBlockedPollersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskQueueBlockedPollersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskQueueBlockedPollersResponse) ProtoMessage() {}
+
+func (x *UpdateTaskQueueBlockedPollersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskQueueBlockedPollersResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskQueueBlockedPollersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *UpdateTaskQueueBlockedPollersResponse) GetBlockedPollers() []*v12.BlockedPoller {
+	if x != nil {
+		return x.BlockedPollers
+	}
+	return nil
+}
+
+type CountWorkersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountWorkersRequest) Reset() {
+	*x = CountWorkersRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountWorkersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountWorkersRequest) ProtoMessage() {}
+
+func (x *CountWorkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountWorkersRequest.ProtoReflect.Descriptor instead.
+func (*CountWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CountWorkersRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CountWorkersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type CountWorkersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Count int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Set if the query has a GROUP BY clause. Ordered by count, largest first.
+	Groups        []*CountWorkersResponse_AggregationGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountWorkersResponse) Reset() {
+	*x = CountWorkersResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountWorkersResponse) ProtoMessage() {}
+
+func (x *CountWorkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountWorkersResponse.ProtoReflect.Descriptor instead.
+func (*CountWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CountWorkersResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CountWorkersResponse) GetGroups() []*CountWorkersResponse_AggregationGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type PreviewScheduleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Id of the schedule, to apply the same jitter as the schedule with this id. Optional.
+	ScheduleId string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Spec       *v115.ScheduleSpec     `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Policies   *v115.SchedulePolicies `protobuf:"bytes,4,opt,name=policies,proto3" json:"policies,omitempty"`
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// How long each started workflow is assumed to run, to apply the overlap policy. Zero means that workflows
+	// complete as soon as they start.
+	RunDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=run_duration,json=runDuration,proto3" json:"run_duration,omitempty"`
+	// Maximum number of actions to return. Defaults to 100.
+	MaximumActionCount int32 `protobuf:"varint,8,opt,name=maximum_action_count,json=maximumActionCount,proto3" json:"maximum_action_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PreviewScheduleRequest) Reset() {
+	*x = PreviewScheduleRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewScheduleRequest) ProtoMessage() {}
+
+func (x *PreviewScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PreviewScheduleRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PreviewScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *PreviewScheduleRequest) GetSpec() *v115.ScheduleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *PreviewScheduleRequest) GetPolicies() *v115.SchedulePolicies {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *PreviewScheduleRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *PreviewScheduleRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *PreviewScheduleRequest) GetRunDuration() *durationpb.Duration {
+	if x != nil {
+		return x.RunDuration
+	}
+	return nil
+}
+
+func (x *PreviewScheduleRequest) GetMaximumActionCount() int32 {
+	if x != nil {
+		return x.MaximumActionCount
+	}
+	return 0
+}
+
+type PreviewScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The spec in the canonical form that the schedule would store.
+	CanonicalSpec *v115.ScheduleSpec                `protobuf:"bytes,1,opt,name=canonical_spec,json=canonicalSpec,proto3" json:"canonical_spec,omitempty"`
+	Actions       []*PreviewScheduleResponse_Action `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Set if there are more actions in the range than the maximum action count.
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewScheduleResponse) Reset() {
+	*x = PreviewScheduleResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewScheduleResponse) ProtoMessage() {}
+
+func (x *PreviewScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PreviewScheduleResponse) GetCanonicalSpec() *v115.ScheduleSpec {
+	if x != nil {
+		return x.CanonicalSpec
+	}
+	return nil
+}
+
+func (x *PreviewScheduleResponse) GetActions() []*PreviewScheduleResponse_Action {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PreviewScheduleResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ListScheduleActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleActionsRequest) Reset() {
+	*x = ListScheduleActionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleActionsRequest) ProtoMessage() {}
+
+func (x *ListScheduleActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleActionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListScheduleActionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListScheduleActionsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ListScheduleActionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScheduleActionsRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListScheduleActionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Workflows started by the schedule, that are still in visibility.
+	StartedActions []*ListScheduleActionsResponse_StartedAction `protobuf:"bytes,1,rep,name=started_actions,json=startedActions,proto3" json:"started_actions,omitempty"`
+	// Actions that the schedule skipped, oldest first. They are paginated along with started_actions,
+	// but by the batches they were recorded in: each page has up to page_size batches.
+	SkippedActions []*v116.SkippedAction `protobuf:"bytes,2,rep,name=skipped_actions,json=skippedActions,proto3" json:"skipped_actions,omitempty"`
+	NextPageToken  []byte                `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListScheduleActionsResponse) Reset() {
+	*x = ListScheduleActionsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleActionsResponse) ProtoMessage() {}
+
+func (x *ListScheduleActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleActionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListScheduleActionsResponse) GetStartedActions() []*ListScheduleActionsResponse_StartedAction {
+	if x != nil {
+		return x.StartedActions
+	}
+	return nil
+}
+
+func (x *ListScheduleActionsResponse) GetSkippedActions() []*v116.SkippedAction {
+	if x != nil {
+		return x.SkippedActions
+	}
+	return nil
+}
+
+func (x *ListScheduleActionsResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type UpdateScheduleCalendarSetRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Days of the set in "2006-01-02" format. An empty list deletes the set.
+	Days          []string `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	Identity      string   `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleCalendarSetRequest) Reset() {
+	*x = UpdateScheduleCalendarSetRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleCalendarSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleCalendarSetRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleCalendarSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleCalendarSetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleCalendarSetRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UpdateScheduleCalendarSetRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateScheduleCalendarSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateScheduleCalendarSetRequest) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *UpdateScheduleCalendarSetRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type UpdateScheduleCalendarSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleCalendarSetResponse) Reset() {
+	*x = UpdateScheduleCalendarSetResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleCalendarSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleCalendarSetResponse) ProtoMessage() {}
+
+func (x *UpdateScheduleCalendarSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleCalendarSetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleCalendarSetResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{113}
+}
+
+type ListScheduleCalendarSetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleCalendarSetsRequest) Reset() {
+	*x = ListScheduleCalendarSetsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleCalendarSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleCalendarSetsRequest) ProtoMessage() {}
+
+func (x *ListScheduleCalendarSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleCalendarSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduleCalendarSetsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListScheduleCalendarSetsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListScheduleCalendarSetsResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	CalendarSets  map[string]*v12.ScheduleCalendarSet `protobuf:"bytes,1,rep,name=calendar_sets,json=calendarSets,proto3" json:"calendar_sets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduleCalendarSetsResponse) Reset() {
+	*x = ListScheduleCalendarSetsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleCalendarSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleCalendarSetsResponse) ProtoMessage() {}
+
+func (x *ListScheduleCalendarSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleCalendarSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduleCalendarSetsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListScheduleCalendarSetsResponse) GetCalendarSets() map[string]*v12.ScheduleCalendarSet {
+	if x != nil {
+		return x.CalendarSets
+	}
+	return nil
+}
+
+type ListCallbacksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query for the workflows whose callbacks are listed. Lists the callbacks of all workflows if empty.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Only callbacks in these states are listed. Lists callbacks in any state if empty.
+	States []v14.CallbackState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=temporal.server.api.enums.v1.CallbackState" json:"states,omitempty"`
+	// Number of workflows to scan per page.
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallbacksRequest) Reset() {
+	*x = ListCallbacksRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallbacksRequest) ProtoMessage() {}
+
+func (x *ListCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*ListCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListCallbacksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListCallbacksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListCallbacksRequest) GetStates() []v14.CallbackState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListCallbacksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCallbacksRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListCallbacksResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Callbacks     []*ListCallbacksResponse_Callback `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	NextPageToken []byte                            `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCallbacksResponse) Reset() {
+	*x = ListCallbacksResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCallbacksResponse) ProtoMessage() {}
+
+func (x *ListCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ListCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListCallbacksResponse) GetCallbacks() []*ListCallbacksResponse_Callback {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+func (x *ListCallbacksResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type RetryCallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution     *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	CallbackId    string                 `protobuf:"bytes,3,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryCallbackRequest) Reset() {
+	*x = RetryCallbackRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryCallbackRequest) ProtoMessage() {}
+
+func (x *RetryCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryCallbackRequest.ProtoReflect.Descriptor instead.
+func (*RetryCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *RetryCallbackRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RetryCallbackRequest) GetExecution() *v1.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *RetryCallbackRequest) GetCallbackId() string {
+	if x != nil {
+		return x.CallbackId
+	}
+	return ""
+}
+
+type RetryCallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryCallbackResponse) Reset() {
+	*x = RetryCallbackResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryCallbackResponse) ProtoMessage() {}
+
+func (x *RetryCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryCallbackResponse.ProtoReflect.Descriptor instead.
+func (*RetryCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{119}
+}
+
+// Health of the calls made to a Nexus endpoint, aggregated over all history hosts.
+type NexusEndpointHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Outcomes of the calls completed within the last few minutes.
+	SuccessCount int64 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int64 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// Ratio of successful calls, zero if there were no calls.
+	SuccessRate float64 `protobuf:"fixed64,3,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// Latency percentiles, as the upper bound of the latency bucket the percentile falls into.
+	LatencyP50 *durationpb.Duration `protobuf:"bytes,4,opt,name=latency_p50,json=latencyP50,proto3" json:"latency_p50,omitempty"`
+	LatencyP90 *durationpb.Duration `protobuf:"bytes,5,opt,name=latency_p90,json=latencyP90,proto3" json:"latency_p90,omitempty"`
+	LatencyP99 *durationpb.Duration `protobuf:"bytes,6,opt,name=latency_p99,json=latencyP99,proto3" json:"latency_p99,omitempty"`
+	// Open if any circuit is open, otherwise half open if any circuit is half open.
+	CircuitBreakerState v14.CircuitBreakerState        `protobuf:"varint,7,opt,name=circuit_breaker_state,json=circuitBreakerState,proto3,enum=temporal.server.api.enums.v1.CircuitBreakerState" json:"circuit_breaker_state,omitempty"`
+	Circuits            []*NexusEndpointHealth_Circuit `protobuf:"bytes,8,rep,name=circuits,proto3" json:"circuits,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NexusEndpointHealth) Reset() {
+	*x = NexusEndpointHealth{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointHealth) ProtoMessage() {}
+
+func (x *NexusEndpointHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointHealth.ProtoReflect.Descriptor instead.
+func (*NexusEndpointHealth) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *NexusEndpointHealth) GetSuccessCount() int64 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *NexusEndpointHealth) GetFailureCount() int64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *NexusEndpointHealth) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *NexusEndpointHealth) GetLatencyP50() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyP50
+	}
+	return nil
+}
+
+func (x *NexusEndpointHealth) GetLatencyP90() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyP90
+	}
+	return nil
+}
+
+func (x *NexusEndpointHealth) GetLatencyP99() *durationpb.Duration {
+	if x != nil {
+		return x.LatencyP99
+	}
+	return nil
+}
+
+func (x *NexusEndpointHealth) GetCircuitBreakerState() v14.CircuitBreakerState {
+	if x != nil {
+		return x.CircuitBreakerState
+	}
+	return v14.CircuitBreakerState(0)
+}
+
+func (x *NexusEndpointHealth) GetCircuits() []*NexusEndpointHealth_Circuit {
+	if x != nil {
+		return x.Circuits
+	}
+	return nil
+}
+
+type GetNexusEndpointRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the endpoint.
+	Endpoint      string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNexusEndpointRequest) Reset() {
+	*x = GetNexusEndpointRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNexusEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNexusEndpointRequest) ProtoMessage() {}
+
+func (x *GetNexusEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNexusEndpointRequest.ProtoReflect.Descriptor instead.
+func (*GetNexusEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetNexusEndpointRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type GetNexusEndpointResponse struct {
+	state  protoimpl.MessageState  `protogen:"open.v1"`
+	Entry  *v12.NexusEndpointEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Health *NexusEndpointHealth    `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	// History hosts that could not be reached. Calls made by these hosts are not reflected in the health.
+	FailedHosts   []string `protobuf:"bytes,3,rep,name=failed_hosts,json=failedHosts,proto3" json:"failed_hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNexusEndpointResponse) Reset() {
+	*x = GetNexusEndpointResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNexusEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNexusEndpointResponse) ProtoMessage() {}
+
+func (x *GetNexusEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNexusEndpointResponse.ProtoReflect.Descriptor instead.
+func (*GetNexusEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetNexusEndpointResponse) GetEntry() *v12.NexusEndpointEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *GetNexusEndpointResponse) GetHealth() *NexusEndpointHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *GetNexusEndpointResponse) GetFailedHosts() []string {
+	if x != nil {
+		return x.FailedHosts
+	}
+	return nil
+}
+
+type ListNexusEndpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNexusEndpointsRequest) Reset() {
+	*x = ListNexusEndpointsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNexusEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNexusEndpointsRequest) ProtoMessage() {}
+
+func (x *ListNexusEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNexusEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListNexusEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListNexusEndpointsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNexusEndpointsRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ListNexusEndpointsResponse struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Endpoints     []*ListNexusEndpointsResponse_Endpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	NextPageToken []byte                                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// History hosts that could not be reached. Calls made by these hosts are not reflected in the health.
+	FailedHosts   []string `protobuf:"bytes,3,rep,name=failed_hosts,json=failedHosts,proto3" json:"failed_hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNexusEndpointsResponse) Reset() {
+	*x = ListNexusEndpointsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNexusEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNexusEndpointsResponse) ProtoMessage() {}
+
+func (x *ListNexusEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNexusEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListNexusEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListNexusEndpointsResponse) GetEndpoints() []*ListNexusEndpointsResponse_Endpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *ListNexusEndpointsResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+func (x *ListNexusEndpointsResponse) GetFailedHosts() []string {
+	if x != nil {
+		return x.FailedHosts
+	}
+	return nil
+}
+
+type SetNexusEndpointCircuitBreakerRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Use CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED to clear a previous override.
+	Override v14.CircuitBreakerOverride `protobuf:"varint,2,opt,name=override,proto3,enum=temporal.server.api.enums.v1.CircuitBreakerOverride" json:"override,omitempty"`
+	// How long the override is kept. Defaults to one hour.
+	Duration      *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNexusEndpointCircuitBreakerRequest) Reset() {
+	*x = SetNexusEndpointCircuitBreakerRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNexusEndpointCircuitBreakerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNexusEndpointCircuitBreakerRequest) ProtoMessage() {}
+
+func (x *SetNexusEndpointCircuitBreakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNexusEndpointCircuitBreakerRequest.ProtoReflect.Descriptor instead.
+func (*SetNexusEndpointCircuitBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SetNexusEndpointCircuitBreakerRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SetNexusEndpointCircuitBreakerRequest) GetOverride() v14.CircuitBreakerOverride {
+	if x != nil {
+		return x.Override
+	}
+	return v14.CircuitBreakerOverride(0)
+}
+
+func (x *SetNexusEndpointCircuitBreakerRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+type SetNexusEndpointCircuitBreakerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the endpoint after the update.
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNexusEndpointCircuitBreakerResponse) Reset() {
+	*x = SetNexusEndpointCircuitBreakerResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNexusEndpointCircuitBreakerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNexusEndpointCircuitBreakerResponse) ProtoMessage() {}
+
+func (x *SetNexusEndpointCircuitBreakerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNexusEndpointCircuitBreakerResponse.ProtoReflect.Descriptor instead.
+func (*SetNexusEndpointCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetNexusEndpointCircuitBreakerResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type SetNexusEndpointAccessPolicyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Names of the namespaces allowed to call the endpoint. Any namespace may call the endpoint if empty.
+	AllowedCallerNamespaces []string `protobuf:"bytes,2,rep,name=allowed_caller_namespaces,json=allowedCallerNamespaces,proto3" json:"allowed_caller_namespaces,omitempty"`
+	// Workflow types allowed to schedule operations on the endpoint. Any workflow type is allowed if empty.
+	AllowedWorkflowTypes []string `protobuf:"bytes,3,rep,name=allowed_workflow_types,json=allowedWorkflowTypes,proto3" json:"allowed_workflow_types,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetNexusEndpointAccessPolicyRequest) Reset() {
+	*x = SetNexusEndpointAccessPolicyRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNexusEndpointAccessPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNexusEndpointAccessPolicyRequest) ProtoMessage() {}
+
+func (x *SetNexusEndpointAccessPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNexusEndpointAccessPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetNexusEndpointAccessPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SetNexusEndpointAccessPolicyRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SetNexusEndpointAccessPolicyRequest) GetAllowedCallerNamespaces() []string {
+	if x != nil {
+		return x.AllowedCallerNamespaces
+	}
+	return nil
+}
+
+func (x *SetNexusEndpointAccessPolicyRequest) GetAllowedWorkflowTypes() []string {
+	if x != nil {
+		return x.AllowedWorkflowTypes
+	}
+	return nil
+}
+
+type SetNexusEndpointAccessPolicyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the endpoint after the update.
+	Version       int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNexusEndpointAccessPolicyResponse) Reset() {
+	*x = SetNexusEndpointAccessPolicyResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNexusEndpointAccessPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNexusEndpointAccessPolicyResponse) ProtoMessage() {}
+
+func (x *SetNexusEndpointAccessPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNexusEndpointAccessPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetNexusEndpointAccessPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SetNexusEndpointAccessPolicyResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type AggregateWorkflowExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query selecting the workflows to aggregate. Aggregates all workflows if empty.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Search attribute to aggregate, of type Int or Double, e.g. ExecutionDuration or HistoryLength. Workflows without a
+	// value are ignored.
+	SearchAttribute string `protobuf:"bytes,3,opt,name=search_attribute,json=searchAttribute,proto3" json:"search_attribute,omitempty"`
+	// Percentiles to compute, between 0 and 100. Elasticsearch computes approximate values.
+	Percentiles   []float64 `protobuf:"fixed64,4,rep,packed,name=percentiles,proto3" json:"percentiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsRequest) Reset() {
+	*x = AggregateWorkflowExecutionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsRequest) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetSearchAttribute() string {
+	if x != nil {
+		return x.SearchAttribute
+	}
+	return ""
+}
+
+func (x *AggregateWorkflowExecutionsRequest) GetPercentiles() []float64 {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type AggregateWorkflowExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of workflows matching the query with a value for the search attribute. All other fields are zero if there
+	// are none.
+	Count int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Sum   float64 `protobuf:"fixed64,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	Avg   float64 `protobuf:"fixed64,3,opt,name=avg,proto3" json:"avg,omitempty"`
+	Min   float64 `protobuf:"fixed64,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max   float64 `protobuf:"fixed64,5,opt,name=max,proto3" json:"max,omitempty"`
+	// Values of the requested percentiles, in the same order.
+	Percentiles   []*AggregateWorkflowExecutionsResponse_Percentile `protobuf:"bytes,6,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse) Reset() {
+	*x = AggregateWorkflowExecutionsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AggregateWorkflowExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateWorkflowExecutionsResponse) ProtoMessage() {}
+
+func (x *AggregateWorkflowExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateWorkflowExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *AggregateWorkflowExecutionsResponse) GetPercentiles() []*AggregateWorkflowExecutionsResponse_Percentile {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+type StartVisibilityReindexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Limits the reindex to a single namespace. Empty means all namespaces.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name of the visibility store the records are written to. Empty means the secondary store if one is configured, and
+	// the primary store otherwise.
+	TargetStore string `protobuf:"bytes,2,opt,name=target_store,json=targetStore,proto3" json:"target_store,omitempty"`
+	// Number of executions read per page. Zero uses the default.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Maximum number of executions processed per second. Zero means no limit.
+	ExecutionsPerSecond float32 `protobuf:"fixed32,4,opt,name=executions_per_second,json=executionsPerSecond,proto3" json:"executions_per_second,omitempty"`
+	// Compare the records of the primary and secondary store after the reindex.
+	Verify bool `protobuf:"varint,5,opt,name=verify,proto3" json:"verify,omitempty"`
+	// Only run the verification pass. Requires verify.
+	SkipReindex   bool   `protobuf:"varint,6,opt,name=skip_reindex,json=skipReindex,proto3" json:"skip_reindex,omitempty"`
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityReindexRequest) Reset() {
+	*x = StartVisibilityReindexRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityReindexRequest) ProtoMessage() {}
+
+func (x *StartVisibilityReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityReindexRequest.ProtoReflect.Descriptor instead.
+func (*StartVisibilityReindexRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *StartVisibilityReindexRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StartVisibilityReindexRequest) GetTargetStore() string {
+	if x != nil {
+		return x.TargetStore
+	}
+	return ""
+}
+
+func (x *StartVisibilityReindexRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *StartVisibilityReindexRequest) GetExecutionsPerSecond() float32 {
+	if x != nil {
+		return x.ExecutionsPerSecond
+	}
+	return 0
+}
+
+func (x *StartVisibilityReindexRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+func (x *StartVisibilityReindexRequest) GetSkipReindex() bool {
+	if x != nil {
+		return x.SkipReindex
+	}
+	return false
+}
+
+func (x *StartVisibilityReindexRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StartVisibilityReindexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartVisibilityReindexResponse) Reset() {
+	*x = StartVisibilityReindexResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartVisibilityReindexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartVisibilityReindexResponse) ProtoMessage() {}
+
+func (x *StartVisibilityReindexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartVisibilityReindexResponse.ProtoReflect.Descriptor instead.
+func (*StartVisibilityReindexResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *StartVisibilityReindexResponse) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *StartVisibilityReindexResponse) GetRunId() string {
 	if x != nil {
 		return x.RunId
 	}
 	return ""
 }
 
-type MergeDLQTasksRequest struct {
-	state                    protoimpl.MessageState       `protogen:"open.v1"`
-	DlqKey                   *v112.HistoryDLQKey          `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	InclusiveMaxTaskMetadata *v112.HistoryDLQTaskMetadata `protobuf:"bytes,2,opt,name=inclusive_max_task_metadata,json=inclusiveMaxTaskMetadata,proto3" json:"inclusive_max_task_metadata,omitempty"`
-	// batch_size controls how many tasks to merge at a time. The default can be found in the dlq package of the server.
-	// - If this is negative, an error will be returned.
-	// - If this is 0, the default will be used.
-	// - If this is greater than the maximum allowed batch size, an error will be returned.
-	// - Otherwise, the specified batch size will be used.
-	BatchSize     int32 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+type DescribeVisibilityReindexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityReindexRequest) Reset() {
+	*x = DescribeVisibilityReindexRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityReindexRequest) ProtoMessage() {}
+
+func (x *DescribeVisibilityReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityReindexRequest.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityReindexRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{133}
+}
+
+type DescribeVisibilityReindexResponse struct {
+	state               protoimpl.MessageState      `protogen:"open.v1"`
+	Status              v16.WorkflowExecutionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	TargetStore         string                      `protobuf:"bytes,2,opt,name=target_store,json=targetStore,proto3" json:"target_store,omitempty"`
+	NumShards           int32                       `protobuf:"varint,3,opt,name=num_shards,json=numShards,proto3" json:"num_shards,omitempty"`
+	ShardsReindexed     int32                       `protobuf:"varint,4,opt,name=shards_reindexed,json=shardsReindexed,proto3" json:"shards_reindexed,omitempty"`
+	ExecutionsReindexed int64                       `protobuf:"varint,5,opt,name=executions_reindexed,json=executionsReindexed,proto3" json:"executions_reindexed,omitempty"`
+	// Executions without a visibility record, e.g. zombie runs.
+	ExecutionsSkipped  int64 `protobuf:"varint,6,opt,name=executions_skipped,json=executionsSkipped,proto3" json:"executions_skipped,omitempty"`
+	ShardsVerified     int32 `protobuf:"varint,7,opt,name=shards_verified,json=shardsVerified,proto3" json:"shards_verified,omitempty"`
+	ExecutionsVerified int64 `protobuf:"varint,8,opt,name=executions_verified,json=executionsVerified,proto3" json:"executions_verified,omitempty"`
+	Mismatches         int64 `protobuf:"varint,9,opt,name=mismatches,proto3" json:"mismatches,omitempty"`
+	// The first mismatches found by the verification pass.
+	MismatchSamples []*DescribeVisibilityReindexResponse_Mismatch `protobuf:"bytes,10,rep,name=mismatch_samples,json=mismatchSamples,proto3" json:"mismatch_samples,omitempty"`
+	StartTime       *timestamppb.Timestamp                        `protobuf:"bytes,11,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime       *timestamppb.Timestamp                        `protobuf:"bytes,12,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	// Closed executions whose record can't be rebuilt because the history that is needed to rebuild it is neither in the
+	// history store nor archived.
+	ExecutionsUnrecoverable int64 `protobuf:"varint,13,opt,name=executions_unrecoverable,json=executionsUnrecoverable,proto3" json:"executions_unrecoverable,omitempty"`
+	// The first unrecoverable executions.
+	UnrecoverableSamples []*DescribeVisibilityReindexResponse_UnrecoverableExecution `protobuf:"bytes,14,rep,name=unrecoverable_samples,json=unrecoverableSamples,proto3" json:"unrecoverable_samples,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DescribeVisibilityReindexResponse) Reset() {
+	*x = DescribeVisibilityReindexResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeVisibilityReindexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeVisibilityReindexResponse) ProtoMessage() {}
+
+func (x *DescribeVisibilityReindexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeVisibilityReindexResponse.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityReindexResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DescribeVisibilityReindexResponse) GetStatus() v16.WorkflowExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v16.WorkflowExecutionStatus(0)
+}
+
+func (x *DescribeVisibilityReindexResponse) GetTargetStore() string {
+	if x != nil {
+		return x.TargetStore
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityReindexResponse) GetNumShards() int32 {
+	if x != nil {
+		return x.NumShards
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetShardsReindexed() int32 {
+	if x != nil {
+		return x.ShardsReindexed
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetExecutionsReindexed() int64 {
+	if x != nil {
+		return x.ExecutionsReindexed
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetExecutionsSkipped() int64 {
+	if x != nil {
+		return x.ExecutionsSkipped
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetShardsVerified() int32 {
+	if x != nil {
+		return x.ShardsVerified
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetExecutionsVerified() int64 {
+	if x != nil {
+		return x.ExecutionsVerified
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetMismatches() int64 {
+	if x != nil {
+		return x.Mismatches
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetMismatchSamples() []*DescribeVisibilityReindexResponse_Mismatch {
+	if x != nil {
+		return x.MismatchSamples
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityReindexResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityReindexResponse) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+func (x *DescribeVisibilityReindexResponse) GetExecutionsUnrecoverable() int64 {
+	if x != nil {
+		return x.ExecutionsUnrecoverable
+	}
+	return 0
+}
+
+func (x *DescribeVisibilityReindexResponse) GetUnrecoverableSamples() []*DescribeVisibilityReindexResponse_UnrecoverableExecution {
+	if x != nil {
+		return x.UnrecoverableSamples
+	}
+	return nil
+}
+
+type CancelVisibilityReindexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVisibilityReindexRequest) Reset() {
+	*x = CancelVisibilityReindexRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVisibilityReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVisibilityReindexRequest) ProtoMessage() {}
+
+func (x *CancelVisibilityReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVisibilityReindexRequest.ProtoReflect.Descriptor instead.
+func (*CancelVisibilityReindexRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *CancelVisibilityReindexRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelVisibilityReindexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Canceled      bool                   `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelVisibilityReindexResponse) Reset() {
+	*x = CancelVisibilityReindexResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelVisibilityReindexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelVisibilityReindexResponse) ProtoMessage() {}
+
+func (x *CancelVisibilityReindexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelVisibilityReindexResponse.ProtoReflect.Descriptor instead.
+func (*CancelVisibilityReindexResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CancelVisibilityReindexResponse) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
+type RenameSearchAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NewName       string                 `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameSearchAttributeRequest) Reset() {
+	*x = RenameSearchAttributeRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameSearchAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSearchAttributeRequest) ProtoMessage() {}
+
+func (x *RenameSearchAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSearchAttributeRequest.ProtoReflect.Descriptor instead.
+func (*RenameSearchAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RenameSearchAttributeRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RenameSearchAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameSearchAttributeRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+type RenameSearchAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameSearchAttributeResponse) Reset() {
+	*x = RenameSearchAttributeResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameSearchAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameSearchAttributeResponse) ProtoMessage() {}
+
+func (x *RenameSearchAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameSearchAttributeResponse.ProtoReflect.Descriptor instead.
+func (*RenameSearchAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{138}
+}
+
+type StartSearchAttributeMigrationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Name of the custom search attribute to migrate.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Type of the search attribute after the migration. Unspecified keeps the current type.
+	NewType v16.IndexedValueType `protobuf:"varint,3,opt,name=new_type,json=newType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"new_type,omitempty"`
+	// Name of the search attribute after the migration. Empty keeps the current name. Required when custom search
+	// attributes are not aliased per namespace because the type of an Elasticsearch field can't change.
+	NewName string `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	// Drop values that can't be converted to the new type instead of failing the migration.
+	SkipInvalidValues bool `protobuf:"varint,5,opt,name=skip_invalid_values,json=skipInvalidValues,proto3" json:"skip_invalid_values,omitempty"`
+	// Only validate that the existing values can be converted.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Number of executions read per page. Zero uses the default.
+	PageSize      int32  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeDLQTasksRequest) Reset() {
-	*x = MergeDLQTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *StartSearchAttributeMigrationRequest) Reset() {
+	*x = StartSearchAttributeMigrationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQTasksRequest) String() string {
+func (x *StartSearchAttributeMigrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQTasksRequest) ProtoMessage() {}
+func (*StartSearchAttributeMigrationRequest) ProtoMessage() {}
 
-func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68]
+func (x *StartSearchAttributeMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4160,54 +8608,90 @@ func (x *MergeDLQTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQTasksRequest.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use StartSearchAttributeMigrationRequest.ProtoReflect.Descriptor instead.
+func (*StartSearchAttributeMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *MergeDLQTasksRequest) GetDlqKey() *v112.HistoryDLQKey {
+func (x *StartSearchAttributeMigrationRequest) GetNamespace() string {
 	if x != nil {
-		return x.DlqKey
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *MergeDLQTasksRequest) GetInclusiveMaxTaskMetadata() *v112.HistoryDLQTaskMetadata {
+func (x *StartSearchAttributeMigrationRequest) GetName() string {
 	if x != nil {
-		return x.InclusiveMaxTaskMetadata
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MergeDLQTasksRequest) GetBatchSize() int32 {
+func (x *StartSearchAttributeMigrationRequest) GetNewType() v16.IndexedValueType {
 	if x != nil {
-		return x.BatchSize
+		return x.NewType
+	}
+	return v16.IndexedValueType(0)
+}
+
+func (x *StartSearchAttributeMigrationRequest) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *StartSearchAttributeMigrationRequest) GetSkipInvalidValues() bool {
+	if x != nil {
+		return x.SkipInvalidValues
+	}
+	return false
+}
+
+func (x *StartSearchAttributeMigrationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *StartSearchAttributeMigrationRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
 	}
 	return 0
 }
 
-type MergeDLQTasksResponse struct {
+func (x *StartSearchAttributeMigrationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type StartSearchAttributeMigrationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	JobToken      []byte                 `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MergeDLQTasksResponse) Reset() {
-	*x = MergeDLQTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *StartSearchAttributeMigrationResponse) Reset() {
+	*x = StartSearchAttributeMigrationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MergeDLQTasksResponse) String() string {
+func (x *StartSearchAttributeMigrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MergeDLQTasksResponse) ProtoMessage() {}
+func (*StartSearchAttributeMigrationResponse) ProtoMessage() {}
 
-func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69]
+func (x *StartSearchAttributeMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4218,41 +8702,227 @@ func (x *MergeDLQTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MergeDLQTasksResponse.ProtoReflect.Descriptor instead.
-func (*MergeDLQTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use StartSearchAttributeMigrationResponse.ProtoReflect.Descriptor instead.
+func (*StartSearchAttributeMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *MergeDLQTasksResponse) GetJobToken() []byte {
+func (x *StartSearchAttributeMigrationResponse) GetWorkflowId() string {
 	if x != nil {
-		return x.JobToken
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *StartSearchAttributeMigrationResponse) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type DescribeSearchAttributeMigrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeSearchAttributeMigrationRequest) Reset() {
+	*x = DescribeSearchAttributeMigrationRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeSearchAttributeMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeSearchAttributeMigrationRequest) ProtoMessage() {}
+
+func (x *DescribeSearchAttributeMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeSearchAttributeMigrationRequest.ProtoReflect.Descriptor instead.
+func (*DescribeSearchAttributeMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{141}
+}
+
+type DescribeSearchAttributeMigrationResponse struct {
+	state     protoimpl.MessageState      `protogen:"open.v1"`
+	Status    v16.WorkflowExecutionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	Namespace string                      `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	NewName   string                      `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	Type      v16.IndexedValueType        `protobuf:"varint,5,opt,name=type,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"type,omitempty"`
+	NewType   v16.IndexedValueType        `protobuf:"varint,6,opt,name=new_type,json=newType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"new_type,omitempty"`
+	// Current phase of the migration, e.g. Validating or Migrating.
+	Phase               string `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	ExecutionsValidated int64  `protobuf:"varint,8,opt,name=executions_validated,json=executionsValidated,proto3" json:"executions_validated,omitempty"`
+	ExecutionsMigrated  int64  `protobuf:"varint,9,opt,name=executions_migrated,json=executionsMigrated,proto3" json:"executions_migrated,omitempty"`
+	InvalidValues       int64  `protobuf:"varint,10,opt,name=invalid_values,json=invalidValues,proto3" json:"invalid_values,omitempty"`
+	// The first values that can't be converted to the new type.
+	InvalidValueSamples []*DescribeSearchAttributeMigrationResponse_InvalidValue `protobuf:"bytes,11,rep,name=invalid_value_samples,json=invalidValueSamples,proto3" json:"invalid_value_samples,omitempty"`
+	StartTime           *timestamppb.Timestamp                                   `protobuf:"bytes,12,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime           *timestamppb.Timestamp                                   `protobuf:"bytes,13,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) Reset() {
+	*x = DescribeSearchAttributeMigrationResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeSearchAttributeMigrationResponse) ProtoMessage() {}
+
+func (x *DescribeSearchAttributeMigrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeSearchAttributeMigrationResponse.ProtoReflect.Descriptor instead.
+func (*DescribeSearchAttributeMigrationResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetStatus() v16.WorkflowExecutionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v16.WorkflowExecutionStatus(0)
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetType() v16.IndexedValueType {
+	if x != nil {
+		return x.Type
+	}
+	return v16.IndexedValueType(0)
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetNewType() v16.IndexedValueType {
+	if x != nil {
+		return x.NewType
+	}
+	return v16.IndexedValueType(0)
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetExecutionsValidated() int64 {
+	if x != nil {
+		return x.ExecutionsValidated
+	}
+	return 0
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetExecutionsMigrated() int64 {
+	if x != nil {
+		return x.ExecutionsMigrated
+	}
+	return 0
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetInvalidValues() int64 {
+	if x != nil {
+		return x.InvalidValues
+	}
+	return 0
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetInvalidValueSamples() []*DescribeSearchAttributeMigrationResponse_InvalidValue {
+	if x != nil {
+		return x.InvalidValueSamples
 	}
 	return nil
 }
 
-type DescribeDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job.
-	JobToken      []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
+func (x *DescribeSearchAttributeMigrationResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DescribeSearchAttributeMigrationResponse) GetCloseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CloseTime
+	}
+	return nil
+}
+
+type PutSavedVisibilityQueryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// create_time and update_time are set by the server.
+	Query         *v12.SavedVisibilityQuery `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobRequest) Reset() {
-	*x = DescribeDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *PutSavedVisibilityQueryRequest) Reset() {
+	*x = PutSavedVisibilityQueryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobRequest) String() string {
+func (x *PutSavedVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobRequest) ProtoMessage() {}
+func (*PutSavedVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70]
+func (x *PutSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4263,52 +8933,84 @@ func (x *DescribeDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use PutSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*PutSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *DescribeDLQJobRequest) GetJobToken() []byte {
+func (x *PutSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.JobToken
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PutSavedVisibilityQueryRequest) GetQuery() *v12.SavedVisibilityQuery {
+	if x != nil {
+		return x.Query
 	}
 	return nil
 }
 
-type DescribeDLQJobResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	DlqKey         *v112.HistoryDLQKey    `protobuf:"bytes,1,opt,name=dlq_key,json=dlqKey,proto3" json:"dlq_key,omitempty"`
-	OperationType  v14.DLQOperationType   `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=temporal.server.api.enums.v1.DLQOperationType" json:"operation_type,omitempty"`
-	OperationState v14.DLQOperationState  `protobuf:"varint,3,opt,name=operation_state,json=operationState,proto3,enum=temporal.server.api.enums.v1.DLQOperationState" json:"operation_state,omitempty"`
-	StartTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	// max_message_id is the ID of the last message(inclusive) to be processed as part of this job.
-	MaxMessageId int64 `protobuf:"varint,6,opt,name=max_message_id,json=maxMessageId,proto3" json:"max_message_id,omitempty"`
-	// last_processed_message_id is the ID of the last message that has been processed.
-	// For PurgeDLQTasks job, it the ID of the last message that was purged.
-	// For MergeDLQTasks job, it is the ID of the last message that was re-enqueued and removed from the DLQ.
-	LastProcessedMessageId int64 `protobuf:"varint,7,opt,name=last_processed_message_id,json=lastProcessedMessageId,proto3" json:"last_processed_message_id,omitempty"`
-	// messages_processed is the total number of messages that are re-enqueued and deleted from the DLQ so far by the DLQ job.
-	MessagesProcessed int64 `protobuf:"varint,8,opt,name=messages_processed,json=messagesProcessed,proto3" json:"messages_processed,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type PutSavedVisibilityQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutSavedVisibilityQueryResponse) Reset() {
+	*x = PutSavedVisibilityQueryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutSavedVisibilityQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSavedVisibilityQueryResponse) ProtoMessage() {}
+
+func (x *PutSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*PutSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{144}
+}
+
+type DeleteSavedVisibilityQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobResponse) Reset() {
-	*x = DescribeDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *DeleteSavedVisibilityQueryRequest) Reset() {
+	*x = DeleteSavedVisibilityQueryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobResponse) String() string {
+func (x *DeleteSavedVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeDLQJobResponse) ProtoMessage() {}
+func (*DeleteSavedVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[71]
+func (x *DeleteSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4319,92 +9021,127 @@ func (x *DescribeDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*DescribeDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use DeleteSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *DescribeDLQJobResponse) GetDlqKey() *v112.HistoryDLQKey {
+func (x *DeleteSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.DlqKey
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *DescribeDLQJobResponse) GetOperationType() v14.DLQOperationType {
+func (x *DeleteSavedVisibilityQueryRequest) GetName() string {
 	if x != nil {
-		return x.OperationType
+		return x.Name
 	}
-	return v14.DLQOperationType(0)
+	return ""
 }
 
-func (x *DescribeDLQJobResponse) GetOperationState() v14.DLQOperationState {
-	if x != nil {
-		return x.OperationState
-	}
-	return v14.DLQOperationState(0)
+type DeleteSavedVisibilityQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeDLQJobResponse) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+func (x *DeleteSavedVisibilityQueryResponse) Reset() {
+	*x = DeleteSavedVisibilityQueryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeDLQJobResponse) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+func (x *DeleteSavedVisibilityQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DescribeDLQJobResponse) GetMaxMessageId() int64 {
+func (*DeleteSavedVisibilityQueryResponse) ProtoMessage() {}
+
+func (x *DeleteSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[146]
 	if x != nil {
-		return x.MaxMessageId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DescribeDLQJobResponse) GetLastProcessedMessageId() int64 {
+// Deprecated: Use DeleteSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{146}
+}
+
+type ListSavedVisibilityQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedVisibilityQueriesRequest) Reset() {
+	*x = ListSavedVisibilityQueriesRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedVisibilityQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedVisibilityQueriesRequest) ProtoMessage() {}
+
+func (x *ListSavedVisibilityQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[147]
 	if x != nil {
-		return x.LastProcessedMessageId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DescribeDLQJobResponse) GetMessagesProcessed() int64 {
+// Deprecated: Use ListSavedVisibilityQueriesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedVisibilityQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListSavedVisibilityQueriesRequest) GetNamespace() string {
 	if x != nil {
-		return x.MessagesProcessed
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-type CancelDLQJobRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Job token of MergeDLQTasks or PurgeDLQTasks job to cancel.
-	JobToken []byte `protobuf:"bytes,1,opt,name=job_token,json=jobToken,proto3" json:"job_token,omitempty"`
-	// The reason for cancellation.
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+type ListSavedVisibilityQueriesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Queries       []*v12.SavedVisibilityQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobRequest) Reset() {
-	*x = CancelDLQJobRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *ListSavedVisibilityQueriesResponse) Reset() {
+	*x = ListSavedVisibilityQueriesResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobRequest) String() string {
+func (x *ListSavedVisibilityQueriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobRequest) ProtoMessage() {}
+func (*ListSavedVisibilityQueriesResponse) ProtoMessage() {}
 
-func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[72]
+func (x *ListSavedVisibilityQueriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,49 +9152,45 @@ func (x *CancelDLQJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobRequest.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ListSavedVisibilityQueriesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedVisibilityQueriesResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *CancelDLQJobRequest) GetJobToken() []byte {
+func (x *ListSavedVisibilityQueriesResponse) GetQueries() []*v12.SavedVisibilityQuery {
 	if x != nil {
-		return x.JobToken
+		return x.Queries
 	}
 	return nil
 }
 
-func (x *CancelDLQJobRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type CancelDLQJobResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is true if the workflow was successfully terminated by this request and false if
-	// the workflow was already completed or terminated.
-	Canceled      bool `protobuf:"varint,1,opt,name=canceled,proto3" json:"canceled,omitempty"`
+type RunSavedVisibilityQueryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Only count the matching executions instead of listing them.
+	CountOnly     bool   `protobuf:"varint,3,opt,name=count_only,json=countOnly,proto3" json:"count_only,omitempty"`
+	PageSize      int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelDLQJobResponse) Reset() {
-	*x = CancelDLQJobResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *RunSavedVisibilityQueryRequest) Reset() {
+	*x = RunSavedVisibilityQueryRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelDLQJobResponse) String() string {
+func (x *RunSavedVisibilityQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelDLQJobResponse) ProtoMessage() {}
+func (*RunSavedVisibilityQueryRequest) ProtoMessage() {}
 
-func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[73]
+func (x *RunSavedVisibilityQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4468,44 +9201,71 @@ func (x *CancelDLQJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelDLQJobResponse.ProtoReflect.Descriptor instead.
-func (*CancelDLQJobResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use RunSavedVisibilityQueryRequest.ProtoReflect.Descriptor instead.
+func (*RunSavedVisibilityQueryRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *CancelDLQJobResponse) GetCanceled() bool {
+func (x *RunSavedVisibilityQueryRequest) GetNamespace() string {
 	if x != nil {
-		return x.Canceled
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RunSavedVisibilityQueryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunSavedVisibilityQueryRequest) GetCountOnly() bool {
+	if x != nil {
+		return x.CountOnly
 	}
 	return false
 }
 
-// This is a direct copy of the same proto in the history service. We can't import it, though because of a circular
-// dependency. In addition, we can't extract a common request proto because the shard_id needs to be present in the top
-// proto layer, so we duplicate it. It shouldn't be a big deal because this proto is not very big.
-type AddTasksRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ShardId       int32                   `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
-	Tasks         []*AddTasksRequest_Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+func (x *RunSavedVisibilityQueryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *RunSavedVisibilityQueryRequest) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type RunSavedVisibilityQueryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only set if count_only is set.
+	Count         int64                        `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Executions    []*v17.WorkflowExecutionInfo `protobuf:"bytes,2,rep,name=executions,proto3" json:"executions,omitempty"`
+	NextPageToken []byte                       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksRequest) Reset() {
-	*x = AddTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *RunSavedVisibilityQueryResponse) Reset() {
+	*x = RunSavedVisibilityQueryResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest) String() string {
+func (x *RunSavedVisibilityQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest) ProtoMessage() {}
+func (*RunSavedVisibilityQueryResponse) ProtoMessage() {}
 
-func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[74]
+func (x *RunSavedVisibilityQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4516,46 +9276,57 @@ func (x *AddTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use RunSavedVisibilityQueryResponse.ProtoReflect.Descriptor instead.
+func (*RunSavedVisibilityQueryResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *AddTasksRequest) GetShardId() int32 {
+func (x *RunSavedVisibilityQueryResponse) GetCount() int64 {
 	if x != nil {
-		return x.ShardId
+		return x.Count
 	}
 	return 0
 }
 
-func (x *AddTasksRequest) GetTasks() []*AddTasksRequest_Task {
+func (x *RunSavedVisibilityQueryResponse) GetExecutions() []*v17.WorkflowExecutionInfo {
 	if x != nil {
-		return x.Tasks
+		return x.Executions
 	}
 	return nil
 }
 
-type AddTasksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RunSavedVisibilityQueryResponse) GetNextPageToken() []byte {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return nil
+}
+
+type ExplainWorkflowExecutionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Visibility query to explain, as passed to ListWorkflowExecutions or CountWorkflowExecutions.
+	Query         string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksResponse) Reset() {
-	*x = AddTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *ExplainWorkflowExecutionsRequest) Reset() {
+	*x = ExplainWorkflowExecutionsRequest{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksResponse) String() string {
+func (x *ExplainWorkflowExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksResponse) ProtoMessage() {}
+func (*ExplainWorkflowExecutionsRequest) ProtoMessage() {}
 
-func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[75]
+func (x *ExplainWorkflowExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4566,35 +9337,65 @@ func (x *AddTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksResponse.ProtoReflect.Descriptor instead.
-func (*AddTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ExplainWorkflowExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ExplainWorkflowExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{151}
 }
 
-type ListQueuesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueType     int32                  `protobuf:"varint,1,opt,name=queue_type,json=queueType,proto3" json:"queue_type,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	NextPageToken []byte                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *ExplainWorkflowExecutionsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExplainWorkflowExecutionsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ExplainWorkflowExecutionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ExplainWorkflowExecutionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the visibility store the query is translated for, e.g. elasticsearch or mysql.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// Query the visibility query translates to: the body of the Elasticsearch search request as JSON, or the SQL
+	// statement followed by its arguments.
+	StoreQuery string `protobuf:"bytes,2,opt,name=store_query,json=storeQuery,proto3" json:"store_query,omitempty"`
+	// Estimated cost of the query and what contributes to it. Only Elasticsearch estimates the cost of queries, the cost
+	// fields are always empty for SQL stores.
+	Cost        int64    `protobuf:"varint,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	CostReasons []string `protobuf:"bytes,4,rep,name=cost_reasons,json=costReasons,proto3" json:"cost_reasons,omitempty"`
+	// Maximum cost of queries of the namespace, set by the system.visibilityQueryCostLimit dynamic config. Queries above
+	// the limit are rejected unless they come from system or background callers. 0 if not limited.
+	CostLimit     int64 `protobuf:"varint,5,opt,name=cost_limit,json=costLimit,proto3" json:"cost_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesRequest) Reset() {
-	*x = ListQueuesRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *ExplainWorkflowExecutionsResponse) Reset() {
+	*x = ExplainWorkflowExecutionsResponse{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesRequest) String() string {
+func (x *ExplainWorkflowExecutionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesRequest) ProtoMessage() {}
+func (*ExplainWorkflowExecutionsResponse) ProtoMessage() {}
 
-func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[76]
+func (x *ExplainWorkflowExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4605,55 +9406,69 @@ func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ExplainWorkflowExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ExplainWorkflowExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *ListQueuesRequest) GetQueueType() int32 {
+func (x *ExplainWorkflowExecutionsResponse) GetStore() string {
 	if x != nil {
-		return x.QueueType
+		return x.Store
 	}
-	return 0
+	return ""
 }
 
-func (x *ListQueuesRequest) GetPageSize() int32 {
+func (x *ExplainWorkflowExecutionsResponse) GetStoreQuery() string {
 	if x != nil {
-		return x.PageSize
+		return x.StoreQuery
+	}
+	return ""
+}
+
+func (x *ExplainWorkflowExecutionsResponse) GetCost() int64 {
+	if x != nil {
+		return x.Cost
 	}
 	return 0
 }
 
-func (x *ListQueuesRequest) GetNextPageToken() []byte {
+func (x *ExplainWorkflowExecutionsResponse) GetCostReasons() []string {
 	if x != nil {
-		return x.NextPageToken
+		return x.CostReasons
 	}
 	return nil
 }
 
-type ListQueuesResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Queues        []*ListQueuesResponse_QueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
-	NextPageToken []byte                          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+func (x *ExplainWorkflowExecutionsResponse) GetCostLimit() int64 {
+	if x != nil {
+		return x.CostLimit
+	}
+	return 0
+}
+
+type AddTasksRequest_Task struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Blob          *v1.DataBlob           `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse) Reset() {
-	*x = ListQueuesResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *AddTasksRequest_Task) Reset() {
+	*x = AddTasksRequest_Task{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse) String() string {
+func (x *AddTasksRequest_Task) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse) ProtoMessage() {}
+func (*AddTasksRequest_Task) ProtoMessage() {}
 
-func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[77]
+func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4664,46 +9479,48 @@ func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
+func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83, 0}
 }
 
-func (x *ListQueuesResponse) GetQueues() []*ListQueuesResponse_QueueInfo {
+func (x *AddTasksRequest_Task) GetCategoryId() int32 {
 	if x != nil {
-		return x.Queues
+		return x.CategoryId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListQueuesResponse) GetNextPageToken() []byte {
+func (x *AddTasksRequest_Task) GetBlob() *v1.DataBlob {
 	if x != nil {
-		return x.NextPageToken
+		return x.Blob
 	}
 	return nil
 }
 
-type DeepHealthCheckRequest struct {
+type ListQueuesResponse_QueueInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueName     string                 `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
+	MessageCount  int64                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckRequest) Reset() {
-	*x = DeepHealthCheckRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *ListQueuesResponse_QueueInfo) Reset() {
+	*x = ListQueuesResponse_QueueInfo{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckRequest) String() string {
+func (x *ListQueuesResponse_QueueInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckRequest) ProtoMessage() {}
+func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
 
-func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[78]
+func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4714,33 +9531,48 @@ func (x *DeepHealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86, 0}
 }
 
-type DeepHealthCheckResponse struct {
+func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
+	if x != nil {
+		return x.QueueName
+	}
+	return ""
+}
+
+func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+type CountWorkersResponse_AggregationGroup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	State         v14.HealthState        `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.HealthState" json:"state,omitempty"`
+	GroupValues   []string               `protobuf:"bytes,1,rep,name=group_values,json=groupValues,proto3" json:"group_values,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeepHealthCheckResponse) Reset() {
-	*x = DeepHealthCheckResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *CountWorkersResponse_AggregationGroup) Reset() {
+	*x = CountWorkersResponse_AggregationGroup{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeepHealthCheckResponse) String() string {
+func (x *CountWorkersResponse_AggregationGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeepHealthCheckResponse) ProtoMessage() {}
+func (*CountWorkersResponse_AggregationGroup) ProtoMessage() {}
 
-func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[79]
+func (x *CountWorkersResponse_AggregationGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4751,44 +9583,60 @@ func (x *DeepHealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeepHealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*DeepHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use CountWorkersResponse_AggregationGroup.ProtoReflect.Descriptor instead.
+func (*CountWorkersResponse_AggregationGroup) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{107, 0}
 }
 
-func (x *DeepHealthCheckResponse) GetState() v14.HealthState {
+func (x *CountWorkersResponse_AggregationGroup) GetGroupValues() []string {
 	if x != nil {
-		return x.State
+		return x.GroupValues
 	}
-	return v14.HealthState(0)
+	return nil
 }
 
-type SyncWorkflowStateRequest struct {
-	state               protoimpl.MessageState   `protogen:"open.v1"`
-	NamespaceId         string                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Execution           *v1.WorkflowExecution    `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	VersionedTransition *v12.VersionedTransition `protobuf:"bytes,3,opt,name=versioned_transition,json=versionedTransition,proto3" json:"versioned_transition,omitempty"`
-	VersionHistories    *v11.VersionHistories    `protobuf:"bytes,4,opt,name=version_histories,json=versionHistories,proto3" json:"version_histories,omitempty"`
-	TargetClusterId     int32                    `protobuf:"varint,5,opt,name=target_cluster_id,json=targetClusterId,proto3" json:"target_cluster_id,omitempty"`
+func (x *CountWorkersResponse_AggregationGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PreviewScheduleResponse_Action struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time that matches the spec.
+	NominalTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=nominal_time,json=nominalTime,proto3" json:"nominal_time,omitempty"`
+	// Nominal time with jitter applied.
+	ActualTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=actual_time,json=actualTime,proto3" json:"actual_time,omitempty"`
+	// Time at which the workflow would start, later than the actual time if it was buffered by the overlap
+	// policy. Unset if the action is skipped.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Set if the overlap policy skips the action.
+	OverlapSkipped bool `protobuf:"varint,4,opt,name=overlap_skipped,json=overlapSkipped,proto3" json:"overlap_skipped,omitempty"`
+	// Set if the action cancels or terminates the workflow that is running when it starts.
+	CancelsRunning bool `protobuf:"varint,5,opt,name=cancels_running,json=cancelsRunning,proto3" json:"cancels_running,omitempty"`
+	// Set if the action is further in the past than the catchup window, and so isn't taken by a schedule that is
+	// created now.
+	MissedCatchupWindow bool `protobuf:"varint,6,opt,name=missed_catchup_window,json=missedCatchupWindow,proto3" json:"missed_catchup_window,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *SyncWorkflowStateRequest) Reset() {
-	*x = SyncWorkflowStateRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *PreviewScheduleResponse_Action) Reset() {
+	*x = PreviewScheduleResponse_Action{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncWorkflowStateRequest) String() string {
+func (x *PreviewScheduleResponse_Action) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncWorkflowStateRequest) ProtoMessage() {}
+func (*PreviewScheduleResponse_Action) ProtoMessage() {}
 
-func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[80]
+func (x *PreviewScheduleResponse_Action) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4799,114 +9647,81 @@ func (x *SyncWorkflowStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncWorkflowStateRequest.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *SyncWorkflowStateRequest) GetNamespaceId() string {
-	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
+// Deprecated: Use PreviewScheduleResponse_Action.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleResponse_Action) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{109, 0}
 }
 
-func (x *SyncWorkflowStateRequest) GetExecution() *v1.WorkflowExecution {
+func (x *PreviewScheduleResponse_Action) GetNominalTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Execution
+		return x.NominalTime
 	}
 	return nil
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionedTransition() *v12.VersionedTransition {
+func (x *PreviewScheduleResponse_Action) GetActualTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VersionedTransition
+		return x.ActualTime
 	}
 	return nil
 }
 
-func (x *SyncWorkflowStateRequest) GetVersionHistories() *v11.VersionHistories {
+func (x *PreviewScheduleResponse_Action) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VersionHistories
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *SyncWorkflowStateRequest) GetTargetClusterId() int32 {
+func (x *PreviewScheduleResponse_Action) GetOverlapSkipped() bool {
 	if x != nil {
-		return x.TargetClusterId
+		return x.OverlapSkipped
 	}
-	return 0
-}
-
-type SyncWorkflowStateResponse struct {
-	state                       protoimpl.MessageState           `protogen:"open.v1"`
-	VersionedTransitionArtifact *v15.VersionedTransitionArtifact `protobuf:"bytes,5,opt,name=versioned_transition_artifact,json=versionedTransitionArtifact,proto3" json:"versioned_transition_artifact,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
-}
-
-func (x *SyncWorkflowStateResponse) Reset() {
-	*x = SyncWorkflowStateResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SyncWorkflowStateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return false
 }
 
-func (*SyncWorkflowStateResponse) ProtoMessage() {}
-
-func (x *SyncWorkflowStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[81]
+func (x *PreviewScheduleResponse_Action) GetCancelsRunning() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CancelsRunning
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SyncWorkflowStateResponse.ProtoReflect.Descriptor instead.
-func (*SyncWorkflowStateResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{81}
+	return false
 }
 
-func (x *SyncWorkflowStateResponse) GetVersionedTransitionArtifact() *v15.VersionedTransitionArtifact {
+func (x *PreviewScheduleResponse_Action) GetMissedCatchupWindow() bool {
 	if x != nil {
-		return x.VersionedTransitionArtifact
+		return x.MissedCatchupWindow
 	}
-	return nil
+	return false
 }
 
-type GenerateLastHistoryReplicationTasksRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Namespace      string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution      *v1.WorkflowExecution  `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
-	TargetClusters []string               `protobuf:"bytes,3,rep,name=target_clusters,json=targetClusters,proto3" json:"target_clusters,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListScheduleActionsResponse_StartedAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nominal time of the action that started the workflow.
+	NominalTime   *timestamppb.Timestamp      `protobuf:"bytes,1,opt,name=nominal_time,json=nominalTime,proto3" json:"nominal_time,omitempty"`
+	WorkflowId    string                      `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                      `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status        v16.WorkflowExecutionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=temporal.api.enums.v1.WorkflowExecutionStatus" json:"status,omitempty"`
+	StartTime     *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime     *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) Reset() {
-	*x = GenerateLastHistoryReplicationTasksRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+func (x *ListScheduleActionsResponse_StartedAction) Reset() {
+	*x = ListScheduleActionsResponse_StartedAction{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) String() string {
+func (x *ListScheduleActionsResponse_StartedAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
+func (*ListScheduleActionsResponse_StartedAction) ProtoMessage() {}
 
-func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[82]
+func (x *ListScheduleActionsResponse_StartedAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4917,109 +9732,78 @@ func (x *GenerateLastHistoryReplicationTasksRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateLastHistoryReplicationTasksRequest.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use ListScheduleActionsResponse_StartedAction.ProtoReflect.Descriptor instead.
+func (*ListScheduleActionsResponse_StartedAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{111, 0}
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetNamespace() string {
+func (x *ListScheduleActionsResponse_StartedAction) GetNominalTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Namespace
+		return x.NominalTime
 	}
-	return ""
+	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetExecution() *v1.WorkflowExecution {
+func (x *ListScheduleActionsResponse_StartedAction) GetWorkflowId() string {
 	if x != nil {
-		return x.Execution
+		return x.WorkflowId
 	}
-	return nil
+	return ""
 }
 
-func (x *GenerateLastHistoryReplicationTasksRequest) GetTargetClusters() []string {
+func (x *ListScheduleActionsResponse_StartedAction) GetRunId() string {
 	if x != nil {
-		return x.TargetClusters
+		return x.RunId
 	}
-	return nil
-}
-
-type GenerateLastHistoryReplicationTasksResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	StateTransitionCount int64                  `protobuf:"varint,1,opt,name=state_transition_count,json=stateTransitionCount,proto3" json:"state_transition_count,omitempty"`
-	HistoryLength        int64                  `protobuf:"varint,2,opt,name=history_length,json=historyLength,proto3" json:"history_length,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *GenerateLastHistoryReplicationTasksResponse) Reset() {
-	*x = GenerateLastHistoryReplicationTasksResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateLastHistoryReplicationTasksResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
-
-func (x *GenerateLastHistoryReplicationTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[83]
+func (x *ListScheduleActionsResponse_StartedAction) GetStatus() v16.WorkflowExecutionStatus {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GenerateLastHistoryReplicationTasksResponse.ProtoReflect.Descriptor instead.
-func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{83}
+	return v16.WorkflowExecutionStatus(0)
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) GetStateTransitionCount() int64 {
+func (x *ListScheduleActionsResponse_StartedAction) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StateTransitionCount
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *GenerateLastHistoryReplicationTasksResponse) GetHistoryLength() int64 {
+func (x *ListScheduleActionsResponse_StartedAction) GetCloseTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HistoryLength
+		return x.CloseTime
 	}
-	return 0
+	return nil
 }
 
-type DescribeTaskQueuePartitionRequest struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	// Absent means unversioned queue. Ignored for sticky partitions.
-	BuildIds      *v114.TaskQueueVersionSelection `protobuf:"bytes,3,opt,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+type ListCallbacksResponse_Callback struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Execution *v1.WorkflowExecution  `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// ID of the callback, for RetryCallback.
+	CallbackId    string            `protobuf:"bytes,2,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	Info          *v12.CallbackInfo `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionRequest) Reset() {
-	*x = DescribeTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *ListCallbacksResponse_Callback) Reset() {
+	*x = ListCallbacksResponse_Callback{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionRequest) String() string {
+func (x *ListCallbacksResponse_Callback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionRequest) ProtoMessage() {}
+func (*ListCallbacksResponse_Callback) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[84]
+func (x *ListCallbacksResponse_Callback) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5030,57 +9814,58 @@ func (x *DescribeTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use ListCallbacksResponse_Callback.ProtoReflect.Descriptor instead.
+func (*ListCallbacksResponse_Callback) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{117, 0}
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetNamespace() string {
+func (x *ListCallbacksResponse_Callback) GetExecution() *v1.WorkflowExecution {
 	if x != nil {
-		return x.Namespace
+		return x.Execution
 	}
-	return ""
+	return nil
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+func (x *ListCallbacksResponse_Callback) GetCallbackId() string {
 	if x != nil {
-		return x.TaskQueuePartition
+		return x.CallbackId
 	}
-	return nil
+	return ""
 }
 
-func (x *DescribeTaskQueuePartitionRequest) GetBuildIds() *v114.TaskQueueVersionSelection {
+func (x *ListCallbacksResponse_Callback) GetInfo() *v12.CallbackInfo {
 	if x != nil {
-		return x.BuildIds
+		return x.Info
 	}
 	return nil
 }
 
-type InternalTaskQueueStatus struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ReadLevel        int64                  `protobuf:"varint,1,opt,name=read_level,json=readLevel,proto3" json:"read_level,omitempty"`
-	AckLevel         int64                  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
-	TaskIdBlock      *v114.TaskIdBlock      `protobuf:"bytes,3,opt,name=task_id_block,json=taskIdBlock,proto3" json:"task_id_block,omitempty"`
-	ReadBufferLength int64                  `protobuf:"varint,4,opt,name=read_buffer_length,json=readBufferLength,proto3" json:"read_buffer_length,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// A circuit breaker protecting the calls of one namespace and task group to the endpoint on one history host.
+type NexusEndpointHealth_Circuit struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	HostAddress   string                  `protobuf:"bytes,1,opt,name=host_address,json=hostAddress,proto3" json:"host_address,omitempty"`
+	Namespace     string                  `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	TaskGroup     string                  `protobuf:"bytes,3,opt,name=task_group,json=taskGroup,proto3" json:"task_group,omitempty"`
+	State         v14.CircuitBreakerState `protobuf:"varint,4,opt,name=state,proto3,enum=temporal.server.api.enums.v1.CircuitBreakerState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalTaskQueueStatus) Reset() {
-	*x = InternalTaskQueueStatus{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *NexusEndpointHealth_Circuit) Reset() {
+	*x = NexusEndpointHealth_Circuit{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalTaskQueueStatus) String() string {
+func (x *NexusEndpointHealth_Circuit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalTaskQueueStatus) ProtoMessage() {}
+func (*NexusEndpointHealth_Circuit) ProtoMessage() {}
 
-func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[85]
+func (x *NexusEndpointHealth_Circuit) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5091,62 +9876,62 @@ func (x *InternalTaskQueueStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalTaskQueueStatus.ProtoReflect.Descriptor instead.
-func (*InternalTaskQueueStatus) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use NexusEndpointHealth_Circuit.ProtoReflect.Descriptor instead.
+func (*NexusEndpointHealth_Circuit) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{120, 0}
 }
 
-func (x *InternalTaskQueueStatus) GetReadLevel() int64 {
+func (x *NexusEndpointHealth_Circuit) GetHostAddress() string {
 	if x != nil {
-		return x.ReadLevel
+		return x.HostAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *InternalTaskQueueStatus) GetAckLevel() int64 {
+func (x *NexusEndpointHealth_Circuit) GetNamespace() string {
 	if x != nil {
-		return x.AckLevel
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-func (x *InternalTaskQueueStatus) GetTaskIdBlock() *v114.TaskIdBlock {
+func (x *NexusEndpointHealth_Circuit) GetTaskGroup() string {
 	if x != nil {
-		return x.TaskIdBlock
+		return x.TaskGroup
 	}
-	return nil
+	return ""
 }
 
-func (x *InternalTaskQueueStatus) GetReadBufferLength() int64 {
+func (x *NexusEndpointHealth_Circuit) GetState() v14.CircuitBreakerState {
 	if x != nil {
-		return x.ReadBufferLength
+		return x.State
 	}
-	return 0
+	return v14.CircuitBreakerState(0)
 }
 
-type DescribeTaskQueuePartitionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// contains k-v pairs of the type: buildID -> TaskQueueVersionInfoInternal
-	VersionsInfoInternal map[string]*v113.TaskQueueVersionInfoInternal `protobuf:"bytes,1,rep,name=versions_info_internal,json=versionsInfoInternal,proto3" json:"versions_info_internal,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ListNexusEndpointsResponse_Endpoint struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entry         *v12.NexusEndpointEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Health        *NexusEndpointHealth    `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DescribeTaskQueuePartitionResponse) Reset() {
-	*x = DescribeTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *ListNexusEndpointsResponse_Endpoint) Reset() {
+	*x = ListNexusEndpointsResponse_Endpoint{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeTaskQueuePartitionResponse) String() string {
+func (x *ListNexusEndpointsResponse_Endpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeTaskQueuePartitionResponse) ProtoMessage() {}
+func (*ListNexusEndpointsResponse_Endpoint) ProtoMessage() {}
 
-func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[86]
+func (x *ListNexusEndpointsResponse_Endpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5157,41 +9942,48 @@ func (x *DescribeTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*DescribeTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use ListNexusEndpointsResponse_Endpoint.ProtoReflect.Descriptor instead.
+func (*ListNexusEndpointsResponse_Endpoint) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{124, 0}
 }
 
-func (x *DescribeTaskQueuePartitionResponse) GetVersionsInfoInternal() map[string]*v113.TaskQueueVersionInfoInternal {
+func (x *ListNexusEndpointsResponse_Endpoint) GetEntry() *v12.NexusEndpointEntry {
 	if x != nil {
-		return x.VersionsInfoInternal
+		return x.Entry
 	}
 	return nil
 }
 
-type ForceUnloadTaskQueuePartitionRequest struct {
-	state              protoimpl.MessageState   `protogen:"open.v1"`
-	Namespace          string                   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	TaskQueuePartition *v113.TaskQueuePartition `protobuf:"bytes,2,opt,name=task_queue_partition,json=taskQueuePartition,proto3" json:"task_queue_partition,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ListNexusEndpointsResponse_Endpoint) GetHealth() *NexusEndpointHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) Reset() {
-	*x = ForceUnloadTaskQueuePartitionRequest{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+type AggregateWorkflowExecutionsResponse_Percentile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percentile    float64                `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AggregateWorkflowExecutionsResponse_Percentile) Reset() {
+	*x = AggregateWorkflowExecutionsResponse_Percentile{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) String() string {
+func (x *AggregateWorkflowExecutionsResponse_Percentile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceUnloadTaskQueuePartitionRequest) ProtoMessage() {}
+func (*AggregateWorkflowExecutionsResponse_Percentile) ProtoMessage() {}
 
-func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[87]
+func (x *AggregateWorkflowExecutionsResponse_Percentile) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5202,47 +9994,50 @@ func (x *ForceUnloadTaskQueuePartitionRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionRequest.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use AggregateWorkflowExecutionsResponse_Percentile.ProtoReflect.Descriptor instead.
+func (*AggregateWorkflowExecutionsResponse_Percentile) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{130, 0}
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetNamespace() string {
+func (x *AggregateWorkflowExecutionsResponse_Percentile) GetPercentile() float64 {
 	if x != nil {
-		return x.Namespace
+		return x.Percentile
 	}
-	return ""
+	return 0
 }
 
-func (x *ForceUnloadTaskQueuePartitionRequest) GetTaskQueuePartition() *v113.TaskQueuePartition {
+func (x *AggregateWorkflowExecutionsResponse_Percentile) GetValue() float64 {
 	if x != nil {
-		return x.TaskQueuePartition
+		return x.Value
 	}
-	return nil
+	return 0
 }
 
-type ForceUnloadTaskQueuePartitionResponse struct {
+type DescribeVisibilityReindexResponse_Mismatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WasLoaded     bool                   `protobuf:"varint,1,opt,name=was_loaded,json=wasLoaded,proto3" json:"was_loaded,omitempty"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) Reset() {
-	*x = ForceUnloadTaskQueuePartitionResponse{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *DescribeVisibilityReindexResponse_Mismatch) Reset() {
+	*x = DescribeVisibilityReindexResponse_Mismatch{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) String() string {
+func (x *DescribeVisibilityReindexResponse_Mismatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForceUnloadTaskQueuePartitionResponse) ProtoMessage() {}
+func (*DescribeVisibilityReindexResponse_Mismatch) ProtoMessage() {}
 
-func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[88]
+func (x *DescribeVisibilityReindexResponse_Mismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5253,41 +10048,64 @@ func (x *ForceUnloadTaskQueuePartitionResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForceUnloadTaskQueuePartitionResponse.ProtoReflect.Descriptor instead.
-func (*ForceUnloadTaskQueuePartitionResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use DescribeVisibilityReindexResponse_Mismatch.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityReindexResponse_Mismatch) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{134, 0}
 }
 
-func (x *ForceUnloadTaskQueuePartitionResponse) GetWasLoaded() bool {
+func (x *DescribeVisibilityReindexResponse_Mismatch) GetNamespaceId() string {
 	if x != nil {
-		return x.WasLoaded
+		return x.NamespaceId
 	}
-	return false
+	return ""
 }
 
-type AddTasksRequest_Task struct {
+func (x *DescribeVisibilityReindexResponse_Mismatch) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityReindexResponse_Mismatch) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityReindexResponse_Mismatch) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DescribeVisibilityReindexResponse_UnrecoverableExecution struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    int32                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Blob          *v1.DataBlob           `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	NamespaceId   string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddTasksRequest_Task) Reset() {
-	*x = AddTasksRequest_Task{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) Reset() {
+	*x = DescribeVisibilityReindexResponse_UnrecoverableExecution{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddTasksRequest_Task) String() string {
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTasksRequest_Task) ProtoMessage() {}
+func (*DescribeVisibilityReindexResponse_UnrecoverableExecution) ProtoMessage() {}
 
-func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[96]
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5298,48 +10116,64 @@ func (x *AddTasksRequest_Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTasksRequest_Task.ProtoReflect.Descriptor instead.
-func (*AddTasksRequest_Task) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{74, 0}
+// Deprecated: Use DescribeVisibilityReindexResponse_UnrecoverableExecution.ProtoReflect.Descriptor instead.
+func (*DescribeVisibilityReindexResponse_UnrecoverableExecution) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{134, 1}
 }
 
-func (x *AddTasksRequest_Task) GetCategoryId() int32 {
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) GetNamespaceId() string {
 	if x != nil {
-		return x.CategoryId
+		return x.NamespaceId
 	}
-	return 0
+	return ""
 }
 
-func (x *AddTasksRequest_Task) GetBlob() *v1.DataBlob {
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) GetWorkflowId() string {
 	if x != nil {
-		return x.Blob
+		return x.WorkflowId
 	}
-	return nil
+	return ""
 }
 
-type ListQueuesResponse_QueueInfo struct {
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *DescribeVisibilityReindexResponse_UnrecoverableExecution) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DescribeSearchAttributeMigrationResponse_InvalidValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueName     string                 `protobuf:"bytes,1,opt,name=queue_name,json=queueName,proto3" json:"queue_name,omitempty"`
-	MessageCount  int64                  `protobuf:"varint,2,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse_QueueInfo) Reset() {
-	*x = ListQueuesResponse_QueueInfo{}
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) Reset() {
+	*x = DescribeSearchAttributeMigrationResponse_InvalidValue{}
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse_QueueInfo) String() string {
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse_QueueInfo) ProtoMessage() {}
+func (*DescribeSearchAttributeMigrationResponse_InvalidValue) ProtoMessage() {}
 
-func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[97]
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5350,30 +10184,44 @@ func (x *ListQueuesResponse_QueueInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse_QueueInfo.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse_QueueInfo) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{77, 0}
+// Deprecated: Use DescribeSearchAttributeMigrationResponse_InvalidValue.ProtoReflect.Descriptor instead.
+func (*DescribeSearchAttributeMigrationResponse_InvalidValue) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP(), []int{142, 0}
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetQueueName() string {
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) GetWorkflowId() string {
 	if x != nil {
-		return x.QueueName
+		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *ListQueuesResponse_QueueInfo) GetMessageCount() int64 {
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) GetRunId() string {
 	if x != nil {
-		return x.MessageCount
+		return x.RunId
 	}
-	return 0
+	return ""
+}
+
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DescribeSearchAttributeMigrationResponse_InvalidValue) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
 var File_temporal_server_api_adminservice_v1_request_response_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc = "" +
 	"\n" +
-	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
+	":temporal/server/api/adminservice/v1/request_response.proto\x12#temporal.server.api.adminservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a$temporal/api/common/v1/message.proto\x1a%temporal/api/version/v1/message.proto\x1a&temporal/api/workflow/v1/message.proto\x1a'temporal/api/namespace/v1/message.proto\x1a)temporal/api/replication/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a,temporal/server/api/cluster/v1/message.proto\x1a'temporal/server/api/common/v1/dlq.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a*temporal/server/api/enums/v1/cluster.proto\x1a'temporal/server/api/enums/v1/task.proto\x1a&temporal/server/api/enums/v1/dlq.proto\x1a,temporal/server/api/history/v1/message.proto\x1a.temporal/server/api/namespace/v1/message.proto\x1a0temporal/server/api/replication/v1/message.proto\x1a-temporal/server/api/schedule/v1/message.proto\x1a9temporal/server/api/persistence/v1/cluster_metadata.proto\x1a?temporal/server/api/persistence/v1/saved_visibility_query.proto\x1a3temporal/server/api/persistence/v1/executions.proto\x1a?temporal/server/api/persistence/v1/workflow_mutable_state.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\x1a4temporal/server/api/persistence/v1/task_queues.proto\x1a,temporal/server/api/persistence/v1/hsm.proto\x1a3temporal/server/api/persistence/v1/namespaces.proto\x1a.temporal/server/api/persistence/v1/nexus.proto\x1a.temporal/server/api/taskqueue/v1/message.proto\"\x83\x01\n" +
 	"\x1aRebuildMutableStateRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"\x1d\n" +
@@ -5621,7 +10469,7 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"endEventId\x12\x1f\n" +
 	"\vend_version\x18\b \x01(\x03R\n" +
 	"endVersion\" \n" +
-	"\x1eResendReplicationTasksResponse\"\xe3\x02\n" +
+	"\x1eResendReplicationTasksResponse\"\xb5\x03\n" +
 	"\x18GetTaskQueueTasksRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
 	"\n" +
@@ -5633,10 +10481,71 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\n" +
 	"batch_size\x18\x06 \x01(\x05R\tbatchSize\x12&\n" +
 	"\x0fnext_page_token\x18\a \x01(\fR\rnextPageToken\x12\x1a\n" +
-	"\bsubqueue\x18\b \x01(\x05R\bsubqueue\"\x90\x01\n" +
+	"\bsubqueue\x18\b \x01(\x05R\bsubqueue\x12P\n" +
+	"\x06filter\x18\n" +
+	" \x01(\v28.temporal.server.api.adminservice.v1.TaskQueueTaskFilterR\x06filter\"\x90\x01\n" +
 	"\x19GetTaskQueueTasksResponse\x12K\n" +
 	"\x05tasks\x18\x01 \x03(\v25.temporal.server.api.persistence.v1.AllocatedTaskInfoR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\x87\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\xdd\x01\n" +
+	"\x13TaskQueueTaskFilter\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12#\n" +
+	"\rworkflow_type\x18\x03 \x01(\tR\fworkflowType\x12#\n" +
+	"\ractivity_type\x18\x04 \x01(\tR\factivityType\x12!\n" +
+	"\fpriority_key\x18\x05 \x01(\x05R\vpriorityKey\x12!\n" +
+	"\ffairness_key\x18\x06 \x01(\tR\vfairnessKey\"\xa2\x04\n" +
+	"\x1bDeleteTaskQueueTasksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x1a\n" +
+	"\bsubqueue\x18\x04 \x01(\x05R\bsubqueue\x12\x19\n" +
+	"\bmin_pass\x18\x05 \x01(\x03R\aminPass\x12\x1e\n" +
+	"\vmin_task_id\x18\x06 \x01(\x03R\tminTaskId\x12\x1e\n" +
+	"\vmax_task_id\x18\a \x01(\x03R\tmaxTaskId\x12P\n" +
+	"\x06filter\x18\b \x01(\v28.temporal.server.api.adminservice.v1.TaskQueueTaskFilterR\x06filter\x12\x19\n" +
+	"\btask_ids\x18\t \x03(\x03R\ataskIds\x124\n" +
+	"\x16destination_task_queue\x18\n" +
+	" \x01(\tR\x14destinationTaskQueue\x12\x17\n" +
+	"\adry_run\x18\v \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\f \x01(\x05R\tbatchSize\x12&\n" +
+	"\x0fnext_page_token\x18\r \x01(\fR\rnextPageToken\"\x93\x01\n" +
+	"\x1cDeleteTaskQueueTasksResponse\x12K\n" +
+	"\x05tasks\x18\x01 \x03(\v25.temporal.server.api.persistence.v1.AllocatedTaskInfoR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\x88\x02\n" +
+	"%StartTaskQueueBacklogMigrationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12*\n" +
+	"\x11source_task_queue\x18\x02 \x01(\tR\x0fsourceTaskQueue\x124\n" +
+	"\x16destination_task_queue\x18\x03 \x01(\tR\x14destinationTaskQueue\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x04 \x01(\x05R\tbatchSize\x12(\n" +
+	"\x10tasks_per_second\x18\x05 \x01(\x02R\x0etasksPerSecond\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"`\n" +
+	"&StartTaskQueueBacklogMigrationResponse\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"t\n" +
+	"(DescribeTaskQueueBacklogMigrationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12*\n" +
+	"\x11source_task_queue\x18\x02 \x01(\tR\x0fsourceTaskQueue\"\x98\x03\n" +
+	")DescribeTaskQueueBacklogMigrationResponse\x124\n" +
+	"\x16destination_task_queue\x18\x01 \x01(\tR\x14destinationTaskQueue\x12F\n" +
+	"\x06status\x18\x02 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x12%\n" +
+	"\x0etasks_migrated\x18\x03 \x01(\x03R\rtasksMigrated\x12%\n" +
+	"\x0ebacklogs_total\x18\x04 \x01(\x05R\rbacklogsTotal\x12)\n" +
+	"\x10backlogs_drained\x18\x05 \x01(\x05R\x0fbacklogsDrained\x129\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\n" +
+	"close_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\"\x8a\x01\n" +
+	"&CancelTaskQueueBacklogMigrationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12*\n" +
+	"\x11source_task_queue\x18\x02 \x01(\tR\x0fsourceTaskQueue\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"E\n" +
+	"'CancelTaskQueueBacklogMigrationResponse\x12\x1a\n" +
+	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"\x87\x01\n" +
 	"\x1eDeleteWorkflowExecutionRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
 	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\"=\n" +
@@ -5761,7 +10670,334 @@ const file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc =
 	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\"F\n" +
 	"%ForceUnloadTaskQueuePartitionResponse\x12\x1d\n" +
 	"\n" +
-	"was_loaded\x18\x01 \x01(\bR\twasLoadedB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
+	"was_loaded\x18\x01 \x01(\bR\twasLoaded\"\x97\x04\n" +
+	"%UpdateTaskQueueFairnessWeightsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x81\x01\n" +
+	"\rset_overrides\x18\x04 \x03(\v2\\.temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest.SetOverridesEntryR\fsetOverrides\x12'\n" +
+	"\x0funset_overrides\x18\x05 \x03(\tR\x0eunsetOverrides\x122\n" +
+	"\x15update_default_weight\x18\x06 \x01(\bR\x13updateDefaultWeight\x12%\n" +
+	"\x0edefault_weight\x18\a \x01(\x02R\rdefaultWeight\x12\x1a\n" +
+	"\bidentity\x18\b \x01(\tR\bidentity\x1a?\n" +
+	"\x11SetOverridesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"\x88\x01\n" +
+	"&UpdateTaskQueueFairnessWeightsResponse\x12^\n" +
+	"\x10fairness_weights\x18\x01 \x01(\v23.temporal.server.api.persistence.v1.FairnessWeightsR\x0ffairnessWeights\"\xc1\x01\n" +
+	"$DescribeTaskQueueFairnessKeysRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12f\n" +
+	"\x14task_queue_partition\x18\x02 \x01(\v24.temporal.server.api.taskqueue.v1.TaskQueuePartitionR\x12taskQueuePartition\x12\x13\n" +
+	"\x05top_n\x18\x03 \x01(\x05R\x04topN\"\xc6\x02\n" +
+	"%DescribeTaskQueueFairnessKeysResponse\x12X\n" +
+	"\x0etop_by_backlog\x18\x01 \x03(\v22.temporal.server.api.taskqueue.v1.FairnessKeyStatsR\ftopByBacklog\x12c\n" +
+	"\x14top_by_dispatch_rate\x18\x02 \x03(\v22.temporal.server.api.taskqueue.v1.FairnessKeyStatsR\x11topByDispatchRate\x12^\n" +
+	"\x10fairness_weights\x18\x03 \x01(\v23.temporal.server.api.persistence.v1.FairnessWeightsR\x0ffairnessWeights\"\x94\x03\n" +
+	"\x1bUpdateTaskQueuePauseRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12D\n" +
+	"\x04mode\x18\x04 \x01(\x0e20.temporal.server.api.enums.v1.TaskQueuePauseModeR\x04mode\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1a\n" +
+	"\bidentity\x18\b \x01(\tR\bidentity\"h\n" +
+	"\x1cUpdateTaskQueuePauseResponse\x12H\n" +
+	"\x05pause\x18\x01 \x01(\v22.temporal.server.api.persistence.v1.TaskQueuePauseR\x05pause\"\x81\x03\n" +
+	"$UpdateTaskQueueBlockedPollersRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x12L\n" +
+	"\x0ftask_queue_type\x18\x03 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12)\n" +
+	"\x10block_identities\x18\x04 \x03(\tR\x0fblockIdentities\x12@\n" +
+	"\x0eblock_duration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\rblockDuration\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12-\n" +
+	"\x12unblock_identities\x18\a \x03(\tR\x11unblockIdentities\x12\x1a\n" +
+	"\bidentity\x18\b \x01(\tR\bidentity\"\x83\x01\n" +
+	"%UpdateTaskQueueBlockedPollersResponse\x12Z\n" +
+	"\x0fblocked_pollers\x18\x01 \x03(\v21.temporal.server.api.persistence.v1.BlockedPollerR\x0eblockedPollers\"I\n" +
+	"\x13CountWorkersRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"\xdd\x01\n" +
+	"\x14CountWorkersResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12b\n" +
+	"\x06groups\x18\x02 \x03(\v2J.temporal.server.api.adminservice.v1.CountWorkersResponse.AggregationGroupR\x06groups\x1aK\n" +
+	"\x10AggregationGroup\x12!\n" +
+	"\fgroup_values\x18\x01 \x03(\tR\vgroupValues\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"\xbd\x03\n" +
+	"\x16PreviewScheduleRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12:\n" +
+	"\x04spec\x18\x03 \x01(\v2&.temporal.api.schedule.v1.ScheduleSpecR\x04spec\x12F\n" +
+	"\bpolicies\x18\x04 \x01(\v2*.temporal.api.schedule.v1.SchedulePoliciesR\bpolicies\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12<\n" +
+	"\frun_duration\x18\a \x01(\v2\x19.google.protobuf.DurationR\vrunDuration\x120\n" +
+	"\x14maximum_action_count\x18\b \x01(\x05R\x12maximumActionCount\"\xad\x04\n" +
+	"\x17PreviewScheduleResponse\x12M\n" +
+	"\x0ecanonical_spec\x18\x01 \x01(\v2&.temporal.api.schedule.v1.ScheduleSpecR\rcanonicalSpec\x12]\n" +
+	"\aactions\x18\x02 \x03(\v2C.temporal.server.api.adminservice.v1.PreviewScheduleResponse.ActionR\aactions\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\x1a\xc5\x02\n" +
+	"\x06Action\x12=\n" +
+	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12;\n" +
+	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"actualTime\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12'\n" +
+	"\x0foverlap_skipped\x18\x04 \x01(\bR\x0eoverlapSkipped\x12'\n" +
+	"\x0fcancels_running\x18\x05 \x01(\bR\x0ecancelsRunning\x122\n" +
+	"\x15missed_catchup_window\x18\x06 \x01(\bR\x13missedCatchupWindow\"\xa0\x01\n" +
+	"\x1aListScheduleActionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\tR\n" +
+	"scheduleId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\fR\rnextPageToken\"\xde\x04\n" +
+	"\x1bListScheduleActionsResponse\x12w\n" +
+	"\x0fstarted_actions\x18\x01 \x03(\v2N.temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedActionR\x0estartedActions\x12W\n" +
+	"\x0fskipped_actions\x18\x02 \x03(\v2..temporal.server.api.schedule.v1.SkippedActionR\x0eskippedActions\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\x1a\xc4\x02\n" +
+	"\rStartedAction\x12=\n" +
+	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12F\n" +
+	"\x06status\x18\x04 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\n" +
+	"close_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\"\x84\x01\n" +
+	" UpdateScheduleCalendarSetRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04days\x18\x03 \x03(\tR\x04days\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\"#\n" +
+	"!UpdateScheduleCalendarSetResponse\"?\n" +
+	"\x1fListScheduleCalendarSetsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x9a\x02\n" +
+	" ListScheduleCalendarSetsResponse\x12|\n" +
+	"\rcalendar_sets\x18\x01 \x03(\v2W.temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse.CalendarSetsEntryR\fcalendarSets\x1ax\n" +
+	"\x11CalendarSetsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12M\n" +
+	"\x05value\x18\x02 \x01(\v27.temporal.server.api.persistence.v1.ScheduleCalendarSetR\x05value:\x028\x01\"\xd4\x01\n" +
+	"\x14ListCallbacksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12C\n" +
+	"\x06states\x18\x03 \x03(\x0e2+.temporal.server.api.enums.v1.CallbackStateR\x06states\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\fR\rnextPageToken\"\xdf\x02\n" +
+	"\x15ListCallbacksResponse\x12a\n" +
+	"\tcallbacks\x18\x01 \x03(\v2C.temporal.server.api.adminservice.v1.ListCallbacksResponse.CallbackR\tcallbacks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\x1a\xba\x01\n" +
+	"\bCallback\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x1f\n" +
+	"\vcallback_id\x18\x02 \x01(\tR\n" +
+	"callbackId\x12D\n" +
+	"\x04info\x18\x03 \x01(\v20.temporal.server.api.persistence.v1.CallbackInfoR\x04info\"\x9e\x01\n" +
+	"\x14RetryCallbackRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12G\n" +
+	"\texecution\x18\x02 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x1f\n" +
+	"\vcallback_id\x18\x03 \x01(\tR\n" +
+	"callbackId\"\x17\n" +
+	"\x15RetryCallbackResponse\"\xb0\x05\n" +
+	"\x13NexusEndpointHealth\x12#\n" +
+	"\rsuccess_count\x18\x01 \x01(\x03R\fsuccessCount\x12#\n" +
+	"\rfailure_count\x18\x02 \x01(\x03R\ffailureCount\x12!\n" +
+	"\fsuccess_rate\x18\x03 \x01(\x01R\vsuccessRate\x12:\n" +
+	"\vlatency_p50\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP50\x12:\n" +
+	"\vlatency_p90\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP90\x12:\n" +
+	"\vlatency_p99\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"latencyP99\x12e\n" +
+	"\x15circuit_breaker_state\x18\a \x01(\x0e21.temporal.server.api.enums.v1.CircuitBreakerStateR\x13circuitBreakerState\x12\\\n" +
+	"\bcircuits\x18\b \x03(\v2@.temporal.server.api.adminservice.v1.NexusEndpointHealth.CircuitR\bcircuits\x1a\xb2\x01\n" +
+	"\aCircuit\x12!\n" +
+	"\fhost_address\x18\x01 \x01(\tR\vhostAddress\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1d\n" +
+	"\n" +
+	"task_group\x18\x03 \x01(\tR\ttaskGroup\x12G\n" +
+	"\x05state\x18\x04 \x01(\x0e21.temporal.server.api.enums.v1.CircuitBreakerStateR\x05state\"5\n" +
+	"\x17GetNexusEndpointRequest\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\"\xdd\x01\n" +
+	"\x18GetNexusEndpointResponse\x12L\n" +
+	"\x05entry\x18\x01 \x01(\v26.temporal.server.api.persistence.v1.NexusEndpointEntryR\x05entry\x12P\n" +
+	"\x06health\x18\x02 \x01(\v28.temporal.server.api.adminservice.v1.NexusEndpointHealthR\x06health\x12!\n" +
+	"\ffailed_hosts\x18\x03 \x03(\tR\vfailedHosts\"`\n" +
+	"\x19ListNexusEndpointsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\"\xfc\x02\n" +
+	"\x1aListNexusEndpointsResponse\x12f\n" +
+	"\tendpoints\x18\x01 \x03(\v2H.temporal.server.api.adminservice.v1.ListNexusEndpointsResponse.EndpointR\tendpoints\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\fR\rnextPageToken\x12!\n" +
+	"\ffailed_hosts\x18\x03 \x03(\tR\vfailedHosts\x1a\xaa\x01\n" +
+	"\bEndpoint\x12L\n" +
+	"\x05entry\x18\x01 \x01(\v26.temporal.server.api.persistence.v1.NexusEndpointEntryR\x05entry\x12P\n" +
+	"\x06health\x18\x02 \x01(\v28.temporal.server.api.adminservice.v1.NexusEndpointHealthR\x06health\"\xcc\x01\n" +
+	"%SetNexusEndpointCircuitBreakerRequest\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12P\n" +
+	"\boverride\x18\x02 \x01(\x0e24.temporal.server.api.enums.v1.CircuitBreakerOverrideR\boverride\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\"B\n" +
+	"&SetNexusEndpointCircuitBreakerResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"\xb3\x01\n" +
+	"#SetNexusEndpointAccessPolicyRequest\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12:\n" +
+	"\x19allowed_caller_namespaces\x18\x02 \x03(\tR\x17allowedCallerNamespaces\x124\n" +
+	"\x16allowed_workflow_types\x18\x03 \x03(\tR\x14allowedWorkflowTypes\"@\n" +
+	"$SetNexusEndpointAccessPolicyResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"\xa5\x01\n" +
+	"\"AggregateWorkflowExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12)\n" +
+	"\x10search_attribute\x18\x03 \x01(\tR\x0fsearchAttribute\x12 \n" +
+	"\vpercentiles\x18\x04 \x03(\x01R\vpercentiles\"\xbe\x02\n" +
+	"#AggregateWorkflowExecutionsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x10\n" +
+	"\x03sum\x18\x02 \x01(\x01R\x03sum\x12\x10\n" +
+	"\x03avg\x18\x03 \x01(\x01R\x03avg\x12\x10\n" +
+	"\x03min\x18\x04 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x05 \x01(\x01R\x03max\x12u\n" +
+	"\vpercentiles\x18\x06 \x03(\v2S.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.PercentileR\vpercentiles\x1aB\n" +
+	"\n" +
+	"Percentile\x12\x1e\n" +
+	"\n" +
+	"percentile\x18\x01 \x01(\x01R\n" +
+	"percentile\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\x84\x02\n" +
+	"\x1dStartVisibilityReindexRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\ftarget_store\x18\x02 \x01(\tR\vtargetStore\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x122\n" +
+	"\x15executions_per_second\x18\x04 \x01(\x02R\x13executionsPerSecond\x12\x16\n" +
+	"\x06verify\x18\x05 \x01(\bR\x06verify\x12!\n" +
+	"\fskip_reindex\x18\x06 \x01(\bR\vskipReindex\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"X\n" +
+	"\x1eStartVisibilityReindexResponse\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\"\"\n" +
+	" DescribeVisibilityReindexRequest\"\x83\t\n" +
+	"!DescribeVisibilityReindexResponse\x12F\n" +
+	"\x06status\x18\x01 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x12!\n" +
+	"\ftarget_store\x18\x02 \x01(\tR\vtargetStore\x12\x1d\n" +
+	"\n" +
+	"num_shards\x18\x03 \x01(\x05R\tnumShards\x12)\n" +
+	"\x10shards_reindexed\x18\x04 \x01(\x05R\x0fshardsReindexed\x121\n" +
+	"\x14executions_reindexed\x18\x05 \x01(\x03R\x13executionsReindexed\x12-\n" +
+	"\x12executions_skipped\x18\x06 \x01(\x03R\x11executionsSkipped\x12'\n" +
+	"\x0fshards_verified\x18\a \x01(\x05R\x0eshardsVerified\x12/\n" +
+	"\x13executions_verified\x18\b \x01(\x03R\x12executionsVerified\x12\x1e\n" +
+	"\n" +
+	"mismatches\x18\t \x01(\x03R\n" +
+	"mismatches\x12z\n" +
+	"\x10mismatch_samples\x18\n" +
+	" \x03(\v2O.temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.MismatchR\x0fmismatchSamples\x129\n" +
+	"\n" +
+	"start_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\n" +
+	"close_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x129\n" +
+	"\x18executions_unrecoverable\x18\r \x01(\x03R\x17executionsUnrecoverable\x12\x92\x01\n" +
+	"\x15unrecoverable_samples\x18\x0e \x03(\v2].temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.UnrecoverableExecutionR\x14unrecoverableSamples\x1a}\n" +
+	"\bMismatch\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x1a\x8b\x01\n" +
+	"\x16UnrecoverableExecution\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"8\n" +
+	"\x1eCancelVisibilityReindexRequest\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"=\n" +
+	"\x1fCancelVisibilityReindexResponse\x12\x1a\n" +
+	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"k\n" +
+	"\x1cRenameSearchAttributeRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\bnew_name\x18\x03 \x01(\tR\anewName\"\x1f\n" +
+	"\x1dRenameSearchAttributeResponse\"\xb5\x02\n" +
+	"$StartSearchAttributeMigrationRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12B\n" +
+	"\bnew_type\x18\x03 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\anewType\x12\x19\n" +
+	"\bnew_name\x18\x04 \x01(\tR\anewName\x12.\n" +
+	"\x13skip_invalid_values\x18\x05 \x01(\bR\x11skipInvalidValues\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\"_\n" +
+	"%StartSearchAttributeMigrationResponse\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\")\n" +
+	"'DescribeSearchAttributeMigrationRequest\"\xdc\x06\n" +
+	"(DescribeSearchAttributeMigrationResponse\x12F\n" +
+	"\x06status\x18\x01 \x01(\x0e2..temporal.api.enums.v1.WorkflowExecutionStatusR\x06status\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bnew_name\x18\x04 \x01(\tR\anewName\x12;\n" +
+	"\x04type\x18\x05 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x04type\x12B\n" +
+	"\bnew_type\x18\x06 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\anewType\x12\x14\n" +
+	"\x05phase\x18\a \x01(\tR\x05phase\x121\n" +
+	"\x14executions_validated\x18\b \x01(\x03R\x13executionsValidated\x12/\n" +
+	"\x13executions_migrated\x18\t \x01(\x03R\x12executionsMigrated\x12%\n" +
+	"\x0einvalid_values\x18\n" +
+	" \x01(\x03R\rinvalidValues\x12\x8e\x01\n" +
+	"\x15invalid_value_samples\x18\v \x03(\v2Z.temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.InvalidValueR\x13invalidValueSamples\x129\n" +
+	"\n" +
+	"start_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x129\n" +
+	"\n" +
+	"close_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcloseTime\x1ar\n" +
+	"\fInvalidValue\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x8e\x01\n" +
+	"\x1ePutSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12N\n" +
+	"\x05query\x18\x02 \x01(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\x05query\"!\n" +
+	"\x1fPutSavedVisibilityQueryResponse\"U\n" +
+	"!DeleteSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"$\n" +
+	"\"DeleteSavedVisibilityQueryResponse\"A\n" +
+	"!ListSavedVisibilityQueriesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"x\n" +
+	"\"ListSavedVisibilityQueriesResponse\x12R\n" +
+	"\aqueries\x18\x01 \x03(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\aqueries\"\xb6\x01\n" +
+	"\x1eRunSavedVisibilityQueryRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"count_only\x18\x03 \x01(\bR\tcountOnly\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\fR\rnextPageToken\"\xb0\x01\n" +
+	"\x1fRunSavedVisibilityQueryResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12O\n" +
+	"\n" +
+	"executions\x18\x02 \x03(\v2/.temporal.api.workflow.v1.WorkflowExecutionInfoR\n" +
+	"executions\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\fR\rnextPageToken\"s\n" +
+	" ExplainWorkflowExecutionsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xb0\x01\n" +
+	"!ExplainWorkflowExecutionsResponse\x12\x14\n" +
+	"\x05store\x18\x01 \x01(\tR\x05store\x12\x1f\n" +
+	"\vstore_query\x18\x02 \x01(\tR\n" +
+	"storeQuery\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x03R\x04cost\x12!\n" +
+	"\fcost_reasons\x18\x04 \x03(\tR\vcostReasons\x12\x1d\n" +
+	"\n" +
+	"cost_limit\x18\x05 \x01(\x03R\tcostLimitB8Z6go.temporal.io/server/api/adminservice/v1;adminserviceb\x06proto3"
 
 var (
 	file_temporal_server_api_adminservice_v1_request_response_proto_rawDescOnce sync.Once
@@ -5775,7 +11011,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_rawDescGZIP
 	return file_temporal_server_api_adminservice_v1_request_response_proto_rawDescData
 }
 
-var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
+var file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes = make([]protoimpl.MessageInfo, 175)
 var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []any{
 	(*RebuildMutableStateRequest)(nil),                  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest
 	(*RebuildMutableStateResponse)(nil),                 // 1: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
@@ -5834,186 +11070,353 @@ var file_temporal_server_api_adminservice_v1_request_response_proto_goTypes = []
 	(*ResendReplicationTasksResponse)(nil),              // 54: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
 	(*GetTaskQueueTasksRequest)(nil),                    // 55: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
 	(*GetTaskQueueTasksResponse)(nil),                   // 56: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionRequest)(nil),              // 57: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*DeleteWorkflowExecutionResponse)(nil),             // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 59: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceRequest)(nil),                         // 61: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetNamespaceResponse)(nil),                        // 62: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksRequest)(nil),                          // 63: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*GetDLQTasksResponse)(nil),                         // 64: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksRequest)(nil),                        // 65: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*PurgeDLQTasksResponse)(nil),                       // 66: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*DLQJobToken)(nil),                                 // 67: temporal.server.api.adminservice.v1.DLQJobToken
-	(*MergeDLQTasksRequest)(nil),                        // 68: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*MergeDLQTasksResponse)(nil),                       // 69: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobRequest)(nil),                       // 70: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*DescribeDLQJobResponse)(nil),                      // 71: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobRequest)(nil),                         // 72: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*CancelDLQJobResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksRequest)(nil),                             // 74: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*AddTasksResponse)(nil),                            // 75: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesRequest)(nil),                           // 76: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*ListQueuesResponse)(nil),                          // 77: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckRequest)(nil),                      // 78: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*DeepHealthCheckResponse)(nil),                     // 79: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateRequest)(nil),                    // 80: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*SyncWorkflowStateResponse)(nil),                   // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 82: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*InternalTaskQueueStatus)(nil),                     // 85: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 86: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 87: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	nil,                                       // 89: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	nil,                                       // 90: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	nil,                                       // 91: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	nil,                                       // 92: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	nil,                                       // 93: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	nil,                                       // 94: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	nil,                                       // 95: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	(*AddTasksRequest_Task)(nil),              // 96: temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	(*ListQueuesResponse_QueueInfo)(nil),      // 97: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	nil,                                       // 98: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	(*v1.WorkflowExecution)(nil),              // 99: temporal.api.common.v1.WorkflowExecution
-	(*v1.DataBlob)(nil),                       // 100: temporal.api.common.v1.DataBlob
-	(*v11.VersionHistory)(nil),                // 101: temporal.server.api.history.v1.VersionHistory
-	(*v12.WorkflowMutableState)(nil),          // 102: temporal.server.api.persistence.v1.WorkflowMutableState
-	(*v13.NamespaceCacheInfo)(nil),            // 103: temporal.server.api.namespace.v1.NamespaceCacheInfo
-	(*v12.ShardInfo)(nil),                     // 104: temporal.server.api.persistence.v1.ShardInfo
-	(*v11.TaskRange)(nil),                     // 105: temporal.server.api.history.v1.TaskRange
-	(v14.TaskType)(0),                         // 106: temporal.server.api.enums.v1.TaskType
-	(*timestamppb.Timestamp)(nil),             // 107: google.protobuf.Timestamp
-	(*v15.ReplicationToken)(nil),              // 108: temporal.server.api.replication.v1.ReplicationToken
-	(*v15.ReplicationMessages)(nil),           // 109: temporal.server.api.replication.v1.ReplicationMessages
-	(*v15.ReplicationTaskInfo)(nil),           // 110: temporal.server.api.replication.v1.ReplicationTaskInfo
-	(*v15.ReplicationTask)(nil),               // 111: temporal.server.api.replication.v1.ReplicationTask
-	(*v17.WorkflowExecutionInfo)(nil),         // 112: temporal.api.workflow.v1.WorkflowExecutionInfo
-	(*v18.MembershipInfo)(nil),                // 113: temporal.server.api.cluster.v1.MembershipInfo
-	(*v19.VersionInfo)(nil),                   // 114: temporal.api.version.v1.VersionInfo
-	(*v12.ClusterMetadata)(nil),               // 115: temporal.server.api.persistence.v1.ClusterMetadata
-	(*durationpb.Duration)(nil),               // 116: google.protobuf.Duration
-	(v14.ClusterMemberRole)(0),                // 117: temporal.server.api.enums.v1.ClusterMemberRole
-	(*v18.ClusterMember)(nil),                 // 118: temporal.server.api.cluster.v1.ClusterMember
-	(v14.DeadLetterQueueType)(0),              // 119: temporal.server.api.enums.v1.DeadLetterQueueType
-	(v16.TaskQueueType)(0),                    // 120: temporal.api.enums.v1.TaskQueueType
-	(*v12.AllocatedTaskInfo)(nil),             // 121: temporal.server.api.persistence.v1.AllocatedTaskInfo
-	(*v15.SyncReplicationState)(nil),          // 122: temporal.server.api.replication.v1.SyncReplicationState
-	(*v15.WorkflowReplicationMessages)(nil),   // 123: temporal.server.api.replication.v1.WorkflowReplicationMessages
-	(*v110.NamespaceInfo)(nil),                // 124: temporal.api.namespace.v1.NamespaceInfo
-	(*v110.NamespaceConfig)(nil),              // 125: temporal.api.namespace.v1.NamespaceConfig
-	(*v111.NamespaceReplicationConfig)(nil),   // 126: temporal.api.replication.v1.NamespaceReplicationConfig
-	(*v111.FailoverStatus)(nil),               // 127: temporal.api.replication.v1.FailoverStatus
-	(*v112.HistoryDLQKey)(nil),                // 128: temporal.server.api.common.v1.HistoryDLQKey
-	(*v112.HistoryDLQTask)(nil),               // 129: temporal.server.api.common.v1.HistoryDLQTask
-	(*v112.HistoryDLQTaskMetadata)(nil),       // 130: temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	(v14.DLQOperationType)(0),                 // 131: temporal.server.api.enums.v1.DLQOperationType
-	(v14.DLQOperationState)(0),                // 132: temporal.server.api.enums.v1.DLQOperationState
-	(v14.HealthState)(0),                      // 133: temporal.server.api.enums.v1.HealthState
-	(*v12.VersionedTransition)(nil),           // 134: temporal.server.api.persistence.v1.VersionedTransition
-	(*v11.VersionHistories)(nil),              // 135: temporal.server.api.history.v1.VersionHistories
-	(*v15.VersionedTransitionArtifact)(nil),   // 136: temporal.server.api.replication.v1.VersionedTransitionArtifact
-	(*v113.TaskQueuePartition)(nil),           // 137: temporal.server.api.taskqueue.v1.TaskQueuePartition
-	(*v114.TaskQueueVersionSelection)(nil),    // 138: temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	(*v114.TaskIdBlock)(nil),                  // 139: temporal.api.taskqueue.v1.TaskIdBlock
-	(v16.IndexedValueType)(0),                 // 140: temporal.api.enums.v1.IndexedValueType
-	(*v113.TaskQueueVersionInfoInternal)(nil), // 141: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*TaskQueueTaskFilter)(nil),                         // 57: temporal.server.api.adminservice.v1.TaskQueueTaskFilter
+	(*DeleteTaskQueueTasksRequest)(nil),                 // 58: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksRequest
+	(*DeleteTaskQueueTasksResponse)(nil),                // 59: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksResponse
+	(*StartTaskQueueBacklogMigrationRequest)(nil),       // 60: temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationRequest
+	(*StartTaskQueueBacklogMigrationResponse)(nil),      // 61: temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationResponse
+	(*DescribeTaskQueueBacklogMigrationRequest)(nil),    // 62: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationRequest
+	(*DescribeTaskQueueBacklogMigrationResponse)(nil),   // 63: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse
+	(*CancelTaskQueueBacklogMigrationRequest)(nil),      // 64: temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationRequest
+	(*CancelTaskQueueBacklogMigrationResponse)(nil),     // 65: temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationResponse
+	(*DeleteWorkflowExecutionRequest)(nil),              // 66: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*DeleteWorkflowExecutionResponse)(nil),             // 67: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 68: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 69: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceRequest)(nil),                         // 70: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),                        // 71: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksRequest)(nil),                          // 72: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*GetDLQTasksResponse)(nil),                         // 73: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksRequest)(nil),                        // 74: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*PurgeDLQTasksResponse)(nil),                       // 75: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*DLQJobToken)(nil),                                 // 76: temporal.server.api.adminservice.v1.DLQJobToken
+	(*MergeDLQTasksRequest)(nil),                        // 77: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*MergeDLQTasksResponse)(nil),                       // 78: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobRequest)(nil),                       // 79: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*DescribeDLQJobResponse)(nil),                      // 80: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobRequest)(nil),                         // 81: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*CancelDLQJobResponse)(nil),                        // 82: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksRequest)(nil),                             // 83: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*AddTasksResponse)(nil),                            // 84: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesRequest)(nil),                           // 85: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*ListQueuesResponse)(nil),                          // 86: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckRequest)(nil),                      // 87: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*DeepHealthCheckResponse)(nil),                     // 88: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateRequest)(nil),                    // 89: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*SyncWorkflowStateResponse)(nil),                   // 90: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 91: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 92: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionRequest)(nil),           // 93: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*InternalTaskQueueStatus)(nil),                     // 94: temporal.server.api.adminservice.v1.InternalTaskQueueStatus
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 95: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 96: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 97: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*UpdateTaskQueueFairnessWeightsRequest)(nil),       // 98: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest
+	(*UpdateTaskQueueFairnessWeightsResponse)(nil),      // 99: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsResponse
+	(*DescribeTaskQueueFairnessKeysRequest)(nil),        // 100: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysRequest
+	(*DescribeTaskQueueFairnessKeysResponse)(nil),       // 101: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse
+	(*UpdateTaskQueuePauseRequest)(nil),                 // 102: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest
+	(*UpdateTaskQueuePauseResponse)(nil),                // 103: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseResponse
+	(*UpdateTaskQueueBlockedPollersRequest)(nil),        // 104: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersRequest
+	(*UpdateTaskQueueBlockedPollersResponse)(nil),       // 105: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersResponse
+	(*CountWorkersRequest)(nil),                         // 106: temporal.server.api.adminservice.v1.CountWorkersRequest
+	(*CountWorkersResponse)(nil),                        // 107: temporal.server.api.adminservice.v1.CountWorkersResponse
+	(*PreviewScheduleRequest)(nil),                      // 108: temporal.server.api.adminservice.v1.PreviewScheduleRequest
+	(*PreviewScheduleResponse)(nil),                     // 109: temporal.server.api.adminservice.v1.PreviewScheduleResponse
+	(*ListScheduleActionsRequest)(nil),                  // 110: temporal.server.api.adminservice.v1.ListScheduleActionsRequest
+	(*ListScheduleActionsResponse)(nil),                 // 111: temporal.server.api.adminservice.v1.ListScheduleActionsResponse
+	(*UpdateScheduleCalendarSetRequest)(nil),            // 112: temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetRequest
+	(*UpdateScheduleCalendarSetResponse)(nil),           // 113: temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetResponse
+	(*ListScheduleCalendarSetsRequest)(nil),             // 114: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsRequest
+	(*ListScheduleCalendarSetsResponse)(nil),            // 115: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse
+	(*ListCallbacksRequest)(nil),                        // 116: temporal.server.api.adminservice.v1.ListCallbacksRequest
+	(*ListCallbacksResponse)(nil),                       // 117: temporal.server.api.adminservice.v1.ListCallbacksResponse
+	(*RetryCallbackRequest)(nil),                        // 118: temporal.server.api.adminservice.v1.RetryCallbackRequest
+	(*RetryCallbackResponse)(nil),                       // 119: temporal.server.api.adminservice.v1.RetryCallbackResponse
+	(*NexusEndpointHealth)(nil),                         // 120: temporal.server.api.adminservice.v1.NexusEndpointHealth
+	(*GetNexusEndpointRequest)(nil),                     // 121: temporal.server.api.adminservice.v1.GetNexusEndpointRequest
+	(*GetNexusEndpointResponse)(nil),                    // 122: temporal.server.api.adminservice.v1.GetNexusEndpointResponse
+	(*ListNexusEndpointsRequest)(nil),                   // 123: temporal.server.api.adminservice.v1.ListNexusEndpointsRequest
+	(*ListNexusEndpointsResponse)(nil),                  // 124: temporal.server.api.adminservice.v1.ListNexusEndpointsResponse
+	(*SetNexusEndpointCircuitBreakerRequest)(nil),       // 125: temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerRequest
+	(*SetNexusEndpointCircuitBreakerResponse)(nil),      // 126: temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerResponse
+	(*SetNexusEndpointAccessPolicyRequest)(nil),         // 127: temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyRequest
+	(*SetNexusEndpointAccessPolicyResponse)(nil),        // 128: temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyResponse
+	(*AggregateWorkflowExecutionsRequest)(nil),          // 129: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	(*AggregateWorkflowExecutionsResponse)(nil),         // 130: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	(*StartVisibilityReindexRequest)(nil),               // 131: temporal.server.api.adminservice.v1.StartVisibilityReindexRequest
+	(*StartVisibilityReindexResponse)(nil),              // 132: temporal.server.api.adminservice.v1.StartVisibilityReindexResponse
+	(*DescribeVisibilityReindexRequest)(nil),            // 133: temporal.server.api.adminservice.v1.DescribeVisibilityReindexRequest
+	(*DescribeVisibilityReindexResponse)(nil),           // 134: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse
+	(*CancelVisibilityReindexRequest)(nil),              // 135: temporal.server.api.adminservice.v1.CancelVisibilityReindexRequest
+	(*CancelVisibilityReindexResponse)(nil),             // 136: temporal.server.api.adminservice.v1.CancelVisibilityReindexResponse
+	(*RenameSearchAttributeRequest)(nil),                // 137: temporal.server.api.adminservice.v1.RenameSearchAttributeRequest
+	(*RenameSearchAttributeResponse)(nil),               // 138: temporal.server.api.adminservice.v1.RenameSearchAttributeResponse
+	(*StartSearchAttributeMigrationRequest)(nil),        // 139: temporal.server.api.adminservice.v1.StartSearchAttributeMigrationRequest
+	(*StartSearchAttributeMigrationResponse)(nil),       // 140: temporal.server.api.adminservice.v1.StartSearchAttributeMigrationResponse
+	(*DescribeSearchAttributeMigrationRequest)(nil),     // 141: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationRequest
+	(*DescribeSearchAttributeMigrationResponse)(nil),    // 142: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse
+	(*PutSavedVisibilityQueryRequest)(nil),              // 143: temporal.server.api.adminservice.v1.PutSavedVisibilityQueryRequest
+	(*PutSavedVisibilityQueryResponse)(nil),             // 144: temporal.server.api.adminservice.v1.PutSavedVisibilityQueryResponse
+	(*DeleteSavedVisibilityQueryRequest)(nil),           // 145: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	(*DeleteSavedVisibilityQueryResponse)(nil),          // 146: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	(*ListSavedVisibilityQueriesRequest)(nil),           // 147: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	(*ListSavedVisibilityQueriesResponse)(nil),          // 148: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	(*RunSavedVisibilityQueryRequest)(nil),              // 149: temporal.server.api.adminservice.v1.RunSavedVisibilityQueryRequest
+	(*RunSavedVisibilityQueryResponse)(nil),             // 150: temporal.server.api.adminservice.v1.RunSavedVisibilityQueryResponse
+	(*ExplainWorkflowExecutionsRequest)(nil),            // 151: temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsRequest
+	(*ExplainWorkflowExecutionsResponse)(nil),           // 152: temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsResponse
+	nil,                                  // 153: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	nil,                                  // 154: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	nil,                                  // 155: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	nil,                                  // 156: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	nil,                                  // 157: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	nil,                                  // 158: temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	nil,                                  // 159: temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	(*AddTasksRequest_Task)(nil),         // 160: temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	(*ListQueuesResponse_QueueInfo)(nil), // 161: temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	nil,                                  // 162: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	nil,                                  // 163: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest.SetOverridesEntry
+	(*CountWorkersResponse_AggregationGroup)(nil),     // 164: temporal.server.api.adminservice.v1.CountWorkersResponse.AggregationGroup
+	(*PreviewScheduleResponse_Action)(nil),            // 165: temporal.server.api.adminservice.v1.PreviewScheduleResponse.Action
+	(*ListScheduleActionsResponse_StartedAction)(nil), // 166: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedAction
+	nil,                                    // 167: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse.CalendarSetsEntry
+	(*ListCallbacksResponse_Callback)(nil), // 168: temporal.server.api.adminservice.v1.ListCallbacksResponse.Callback
+	(*NexusEndpointHealth_Circuit)(nil),    // 169: temporal.server.api.adminservice.v1.NexusEndpointHealth.Circuit
+	(*ListNexusEndpointsResponse_Endpoint)(nil),                      // 170: temporal.server.api.adminservice.v1.ListNexusEndpointsResponse.Endpoint
+	(*AggregateWorkflowExecutionsResponse_Percentile)(nil),           // 171: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.Percentile
+	(*DescribeVisibilityReindexResponse_Mismatch)(nil),               // 172: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.Mismatch
+	(*DescribeVisibilityReindexResponse_UnrecoverableExecution)(nil), // 173: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.UnrecoverableExecution
+	(*DescribeSearchAttributeMigrationResponse_InvalidValue)(nil),    // 174: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.InvalidValue
+	(*v1.WorkflowExecution)(nil),                                     // 175: temporal.api.common.v1.WorkflowExecution
+	(*v1.DataBlob)(nil),                                              // 176: temporal.api.common.v1.DataBlob
+	(*v11.VersionHistory)(nil),                                       // 177: temporal.server.api.history.v1.VersionHistory
+	(*v12.WorkflowMutableState)(nil),                                 // 178: temporal.server.api.persistence.v1.WorkflowMutableState
+	(*v13.NamespaceCacheInfo)(nil),                                   // 179: temporal.server.api.namespace.v1.NamespaceCacheInfo
+	(*v12.ShardInfo)(nil),                                            // 180: temporal.server.api.persistence.v1.ShardInfo
+	(*v11.TaskRange)(nil),                                            // 181: temporal.server.api.history.v1.TaskRange
+	(v14.TaskType)(0),                                                // 182: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil),                                    // 183: google.protobuf.Timestamp
+	(*v15.ReplicationToken)(nil),                                     // 184: temporal.server.api.replication.v1.ReplicationToken
+	(*v15.ReplicationMessages)(nil),                                  // 185: temporal.server.api.replication.v1.ReplicationMessages
+	(*v15.ReplicationTaskInfo)(nil),                                  // 186: temporal.server.api.replication.v1.ReplicationTaskInfo
+	(*v15.ReplicationTask)(nil),                                      // 187: temporal.server.api.replication.v1.ReplicationTask
+	(*v17.WorkflowExecutionInfo)(nil),                                // 188: temporal.api.workflow.v1.WorkflowExecutionInfo
+	(*v18.MembershipInfo)(nil),                                       // 189: temporal.server.api.cluster.v1.MembershipInfo
+	(*v19.VersionInfo)(nil),                                          // 190: temporal.api.version.v1.VersionInfo
+	(*v12.ClusterMetadata)(nil),                                      // 191: temporal.server.api.persistence.v1.ClusterMetadata
+	(*durationpb.Duration)(nil),                                      // 192: google.protobuf.Duration
+	(v14.ClusterMemberRole)(0),                                       // 193: temporal.server.api.enums.v1.ClusterMemberRole
+	(*v18.ClusterMember)(nil),                                        // 194: temporal.server.api.cluster.v1.ClusterMember
+	(v14.DeadLetterQueueType)(0),                                     // 195: temporal.server.api.enums.v1.DeadLetterQueueType
+	(v16.TaskQueueType)(0),                                           // 196: temporal.api.enums.v1.TaskQueueType
+	(*v12.AllocatedTaskInfo)(nil),                                    // 197: temporal.server.api.persistence.v1.AllocatedTaskInfo
+	(v16.WorkflowExecutionStatus)(0),                                 // 198: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v15.SyncReplicationState)(nil),                                 // 199: temporal.server.api.replication.v1.SyncReplicationState
+	(*v15.WorkflowReplicationMessages)(nil),                          // 200: temporal.server.api.replication.v1.WorkflowReplicationMessages
+	(*v110.NamespaceInfo)(nil),                                       // 201: temporal.api.namespace.v1.NamespaceInfo
+	(*v110.NamespaceConfig)(nil),                                     // 202: temporal.api.namespace.v1.NamespaceConfig
+	(*v111.NamespaceReplicationConfig)(nil),                          // 203: temporal.api.replication.v1.NamespaceReplicationConfig
+	(*v111.FailoverStatus)(nil),                                      // 204: temporal.api.replication.v1.FailoverStatus
+	(*v112.HistoryDLQKey)(nil),                                       // 205: temporal.server.api.common.v1.HistoryDLQKey
+	(*v112.HistoryDLQTask)(nil),                                      // 206: temporal.server.api.common.v1.HistoryDLQTask
+	(*v112.HistoryDLQTaskMetadata)(nil),                              // 207: temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	(v14.DLQOperationType)(0),                                        // 208: temporal.server.api.enums.v1.DLQOperationType
+	(v14.DLQOperationState)(0),                                       // 209: temporal.server.api.enums.v1.DLQOperationState
+	(v14.HealthState)(0),                                             // 210: temporal.server.api.enums.v1.HealthState
+	(*v12.VersionedTransition)(nil),                                  // 211: temporal.server.api.persistence.v1.VersionedTransition
+	(*v11.VersionHistories)(nil),                                     // 212: temporal.server.api.history.v1.VersionHistories
+	(*v15.VersionedTransitionArtifact)(nil),                          // 213: temporal.server.api.replication.v1.VersionedTransitionArtifact
+	(*v113.TaskQueuePartition)(nil),                                  // 214: temporal.server.api.taskqueue.v1.TaskQueuePartition
+	(*v114.TaskQueueVersionSelection)(nil),                           // 215: temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	(*v114.TaskIdBlock)(nil),                                         // 216: temporal.api.taskqueue.v1.TaskIdBlock
+	(*v12.FairnessWeights)(nil),                                      // 217: temporal.server.api.persistence.v1.FairnessWeights
+	(*v113.FairnessKeyStats)(nil),                                    // 218: temporal.server.api.taskqueue.v1.FairnessKeyStats
+	(v14.TaskQueuePauseMode)(0),                                      // 219: temporal.server.api.enums.v1.TaskQueuePauseMode
+	(*v12.TaskQueuePause)(nil),                                       // 220: temporal.server.api.persistence.v1.TaskQueuePause
+	(*v12.BlockedPoller)(nil),                                        // 221: temporal.server.api.persistence.v1.BlockedPoller
+	(*v115.ScheduleSpec)(nil),                                        // 222: temporal.api.schedule.v1.ScheduleSpec
+	(*v115.SchedulePolicies)(nil),                                    // 223: temporal.api.schedule.v1.SchedulePolicies
+	(*v116.SkippedAction)(nil),                                       // 224: temporal.server.api.schedule.v1.SkippedAction
+	(v14.CallbackState)(0),                                           // 225: temporal.server.api.enums.v1.CallbackState
+	(v14.CircuitBreakerState)(0),                                     // 226: temporal.server.api.enums.v1.CircuitBreakerState
+	(*v12.NexusEndpointEntry)(nil),                                   // 227: temporal.server.api.persistence.v1.NexusEndpointEntry
+	(v14.CircuitBreakerOverride)(0),                                  // 228: temporal.server.api.enums.v1.CircuitBreakerOverride
+	(v16.IndexedValueType)(0),                                        // 229: temporal.api.enums.v1.IndexedValueType
+	(*v12.SavedVisibilityQuery)(nil),                                 // 230: temporal.server.api.persistence.v1.SavedVisibilityQuery
+	(*v113.TaskQueueVersionInfoInternal)(nil),                        // 231: temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	(*v12.ScheduleCalendarSet)(nil),                                  // 232: temporal.server.api.persistence.v1.ScheduleCalendarSet
+	(*v12.CallbackInfo)(nil),                                         // 233: temporal.server.api.persistence.v1.CallbackInfo
 }
 var file_temporal_server_api_adminservice_v1_request_response_proto_depIdxs = []int32{
-	99,  // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	99,  // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	102, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	102, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
-	99,  // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	103, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
-	104, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
-	105, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
+	175, // 0: temporal.server.api.adminservice.v1.RebuildMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	175, // 1: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	176, // 2: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	177, // 3: temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	175, // 4: temporal.server.api.adminservice.v1.DescribeMutableStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	178, // 5: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.cache_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	178, // 6: temporal.server.api.adminservice.v1.DescribeMutableStateResponse.database_mutable_state:type_name -> temporal.server.api.persistence.v1.WorkflowMutableState
+	175, // 7: temporal.server.api.adminservice.v1.DescribeHistoryHostRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	179, // 8: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse.namespace_cache:type_name -> temporal.server.api.namespace.v1.NamespaceCacheInfo
+	180, // 9: temporal.server.api.adminservice.v1.GetShardResponse.shard_info:type_name -> temporal.server.api.persistence.v1.ShardInfo
+	181, // 10: temporal.server.api.adminservice.v1.ListHistoryTasksRequest.task_range:type_name -> temporal.server.api.history.v1.TaskRange
 	14,  // 11: temporal.server.api.adminservice.v1.ListHistoryTasksResponse.tasks:type_name -> temporal.server.api.adminservice.v1.Task
-	106, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
-	107, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
-	107, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
-	99,  // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	99,  // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
-	101, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
-	108, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
-	89,  // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
-	109, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	110, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	111, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	99,  // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	100, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
-	90,  // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
-	91,  // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
-	92,  // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
-	93,  // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
-	112, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
-	94,  // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
-	113, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
-	114, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	95,  // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
-	115, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
-	116, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
-	117, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
-	107, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
-	118, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
-	119, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	111, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
-	110, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
-	119, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	119, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
-	99,  // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	120, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
-	121, // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
-	99,  // 51: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	122, // 52: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
-	123, // 53: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
-	124, // 54: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
-	125, // 55: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
-	126, // 56: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
-	127, // 57: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
-	128, // 58: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	129, // 59: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
-	128, // 60: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 61: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 62: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	130, // 63: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
-	128, // 64: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
-	131, // 65: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
-	132, // 66: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
-	107, // 67: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
-	107, // 68: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
-	96,  // 69: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
-	97,  // 70: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
-	133, // 71: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
-	99,  // 72: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	134, // 73: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	135, // 74: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	136, // 75: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
-	99,  // 76: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	137, // 77: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	138, // 78: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
-	139, // 79: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
-	98,  // 80: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
-	137, // 81: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
-	109, // 82: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
-	140, // 83: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 84: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	140, // 85: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	100, // 86: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
-	141, // 87: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
-	88,  // [88:88] is the sub-list for method output_type
-	88,  // [88:88] is the sub-list for method input_type
-	88,  // [88:88] is the sub-list for extension type_name
-	88,  // [88:88] is the sub-list for extension extendee
-	0,   // [0:88] is the sub-list for field type_name
+	182, // 12: temporal.server.api.adminservice.v1.Task.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	183, // 13: temporal.server.api.adminservice.v1.Task.fire_time:type_name -> google.protobuf.Timestamp
+	183, // 14: temporal.server.api.adminservice.v1.RemoveTaskRequest.visibility_time:type_name -> google.protobuf.Timestamp
+	175, // 15: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	176, // 16: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	177, // 17: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	175, // 18: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	176, // 19: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.history_batches:type_name -> temporal.api.common.v1.DataBlob
+	177, // 20: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse.version_history:type_name -> temporal.server.api.history.v1.VersionHistory
+	184, // 21: temporal.server.api.adminservice.v1.GetReplicationMessagesRequest.tokens:type_name -> temporal.server.api.replication.v1.ReplicationToken
+	153, // 22: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.shard_messages:type_name -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry
+	185, // 23: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	186, // 24: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest.task_infos:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	187, // 25: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	175, // 26: temporal.server.api.adminservice.v1.ReapplyEventsRequest.workflow_execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	176, // 27: temporal.server.api.adminservice.v1.ReapplyEventsRequest.events:type_name -> temporal.api.common.v1.DataBlob
+	154, // 28: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.search_attributes:type_name -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry
+	155, // 29: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.custom_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry
+	156, // 30: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.system_attributes:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry
+	157, // 31: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.mapping:type_name -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse.MappingEntry
+	188, // 32: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.add_workflow_execution_info:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	158, // 33: temporal.server.api.adminservice.v1.DescribeClusterResponse.supported_clients:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.SupportedClientsEntry
+	189, // 34: temporal.server.api.adminservice.v1.DescribeClusterResponse.membership_info:type_name -> temporal.server.api.cluster.v1.MembershipInfo
+	190, // 35: temporal.server.api.adminservice.v1.DescribeClusterResponse.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	159, // 36: temporal.server.api.adminservice.v1.DescribeClusterResponse.tags:type_name -> temporal.server.api.adminservice.v1.DescribeClusterResponse.TagsEntry
+	191, // 37: temporal.server.api.adminservice.v1.ListClustersResponse.clusters:type_name -> temporal.server.api.persistence.v1.ClusterMetadata
+	192, // 38: temporal.server.api.adminservice.v1.ListClusterMembersRequest.last_heartbeat_within:type_name -> google.protobuf.Duration
+	193, // 39: temporal.server.api.adminservice.v1.ListClusterMembersRequest.role:type_name -> temporal.server.api.enums.v1.ClusterMemberRole
+	183, // 40: temporal.server.api.adminservice.v1.ListClusterMembersRequest.session_started_after_time:type_name -> google.protobuf.Timestamp
+	194, // 41: temporal.server.api.adminservice.v1.ListClusterMembersResponse.active_members:type_name -> temporal.server.api.cluster.v1.ClusterMember
+	195, // 42: temporal.server.api.adminservice.v1.GetDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	195, // 43: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	187, // 44: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks:type_name -> temporal.server.api.replication.v1.ReplicationTask
+	186, // 45: temporal.server.api.adminservice.v1.GetDLQMessagesResponse.replication_tasks_info:type_name -> temporal.server.api.replication.v1.ReplicationTaskInfo
+	195, // 46: temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	195, // 47: temporal.server.api.adminservice.v1.MergeDLQMessagesRequest.type:type_name -> temporal.server.api.enums.v1.DeadLetterQueueType
+	175, // 48: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	196, // 49: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	57,  // 50: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest.filter:type_name -> temporal.server.api.adminservice.v1.TaskQueueTaskFilter
+	197, // 51: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	196, // 52: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	57,  // 53: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksRequest.filter:type_name -> temporal.server.api.adminservice.v1.TaskQueueTaskFilter
+	197, // 54: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksResponse.tasks:type_name -> temporal.server.api.persistence.v1.AllocatedTaskInfo
+	198, // 55: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	183, // 56: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse.start_time:type_name -> google.protobuf.Timestamp
+	183, // 57: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse.close_time:type_name -> google.protobuf.Timestamp
+	175, // 58: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	199, // 59: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest.sync_replication_state:type_name -> temporal.server.api.replication.v1.SyncReplicationState
+	200, // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse.messages:type_name -> temporal.server.api.replication.v1.WorkflowReplicationMessages
+	201, // 61: temporal.server.api.adminservice.v1.GetNamespaceResponse.info:type_name -> temporal.api.namespace.v1.NamespaceInfo
+	202, // 62: temporal.server.api.adminservice.v1.GetNamespaceResponse.config:type_name -> temporal.api.namespace.v1.NamespaceConfig
+	203, // 63: temporal.server.api.adminservice.v1.GetNamespaceResponse.replication_config:type_name -> temporal.api.replication.v1.NamespaceReplicationConfig
+	204, // 64: temporal.server.api.adminservice.v1.GetNamespaceResponse.failover_history:type_name -> temporal.api.replication.v1.FailoverStatus
+	205, // 65: temporal.server.api.adminservice.v1.GetDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	206, // 66: temporal.server.api.adminservice.v1.GetDLQTasksResponse.dlq_tasks:type_name -> temporal.server.api.common.v1.HistoryDLQTask
+	205, // 67: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	207, // 68: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	205, // 69: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	207, // 70: temporal.server.api.adminservice.v1.MergeDLQTasksRequest.inclusive_max_task_metadata:type_name -> temporal.server.api.common.v1.HistoryDLQTaskMetadata
+	205, // 71: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.dlq_key:type_name -> temporal.server.api.common.v1.HistoryDLQKey
+	208, // 72: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_type:type_name -> temporal.server.api.enums.v1.DLQOperationType
+	209, // 73: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.operation_state:type_name -> temporal.server.api.enums.v1.DLQOperationState
+	183, // 74: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.start_time:type_name -> google.protobuf.Timestamp
+	183, // 75: temporal.server.api.adminservice.v1.DescribeDLQJobResponse.end_time:type_name -> google.protobuf.Timestamp
+	160, // 76: temporal.server.api.adminservice.v1.AddTasksRequest.tasks:type_name -> temporal.server.api.adminservice.v1.AddTasksRequest.Task
+	161, // 77: temporal.server.api.adminservice.v1.ListQueuesResponse.queues:type_name -> temporal.server.api.adminservice.v1.ListQueuesResponse.QueueInfo
+	210, // 78: temporal.server.api.adminservice.v1.DeepHealthCheckResponse.state:type_name -> temporal.server.api.enums.v1.HealthState
+	175, // 79: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	211, // 80: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	212, // 81: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	213, // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse.versioned_transition_artifact:type_name -> temporal.server.api.replication.v1.VersionedTransitionArtifact
+	175, // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	214, // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	215, // 85: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest.build_ids:type_name -> temporal.api.taskqueue.v1.TaskQueueVersionSelection
+	216, // 86: temporal.server.api.adminservice.v1.InternalTaskQueueStatus.task_id_block:type_name -> temporal.api.taskqueue.v1.TaskIdBlock
+	162, // 87: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.versions_info_internal:type_name -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry
+	214, // 88: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	196, // 89: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	163, // 90: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest.set_overrides:type_name -> temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest.SetOverridesEntry
+	217, // 91: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsResponse.fairness_weights:type_name -> temporal.server.api.persistence.v1.FairnessWeights
+	214, // 92: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysRequest.task_queue_partition:type_name -> temporal.server.api.taskqueue.v1.TaskQueuePartition
+	218, // 93: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse.top_by_backlog:type_name -> temporal.server.api.taskqueue.v1.FairnessKeyStats
+	218, // 94: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse.top_by_dispatch_rate:type_name -> temporal.server.api.taskqueue.v1.FairnessKeyStats
+	217, // 95: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse.fairness_weights:type_name -> temporal.server.api.persistence.v1.FairnessWeights
+	196, // 96: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	219, // 97: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest.mode:type_name -> temporal.server.api.enums.v1.TaskQueuePauseMode
+	183, // 98: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest.start_time:type_name -> google.protobuf.Timestamp
+	183, // 99: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest.end_time:type_name -> google.protobuf.Timestamp
+	220, // 100: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseResponse.pause:type_name -> temporal.server.api.persistence.v1.TaskQueuePause
+	196, // 101: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersRequest.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	192, // 102: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersRequest.block_duration:type_name -> google.protobuf.Duration
+	221, // 103: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersResponse.blocked_pollers:type_name -> temporal.server.api.persistence.v1.BlockedPoller
+	164, // 104: temporal.server.api.adminservice.v1.CountWorkersResponse.groups:type_name -> temporal.server.api.adminservice.v1.CountWorkersResponse.AggregationGroup
+	222, // 105: temporal.server.api.adminservice.v1.PreviewScheduleRequest.spec:type_name -> temporal.api.schedule.v1.ScheduleSpec
+	223, // 106: temporal.server.api.adminservice.v1.PreviewScheduleRequest.policies:type_name -> temporal.api.schedule.v1.SchedulePolicies
+	183, // 107: temporal.server.api.adminservice.v1.PreviewScheduleRequest.start_time:type_name -> google.protobuf.Timestamp
+	183, // 108: temporal.server.api.adminservice.v1.PreviewScheduleRequest.end_time:type_name -> google.protobuf.Timestamp
+	192, // 109: temporal.server.api.adminservice.v1.PreviewScheduleRequest.run_duration:type_name -> google.protobuf.Duration
+	222, // 110: temporal.server.api.adminservice.v1.PreviewScheduleResponse.canonical_spec:type_name -> temporal.api.schedule.v1.ScheduleSpec
+	165, // 111: temporal.server.api.adminservice.v1.PreviewScheduleResponse.actions:type_name -> temporal.server.api.adminservice.v1.PreviewScheduleResponse.Action
+	166, // 112: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.started_actions:type_name -> temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedAction
+	224, // 113: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.skipped_actions:type_name -> temporal.server.api.schedule.v1.SkippedAction
+	167, // 114: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse.calendar_sets:type_name -> temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse.CalendarSetsEntry
+	225, // 115: temporal.server.api.adminservice.v1.ListCallbacksRequest.states:type_name -> temporal.server.api.enums.v1.CallbackState
+	168, // 116: temporal.server.api.adminservice.v1.ListCallbacksResponse.callbacks:type_name -> temporal.server.api.adminservice.v1.ListCallbacksResponse.Callback
+	175, // 117: temporal.server.api.adminservice.v1.RetryCallbackRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	192, // 118: temporal.server.api.adminservice.v1.NexusEndpointHealth.latency_p50:type_name -> google.protobuf.Duration
+	192, // 119: temporal.server.api.adminservice.v1.NexusEndpointHealth.latency_p90:type_name -> google.protobuf.Duration
+	192, // 120: temporal.server.api.adminservice.v1.NexusEndpointHealth.latency_p99:type_name -> google.protobuf.Duration
+	226, // 121: temporal.server.api.adminservice.v1.NexusEndpointHealth.circuit_breaker_state:type_name -> temporal.server.api.enums.v1.CircuitBreakerState
+	169, // 122: temporal.server.api.adminservice.v1.NexusEndpointHealth.circuits:type_name -> temporal.server.api.adminservice.v1.NexusEndpointHealth.Circuit
+	227, // 123: temporal.server.api.adminservice.v1.GetNexusEndpointResponse.entry:type_name -> temporal.server.api.persistence.v1.NexusEndpointEntry
+	120, // 124: temporal.server.api.adminservice.v1.GetNexusEndpointResponse.health:type_name -> temporal.server.api.adminservice.v1.NexusEndpointHealth
+	170, // 125: temporal.server.api.adminservice.v1.ListNexusEndpointsResponse.endpoints:type_name -> temporal.server.api.adminservice.v1.ListNexusEndpointsResponse.Endpoint
+	228, // 126: temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerRequest.override:type_name -> temporal.server.api.enums.v1.CircuitBreakerOverride
+	192, // 127: temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerRequest.duration:type_name -> google.protobuf.Duration
+	171, // 128: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.percentiles:type_name -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse.Percentile
+	198, // 129: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	172, // 130: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.mismatch_samples:type_name -> temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.Mismatch
+	183, // 131: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.start_time:type_name -> google.protobuf.Timestamp
+	183, // 132: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.close_time:type_name -> google.protobuf.Timestamp
+	173, // 133: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.unrecoverable_samples:type_name -> temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse.UnrecoverableExecution
+	229, // 134: temporal.server.api.adminservice.v1.StartSearchAttributeMigrationRequest.new_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	198, // 135: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	229, // 136: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.type:type_name -> temporal.api.enums.v1.IndexedValueType
+	229, // 137: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.new_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	174, // 138: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.invalid_value_samples:type_name -> temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.InvalidValue
+	183, // 139: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.start_time:type_name -> google.protobuf.Timestamp
+	183, // 140: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse.close_time:type_name -> google.protobuf.Timestamp
+	230, // 141: temporal.server.api.adminservice.v1.PutSavedVisibilityQueryRequest.query:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	230, // 142: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse.queries:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	188, // 143: temporal.server.api.adminservice.v1.RunSavedVisibilityQueryResponse.executions:type_name -> temporal.api.workflow.v1.WorkflowExecutionInfo
+	185, // 144: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry.value:type_name -> temporal.server.api.replication.v1.ReplicationMessages
+	229, // 145: temporal.server.api.adminservice.v1.AddSearchAttributesRequest.SearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	229, // 146: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.CustomAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	229, // 147: temporal.server.api.adminservice.v1.GetSearchAttributesResponse.SystemAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	176, // 148: temporal.server.api.adminservice.v1.AddTasksRequest.Task.blob:type_name -> temporal.api.common.v1.DataBlob
+	231, // 149: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse.VersionsInfoInternalEntry.value:type_name -> temporal.server.api.taskqueue.v1.TaskQueueVersionInfoInternal
+	183, // 150: temporal.server.api.adminservice.v1.PreviewScheduleResponse.Action.nominal_time:type_name -> google.protobuf.Timestamp
+	183, // 151: temporal.server.api.adminservice.v1.PreviewScheduleResponse.Action.actual_time:type_name -> google.protobuf.Timestamp
+	183, // 152: temporal.server.api.adminservice.v1.PreviewScheduleResponse.Action.start_time:type_name -> google.protobuf.Timestamp
+	183, // 153: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedAction.nominal_time:type_name -> google.protobuf.Timestamp
+	198, // 154: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedAction.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	183, // 155: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedAction.start_time:type_name -> google.protobuf.Timestamp
+	183, // 156: temporal.server.api.adminservice.v1.ListScheduleActionsResponse.StartedAction.close_time:type_name -> google.protobuf.Timestamp
+	232, // 157: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse.CalendarSetsEntry.value:type_name -> temporal.server.api.persistence.v1.ScheduleCalendarSet
+	175, // 158: temporal.server.api.adminservice.v1.ListCallbacksResponse.Callback.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	233, // 159: temporal.server.api.adminservice.v1.ListCallbacksResponse.Callback.info:type_name -> temporal.server.api.persistence.v1.CallbackInfo
+	226, // 160: temporal.server.api.adminservice.v1.NexusEndpointHealth.Circuit.state:type_name -> temporal.server.api.enums.v1.CircuitBreakerState
+	227, // 161: temporal.server.api.adminservice.v1.ListNexusEndpointsResponse.Endpoint.entry:type_name -> temporal.server.api.persistence.v1.NexusEndpointEntry
+	120, // 162: temporal.server.api.adminservice.v1.ListNexusEndpointsResponse.Endpoint.health:type_name -> temporal.server.api.adminservice.v1.NexusEndpointHealth
+	163, // [163:163] is the sub-list for method output_type
+	163, // [163:163] is the sub-list for method input_type
+	163, // [163:163] is the sub-list for extension type_name
+	163, // [163:163] is the sub-list for extension extendee
+	0,   // [0:163] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_request_response_proto_init() }
@@ -6021,13 +11424,13 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 	if File_temporal_server_api_adminservice_v1_request_response_proto != nil {
 		return
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[59].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[68].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[60].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[69].OneofWrappers = []any{
 		(*StreamWorkflowReplicationMessagesResponse_Messages)(nil),
 	}
-	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[61].OneofWrappers = []any{
+	file_temporal_server_api_adminservice_v1_request_response_proto_msgTypes[70].OneofWrappers = []any{
 		(*GetNamespaceRequest_Namespace)(nil),
 		(*GetNamespaceRequest_Id)(nil),
 	}
@@ -6037,7 +11440,7 @@ func file_temporal_server_api_adminservice_v1_request_response_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc), len(file_temporal_server_api_adminservice_v1_request_response_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   99,
+			NumMessages:   175,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
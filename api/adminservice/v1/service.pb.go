@@ -25,7 +25,7 @@ var File_temporal_server_api_adminservice_v1_service_proto protoreflect.FileDesc
 
 const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto2\xbf4\n" +
+	"1temporal/server/api/adminservice/v1/service.proto\x12#temporal.server.api.adminservice.v1\x1a:temporal/server/api/adminservice/v1/request_response.proto2\xfe]\n" +
 	"\fAdminService\x12\x9a\x01\n" +
 	"\x13RebuildMutableState\x12?.temporal.server.api.adminservice.v1.RebuildMutableStateRequest\x1a@.temporal.server.api.adminservice.v1.RebuildMutableStateResponse\"\x00\x12\xa6\x01\n" +
 	"\x17ImportWorkflowExecution\x12C.temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse\"\x00\x12\x9d\x01\n" +
@@ -56,7 +56,38 @@ const file_temporal_server_api_adminservice_v1_service_proto_rawDesc = "" +
 	"\x10MergeDLQMessages\x12<.temporal.server.api.adminservice.v1.MergeDLQMessagesRequest\x1a=.temporal.server.api.adminservice.v1.MergeDLQMessagesResponse\"\x00\x12\x9d\x01\n" +
 	"\x14RefreshWorkflowTasks\x12@.temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest\x1aA.temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse\"\x00\x12\xa3\x01\n" +
 	"\x16ResendReplicationTasks\x12B.temporal.server.api.adminservice.v1.ResendReplicationTasksRequest\x1aC.temporal.server.api.adminservice.v1.ResendReplicationTasksResponse\"\x00\x12\x94\x01\n" +
-	"\x11GetTaskQueueTasks\x12=.temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest\x1a>.temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse\"\x00\x12\xa6\x01\n" +
+	"\x11GetTaskQueueTasks\x12=.temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest\x1a>.temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse\"\x00\x12\x9d\x01\n" +
+	"\x14DeleteTaskQueueTasks\x12@.temporal.server.api.adminservice.v1.DeleteTaskQueueTasksRequest\x1aA.temporal.server.api.adminservice.v1.DeleteTaskQueueTasksResponse\"\x00\x12\xbb\x01\n" +
+	"\x1eStartTaskQueueBacklogMigration\x12J.temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationRequest\x1aK.temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationResponse\"\x00\x12\xc4\x01\n" +
+	"!DescribeTaskQueueBacklogMigration\x12M.temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationRequest\x1aN.temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse\"\x00\x12\xbe\x01\n" +
+	"\x1fCancelTaskQueueBacklogMigration\x12K.temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationRequest\x1aL.temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationResponse\"\x00\x12\xbb\x01\n" +
+	"\x1eUpdateTaskQueueFairnessWeights\x12J.temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest\x1aK.temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsResponse\"\x00\x12\xb8\x01\n" +
+	"\x1dDescribeTaskQueueFairnessKeys\x12I.temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysRequest\x1aJ.temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse\"\x00\x12\x9d\x01\n" +
+	"\x14UpdateTaskQueuePause\x12@.temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest\x1aA.temporal.server.api.adminservice.v1.UpdateTaskQueuePauseResponse\"\x00\x12\xb8\x01\n" +
+	"\x1dUpdateTaskQueueBlockedPollers\x12I.temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersRequest\x1aJ.temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersResponse\"\x00\x12\x85\x01\n" +
+	"\fCountWorkers\x128.temporal.server.api.adminservice.v1.CountWorkersRequest\x1a9.temporal.server.api.adminservice.v1.CountWorkersResponse\"\x00\x12\x8e\x01\n" +
+	"\x0fPreviewSchedule\x12;.temporal.server.api.adminservice.v1.PreviewScheduleRequest\x1a<.temporal.server.api.adminservice.v1.PreviewScheduleResponse\"\x00\x12\x9a\x01\n" +
+	"\x13ListScheduleActions\x12?.temporal.server.api.adminservice.v1.ListScheduleActionsRequest\x1a@.temporal.server.api.adminservice.v1.ListScheduleActionsResponse\"\x00\x12\xac\x01\n" +
+	"\x19UpdateScheduleCalendarSet\x12E.temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetRequest\x1aF.temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetResponse\"\x00\x12\xa9\x01\n" +
+	"\x18ListScheduleCalendarSets\x12D.temporal.server.api.adminservice.v1.ListScheduleCalendarSetsRequest\x1aE.temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse\"\x00\x12\x88\x01\n" +
+	"\rListCallbacks\x129.temporal.server.api.adminservice.v1.ListCallbacksRequest\x1a:.temporal.server.api.adminservice.v1.ListCallbacksResponse\"\x00\x12\x88\x01\n" +
+	"\rRetryCallback\x129.temporal.server.api.adminservice.v1.RetryCallbackRequest\x1a:.temporal.server.api.adminservice.v1.RetryCallbackResponse\"\x00\x12\x91\x01\n" +
+	"\x10GetNexusEndpoint\x12<.temporal.server.api.adminservice.v1.GetNexusEndpointRequest\x1a=.temporal.server.api.adminservice.v1.GetNexusEndpointResponse\"\x00\x12\x97\x01\n" +
+	"\x12ListNexusEndpoints\x12>.temporal.server.api.adminservice.v1.ListNexusEndpointsRequest\x1a?.temporal.server.api.adminservice.v1.ListNexusEndpointsResponse\"\x00\x12\xbb\x01\n" +
+	"\x1eSetNexusEndpointCircuitBreaker\x12J.temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerRequest\x1aK.temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerResponse\"\x00\x12\xb5\x01\n" +
+	"\x1cSetNexusEndpointAccessPolicy\x12H.temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyRequest\x1aI.temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyResponse\"\x00\x12\xb2\x01\n" +
+	"\x1bAggregateWorkflowExecutions\x12G.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest\x1aH.temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse\"\x00\x12\xac\x01\n" +
+	"\x19ExplainWorkflowExecutions\x12E.temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsRequest\x1aF.temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsResponse\"\x00\x12\xa3\x01\n" +
+	"\x16StartVisibilityReindex\x12B.temporal.server.api.adminservice.v1.StartVisibilityReindexRequest\x1aC.temporal.server.api.adminservice.v1.StartVisibilityReindexResponse\"\x00\x12\xac\x01\n" +
+	"\x19DescribeVisibilityReindex\x12E.temporal.server.api.adminservice.v1.DescribeVisibilityReindexRequest\x1aF.temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse\"\x00\x12\xa6\x01\n" +
+	"\x17CancelVisibilityReindex\x12C.temporal.server.api.adminservice.v1.CancelVisibilityReindexRequest\x1aD.temporal.server.api.adminservice.v1.CancelVisibilityReindexResponse\"\x00\x12\xa0\x01\n" +
+	"\x15RenameSearchAttribute\x12A.temporal.server.api.adminservice.v1.RenameSearchAttributeRequest\x1aB.temporal.server.api.adminservice.v1.RenameSearchAttributeResponse\"\x00\x12\xb8\x01\n" +
+	"\x1dStartSearchAttributeMigration\x12I.temporal.server.api.adminservice.v1.StartSearchAttributeMigrationRequest\x1aJ.temporal.server.api.adminservice.v1.StartSearchAttributeMigrationResponse\"\x00\x12\xc1\x01\n" +
+	" DescribeSearchAttributeMigration\x12L.temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationRequest\x1aM.temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse\"\x00\x12\xa6\x01\n" +
+	"\x17PutSavedVisibilityQuery\x12C.temporal.server.api.adminservice.v1.PutSavedVisibilityQueryRequest\x1aD.temporal.server.api.adminservice.v1.PutSavedVisibilityQueryResponse\"\x00\x12\xaf\x01\n" +
+	"\x1aDeleteSavedVisibilityQuery\x12F.temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest\x1aG.temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse\"\x00\x12\xaf\x01\n" +
+	"\x1aListSavedVisibilityQueries\x12F.temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest\x1aG.temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse\"\x00\x12\xa6\x01\n" +
+	"\x17RunSavedVisibilityQuery\x12C.temporal.server.api.adminservice.v1.RunSavedVisibilityQueryRequest\x1aD.temporal.server.api.adminservice.v1.RunSavedVisibilityQueryResponse\"\x00\x12\xa6\x01\n" +
 	"\x17DeleteWorkflowExecution\x12C.temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest\x1aD.temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse\"\x00\x12\xc8\x01\n" +
 	"!StreamWorkflowReplicationMessages\x12M.temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest\x1aN.temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse\"\x00(\x010\x01\x12\x85\x01\n" +
 	"\fGetNamespace\x128.temporal.server.api.adminservice.v1.GetNamespaceRequest\x1a9.temporal.server.api.adminservice.v1.GetNamespaceResponse\"\x00\x12\x82\x01\n" +
@@ -103,157 +134,281 @@ var file_temporal_server_api_adminservice_v1_service_proto_goTypes = []any{
 	(*RefreshWorkflowTasksRequest)(nil),                 // 25: temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
 	(*ResendReplicationTasksRequest)(nil),               // 26: temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
 	(*GetTaskQueueTasksRequest)(nil),                    // 27: temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	(*DeleteWorkflowExecutionRequest)(nil),              // 28: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 29: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	(*GetNamespaceRequest)(nil),                         // 30: temporal.server.api.adminservice.v1.GetNamespaceRequest
-	(*GetDLQTasksRequest)(nil),                          // 31: temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	(*PurgeDLQTasksRequest)(nil),                        // 32: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	(*MergeDLQTasksRequest)(nil),                        // 33: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	(*DescribeDLQJobRequest)(nil),                       // 34: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	(*CancelDLQJobRequest)(nil),                         // 35: temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	(*AddTasksRequest)(nil),                             // 36: temporal.server.api.adminservice.v1.AddTasksRequest
-	(*ListQueuesRequest)(nil),                           // 37: temporal.server.api.adminservice.v1.ListQueuesRequest
-	(*DeepHealthCheckRequest)(nil),                      // 38: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	(*SyncWorkflowStateRequest)(nil),                    // 39: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 40: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	(*DescribeTaskQueuePartitionRequest)(nil),           // 41: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 42: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	(*RebuildMutableStateResponse)(nil),                 // 43: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	(*ImportWorkflowExecutionResponse)(nil),             // 44: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	(*DescribeMutableStateResponse)(nil),                // 45: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	(*DescribeHistoryHostResponse)(nil),                 // 46: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	(*GetShardResponse)(nil),                            // 47: temporal.server.api.adminservice.v1.GetShardResponse
-	(*CloseShardResponse)(nil),                          // 48: temporal.server.api.adminservice.v1.CloseShardResponse
-	(*ListHistoryTasksResponse)(nil),                    // 49: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	(*RemoveTaskResponse)(nil),                          // 50: temporal.server.api.adminservice.v1.RemoveTaskResponse
-	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 51: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 52: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	(*GetReplicationMessagesResponse)(nil),              // 53: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	(*GetNamespaceReplicationMessagesResponse)(nil),     // 54: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	(*GetDLQReplicationMessagesResponse)(nil),           // 55: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	(*ReapplyEventsResponse)(nil),                       // 56: temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	(*AddSearchAttributesResponse)(nil),                 // 57: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	(*RemoveSearchAttributesResponse)(nil),              // 58: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	(*GetSearchAttributesResponse)(nil),                 // 59: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	(*DescribeClusterResponse)(nil),                     // 60: temporal.server.api.adminservice.v1.DescribeClusterResponse
-	(*ListClustersResponse)(nil),                        // 61: temporal.server.api.adminservice.v1.ListClustersResponse
-	(*ListClusterMembersResponse)(nil),                  // 62: temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	(*AddOrUpdateRemoteClusterResponse)(nil),            // 63: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	(*RemoveRemoteClusterResponse)(nil),                 // 64: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	(*GetDLQMessagesResponse)(nil),                      // 65: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	(*PurgeDLQMessagesResponse)(nil),                    // 66: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	(*MergeDLQMessagesResponse)(nil),                    // 67: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	(*RefreshWorkflowTasksResponse)(nil),                // 68: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	(*ResendReplicationTasksResponse)(nil),              // 69: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	(*GetTaskQueueTasksResponse)(nil),                   // 70: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	(*DeleteWorkflowExecutionResponse)(nil),             // 71: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 72: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	(*GetNamespaceResponse)(nil),                        // 73: temporal.server.api.adminservice.v1.GetNamespaceResponse
-	(*GetDLQTasksResponse)(nil),                         // 74: temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	(*PurgeDLQTasksResponse)(nil),                       // 75: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	(*MergeDLQTasksResponse)(nil),                       // 76: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	(*DescribeDLQJobResponse)(nil),                      // 77: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	(*CancelDLQJobResponse)(nil),                        // 78: temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	(*AddTasksResponse)(nil),                            // 79: temporal.server.api.adminservice.v1.AddTasksResponse
-	(*ListQueuesResponse)(nil),                          // 80: temporal.server.api.adminservice.v1.ListQueuesResponse
-	(*DeepHealthCheckResponse)(nil),                     // 81: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	(*SyncWorkflowStateResponse)(nil),                   // 82: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 83: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	(*DescribeTaskQueuePartitionResponse)(nil),          // 84: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 85: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	(*DeleteTaskQueueTasksRequest)(nil),                 // 28: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksRequest
+	(*StartTaskQueueBacklogMigrationRequest)(nil),       // 29: temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationRequest
+	(*DescribeTaskQueueBacklogMigrationRequest)(nil),    // 30: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationRequest
+	(*CancelTaskQueueBacklogMigrationRequest)(nil),      // 31: temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationRequest
+	(*UpdateTaskQueueFairnessWeightsRequest)(nil),       // 32: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest
+	(*DescribeTaskQueueFairnessKeysRequest)(nil),        // 33: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysRequest
+	(*UpdateTaskQueuePauseRequest)(nil),                 // 34: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest
+	(*UpdateTaskQueueBlockedPollersRequest)(nil),        // 35: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersRequest
+	(*CountWorkersRequest)(nil),                         // 36: temporal.server.api.adminservice.v1.CountWorkersRequest
+	(*PreviewScheduleRequest)(nil),                      // 37: temporal.server.api.adminservice.v1.PreviewScheduleRequest
+	(*ListScheduleActionsRequest)(nil),                  // 38: temporal.server.api.adminservice.v1.ListScheduleActionsRequest
+	(*UpdateScheduleCalendarSetRequest)(nil),            // 39: temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetRequest
+	(*ListScheduleCalendarSetsRequest)(nil),             // 40: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsRequest
+	(*ListCallbacksRequest)(nil),                        // 41: temporal.server.api.adminservice.v1.ListCallbacksRequest
+	(*RetryCallbackRequest)(nil),                        // 42: temporal.server.api.adminservice.v1.RetryCallbackRequest
+	(*GetNexusEndpointRequest)(nil),                     // 43: temporal.server.api.adminservice.v1.GetNexusEndpointRequest
+	(*ListNexusEndpointsRequest)(nil),                   // 44: temporal.server.api.adminservice.v1.ListNexusEndpointsRequest
+	(*SetNexusEndpointCircuitBreakerRequest)(nil),       // 45: temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerRequest
+	(*SetNexusEndpointAccessPolicyRequest)(nil),         // 46: temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyRequest
+	(*AggregateWorkflowExecutionsRequest)(nil),          // 47: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	(*ExplainWorkflowExecutionsRequest)(nil),            // 48: temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsRequest
+	(*StartVisibilityReindexRequest)(nil),               // 49: temporal.server.api.adminservice.v1.StartVisibilityReindexRequest
+	(*DescribeVisibilityReindexRequest)(nil),            // 50: temporal.server.api.adminservice.v1.DescribeVisibilityReindexRequest
+	(*CancelVisibilityReindexRequest)(nil),              // 51: temporal.server.api.adminservice.v1.CancelVisibilityReindexRequest
+	(*RenameSearchAttributeRequest)(nil),                // 52: temporal.server.api.adminservice.v1.RenameSearchAttributeRequest
+	(*StartSearchAttributeMigrationRequest)(nil),        // 53: temporal.server.api.adminservice.v1.StartSearchAttributeMigrationRequest
+	(*DescribeSearchAttributeMigrationRequest)(nil),     // 54: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationRequest
+	(*PutSavedVisibilityQueryRequest)(nil),              // 55: temporal.server.api.adminservice.v1.PutSavedVisibilityQueryRequest
+	(*DeleteSavedVisibilityQueryRequest)(nil),           // 56: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	(*ListSavedVisibilityQueriesRequest)(nil),           // 57: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	(*RunSavedVisibilityQueryRequest)(nil),              // 58: temporal.server.api.adminservice.v1.RunSavedVisibilityQueryRequest
+	(*DeleteWorkflowExecutionRequest)(nil),              // 59: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	(*StreamWorkflowReplicationMessagesRequest)(nil),    // 60: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	(*GetNamespaceRequest)(nil),                         // 61: temporal.server.api.adminservice.v1.GetNamespaceRequest
+	(*GetDLQTasksRequest)(nil),                          // 62: temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	(*PurgeDLQTasksRequest)(nil),                        // 63: temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	(*MergeDLQTasksRequest)(nil),                        // 64: temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	(*DescribeDLQJobRequest)(nil),                       // 65: temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	(*CancelDLQJobRequest)(nil),                         // 66: temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	(*AddTasksRequest)(nil),                             // 67: temporal.server.api.adminservice.v1.AddTasksRequest
+	(*ListQueuesRequest)(nil),                           // 68: temporal.server.api.adminservice.v1.ListQueuesRequest
+	(*DeepHealthCheckRequest)(nil),                      // 69: temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	(*SyncWorkflowStateRequest)(nil),                    // 70: temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	(*GenerateLastHistoryReplicationTasksRequest)(nil),  // 71: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	(*DescribeTaskQueuePartitionRequest)(nil),           // 72: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	(*ForceUnloadTaskQueuePartitionRequest)(nil),        // 73: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	(*RebuildMutableStateResponse)(nil),                 // 74: temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	(*ImportWorkflowExecutionResponse)(nil),             // 75: temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	(*DescribeMutableStateResponse)(nil),                // 76: temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	(*DescribeHistoryHostResponse)(nil),                 // 77: temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	(*GetShardResponse)(nil),                            // 78: temporal.server.api.adminservice.v1.GetShardResponse
+	(*CloseShardResponse)(nil),                          // 79: temporal.server.api.adminservice.v1.CloseShardResponse
+	(*ListHistoryTasksResponse)(nil),                    // 80: temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	(*RemoveTaskResponse)(nil),                          // 81: temporal.server.api.adminservice.v1.RemoveTaskResponse
+	(*GetWorkflowExecutionRawHistoryV2Response)(nil),    // 82: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	(*GetWorkflowExecutionRawHistoryResponse)(nil),      // 83: temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	(*GetReplicationMessagesResponse)(nil),              // 84: temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	(*GetNamespaceReplicationMessagesResponse)(nil),     // 85: temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	(*GetDLQReplicationMessagesResponse)(nil),           // 86: temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	(*ReapplyEventsResponse)(nil),                       // 87: temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	(*AddSearchAttributesResponse)(nil),                 // 88: temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	(*RemoveSearchAttributesResponse)(nil),              // 89: temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	(*GetSearchAttributesResponse)(nil),                 // 90: temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	(*DescribeClusterResponse)(nil),                     // 91: temporal.server.api.adminservice.v1.DescribeClusterResponse
+	(*ListClustersResponse)(nil),                        // 92: temporal.server.api.adminservice.v1.ListClustersResponse
+	(*ListClusterMembersResponse)(nil),                  // 93: temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	(*AddOrUpdateRemoteClusterResponse)(nil),            // 94: temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	(*RemoveRemoteClusterResponse)(nil),                 // 95: temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	(*GetDLQMessagesResponse)(nil),                      // 96: temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	(*PurgeDLQMessagesResponse)(nil),                    // 97: temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	(*MergeDLQMessagesResponse)(nil),                    // 98: temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	(*RefreshWorkflowTasksResponse)(nil),                // 99: temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	(*ResendReplicationTasksResponse)(nil),              // 100: temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	(*GetTaskQueueTasksResponse)(nil),                   // 101: temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	(*DeleteTaskQueueTasksResponse)(nil),                // 102: temporal.server.api.adminservice.v1.DeleteTaskQueueTasksResponse
+	(*StartTaskQueueBacklogMigrationResponse)(nil),      // 103: temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationResponse
+	(*DescribeTaskQueueBacklogMigrationResponse)(nil),   // 104: temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse
+	(*CancelTaskQueueBacklogMigrationResponse)(nil),     // 105: temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationResponse
+	(*UpdateTaskQueueFairnessWeightsResponse)(nil),      // 106: temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsResponse
+	(*DescribeTaskQueueFairnessKeysResponse)(nil),       // 107: temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse
+	(*UpdateTaskQueuePauseResponse)(nil),                // 108: temporal.server.api.adminservice.v1.UpdateTaskQueuePauseResponse
+	(*UpdateTaskQueueBlockedPollersResponse)(nil),       // 109: temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersResponse
+	(*CountWorkersResponse)(nil),                        // 110: temporal.server.api.adminservice.v1.CountWorkersResponse
+	(*PreviewScheduleResponse)(nil),                     // 111: temporal.server.api.adminservice.v1.PreviewScheduleResponse
+	(*ListScheduleActionsResponse)(nil),                 // 112: temporal.server.api.adminservice.v1.ListScheduleActionsResponse
+	(*UpdateScheduleCalendarSetResponse)(nil),           // 113: temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetResponse
+	(*ListScheduleCalendarSetsResponse)(nil),            // 114: temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse
+	(*ListCallbacksResponse)(nil),                       // 115: temporal.server.api.adminservice.v1.ListCallbacksResponse
+	(*RetryCallbackResponse)(nil),                       // 116: temporal.server.api.adminservice.v1.RetryCallbackResponse
+	(*GetNexusEndpointResponse)(nil),                    // 117: temporal.server.api.adminservice.v1.GetNexusEndpointResponse
+	(*ListNexusEndpointsResponse)(nil),                  // 118: temporal.server.api.adminservice.v1.ListNexusEndpointsResponse
+	(*SetNexusEndpointCircuitBreakerResponse)(nil),      // 119: temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerResponse
+	(*SetNexusEndpointAccessPolicyResponse)(nil),        // 120: temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyResponse
+	(*AggregateWorkflowExecutionsResponse)(nil),         // 121: temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	(*ExplainWorkflowExecutionsResponse)(nil),           // 122: temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsResponse
+	(*StartVisibilityReindexResponse)(nil),              // 123: temporal.server.api.adminservice.v1.StartVisibilityReindexResponse
+	(*DescribeVisibilityReindexResponse)(nil),           // 124: temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse
+	(*CancelVisibilityReindexResponse)(nil),             // 125: temporal.server.api.adminservice.v1.CancelVisibilityReindexResponse
+	(*RenameSearchAttributeResponse)(nil),               // 126: temporal.server.api.adminservice.v1.RenameSearchAttributeResponse
+	(*StartSearchAttributeMigrationResponse)(nil),       // 127: temporal.server.api.adminservice.v1.StartSearchAttributeMigrationResponse
+	(*DescribeSearchAttributeMigrationResponse)(nil),    // 128: temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse
+	(*PutSavedVisibilityQueryResponse)(nil),             // 129: temporal.server.api.adminservice.v1.PutSavedVisibilityQueryResponse
+	(*DeleteSavedVisibilityQueryResponse)(nil),          // 130: temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	(*ListSavedVisibilityQueriesResponse)(nil),          // 131: temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	(*RunSavedVisibilityQueryResponse)(nil),             // 132: temporal.server.api.adminservice.v1.RunSavedVisibilityQueryResponse
+	(*DeleteWorkflowExecutionResponse)(nil),             // 133: temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	(*StreamWorkflowReplicationMessagesResponse)(nil),   // 134: temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	(*GetNamespaceResponse)(nil),                        // 135: temporal.server.api.adminservice.v1.GetNamespaceResponse
+	(*GetDLQTasksResponse)(nil),                         // 136: temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	(*PurgeDLQTasksResponse)(nil),                       // 137: temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	(*MergeDLQTasksResponse)(nil),                       // 138: temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	(*DescribeDLQJobResponse)(nil),                      // 139: temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	(*CancelDLQJobResponse)(nil),                        // 140: temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	(*AddTasksResponse)(nil),                            // 141: temporal.server.api.adminservice.v1.AddTasksResponse
+	(*ListQueuesResponse)(nil),                          // 142: temporal.server.api.adminservice.v1.ListQueuesResponse
+	(*DeepHealthCheckResponse)(nil),                     // 143: temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	(*SyncWorkflowStateResponse)(nil),                   // 144: temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	(*GenerateLastHistoryReplicationTasksResponse)(nil), // 145: temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	(*DescribeTaskQueuePartitionResponse)(nil),          // 146: temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	(*ForceUnloadTaskQueuePartitionResponse)(nil),       // 147: temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
 }
 var file_temporal_server_api_adminservice_v1_service_proto_depIdxs = []int32{
-	0,  // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
-	1,  // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
-	2,  // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
-	3,  // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
-	4,  // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
-	5,  // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
-	6,  // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
-	7,  // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
-	8,  // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
-	9,  // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
-	10, // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
-	11, // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
-	12, // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
-	13, // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
-	14, // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
-	15, // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
-	16, // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
-	17, // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
-	18, // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
-	19, // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
-	20, // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
-	21, // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
-	22, // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
-	23, // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
-	24, // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
-	25, // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
-	26, // 26: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
-	27, // 27: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
-	28, // 28: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
-	29, // 29: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
-	30, // 30: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
-	31, // 31: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
-	32, // 32: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
-	33, // 33: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
-	34, // 34: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
-	35, // 35: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
-	36, // 36: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
-	37, // 37: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
-	38, // 38: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
-	39, // 39: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
-	40, // 40: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
-	41, // 41: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
-	42, // 42: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
-	43, // 43: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
-	44, // 44: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
-	45, // 45: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
-	46, // 46: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
-	47, // 47: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
-	48, // 48: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
-	49, // 49: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
-	50, // 50: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
-	51, // 51: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
-	52, // 52: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
-	53, // 53: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
-	54, // 54: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
-	55, // 55: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
-	56, // 56: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
-	57, // 57: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
-	58, // 58: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
-	59, // 59: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
-	60, // 60: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
-	61, // 61: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
-	62, // 62: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
-	63, // 63: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
-	64, // 64: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
-	65, // 65: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
-	66, // 66: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
-	67, // 67: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
-	68, // 68: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
-	69, // 69: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
-	70, // 70: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
-	71, // 71: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
-	72, // 72: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
-	73, // 73: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
-	74, // 74: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
-	75, // 75: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
-	76, // 76: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
-	77, // 77: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
-	78, // 78: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
-	79, // 79: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
-	80, // 80: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
-	81, // 81: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
-	82, // 82: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
-	83, // 83: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
-	84, // 84: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
-	85, // 85: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
-	43, // [43:86] is the sub-list for method output_type
-	0,  // [0:43] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:input_type -> temporal.server.api.adminservice.v1.RebuildMutableStateRequest
+	1,   // 1: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionRequest
+	2,   // 2: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:input_type -> temporal.server.api.adminservice.v1.DescribeMutableStateRequest
+	3,   // 3: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:input_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostRequest
+	4,   // 4: temporal.server.api.adminservice.v1.AdminService.GetShard:input_type -> temporal.server.api.adminservice.v1.GetShardRequest
+	5,   // 5: temporal.server.api.adminservice.v1.AdminService.CloseShard:input_type -> temporal.server.api.adminservice.v1.CloseShardRequest
+	6,   // 6: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:input_type -> temporal.server.api.adminservice.v1.ListHistoryTasksRequest
+	7,   // 7: temporal.server.api.adminservice.v1.AdminService.RemoveTask:input_type -> temporal.server.api.adminservice.v1.RemoveTaskRequest
+	8,   // 8: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request
+	9,   // 9: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:input_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryRequest
+	10,  // 10: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesRequest
+	11,  // 11: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesRequest
+	12,  // 12: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesRequest
+	13,  // 13: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:input_type -> temporal.server.api.adminservice.v1.ReapplyEventsRequest
+	14,  // 14: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:input_type -> temporal.server.api.adminservice.v1.AddSearchAttributesRequest
+	15,  // 15: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:input_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesRequest
+	16,  // 16: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:input_type -> temporal.server.api.adminservice.v1.GetSearchAttributesRequest
+	17,  // 17: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:input_type -> temporal.server.api.adminservice.v1.DescribeClusterRequest
+	18,  // 18: temporal.server.api.adminservice.v1.AdminService.ListClusters:input_type -> temporal.server.api.adminservice.v1.ListClustersRequest
+	19,  // 19: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:input_type -> temporal.server.api.adminservice.v1.ListClusterMembersRequest
+	20,  // 20: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:input_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterRequest
+	21,  // 21: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:input_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterRequest
+	22,  // 22: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:input_type -> temporal.server.api.adminservice.v1.GetDLQMessagesRequest
+	23,  // 23: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:input_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesRequest
+	24,  // 24: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:input_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesRequest
+	25,  // 25: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:input_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksRequest
+	26,  // 26: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:input_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksRequest
+	27,  // 27: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest
+	28,  // 28: temporal.server.api.adminservice.v1.AdminService.DeleteTaskQueueTasks:input_type -> temporal.server.api.adminservice.v1.DeleteTaskQueueTasksRequest
+	29,  // 29: temporal.server.api.adminservice.v1.AdminService.StartTaskQueueBacklogMigration:input_type -> temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationRequest
+	30,  // 30: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueueBacklogMigration:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationRequest
+	31,  // 31: temporal.server.api.adminservice.v1.AdminService.CancelTaskQueueBacklogMigration:input_type -> temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationRequest
+	32,  // 32: temporal.server.api.adminservice.v1.AdminService.UpdateTaskQueueFairnessWeights:input_type -> temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsRequest
+	33,  // 33: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueueFairnessKeys:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysRequest
+	34,  // 34: temporal.server.api.adminservice.v1.AdminService.UpdateTaskQueuePause:input_type -> temporal.server.api.adminservice.v1.UpdateTaskQueuePauseRequest
+	35,  // 35: temporal.server.api.adminservice.v1.AdminService.UpdateTaskQueueBlockedPollers:input_type -> temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersRequest
+	36,  // 36: temporal.server.api.adminservice.v1.AdminService.CountWorkers:input_type -> temporal.server.api.adminservice.v1.CountWorkersRequest
+	37,  // 37: temporal.server.api.adminservice.v1.AdminService.PreviewSchedule:input_type -> temporal.server.api.adminservice.v1.PreviewScheduleRequest
+	38,  // 38: temporal.server.api.adminservice.v1.AdminService.ListScheduleActions:input_type -> temporal.server.api.adminservice.v1.ListScheduleActionsRequest
+	39,  // 39: temporal.server.api.adminservice.v1.AdminService.UpdateScheduleCalendarSet:input_type -> temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetRequest
+	40,  // 40: temporal.server.api.adminservice.v1.AdminService.ListScheduleCalendarSets:input_type -> temporal.server.api.adminservice.v1.ListScheduleCalendarSetsRequest
+	41,  // 41: temporal.server.api.adminservice.v1.AdminService.ListCallbacks:input_type -> temporal.server.api.adminservice.v1.ListCallbacksRequest
+	42,  // 42: temporal.server.api.adminservice.v1.AdminService.RetryCallback:input_type -> temporal.server.api.adminservice.v1.RetryCallbackRequest
+	43,  // 43: temporal.server.api.adminservice.v1.AdminService.GetNexusEndpoint:input_type -> temporal.server.api.adminservice.v1.GetNexusEndpointRequest
+	44,  // 44: temporal.server.api.adminservice.v1.AdminService.ListNexusEndpoints:input_type -> temporal.server.api.adminservice.v1.ListNexusEndpointsRequest
+	45,  // 45: temporal.server.api.adminservice.v1.AdminService.SetNexusEndpointCircuitBreaker:input_type -> temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerRequest
+	46,  // 46: temporal.server.api.adminservice.v1.AdminService.SetNexusEndpointAccessPolicy:input_type -> temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyRequest
+	47,  // 47: temporal.server.api.adminservice.v1.AdminService.AggregateWorkflowExecutions:input_type -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsRequest
+	48,  // 48: temporal.server.api.adminservice.v1.AdminService.ExplainWorkflowExecutions:input_type -> temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsRequest
+	49,  // 49: temporal.server.api.adminservice.v1.AdminService.StartVisibilityReindex:input_type -> temporal.server.api.adminservice.v1.StartVisibilityReindexRequest
+	50,  // 50: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityReindex:input_type -> temporal.server.api.adminservice.v1.DescribeVisibilityReindexRequest
+	51,  // 51: temporal.server.api.adminservice.v1.AdminService.CancelVisibilityReindex:input_type -> temporal.server.api.adminservice.v1.CancelVisibilityReindexRequest
+	52,  // 52: temporal.server.api.adminservice.v1.AdminService.RenameSearchAttribute:input_type -> temporal.server.api.adminservice.v1.RenameSearchAttributeRequest
+	53,  // 53: temporal.server.api.adminservice.v1.AdminService.StartSearchAttributeMigration:input_type -> temporal.server.api.adminservice.v1.StartSearchAttributeMigrationRequest
+	54,  // 54: temporal.server.api.adminservice.v1.AdminService.DescribeSearchAttributeMigration:input_type -> temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationRequest
+	55,  // 55: temporal.server.api.adminservice.v1.AdminService.PutSavedVisibilityQuery:input_type -> temporal.server.api.adminservice.v1.PutSavedVisibilityQueryRequest
+	56,  // 56: temporal.server.api.adminservice.v1.AdminService.DeleteSavedVisibilityQuery:input_type -> temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryRequest
+	57,  // 57: temporal.server.api.adminservice.v1.AdminService.ListSavedVisibilityQueries:input_type -> temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesRequest
+	58,  // 58: temporal.server.api.adminservice.v1.AdminService.RunSavedVisibilityQuery:input_type -> temporal.server.api.adminservice.v1.RunSavedVisibilityQueryRequest
+	59,  // 59: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:input_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest
+	60,  // 60: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:input_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest
+	61,  // 61: temporal.server.api.adminservice.v1.AdminService.GetNamespace:input_type -> temporal.server.api.adminservice.v1.GetNamespaceRequest
+	62,  // 62: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:input_type -> temporal.server.api.adminservice.v1.GetDLQTasksRequest
+	63,  // 63: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:input_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksRequest
+	64,  // 64: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:input_type -> temporal.server.api.adminservice.v1.MergeDLQTasksRequest
+	65,  // 65: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:input_type -> temporal.server.api.adminservice.v1.DescribeDLQJobRequest
+	66,  // 66: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:input_type -> temporal.server.api.adminservice.v1.CancelDLQJobRequest
+	67,  // 67: temporal.server.api.adminservice.v1.AdminService.AddTasks:input_type -> temporal.server.api.adminservice.v1.AddTasksRequest
+	68,  // 68: temporal.server.api.adminservice.v1.AdminService.ListQueues:input_type -> temporal.server.api.adminservice.v1.ListQueuesRequest
+	69,  // 69: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:input_type -> temporal.server.api.adminservice.v1.DeepHealthCheckRequest
+	70,  // 70: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:input_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateRequest
+	71,  // 71: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:input_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksRequest
+	72,  // 72: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionRequest
+	73,  // 73: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:input_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionRequest
+	74,  // 74: temporal.server.api.adminservice.v1.AdminService.RebuildMutableState:output_type -> temporal.server.api.adminservice.v1.RebuildMutableStateResponse
+	75,  // 75: temporal.server.api.adminservice.v1.AdminService.ImportWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.ImportWorkflowExecutionResponse
+	76,  // 76: temporal.server.api.adminservice.v1.AdminService.DescribeMutableState:output_type -> temporal.server.api.adminservice.v1.DescribeMutableStateResponse
+	77,  // 77: temporal.server.api.adminservice.v1.AdminService.DescribeHistoryHost:output_type -> temporal.server.api.adminservice.v1.DescribeHistoryHostResponse
+	78,  // 78: temporal.server.api.adminservice.v1.AdminService.GetShard:output_type -> temporal.server.api.adminservice.v1.GetShardResponse
+	79,  // 79: temporal.server.api.adminservice.v1.AdminService.CloseShard:output_type -> temporal.server.api.adminservice.v1.CloseShardResponse
+	80,  // 80: temporal.server.api.adminservice.v1.AdminService.ListHistoryTasks:output_type -> temporal.server.api.adminservice.v1.ListHistoryTasksResponse
+	81,  // 81: temporal.server.api.adminservice.v1.AdminService.RemoveTask:output_type -> temporal.server.api.adminservice.v1.RemoveTaskResponse
+	82,  // 82: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistoryV2:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response
+	83,  // 83: temporal.server.api.adminservice.v1.AdminService.GetWorkflowExecutionRawHistory:output_type -> temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryResponse
+	84,  // 84: temporal.server.api.adminservice.v1.AdminService.GetReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetReplicationMessagesResponse
+	85,  // 85: temporal.server.api.adminservice.v1.AdminService.GetNamespaceReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetNamespaceReplicationMessagesResponse
+	86,  // 86: temporal.server.api.adminservice.v1.AdminService.GetDLQReplicationMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQReplicationMessagesResponse
+	87,  // 87: temporal.server.api.adminservice.v1.AdminService.ReapplyEvents:output_type -> temporal.server.api.adminservice.v1.ReapplyEventsResponse
+	88,  // 88: temporal.server.api.adminservice.v1.AdminService.AddSearchAttributes:output_type -> temporal.server.api.adminservice.v1.AddSearchAttributesResponse
+	89,  // 89: temporal.server.api.adminservice.v1.AdminService.RemoveSearchAttributes:output_type -> temporal.server.api.adminservice.v1.RemoveSearchAttributesResponse
+	90,  // 90: temporal.server.api.adminservice.v1.AdminService.GetSearchAttributes:output_type -> temporal.server.api.adminservice.v1.GetSearchAttributesResponse
+	91,  // 91: temporal.server.api.adminservice.v1.AdminService.DescribeCluster:output_type -> temporal.server.api.adminservice.v1.DescribeClusterResponse
+	92,  // 92: temporal.server.api.adminservice.v1.AdminService.ListClusters:output_type -> temporal.server.api.adminservice.v1.ListClustersResponse
+	93,  // 93: temporal.server.api.adminservice.v1.AdminService.ListClusterMembers:output_type -> temporal.server.api.adminservice.v1.ListClusterMembersResponse
+	94,  // 94: temporal.server.api.adminservice.v1.AdminService.AddOrUpdateRemoteCluster:output_type -> temporal.server.api.adminservice.v1.AddOrUpdateRemoteClusterResponse
+	95,  // 95: temporal.server.api.adminservice.v1.AdminService.RemoveRemoteCluster:output_type -> temporal.server.api.adminservice.v1.RemoveRemoteClusterResponse
+	96,  // 96: temporal.server.api.adminservice.v1.AdminService.GetDLQMessages:output_type -> temporal.server.api.adminservice.v1.GetDLQMessagesResponse
+	97,  // 97: temporal.server.api.adminservice.v1.AdminService.PurgeDLQMessages:output_type -> temporal.server.api.adminservice.v1.PurgeDLQMessagesResponse
+	98,  // 98: temporal.server.api.adminservice.v1.AdminService.MergeDLQMessages:output_type -> temporal.server.api.adminservice.v1.MergeDLQMessagesResponse
+	99,  // 99: temporal.server.api.adminservice.v1.AdminService.RefreshWorkflowTasks:output_type -> temporal.server.api.adminservice.v1.RefreshWorkflowTasksResponse
+	100, // 100: temporal.server.api.adminservice.v1.AdminService.ResendReplicationTasks:output_type -> temporal.server.api.adminservice.v1.ResendReplicationTasksResponse
+	101, // 101: temporal.server.api.adminservice.v1.AdminService.GetTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse
+	102, // 102: temporal.server.api.adminservice.v1.AdminService.DeleteTaskQueueTasks:output_type -> temporal.server.api.adminservice.v1.DeleteTaskQueueTasksResponse
+	103, // 103: temporal.server.api.adminservice.v1.AdminService.StartTaskQueueBacklogMigration:output_type -> temporal.server.api.adminservice.v1.StartTaskQueueBacklogMigrationResponse
+	104, // 104: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueueBacklogMigration:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueueBacklogMigrationResponse
+	105, // 105: temporal.server.api.adminservice.v1.AdminService.CancelTaskQueueBacklogMigration:output_type -> temporal.server.api.adminservice.v1.CancelTaskQueueBacklogMigrationResponse
+	106, // 106: temporal.server.api.adminservice.v1.AdminService.UpdateTaskQueueFairnessWeights:output_type -> temporal.server.api.adminservice.v1.UpdateTaskQueueFairnessWeightsResponse
+	107, // 107: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueueFairnessKeys:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueueFairnessKeysResponse
+	108, // 108: temporal.server.api.adminservice.v1.AdminService.UpdateTaskQueuePause:output_type -> temporal.server.api.adminservice.v1.UpdateTaskQueuePauseResponse
+	109, // 109: temporal.server.api.adminservice.v1.AdminService.UpdateTaskQueueBlockedPollers:output_type -> temporal.server.api.adminservice.v1.UpdateTaskQueueBlockedPollersResponse
+	110, // 110: temporal.server.api.adminservice.v1.AdminService.CountWorkers:output_type -> temporal.server.api.adminservice.v1.CountWorkersResponse
+	111, // 111: temporal.server.api.adminservice.v1.AdminService.PreviewSchedule:output_type -> temporal.server.api.adminservice.v1.PreviewScheduleResponse
+	112, // 112: temporal.server.api.adminservice.v1.AdminService.ListScheduleActions:output_type -> temporal.server.api.adminservice.v1.ListScheduleActionsResponse
+	113, // 113: temporal.server.api.adminservice.v1.AdminService.UpdateScheduleCalendarSet:output_type -> temporal.server.api.adminservice.v1.UpdateScheduleCalendarSetResponse
+	114, // 114: temporal.server.api.adminservice.v1.AdminService.ListScheduleCalendarSets:output_type -> temporal.server.api.adminservice.v1.ListScheduleCalendarSetsResponse
+	115, // 115: temporal.server.api.adminservice.v1.AdminService.ListCallbacks:output_type -> temporal.server.api.adminservice.v1.ListCallbacksResponse
+	116, // 116: temporal.server.api.adminservice.v1.AdminService.RetryCallback:output_type -> temporal.server.api.adminservice.v1.RetryCallbackResponse
+	117, // 117: temporal.server.api.adminservice.v1.AdminService.GetNexusEndpoint:output_type -> temporal.server.api.adminservice.v1.GetNexusEndpointResponse
+	118, // 118: temporal.server.api.adminservice.v1.AdminService.ListNexusEndpoints:output_type -> temporal.server.api.adminservice.v1.ListNexusEndpointsResponse
+	119, // 119: temporal.server.api.adminservice.v1.AdminService.SetNexusEndpointCircuitBreaker:output_type -> temporal.server.api.adminservice.v1.SetNexusEndpointCircuitBreakerResponse
+	120, // 120: temporal.server.api.adminservice.v1.AdminService.SetNexusEndpointAccessPolicy:output_type -> temporal.server.api.adminservice.v1.SetNexusEndpointAccessPolicyResponse
+	121, // 121: temporal.server.api.adminservice.v1.AdminService.AggregateWorkflowExecutions:output_type -> temporal.server.api.adminservice.v1.AggregateWorkflowExecutionsResponse
+	122, // 122: temporal.server.api.adminservice.v1.AdminService.ExplainWorkflowExecutions:output_type -> temporal.server.api.adminservice.v1.ExplainWorkflowExecutionsResponse
+	123, // 123: temporal.server.api.adminservice.v1.AdminService.StartVisibilityReindex:output_type -> temporal.server.api.adminservice.v1.StartVisibilityReindexResponse
+	124, // 124: temporal.server.api.adminservice.v1.AdminService.DescribeVisibilityReindex:output_type -> temporal.server.api.adminservice.v1.DescribeVisibilityReindexResponse
+	125, // 125: temporal.server.api.adminservice.v1.AdminService.CancelVisibilityReindex:output_type -> temporal.server.api.adminservice.v1.CancelVisibilityReindexResponse
+	126, // 126: temporal.server.api.adminservice.v1.AdminService.RenameSearchAttribute:output_type -> temporal.server.api.adminservice.v1.RenameSearchAttributeResponse
+	127, // 127: temporal.server.api.adminservice.v1.AdminService.StartSearchAttributeMigration:output_type -> temporal.server.api.adminservice.v1.StartSearchAttributeMigrationResponse
+	128, // 128: temporal.server.api.adminservice.v1.AdminService.DescribeSearchAttributeMigration:output_type -> temporal.server.api.adminservice.v1.DescribeSearchAttributeMigrationResponse
+	129, // 129: temporal.server.api.adminservice.v1.AdminService.PutSavedVisibilityQuery:output_type -> temporal.server.api.adminservice.v1.PutSavedVisibilityQueryResponse
+	130, // 130: temporal.server.api.adminservice.v1.AdminService.DeleteSavedVisibilityQuery:output_type -> temporal.server.api.adminservice.v1.DeleteSavedVisibilityQueryResponse
+	131, // 131: temporal.server.api.adminservice.v1.AdminService.ListSavedVisibilityQueries:output_type -> temporal.server.api.adminservice.v1.ListSavedVisibilityQueriesResponse
+	132, // 132: temporal.server.api.adminservice.v1.AdminService.RunSavedVisibilityQuery:output_type -> temporal.server.api.adminservice.v1.RunSavedVisibilityQueryResponse
+	133, // 133: temporal.server.api.adminservice.v1.AdminService.DeleteWorkflowExecution:output_type -> temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse
+	134, // 134: temporal.server.api.adminservice.v1.AdminService.StreamWorkflowReplicationMessages:output_type -> temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesResponse
+	135, // 135: temporal.server.api.adminservice.v1.AdminService.GetNamespace:output_type -> temporal.server.api.adminservice.v1.GetNamespaceResponse
+	136, // 136: temporal.server.api.adminservice.v1.AdminService.GetDLQTasks:output_type -> temporal.server.api.adminservice.v1.GetDLQTasksResponse
+	137, // 137: temporal.server.api.adminservice.v1.AdminService.PurgeDLQTasks:output_type -> temporal.server.api.adminservice.v1.PurgeDLQTasksResponse
+	138, // 138: temporal.server.api.adminservice.v1.AdminService.MergeDLQTasks:output_type -> temporal.server.api.adminservice.v1.MergeDLQTasksResponse
+	139, // 139: temporal.server.api.adminservice.v1.AdminService.DescribeDLQJob:output_type -> temporal.server.api.adminservice.v1.DescribeDLQJobResponse
+	140, // 140: temporal.server.api.adminservice.v1.AdminService.CancelDLQJob:output_type -> temporal.server.api.adminservice.v1.CancelDLQJobResponse
+	141, // 141: temporal.server.api.adminservice.v1.AdminService.AddTasks:output_type -> temporal.server.api.adminservice.v1.AddTasksResponse
+	142, // 142: temporal.server.api.adminservice.v1.AdminService.ListQueues:output_type -> temporal.server.api.adminservice.v1.ListQueuesResponse
+	143, // 143: temporal.server.api.adminservice.v1.AdminService.DeepHealthCheck:output_type -> temporal.server.api.adminservice.v1.DeepHealthCheckResponse
+	144, // 144: temporal.server.api.adminservice.v1.AdminService.SyncWorkflowState:output_type -> temporal.server.api.adminservice.v1.SyncWorkflowStateResponse
+	145, // 145: temporal.server.api.adminservice.v1.AdminService.GenerateLastHistoryReplicationTasks:output_type -> temporal.server.api.adminservice.v1.GenerateLastHistoryReplicationTasksResponse
+	146, // 146: temporal.server.api.adminservice.v1.AdminService.DescribeTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.DescribeTaskQueuePartitionResponse
+	147, // 147: temporal.server.api.adminservice.v1.AdminService.ForceUnloadTaskQueuePartition:output_type -> temporal.server.api.adminservice.v1.ForceUnloadTaskQueuePartitionResponse
+	74,  // [74:148] is the sub-list for method output_type
+	0,   // [0:74] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_adminservice_v1_service_proto_init() }
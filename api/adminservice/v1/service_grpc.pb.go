@@ -48,6 +48,37 @@ const (
 	AdminService_RefreshWorkflowTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/RefreshWorkflowTasks"
 	AdminService_ResendReplicationTasks_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/ResendReplicationTasks"
 	AdminService_GetTaskQueueTasks_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/GetTaskQueueTasks"
+	AdminService_DeleteTaskQueueTasks_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/DeleteTaskQueueTasks"
+	AdminService_StartTaskQueueBacklogMigration_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/StartTaskQueueBacklogMigration"
+	AdminService_DescribeTaskQueueBacklogMigration_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueBacklogMigration"
+	AdminService_CancelTaskQueueBacklogMigration_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/CancelTaskQueueBacklogMigration"
+	AdminService_UpdateTaskQueueFairnessWeights_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/UpdateTaskQueueFairnessWeights"
+	AdminService_DescribeTaskQueueFairnessKeys_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueueFairnessKeys"
+	AdminService_UpdateTaskQueuePause_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/UpdateTaskQueuePause"
+	AdminService_UpdateTaskQueueBlockedPollers_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/UpdateTaskQueueBlockedPollers"
+	AdminService_CountWorkers_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/CountWorkers"
+	AdminService_PreviewSchedule_FullMethodName                     = "/temporal.server.api.adminservice.v1.AdminService/PreviewSchedule"
+	AdminService_ListScheduleActions_FullMethodName                 = "/temporal.server.api.adminservice.v1.AdminService/ListScheduleActions"
+	AdminService_UpdateScheduleCalendarSet_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/UpdateScheduleCalendarSet"
+	AdminService_ListScheduleCalendarSets_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ListScheduleCalendarSets"
+	AdminService_ListCallbacks_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/ListCallbacks"
+	AdminService_RetryCallback_FullMethodName                       = "/temporal.server.api.adminservice.v1.AdminService/RetryCallback"
+	AdminService_GetNexusEndpoint_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/GetNexusEndpoint"
+	AdminService_ListNexusEndpoints_FullMethodName                  = "/temporal.server.api.adminservice.v1.AdminService/ListNexusEndpoints"
+	AdminService_SetNexusEndpointCircuitBreaker_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/SetNexusEndpointCircuitBreaker"
+	AdminService_SetNexusEndpointAccessPolicy_FullMethodName        = "/temporal.server.api.adminservice.v1.AdminService/SetNexusEndpointAccessPolicy"
+	AdminService_AggregateWorkflowExecutions_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/AggregateWorkflowExecutions"
+	AdminService_ExplainWorkflowExecutions_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ExplainWorkflowExecutions"
+	AdminService_StartVisibilityReindex_FullMethodName              = "/temporal.server.api.adminservice.v1.AdminService/StartVisibilityReindex"
+	AdminService_DescribeVisibilityReindex_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/DescribeVisibilityReindex"
+	AdminService_CancelVisibilityReindex_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/CancelVisibilityReindex"
+	AdminService_RenameSearchAttribute_FullMethodName               = "/temporal.server.api.adminservice.v1.AdminService/RenameSearchAttribute"
+	AdminService_StartSearchAttributeMigration_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/StartSearchAttributeMigration"
+	AdminService_DescribeSearchAttributeMigration_FullMethodName    = "/temporal.server.api.adminservice.v1.AdminService/DescribeSearchAttributeMigration"
+	AdminService_PutSavedVisibilityQuery_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/PutSavedVisibilityQuery"
+	AdminService_DeleteSavedVisibilityQuery_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DeleteSavedVisibilityQuery"
+	AdminService_ListSavedVisibilityQueries_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/ListSavedVisibilityQueries"
+	AdminService_RunSavedVisibilityQuery_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/RunSavedVisibilityQuery"
 	AdminService_DeleteWorkflowExecution_FullMethodName             = "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution"
 	AdminService_StreamWorkflowReplicationMessages_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/StreamWorkflowReplicationMessages"
 	AdminService_GetNamespace_FullMethodName                        = "/temporal.server.api.adminservice.v1.AdminService/GetNamespace"
@@ -134,6 +165,96 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// DeleteTaskQueueTasks deletes tasks matching a filter from a task queue backlog, optionally
+	// moving them to another task queue first. A loaded task queue partition may still dispatch
+	// tasks it has already read into memory; unload the partition to avoid that.
+	DeleteTaskQueueTasks(ctx context.Context, in *DeleteTaskQueueTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueTasksResponse, error)
+	// StartTaskQueueBacklogMigration starts a system workflow that moves the backlog of a task
+	// queue to another task queue. Only one migration per source task queue can run at a time.
+	// The source task queue is paused while its backlog is moved and resumed afterwards, unless it
+	// was already paused. Migrations of task queues with a draining, scheduled or expiring pause,
+	// and of task queues that use worker versioning, are rejected.
+	StartTaskQueueBacklogMigration(ctx context.Context, in *StartTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*StartTaskQueueBacklogMigrationResponse, error)
+	// DescribeTaskQueueBacklogMigration returns the progress of the latest backlog migration of a task queue.
+	DescribeTaskQueueBacklogMigration(ctx context.Context, in *DescribeTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogMigrationResponse, error)
+	// CancelTaskQueueBacklogMigration stops a running backlog migration and resumes the source task
+	// queue. Tasks that were already moved stay on the destination task queue.
+	CancelTaskQueueBacklogMigration(ctx context.Context, in *CancelTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*CancelTaskQueueBacklogMigrationResponse, error)
+	// UpdateTaskQueueFairnessWeights sets fairness key weight overrides and the default fairness
+	// weight of a task queue.
+	UpdateTaskQueueFairnessWeights(ctx context.Context, in *UpdateTaskQueueFairnessWeightsRequest, opts ...grpc.CallOption) (*UpdateTaskQueueFairnessWeightsResponse, error)
+	// DescribeTaskQueueFairnessKeys returns the fairness keys of a task queue partition with the
+	// largest backlogs and dispatch rates.
+	DescribeTaskQueueFairnessKeys(ctx context.Context, in *DescribeTaskQueueFairnessKeysRequest, opts ...grpc.CallOption) (*DescribeTaskQueueFairnessKeysResponse, error)
+	// UpdateTaskQueuePause pauses or drains a task queue, optionally for a scheduled time range, or removes its pause.
+	UpdateTaskQueuePause(ctx context.Context, in *UpdateTaskQueuePauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePauseResponse, error)
+	// UpdateTaskQueueBlockedPollers blocks poller identities from receiving tasks from a task queue for some time, or
+	// unblocks them.
+	UpdateTaskQueueBlockedPollers(ctx context.Context, in *UpdateTaskQueueBlockedPollersRequest, opts ...grpc.CallOption) (*UpdateTaskQueueBlockedPollersResponse, error)
+	// CountWorkers counts the workers of a namespace that match a ListWorkers query, optionally grouped by worker
+	// properties with a GROUP BY clause.
+	CountWorkers(ctx context.Context, in *CountWorkersRequest, opts ...grpc.CallOption) (*CountWorkersResponse, error)
+	// PreviewSchedule returns the actions that a schedule with the given spec and policies would take in a time
+	// range, without creating it.
+	PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...grpc.CallOption) (*PreviewScheduleResponse, error)
+	// ListScheduleActions lists the workflows that a schedule started, from visibility, and the actions that it
+	// recently skipped.
+	ListScheduleActions(ctx context.Context, in *ListScheduleActionsRequest, opts ...grpc.CallOption) (*ListScheduleActionsResponse, error)
+	// UpdateScheduleCalendarSet creates, replaces or deletes a named calendar set of a namespace. Schedules copy the
+	// days of the sets they reference when they're created or updated, so changes apply to a schedule on its next
+	// update.
+	UpdateScheduleCalendarSet(ctx context.Context, in *UpdateScheduleCalendarSetRequest, opts ...grpc.CallOption) (*UpdateScheduleCalendarSetResponse, error)
+	// ListScheduleCalendarSets returns the calendar sets of a namespace.
+	ListScheduleCalendarSets(ctx context.Context, in *ListScheduleCalendarSetsRequest, opts ...grpc.CallOption) (*ListScheduleCalendarSetsResponse, error)
+	// ListCallbacks lists the callbacks of the workflows matching a visibility query, optionally filtered by state.
+	ListCallbacks(ctx context.Context, in *ListCallbacksRequest, opts ...grpc.CallOption) (*ListCallbacksResponse, error)
+	// RetryCallback schedules a failed callback to be delivered again, with a new set of attempts.
+	RetryCallback(ctx context.Context, in *RetryCallbackRequest, opts ...grpc.CallOption) (*RetryCallbackResponse, error)
+	// GetNexusEndpoint returns a Nexus endpoint as stored in persistence, along with the recent success rate, latency
+	// and circuit breaker state of calls to it, aggregated over all history hosts.
+	GetNexusEndpoint(ctx context.Context, in *GetNexusEndpointRequest, opts ...grpc.CallOption) (*GetNexusEndpointResponse, error)
+	// ListNexusEndpoints lists Nexus endpoints as stored in persistence, along with the health of calls to them.
+	ListNexusEndpoints(ctx context.Context, in *ListNexusEndpointsRequest, opts ...grpc.CallOption) (*ListNexusEndpointsResponse, error)
+	// SetNexusEndpointCircuitBreaker manually opens or closes the circuit breakers of a Nexus endpoint, for a limited
+	// duration. The override is stored on the endpoint and applies to all history hosts.
+	SetNexusEndpointCircuitBreaker(ctx context.Context, in *SetNexusEndpointCircuitBreakerRequest, opts ...grpc.CallOption) (*SetNexusEndpointCircuitBreakerResponse, error)
+	// SetNexusEndpointAccessPolicy replaces the allowlist of caller namespaces and workflow types of a Nexus endpoint.
+	// Empty lists remove the respective restriction.
+	SetNexusEndpointAccessPolicy(ctx context.Context, in *SetNexusEndpointAccessPolicyRequest, opts ...grpc.CallOption) (*SetNexusEndpointAccessPolicyResponse, error)
+	// AggregateWorkflowExecutions computes the count, sum, average, minimum, maximum and percentiles of a numeric search
+	// attribute, e.g. ExecutionDuration, over the workflows matching a visibility query.
+	AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error)
+	// ExplainWorkflowExecutions returns the query a visibility query translates to in the visibility store, and its
+	// estimated cost if the store is Elasticsearch, without running it.
+	ExplainWorkflowExecutions(ctx context.Context, in *ExplainWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExplainWorkflowExecutionsResponse, error)
+	// StartVisibilityReindex starts a system workflow that rebuilds the visibility records of all executions from
+	// mutable state and writes them to a visibility store, optionally followed by a pass that compares the primary and
+	// secondary visibility stores. Only one reindex can run at a time.
+	StartVisibilityReindex(ctx context.Context, in *StartVisibilityReindexRequest, opts ...grpc.CallOption) (*StartVisibilityReindexResponse, error)
+	// DescribeVisibilityReindex returns the progress of the latest visibility reindex.
+	DescribeVisibilityReindex(ctx context.Context, in *DescribeVisibilityReindexRequest, opts ...grpc.CallOption) (*DescribeVisibilityReindexResponse, error)
+	// CancelVisibilityReindex stops a running visibility reindex. Records that were already written are kept.
+	CancelVisibilityReindex(ctx context.Context, in *CancelVisibilityReindexRequest, opts ...grpc.CallOption) (*CancelVisibilityReindexResponse, error)
+	// RenameSearchAttribute changes the name of a custom search attribute of a namespace without moving its values.
+	// It is only supported when custom search attributes are aliased per namespace, i.e. with SQL visibility. Use
+	// StartSearchAttributeMigration to rename search attributes in Elasticsearch.
+	RenameSearchAttribute(ctx context.Context, in *RenameSearchAttributeRequest, opts ...grpc.CallOption) (*RenameSearchAttributeResponse, error)
+	// StartSearchAttributeMigration starts a system workflow that moves a custom search attribute to a new type and/or
+	// name. Existing values are validated, converted and rewritten in all visibility stores, and values written by
+	// executions while and after the migration runs are redirected to the new search attribute. Only one migration
+	// can run at a time.
+	StartSearchAttributeMigration(ctx context.Context, in *StartSearchAttributeMigrationRequest, opts ...grpc.CallOption) (*StartSearchAttributeMigrationResponse, error)
+	// DescribeSearchAttributeMigration returns the progress of the latest search attribute migration.
+	DescribeSearchAttributeMigration(ctx context.Context, in *DescribeSearchAttributeMigrationRequest, opts ...grpc.CallOption) (*DescribeSearchAttributeMigrationResponse, error)
+	// PutSavedVisibilityQuery creates or replaces a saved visibility query of a namespace. If the query has an alert,
+	// a system workflow is started that evaluates the alert until the query is deleted or its alert is removed.
+	PutSavedVisibilityQuery(ctx context.Context, in *PutSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*PutSavedVisibilityQueryResponse, error)
+	// DeleteSavedVisibilityQuery deletes a saved visibility query of a namespace.
+	DeleteSavedVisibilityQuery(ctx context.Context, in *DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*DeleteSavedVisibilityQueryResponse, error)
+	// ListSavedVisibilityQueries returns the saved visibility queries of a namespace.
+	ListSavedVisibilityQueries(ctx context.Context, in *ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*ListSavedVisibilityQueriesResponse, error)
+	// RunSavedVisibilityQuery counts or lists the workflow executions matching a saved visibility query.
+	RunSavedVisibilityQuery(ctx context.Context, in *RunSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*RunSavedVisibilityQueryResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
@@ -415,6 +536,285 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) DeleteTaskQueueTasks(ctx context.Context, in *DeleteTaskQueueTasksRequest, opts ...grpc.CallOption) (*DeleteTaskQueueTasksResponse, error) {
+	out := new(DeleteTaskQueueTasksResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteTaskQueueTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartTaskQueueBacklogMigration(ctx context.Context, in *StartTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*StartTaskQueueBacklogMigrationResponse, error) {
+	out := new(StartTaskQueueBacklogMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartTaskQueueBacklogMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueueBacklogMigration(ctx context.Context, in *DescribeTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*DescribeTaskQueueBacklogMigrationResponse, error) {
+	out := new(DescribeTaskQueueBacklogMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeTaskQueueBacklogMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelTaskQueueBacklogMigration(ctx context.Context, in *CancelTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*CancelTaskQueueBacklogMigrationResponse, error) {
+	out := new(CancelTaskQueueBacklogMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelTaskQueueBacklogMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateTaskQueueFairnessWeights(ctx context.Context, in *UpdateTaskQueueFairnessWeightsRequest, opts ...grpc.CallOption) (*UpdateTaskQueueFairnessWeightsResponse, error) {
+	out := new(UpdateTaskQueueFairnessWeightsResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaskQueueFairnessWeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeTaskQueueFairnessKeys(ctx context.Context, in *DescribeTaskQueueFairnessKeysRequest, opts ...grpc.CallOption) (*DescribeTaskQueueFairnessKeysResponse, error) {
+	out := new(DescribeTaskQueueFairnessKeysResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeTaskQueueFairnessKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateTaskQueuePause(ctx context.Context, in *UpdateTaskQueuePauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePauseResponse, error) {
+	out := new(UpdateTaskQueuePauseResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaskQueuePause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateTaskQueueBlockedPollers(ctx context.Context, in *UpdateTaskQueueBlockedPollersRequest, opts ...grpc.CallOption) (*UpdateTaskQueueBlockedPollersResponse, error) {
+	out := new(UpdateTaskQueueBlockedPollersResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateTaskQueueBlockedPollers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CountWorkers(ctx context.Context, in *CountWorkersRequest, opts ...grpc.CallOption) (*CountWorkersResponse, error) {
+	out := new(CountWorkersResponse)
+	err := c.cc.Invoke(ctx, AdminService_CountWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PreviewSchedule(ctx context.Context, in *PreviewScheduleRequest, opts ...grpc.CallOption) (*PreviewScheduleResponse, error) {
+	out := new(PreviewScheduleResponse)
+	err := c.cc.Invoke(ctx, AdminService_PreviewSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListScheduleActions(ctx context.Context, in *ListScheduleActionsRequest, opts ...grpc.CallOption) (*ListScheduleActionsResponse, error) {
+	out := new(ListScheduleActionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListScheduleActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateScheduleCalendarSet(ctx context.Context, in *UpdateScheduleCalendarSetRequest, opts ...grpc.CallOption) (*UpdateScheduleCalendarSetResponse, error) {
+	out := new(UpdateScheduleCalendarSetResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateScheduleCalendarSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListScheduleCalendarSets(ctx context.Context, in *ListScheduleCalendarSetsRequest, opts ...grpc.CallOption) (*ListScheduleCalendarSetsResponse, error) {
+	out := new(ListScheduleCalendarSetsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListScheduleCalendarSets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCallbacks(ctx context.Context, in *ListCallbacksRequest, opts ...grpc.CallOption) (*ListCallbacksResponse, error) {
+	out := new(ListCallbacksResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCallbacks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RetryCallback(ctx context.Context, in *RetryCallbackRequest, opts ...grpc.CallOption) (*RetryCallbackResponse, error) {
+	out := new(RetryCallbackResponse)
+	err := c.cc.Invoke(ctx, AdminService_RetryCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNexusEndpoint(ctx context.Context, in *GetNexusEndpointRequest, opts ...grpc.CallOption) (*GetNexusEndpointResponse, error) {
+	out := new(GetNexusEndpointResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetNexusEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListNexusEndpoints(ctx context.Context, in *ListNexusEndpointsRequest, opts ...grpc.CallOption) (*ListNexusEndpointsResponse, error) {
+	out := new(ListNexusEndpointsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListNexusEndpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetNexusEndpointCircuitBreaker(ctx context.Context, in *SetNexusEndpointCircuitBreakerRequest, opts ...grpc.CallOption) (*SetNexusEndpointCircuitBreakerResponse, error) {
+	out := new(SetNexusEndpointCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetNexusEndpointCircuitBreaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetNexusEndpointAccessPolicy(ctx context.Context, in *SetNexusEndpointAccessPolicyRequest, opts ...grpc.CallOption) (*SetNexusEndpointAccessPolicyResponse, error) {
+	out := new(SetNexusEndpointAccessPolicyResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetNexusEndpointAccessPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*AggregateWorkflowExecutionsResponse, error) {
+	out := new(AggregateWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_AggregateWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExplainWorkflowExecutions(ctx context.Context, in *ExplainWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ExplainWorkflowExecutionsResponse, error) {
+	out := new(ExplainWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExplainWorkflowExecutions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartVisibilityReindex(ctx context.Context, in *StartVisibilityReindexRequest, opts ...grpc.CallOption) (*StartVisibilityReindexResponse, error) {
+	out := new(StartVisibilityReindexResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartVisibilityReindex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeVisibilityReindex(ctx context.Context, in *DescribeVisibilityReindexRequest, opts ...grpc.CallOption) (*DescribeVisibilityReindexResponse, error) {
+	out := new(DescribeVisibilityReindexResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeVisibilityReindex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CancelVisibilityReindex(ctx context.Context, in *CancelVisibilityReindexRequest, opts ...grpc.CallOption) (*CancelVisibilityReindexResponse, error) {
+	out := new(CancelVisibilityReindexResponse)
+	err := c.cc.Invoke(ctx, AdminService_CancelVisibilityReindex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RenameSearchAttribute(ctx context.Context, in *RenameSearchAttributeRequest, opts ...grpc.CallOption) (*RenameSearchAttributeResponse, error) {
+	out := new(RenameSearchAttributeResponse)
+	err := c.cc.Invoke(ctx, AdminService_RenameSearchAttribute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartSearchAttributeMigration(ctx context.Context, in *StartSearchAttributeMigrationRequest, opts ...grpc.CallOption) (*StartSearchAttributeMigrationResponse, error) {
+	out := new(StartSearchAttributeMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_StartSearchAttributeMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeSearchAttributeMigration(ctx context.Context, in *DescribeSearchAttributeMigrationRequest, opts ...grpc.CallOption) (*DescribeSearchAttributeMigrationResponse, error) {
+	out := new(DescribeSearchAttributeMigrationResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeSearchAttributeMigration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PutSavedVisibilityQuery(ctx context.Context, in *PutSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*PutSavedVisibilityQueryResponse, error) {
+	out := new(PutSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_PutSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteSavedVisibilityQuery(ctx context.Context, in *DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*DeleteSavedVisibilityQueryResponse, error) {
+	out := new(DeleteSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListSavedVisibilityQueries(ctx context.Context, in *ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*ListSavedVisibilityQueriesResponse, error) {
+	out := new(ListSavedVisibilityQueriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListSavedVisibilityQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RunSavedVisibilityQuery(ctx context.Context, in *RunSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*RunSavedVisibilityQueryResponse, error) {
+	out := new(RunSavedVisibilityQueryResponse)
+	err := c.cc.Invoke(ctx, AdminService_RunSavedVisibilityQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, AdminService_DeleteWorkflowExecution_FullMethodName, in, out, opts...)
@@ -641,6 +1041,96 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// DeleteTaskQueueTasks deletes tasks matching a filter from a task queue backlog, optionally
+	// moving them to another task queue first. A loaded task queue partition may still dispatch
+	// tasks it has already read into memory; unload the partition to avoid that.
+	DeleteTaskQueueTasks(context.Context, *DeleteTaskQueueTasksRequest) (*DeleteTaskQueueTasksResponse, error)
+	// StartTaskQueueBacklogMigration starts a system workflow that moves the backlog of a task
+	// queue to another task queue. Only one migration per source task queue can run at a time.
+	// The source task queue is paused while its backlog is moved and resumed afterwards, unless it
+	// was already paused. Migrations of task queues with a draining, scheduled or expiring pause,
+	// and of task queues that use worker versioning, are rejected.
+	StartTaskQueueBacklogMigration(context.Context, *StartTaskQueueBacklogMigrationRequest) (*StartTaskQueueBacklogMigrationResponse, error)
+	// DescribeTaskQueueBacklogMigration returns the progress of the latest backlog migration of a task queue.
+	DescribeTaskQueueBacklogMigration(context.Context, *DescribeTaskQueueBacklogMigrationRequest) (*DescribeTaskQueueBacklogMigrationResponse, error)
+	// CancelTaskQueueBacklogMigration stops a running backlog migration and resumes the source task
+	// queue. Tasks that were already moved stay on the destination task queue.
+	CancelTaskQueueBacklogMigration(context.Context, *CancelTaskQueueBacklogMigrationRequest) (*CancelTaskQueueBacklogMigrationResponse, error)
+	// UpdateTaskQueueFairnessWeights sets fairness key weight overrides and the default fairness
+	// weight of a task queue.
+	UpdateTaskQueueFairnessWeights(context.Context, *UpdateTaskQueueFairnessWeightsRequest) (*UpdateTaskQueueFairnessWeightsResponse, error)
+	// DescribeTaskQueueFairnessKeys returns the fairness keys of a task queue partition with the
+	// largest backlogs and dispatch rates.
+	DescribeTaskQueueFairnessKeys(context.Context, *DescribeTaskQueueFairnessKeysRequest) (*DescribeTaskQueueFairnessKeysResponse, error)
+	// UpdateTaskQueuePause pauses or drains a task queue, optionally for a scheduled time range, or removes its pause.
+	UpdateTaskQueuePause(context.Context, *UpdateTaskQueuePauseRequest) (*UpdateTaskQueuePauseResponse, error)
+	// UpdateTaskQueueBlockedPollers blocks poller identities from receiving tasks from a task queue for some time, or
+	// unblocks them.
+	UpdateTaskQueueBlockedPollers(context.Context, *UpdateTaskQueueBlockedPollersRequest) (*UpdateTaskQueueBlockedPollersResponse, error)
+	// CountWorkers counts the workers of a namespace that match a ListWorkers query, optionally grouped by worker
+	// properties with a GROUP BY clause.
+	CountWorkers(context.Context, *CountWorkersRequest) (*CountWorkersResponse, error)
+	// PreviewSchedule returns the actions that a schedule with the given spec and policies would take in a time
+	// range, without creating it.
+	PreviewSchedule(context.Context, *PreviewScheduleRequest) (*PreviewScheduleResponse, error)
+	// ListScheduleActions lists the workflows that a schedule started, from visibility, and the actions that it
+	// recently skipped.
+	ListScheduleActions(context.Context, *ListScheduleActionsRequest) (*ListScheduleActionsResponse, error)
+	// UpdateScheduleCalendarSet creates, replaces or deletes a named calendar set of a namespace. Schedules copy the
+	// days of the sets they reference when they're created or updated, so changes apply to a schedule on its next
+	// update.
+	UpdateScheduleCalendarSet(context.Context, *UpdateScheduleCalendarSetRequest) (*UpdateScheduleCalendarSetResponse, error)
+	// ListScheduleCalendarSets returns the calendar sets of a namespace.
+	ListScheduleCalendarSets(context.Context, *ListScheduleCalendarSetsRequest) (*ListScheduleCalendarSetsResponse, error)
+	// ListCallbacks lists the callbacks of the workflows matching a visibility query, optionally filtered by state.
+	ListCallbacks(context.Context, *ListCallbacksRequest) (*ListCallbacksResponse, error)
+	// RetryCallback schedules a failed callback to be delivered again, with a new set of attempts.
+	RetryCallback(context.Context, *RetryCallbackRequest) (*RetryCallbackResponse, error)
+	// GetNexusEndpoint returns a Nexus endpoint as stored in persistence, along with the recent success rate, latency
+	// and circuit breaker state of calls to it, aggregated over all history hosts.
+	GetNexusEndpoint(context.Context, *GetNexusEndpointRequest) (*GetNexusEndpointResponse, error)
+	// ListNexusEndpoints lists Nexus endpoints as stored in persistence, along with the health of calls to them.
+	ListNexusEndpoints(context.Context, *ListNexusEndpointsRequest) (*ListNexusEndpointsResponse, error)
+	// SetNexusEndpointCircuitBreaker manually opens or closes the circuit breakers of a Nexus endpoint, for a limited
+	// duration. The override is stored on the endpoint and applies to all history hosts.
+	SetNexusEndpointCircuitBreaker(context.Context, *SetNexusEndpointCircuitBreakerRequest) (*SetNexusEndpointCircuitBreakerResponse, error)
+	// SetNexusEndpointAccessPolicy replaces the allowlist of caller namespaces and workflow types of a Nexus endpoint.
+	// Empty lists remove the respective restriction.
+	SetNexusEndpointAccessPolicy(context.Context, *SetNexusEndpointAccessPolicyRequest) (*SetNexusEndpointAccessPolicyResponse, error)
+	// AggregateWorkflowExecutions computes the count, sum, average, minimum, maximum and percentiles of a numeric search
+	// attribute, e.g. ExecutionDuration, over the workflows matching a visibility query.
+	AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
+	// ExplainWorkflowExecutions returns the query a visibility query translates to in the visibility store, and its
+	// estimated cost if the store is Elasticsearch, without running it.
+	ExplainWorkflowExecutions(context.Context, *ExplainWorkflowExecutionsRequest) (*ExplainWorkflowExecutionsResponse, error)
+	// StartVisibilityReindex starts a system workflow that rebuilds the visibility records of all executions from
+	// mutable state and writes them to a visibility store, optionally followed by a pass that compares the primary and
+	// secondary visibility stores. Only one reindex can run at a time.
+	StartVisibilityReindex(context.Context, *StartVisibilityReindexRequest) (*StartVisibilityReindexResponse, error)
+	// DescribeVisibilityReindex returns the progress of the latest visibility reindex.
+	DescribeVisibilityReindex(context.Context, *DescribeVisibilityReindexRequest) (*DescribeVisibilityReindexResponse, error)
+	// CancelVisibilityReindex stops a running visibility reindex. Records that were already written are kept.
+	CancelVisibilityReindex(context.Context, *CancelVisibilityReindexRequest) (*CancelVisibilityReindexResponse, error)
+	// RenameSearchAttribute changes the name of a custom search attribute of a namespace without moving its values.
+	// It is only supported when custom search attributes are aliased per namespace, i.e. with SQL visibility. Use
+	// StartSearchAttributeMigration to rename search attributes in Elasticsearch.
+	RenameSearchAttribute(context.Context, *RenameSearchAttributeRequest) (*RenameSearchAttributeResponse, error)
+	// StartSearchAttributeMigration starts a system workflow that moves a custom search attribute to a new type and/or
+	// name. Existing values are validated, converted and rewritten in all visibility stores, and values written by
+	// executions while and after the migration runs are redirected to the new search attribute. Only one migration
+	// can run at a time.
+	StartSearchAttributeMigration(context.Context, *StartSearchAttributeMigrationRequest) (*StartSearchAttributeMigrationResponse, error)
+	// DescribeSearchAttributeMigration returns the progress of the latest search attribute migration.
+	DescribeSearchAttributeMigration(context.Context, *DescribeSearchAttributeMigrationRequest) (*DescribeSearchAttributeMigrationResponse, error)
+	// PutSavedVisibilityQuery creates or replaces a saved visibility query of a namespace. If the query has an alert,
+	// a system workflow is started that evaluates the alert until the query is deleted or its alert is removed.
+	PutSavedVisibilityQuery(context.Context, *PutSavedVisibilityQueryRequest) (*PutSavedVisibilityQueryResponse, error)
+	// DeleteSavedVisibilityQuery deletes a saved visibility query of a namespace.
+	DeleteSavedVisibilityQuery(context.Context, *DeleteSavedVisibilityQueryRequest) (*DeleteSavedVisibilityQueryResponse, error)
+	// ListSavedVisibilityQueries returns the saved visibility queries of a namespace.
+	ListSavedVisibilityQueries(context.Context, *ListSavedVisibilityQueriesRequest) (*ListSavedVisibilityQueriesResponse, error)
+	// RunSavedVisibilityQuery counts or lists the workflow executions matching a saved visibility query.
+	RunSavedVisibilityQuery(context.Context, *RunSavedVisibilityQueryRequest) (*RunSavedVisibilityQueryResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
@@ -751,6 +1241,99 @@ func (UnimplementedAdminServiceServer) ResendReplicationTasks(context.Context, *
 func (UnimplementedAdminServiceServer) GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (UnimplementedAdminServiceServer) DeleteTaskQueueTasks(context.Context, *DeleteTaskQueueTasksRequest) (*DeleteTaskQueueTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaskQueueTasks not implemented")
+}
+func (UnimplementedAdminServiceServer) StartTaskQueueBacklogMigration(context.Context, *StartTaskQueueBacklogMigrationRequest) (*StartTaskQueueBacklogMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTaskQueueBacklogMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeTaskQueueBacklogMigration(context.Context, *DescribeTaskQueueBacklogMigrationRequest) (*DescribeTaskQueueBacklogMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueBacklogMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelTaskQueueBacklogMigration(context.Context, *CancelTaskQueueBacklogMigrationRequest) (*CancelTaskQueueBacklogMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTaskQueueBacklogMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateTaskQueueFairnessWeights(context.Context, *UpdateTaskQueueFairnessWeightsRequest) (*UpdateTaskQueueFairnessWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueFairnessWeights not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeTaskQueueFairnessKeys(context.Context, *DescribeTaskQueueFairnessKeysRequest) (*DescribeTaskQueueFairnessKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTaskQueueFairnessKeys not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateTaskQueuePause(context.Context, *UpdateTaskQueuePauseRequest) (*UpdateTaskQueuePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueuePause not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateTaskQueueBlockedPollers(context.Context, *UpdateTaskQueueBlockedPollersRequest) (*UpdateTaskQueueBlockedPollersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueBlockedPollers not implemented")
+}
+func (UnimplementedAdminServiceServer) CountWorkers(context.Context, *CountWorkersRequest) (*CountWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWorkers not implemented")
+}
+func (UnimplementedAdminServiceServer) PreviewSchedule(context.Context, *PreviewScheduleRequest) (*PreviewScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSchedule not implemented")
+}
+func (UnimplementedAdminServiceServer) ListScheduleActions(context.Context, *ListScheduleActionsRequest) (*ListScheduleActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduleActions not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateScheduleCalendarSet(context.Context, *UpdateScheduleCalendarSetRequest) (*UpdateScheduleCalendarSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduleCalendarSet not implemented")
+}
+func (UnimplementedAdminServiceServer) ListScheduleCalendarSets(context.Context, *ListScheduleCalendarSetsRequest) (*ListScheduleCalendarSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduleCalendarSets not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCallbacks(context.Context, *ListCallbacksRequest) (*ListCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCallbacks not implemented")
+}
+func (UnimplementedAdminServiceServer) RetryCallback(context.Context, *RetryCallbackRequest) (*RetryCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryCallback not implemented")
+}
+func (UnimplementedAdminServiceServer) GetNexusEndpoint(context.Context, *GetNexusEndpointRequest) (*GetNexusEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNexusEndpoint not implemented")
+}
+func (UnimplementedAdminServiceServer) ListNexusEndpoints(context.Context, *ListNexusEndpointsRequest) (*ListNexusEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNexusEndpoints not implemented")
+}
+func (UnimplementedAdminServiceServer) SetNexusEndpointCircuitBreaker(context.Context, *SetNexusEndpointCircuitBreakerRequest) (*SetNexusEndpointCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNexusEndpointCircuitBreaker not implemented")
+}
+func (UnimplementedAdminServiceServer) SetNexusEndpointAccessPolicy(context.Context, *SetNexusEndpointAccessPolicyRequest) (*SetNexusEndpointAccessPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNexusEndpointAccessPolicy not implemented")
+}
+func (UnimplementedAdminServiceServer) AggregateWorkflowExecutions(context.Context, *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) ExplainWorkflowExecutions(context.Context, *ExplainWorkflowExecutionsRequest) (*ExplainWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainWorkflowExecutions not implemented")
+}
+func (UnimplementedAdminServiceServer) StartVisibilityReindex(context.Context, *StartVisibilityReindexRequest) (*StartVisibilityReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVisibilityReindex not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeVisibilityReindex(context.Context, *DescribeVisibilityReindexRequest) (*DescribeVisibilityReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVisibilityReindex not implemented")
+}
+func (UnimplementedAdminServiceServer) CancelVisibilityReindex(context.Context, *CancelVisibilityReindexRequest) (*CancelVisibilityReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelVisibilityReindex not implemented")
+}
+func (UnimplementedAdminServiceServer) RenameSearchAttribute(context.Context, *RenameSearchAttributeRequest) (*RenameSearchAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSearchAttribute not implemented")
+}
+func (UnimplementedAdminServiceServer) StartSearchAttributeMigration(context.Context, *StartSearchAttributeMigrationRequest) (*StartSearchAttributeMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSearchAttributeMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeSearchAttributeMigration(context.Context, *DescribeSearchAttributeMigrationRequest) (*DescribeSearchAttributeMigrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSearchAttributeMigration not implemented")
+}
+func (UnimplementedAdminServiceServer) PutSavedVisibilityQuery(context.Context, *PutSavedVisibilityQueryRequest) (*PutSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutSavedVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteSavedVisibilityQuery(context.Context, *DeleteSavedVisibilityQueryRequest) (*DeleteSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedVisibilityQuery not implemented")
+}
+func (UnimplementedAdminServiceServer) ListSavedVisibilityQueries(context.Context, *ListSavedVisibilityQueriesRequest) (*ListSavedVisibilityQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedVisibilityQueries not implemented")
+}
+func (UnimplementedAdminServiceServer) RunSavedVisibilityQuery(context.Context, *RunSavedVisibilityQueryRequest) (*RunSavedVisibilityQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSavedVisibilityQuery not implemented")
+}
 func (UnimplementedAdminServiceServer) DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
@@ -1313,75 +1896,633 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkflowExecutionRequest)
+func _AdminService_DeleteTaskQueueTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskQueueTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, in)
+		return srv.(AdminServiceServer).DeleteTaskQueueTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_DeleteWorkflowExecution_FullMethodName,
+		FullMethod: AdminService_DeleteTaskQueueTasks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+		return srv.(AdminServiceServer).DeleteTaskQueueTasks(ctx, req.(*DeleteTaskQueueTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_StreamWorkflowReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(AdminServiceServer).StreamWorkflowReplicationMessages(&adminServiceStreamWorkflowReplicationMessagesServer{stream})
-}
-
-type AdminService_StreamWorkflowReplicationMessagesServer interface {
-	Send(*StreamWorkflowReplicationMessagesResponse) error
-	Recv() (*StreamWorkflowReplicationMessagesRequest, error)
-	grpc.ServerStream
-}
-
-type adminServiceStreamWorkflowReplicationMessagesServer struct {
-	grpc.ServerStream
-}
-
-func (x *adminServiceStreamWorkflowReplicationMessagesServer) Send(m *StreamWorkflowReplicationMessagesResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *adminServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamWorkflowReplicationMessagesRequest, error) {
-	m := new(StreamWorkflowReplicationMessagesRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _AdminService_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNamespaceRequest)
+func _AdminService_StartTaskQueueBacklogMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskQueueBacklogMigrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetNamespace(ctx, in)
+		return srv.(AdminServiceServer).StartTaskQueueBacklogMigration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_GetNamespace_FullMethodName,
+		FullMethod: AdminService_StartTaskQueueBacklogMigration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetNamespace(ctx, req.(*GetNamespaceRequest))
+		return srv.(AdminServiceServer).StartTaskQueueBacklogMigration(ctx, req.(*StartTaskQueueBacklogMigrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDLQTasksRequest)
+func _AdminService_DescribeTaskQueueBacklogMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueBacklogMigrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDLQTasks(ctx, in)
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklogMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeTaskQueueBacklogMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueBacklogMigration(ctx, req.(*DescribeTaskQueueBacklogMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelTaskQueueBacklogMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTaskQueueBacklogMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelTaskQueueBacklogMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelTaskQueueBacklogMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelTaskQueueBacklogMigration(ctx, req.(*CancelTaskQueueBacklogMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateTaskQueueFairnessWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueFairnessWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaskQueueFairnessWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaskQueueFairnessWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaskQueueFairnessWeights(ctx, req.(*UpdateTaskQueueFairnessWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeTaskQueueFairnessKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTaskQueueFairnessKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeTaskQueueFairnessKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeTaskQueueFairnessKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeTaskQueueFairnessKeys(ctx, req.(*DescribeTaskQueueFairnessKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateTaskQueuePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueuePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaskQueuePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaskQueuePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaskQueuePause(ctx, req.(*UpdateTaskQueuePauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateTaskQueueBlockedPollers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueueBlockedPollersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateTaskQueueBlockedPollers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateTaskQueueBlockedPollers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateTaskQueueBlockedPollers(ctx, req.(*UpdateTaskQueueBlockedPollersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CountWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CountWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CountWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CountWorkers(ctx, req.(*CountWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PreviewSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PreviewSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PreviewSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PreviewSchedule(ctx, req.(*PreviewScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListScheduleActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListScheduleActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListScheduleActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListScheduleActions(ctx, req.(*ListScheduleActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateScheduleCalendarSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleCalendarSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateScheduleCalendarSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateScheduleCalendarSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateScheduleCalendarSet(ctx, req.(*UpdateScheduleCalendarSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListScheduleCalendarSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleCalendarSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListScheduleCalendarSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListScheduleCalendarSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListScheduleCalendarSets(ctx, req.(*ListScheduleCalendarSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCallbacks(ctx, req.(*ListCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RetryCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RetryCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RetryCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RetryCallback(ctx, req.(*RetryCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNexusEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNexusEndpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNexusEndpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetNexusEndpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNexusEndpoint(ctx, req.(*GetNexusEndpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListNexusEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNexusEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListNexusEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListNexusEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListNexusEndpoints(ctx, req.(*ListNexusEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetNexusEndpointCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNexusEndpointCircuitBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetNexusEndpointCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetNexusEndpointCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetNexusEndpointCircuitBreaker(ctx, req.(*SetNexusEndpointCircuitBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetNexusEndpointAccessPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNexusEndpointAccessPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetNexusEndpointAccessPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetNexusEndpointAccessPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetNexusEndpointAccessPolicy(ctx, req.(*SetNexusEndpointAccessPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AggregateWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AggregateWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AggregateWorkflowExecutions(ctx, req.(*AggregateWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExplainWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExplainWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExplainWorkflowExecutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExplainWorkflowExecutions(ctx, req.(*ExplainWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartVisibilityReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartVisibilityReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartVisibilityReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartVisibilityReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartVisibilityReindex(ctx, req.(*StartVisibilityReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeVisibilityReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVisibilityReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeVisibilityReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeVisibilityReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeVisibilityReindex(ctx, req.(*DescribeVisibilityReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CancelVisibilityReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelVisibilityReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CancelVisibilityReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CancelVisibilityReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CancelVisibilityReindex(ctx, req.(*CancelVisibilityReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RenameSearchAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSearchAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RenameSearchAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RenameSearchAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RenameSearchAttribute(ctx, req.(*RenameSearchAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StartSearchAttributeMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSearchAttributeMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartSearchAttributeMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_StartSearchAttributeMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartSearchAttributeMigration(ctx, req.(*StartSearchAttributeMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeSearchAttributeMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSearchAttributeMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeSearchAttributeMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeSearchAttributeMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeSearchAttributeMigration(ctx, req.(*DescribeSearchAttributeMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PutSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PutSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PutSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PutSavedVisibilityQuery(ctx, req.(*PutSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteSavedVisibilityQuery(ctx, req.(*DeleteSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListSavedVisibilityQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedVisibilityQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListSavedVisibilityQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListSavedVisibilityQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListSavedVisibilityQueries(ctx, req.(*ListSavedVisibilityQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RunSavedVisibilityQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunSavedVisibilityQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RunSavedVisibilityQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RunSavedVisibilityQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RunSavedVisibilityQuery(ctx, req.(*RunSavedVisibilityQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteWorkflowExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWorkflowExecution(ctx, req.(*DeleteWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_StreamWorkflowReplicationMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).StreamWorkflowReplicationMessages(&adminServiceStreamWorkflowReplicationMessagesServer{stream})
+}
+
+type AdminService_StreamWorkflowReplicationMessagesServer interface {
+	Send(*StreamWorkflowReplicationMessagesResponse) error
+	Recv() (*StreamWorkflowReplicationMessagesRequest, error)
+	grpc.ServerStream
+}
+
+type adminServiceStreamWorkflowReplicationMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceStreamWorkflowReplicationMessagesServer) Send(m *StreamWorkflowReplicationMessagesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminServiceStreamWorkflowReplicationMessagesServer) Recv() (*StreamWorkflowReplicationMessagesRequest, error) {
+	m := new(StreamWorkflowReplicationMessagesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AdminService_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNamespace(ctx, req.(*GetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDLQTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDLQTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDLQTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1710,6 +2851,130 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "DeleteTaskQueueTasks",
+			Handler:    _AdminService_DeleteTaskQueueTasks_Handler,
+		},
+		{
+			MethodName: "StartTaskQueueBacklogMigration",
+			Handler:    _AdminService_StartTaskQueueBacklogMigration_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueBacklogMigration",
+			Handler:    _AdminService_DescribeTaskQueueBacklogMigration_Handler,
+		},
+		{
+			MethodName: "CancelTaskQueueBacklogMigration",
+			Handler:    _AdminService_CancelTaskQueueBacklogMigration_Handler,
+		},
+		{
+			MethodName: "UpdateTaskQueueFairnessWeights",
+			Handler:    _AdminService_UpdateTaskQueueFairnessWeights_Handler,
+		},
+		{
+			MethodName: "DescribeTaskQueueFairnessKeys",
+			Handler:    _AdminService_DescribeTaskQueueFairnessKeys_Handler,
+		},
+		{
+			MethodName: "UpdateTaskQueuePause",
+			Handler:    _AdminService_UpdateTaskQueuePause_Handler,
+		},
+		{
+			MethodName: "UpdateTaskQueueBlockedPollers",
+			Handler:    _AdminService_UpdateTaskQueueBlockedPollers_Handler,
+		},
+		{
+			MethodName: "CountWorkers",
+			Handler:    _AdminService_CountWorkers_Handler,
+		},
+		{
+			MethodName: "PreviewSchedule",
+			Handler:    _AdminService_PreviewSchedule_Handler,
+		},
+		{
+			MethodName: "ListScheduleActions",
+			Handler:    _AdminService_ListScheduleActions_Handler,
+		},
+		{
+			MethodName: "UpdateScheduleCalendarSet",
+			Handler:    _AdminService_UpdateScheduleCalendarSet_Handler,
+		},
+		{
+			MethodName: "ListScheduleCalendarSets",
+			Handler:    _AdminService_ListScheduleCalendarSets_Handler,
+		},
+		{
+			MethodName: "ListCallbacks",
+			Handler:    _AdminService_ListCallbacks_Handler,
+		},
+		{
+			MethodName: "RetryCallback",
+			Handler:    _AdminService_RetryCallback_Handler,
+		},
+		{
+			MethodName: "GetNexusEndpoint",
+			Handler:    _AdminService_GetNexusEndpoint_Handler,
+		},
+		{
+			MethodName: "ListNexusEndpoints",
+			Handler:    _AdminService_ListNexusEndpoints_Handler,
+		},
+		{
+			MethodName: "SetNexusEndpointCircuitBreaker",
+			Handler:    _AdminService_SetNexusEndpointCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetNexusEndpointAccessPolicy",
+			Handler:    _AdminService_SetNexusEndpointAccessPolicy_Handler,
+		},
+		{
+			MethodName: "AggregateWorkflowExecutions",
+			Handler:    _AdminService_AggregateWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "ExplainWorkflowExecutions",
+			Handler:    _AdminService_ExplainWorkflowExecutions_Handler,
+		},
+		{
+			MethodName: "StartVisibilityReindex",
+			Handler:    _AdminService_StartVisibilityReindex_Handler,
+		},
+		{
+			MethodName: "DescribeVisibilityReindex",
+			Handler:    _AdminService_DescribeVisibilityReindex_Handler,
+		},
+		{
+			MethodName: "CancelVisibilityReindex",
+			Handler:    _AdminService_CancelVisibilityReindex_Handler,
+		},
+		{
+			MethodName: "RenameSearchAttribute",
+			Handler:    _AdminService_RenameSearchAttribute_Handler,
+		},
+		{
+			MethodName: "StartSearchAttributeMigration",
+			Handler:    _AdminService_StartSearchAttributeMigration_Handler,
+		},
+		{
+			MethodName: "DescribeSearchAttributeMigration",
+			Handler:    _AdminService_DescribeSearchAttributeMigration_Handler,
+		},
+		{
+			MethodName: "PutSavedVisibilityQuery",
+			Handler:    _AdminService_PutSavedVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "DeleteSavedVisibilityQuery",
+			Handler:    _AdminService_DeleteSavedVisibilityQuery_Handler,
+		},
+		{
+			MethodName: "ListSavedVisibilityQueries",
+			Handler:    _AdminService_ListSavedVisibilityQueries_Handler,
+		},
+		{
+			MethodName: "RunSavedVisibilityQuery",
+			Handler:    _AdminService_RunSavedVisibilityQuery_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
@@ -103,6 +103,26 @@ func (mr *MockAdminServiceClientMockRecorder) AddTasks(ctx, in any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).AddTasks), varargs...)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) AggregateWorkflowExecutions(ctx context.Context, in *adminservice.AggregateWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) AggregateWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).AggregateWorkflowExecutions), varargs...)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceClient) CancelDLQJob(ctx context.Context, in *adminservice.CancelDLQJobRequest, opts ...grpc.CallOption) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +143,46 @@ func (mr *MockAdminServiceClientMockRecorder) CancelDLQJob(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelDLQJob), varargs...)
 }
 
+// CancelTaskQueueBacklogMigration mocks base method.
+func (m *MockAdminServiceClient) CancelTaskQueueBacklogMigration(ctx context.Context, in *adminservice.CancelTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*adminservice.CancelTaskQueueBacklogMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelTaskQueueBacklogMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelTaskQueueBacklogMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTaskQueueBacklogMigration indicates an expected call of CancelTaskQueueBacklogMigration.
+func (mr *MockAdminServiceClientMockRecorder) CancelTaskQueueBacklogMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTaskQueueBacklogMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelTaskQueueBacklogMigration), varargs...)
+}
+
+// CancelVisibilityReindex mocks base method.
+func (m *MockAdminServiceClient) CancelVisibilityReindex(ctx context.Context, in *adminservice.CancelVisibilityReindexRequest, opts ...grpc.CallOption) (*adminservice.CancelVisibilityReindexResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelVisibilityReindex", varargs...)
+	ret0, _ := ret[0].(*adminservice.CancelVisibilityReindexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVisibilityReindex indicates an expected call of CancelVisibilityReindex.
+func (mr *MockAdminServiceClientMockRecorder) CancelVisibilityReindex(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVisibilityReindex", reflect.TypeOf((*MockAdminServiceClient)(nil).CancelVisibilityReindex), varargs...)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceClient) CloseShard(ctx context.Context, in *adminservice.CloseShardRequest, opts ...grpc.CallOption) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +203,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CountWorkers mocks base method.
+func (m *MockAdminServiceClient) CountWorkers(ctx context.Context, in *adminservice.CountWorkersRequest, opts ...grpc.CallOption) (*adminservice.CountWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountWorkers", varargs...)
+	ret0, _ := ret[0].(*adminservice.CountWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkers indicates an expected call of CountWorkers.
+func (mr *MockAdminServiceClientMockRecorder) CountWorkers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkers", reflect.TypeOf((*MockAdminServiceClient)(nil).CountWorkers), varargs...)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceClient) DeepHealthCheck(ctx context.Context, in *adminservice.DeepHealthCheckRequest, opts ...grpc.CallOption) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +243,46 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) DeleteSavedVisibilityQuery(ctx context.Context, in *adminservice.DeleteSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedVisibilityQuery indicates an expected call of DeleteSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) DeleteSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteSavedVisibilityQuery), varargs...)
+}
+
+// DeleteTaskQueueTasks mocks base method.
+func (m *MockAdminServiceClient) DeleteTaskQueueTasks(ctx context.Context, in *adminservice.DeleteTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTaskQueueTasks", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueTasks indicates an expected call of DeleteTaskQueueTasks.
+func (mr *MockAdminServiceClientMockRecorder) DeleteTaskQueueTasks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteTaskQueueTasks), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +383,66 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeSearchAttributeMigration mocks base method.
+func (m *MockAdminServiceClient) DescribeSearchAttributeMigration(ctx context.Context, in *adminservice.DescribeSearchAttributeMigrationRequest, opts ...grpc.CallOption) (*adminservice.DescribeSearchAttributeMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSearchAttributeMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeSearchAttributeMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSearchAttributeMigration indicates an expected call of DescribeSearchAttributeMigration.
+func (mr *MockAdminServiceClientMockRecorder) DescribeSearchAttributeMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSearchAttributeMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeSearchAttributeMigration), varargs...)
+}
+
+// DescribeTaskQueueBacklogMigration mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueueBacklogMigration(ctx context.Context, in *adminservice.DescribeTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueBacklogMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklogMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklogMigration indicates an expected call of DescribeTaskQueueBacklogMigration.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueueBacklogMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklogMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueueBacklogMigration), varargs...)
+}
+
+// DescribeTaskQueueFairnessKeys mocks base method.
+func (m *MockAdminServiceClient) DescribeTaskQueueFairnessKeys(ctx context.Context, in *adminservice.DescribeTaskQueueFairnessKeysRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueueFairnessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskQueueFairnessKeys", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueFairnessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueFairnessKeys indicates an expected call of DescribeTaskQueueFairnessKeys.
+func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueueFairnessKeys(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueFairnessKeys", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueueFairnessKeys), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +463,46 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// DescribeVisibilityReindex mocks base method.
+func (m *MockAdminServiceClient) DescribeVisibilityReindex(ctx context.Context, in *adminservice.DescribeVisibilityReindexRequest, opts ...grpc.CallOption) (*adminservice.DescribeVisibilityReindexResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVisibilityReindex", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityReindexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityReindex indicates an expected call of DescribeVisibilityReindex.
+func (mr *MockAdminServiceClientMockRecorder) DescribeVisibilityReindex(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityReindex", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeVisibilityReindex), varargs...)
+}
+
+// ExplainWorkflowExecutions mocks base method.
+func (m *MockAdminServiceClient) ExplainWorkflowExecutions(ctx context.Context, in *adminservice.ExplainWorkflowExecutionsRequest, opts ...grpc.CallOption) (*adminservice.ExplainWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainWorkflowExecutions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExplainWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainWorkflowExecutions indicates an expected call of ExplainWorkflowExecutions.
+func (mr *MockAdminServiceClientMockRecorder) ExplainWorkflowExecutions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainWorkflowExecutions", reflect.TypeOf((*MockAdminServiceClient)(nil).ExplainWorkflowExecutions), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -423,6 +643,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetNamespaceReplicationMessages(ct
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNamespaceReplicationMessages), varargs...)
 }
 
+// GetNexusEndpoint mocks base method.
+func (m *MockAdminServiceClient) GetNexusEndpoint(ctx context.Context, in *adminservice.GetNexusEndpointRequest, opts ...grpc.CallOption) (*adminservice.GetNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNexusEndpoint", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNexusEndpoint indicates an expected call of GetNexusEndpoint.
+func (mr *MockAdminServiceClientMockRecorder) GetNexusEndpoint(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNexusEndpoint", reflect.TypeOf((*MockAdminServiceClient)(nil).GetNexusEndpoint), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) GetReplicationMessages(ctx context.Context, in *adminservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -563,6 +803,26 @@ func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
+// ListCallbacks mocks base method.
+func (m *MockAdminServiceClient) ListCallbacks(ctx context.Context, in *adminservice.ListCallbacksRequest, opts ...grpc.CallOption) (*adminservice.ListCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCallbacks", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCallbacks indicates an expected call of ListCallbacks.
+func (mr *MockAdminServiceClientMockRecorder) ListCallbacks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCallbacks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListCallbacks), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -623,6 +883,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListNexusEndpoints mocks base method.
+func (m *MockAdminServiceClient) ListNexusEndpoints(ctx context.Context, in *adminservice.ListNexusEndpointsRequest, opts ...grpc.CallOption) (*adminservice.ListNexusEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNexusEndpoints", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListNexusEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNexusEndpoints indicates an expected call of ListNexusEndpoints.
+func (mr *MockAdminServiceClientMockRecorder) ListNexusEndpoints(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockAdminServiceClient)(nil).ListNexusEndpoints), varargs...)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceClient) ListQueues(ctx context.Context, in *adminservice.ListQueuesRequest, opts ...grpc.CallOption) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +923,66 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListSavedVisibilityQueries mocks base method.
+func (m *MockAdminServiceClient) ListSavedVisibilityQueries(ctx context.Context, in *adminservice.ListSavedVisibilityQueriesRequest, opts ...grpc.CallOption) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSavedVisibilityQueries", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListSavedVisibilityQueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedVisibilityQueries indicates an expected call of ListSavedVisibilityQueries.
+func (mr *MockAdminServiceClientMockRecorder) ListSavedVisibilityQueries(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedVisibilityQueries", reflect.TypeOf((*MockAdminServiceClient)(nil).ListSavedVisibilityQueries), varargs...)
+}
+
+// ListScheduleActions mocks base method.
+func (m *MockAdminServiceClient) ListScheduleActions(ctx context.Context, in *adminservice.ListScheduleActionsRequest, opts ...grpc.CallOption) (*adminservice.ListScheduleActionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScheduleActions", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListScheduleActionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleActions indicates an expected call of ListScheduleActions.
+func (mr *MockAdminServiceClientMockRecorder) ListScheduleActions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleActions", reflect.TypeOf((*MockAdminServiceClient)(nil).ListScheduleActions), varargs...)
+}
+
+// ListScheduleCalendarSets mocks base method.
+func (m *MockAdminServiceClient) ListScheduleCalendarSets(ctx context.Context, in *adminservice.ListScheduleCalendarSetsRequest, opts ...grpc.CallOption) (*adminservice.ListScheduleCalendarSetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScheduleCalendarSets", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListScheduleCalendarSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleCalendarSets indicates an expected call of ListScheduleCalendarSets.
+func (mr *MockAdminServiceClientMockRecorder) ListScheduleCalendarSets(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleCalendarSets", reflect.TypeOf((*MockAdminServiceClient)(nil).ListScheduleCalendarSets), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +1023,26 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// PreviewSchedule mocks base method.
+func (m *MockAdminServiceClient) PreviewSchedule(ctx context.Context, in *adminservice.PreviewScheduleRequest, opts ...grpc.CallOption) (*adminservice.PreviewScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewSchedule", varargs...)
+	ret0, _ := ret[0].(*adminservice.PreviewScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewSchedule indicates an expected call of PreviewSchedule.
+func (mr *MockAdminServiceClientMockRecorder) PreviewSchedule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewSchedule", reflect.TypeOf((*MockAdminServiceClient)(nil).PreviewSchedule), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -723,6 +1083,26 @@ func (mr *MockAdminServiceClientMockRecorder) PurgeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).PurgeDLQTasks), varargs...)
 }
 
+// PutSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) PutSavedVisibilityQuery(ctx context.Context, in *adminservice.PutSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.PutSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.PutSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSavedVisibilityQuery indicates an expected call of PutSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) PutSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).PutSavedVisibilityQuery), varargs...)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceClient) ReapplyEvents(ctx context.Context, in *adminservice.ReapplyEventsRequest, opts ...grpc.CallOption) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -843,6 +1223,26 @@ func (mr *MockAdminServiceClientMockRecorder) RemoveTask(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceClient)(nil).RemoveTask), varargs...)
 }
 
+// RenameSearchAttribute mocks base method.
+func (m *MockAdminServiceClient) RenameSearchAttribute(ctx context.Context, in *adminservice.RenameSearchAttributeRequest, opts ...grpc.CallOption) (*adminservice.RenameSearchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameSearchAttribute", varargs...)
+	ret0, _ := ret[0].(*adminservice.RenameSearchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameSearchAttribute indicates an expected call of RenameSearchAttribute.
+func (mr *MockAdminServiceClientMockRecorder) RenameSearchAttribute(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSearchAttribute", reflect.TypeOf((*MockAdminServiceClient)(nil).RenameSearchAttribute), varargs...)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceClient) ResendReplicationTasks(ctx context.Context, in *adminservice.ResendReplicationTasksRequest, opts ...grpc.CallOption) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1263,146 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// RetryCallback mocks base method.
+func (m *MockAdminServiceClient) RetryCallback(ctx context.Context, in *adminservice.RetryCallbackRequest, opts ...grpc.CallOption) (*adminservice.RetryCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryCallback", varargs...)
+	ret0, _ := ret[0].(*adminservice.RetryCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryCallback indicates an expected call of RetryCallback.
+func (mr *MockAdminServiceClientMockRecorder) RetryCallback(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryCallback", reflect.TypeOf((*MockAdminServiceClient)(nil).RetryCallback), varargs...)
+}
+
+// RunSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceClient) RunSavedVisibilityQuery(ctx context.Context, in *adminservice.RunSavedVisibilityQueryRequest, opts ...grpc.CallOption) (*adminservice.RunSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunSavedVisibilityQuery", varargs...)
+	ret0, _ := ret[0].(*adminservice.RunSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunSavedVisibilityQuery indicates an expected call of RunSavedVisibilityQuery.
+func (mr *MockAdminServiceClientMockRecorder) RunSavedVisibilityQuery(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceClient)(nil).RunSavedVisibilityQuery), varargs...)
+}
+
+// SetNexusEndpointAccessPolicy mocks base method.
+func (m *MockAdminServiceClient) SetNexusEndpointAccessPolicy(ctx context.Context, in *adminservice.SetNexusEndpointAccessPolicyRequest, opts ...grpc.CallOption) (*adminservice.SetNexusEndpointAccessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetNexusEndpointAccessPolicy", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetNexusEndpointAccessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNexusEndpointAccessPolicy indicates an expected call of SetNexusEndpointAccessPolicy.
+func (mr *MockAdminServiceClientMockRecorder) SetNexusEndpointAccessPolicy(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNexusEndpointAccessPolicy", reflect.TypeOf((*MockAdminServiceClient)(nil).SetNexusEndpointAccessPolicy), varargs...)
+}
+
+// SetNexusEndpointCircuitBreaker mocks base method.
+func (m *MockAdminServiceClient) SetNexusEndpointCircuitBreaker(ctx context.Context, in *adminservice.SetNexusEndpointCircuitBreakerRequest, opts ...grpc.CallOption) (*adminservice.SetNexusEndpointCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetNexusEndpointCircuitBreaker", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetNexusEndpointCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNexusEndpointCircuitBreaker indicates an expected call of SetNexusEndpointCircuitBreaker.
+func (mr *MockAdminServiceClientMockRecorder) SetNexusEndpointCircuitBreaker(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNexusEndpointCircuitBreaker", reflect.TypeOf((*MockAdminServiceClient)(nil).SetNexusEndpointCircuitBreaker), varargs...)
+}
+
+// StartSearchAttributeMigration mocks base method.
+func (m *MockAdminServiceClient) StartSearchAttributeMigration(ctx context.Context, in *adminservice.StartSearchAttributeMigrationRequest, opts ...grpc.CallOption) (*adminservice.StartSearchAttributeMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartSearchAttributeMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartSearchAttributeMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSearchAttributeMigration indicates an expected call of StartSearchAttributeMigration.
+func (mr *MockAdminServiceClientMockRecorder) StartSearchAttributeMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSearchAttributeMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).StartSearchAttributeMigration), varargs...)
+}
+
+// StartTaskQueueBacklogMigration mocks base method.
+func (m *MockAdminServiceClient) StartTaskQueueBacklogMigration(ctx context.Context, in *adminservice.StartTaskQueueBacklogMigrationRequest, opts ...grpc.CallOption) (*adminservice.StartTaskQueueBacklogMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartTaskQueueBacklogMigration", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartTaskQueueBacklogMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTaskQueueBacklogMigration indicates an expected call of StartTaskQueueBacklogMigration.
+func (mr *MockAdminServiceClientMockRecorder) StartTaskQueueBacklogMigration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTaskQueueBacklogMigration", reflect.TypeOf((*MockAdminServiceClient)(nil).StartTaskQueueBacklogMigration), varargs...)
+}
+
+// StartVisibilityReindex mocks base method.
+func (m *MockAdminServiceClient) StartVisibilityReindex(ctx context.Context, in *adminservice.StartVisibilityReindexRequest, opts ...grpc.CallOption) (*adminservice.StartVisibilityReindexResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartVisibilityReindex", varargs...)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityReindexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityReindex indicates an expected call of StartVisibilityReindex.
+func (mr *MockAdminServiceClientMockRecorder) StartVisibilityReindex(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityReindex", reflect.TypeOf((*MockAdminServiceClient)(nil).StartVisibilityReindex), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -883,24 +1423,104 @@ func (mr *MockAdminServiceClientMockRecorder) StreamWorkflowReplicationMessages(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkflowReplicationMessages", reflect.TypeOf((*MockAdminServiceClient)(nil).StreamWorkflowReplicationMessages), varargs...)
 }
 
-// SyncWorkflowState mocks base method.
-func (m *MockAdminServiceClient) SyncWorkflowState(ctx context.Context, in *adminservice.SyncWorkflowStateRequest, opts ...grpc.CallOption) (*adminservice.SyncWorkflowStateResponse, error) {
+// SyncWorkflowState mocks base method.
+func (m *MockAdminServiceClient) SyncWorkflowState(ctx context.Context, in *adminservice.SyncWorkflowStateRequest, opts ...grpc.CallOption) (*adminservice.SyncWorkflowStateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncWorkflowState", varargs...)
+	ret0, _ := ret[0].(*adminservice.SyncWorkflowStateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncWorkflowState indicates an expected call of SyncWorkflowState.
+func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
+}
+
+// UpdateScheduleCalendarSet mocks base method.
+func (m *MockAdminServiceClient) UpdateScheduleCalendarSet(ctx context.Context, in *adminservice.UpdateScheduleCalendarSetRequest, opts ...grpc.CallOption) (*adminservice.UpdateScheduleCalendarSetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateScheduleCalendarSet", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleCalendarSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleCalendarSet indicates an expected call of UpdateScheduleCalendarSet.
+func (mr *MockAdminServiceClientMockRecorder) UpdateScheduleCalendarSet(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleCalendarSet", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateScheduleCalendarSet), varargs...)
+}
+
+// UpdateTaskQueueBlockedPollers mocks base method.
+func (m *MockAdminServiceClient) UpdateTaskQueueBlockedPollers(ctx context.Context, in *adminservice.UpdateTaskQueueBlockedPollersRequest, opts ...grpc.CallOption) (*adminservice.UpdateTaskQueueBlockedPollersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueBlockedPollers", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueBlockedPollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueBlockedPollers indicates an expected call of UpdateTaskQueueBlockedPollers.
+func (mr *MockAdminServiceClientMockRecorder) UpdateTaskQueueBlockedPollers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueBlockedPollers", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateTaskQueueBlockedPollers), varargs...)
+}
+
+// UpdateTaskQueueFairnessWeights mocks base method.
+func (m *MockAdminServiceClient) UpdateTaskQueueFairnessWeights(ctx context.Context, in *adminservice.UpdateTaskQueueFairnessWeightsRequest, opts ...grpc.CallOption) (*adminservice.UpdateTaskQueueFairnessWeightsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueueFairnessWeights", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueFairnessWeightsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueFairnessWeights indicates an expected call of UpdateTaskQueueFairnessWeights.
+func (mr *MockAdminServiceClientMockRecorder) UpdateTaskQueueFairnessWeights(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueFairnessWeights", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateTaskQueueFairnessWeights), varargs...)
+}
+
+// UpdateTaskQueuePause mocks base method.
+func (m *MockAdminServiceClient) UpdateTaskQueuePause(ctx context.Context, in *adminservice.UpdateTaskQueuePauseRequest, opts ...grpc.CallOption) (*adminservice.UpdateTaskQueuePauseResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SyncWorkflowState", varargs...)
-	ret0, _ := ret[0].(*adminservice.SyncWorkflowStateResponse)
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePause", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueuePauseResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SyncWorkflowState indicates an expected call of SyncWorkflowState.
-func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opts ...any) *gomock.Call {
+// UpdateTaskQueuePause indicates an expected call of UpdateTaskQueuePause.
+func (mr *MockAdminServiceClientMockRecorder) UpdateTaskQueuePause(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePause", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateTaskQueuePause), varargs...)
 }
 
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
@@ -1110,6 +1730,21 @@ func (mr *MockAdminServiceServerMockRecorder) AddTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).AddTasks), arg0, arg1)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) AggregateWorkflowExecutions(arg0 context.Context, arg1 *adminservice.AggregateWorkflowExecutionsRequest) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) AggregateWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).AggregateWorkflowExecutions), arg0, arg1)
+}
+
 // CancelDLQJob mocks base method.
 func (m *MockAdminServiceServer) CancelDLQJob(arg0 context.Context, arg1 *adminservice.CancelDLQJobRequest) (*adminservice.CancelDLQJobResponse, error) {
 	m.ctrl.T.Helper()
@@ -1125,6 +1760,36 @@ func (mr *MockAdminServiceServerMockRecorder) CancelDLQJob(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDLQJob", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelDLQJob), arg0, arg1)
 }
 
+// CancelTaskQueueBacklogMigration mocks base method.
+func (m *MockAdminServiceServer) CancelTaskQueueBacklogMigration(arg0 context.Context, arg1 *adminservice.CancelTaskQueueBacklogMigrationRequest) (*adminservice.CancelTaskQueueBacklogMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTaskQueueBacklogMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelTaskQueueBacklogMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelTaskQueueBacklogMigration indicates an expected call of CancelTaskQueueBacklogMigration.
+func (mr *MockAdminServiceServerMockRecorder) CancelTaskQueueBacklogMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTaskQueueBacklogMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelTaskQueueBacklogMigration), arg0, arg1)
+}
+
+// CancelVisibilityReindex mocks base method.
+func (m *MockAdminServiceServer) CancelVisibilityReindex(arg0 context.Context, arg1 *adminservice.CancelVisibilityReindexRequest) (*adminservice.CancelVisibilityReindexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVisibilityReindex", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CancelVisibilityReindexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelVisibilityReindex indicates an expected call of CancelVisibilityReindex.
+func (mr *MockAdminServiceServerMockRecorder) CancelVisibilityReindex(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVisibilityReindex", reflect.TypeOf((*MockAdminServiceServer)(nil).CancelVisibilityReindex), arg0, arg1)
+}
+
 // CloseShard mocks base method.
 func (m *MockAdminServiceServer) CloseShard(arg0 context.Context, arg1 *adminservice.CloseShardRequest) (*adminservice.CloseShardResponse, error) {
 	m.ctrl.T.Helper()
@@ -1140,6 +1805,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CountWorkers mocks base method.
+func (m *MockAdminServiceServer) CountWorkers(arg0 context.Context, arg1 *adminservice.CountWorkersRequest) (*adminservice.CountWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CountWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkers indicates an expected call of CountWorkers.
+func (mr *MockAdminServiceServerMockRecorder) CountWorkers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkers", reflect.TypeOf((*MockAdminServiceServer)(nil).CountWorkers), arg0, arg1)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceServer) DeepHealthCheck(arg0 context.Context, arg1 *adminservice.DeepHealthCheckRequest) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -1155,6 +1835,36 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) DeleteSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.DeleteSavedVisibilityQueryRequest) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSavedVisibilityQuery indicates an expected call of DeleteSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) DeleteSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteSavedVisibilityQuery), arg0, arg1)
+}
+
+// DeleteTaskQueueTasks mocks base method.
+func (m *MockAdminServiceServer) DeleteTaskQueueTasks(arg0 context.Context, arg1 *adminservice.DeleteTaskQueueTasksRequest) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTaskQueueTasks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteTaskQueueTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTaskQueueTasks indicates an expected call of DeleteTaskQueueTasks.
+func (mr *MockAdminServiceServerMockRecorder) DeleteTaskQueueTasks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTaskQueueTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteTaskQueueTasks), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1940,51 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeSearchAttributeMigration mocks base method.
+func (m *MockAdminServiceServer) DescribeSearchAttributeMigration(arg0 context.Context, arg1 *adminservice.DescribeSearchAttributeMigrationRequest) (*adminservice.DescribeSearchAttributeMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSearchAttributeMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeSearchAttributeMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSearchAttributeMigration indicates an expected call of DescribeSearchAttributeMigration.
+func (mr *MockAdminServiceServerMockRecorder) DescribeSearchAttributeMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSearchAttributeMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeSearchAttributeMigration), arg0, arg1)
+}
+
+// DescribeTaskQueueBacklogMigration mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueueBacklogMigration(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueBacklogMigrationRequest) (*adminservice.DescribeTaskQueueBacklogMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueBacklogMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueBacklogMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueBacklogMigration indicates an expected call of DescribeTaskQueueBacklogMigration.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueueBacklogMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueBacklogMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueueBacklogMigration), arg0, arg1)
+}
+
+// DescribeTaskQueueFairnessKeys mocks base method.
+func (m *MockAdminServiceServer) DescribeTaskQueueFairnessKeys(arg0 context.Context, arg1 *adminservice.DescribeTaskQueueFairnessKeysRequest) (*adminservice.DescribeTaskQueueFairnessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskQueueFairnessKeys", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeTaskQueueFairnessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskQueueFairnessKeys indicates an expected call of DescribeTaskQueueFairnessKeys.
+func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueueFairnessKeys(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueueFairnessKeys", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueueFairnessKeys), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1245,6 +2000,36 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// DescribeVisibilityReindex mocks base method.
+func (m *MockAdminServiceServer) DescribeVisibilityReindex(arg0 context.Context, arg1 *adminservice.DescribeVisibilityReindexRequest) (*adminservice.DescribeVisibilityReindexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVisibilityReindex", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeVisibilityReindexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVisibilityReindex indicates an expected call of DescribeVisibilityReindex.
+func (mr *MockAdminServiceServerMockRecorder) DescribeVisibilityReindex(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVisibilityReindex", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeVisibilityReindex), arg0, arg1)
+}
+
+// ExplainWorkflowExecutions mocks base method.
+func (m *MockAdminServiceServer) ExplainWorkflowExecutions(arg0 context.Context, arg1 *adminservice.ExplainWorkflowExecutionsRequest) (*adminservice.ExplainWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainWorkflowExecutions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExplainWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainWorkflowExecutions indicates an expected call of ExplainWorkflowExecutions.
+func (mr *MockAdminServiceServerMockRecorder) ExplainWorkflowExecutions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainWorkflowExecutions", reflect.TypeOf((*MockAdminServiceServer)(nil).ExplainWorkflowExecutions), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1350,6 +2135,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetNamespaceReplicationMessages(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceReplicationMessages", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNamespaceReplicationMessages), arg0, arg1)
 }
 
+// GetNexusEndpoint mocks base method.
+func (m *MockAdminServiceServer) GetNexusEndpoint(arg0 context.Context, arg1 *adminservice.GetNexusEndpointRequest) (*adminservice.GetNexusEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNexusEndpoint", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetNexusEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNexusEndpoint indicates an expected call of GetNexusEndpoint.
+func (mr *MockAdminServiceServerMockRecorder) GetNexusEndpoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNexusEndpoint", reflect.TypeOf((*MockAdminServiceServer)(nil).GetNexusEndpoint), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *adminservice.GetReplicationMessagesRequest) (*adminservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1455,6 +2255,21 @@ func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
+// ListCallbacks mocks base method.
+func (m *MockAdminServiceServer) ListCallbacks(arg0 context.Context, arg1 *adminservice.ListCallbacksRequest) (*adminservice.ListCallbacksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCallbacks", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListCallbacksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCallbacks indicates an expected call of ListCallbacks.
+func (mr *MockAdminServiceServerMockRecorder) ListCallbacks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCallbacks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListCallbacks), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1500,6 +2315,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListNexusEndpoints mocks base method.
+func (m *MockAdminServiceServer) ListNexusEndpoints(arg0 context.Context, arg1 *adminservice.ListNexusEndpointsRequest) (*adminservice.ListNexusEndpointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNexusEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListNexusEndpointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNexusEndpoints indicates an expected call of ListNexusEndpoints.
+func (mr *MockAdminServiceServerMockRecorder) ListNexusEndpoints(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNexusEndpoints", reflect.TypeOf((*MockAdminServiceServer)(nil).ListNexusEndpoints), arg0, arg1)
+}
+
 // ListQueues mocks base method.
 func (m *MockAdminServiceServer) ListQueues(arg0 context.Context, arg1 *adminservice.ListQueuesRequest) (*adminservice.ListQueuesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1515,6 +2345,51 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListSavedVisibilityQueries mocks base method.
+func (m *MockAdminServiceServer) ListSavedVisibilityQueries(arg0 context.Context, arg1 *adminservice.ListSavedVisibilityQueriesRequest) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSavedVisibilityQueries", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListSavedVisibilityQueriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSavedVisibilityQueries indicates an expected call of ListSavedVisibilityQueries.
+func (mr *MockAdminServiceServerMockRecorder) ListSavedVisibilityQueries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSavedVisibilityQueries", reflect.TypeOf((*MockAdminServiceServer)(nil).ListSavedVisibilityQueries), arg0, arg1)
+}
+
+// ListScheduleActions mocks base method.
+func (m *MockAdminServiceServer) ListScheduleActions(arg0 context.Context, arg1 *adminservice.ListScheduleActionsRequest) (*adminservice.ListScheduleActionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduleActions", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListScheduleActionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleActions indicates an expected call of ListScheduleActions.
+func (mr *MockAdminServiceServerMockRecorder) ListScheduleActions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleActions", reflect.TypeOf((*MockAdminServiceServer)(nil).ListScheduleActions), arg0, arg1)
+}
+
+// ListScheduleCalendarSets mocks base method.
+func (m *MockAdminServiceServer) ListScheduleCalendarSets(arg0 context.Context, arg1 *adminservice.ListScheduleCalendarSetsRequest) (*adminservice.ListScheduleCalendarSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduleCalendarSets", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListScheduleCalendarSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleCalendarSets indicates an expected call of ListScheduleCalendarSets.
+func (mr *MockAdminServiceServerMockRecorder) ListScheduleCalendarSets(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleCalendarSets", reflect.TypeOf((*MockAdminServiceServer)(nil).ListScheduleCalendarSets), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1545,6 +2420,21 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// PreviewSchedule mocks base method.
+func (m *MockAdminServiceServer) PreviewSchedule(arg0 context.Context, arg1 *adminservice.PreviewScheduleRequest) (*adminservice.PreviewScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PreviewScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewSchedule indicates an expected call of PreviewSchedule.
+func (mr *MockAdminServiceServerMockRecorder) PreviewSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewSchedule", reflect.TypeOf((*MockAdminServiceServer)(nil).PreviewSchedule), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1575,6 +2465,21 @@ func (mr *MockAdminServiceServerMockRecorder) PurgeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).PurgeDLQTasks), arg0, arg1)
 }
 
+// PutSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) PutSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.PutSavedVisibilityQueryRequest) (*adminservice.PutSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.PutSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSavedVisibilityQuery indicates an expected call of PutSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) PutSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).PutSavedVisibilityQuery), arg0, arg1)
+}
+
 // ReapplyEvents mocks base method.
 func (m *MockAdminServiceServer) ReapplyEvents(arg0 context.Context, arg1 *adminservice.ReapplyEventsRequest) (*adminservice.ReapplyEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1665,6 +2570,21 @@ func (mr *MockAdminServiceServerMockRecorder) RemoveTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockAdminServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// RenameSearchAttribute mocks base method.
+func (m *MockAdminServiceServer) RenameSearchAttribute(arg0 context.Context, arg1 *adminservice.RenameSearchAttributeRequest) (*adminservice.RenameSearchAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameSearchAttribute", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RenameSearchAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameSearchAttribute indicates an expected call of RenameSearchAttribute.
+func (mr *MockAdminServiceServerMockRecorder) RenameSearchAttribute(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameSearchAttribute", reflect.TypeOf((*MockAdminServiceServer)(nil).RenameSearchAttribute), arg0, arg1)
+}
+
 // ResendReplicationTasks mocks base method.
 func (m *MockAdminServiceServer) ResendReplicationTasks(arg0 context.Context, arg1 *adminservice.ResendReplicationTasksRequest) (*adminservice.ResendReplicationTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1680,6 +2600,111 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// RetryCallback mocks base method.
+func (m *MockAdminServiceServer) RetryCallback(arg0 context.Context, arg1 *adminservice.RetryCallbackRequest) (*adminservice.RetryCallbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryCallback", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RetryCallbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryCallback indicates an expected call of RetryCallback.
+func (mr *MockAdminServiceServerMockRecorder) RetryCallback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryCallback", reflect.TypeOf((*MockAdminServiceServer)(nil).RetryCallback), arg0, arg1)
+}
+
+// RunSavedVisibilityQuery mocks base method.
+func (m *MockAdminServiceServer) RunSavedVisibilityQuery(arg0 context.Context, arg1 *adminservice.RunSavedVisibilityQueryRequest) (*adminservice.RunSavedVisibilityQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunSavedVisibilityQuery", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.RunSavedVisibilityQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunSavedVisibilityQuery indicates an expected call of RunSavedVisibilityQuery.
+func (mr *MockAdminServiceServerMockRecorder) RunSavedVisibilityQuery(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSavedVisibilityQuery", reflect.TypeOf((*MockAdminServiceServer)(nil).RunSavedVisibilityQuery), arg0, arg1)
+}
+
+// SetNexusEndpointAccessPolicy mocks base method.
+func (m *MockAdminServiceServer) SetNexusEndpointAccessPolicy(arg0 context.Context, arg1 *adminservice.SetNexusEndpointAccessPolicyRequest) (*adminservice.SetNexusEndpointAccessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNexusEndpointAccessPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetNexusEndpointAccessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNexusEndpointAccessPolicy indicates an expected call of SetNexusEndpointAccessPolicy.
+func (mr *MockAdminServiceServerMockRecorder) SetNexusEndpointAccessPolicy(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNexusEndpointAccessPolicy", reflect.TypeOf((*MockAdminServiceServer)(nil).SetNexusEndpointAccessPolicy), arg0, arg1)
+}
+
+// SetNexusEndpointCircuitBreaker mocks base method.
+func (m *MockAdminServiceServer) SetNexusEndpointCircuitBreaker(arg0 context.Context, arg1 *adminservice.SetNexusEndpointCircuitBreakerRequest) (*adminservice.SetNexusEndpointCircuitBreakerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNexusEndpointCircuitBreaker", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetNexusEndpointCircuitBreakerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNexusEndpointCircuitBreaker indicates an expected call of SetNexusEndpointCircuitBreaker.
+func (mr *MockAdminServiceServerMockRecorder) SetNexusEndpointCircuitBreaker(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNexusEndpointCircuitBreaker", reflect.TypeOf((*MockAdminServiceServer)(nil).SetNexusEndpointCircuitBreaker), arg0, arg1)
+}
+
+// StartSearchAttributeMigration mocks base method.
+func (m *MockAdminServiceServer) StartSearchAttributeMigration(arg0 context.Context, arg1 *adminservice.StartSearchAttributeMigrationRequest) (*adminservice.StartSearchAttributeMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSearchAttributeMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartSearchAttributeMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSearchAttributeMigration indicates an expected call of StartSearchAttributeMigration.
+func (mr *MockAdminServiceServerMockRecorder) StartSearchAttributeMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSearchAttributeMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).StartSearchAttributeMigration), arg0, arg1)
+}
+
+// StartTaskQueueBacklogMigration mocks base method.
+func (m *MockAdminServiceServer) StartTaskQueueBacklogMigration(arg0 context.Context, arg1 *adminservice.StartTaskQueueBacklogMigrationRequest) (*adminservice.StartTaskQueueBacklogMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTaskQueueBacklogMigration", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartTaskQueueBacklogMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTaskQueueBacklogMigration indicates an expected call of StartTaskQueueBacklogMigration.
+func (mr *MockAdminServiceServerMockRecorder) StartTaskQueueBacklogMigration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTaskQueueBacklogMigration", reflect.TypeOf((*MockAdminServiceServer)(nil).StartTaskQueueBacklogMigration), arg0, arg1)
+}
+
+// StartVisibilityReindex mocks base method.
+func (m *MockAdminServiceServer) StartVisibilityReindex(arg0 context.Context, arg1 *adminservice.StartVisibilityReindexRequest) (*adminservice.StartVisibilityReindexResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVisibilityReindex", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.StartVisibilityReindexResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVisibilityReindex indicates an expected call of StartVisibilityReindex.
+func (mr *MockAdminServiceServerMockRecorder) StartVisibilityReindex(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVisibilityReindex", reflect.TypeOf((*MockAdminServiceServer)(nil).StartVisibilityReindex), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1709,6 +2734,66 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateScheduleCalendarSet mocks base method.
+func (m *MockAdminServiceServer) UpdateScheduleCalendarSet(arg0 context.Context, arg1 *adminservice.UpdateScheduleCalendarSetRequest) (*adminservice.UpdateScheduleCalendarSetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleCalendarSet", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleCalendarSetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleCalendarSet indicates an expected call of UpdateScheduleCalendarSet.
+func (mr *MockAdminServiceServerMockRecorder) UpdateScheduleCalendarSet(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleCalendarSet", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateScheduleCalendarSet), arg0, arg1)
+}
+
+// UpdateTaskQueueBlockedPollers mocks base method.
+func (m *MockAdminServiceServer) UpdateTaskQueueBlockedPollers(arg0 context.Context, arg1 *adminservice.UpdateTaskQueueBlockedPollersRequest) (*adminservice.UpdateTaskQueueBlockedPollersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueBlockedPollers", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueBlockedPollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueBlockedPollers indicates an expected call of UpdateTaskQueueBlockedPollers.
+func (mr *MockAdminServiceServerMockRecorder) UpdateTaskQueueBlockedPollers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueBlockedPollers", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateTaskQueueBlockedPollers), arg0, arg1)
+}
+
+// UpdateTaskQueueFairnessWeights mocks base method.
+func (m *MockAdminServiceServer) UpdateTaskQueueFairnessWeights(arg0 context.Context, arg1 *adminservice.UpdateTaskQueueFairnessWeightsRequest) (*adminservice.UpdateTaskQueueFairnessWeightsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueueFairnessWeights", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueueFairnessWeightsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueueFairnessWeights indicates an expected call of UpdateTaskQueueFairnessWeights.
+func (mr *MockAdminServiceServerMockRecorder) UpdateTaskQueueFairnessWeights(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueFairnessWeights", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateTaskQueueFairnessWeights), arg0, arg1)
+}
+
+// UpdateTaskQueuePause mocks base method.
+func (m *MockAdminServiceServer) UpdateTaskQueuePause(arg0 context.Context, arg1 *adminservice.UpdateTaskQueuePauseRequest) (*adminservice.UpdateTaskQueuePauseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePause", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateTaskQueuePauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePause indicates an expected call of UpdateTaskQueuePause.
+func (mr *MockAdminServiceServerMockRecorder) UpdateTaskQueuePause(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePause", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateTaskQueuePause), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -82,3 +82,42 @@ func CallbackStateFromString(s string) (CallbackState, error) {
 	}
 	return CallbackState(0), fmt.Errorf("%s is not a valid CallbackState", s)
 }
+
+var (
+	CircuitBreakerState_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Closed":      1,
+		"HalfOpen":    2,
+		"Open":        3,
+	}
+)
+
+// CircuitBreakerStateFromString parses a CircuitBreakerState value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to CircuitBreakerState
+func CircuitBreakerStateFromString(s string) (CircuitBreakerState, error) {
+	if v, ok := CircuitBreakerState_value[s]; ok {
+		return CircuitBreakerState(v), nil
+	} else if v, ok := CircuitBreakerState_shorthandValue[s]; ok {
+		return CircuitBreakerState(v), nil
+	}
+	return CircuitBreakerState(0), fmt.Errorf("%s is not a valid CircuitBreakerState", s)
+}
+
+var (
+	CircuitBreakerOverride_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"ForceOpen":   1,
+		"ForceClosed": 2,
+	}
+)
+
+// CircuitBreakerOverrideFromString parses a CircuitBreakerOverride value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to CircuitBreakerOverride
+func CircuitBreakerOverrideFromString(s string) (CircuitBreakerOverride, error) {
+	if v, ok := CircuitBreakerOverride_value[s]; ok {
+		return CircuitBreakerOverride(v), nil
+	} else if v, ok := CircuitBreakerOverride_shorthandValue[s]; ok {
+		return CircuitBreakerOverride(v), nil
+	}
+	return CircuitBreakerOverride(0), fmt.Errorf("%s is not a valid CircuitBreakerOverride", s)
+}
@@ -280,6 +280,137 @@ func (CallbackState) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{3}
 }
 
+// State of a circuit breaker protecting calls to a destination.
+type CircuitBreakerState int32
+
+const (
+	CIRCUIT_BREAKER_STATE_UNSPECIFIED CircuitBreakerState = 0
+	// Calls are allowed.
+	CIRCUIT_BREAKER_STATE_CLOSED CircuitBreakerState = 1
+	// A limited number of calls are allowed to probe whether the destination recovered.
+	CIRCUIT_BREAKER_STATE_HALF_OPEN CircuitBreakerState = 2
+	// Calls are rejected.
+	CIRCUIT_BREAKER_STATE_OPEN CircuitBreakerState = 3
+)
+
+// Enum value maps for CircuitBreakerState.
+var (
+	CircuitBreakerState_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_STATE_UNSPECIFIED",
+		1: "CIRCUIT_BREAKER_STATE_CLOSED",
+		2: "CIRCUIT_BREAKER_STATE_HALF_OPEN",
+		3: "CIRCUIT_BREAKER_STATE_OPEN",
+	}
+	CircuitBreakerState_value = map[string]int32{
+		"CIRCUIT_BREAKER_STATE_UNSPECIFIED": 0,
+		"CIRCUIT_BREAKER_STATE_CLOSED":      1,
+		"CIRCUIT_BREAKER_STATE_HALF_OPEN":   2,
+		"CIRCUIT_BREAKER_STATE_OPEN":        3,
+	}
+)
+
+func (x CircuitBreakerState) Enum() *CircuitBreakerState {
+	p := new(CircuitBreakerState)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerState) String() string {
+	switch x {
+	case CIRCUIT_BREAKER_STATE_UNSPECIFIED:
+		return "Unspecified"
+	case CIRCUIT_BREAKER_STATE_CLOSED:
+		return "Closed"
+	case CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		return "HalfOpen"
+	case CIRCUIT_BREAKER_STATE_OPEN:
+		return "Open"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (CircuitBreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[4].Descriptor()
+}
+
+func (CircuitBreakerState) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[4]
+}
+
+func (x CircuitBreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerState.Descriptor instead.
+func (CircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{4}
+}
+
+// Manual override of a circuit breaker set by an operator.
+type CircuitBreakerOverride int32
+
+const (
+	// No override, the circuit breaker trips and recovers based on call outcomes.
+	CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED CircuitBreakerOverride = 0
+	// Reject all calls.
+	CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN CircuitBreakerOverride = 1
+	// Allow all calls regardless of their outcome.
+	CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED CircuitBreakerOverride = 2
+)
+
+// Enum value maps for CircuitBreakerOverride.
+var (
+	CircuitBreakerOverride_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED",
+		1: "CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN",
+		2: "CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED",
+	}
+	CircuitBreakerOverride_value = map[string]int32{
+		"CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED":  0,
+		"CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN":   1,
+		"CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED": 2,
+	}
+)
+
+func (x CircuitBreakerOverride) Enum() *CircuitBreakerOverride {
+	p := new(CircuitBreakerOverride)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerOverride) String() string {
+	switch x {
+	case CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED:
+		return "Unspecified"
+	case CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN:
+		return "ForceOpen"
+	case CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED:
+		return "ForceClosed"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (CircuitBreakerOverride) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_common_proto_enumTypes[5].Descriptor()
+}
+
+func (CircuitBreakerOverride) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_common_proto_enumTypes[5]
+}
+
+func (x CircuitBreakerOverride) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerOverride.Descriptor instead.
+func (CircuitBreakerOverride) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_common_proto_rawDescGZIP(), []int{5}
+}
+
 var File_temporal_server_api_enums_v1_common_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_enums_v1_common_proto_rawDesc = "" +
@@ -302,7 +433,16 @@ const file_temporal_server_api_enums_v1_common_proto_rawDesc = "" +
 	"\x18CALLBACK_STATE_SCHEDULED\x10\x02\x12\x1e\n" +
 	"\x1aCALLBACK_STATE_BACKING_OFF\x10\x03\x12\x19\n" +
 	"\x15CALLBACK_STATE_FAILED\x10\x04\x12\x1c\n" +
-	"\x18CALLBACK_STATE_SUCCEEDED\x10\x05B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+	"\x18CALLBACK_STATE_SUCCEEDED\x10\x05*\xa3\x01\n" +
+	"\x13CircuitBreakerState\x12%\n" +
+	"!CIRCUIT_BREAKER_STATE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCIRCUIT_BREAKER_STATE_CLOSED\x10\x01\x12#\n" +
+	"\x1fCIRCUIT_BREAKER_STATE_HALF_OPEN\x10\x02\x12\x1e\n" +
+	"\x1aCIRCUIT_BREAKER_STATE_OPEN\x10\x03*\x96\x01\n" +
+	"\x16CircuitBreakerOverride\x12(\n" +
+	"$CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED\x10\x00\x12'\n" +
+	"#CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN\x10\x01\x12)\n" +
+	"%CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED\x10\x02B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
 
 var (
 	file_temporal_server_api_enums_v1_common_proto_rawDescOnce sync.Once
@@ -316,12 +456,14 @@ func file_temporal_server_api_enums_v1_common_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_common_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_temporal_server_api_enums_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
 var file_temporal_server_api_enums_v1_common_proto_goTypes = []any{
-	(DeadLetterQueueType)(0),   // 0: temporal.server.api.enums.v1.DeadLetterQueueType
-	(ChecksumFlavor)(0),        // 1: temporal.server.api.enums.v1.ChecksumFlavor
-	(SchedulerInvokerState)(0), // 2: temporal.server.api.enums.v1.SchedulerInvokerState
-	(CallbackState)(0),         // 3: temporal.server.api.enums.v1.CallbackState
+	(DeadLetterQueueType)(0),    // 0: temporal.server.api.enums.v1.DeadLetterQueueType
+	(ChecksumFlavor)(0),         // 1: temporal.server.api.enums.v1.ChecksumFlavor
+	(SchedulerInvokerState)(0),  // 2: temporal.server.api.enums.v1.SchedulerInvokerState
+	(CallbackState)(0),          // 3: temporal.server.api.enums.v1.CallbackState
+	(CircuitBreakerState)(0),    // 4: temporal.server.api.enums.v1.CircuitBreakerState
+	(CircuitBreakerOverride)(0), // 5: temporal.server.api.enums.v1.CircuitBreakerOverride
 }
 var file_temporal_server_api_enums_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -341,7 +483,7 @@ func file_temporal_server_api_enums_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_common_proto_rawDesc), len(file_temporal_server_api_enums_v1_common_proto_rawDesc)),
-			NumEnums:      4,
+			NumEnums:      6,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,65 @@
+// Code generated by protoc-gen-go-helpers. DO NOT EDIT.
+package enums
+
+import (
+	"fmt"
+)
+
+var (
+	ScheduleActionSkipReason_shorthandValue = map[string]int32{
+		"Unspecified":   0,
+		"Overlap":       1,
+		"CatchupWindow": 2,
+		"Dependency":    3,
+	}
+)
+
+// ScheduleActionSkipReasonFromString parses a ScheduleActionSkipReason value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ScheduleActionSkipReason
+func ScheduleActionSkipReasonFromString(s string) (ScheduleActionSkipReason, error) {
+	if v, ok := ScheduleActionSkipReason_value[s]; ok {
+		return ScheduleActionSkipReason(v), nil
+	} else if v, ok := ScheduleActionSkipReason_shorthandValue[s]; ok {
+		return ScheduleActionSkipReason(v), nil
+	}
+	return ScheduleActionSkipReason(0), fmt.Errorf("%s is not a valid ScheduleActionSkipReason", s)
+}
+
+var (
+	ScheduleDependencyPolicy_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Skip":        1,
+		"Fail":        2,
+	}
+)
+
+// ScheduleDependencyPolicyFromString parses a ScheduleDependencyPolicy value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ScheduleDependencyPolicy
+func ScheduleDependencyPolicyFromString(s string) (ScheduleDependencyPolicy, error) {
+	if v, ok := ScheduleDependencyPolicy_value[s]; ok {
+		return ScheduleDependencyPolicy(v), nil
+	} else if v, ok := ScheduleDependencyPolicy_shorthandValue[s]; ok {
+		return ScheduleDependencyPolicy(v), nil
+	}
+	return ScheduleDependencyPolicy(0), fmt.Errorf("%s is not a valid ScheduleDependencyPolicy", s)
+}
+
+var (
+	ScheduleDependencyStatus_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Satisfied":   1,
+		"Pending":     2,
+		"Failed":      3,
+	}
+)
+
+// ScheduleDependencyStatusFromString parses a ScheduleDependencyStatus value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to ScheduleDependencyStatus
+func ScheduleDependencyStatusFromString(s string) (ScheduleDependencyStatus, error) {
+	if v, ok := ScheduleDependencyStatus_value[s]; ok {
+		return ScheduleDependencyStatus(v), nil
+	} else if v, ok := ScheduleDependencyStatus_shorthandValue[s]; ok {
+		return ScheduleDependencyStatus(v), nil
+	}
+	return ScheduleDependencyStatus(0), fmt.Errorf("%s is not a valid ScheduleDependencyStatus", s)
+}
@@ -89,3 +89,22 @@ func TaskPriorityFromString(s string) (TaskPriority, error) {
 	}
 	return TaskPriority(0), fmt.Errorf("%s is not a valid TaskPriority", s)
 }
+
+var (
+	TaskQueuePauseMode_shorthandValue = map[string]int32{
+		"Unspecified": 0,
+		"Paused":      1,
+		"Draining":    2,
+	}
+)
+
+// TaskQueuePauseModeFromString parses a TaskQueuePauseMode value from  either the protojson
+// canonical SCREAMING_CASE enum or the traditional temporal PascalCase enum to TaskQueuePauseMode
+func TaskQueuePauseModeFromString(s string) (TaskQueuePauseMode, error) {
+	if v, ok := TaskQueuePauseMode_value[s]; ok {
+		return TaskQueuePauseMode(v), nil
+	} else if v, ok := TaskQueuePauseMode_shorthandValue[s]; ok {
+		return TaskQueuePauseMode(v), nil
+	}
+	return TaskQueuePauseMode(0), fmt.Errorf("%s is not a valid TaskQueuePauseMode", s)
+}
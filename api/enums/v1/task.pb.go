@@ -220,15 +220,11 @@ func (x TaskType) String() string {
 		return "TransferCloseExecution"
 	case TASK_TYPE_TRANSFER_CANCEL_EXECUTION:
 		return "TransferCancelExecution"
-
-		// Deprecated: Use TaskType.Descriptor instead.
 	case TASK_TYPE_TRANSFER_START_CHILD_EXECUTION:
 		return "TransferStartChildExecution"
 	case TASK_TYPE_TRANSFER_SIGNAL_EXECUTION:
 		return "TransferSignalExecution"
 	case TASK_TYPE_TRANSFER_RESET_WORKFLOW:
-
-		// TaskPriority is only used for replication task as of May 2024
 		return "TransferResetWorkflow"
 	case TASK_TYPE_WORKFLOW_TASK_TIMEOUT:
 		return "WorkflowTaskTimeout"
@@ -236,14 +232,10 @@ func (x TaskType) String() string {
 		return "ActivityTimeout"
 	case TASK_TYPE_USER_TIMER:
 		return "UserTimer"
-
-		// gap between index can be used for future priority levels if needed
 	case TASK_TYPE_WORKFLOW_RUN_TIMEOUT:
 		return "WorkflowRunTimeout"
 	case TASK_TYPE_DELETE_HISTORY_EVENT:
 		return "DeleteHistoryEvent"
-
-		// Enum value maps for TaskPriority.
 	case TASK_TYPE_ACTIVITY_RETRY_TIMER:
 		return "ActivityRetryTimer"
 	case TASK_TYPE_WORKFLOW_BACKOFF_TIMER:
@@ -270,8 +262,6 @@ func (x TaskType) String() string {
 		return "WorkflowExecutionTimeout"
 	case TASK_TYPE_REPLICATION_SYNC_HSM:
 		return "ReplicationSyncHsm"
-
-		// Deprecated: Use TaskPriority.Descriptor instead.
 	case TASK_TYPE_REPLICATION_SYNC_VERSIONED_TRANSITION:
 		return "ReplicationSyncVersionedTransition"
 	case TASK_TYPE_CHASM_PURE:
@@ -296,19 +286,22 @@ func (x TaskType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+// Deprecated: Use TaskType.Descriptor instead.
 func (TaskType) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{1}
 }
 
+// TaskPriority is only used for replication task as of May 2024
 type TaskPriority int32
 
 const (
 	TASK_PRIORITY_UNSPECIFIED TaskPriority = 0
 	TASK_PRIORITY_HIGH        TaskPriority = 1
-
+	// gap between index can be used for future priority levels if needed
 	TASK_PRIORITY_LOW TaskPriority = 10
 )
 
+// Enum value maps for TaskPriority.
 var (
 	TaskPriority_name = map[int32]string{
 		0:  "TASK_PRIORITY_UNSPECIFIED",
@@ -354,10 +347,73 @@ func (x TaskPriority) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
+// Deprecated: Use TaskPriority.Descriptor instead.
 func (TaskPriority) EnumDescriptor() ([]byte, []int) {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{2}
 }
 
+// TaskQueuePauseMode is how an operator pause affects a task queue.
+type TaskQueuePauseMode int32
+
+const (
+	TASK_QUEUE_PAUSE_MODE_UNSPECIFIED TaskQueuePauseMode = 0
+	// New tasks are accepted and written to the backlog, but no tasks are dispatched to pollers.
+	TASK_QUEUE_PAUSE_MODE_PAUSED TaskQueuePauseMode = 1
+	// New tasks are rejected, the remaining backlog is dispatched to pollers.
+	TASK_QUEUE_PAUSE_MODE_DRAINING TaskQueuePauseMode = 2
+)
+
+// Enum value maps for TaskQueuePauseMode.
+var (
+	TaskQueuePauseMode_name = map[int32]string{
+		0: "TASK_QUEUE_PAUSE_MODE_UNSPECIFIED",
+		1: "TASK_QUEUE_PAUSE_MODE_PAUSED",
+		2: "TASK_QUEUE_PAUSE_MODE_DRAINING",
+	}
+	TaskQueuePauseMode_value = map[string]int32{
+		"TASK_QUEUE_PAUSE_MODE_UNSPECIFIED": 0,
+		"TASK_QUEUE_PAUSE_MODE_PAUSED":      1,
+		"TASK_QUEUE_PAUSE_MODE_DRAINING":    2,
+	}
+)
+
+func (x TaskQueuePauseMode) Enum() *TaskQueuePauseMode {
+	p := new(TaskQueuePauseMode)
+	*p = x
+	return p
+}
+
+func (x TaskQueuePauseMode) String() string {
+	switch x {
+	case TASK_QUEUE_PAUSE_MODE_UNSPECIFIED:
+		return "Unspecified"
+	case TASK_QUEUE_PAUSE_MODE_PAUSED:
+		return "Paused"
+	case TASK_QUEUE_PAUSE_MODE_DRAINING:
+		return "Draining"
+	default:
+		return strconv.Itoa(int(x))
+	}
+
+}
+
+func (TaskQueuePauseMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_temporal_server_api_enums_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskQueuePauseMode) Type() protoreflect.EnumType {
+	return &file_temporal_server_api_enums_v1_task_proto_enumTypes[3]
+}
+
+func (x TaskQueuePauseMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskQueuePauseMode.Descriptor instead.
+func (TaskQueuePauseMode) EnumDescriptor() ([]byte, []int) {
+	return file_temporal_server_api_enums_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
 var File_temporal_server_api_enums_v1_task_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_enums_v1_task_proto_rawDesc = "" +
@@ -405,7 +461,11 @@ const file_temporal_server_api_enums_v1_task_proto_rawDesc = "" +
 	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12TASK_PRIORITY_HIGH\x10\x01\x12\x15\n" +
 	"\x11TASK_PRIORITY_LOW\x10\n" +
-	"B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
+	"*\x81\x01\n" +
+	"\x12TaskQueuePauseMode\x12%\n" +
+	"!TASK_QUEUE_PAUSE_MODE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cTASK_QUEUE_PAUSE_MODE_PAUSED\x10\x01\x12\"\n" +
+	"\x1eTASK_QUEUE_PAUSE_MODE_DRAINING\x10\x02B*Z(go.temporal.io/server/api/enums/v1;enumsb\x06proto3"
 
 var (
 	file_temporal_server_api_enums_v1_task_proto_rawDescOnce sync.Once
@@ -419,11 +479,12 @@ func file_temporal_server_api_enums_v1_task_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_enums_v1_task_proto_rawDescData
 }
 
-var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_temporal_server_api_enums_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_temporal_server_api_enums_v1_task_proto_goTypes = []any{
-	(TaskSource)(0),   // 0: temporal.server.api.enums.v1.TaskSource
-	(TaskType)(0),     // 1: temporal.server.api.enums.v1.TaskType
-	(TaskPriority)(0), // 2: temporal.server.api.enums.v1.TaskPriority
+	(TaskSource)(0),         // 0: temporal.server.api.enums.v1.TaskSource
+	(TaskType)(0),           // 1: temporal.server.api.enums.v1.TaskType
+	(TaskPriority)(0),       // 2: temporal.server.api.enums.v1.TaskPriority
+	(TaskQueuePauseMode)(0), // 3: temporal.server.api.enums.v1.TaskQueuePauseMode
 }
 var file_temporal_server_api_enums_v1_task_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -443,7 +504,7 @@ func file_temporal_server_api_enums_v1_task_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_enums_v1_task_proto_rawDesc), len(file_temporal_server_api_enums_v1_task_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
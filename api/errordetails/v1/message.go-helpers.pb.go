@@ -337,3 +337,40 @@ func (this *ActivityStartDuringTransitionFailure) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type TaskQueueDrainingFailure to the protobuf v3 wire format
+func (val *TaskQueueDrainingFailure) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueueDrainingFailure from the protobuf v3 wire format
+func (val *TaskQueueDrainingFailure) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueueDrainingFailure) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueueDrainingFailure values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueueDrainingFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueueDrainingFailure
+	switch t := that.(type) {
+	case *TaskQueueDrainingFailure:
+		that1 = t
+	case TaskQueueDrainingFailure:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -5111,6 +5111,80 @@ func (this *DeepHealthCheckResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type GetOutboundDestinationHealthRequest to the protobuf v3 wire format
+func (val *GetOutboundDestinationHealthRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetOutboundDestinationHealthRequest from the protobuf v3 wire format
+func (val *GetOutboundDestinationHealthRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetOutboundDestinationHealthRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetOutboundDestinationHealthRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetOutboundDestinationHealthRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetOutboundDestinationHealthRequest
+	switch t := that.(type) {
+	case *GetOutboundDestinationHealthRequest:
+		that1 = t
+	case GetOutboundDestinationHealthRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetOutboundDestinationHealthResponse to the protobuf v3 wire format
+func (val *GetOutboundDestinationHealthResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetOutboundDestinationHealthResponse from the protobuf v3 wire format
+func (val *GetOutboundDestinationHealthResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetOutboundDestinationHealthResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetOutboundDestinationHealthResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetOutboundDestinationHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetOutboundDestinationHealthResponse
+	switch t := that.(type) {
+	case *GetOutboundDestinationHealthResponse:
+		that1 = t
+	case GetOutboundDestinationHealthResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type SyncWorkflowStateRequest to the protobuf v3 wire format
 func (val *SyncWorkflowStateRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -86,6 +86,7 @@ const (
 	HistoryService_CompleteNexusOperation_FullMethodName                 = "/temporal.server.api.historyservice.v1.HistoryService/CompleteNexusOperation"
 	HistoryService_InvokeStateMachineMethod_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/InvokeStateMachineMethod"
 	HistoryService_DeepHealthCheck_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/DeepHealthCheck"
+	HistoryService_GetOutboundDestinationHealth_FullMethodName           = "/temporal.server.api.historyservice.v1.HistoryService/GetOutboundDestinationHealth"
 	HistoryService_SyncWorkflowState_FullMethodName                      = "/temporal.server.api.historyservice.v1.HistoryService/SyncWorkflowState"
 	HistoryService_UpdateActivityOptions_FullMethodName                  = "/temporal.server.api.historyservice.v1.HistoryService/UpdateActivityOptions"
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
@@ -309,6 +310,8 @@ type HistoryServiceClient interface {
 	InvokeStateMachineMethod(ctx context.Context, in *InvokeStateMachineMethodRequest, opts ...grpc.CallOption) (*InvokeStateMachineMethodResponse, error)
 	// Deep health check history service dependencies health status
 	DeepHealthCheck(ctx context.Context, in *DeepHealthCheckRequest, opts ...grpc.CallOption) (*DeepHealthCheckResponse, error)
+	// Returns the health of the calls made by the outbound queue of a history host, per destination.
+	GetOutboundDestinationHealth(ctx context.Context, in *GetOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*GetOutboundDestinationHealthResponse, error)
 	SyncWorkflowState(ctx context.Context, in *SyncWorkflowStateRequest, opts ...grpc.CallOption) (*SyncWorkflowStateResponse, error)
 	// UpdateActivityOptions is called by the client to update the options of an activity
 	// (-- api-linter: core::0134::method-signature=disabled
@@ -995,6 +998,15 @@ func (c *historyServiceClient) DeepHealthCheck(ctx context.Context, in *DeepHeal
 	return out, nil
 }
 
+func (c *historyServiceClient) GetOutboundDestinationHealth(ctx context.Context, in *GetOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*GetOutboundDestinationHealthResponse, error) {
+	out := new(GetOutboundDestinationHealthResponse)
+	err := c.cc.Invoke(ctx, HistoryService_GetOutboundDestinationHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) SyncWorkflowState(ctx context.Context, in *SyncWorkflowStateRequest, opts ...grpc.CallOption) (*SyncWorkflowStateResponse, error) {
 	out := new(SyncWorkflowStateResponse)
 	err := c.cc.Invoke(ctx, HistoryService_SyncWorkflowState_FullMethodName, in, out, opts...)
@@ -1256,6 +1268,8 @@ type HistoryServiceServer interface {
 	InvokeStateMachineMethod(context.Context, *InvokeStateMachineMethodRequest) (*InvokeStateMachineMethodResponse, error)
 	// Deep health check history service dependencies health status
 	DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error)
+	// Returns the health of the calls made by the outbound queue of a history host, per destination.
+	GetOutboundDestinationHealth(context.Context, *GetOutboundDestinationHealthRequest) (*GetOutboundDestinationHealthResponse, error)
 	SyncWorkflowState(context.Context, *SyncWorkflowStateRequest) (*SyncWorkflowStateResponse, error)
 	// UpdateActivityOptions is called by the client to update the options of an activity
 	// (-- api-linter: core::0134::method-signature=disabled
@@ -1521,6 +1535,9 @@ func (UnimplementedHistoryServiceServer) InvokeStateMachineMethod(context.Contex
 func (UnimplementedHistoryServiceServer) DeepHealthCheck(context.Context, *DeepHealthCheckRequest) (*DeepHealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeepHealthCheck not implemented")
 }
+func (UnimplementedHistoryServiceServer) GetOutboundDestinationHealth(context.Context, *GetOutboundDestinationHealthRequest) (*GetOutboundDestinationHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundDestinationHealth not implemented")
+}
 func (UnimplementedHistoryServiceServer) SyncWorkflowState(context.Context, *SyncWorkflowStateRequest) (*SyncWorkflowStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncWorkflowState not implemented")
 }
@@ -2745,6 +2762,24 @@ func _HistoryService_DeepHealthCheck_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_GetOutboundDestinationHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutboundDestinationHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).GetOutboundDestinationHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_GetOutboundDestinationHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).GetOutboundDestinationHealth(ctx, req.(*GetOutboundDestinationHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_SyncWorkflowState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SyncWorkflowStateRequest)
 	if err := dec(in); err != nil {
@@ -3102,6 +3137,10 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeepHealthCheck",
 			Handler:    _HistoryService_DeepHealthCheck_Handler,
 		},
+		{
+			MethodName: "GetOutboundDestinationHealth",
+			Handler:    _HistoryService_GetOutboundDestinationHealth_Handler,
+		},
 		{
 			MethodName: "SyncWorkflowState",
 			Handler:    _HistoryService_SyncWorkflowState_Handler,
@@ -383,6 +383,26 @@ func (mr *MockHistoryServiceClientMockRecorder) GetMutableState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetMutableState), varargs...)
 }
 
+// GetOutboundDestinationHealth mocks base method.
+func (m *MockHistoryServiceClient) GetOutboundDestinationHealth(ctx context.Context, in *historyservice.GetOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*historyservice.GetOutboundDestinationHealthResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOutboundDestinationHealth", varargs...)
+	ret0, _ := ret[0].(*historyservice.GetOutboundDestinationHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboundDestinationHealth indicates an expected call of GetOutboundDestinationHealth.
+func (mr *MockHistoryServiceClientMockRecorder) GetOutboundDestinationHealth(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundDestinationHealth", reflect.TypeOf((*MockHistoryServiceClient)(nil).GetOutboundDestinationHealth), varargs...)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceClient) GetReplicationMessages(ctx context.Context, in *historyservice.GetReplicationMessagesRequest, opts ...grpc.CallOption) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1880,6 +1900,21 @@ func (mr *MockHistoryServiceServerMockRecorder) GetMutableState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutableState", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetMutableState), arg0, arg1)
 }
 
+// GetOutboundDestinationHealth mocks base method.
+func (m *MockHistoryServiceServer) GetOutboundDestinationHealth(arg0 context.Context, arg1 *historyservice.GetOutboundDestinationHealthRequest) (*historyservice.GetOutboundDestinationHealthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutboundDestinationHealth", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.GetOutboundDestinationHealthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutboundDestinationHealth indicates an expected call of GetOutboundDestinationHealth.
+func (mr *MockHistoryServiceServerMockRecorder) GetOutboundDestinationHealth(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutboundDestinationHealth", reflect.TypeOf((*MockHistoryServiceServer)(nil).GetOutboundDestinationHealth), arg0, arg1)
+}
+
 // GetReplicationMessages mocks base method.
 func (m *MockHistoryServiceServer) GetReplicationMessages(arg0 context.Context, arg1 *historyservice.GetReplicationMessagesRequest) (*historyservice.GetReplicationMessagesResponse, error) {
 	m.ctrl.T.Helper()
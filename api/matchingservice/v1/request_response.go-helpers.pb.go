@@ -2595,6 +2595,80 @@ func (this *ListWorkersResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CountWorkersRequest to the protobuf v3 wire format
+func (val *CountWorkersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CountWorkersRequest from the protobuf v3 wire format
+func (val *CountWorkersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CountWorkersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CountWorkersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CountWorkersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CountWorkersRequest
+	switch t := that.(type) {
+	case *CountWorkersRequest:
+		that1 = t
+	case CountWorkersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CountWorkersResponse to the protobuf v3 wire format
+func (val *CountWorkersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CountWorkersResponse from the protobuf v3 wire format
+func (val *CountWorkersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CountWorkersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CountWorkersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CountWorkersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CountWorkersResponse
+	switch t := that.(type) {
+	case *CountWorkersResponse:
+		that1 = t
+	case CountWorkersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type UpdateTaskQueueConfigRequest to the protobuf v3 wire format
 func (val *UpdateTaskQueueConfigRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -2742,3 +2816,299 @@ func (this *DescribeWorkerResponse) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type UpdateFairnessWeightsRequest to the protobuf v3 wire format
+func (val *UpdateFairnessWeightsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFairnessWeightsRequest from the protobuf v3 wire format
+func (val *UpdateFairnessWeightsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFairnessWeightsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFairnessWeightsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFairnessWeightsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFairnessWeightsRequest
+	switch t := that.(type) {
+	case *UpdateFairnessWeightsRequest:
+		that1 = t
+	case UpdateFairnessWeightsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateFairnessWeightsResponse to the protobuf v3 wire format
+func (val *UpdateFairnessWeightsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateFairnessWeightsResponse from the protobuf v3 wire format
+func (val *UpdateFairnessWeightsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateFairnessWeightsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateFairnessWeightsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateFairnessWeightsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateFairnessWeightsResponse
+	switch t := that.(type) {
+	case *UpdateFairnessWeightsResponse:
+		that1 = t
+	case UpdateFairnessWeightsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeFairnessKeysRequest to the protobuf v3 wire format
+func (val *DescribeFairnessKeysRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeFairnessKeysRequest from the protobuf v3 wire format
+func (val *DescribeFairnessKeysRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeFairnessKeysRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeFairnessKeysRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeFairnessKeysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeFairnessKeysRequest
+	switch t := that.(type) {
+	case *DescribeFairnessKeysRequest:
+		that1 = t
+	case DescribeFairnessKeysRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeFairnessKeysResponse to the protobuf v3 wire format
+func (val *DescribeFairnessKeysResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeFairnessKeysResponse from the protobuf v3 wire format
+func (val *DescribeFairnessKeysResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeFairnessKeysResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeFairnessKeysResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeFairnessKeysResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeFairnessKeysResponse
+	switch t := that.(type) {
+	case *DescribeFairnessKeysResponse:
+		that1 = t
+	case DescribeFairnessKeysResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueuePauseRequest to the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePauseRequest from the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePauseRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePauseRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePauseRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePauseRequest
+	switch t := that.(type) {
+	case *UpdateTaskQueuePauseRequest:
+		that1 = t
+	case UpdateTaskQueuePauseRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateTaskQueuePauseResponse to the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateTaskQueuePauseResponse from the protobuf v3 wire format
+func (val *UpdateTaskQueuePauseResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateTaskQueuePauseResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateTaskQueuePauseResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateTaskQueuePauseResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateTaskQueuePauseResponse
+	switch t := that.(type) {
+	case *UpdateTaskQueuePauseResponse:
+		that1 = t
+	case UpdateTaskQueuePauseResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateBlockedPollersRequest to the protobuf v3 wire format
+func (val *UpdateBlockedPollersRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateBlockedPollersRequest from the protobuf v3 wire format
+func (val *UpdateBlockedPollersRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateBlockedPollersRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateBlockedPollersRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateBlockedPollersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateBlockedPollersRequest
+	switch t := that.(type) {
+	case *UpdateBlockedPollersRequest:
+		that1 = t
+	case UpdateBlockedPollersRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateBlockedPollersResponse to the protobuf v3 wire format
+func (val *UpdateBlockedPollersResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateBlockedPollersResponse from the protobuf v3 wire format
+func (val *UpdateBlockedPollersResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateBlockedPollersResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateBlockedPollersResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateBlockedPollersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateBlockedPollersResponse
+	switch t := that.(type) {
+	case *UpdateBlockedPollersResponse:
+		that1 = t
+	case UpdateBlockedPollersResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
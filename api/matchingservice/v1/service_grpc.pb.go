@@ -55,8 +55,13 @@ const (
 	MatchingService_ListNexusEndpoints_FullMethodName                     = "/temporal.server.api.matchingservice.v1.MatchingService/ListNexusEndpoints"
 	MatchingService_RecordWorkerHeartbeat_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/RecordWorkerHeartbeat"
 	MatchingService_ListWorkers_FullMethodName                            = "/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers"
+	MatchingService_CountWorkers_FullMethodName                           = "/temporal.server.api.matchingservice.v1.MatchingService/CountWorkers"
 	MatchingService_UpdateTaskQueueConfig_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig"
 	MatchingService_DescribeWorker_FullMethodName                         = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker"
+	MatchingService_UpdateFairnessWeights_FullMethodName                  = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessWeights"
+	MatchingService_DescribeFairnessKeys_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/DescribeFairnessKeys"
+	MatchingService_UpdateTaskQueuePause_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueuePause"
+	MatchingService_UpdateBlockedPollers_FullMethodName                   = "/temporal.server.api.matchingservice.v1.MatchingService/UpdateBlockedPollers"
 )
 
 // MatchingServiceClient is the client API for MatchingService service.
@@ -205,6 +210,9 @@ type MatchingServiceClient interface {
 	// Supports pagination for large result sets. Returns an empty list if no workers match the criteria.
 	// Returns an error if the namespace doesn't exist.
 	ListWorkers(ctx context.Context, in *ListWorkersRequest, opts ...grpc.CallOption) (*ListWorkersResponse, error)
+	// CountWorkers counts the workers in the specified namespace that match the provided query,
+	// optionally grouped by worker properties.
+	CountWorkers(ctx context.Context, in *CountWorkersRequest, opts ...grpc.CallOption) (*CountWorkersResponse, error)
 	// Set the persisted task queue configuration.
 	// (-- api-linter: core::0134::method-signature=disabled
 	//
@@ -221,6 +229,28 @@ type MatchingServiceClient interface {
 	// DescribeWorker retrieves a worker information in the specified namespace that match the provided instance key.
 	// Returns an error if the namespace or worker doesn't exist.
 	DescribeWorker(ctx context.Context, in *DescribeWorkerRequest, opts ...grpc.CallOption) (*DescribeWorkerResponse, error)
+	// Set the fairness key weights of a task queue. Weights are stored in the user data of the task queue family and
+	// apply to tasks written after they propagate to all partitions.
+	UpdateFairnessWeights(ctx context.Context, in *UpdateFairnessWeightsRequest, opts ...grpc.CallOption) (*UpdateFairnessWeightsResponse, error)
+	// DescribeFairnessKeys returns the fairness keys of a task queue partition with the largest backlogs and
+	// dispatch rates. Only task queues with fairness enabled track fairness keys.
+	DescribeFairnessKeys(ctx context.Context, in *DescribeFairnessKeysRequest, opts ...grpc.CallOption) (*DescribeFairnessKeysResponse, error)
+	// Pause or drain a task queue, or remove its pause. The pause is stored in the user data of the task queue family.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: UpdateTaskQueuePause RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: UpdateTaskQueuePause RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//
+	//	aip.dev/not-precedent: UpdateTaskQueuePause RPC doesn't follow Google API format. --)
+	UpdateTaskQueuePause(ctx context.Context, in *UpdateTaskQueuePauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePauseResponse, error)
+	// Block poller identities from receiving tasks from a task queue for some time, or unblock them. Blocked pollers
+	// are stored in the user data of the task queue family, expired blocks are removed on the next update.
+	UpdateBlockedPollers(ctx context.Context, in *UpdateBlockedPollersRequest, opts ...grpc.CallOption) (*UpdateBlockedPollersResponse, error)
 }
 
 type matchingServiceClient struct {
@@ -546,6 +576,15 @@ func (c *matchingServiceClient) ListWorkers(ctx context.Context, in *ListWorkers
 	return out, nil
 }
 
+func (c *matchingServiceClient) CountWorkers(ctx context.Context, in *CountWorkersRequest, opts ...grpc.CallOption) (*CountWorkersResponse, error) {
+	out := new(CountWorkersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_CountWorkers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) UpdateTaskQueueConfig(ctx context.Context, in *UpdateTaskQueueConfigRequest, opts ...grpc.CallOption) (*UpdateTaskQueueConfigResponse, error) {
 	out := new(UpdateTaskQueueConfigResponse)
 	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueueConfig_FullMethodName, in, out, opts...)
@@ -564,6 +603,42 @@ func (c *matchingServiceClient) DescribeWorker(ctx context.Context, in *Describe
 	return out, nil
 }
 
+func (c *matchingServiceClient) UpdateFairnessWeights(ctx context.Context, in *UpdateFairnessWeightsRequest, opts ...grpc.CallOption) (*UpdateFairnessWeightsResponse, error) {
+	out := new(UpdateFairnessWeightsResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateFairnessWeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) DescribeFairnessKeys(ctx context.Context, in *DescribeFairnessKeysRequest, opts ...grpc.CallOption) (*DescribeFairnessKeysResponse, error) {
+	out := new(DescribeFairnessKeysResponse)
+	err := c.cc.Invoke(ctx, MatchingService_DescribeFairnessKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) UpdateTaskQueuePause(ctx context.Context, in *UpdateTaskQueuePauseRequest, opts ...grpc.CallOption) (*UpdateTaskQueuePauseResponse, error) {
+	out := new(UpdateTaskQueuePauseResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateTaskQueuePause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) UpdateBlockedPollers(ctx context.Context, in *UpdateBlockedPollersRequest, opts ...grpc.CallOption) (*UpdateBlockedPollersResponse, error) {
+	out := new(UpdateBlockedPollersResponse)
+	err := c.cc.Invoke(ctx, MatchingService_UpdateBlockedPollers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchingServiceServer is the server API for MatchingService service.
 // All implementations must embed UnimplementedMatchingServiceServer
 // for forward compatibility
@@ -710,6 +785,9 @@ type MatchingServiceServer interface {
 	// Supports pagination for large result sets. Returns an empty list if no workers match the criteria.
 	// Returns an error if the namespace doesn't exist.
 	ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error)
+	// CountWorkers counts the workers in the specified namespace that match the provided query,
+	// optionally grouped by worker properties.
+	CountWorkers(context.Context, *CountWorkersRequest) (*CountWorkersResponse, error)
 	// Set the persisted task queue configuration.
 	// (-- api-linter: core::0134::method-signature=disabled
 	//
@@ -726,6 +804,28 @@ type MatchingServiceServer interface {
 	// DescribeWorker retrieves a worker information in the specified namespace that match the provided instance key.
 	// Returns an error if the namespace or worker doesn't exist.
 	DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error)
+	// Set the fairness key weights of a task queue. Weights are stored in the user data of the task queue family and
+	// apply to tasks written after they propagate to all partitions.
+	UpdateFairnessWeights(context.Context, *UpdateFairnessWeightsRequest) (*UpdateFairnessWeightsResponse, error)
+	// DescribeFairnessKeys returns the fairness keys of a task queue partition with the largest backlogs and
+	// dispatch rates. Only task queues with fairness enabled track fairness keys.
+	DescribeFairnessKeys(context.Context, *DescribeFairnessKeysRequest) (*DescribeFairnessKeysResponse, error)
+	// Pause or drain a task queue, or remove its pause. The pause is stored in the user data of the task queue family.
+	// (-- api-linter: core::0134::method-signature=disabled
+	//
+	//	aip.dev/not-precedent: UpdateTaskQueuePause RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::response-message-name=disabled
+	//
+	//	aip.dev/not-precedent: UpdateTaskQueuePause RPC doesn't follow Google API format. --)
+	//
+	// (-- api-linter: core::0134::request-resource-required=disabled
+	//
+	//	aip.dev/not-precedent: UpdateTaskQueuePause RPC doesn't follow Google API format. --)
+	UpdateTaskQueuePause(context.Context, *UpdateTaskQueuePauseRequest) (*UpdateTaskQueuePauseResponse, error)
+	// Block poller identities from receiving tasks from a task queue for some time, or unblock them. Blocked pollers
+	// are stored in the user data of the task queue family, expired blocks are removed on the next update.
+	UpdateBlockedPollers(context.Context, *UpdateBlockedPollersRequest) (*UpdateBlockedPollersResponse, error)
 	mustEmbedUnimplementedMatchingServiceServer()
 }
 
@@ -838,12 +938,27 @@ func (UnimplementedMatchingServiceServer) RecordWorkerHeartbeat(context.Context,
 func (UnimplementedMatchingServiceServer) ListWorkers(context.Context, *ListWorkersRequest) (*ListWorkersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkers not implemented")
 }
+func (UnimplementedMatchingServiceServer) CountWorkers(context.Context, *CountWorkersRequest) (*CountWorkersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountWorkers not implemented")
+}
 func (UnimplementedMatchingServiceServer) UpdateTaskQueueConfig(context.Context, *UpdateTaskQueueConfigRequest) (*UpdateTaskQueueConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueueConfig not implemented")
 }
 func (UnimplementedMatchingServiceServer) DescribeWorker(context.Context, *DescribeWorkerRequest) (*DescribeWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeWorker not implemented")
 }
+func (UnimplementedMatchingServiceServer) UpdateFairnessWeights(context.Context, *UpdateFairnessWeightsRequest) (*UpdateFairnessWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFairnessWeights not implemented")
+}
+func (UnimplementedMatchingServiceServer) DescribeFairnessKeys(context.Context, *DescribeFairnessKeysRequest) (*DescribeFairnessKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeFairnessKeys not implemented")
+}
+func (UnimplementedMatchingServiceServer) UpdateTaskQueuePause(context.Context, *UpdateTaskQueuePauseRequest) (*UpdateTaskQueuePauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaskQueuePause not implemented")
+}
+func (UnimplementedMatchingServiceServer) UpdateBlockedPollers(context.Context, *UpdateBlockedPollersRequest) (*UpdateBlockedPollersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockedPollers not implemented")
+}
 func (UnimplementedMatchingServiceServer) mustEmbedUnimplementedMatchingServiceServer() {}
 
 // UnsafeMatchingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1487,6 +1602,24 @@ func _MatchingService_ListWorkers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_CountWorkers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountWorkersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).CountWorkers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_CountWorkers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).CountWorkers(ctx, req.(*CountWorkersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_UpdateTaskQueueConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTaskQueueConfigRequest)
 	if err := dec(in); err != nil {
@@ -1523,6 +1656,78 @@ func _MatchingService_DescribeWorker_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_UpdateFairnessWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFairnessWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateFairnessWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateFairnessWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateFairnessWeights(ctx, req.(*UpdateFairnessWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_DescribeFairnessKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeFairnessKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribeFairnessKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_DescribeFairnessKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribeFairnessKeys(ctx, req.(*DescribeFairnessKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_UpdateTaskQueuePause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskQueuePauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateTaskQueuePause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateTaskQueuePause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateTaskQueuePause(ctx, req.(*UpdateTaskQueuePauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_UpdateBlockedPollers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBlockedPollersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).UpdateBlockedPollers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchingService_UpdateBlockedPollers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).UpdateBlockedPollers(ctx, req.(*UpdateBlockedPollersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchingService_ServiceDesc is the grpc.ServiceDesc for MatchingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1670,6 +1875,10 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkers",
 			Handler:    _MatchingService_ListWorkers_Handler,
 		},
+		{
+			MethodName: "CountWorkers",
+			Handler:    _MatchingService_CountWorkers_Handler,
+		},
 		{
 			MethodName: "UpdateTaskQueueConfig",
 			Handler:    _MatchingService_UpdateTaskQueueConfig_Handler,
@@ -1678,6 +1887,22 @@ var MatchingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeWorker",
 			Handler:    _MatchingService_DescribeWorker_Handler,
 		},
+		{
+			MethodName: "UpdateFairnessWeights",
+			Handler:    _MatchingService_UpdateFairnessWeights_Handler,
+		},
+		{
+			MethodName: "DescribeFairnessKeys",
+			Handler:    _MatchingService_DescribeFairnessKeys_Handler,
+		},
+		{
+			MethodName: "UpdateTaskQueuePause",
+			Handler:    _MatchingService_UpdateTaskQueuePause_Handler,
+		},
+		{
+			MethodName: "UpdateBlockedPollers",
+			Handler:    _MatchingService_UpdateBlockedPollers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "temporal/server/api/matchingservice/v1/service.proto",
@@ -142,6 +142,26 @@ func (mr *MockMatchingServiceClientMockRecorder) CheckTaskQueueUserDataPropagati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTaskQueueUserDataPropagation", reflect.TypeOf((*MockMatchingServiceClient)(nil).CheckTaskQueueUserDataPropagation), varargs...)
 }
 
+// CountWorkers mocks base method.
+func (m *MockMatchingServiceClient) CountWorkers(ctx context.Context, in *matchingservice.CountWorkersRequest, opts ...grpc.CallOption) (*matchingservice.CountWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountWorkers", varargs...)
+	ret0, _ := ret[0].(*matchingservice.CountWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkers indicates an expected call of CountWorkers.
+func (mr *MockMatchingServiceClientMockRecorder) CountWorkers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkers", reflect.TypeOf((*MockMatchingServiceClient)(nil).CountWorkers), varargs...)
+}
+
 // CreateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceClient) CreateNexusEndpoint(ctx context.Context, in *matchingservice.CreateNexusEndpointRequest, opts ...grpc.CallOption) (*matchingservice.CreateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +202,26 @@ func (mr *MockMatchingServiceClientMockRecorder) DeleteNexusEndpoint(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNexusEndpoint", reflect.TypeOf((*MockMatchingServiceClient)(nil).DeleteNexusEndpoint), varargs...)
 }
 
+// DescribeFairnessKeys mocks base method.
+func (m *MockMatchingServiceClient) DescribeFairnessKeys(ctx context.Context, in *matchingservice.DescribeFairnessKeysRequest, opts ...grpc.CallOption) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeFairnessKeys", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DescribeFairnessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFairnessKeys indicates an expected call of DescribeFairnessKeys.
+func (mr *MockMatchingServiceClientMockRecorder) DescribeFairnessKeys(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFairnessKeys", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeFairnessKeys), varargs...)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) DescribeTaskQueue(ctx context.Context, in *matchingservice.DescribeTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -682,6 +722,46 @@ func (mr *MockMatchingServiceClientMockRecorder) SyncDeploymentUserData(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncDeploymentUserData", reflect.TypeOf((*MockMatchingServiceClient)(nil).SyncDeploymentUserData), varargs...)
 }
 
+// UpdateBlockedPollers mocks base method.
+func (m *MockMatchingServiceClient) UpdateBlockedPollers(ctx context.Context, in *matchingservice.UpdateBlockedPollersRequest, opts ...grpc.CallOption) (*matchingservice.UpdateBlockedPollersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateBlockedPollers", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateBlockedPollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBlockedPollers indicates an expected call of UpdateBlockedPollers.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateBlockedPollers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlockedPollers", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateBlockedPollers), varargs...)
+}
+
+// UpdateFairnessWeights mocks base method.
+func (m *MockMatchingServiceClient) UpdateFairnessWeights(ctx context.Context, in *matchingservice.UpdateFairnessWeightsRequest, opts ...grpc.CallOption) (*matchingservice.UpdateFairnessWeightsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateFairnessWeights", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateFairnessWeightsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFairnessWeights indicates an expected call of UpdateFairnessWeights.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateFairnessWeights(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFairnessWeights", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateFairnessWeights), varargs...)
+}
+
 // UpdateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceClient) UpdateNexusEndpoint(ctx context.Context, in *matchingservice.UpdateNexusEndpointRequest, opts ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -722,6 +802,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueueConfig(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueueConfig), varargs...)
 }
 
+// UpdateTaskQueuePause mocks base method.
+func (m *MockMatchingServiceClient) UpdateTaskQueuePause(ctx context.Context, in *matchingservice.UpdateTaskQueuePauseRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueuePauseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePause", varargs...)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueuePauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePause indicates an expected call of UpdateTaskQueuePause.
+func (mr *MockMatchingServiceClientMockRecorder) UpdateTaskQueuePause(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePause", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateTaskQueuePause), varargs...)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) UpdateTaskQueueUserData(ctx context.Context, in *matchingservice.UpdateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -881,6 +981,21 @@ func (mr *MockMatchingServiceServerMockRecorder) CheckTaskQueueUserDataPropagati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTaskQueueUserDataPropagation", reflect.TypeOf((*MockMatchingServiceServer)(nil).CheckTaskQueueUserDataPropagation), arg0, arg1)
 }
 
+// CountWorkers mocks base method.
+func (m *MockMatchingServiceServer) CountWorkers(arg0 context.Context, arg1 *matchingservice.CountWorkersRequest) (*matchingservice.CountWorkersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkers", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.CountWorkersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkers indicates an expected call of CountWorkers.
+func (mr *MockMatchingServiceServerMockRecorder) CountWorkers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkers", reflect.TypeOf((*MockMatchingServiceServer)(nil).CountWorkers), arg0, arg1)
+}
+
 // CreateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceServer) CreateNexusEndpoint(arg0 context.Context, arg1 *matchingservice.CreateNexusEndpointRequest) (*matchingservice.CreateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -911,6 +1026,21 @@ func (mr *MockMatchingServiceServerMockRecorder) DeleteNexusEndpoint(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNexusEndpoint", reflect.TypeOf((*MockMatchingServiceServer)(nil).DeleteNexusEndpoint), arg0, arg1)
 }
 
+// DescribeFairnessKeys mocks base method.
+func (m *MockMatchingServiceServer) DescribeFairnessKeys(arg0 context.Context, arg1 *matchingservice.DescribeFairnessKeysRequest) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeFairnessKeys", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DescribeFairnessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFairnessKeys indicates an expected call of DescribeFairnessKeys.
+func (mr *MockMatchingServiceServerMockRecorder) DescribeFairnessKeys(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFairnessKeys", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeFairnessKeys), arg0, arg1)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) DescribeTaskQueue(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -1286,6 +1416,36 @@ func (mr *MockMatchingServiceServerMockRecorder) SyncDeploymentUserData(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncDeploymentUserData", reflect.TypeOf((*MockMatchingServiceServer)(nil).SyncDeploymentUserData), arg0, arg1)
 }
 
+// UpdateBlockedPollers mocks base method.
+func (m *MockMatchingServiceServer) UpdateBlockedPollers(arg0 context.Context, arg1 *matchingservice.UpdateBlockedPollersRequest) (*matchingservice.UpdateBlockedPollersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBlockedPollers", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateBlockedPollersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBlockedPollers indicates an expected call of UpdateBlockedPollers.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateBlockedPollers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlockedPollers", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateBlockedPollers), arg0, arg1)
+}
+
+// UpdateFairnessWeights mocks base method.
+func (m *MockMatchingServiceServer) UpdateFairnessWeights(arg0 context.Context, arg1 *matchingservice.UpdateFairnessWeightsRequest) (*matchingservice.UpdateFairnessWeightsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFairnessWeights", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateFairnessWeightsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFairnessWeights indicates an expected call of UpdateFairnessWeights.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateFairnessWeights(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFairnessWeights", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateFairnessWeights), arg0, arg1)
+}
+
 // UpdateNexusEndpoint mocks base method.
 func (m *MockMatchingServiceServer) UpdateNexusEndpoint(arg0 context.Context, arg1 *matchingservice.UpdateNexusEndpointRequest) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	m.ctrl.T.Helper()
@@ -1316,6 +1476,21 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueueConfig(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueueConfig", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueueConfig), arg0, arg1)
 }
 
+// UpdateTaskQueuePause mocks base method.
+func (m *MockMatchingServiceServer) UpdateTaskQueuePause(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueuePauseRequest) (*matchingservice.UpdateTaskQueuePauseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskQueuePause", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.UpdateTaskQueuePauseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskQueuePause indicates an expected call of UpdateTaskQueuePause.
+func (mr *MockMatchingServiceServerMockRecorder) UpdateTaskQueuePause(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskQueuePause", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateTaskQueuePause), arg0, arg1)
+}
+
 // UpdateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) UpdateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +78,40 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type SearchAttributeFieldRemap to the protobuf v3 wire format
+func (val *SearchAttributeFieldRemap) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SearchAttributeFieldRemap from the protobuf v3 wire format
+func (val *SearchAttributeFieldRemap) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SearchAttributeFieldRemap) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SearchAttributeFieldRemap values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SearchAttributeFieldRemap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SearchAttributeFieldRemap
+	switch t := that.(type) {
+	case *SearchAttributeFieldRemap:
+		that1 = t
+	case SearchAttributeFieldRemap:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -40,8 +40,10 @@ type ClusterMetadata struct {
 	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
 	UseClusterIdMembership   bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
 	Tags                     map[string]string                 `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// Saved visibility queries keyed by namespace ID.
+	SavedVisibilityQueries map[string]*SavedVisibilityQueries `protobuf:"bytes,14,rep,name=saved_visibility_queries,json=savedVisibilityQueries,proto3" json:"saved_visibility_queries,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *ClusterMetadata) Reset() {
@@ -165,11 +167,20 @@ func (x *ClusterMetadata) GetTags() map[string]string {
 	return nil
 }
 
+func (x *ClusterMetadata) GetSavedVisibilityQueries() map[string]*SavedVisibilityQueries {
+	if x != nil {
+		return x.SavedVisibilityQueries
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	state                  protoimpl.MessageState          `protogen:"open.v1"`
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Field remaps of search attributes that were migrated to another field.
+	FieldRemaps   []*SearchAttributeFieldRemap `protobuf:"bytes,2,rep,name=field_remaps,json=fieldRemaps,proto3" json:"field_remaps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *IndexSearchAttributes) Reset() {
@@ -209,11 +220,90 @@ func (x *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+func (x *IndexSearchAttributes) GetFieldRemaps() []*SearchAttributeFieldRemap {
+	if x != nil {
+		return x.FieldRemaps
+	}
+	return nil
+}
+
+// SearchAttributeFieldRemap redirects values that are written to source_field to target_field. Values are converted
+// from source_type to the type of target_field. It is created when a custom search attribute is migrated to another
+// field and kept afterwards because mutable state of existing executions still refers to the source field.
+type SearchAttributeFieldRemap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty if the remap applies to all namespaces, i.e. when field names are not namespace specific.
+	NamespaceId   string               `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	SourceField   string               `protobuf:"bytes,2,opt,name=source_field,json=sourceField,proto3" json:"source_field,omitempty"`
+	SourceType    v11.IndexedValueType `protobuf:"varint,3,opt,name=source_type,json=sourceType,proto3,enum=temporal.api.enums.v1.IndexedValueType" json:"source_type,omitempty"`
+	TargetField   string               `protobuf:"bytes,4,opt,name=target_field,json=targetField,proto3" json:"target_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAttributeFieldRemap) Reset() {
+	*x = SearchAttributeFieldRemap{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAttributeFieldRemap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAttributeFieldRemap) ProtoMessage() {}
+
+func (x *SearchAttributeFieldRemap) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAttributeFieldRemap.ProtoReflect.Descriptor instead.
+func (*SearchAttributeFieldRemap) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchAttributeFieldRemap) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *SearchAttributeFieldRemap) GetSourceField() string {
+	if x != nil {
+		return x.SourceField
+	}
+	return ""
+}
+
+func (x *SearchAttributeFieldRemap) GetSourceType() v11.IndexedValueType {
+	if x != nil {
+		return x.SourceType
+	}
+	return v11.IndexedValueType(0)
+}
+
+func (x *SearchAttributeFieldRemap) GetTargetField() string {
+	if x != nil {
+		return x.TargetField
+	}
+	return ""
+}
+
 var File_temporal_server_api_persistence_v1_cluster_metadata_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "" +
 	"\n" +
-	"9temporal/server/api/persistence/v1/cluster_metadata.proto\x12\"temporal.server.api.persistence.v1\x1a\"temporal/api/enums/v1/common.proto\x1a%temporal/api/version/v1/message.proto\"\xd9\a\n" +
+	"9temporal/server/api/persistence/v1/cluster_metadata.proto\x12\"temporal.server.api.persistence.v1\x1a\"temporal/api/enums/v1/common.proto\x1a%temporal/api/version/v1/message.proto\x1a?temporal/server/api/persistence/v1/saved_visibility_query.proto\"\xed\t\n" +
 	"\x0fClusterMetadata\x12!\n" +
 	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12.\n" +
 	"\x13history_shard_count\x18\x02 \x01(\x05R\x11historyShardCount\x12\x1d\n" +
@@ -229,18 +319,29 @@ const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "
 	"\x15is_connection_enabled\x18\n" +
 	" \x01(\bR\x13isConnectionEnabled\x129\n" +
 	"\x19use_cluster_id_membership\x18\v \x01(\bR\x16useClusterIdMembership\x12Q\n" +
-	"\x04tags\x18\f \x03(\v2=.temporal.server.api.persistence.v1.ClusterMetadata.TagsEntryR\x04tags\x1a\x83\x01\n" +
+	"\x04tags\x18\f \x03(\v2=.temporal.server.api.persistence.v1.ClusterMetadata.TagsEntryR\x04tags\x12\x89\x01\n" +
+	"\x18saved_visibility_queries\x18\x0e \x03(\v2O.temporal.server.api.persistence.v1.ClusterMetadata.SavedVisibilityQueriesEntryR\x16savedVisibilityQueries\x1a\x83\x01\n" +
 	"\x1aIndexSearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12O\n" +
 	"\x05value\x18\x02 \x01(\v29.temporal.server.api.persistence.v1.IndexSearchAttributesR\x05value:\x028\x01\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\x85\x01\n" +
+	"\x1bSavedVisibilityQueriesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12P\n" +
+	"\x05value\x18\x02 \x01(\v2:.temporal.server.api.persistence.v1.SavedVisibilityQueriesR\x05value:\x028\x01\"\xff\x02\n" +
 	"\x15IndexSearchAttributes\x12\x8f\x01\n" +
-	"\x18custom_search_attributes\x18\x01 \x03(\v2U.temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntryR\x16customSearchAttributes\x1ar\n" +
+	"\x18custom_search_attributes\x18\x01 \x03(\v2U.temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntryR\x16customSearchAttributes\x12`\n" +
+	"\ffield_remaps\x18\x02 \x03(\v2=.temporal.server.api.persistence.v1.SearchAttributeFieldRemapR\vfieldRemaps\x1ar\n" +
 	"\x1bCustomSearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
-	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01B6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01\"\xce\x01\n" +
+	"\x19SearchAttributeFieldRemap\x12!\n" +
+	"\fnamespace_id\x18\x01 \x01(\tR\vnamespaceId\x12!\n" +
+	"\fsource_field\x18\x02 \x01(\tR\vsourceField\x12H\n" +
+	"\vsource_type\x18\x03 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\n" +
+	"sourceType\x12!\n" +
+	"\ftarget_field\x18\x04 \x01(\tR\vtargetFieldB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
 
 var (
 	file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescOnce sync.Once
@@ -254,28 +355,35 @@ func file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(
 	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_temporal_server_api_persistence_v1_cluster_metadata_proto_goTypes = []any{
-	(*ClusterMetadata)(nil),       // 0: temporal.server.api.persistence.v1.ClusterMetadata
-	(*IndexSearchAttributes)(nil), // 1: temporal.server.api.persistence.v1.IndexSearchAttributes
-	nil,                           // 2: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
-	nil,                           // 3: temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
-	nil,                           // 4: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
-	(*v1.VersionInfo)(nil),        // 5: temporal.api.version.v1.VersionInfo
-	(v11.IndexedValueType)(0),     // 6: temporal.api.enums.v1.IndexedValueType
+	(*ClusterMetadata)(nil),           // 0: temporal.server.api.persistence.v1.ClusterMetadata
+	(*IndexSearchAttributes)(nil),     // 1: temporal.server.api.persistence.v1.IndexSearchAttributes
+	(*SearchAttributeFieldRemap)(nil), // 2: temporal.server.api.persistence.v1.SearchAttributeFieldRemap
+	nil,                               // 3: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
+	nil,                               // 4: temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
+	nil,                               // 5: temporal.server.api.persistence.v1.ClusterMetadata.SavedVisibilityQueriesEntry
+	nil,                               // 6: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
+	(*v1.VersionInfo)(nil),            // 7: temporal.api.version.v1.VersionInfo
+	(v11.IndexedValueType)(0),         // 8: temporal.api.enums.v1.IndexedValueType
+	(*SavedVisibilityQueries)(nil),    // 9: temporal.server.api.persistence.v1.SavedVisibilityQueries
 }
 var file_temporal_server_api_persistence_v1_cluster_metadata_proto_depIdxs = []int32{
-	5, // 0: temporal.server.api.persistence.v1.ClusterMetadata.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	2, // 1: temporal.server.api.persistence.v1.ClusterMetadata.index_search_attributes:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
-	3, // 2: temporal.server.api.persistence.v1.ClusterMetadata.tags:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
-	4, // 3: temporal.server.api.persistence.v1.IndexSearchAttributes.custom_search_attributes:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
-	1, // 4: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry.value:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes
-	6, // 5: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7,  // 0: temporal.server.api.persistence.v1.ClusterMetadata.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	3,  // 1: temporal.server.api.persistence.v1.ClusterMetadata.index_search_attributes:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
+	4,  // 2: temporal.server.api.persistence.v1.ClusterMetadata.tags:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
+	5,  // 3: temporal.server.api.persistence.v1.ClusterMetadata.saved_visibility_queries:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.SavedVisibilityQueriesEntry
+	6,  // 4: temporal.server.api.persistence.v1.IndexSearchAttributes.custom_search_attributes:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
+	2,  // 5: temporal.server.api.persistence.v1.IndexSearchAttributes.field_remaps:type_name -> temporal.server.api.persistence.v1.SearchAttributeFieldRemap
+	8,  // 6: temporal.server.api.persistence.v1.SearchAttributeFieldRemap.source_type:type_name -> temporal.api.enums.v1.IndexedValueType
+	1,  // 7: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry.value:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes
+	9,  // 8: temporal.server.api.persistence.v1.ClusterMetadata.SavedVisibilityQueriesEntry.value:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQueries
+	8,  // 9: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_cluster_metadata_proto_init() }
@@ -283,13 +391,14 @@ func file_temporal_server_api_persistence_v1_cluster_metadata_proto_init() {
 	if File_temporal_server_api_persistence_v1_cluster_metadata_proto != nil {
 		return
 	}
+	file_temporal_server_api_persistence_v1_saved_visibility_query_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc), len(file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
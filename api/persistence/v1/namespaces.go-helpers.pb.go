@@ -116,6 +116,43 @@ func (this *NamespaceConfig) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ScheduleCalendarSet to the protobuf v3 wire format
+func (val *ScheduleCalendarSet) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleCalendarSet from the protobuf v3 wire format
+func (val *ScheduleCalendarSet) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleCalendarSet) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleCalendarSet values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleCalendarSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleCalendarSet
+	switch t := that.(type) {
+	case *ScheduleCalendarSet:
+		that1 = t
+	case ScheduleCalendarSet:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NamespaceReplicationConfig to the protobuf v3 wire format
 func (val *NamespaceReplicationConfig) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -116,6 +116,80 @@ func (this *NexusEndpoint) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type NexusEndpointAccessPolicy to the protobuf v3 wire format
+func (val *NexusEndpointAccessPolicy) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointAccessPolicy from the protobuf v3 wire format
+func (val *NexusEndpointAccessPolicy) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointAccessPolicy) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointAccessPolicy values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointAccessPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointAccessPolicy
+	switch t := that.(type) {
+	case *NexusEndpointAccessPolicy:
+		that1 = t
+	case NexusEndpointAccessPolicy:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusEndpointCircuitBreakerOverride to the protobuf v3 wire format
+func (val *NexusEndpointCircuitBreakerOverride) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusEndpointCircuitBreakerOverride from the protobuf v3 wire format
+func (val *NexusEndpointCircuitBreakerOverride) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusEndpointCircuitBreakerOverride) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusEndpointCircuitBreakerOverride values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusEndpointCircuitBreakerOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusEndpointCircuitBreakerOverride
+	switch t := that.(type) {
+	case *NexusEndpointCircuitBreakerOverride:
+		that1 = t
+	case NexusEndpointCircuitBreakerOverride:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NexusEndpointEntry to the protobuf v3 wire format
 func (val *NexusEndpointEntry) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -13,6 +13,7 @@ import (
 
 	v1 "go.temporal.io/api/common/v1"
 	v11 "go.temporal.io/server/api/clock/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -189,9 +190,14 @@ type NexusEndpoint struct {
 	// (-- api-linter: core::0142::time-field-names=disabled
 	//
 	//	aip.dev/not-precedent: Not following linter rules. --)
-	CreatedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CreatedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	// Restricts which callers may use this endpoint. Not part of the public API, managed via the admin API.
+	AccessPolicy *NexusEndpointAccessPolicy `protobuf:"bytes,4,opt,name=access_policy,json=accessPolicy,proto3" json:"access_policy,omitempty"`
+	// Manual override of the circuit breakers protecting calls to the endpoint. Not part of the public API, managed via
+	// the admin API.
+	CircuitBreakerOverride *NexusEndpointCircuitBreakerOverride `protobuf:"bytes,5,opt,name=circuit_breaker_override,json=circuitBreakerOverride,proto3" json:"circuit_breaker_override,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *NexusEndpoint) Reset() {
@@ -245,6 +251,132 @@ func (x *NexusEndpoint) GetCreatedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *NexusEndpoint) GetAccessPolicy() *NexusEndpointAccessPolicy {
+	if x != nil {
+		return x.AccessPolicy
+	}
+	return nil
+}
+
+func (x *NexusEndpoint) GetCircuitBreakerOverride() *NexusEndpointCircuitBreakerOverride {
+	if x != nil {
+		return x.CircuitBreakerOverride
+	}
+	return nil
+}
+
+// Allowlist of callers of a Nexus endpoint. An empty list places no restriction on the respective dimension.
+type NexusEndpointAccessPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the namespaces allowed to call the endpoint.
+	AllowedCallerNamespaceIds []string `protobuf:"bytes,1,rep,name=allowed_caller_namespace_ids,json=allowedCallerNamespaceIds,proto3" json:"allowed_caller_namespace_ids,omitempty"`
+	// Workflow types allowed to schedule operations on the endpoint.
+	AllowedWorkflowTypes []string `protobuf:"bytes,2,rep,name=allowed_workflow_types,json=allowedWorkflowTypes,proto3" json:"allowed_workflow_types,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *NexusEndpointAccessPolicy) Reset() {
+	*x = NexusEndpointAccessPolicy{}
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointAccessPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointAccessPolicy) ProtoMessage() {}
+
+func (x *NexusEndpointAccessPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointAccessPolicy.ProtoReflect.Descriptor instead.
+func (*NexusEndpointAccessPolicy) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NexusEndpointAccessPolicy) GetAllowedCallerNamespaceIds() []string {
+	if x != nil {
+		return x.AllowedCallerNamespaceIds
+	}
+	return nil
+}
+
+func (x *NexusEndpointAccessPolicy) GetAllowedWorkflowTypes() []string {
+	if x != nil {
+		return x.AllowedWorkflowTypes
+	}
+	return nil
+}
+
+// Manual override of the circuit breakers of a Nexus endpoint. Expired overrides have no effect and are removed by the
+// matching host that owns the nexus_endpoints table.
+type NexusEndpointCircuitBreakerOverride struct {
+	state    protoimpl.MessageState     `protogen:"open.v1"`
+	Override v12.CircuitBreakerOverride `protobuf:"varint,1,opt,name=override,proto3,enum=temporal.server.api.enums.v1.CircuitBreakerOverride" json:"override,omitempty"`
+	// (-- api-linter: core::0142::time-field-names=disabled
+	//
+	//	aip.dev/not-precedent: Not following linter rules. --)
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NexusEndpointCircuitBreakerOverride) Reset() {
+	*x = NexusEndpointCircuitBreakerOverride{}
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusEndpointCircuitBreakerOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusEndpointCircuitBreakerOverride) ProtoMessage() {}
+
+func (x *NexusEndpointCircuitBreakerOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusEndpointCircuitBreakerOverride.ProtoReflect.Descriptor instead.
+func (*NexusEndpointCircuitBreakerOverride) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NexusEndpointCircuitBreakerOverride) GetOverride() v12.CircuitBreakerOverride {
+	if x != nil {
+		return x.Override
+	}
+	return v12.CircuitBreakerOverride(0)
+}
+
+func (x *NexusEndpointCircuitBreakerOverride) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
 // Container for a version, a UUID, and a NexusEndpoint.
 type NexusEndpointEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -257,7 +389,7 @@ type NexusEndpointEntry struct {
 
 func (x *NexusEndpointEntry) Reset() {
 	*x = NexusEndpointEntry{}
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +401,7 @@ func (x *NexusEndpointEntry) String() string {
 func (*NexusEndpointEntry) ProtoMessage() {}
 
 func (x *NexusEndpointEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[3]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +414,7 @@ func (x *NexusEndpointEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NexusEndpointEntry.ProtoReflect.Descriptor instead.
 func (*NexusEndpointEntry) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NexusEndpointEntry) GetVersion() int64 {
@@ -319,7 +451,7 @@ type NexusEndpointTarget_Worker struct {
 
 func (x *NexusEndpointTarget_Worker) Reset() {
 	*x = NexusEndpointTarget_Worker{}
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -331,7 +463,7 @@ func (x *NexusEndpointTarget_Worker) String() string {
 func (*NexusEndpointTarget_Worker) ProtoMessage() {}
 
 func (x *NexusEndpointTarget_Worker) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[4]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +509,7 @@ type NexusEndpointTarget_External struct {
 
 func (x *NexusEndpointTarget_External) Reset() {
 	*x = NexusEndpointTarget_External{}
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +521,7 @@ func (x *NexusEndpointTarget_External) String() string {
 func (*NexusEndpointTarget_External) ProtoMessage() {}
 
 func (x *NexusEndpointTarget_External) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[5]
+	mi := &file_temporal_server_api_persistence_v1_nexus_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +548,7 @@ var File_temporal_server_api_persistence_v1_nexus_proto protoreflect.FileDescrip
 
 const file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = "" +
 	"\n" +
-	".temporal/server/api/persistence/v1/nexus.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\"\xbb\x01\n" +
+	".temporal/server/api/persistence/v1/nexus.proto\x12\"temporal.server.api.persistence.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$temporal/api/common/v1/message.proto\x1a*temporal/server/api/clock/v1/message.proto\x1a)temporal/server/api/enums/v1/common.proto\"\xbb\x01\n" +
 	"\x11NexusEndpointSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12A\n" +
 	"\vdescription\x18\x02 \x01(\v2\x1f.temporal.api.common.v1.PayloadR\vdescription\x12O\n" +
@@ -430,11 +562,19 @@ const file_temporal_server_api_persistence_v1_nexus_proto_rawDesc = "" +
 	"task_queue\x18\x02 \x01(\tR\ttaskQueue\x1a\x1c\n" +
 	"\bExternal\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03urlB\t\n" +
-	"\avariant\"\xe1\x01\n" +
+	"\avariant\"\xc9\x03\n" +
 	"\rNexusEndpoint\x12F\n" +
 	"\x05clock\x18\x01 \x01(\v20.temporal.server.api.clock.v1.HybridLogicalClockR\x05clock\x12I\n" +
 	"\x04spec\x18\x02 \x01(\v25.temporal.server.api.persistence.v1.NexusEndpointSpecR\x04spec\x12=\n" +
-	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\"\x8d\x01\n" +
+	"\fcreated_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12b\n" +
+	"\raccess_policy\x18\x04 \x01(\v2=.temporal.server.api.persistence.v1.NexusEndpointAccessPolicyR\faccessPolicy\x12\x81\x01\n" +
+	"\x18circuit_breaker_override\x18\x05 \x01(\v2G.temporal.server.api.persistence.v1.NexusEndpointCircuitBreakerOverrideR\x16circuitBreakerOverride\"\x92\x01\n" +
+	"\x19NexusEndpointAccessPolicy\x12?\n" +
+	"\x1callowed_caller_namespace_ids\x18\x01 \x03(\tR\x19allowedCallerNamespaceIds\x124\n" +
+	"\x16allowed_workflow_types\x18\x02 \x03(\tR\x14allowedWorkflowTypes\"\xbc\x01\n" +
+	"#NexusEndpointCircuitBreakerOverride\x12P\n" +
+	"\boverride\x18\x01 \x01(\x0e24.temporal.server.api.enums.v1.CircuitBreakerOverrideR\boverride\x12C\n" +
+	"\x0fexpiration_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpirationTime\"\x8d\x01\n" +
 	"\x12NexusEndpointEntry\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12M\n" +
@@ -452,32 +592,39 @@ func file_temporal_server_api_persistence_v1_nexus_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_nexus_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_temporal_server_api_persistence_v1_nexus_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_temporal_server_api_persistence_v1_nexus_proto_goTypes = []any{
-	(*NexusEndpointSpec)(nil),            // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
-	(*NexusEndpointTarget)(nil),          // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
-	(*NexusEndpoint)(nil),                // 2: temporal.server.api.persistence.v1.NexusEndpoint
-	(*NexusEndpointEntry)(nil),           // 3: temporal.server.api.persistence.v1.NexusEndpointEntry
-	(*NexusEndpointTarget_Worker)(nil),   // 4: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	(*NexusEndpointTarget_External)(nil), // 5: temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	(*v1.Payload)(nil),                   // 6: temporal.api.common.v1.Payload
-	(*v11.HybridLogicalClock)(nil),       // 7: temporal.server.api.clock.v1.HybridLogicalClock
-	(*timestamppb.Timestamp)(nil),        // 8: google.protobuf.Timestamp
+	(*NexusEndpointSpec)(nil),                   // 0: temporal.server.api.persistence.v1.NexusEndpointSpec
+	(*NexusEndpointTarget)(nil),                 // 1: temporal.server.api.persistence.v1.NexusEndpointTarget
+	(*NexusEndpoint)(nil),                       // 2: temporal.server.api.persistence.v1.NexusEndpoint
+	(*NexusEndpointAccessPolicy)(nil),           // 3: temporal.server.api.persistence.v1.NexusEndpointAccessPolicy
+	(*NexusEndpointCircuitBreakerOverride)(nil), // 4: temporal.server.api.persistence.v1.NexusEndpointCircuitBreakerOverride
+	(*NexusEndpointEntry)(nil),                  // 5: temporal.server.api.persistence.v1.NexusEndpointEntry
+	(*NexusEndpointTarget_Worker)(nil),          // 6: temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	(*NexusEndpointTarget_External)(nil),        // 7: temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	(*v1.Payload)(nil),                          // 8: temporal.api.common.v1.Payload
+	(*v11.HybridLogicalClock)(nil),              // 9: temporal.server.api.clock.v1.HybridLogicalClock
+	(*timestamppb.Timestamp)(nil),               // 10: google.protobuf.Timestamp
+	(v12.CircuitBreakerOverride)(0),             // 11: temporal.server.api.enums.v1.CircuitBreakerOverride
 }
 var file_temporal_server_api_persistence_v1_nexus_proto_depIdxs = []int32{
-	6, // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
-	1, // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
-	4, // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
-	5, // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
-	7, // 4: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
-	0, // 5: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
-	8, // 6: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
-	2, // 7: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	8,  // 0: temporal.server.api.persistence.v1.NexusEndpointSpec.description:type_name -> temporal.api.common.v1.Payload
+	1,  // 1: temporal.server.api.persistence.v1.NexusEndpointSpec.target:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget
+	6,  // 2: temporal.server.api.persistence.v1.NexusEndpointTarget.worker:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.Worker
+	7,  // 3: temporal.server.api.persistence.v1.NexusEndpointTarget.external:type_name -> temporal.server.api.persistence.v1.NexusEndpointTarget.External
+	9,  // 4: temporal.server.api.persistence.v1.NexusEndpoint.clock:type_name -> temporal.server.api.clock.v1.HybridLogicalClock
+	0,  // 5: temporal.server.api.persistence.v1.NexusEndpoint.spec:type_name -> temporal.server.api.persistence.v1.NexusEndpointSpec
+	10, // 6: temporal.server.api.persistence.v1.NexusEndpoint.created_time:type_name -> google.protobuf.Timestamp
+	3,  // 7: temporal.server.api.persistence.v1.NexusEndpoint.access_policy:type_name -> temporal.server.api.persistence.v1.NexusEndpointAccessPolicy
+	4,  // 8: temporal.server.api.persistence.v1.NexusEndpoint.circuit_breaker_override:type_name -> temporal.server.api.persistence.v1.NexusEndpointCircuitBreakerOverride
+	11, // 9: temporal.server.api.persistence.v1.NexusEndpointCircuitBreakerOverride.override:type_name -> temporal.server.api.enums.v1.CircuitBreakerOverride
+	10, // 10: temporal.server.api.persistence.v1.NexusEndpointCircuitBreakerOverride.expiration_time:type_name -> google.protobuf.Timestamp
+	2,  // 11: temporal.server.api.persistence.v1.NexusEndpointEntry.endpoint:type_name -> temporal.server.api.persistence.v1.NexusEndpoint
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_nexus_proto_init() }
@@ -495,7 +642,7 @@ func file_temporal_server_api_persistence_v1_nexus_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_nexus_proto_rawDesc), len(file_temporal_server_api_persistence_v1_nexus_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -264,6 +264,43 @@ func (this *HistoryTask) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type WorkerHeartbeats to the protobuf v3 wire format
+func (val *WorkerHeartbeats) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type WorkerHeartbeats from the protobuf v3 wire format
+func (val *WorkerHeartbeats) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *WorkerHeartbeats) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two WorkerHeartbeats values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *WorkerHeartbeats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *WorkerHeartbeats
+	switch t := that.(type) {
+	case *WorkerHeartbeats:
+		that1 = t
+	case WorkerHeartbeats:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type QueuePartition to the protobuf v3 wire format
 func (val *QueuePartition) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -12,6 +12,7 @@ import (
 	unsafe "unsafe"
 
 	v1 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/api/worker/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -372,6 +373,53 @@ func (x *HistoryTask) GetBlob() *v1.DataBlob {
 	return nil
 }
 
+// WorkerHeartbeats is a message of the worker heartbeat queue of a namespace. The matching host owning the namespace
+// appends the heartbeats it received since its last write, and periodically a snapshot of all the workers it knows,
+// after which the older messages are deleted.
+type WorkerHeartbeats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Heartbeats    []*v11.WorkerHeartbeat `protobuf:"bytes,1,rep,name=heartbeats,proto3" json:"heartbeats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkerHeartbeats) Reset() {
+	*x = WorkerHeartbeats{}
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkerHeartbeats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkerHeartbeats) ProtoMessage() {}
+
+func (x *WorkerHeartbeats) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkerHeartbeats.ProtoReflect.Descriptor instead.
+func (*WorkerHeartbeats) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WorkerHeartbeats) GetHeartbeats() []*v11.WorkerHeartbeat {
+	if x != nil {
+		return x.Heartbeats
+	}
+	return nil
+}
+
 type QueuePartition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// min_message_id is less than or equal to the id of every message in the queue. The min_message_id is mainly used to
@@ -392,7 +440,7 @@ type QueuePartition struct {
 
 func (x *QueuePartition) Reset() {
 	*x = QueuePartition{}
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +452,7 @@ func (x *QueuePartition) String() string {
 func (*QueuePartition) ProtoMessage() {}
 
 func (x *QueuePartition) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[7]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +465,7 @@ func (x *QueuePartition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuePartition.ProtoReflect.Descriptor instead.
 func (*QueuePartition) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *QueuePartition) GetMinMessageId() int64 {
@@ -437,7 +485,7 @@ type Queue struct {
 
 func (x *Queue) Reset() {
 	*x = Queue{}
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +497,7 @@ func (x *Queue) String() string {
 func (*Queue) ProtoMessage() {}
 
 func (x *Queue) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[8]
+	mi := &file_temporal_server_api_persistence_v1_queues_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +510,7 @@ func (x *Queue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Queue.ProtoReflect.Descriptor instead.
 func (*Queue) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Queue) GetPartitions() map[int32]*QueuePartition {
@@ -476,7 +524,7 @@ var File_temporal_server_api_persistence_v1_queues_proto protoreflect.FileDescri
 
 const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\n" +
-	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a$temporal/api/common/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"\xde\x02\n" +
+	"/temporal/server/api/persistence/v1/queues.proto\x12\"temporal.server.api.persistence.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/worker/v1/message.proto\x1a3temporal/server/api/persistence/v1/predicates.proto\x1a.temporal/server/api/persistence/v1/tasks.proto\"\xde\x02\n" +
 	"\n" +
 	"QueueState\x12e\n" +
 	"\rreader_states\x18\x01 \x03(\v2@.temporal.server.api.persistence.v1.QueueState.ReaderStatesEntryR\freaderStates\x12r\n" +
@@ -498,7 +546,11 @@ const file_temporal_server_api_persistence_v1_queues_proto_rawDesc = "" +
 	"\x16last_read_queue_number\x18\x01 \x01(\x03R\x13lastReadQueueNumber\"^\n" +
 	"\vHistoryTask\x12\x19\n" +
 	"\bshard_id\x18\x01 \x01(\x05R\ashardId\x124\n" +
-	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"6\n" +
+	"\x04blob\x18\x02 \x01(\v2 .temporal.api.common.v1.DataBlobR\x04blob\"[\n" +
+	"\x10WorkerHeartbeats\x12G\n" +
+	"\n" +
+	"heartbeats\x18\x01 \x03(\v2'.temporal.api.worker.v1.WorkerHeartbeatR\n" +
+	"heartbeats\"6\n" +
 	"\x0eQueuePartition\x12$\n" +
 	"\x0emin_message_id\x18\x01 \x01(\x03R\fminMessageId\"\xd5\x01\n" +
 	"\x05Queue\x12Y\n" +
@@ -521,7 +573,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_persistence_v1_queues_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_temporal_server_api_persistence_v1_queues_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*QueueState)(nil),                     // 0: temporal.server.api.persistence.v1.QueueState
 	(*QueueReaderState)(nil),               // 1: temporal.server.api.persistence.v1.QueueReaderState
@@ -530,31 +582,34 @@ var file_temporal_server_api_persistence_v1_queues_proto_goTypes = []any{
 	(*ReadQueueMessagesNextPageToken)(nil), // 4: temporal.server.api.persistence.v1.ReadQueueMessagesNextPageToken
 	(*ListQueuesNextPageToken)(nil),        // 5: temporal.server.api.persistence.v1.ListQueuesNextPageToken
 	(*HistoryTask)(nil),                    // 6: temporal.server.api.persistence.v1.HistoryTask
-	(*QueuePartition)(nil),                 // 7: temporal.server.api.persistence.v1.QueuePartition
-	(*Queue)(nil),                          // 8: temporal.server.api.persistence.v1.Queue
-	nil,                                    // 9: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	nil,                                    // 10: temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	(*TaskKey)(nil),                        // 11: temporal.server.api.persistence.v1.TaskKey
-	(*Predicate)(nil),                      // 12: temporal.server.api.persistence.v1.Predicate
-	(*v1.DataBlob)(nil),                    // 13: temporal.api.common.v1.DataBlob
+	(*WorkerHeartbeats)(nil),               // 7: temporal.server.api.persistence.v1.WorkerHeartbeats
+	(*QueuePartition)(nil),                 // 8: temporal.server.api.persistence.v1.QueuePartition
+	(*Queue)(nil),                          // 9: temporal.server.api.persistence.v1.Queue
+	nil,                                    // 10: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	nil,                                    // 11: temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	(*TaskKey)(nil),                        // 12: temporal.server.api.persistence.v1.TaskKey
+	(*Predicate)(nil),                      // 13: temporal.server.api.persistence.v1.Predicate
+	(*v1.DataBlob)(nil),                    // 14: temporal.api.common.v1.DataBlob
+	(*v11.WorkerHeartbeat)(nil),            // 15: temporal.api.worker.v1.WorkerHeartbeat
 }
 var file_temporal_server_api_persistence_v1_queues_proto_depIdxs = []int32{
-	9,  // 0: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
-	11, // 1: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
+	10, // 0: temporal.server.api.persistence.v1.QueueState.reader_states:type_name -> temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry
+	12, // 1: temporal.server.api.persistence.v1.QueueState.exclusive_reader_high_watermark:type_name -> temporal.server.api.persistence.v1.TaskKey
 	2,  // 2: temporal.server.api.persistence.v1.QueueReaderState.scopes:type_name -> temporal.server.api.persistence.v1.QueueSliceScope
 	3,  // 3: temporal.server.api.persistence.v1.QueueSliceScope.range:type_name -> temporal.server.api.persistence.v1.QueueSliceRange
-	12, // 4: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
-	11, // 5: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
-	11, // 6: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
-	13, // 7: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
-	10, // 8: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
-	1,  // 9: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
-	7,  // 10: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	13, // 4: temporal.server.api.persistence.v1.QueueSliceScope.predicate:type_name -> temporal.server.api.persistence.v1.Predicate
+	12, // 5: temporal.server.api.persistence.v1.QueueSliceRange.inclusive_min:type_name -> temporal.server.api.persistence.v1.TaskKey
+	12, // 6: temporal.server.api.persistence.v1.QueueSliceRange.exclusive_max:type_name -> temporal.server.api.persistence.v1.TaskKey
+	14, // 7: temporal.server.api.persistence.v1.HistoryTask.blob:type_name -> temporal.api.common.v1.DataBlob
+	15, // 8: temporal.server.api.persistence.v1.WorkerHeartbeats.heartbeats:type_name -> temporal.api.worker.v1.WorkerHeartbeat
+	11, // 9: temporal.server.api.persistence.v1.Queue.partitions:type_name -> temporal.server.api.persistence.v1.Queue.PartitionsEntry
+	1,  // 10: temporal.server.api.persistence.v1.QueueState.ReaderStatesEntry.value:type_name -> temporal.server.api.persistence.v1.QueueReaderState
+	8,  // 11: temporal.server.api.persistence.v1.Queue.PartitionsEntry.value:type_name -> temporal.server.api.persistence.v1.QueuePartition
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_queues_proto_init() }
@@ -570,7 +625,7 @@ func file_temporal_server_api_persistence_v1_queues_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_queues_proto_rawDesc), len(file_temporal_server_api_persistence_v1_queues_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
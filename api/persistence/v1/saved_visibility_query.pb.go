@@ -0,0 +1,325 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/persistence/v1/saved_visibility_query.proto
+
+package persistence
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SavedVisibilityQuery is a visibility query that is stored under a name unique within its namespace.
+type SavedVisibilityQuery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// List filter of the query, using the search attribute names of the namespace.
+	Query       string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// If set, a system workflow periodically counts the executions matching the query and notifies the webhook of the
+	// alert whenever the count crosses the threshold.
+	Alert         *SavedVisibilityQueryAlert `protobuf:"bytes,4,opt,name=alert,proto3" json:"alert,omitempty"`
+	CreateTime    *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedVisibilityQuery) Reset() {
+	*x = SavedVisibilityQuery{}
+	mi := &file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedVisibilityQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedVisibilityQuery) ProtoMessage() {}
+
+func (x *SavedVisibilityQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedVisibilityQuery.ProtoReflect.Descriptor instead.
+func (*SavedVisibilityQuery) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavedVisibilityQuery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedVisibilityQuery) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedVisibilityQuery) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SavedVisibilityQuery) GetAlert() *SavedVisibilityQueryAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *SavedVisibilityQuery) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SavedVisibilityQuery) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type SavedVisibilityQueryAlert struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The alert fires when the count is greater than the threshold, or less than the threshold if
+	// fire_below_threshold is set.
+	Threshold          int64 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	FireBelowThreshold bool  `protobuf:"varint,2,opt,name=fire_below_threshold,json=fireBelowThreshold,proto3" json:"fire_below_threshold,omitempty"`
+	// How often the count is evaluated.
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// URL that receives an HTTP POST with a JSON body when the alert fires or resolves.
+	WebhookUrl string `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Headers added to the webhook request, e.g. for authorization.
+	WebhookHeaders map[string]string `protobuf:"bytes,5,rep,name=webhook_headers,json=webhookHeaders,proto3" json:"webhook_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SavedVisibilityQueryAlert) Reset() {
+	*x = SavedVisibilityQueryAlert{}
+	mi := &file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedVisibilityQueryAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedVisibilityQueryAlert) ProtoMessage() {}
+
+func (x *SavedVisibilityQueryAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedVisibilityQueryAlert.ProtoReflect.Descriptor instead.
+func (*SavedVisibilityQueryAlert) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SavedVisibilityQueryAlert) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SavedVisibilityQueryAlert) GetFireBelowThreshold() bool {
+	if x != nil {
+		return x.FireBelowThreshold
+	}
+	return false
+}
+
+func (x *SavedVisibilityQueryAlert) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *SavedVisibilityQueryAlert) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *SavedVisibilityQueryAlert) GetWebhookHeaders() map[string]string {
+	if x != nil {
+		return x.WebhookHeaders
+	}
+	return nil
+}
+
+// SavedVisibilityQueries holds the saved visibility queries of a namespace.
+type SavedVisibilityQueries struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Queries       []*SavedVisibilityQuery `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedVisibilityQueries) Reset() {
+	*x = SavedVisibilityQueries{}
+	mi := &file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedVisibilityQueries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedVisibilityQueries) ProtoMessage() {}
+
+func (x *SavedVisibilityQueries) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedVisibilityQueries.ProtoReflect.Descriptor instead.
+func (*SavedVisibilityQueries) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SavedVisibilityQueries) GetQueries() []*SavedVisibilityQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+var File_temporal_server_api_persistence_v1_saved_visibility_query_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDesc = "" +
+	"\n" +
+	"?temporal/server/api/persistence/v1/saved_visibility_query.proto\x12\"temporal.server.api.persistence.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x02\n" +
+	"\x14SavedVisibilityQuery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12S\n" +
+	"\x05alert\x18\x04 \x01(\v2=.temporal.server.api.persistence.v1.SavedVisibilityQueryAlertR\x05alert\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\"\x82\x03\n" +
+	"\x19SavedVisibilityQueryAlert\x12\x1c\n" +
+	"\tthreshold\x18\x01 \x01(\x03R\tthreshold\x120\n" +
+	"\x14fire_below_threshold\x18\x02 \x01(\bR\x12fireBelowThreshold\x125\n" +
+	"\binterval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\binterval\x12\x1f\n" +
+	"\vwebhook_url\x18\x04 \x01(\tR\n" +
+	"webhookUrl\x12z\n" +
+	"\x0fwebhook_headers\x18\x05 \x03(\v2Q.temporal.server.api.persistence.v1.SavedVisibilityQueryAlert.WebhookHeadersEntryR\x0ewebhookHeaders\x1aA\n" +
+	"\x13WebhookHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\x16SavedVisibilityQueries\x12R\n" +
+	"\aqueries\x18\x01 \x03(\v28.temporal.server.api.persistence.v1.SavedVisibilityQueryR\aqueriesB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+
+var (
+	file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescOnce sync.Once
+	file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDesc), len(file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDesc)))
+	})
+	return file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDescData
+}
+
+var file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_temporal_server_api_persistence_v1_saved_visibility_query_proto_goTypes = []any{
+	(*SavedVisibilityQuery)(nil),      // 0: temporal.server.api.persistence.v1.SavedVisibilityQuery
+	(*SavedVisibilityQueryAlert)(nil), // 1: temporal.server.api.persistence.v1.SavedVisibilityQueryAlert
+	(*SavedVisibilityQueries)(nil),    // 2: temporal.server.api.persistence.v1.SavedVisibilityQueries
+	nil,                               // 3: temporal.server.api.persistence.v1.SavedVisibilityQueryAlert.WebhookHeadersEntry
+	(*timestamppb.Timestamp)(nil),     // 4: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 5: google.protobuf.Duration
+}
+var file_temporal_server_api_persistence_v1_saved_visibility_query_proto_depIdxs = []int32{
+	1, // 0: temporal.server.api.persistence.v1.SavedVisibilityQuery.alert:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQueryAlert
+	4, // 1: temporal.server.api.persistence.v1.SavedVisibilityQuery.create_time:type_name -> google.protobuf.Timestamp
+	4, // 2: temporal.server.api.persistence.v1.SavedVisibilityQuery.update_time:type_name -> google.protobuf.Timestamp
+	5, // 3: temporal.server.api.persistence.v1.SavedVisibilityQueryAlert.interval:type_name -> google.protobuf.Duration
+	3, // 4: temporal.server.api.persistence.v1.SavedVisibilityQueryAlert.webhook_headers:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQueryAlert.WebhookHeadersEntry
+	0, // 5: temporal.server.api.persistence.v1.SavedVisibilityQueries.queries:type_name -> temporal.server.api.persistence.v1.SavedVisibilityQuery
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_persistence_v1_saved_visibility_query_proto_init() }
+func file_temporal_server_api_persistence_v1_saved_visibility_query_proto_init() {
+	if File_temporal_server_api_persistence_v1_saved_visibility_query_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDesc), len(file_temporal_server_api_persistence_v1_saved_visibility_query_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_persistence_v1_saved_visibility_query_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_persistence_v1_saved_visibility_query_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_persistence_v1_saved_visibility_query_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_persistence_v1_saved_visibility_query_proto = out.File
+	file_temporal_server_api_persistence_v1_saved_visibility_query_proto_goTypes = nil
+	file_temporal_server_api_persistence_v1_saved_visibility_query_proto_depIdxs = nil
+}
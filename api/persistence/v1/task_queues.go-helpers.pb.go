@@ -264,6 +264,117 @@ func (this *TaskQueueTypeUserData) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type FairnessWeights to the protobuf v3 wire format
+func (val *FairnessWeights) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FairnessWeights from the protobuf v3 wire format
+func (val *FairnessWeights) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FairnessWeights) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FairnessWeights values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FairnessWeights) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FairnessWeights
+	switch t := that.(type) {
+	case *FairnessWeights:
+		that1 = t
+	case FairnessWeights:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type TaskQueuePause to the protobuf v3 wire format
+func (val *TaskQueuePause) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type TaskQueuePause from the protobuf v3 wire format
+func (val *TaskQueuePause) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *TaskQueuePause) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two TaskQueuePause values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *TaskQueuePause) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *TaskQueuePause
+	switch t := that.(type) {
+	case *TaskQueuePause:
+		that1 = t
+	case TaskQueuePause:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type BlockedPoller to the protobuf v3 wire format
+func (val *BlockedPoller) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type BlockedPoller from the protobuf v3 wire format
+func (val *BlockedPoller) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *BlockedPoller) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two BlockedPoller values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *BlockedPoller) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *BlockedPoller
+	switch t := that.(type) {
+	case *BlockedPoller:
+		that1 = t
+	case BlockedPoller:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueueUserData to the protobuf v3 wire format
 func (val *TaskQueueUserData) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -79,6 +79,80 @@ func (this *InternalState) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type SkippedAction to the protobuf v3 wire format
+func (val *SkippedAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SkippedAction from the protobuf v3 wire format
+func (val *SkippedAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SkippedAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SkippedAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SkippedAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SkippedAction
+	switch t := that.(type) {
+	case *SkippedAction:
+		that1 = t
+	case SkippedAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SkippedActions to the protobuf v3 wire format
+func (val *SkippedActions) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SkippedActions from the protobuf v3 wire format
+func (val *SkippedActions) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SkippedActions) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SkippedActions values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SkippedActions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SkippedActions
+	switch t := that.(type) {
+	case *SkippedActions:
+		that1 = t
+	case SkippedActions:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type StartScheduleArgs to the protobuf v3 wire format
 func (val *StartScheduleArgs) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -190,6 +264,154 @@ func (this *DescribeResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type CalendarSetReference to the protobuf v3 wire format
+func (val *CalendarSetReference) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CalendarSetReference from the protobuf v3 wire format
+func (val *CalendarSetReference) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CalendarSetReference) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CalendarSetReference values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CalendarSetReference) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CalendarSetReference
+	switch t := that.(type) {
+	case *CalendarSetReference:
+		that1 = t
+	case CalendarSetReference:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type RecordSkippedActionsRequest to the protobuf v3 wire format
+func (val *RecordSkippedActionsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type RecordSkippedActionsRequest from the protobuf v3 wire format
+func (val *RecordSkippedActionsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *RecordSkippedActionsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two RecordSkippedActionsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *RecordSkippedActionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *RecordSkippedActionsRequest
+	switch t := that.(type) {
+	case *RecordSkippedActionsRequest:
+		that1 = t
+	case RecordSkippedActionsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CheckDependencyRequest to the protobuf v3 wire format
+func (val *CheckDependencyRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CheckDependencyRequest from the protobuf v3 wire format
+func (val *CheckDependencyRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CheckDependencyRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CheckDependencyRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CheckDependencyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CheckDependencyRequest
+	switch t := that.(type) {
+	case *CheckDependencyRequest:
+		that1 = t
+	case CheckDependencyRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CheckDependencyResponse to the protobuf v3 wire format
+func (val *CheckDependencyResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CheckDependencyResponse from the protobuf v3 wire format
+func (val *CheckDependencyResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CheckDependencyResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CheckDependencyResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CheckDependencyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CheckDependencyResponse
+	switch t := that.(type) {
+	case *CheckDependencyResponse:
+		that1 = t
+	case CheckDependencyResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type WatchWorkflowRequest to the protobuf v3 wire format
 func (val *WatchWorkflowRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -486,6 +708,43 @@ func (this *SchedulerInternal) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type ScheduleDependency to the protobuf v3 wire format
+func (val *ScheduleDependency) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleDependency from the protobuf v3 wire format
+func (val *ScheduleDependency) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleDependency) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleDependency values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleDependency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleDependency
+	switch t := that.(type) {
+	case *ScheduleDependency:
+		that1 = t
+	case ScheduleDependency:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type GeneratorInternal to the protobuf v3 wire format
 func (val *GeneratorInternal) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -15,10 +15,11 @@ import (
 	v1 "go.temporal.io/api/enums/v1"
 	v13 "go.temporal.io/api/failure/v1"
 	v11 "go.temporal.io/api/schedule/v1"
-	v14 "go.temporal.io/api/workflowservice/v1"
-	v15 "go.temporal.io/server/api/enums/v1"
+	v15 "go.temporal.io/api/workflowservice/v1"
+	v14 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -160,8 +161,10 @@ type InternalState struct {
 	// conflict token is implemented as simple sequence number
 	ConflictToken int64 `protobuf:"varint,7,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	NeedRefresh   bool  `protobuf:"varint,9,opt,name=need_refresh,json=needRefresh,proto3" json:"need_refresh,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Actions that the schedule skipped and that weren't persisted yet, oldest first.
+	PendingSkippedActions []*SkippedAction `protobuf:"bytes,11,rep,name=pending_skipped_actions,json=pendingSkippedActions,proto3" json:"pending_skipped_actions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *InternalState) Reset() {
@@ -264,19 +267,137 @@ func (x *InternalState) GetNeedRefresh() bool {
 	return false
 }
 
+func (x *InternalState) GetPendingSkippedActions() []*SkippedAction {
+	if x != nil {
+		return x.PendingSkippedActions
+	}
+	return nil
+}
+
+type SkippedAction struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	NominalTime   *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=nominal_time,json=nominalTime,proto3" json:"nominal_time,omitempty"`
+	ActualTime    *timestamppb.Timestamp       `protobuf:"bytes,2,opt,name=actual_time,json=actualTime,proto3" json:"actual_time,omitempty"`
+	Reason        v14.ScheduleActionSkipReason `protobuf:"varint,3,opt,name=reason,proto3,enum=temporal.server.api.enums.v1.ScheduleActionSkipReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkippedAction) Reset() {
+	*x = SkippedAction{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedAction) ProtoMessage() {}
+
+func (x *SkippedAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedAction.ProtoReflect.Descriptor instead.
+func (*SkippedAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SkippedAction) GetNominalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NominalTime
+	}
+	return nil
+}
+
+func (x *SkippedAction) GetActualTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualTime
+	}
+	return nil
+}
+
+func (x *SkippedAction) GetReason() v14.ScheduleActionSkipReason {
+	if x != nil {
+		return x.Reason
+	}
+	return v14.ScheduleActionSkipReason(0)
+}
+
+// SkippedActions is a message of the skipped action queue of a schedule.
+type SkippedActions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first.
+	SkippedActions []*SkippedAction `protobuf:"bytes,1,rep,name=skipped_actions,json=skippedActions,proto3" json:"skipped_actions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SkippedActions) Reset() {
+	*x = SkippedActions{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkippedActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedActions) ProtoMessage() {}
+
+func (x *SkippedActions) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkippedActions.ProtoReflect.Descriptor instead.
+func (*SkippedActions) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SkippedActions) GetSkippedActions() []*SkippedAction {
+	if x != nil {
+		return x.SkippedActions
+	}
+	return nil
+}
+
 type StartScheduleArgs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schedule      *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info          *v11.ScheduleInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	InitialPatch  *v11.SchedulePatch     `protobuf:"bytes,3,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
-	State         *InternalState         `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Schedule     *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info         *v11.ScheduleInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	InitialPatch *v11.SchedulePatch     `protobuf:"bytes,3,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
+	State        *InternalState         `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// Calendar sets whose days the spec of the schedule excludes or shifts.
+	CalendarSets []*CalendarSetReference `protobuf:"bytes,5,rep,name=calendar_sets,json=calendarSets,proto3" json:"calendar_sets,omitempty"`
+	// Scheduled actions are started only after the actions of these schedules for the same
+	// nominal time completed successfully.
+	Dependencies  []*ScheduleDependency `protobuf:"bytes,6,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StartScheduleArgs) Reset() {
 	*x = StartScheduleArgs{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[2]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +409,7 @@ func (x *StartScheduleArgs) String() string {
 func (*StartScheduleArgs) ProtoMessage() {}
 
 func (x *StartScheduleArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[2]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +422,7 @@ func (x *StartScheduleArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartScheduleArgs.ProtoReflect.Descriptor instead.
 func (*StartScheduleArgs) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{2}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StartScheduleArgs) GetSchedule() *v11.Schedule {
@@ -332,18 +453,34 @@ func (x *StartScheduleArgs) GetState() *InternalState {
 	return nil
 }
 
+func (x *StartScheduleArgs) GetCalendarSets() []*CalendarSetReference {
+	if x != nil {
+		return x.CalendarSets
+	}
+	return nil
+}
+
+func (x *StartScheduleArgs) GetDependencies() []*ScheduleDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
 type FullUpdateRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Schedule         *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	ConflictToken    int64                  `protobuf:"varint,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
-	SearchAttributes *v12.SearchAttributes  `protobuf:"bytes,3,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Schedule         *v11.Schedule           `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	ConflictToken    int64                   `protobuf:"varint,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	SearchAttributes *v12.SearchAttributes   `protobuf:"bytes,3,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	CalendarSets     []*CalendarSetReference `protobuf:"bytes,4,rep,name=calendar_sets,json=calendarSets,proto3" json:"calendar_sets,omitempty"`
+	Dependencies     []*ScheduleDependency   `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *FullUpdateRequest) Reset() {
 	*x = FullUpdateRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[3]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -355,7 +492,7 @@ func (x *FullUpdateRequest) String() string {
 func (*FullUpdateRequest) ProtoMessage() {}
 
 func (x *FullUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[3]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,7 +505,7 @@ func (x *FullUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FullUpdateRequest.ProtoReflect.Descriptor instead.
 func (*FullUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{3}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FullUpdateRequest) GetSchedule() *v11.Schedule {
@@ -392,18 +529,34 @@ func (x *FullUpdateRequest) GetSearchAttributes() *v12.SearchAttributes {
 	return nil
 }
 
+func (x *FullUpdateRequest) GetCalendarSets() []*CalendarSetReference {
+	if x != nil {
+		return x.CalendarSets
+	}
+	return nil
+}
+
+func (x *FullUpdateRequest) GetDependencies() []*ScheduleDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
 type DescribeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schedule      *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info          *v11.ScheduleInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	ConflictToken int64                  `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Schedule      *v11.Schedule           `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info          *v11.ScheduleInfo       `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	ConflictToken int64                   `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	CalendarSets  []*CalendarSetReference `protobuf:"bytes,4,rep,name=calendar_sets,json=calendarSets,proto3" json:"calendar_sets,omitempty"`
+	Dependencies  []*ScheduleDependency   `protobuf:"bytes,5,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DescribeResponse) Reset() {
 	*x = DescribeResponse{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[4]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +568,7 @@ func (x *DescribeResponse) String() string {
 func (*DescribeResponse) ProtoMessage() {}
 
 func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[4]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +581,7 @@ func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
 func (*DescribeResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{4}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DescribeResponse) GetSchedule() *v11.Schedule {
@@ -452,6 +605,244 @@ func (x *DescribeResponse) GetConflictToken() int64 {
 	return 0
 }
 
+func (x *DescribeResponse) GetCalendarSets() []*CalendarSetReference {
+	if x != nil {
+		return x.CalendarSets
+	}
+	return nil
+}
+
+func (x *DescribeResponse) GetDependencies() []*ScheduleDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// CalendarSetReference is a reference from a schedule to a named calendar set of its namespace.
+// The days of the set are copied into the reference when the schedule is created or updated, so
+// the actions of the schedule don't depend on the current state of the namespace.
+type CalendarSetReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If set, actions that land on a day of the set move to the same time on the next weekday
+	// that isn't in any referenced set, instead of being skipped.
+	ShiftToNextBusinessDay bool `protobuf:"varint,2,opt,name=shift_to_next_business_day,json=shiftToNextBusinessDay,proto3" json:"shift_to_next_business_day,omitempty"`
+	// Days of the set in "2006-01-02" format, in the time zone of the schedule.
+	Days          []string `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarSetReference) Reset() {
+	*x = CalendarSetReference{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarSetReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarSetReference) ProtoMessage() {}
+
+func (x *CalendarSetReference) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarSetReference.ProtoReflect.Descriptor instead.
+func (*CalendarSetReference) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalendarSetReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarSetReference) GetShiftToNextBusinessDay() bool {
+	if x != nil {
+		return x.ShiftToNextBusinessDay
+	}
+	return false
+}
+
+func (x *CalendarSetReference) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type RecordSkippedActionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Oldest first.
+	SkippedActions []*SkippedAction `protobuf:"bytes,2,rep,name=skipped_actions,json=skippedActions,proto3" json:"skipped_actions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordSkippedActionsRequest) Reset() {
+	*x = RecordSkippedActionsRequest{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSkippedActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSkippedActionsRequest) ProtoMessage() {}
+
+func (x *RecordSkippedActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSkippedActionsRequest.ProtoReflect.Descriptor instead.
+func (*RecordSkippedActionsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RecordSkippedActionsRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *RecordSkippedActionsRequest) GetSkippedActions() []*SkippedAction {
+	if x != nil {
+		return x.SkippedActions
+	}
+	return nil
+}
+
+type CheckDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dependency    *ScheduleDependency    `protobuf:"bytes,1,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	NominalTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=nominal_time,json=nominalTime,proto3" json:"nominal_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDependencyRequest) Reset() {
+	*x = CheckDependencyRequest{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDependencyRequest) ProtoMessage() {}
+
+func (x *CheckDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDependencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckDependencyRequest) GetDependency() *ScheduleDependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+func (x *CheckDependencyRequest) GetNominalTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NominalTime
+	}
+	return nil
+}
+
+type CheckDependencyResponse struct {
+	state  protoimpl.MessageState       `protogen:"open.v1"`
+	Status v14.ScheduleDependencyStatus `protobuf:"varint,1,opt,name=status,proto3,enum=temporal.server.api.enums.v1.ScheduleDependencyStatus" json:"status,omitempty"`
+	// Why the upstream action failed, if it did.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDependencyResponse) Reset() {
+	*x = CheckDependencyResponse{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDependencyResponse) ProtoMessage() {}
+
+func (x *CheckDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDependencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckDependencyResponse) GetStatus() v14.ScheduleDependencyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v14.ScheduleDependencyStatus(0)
+}
+
+func (x *CheckDependencyResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type WatchWorkflowRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Note: this will be sent to the activity with empty execution.run_id, and
@@ -465,7 +856,7 @@ type WatchWorkflowRequest struct {
 
 func (x *WatchWorkflowRequest) Reset() {
 	*x = WatchWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -477,7 +868,7 @@ func (x *WatchWorkflowRequest) String() string {
 func (*WatchWorkflowRequest) ProtoMessage() {}
 
 func (x *WatchWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[5]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,7 +881,7 @@ func (x *WatchWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*WatchWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{5}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WatchWorkflowRequest) GetExecution() *v12.WorkflowExecution {
@@ -530,7 +921,7 @@ type WatchWorkflowResponse struct {
 
 func (x *WatchWorkflowResponse) Reset() {
 	*x = WatchWorkflowResponse{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +933,7 @@ func (x *WatchWorkflowResponse) String() string {
 func (*WatchWorkflowResponse) ProtoMessage() {}
 
 func (x *WatchWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[6]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +946,7 @@ func (x *WatchWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WatchWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*WatchWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{6}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *WatchWorkflowResponse) GetStatus() v1.WorkflowExecutionStatus {
@@ -615,7 +1006,7 @@ func (*WatchWorkflowResponse_Failure) isWatchWorkflowResponse_ResultFailure() {}
 
 type StartWorkflowRequest struct {
 	state                   protoimpl.MessageState             `protogen:"open.v1"`
-	Request                 *v14.StartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Request                 *v15.StartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 	CompletedRateLimitSleep bool                               `protobuf:"varint,6,opt,name=completed_rate_limit_sleep,json=completedRateLimitSleep,proto3" json:"completed_rate_limit_sleep,omitempty"`
 	unknownFields           protoimpl.UnknownFields
 	sizeCache               protoimpl.SizeCache
@@ -623,7 +1014,7 @@ type StartWorkflowRequest struct {
 
 func (x *StartWorkflowRequest) Reset() {
 	*x = StartWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -635,7 +1026,7 @@ func (x *StartWorkflowRequest) String() string {
 func (*StartWorkflowRequest) ProtoMessage() {}
 
 func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[7]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,10 +1039,10 @@ func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{7}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StartWorkflowRequest) GetRequest() *v14.StartWorkflowExecutionRequest {
+func (x *StartWorkflowRequest) GetRequest() *v15.StartWorkflowExecutionRequest {
 	if x != nil {
 		return x.Request
 	}
@@ -675,7 +1066,7 @@ type StartWorkflowResponse struct {
 
 func (x *StartWorkflowResponse) Reset() {
 	*x = StartWorkflowResponse{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +1078,7 @@ func (x *StartWorkflowResponse) String() string {
 func (*StartWorkflowResponse) ProtoMessage() {}
 
 func (x *StartWorkflowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[8]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +1091,7 @@ func (x *StartWorkflowResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartWorkflowResponse.ProtoReflect.Descriptor instead.
 func (*StartWorkflowResponse) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{8}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StartWorkflowResponse) GetRunId() string {
@@ -730,7 +1121,7 @@ type CancelWorkflowRequest struct {
 
 func (x *CancelWorkflowRequest) Reset() {
 	*x = CancelWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -742,7 +1133,7 @@ func (x *CancelWorkflowRequest) String() string {
 func (*CancelWorkflowRequest) ProtoMessage() {}
 
 func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +1146,7 @@ func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{9}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CancelWorkflowRequest) GetRequestId() string {
@@ -799,7 +1190,7 @@ type TerminateWorkflowRequest struct {
 
 func (x *TerminateWorkflowRequest) Reset() {
 	*x = TerminateWorkflowRequest{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -811,7 +1202,7 @@ func (x *TerminateWorkflowRequest) String() string {
 func (*TerminateWorkflowRequest) ProtoMessage() {}
 
 func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1215,7 @@ func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TerminateWorkflowRequest.ProtoReflect.Descriptor instead.
 func (*TerminateWorkflowRequest) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{10}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TerminateWorkflowRequest) GetRequestId() string {
@@ -874,7 +1265,7 @@ type NextTimeCache struct {
 
 func (x *NextTimeCache) Reset() {
 	*x = NextTimeCache{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1277,7 @@ func (x *NextTimeCache) String() string {
 func (*NextTimeCache) ProtoMessage() {}
 
 func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1290,7 @@ func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextTimeCache.ProtoReflect.Descriptor instead.
 func (*NextTimeCache) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{11}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *NextTimeCache) GetVersion() int64 {
@@ -951,13 +1342,18 @@ type SchedulerInternal struct {
 	// Implemented as a sequence number. Used for optimistic locking against
 	// update requests.
 	ConflictToken int64 `protobuf:"varint,8,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	// Scheduled actions are started only after the actions of these schedules for the same
+	// nominal time completed successfully.
+	Dependencies []*ScheduleDependency `protobuf:"bytes,9,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Calendar sets whose days the spec of the schedule excludes or shifts.
+	CalendarSets  []*CalendarSetReference `protobuf:"bytes,10,rep,name=calendar_sets,json=calendarSets,proto3" json:"calendar_sets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SchedulerInternal) Reset() {
 	*x = SchedulerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -969,7 +1365,7 @@ func (x *SchedulerInternal) String() string {
 func (*SchedulerInternal) ProtoMessage() {}
 
 func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1378,7 @@ func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SchedulerInternal.ProtoReflect.Descriptor instead.
 func (*SchedulerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{12}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SchedulerInternal) GetSchedule() *v11.Schedule {
@@ -1034,6 +1430,97 @@ func (x *SchedulerInternal) GetConflictToken() int64 {
 	return 0
 }
 
+func (x *SchedulerInternal) GetDependencies() []*ScheduleDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetCalendarSets() []*CalendarSetReference {
+	if x != nil {
+		return x.CalendarSets
+	}
+	return nil
+}
+
+// ScheduleDependency is an upstream schedule of a schedule. The upstream action
+// for a nominal time is the latest workflow that the upstream schedule started for a nominal
+// time in the window that ends at that nominal time, so that the schedules don't need the same spec.
+type ScheduleDependency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Schedule id of the upstream schedule, in the same namespace.
+	ScheduleId string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// How long after the actual time of an action to wait for the upstream action to complete.
+	// Defaults to the catchup window of the schedule.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// What to do when the upstream action fails or times out. Defaults to skip.
+	Policy v14.ScheduleDependencyPolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=temporal.server.api.enums.v1.ScheduleDependencyPolicy" json:"policy,omitempty"`
+	// Length of the window before the nominal time of an action in which the nominal time of
+	// the upstream action must be. Defaults to one day.
+	Window        *durationpb.Duration `protobuf:"bytes,4,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleDependency) Reset() {
+	*x = ScheduleDependency{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDependency) ProtoMessage() {}
+
+func (x *ScheduleDependency) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleDependency.ProtoReflect.Descriptor instead.
+func (*ScheduleDependency) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ScheduleDependency) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduleDependency) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *ScheduleDependency) GetPolicy() v14.ScheduleDependencyPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return v14.ScheduleDependencyPolicy(0)
+}
+
+func (x *ScheduleDependency) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
 // State machine scheduler's Generator internal state.
 type GeneratorInternal struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1047,7 +1534,7 @@ type GeneratorInternal struct {
 
 func (x *GeneratorInternal) Reset() {
 	*x = GeneratorInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1059,7 +1546,7 @@ func (x *GeneratorInternal) String() string {
 func (*GeneratorInternal) ProtoMessage() {}
 
 func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1559,7 @@ func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneratorInternal.ProtoReflect.Descriptor instead.
 func (*GeneratorInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GeneratorInternal) GetNextInvocationTime() *timestamppb.Timestamp {
@@ -1092,7 +1579,7 @@ func (x *GeneratorInternal) GetLastProcessedTime() *timestamppb.Timestamp {
 // State machine scheduler's Invoker internal state.
 type InvokerInternal struct {
 	state protoimpl.MessageState    `protogen:"open.v1"`
-	State v15.SchedulerInvokerState `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.SchedulerInvokerState" json:"state,omitempty"`
+	State v14.SchedulerInvokerState `protobuf:"varint,1,opt,name=state,proto3,enum=temporal.server.api.enums.v1.SchedulerInvokerState" json:"state,omitempty"`
 	// Buffered starts that will be started by the Invoker.
 	BufferedStarts []*BufferedStart `protobuf:"bytes,2,rep,name=buffered_starts,json=bufferedStarts,proto3" json:"buffered_starts,omitempty"`
 	// Workflow executions that will be cancelled due to overlap policy.
@@ -1105,13 +1592,16 @@ type InvokerInternal struct {
 	// as after applying a replicated state (as opposed to evaluating based on
 	// present time).
 	LastProcessedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_processed_time,json=lastProcessedTime,proto3" json:"last_processed_time,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Actions that the schedule skipped and that weren't persisted yet, oldest first. They are
+	// persisted by the next ExecuteTask.
+	PendingSkippedActions []*SkippedAction `protobuf:"bytes,6,rep,name=pending_skipped_actions,json=pendingSkippedActions,proto3" json:"pending_skipped_actions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *InvokerInternal) Reset() {
 	*x = InvokerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1613,7 @@ func (x *InvokerInternal) String() string {
 func (*InvokerInternal) ProtoMessage() {}
 
 func (x *InvokerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,14 +1626,14 @@ func (x *InvokerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokerInternal.ProtoReflect.Descriptor instead.
 func (*InvokerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *InvokerInternal) GetState() v15.SchedulerInvokerState {
+func (x *InvokerInternal) GetState() v14.SchedulerInvokerState {
 	if x != nil {
 		return x.State
 	}
-	return v15.SchedulerInvokerState(0)
+	return v14.SchedulerInvokerState(0)
 }
 
 func (x *InvokerInternal) GetBufferedStarts() []*BufferedStart {
@@ -1174,6 +1664,13 @@ func (x *InvokerInternal) GetLastProcessedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *InvokerInternal) GetPendingSkippedActions() []*SkippedAction {
+	if x != nil {
+		return x.PendingSkippedActions
+	}
+	return nil
+}
+
 // State machine scheduler's Backfiller internal state. Backfill requests are 1:1
 // with Backfiller nodes. Backfiller nodes also handle immediate trigger requests.
 type BackfillerInternal struct {
@@ -1199,7 +1696,7 @@ type BackfillerInternal struct {
 
 func (x *BackfillerInternal) Reset() {
 	*x = BackfillerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1211,7 +1708,7 @@ func (x *BackfillerInternal) String() string {
 func (*BackfillerInternal) ProtoMessage() {}
 
 func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,7 +1721,7 @@ func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackfillerInternal.ProtoReflect.Descriptor instead.
 func (*BackfillerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *BackfillerInternal) GetRequest() isBackfillerInternal_Request {
@@ -1301,7 +1798,7 @@ var File_temporal_server_api_schedule_v1_message_proto protoreflect.FileDescript
 
 const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"-temporal/server/api/schedule/v1/message.proto\x12\x1ftemporal.server.api.schedule.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/enums/v1/schedule.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/failure/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x03\n" +
+	"-temporal/server/api/schedule/v1/message.proto\x12\x1ftemporal.server.api.schedule.v1\x1a$temporal/api/common/v1/message.proto\x1a$temporal/api/enums/v1/schedule.proto\x1a$temporal/api/enums/v1/workflow.proto\x1a%temporal/api/failure/v1/message.proto\x1a&temporal/api/schedule/v1/message.proto\x1a6temporal/api/workflowservice/v1/request_response.proto\x1a)temporal/server/api/enums/v1/common.proto\x1a+temporal/server/api/enums/v1/schedule.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x03\n" +
 	"\rBufferedStart\x12=\n" +
 	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12;\n" +
 	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -1312,7 +1809,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"request_id\x18\x06 \x01(\tR\trequestId\x12\x18\n" +
 	"\aattempt\x18\a \x01(\x03R\aattempt\x12=\n" +
-	"\fbackoff_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vbackoffTime\"\xdf\x04\n" +
+	"\fbackoff_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vbackoffTime\"\xc7\x05\n" +
 	"\rInternalState\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12\x1f\n" +
@@ -1325,20 +1822,50 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x16last_completion_result\x18\x05 \x01(\v2 .temporal.api.common.v1.PayloadsR\x14lastCompletionResult\x12M\n" +
 	"\x11continued_failure\x18\x06 \x01(\v2 .temporal.api.failure.v1.FailureR\x10continuedFailure\x12%\n" +
 	"\x0econflict_token\x18\a \x01(\x03R\rconflictToken\x12!\n" +
-	"\fneed_refresh\x18\t \x01(\bR\vneedRefresh\"\xa3\x02\n" +
+	"\fneed_refresh\x18\t \x01(\bR\vneedRefresh\x12f\n" +
+	"\x17pending_skipped_actions\x18\v \x03(\v2..temporal.server.api.schedule.v1.SkippedActionR\x15pendingSkippedActions\"\xdb\x01\n" +
+	"\rSkippedAction\x12=\n" +
+	"\fnominal_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\x12;\n" +
+	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"actualTime\x12N\n" +
+	"\x06reason\x18\x03 \x01(\x0e26.temporal.server.api.enums.v1.ScheduleActionSkipReasonR\x06reason\"i\n" +
+	"\x0eSkippedActions\x12W\n" +
+	"\x0fskipped_actions\x18\x01 \x03(\v2..temporal.server.api.schedule.v1.SkippedActionR\x0eskippedActions\"\xd8\x03\n" +
 	"\x11StartScheduleArgs\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x02 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
 	"\rinitial_patch\x18\x03 \x01(\v2'.temporal.api.schedule.v1.SchedulePatchR\finitialPatch\x12D\n" +
-	"\x05state\x18\x04 \x01(\v2..temporal.server.api.schedule.v1.InternalStateR\x05state\"\xd1\x01\n" +
+	"\x05state\x18\x04 \x01(\v2..temporal.server.api.schedule.v1.InternalStateR\x05state\x12Z\n" +
+	"\rcalendar_sets\x18\x05 \x03(\v25.temporal.server.api.schedule.v1.CalendarSetReferenceR\fcalendarSets\x12W\n" +
+	"\fdependencies\x18\x06 \x03(\v23.temporal.server.api.schedule.v1.ScheduleDependencyR\fdependencies\"\x86\x03\n" +
 	"\x11FullUpdateRequest\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12%\n" +
 	"\x0econflict_token\x18\x02 \x01(\x03R\rconflictToken\x12U\n" +
-	"\x11search_attributes\x18\x03 \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\"\xb5\x01\n" +
+	"\x11search_attributes\x18\x03 \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\x12Z\n" +
+	"\rcalendar_sets\x18\x04 \x03(\v25.temporal.server.api.schedule.v1.CalendarSetReferenceR\fcalendarSets\x12W\n" +
+	"\fdependencies\x18\x05 \x03(\v23.temporal.server.api.schedule.v1.ScheduleDependencyR\fdependencies\"\xea\x02\n" +
 	"\x10DescribeResponse\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x02 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12%\n" +
-	"\x0econflict_token\x18\x03 \x01(\x03R\rconflictToken\"\xb1\x01\n" +
+	"\x0econflict_token\x18\x03 \x01(\x03R\rconflictToken\x12Z\n" +
+	"\rcalendar_sets\x18\x04 \x03(\v25.temporal.server.api.schedule.v1.CalendarSetReferenceR\fcalendarSets\x12W\n" +
+	"\fdependencies\x18\x05 \x03(\v23.temporal.server.api.schedule.v1.ScheduleDependencyR\fdependencies\"z\n" +
+	"\x14CalendarSetReference\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12:\n" +
+	"\x1ashift_to_next_business_day\x18\x02 \x01(\bR\x16shiftToNextBusinessDay\x12\x12\n" +
+	"\x04days\x18\x03 \x03(\tR\x04days\"\x97\x01\n" +
+	"\x1bRecordSkippedActionsRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12W\n" +
+	"\x0fskipped_actions\x18\x02 \x03(\v2..temporal.server.api.schedule.v1.SkippedActionR\x0eskippedActions\"\xac\x01\n" +
+	"\x16CheckDependencyRequest\x12S\n" +
+	"\n" +
+	"dependency\x18\x01 \x01(\v23.temporal.server.api.schedule.v1.ScheduleDependencyR\n" +
+	"dependency\x12=\n" +
+	"\fnominal_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vnominalTime\"\x81\x01\n" +
+	"\x17CheckDependencyResponse\x12N\n" +
+	"\x06status\x18\x01 \x01(\x0e26.temporal.server.api.enums.v1.ScheduleDependencyStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xb1\x01\n" +
 	"\x14WatchWorkflowRequest\x12G\n" +
 	"\texecution\x18\x03 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x123\n" +
 	"\x16first_execution_run_id\x18\x04 \x01(\tR\x13firstExecutionRunId\x12\x1b\n" +
@@ -1375,7 +1902,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"next_times\x18\x03 \x03(\x03R\tnextTimes\x12#\n" +
 	"\rnominal_times\x18\x04 \x03(\x03R\fnominalTimes\x12\x1c\n" +
-	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\xe6\x02\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\x9b\x04\n" +
 	"\x11SchedulerInternal\x12>\n" +
 	"\bschedule\x18\x02 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x03 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
@@ -1384,16 +1911,26 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\fnamespace_id\x18\x06 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vschedule_id\x18\a \x01(\tR\n" +
 	"scheduleId\x12%\n" +
-	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\"\xad\x01\n" +
+	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\x12W\n" +
+	"\fdependencies\x18\t \x03(\v23.temporal.server.api.schedule.v1.ScheduleDependencyR\fdependencies\x12Z\n" +
+	"\rcalendar_sets\x18\n" +
+	" \x03(\v25.temporal.server.api.schedule.v1.CalendarSetReferenceR\fcalendarSets\"\xed\x01\n" +
+	"\x12ScheduleDependency\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x123\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12N\n" +
+	"\x06policy\x18\x03 \x01(\x0e26.temporal.server.api.enums.v1.ScheduleDependencyPolicyR\x06policy\x121\n" +
+	"\x06window\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x06window\"\xad\x01\n" +
 	"\x11GeneratorInternal\x12L\n" +
 	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTime\x12J\n" +
-	"\x13last_processed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\xb3\x03\n" +
+	"\x13last_processed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\x9b\x04\n" +
 	"\x0fInvokerInternal\x12I\n" +
 	"\x05state\x18\x01 \x01(\x0e23.temporal.server.api.enums.v1.SchedulerInvokerStateR\x05state\x12W\n" +
 	"\x0fbuffered_starts\x18\x02 \x03(\v2..temporal.server.api.schedule.v1.BufferedStartR\x0ebufferedStarts\x12T\n" +
 	"\x10cancel_workflows\x18\x03 \x03(\v2).temporal.api.common.v1.WorkflowExecutionR\x0fcancelWorkflows\x12Z\n" +
 	"\x13terminate_workflows\x18\x04 \x03(\v2).temporal.api.common.v1.WorkflowExecutionR\x12terminateWorkflows\x12J\n" +
-	"\x13last_processed_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\xac\x03\n" +
+	"\x13last_processed_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\x12f\n" +
+	"\x17pending_skipped_actions\x18\x06 \x03(\v2..temporal.server.api.schedule.v1.SkippedActionR\x15pendingSkippedActions\"\xac\x03\n" +
 	"\x12BackfillerInternal\x12V\n" +
 	"\x10backfill_request\x18\x01 \x01(\v2).temporal.api.schedule.v1.BackfillRequestH\x00R\x0fbackfillRequest\x12^\n" +
 	"\x0ftrigger_request\x18\x02 \x01(\v23.temporal.api.schedule.v1.TriggerImmediatelyRequestH\x00R\x0etriggerRequest\x12\x1f\n" +
@@ -1416,87 +1953,119 @@ func file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_temporal_server_api_schedule_v1_message_proto_goTypes = []any{
 	(*BufferedStart)(nil),                     // 0: temporal.server.api.schedule.v1.BufferedStart
 	(*InternalState)(nil),                     // 1: temporal.server.api.schedule.v1.InternalState
-	(*StartScheduleArgs)(nil),                 // 2: temporal.server.api.schedule.v1.StartScheduleArgs
-	(*FullUpdateRequest)(nil),                 // 3: temporal.server.api.schedule.v1.FullUpdateRequest
-	(*DescribeResponse)(nil),                  // 4: temporal.server.api.schedule.v1.DescribeResponse
-	(*WatchWorkflowRequest)(nil),              // 5: temporal.server.api.schedule.v1.WatchWorkflowRequest
-	(*WatchWorkflowResponse)(nil),             // 6: temporal.server.api.schedule.v1.WatchWorkflowResponse
-	(*StartWorkflowRequest)(nil),              // 7: temporal.server.api.schedule.v1.StartWorkflowRequest
-	(*StartWorkflowResponse)(nil),             // 8: temporal.server.api.schedule.v1.StartWorkflowResponse
-	(*CancelWorkflowRequest)(nil),             // 9: temporal.server.api.schedule.v1.CancelWorkflowRequest
-	(*TerminateWorkflowRequest)(nil),          // 10: temporal.server.api.schedule.v1.TerminateWorkflowRequest
-	(*NextTimeCache)(nil),                     // 11: temporal.server.api.schedule.v1.NextTimeCache
-	(*SchedulerInternal)(nil),                 // 12: temporal.server.api.schedule.v1.SchedulerInternal
-	(*GeneratorInternal)(nil),                 // 13: temporal.server.api.schedule.v1.GeneratorInternal
-	(*InvokerInternal)(nil),                   // 14: temporal.server.api.schedule.v1.InvokerInternal
-	(*BackfillerInternal)(nil),                // 15: temporal.server.api.schedule.v1.BackfillerInternal
-	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
-	(v1.ScheduleOverlapPolicy)(0),             // 17: temporal.api.enums.v1.ScheduleOverlapPolicy
-	(*v11.BackfillRequest)(nil),               // 18: temporal.api.schedule.v1.BackfillRequest
-	(*v12.Payloads)(nil),                      // 19: temporal.api.common.v1.Payloads
-	(*v13.Failure)(nil),                       // 20: temporal.api.failure.v1.Failure
-	(*v11.Schedule)(nil),                      // 21: temporal.api.schedule.v1.Schedule
-	(*v11.ScheduleInfo)(nil),                  // 22: temporal.api.schedule.v1.ScheduleInfo
-	(*v11.SchedulePatch)(nil),                 // 23: temporal.api.schedule.v1.SchedulePatch
-	(*v12.SearchAttributes)(nil),              // 24: temporal.api.common.v1.SearchAttributes
-	(*v12.WorkflowExecution)(nil),             // 25: temporal.api.common.v1.WorkflowExecution
-	(v1.WorkflowExecutionStatus)(0),           // 26: temporal.api.enums.v1.WorkflowExecutionStatus
-	(*v14.StartWorkflowExecutionRequest)(nil), // 27: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	(v15.SchedulerInvokerState)(0),            // 28: temporal.server.api.enums.v1.SchedulerInvokerState
-	(*v11.TriggerImmediatelyRequest)(nil),     // 29: temporal.api.schedule.v1.TriggerImmediatelyRequest
+	(*SkippedAction)(nil),                     // 2: temporal.server.api.schedule.v1.SkippedAction
+	(*SkippedActions)(nil),                    // 3: temporal.server.api.schedule.v1.SkippedActions
+	(*StartScheduleArgs)(nil),                 // 4: temporal.server.api.schedule.v1.StartScheduleArgs
+	(*FullUpdateRequest)(nil),                 // 5: temporal.server.api.schedule.v1.FullUpdateRequest
+	(*DescribeResponse)(nil),                  // 6: temporal.server.api.schedule.v1.DescribeResponse
+	(*CalendarSetReference)(nil),              // 7: temporal.server.api.schedule.v1.CalendarSetReference
+	(*RecordSkippedActionsRequest)(nil),       // 8: temporal.server.api.schedule.v1.RecordSkippedActionsRequest
+	(*CheckDependencyRequest)(nil),            // 9: temporal.server.api.schedule.v1.CheckDependencyRequest
+	(*CheckDependencyResponse)(nil),           // 10: temporal.server.api.schedule.v1.CheckDependencyResponse
+	(*WatchWorkflowRequest)(nil),              // 11: temporal.server.api.schedule.v1.WatchWorkflowRequest
+	(*WatchWorkflowResponse)(nil),             // 12: temporal.server.api.schedule.v1.WatchWorkflowResponse
+	(*StartWorkflowRequest)(nil),              // 13: temporal.server.api.schedule.v1.StartWorkflowRequest
+	(*StartWorkflowResponse)(nil),             // 14: temporal.server.api.schedule.v1.StartWorkflowResponse
+	(*CancelWorkflowRequest)(nil),             // 15: temporal.server.api.schedule.v1.CancelWorkflowRequest
+	(*TerminateWorkflowRequest)(nil),          // 16: temporal.server.api.schedule.v1.TerminateWorkflowRequest
+	(*NextTimeCache)(nil),                     // 17: temporal.server.api.schedule.v1.NextTimeCache
+	(*SchedulerInternal)(nil),                 // 18: temporal.server.api.schedule.v1.SchedulerInternal
+	(*ScheduleDependency)(nil),                // 19: temporal.server.api.schedule.v1.ScheduleDependency
+	(*GeneratorInternal)(nil),                 // 20: temporal.server.api.schedule.v1.GeneratorInternal
+	(*InvokerInternal)(nil),                   // 21: temporal.server.api.schedule.v1.InvokerInternal
+	(*BackfillerInternal)(nil),                // 22: temporal.server.api.schedule.v1.BackfillerInternal
+	(*timestamppb.Timestamp)(nil),             // 23: google.protobuf.Timestamp
+	(v1.ScheduleOverlapPolicy)(0),             // 24: temporal.api.enums.v1.ScheduleOverlapPolicy
+	(*v11.BackfillRequest)(nil),               // 25: temporal.api.schedule.v1.BackfillRequest
+	(*v12.Payloads)(nil),                      // 26: temporal.api.common.v1.Payloads
+	(*v13.Failure)(nil),                       // 27: temporal.api.failure.v1.Failure
+	(v14.ScheduleActionSkipReason)(0),         // 28: temporal.server.api.enums.v1.ScheduleActionSkipReason
+	(*v11.Schedule)(nil),                      // 29: temporal.api.schedule.v1.Schedule
+	(*v11.ScheduleInfo)(nil),                  // 30: temporal.api.schedule.v1.ScheduleInfo
+	(*v11.SchedulePatch)(nil),                 // 31: temporal.api.schedule.v1.SchedulePatch
+	(*v12.SearchAttributes)(nil),              // 32: temporal.api.common.v1.SearchAttributes
+	(v14.ScheduleDependencyStatus)(0),         // 33: temporal.server.api.enums.v1.ScheduleDependencyStatus
+	(*v12.WorkflowExecution)(nil),             // 34: temporal.api.common.v1.WorkflowExecution
+	(v1.WorkflowExecutionStatus)(0),           // 35: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v15.StartWorkflowExecutionRequest)(nil), // 36: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	(*durationpb.Duration)(nil),               // 37: google.protobuf.Duration
+	(v14.ScheduleDependencyPolicy)(0),         // 38: temporal.server.api.enums.v1.ScheduleDependencyPolicy
+	(v14.SchedulerInvokerState)(0),            // 39: temporal.server.api.enums.v1.SchedulerInvokerState
+	(*v11.TriggerImmediatelyRequest)(nil),     // 40: temporal.api.schedule.v1.TriggerImmediatelyRequest
 }
 var file_temporal_server_api_schedule_v1_message_proto_depIdxs = []int32{
-	16, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
-	16, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
-	16, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
-	17, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
-	16, // 4: temporal.server.api.schedule.v1.BufferedStart.backoff_time:type_name -> google.protobuf.Timestamp
-	16, // 5: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
+	23, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
+	23, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
+	23, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
+	24, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
+	23, // 4: temporal.server.api.schedule.v1.BufferedStart.backoff_time:type_name -> google.protobuf.Timestamp
+	23, // 5: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
 	0,  // 6: temporal.server.api.schedule.v1.InternalState.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	18, // 7: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
-	19, // 8: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
-	20, // 9: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
-	21, // 10: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 11: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 12: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	1,  // 13: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
-	21, // 14: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	24, // 15: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
-	21, // 16: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 17: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	25, // 18: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	26, // 19: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
-	19, // 20: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
-	20, // 21: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
-	16, // 22: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
-	27, // 23: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	16, // 24: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
-	25, // 25: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	25, // 26: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	16, // 27: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
-	21, // 28: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 29: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 30: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	16, // 31: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	16, // 32: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	28, // 33: temporal.server.api.schedule.v1.InvokerInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerInvokerState
-	0,  // 34: temporal.server.api.schedule.v1.InvokerInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	25, // 35: temporal.server.api.schedule.v1.InvokerInternal.cancel_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
-	25, // 36: temporal.server.api.schedule.v1.InvokerInternal.terminate_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
-	16, // 37: temporal.server.api.schedule.v1.InvokerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	18, // 38: temporal.server.api.schedule.v1.BackfillerInternal.backfill_request:type_name -> temporal.api.schedule.v1.BackfillRequest
-	29, // 39: temporal.server.api.schedule.v1.BackfillerInternal.trigger_request:type_name -> temporal.api.schedule.v1.TriggerImmediatelyRequest
-	16, // 40: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	16, // 41: temporal.server.api.schedule.v1.BackfillerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	42, // [42:42] is the sub-list for method output_type
-	42, // [42:42] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	25, // 7: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
+	26, // 8: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
+	27, // 9: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
+	2,  // 10: temporal.server.api.schedule.v1.InternalState.pending_skipped_actions:type_name -> temporal.server.api.schedule.v1.SkippedAction
+	23, // 11: temporal.server.api.schedule.v1.SkippedAction.nominal_time:type_name -> google.protobuf.Timestamp
+	23, // 12: temporal.server.api.schedule.v1.SkippedAction.actual_time:type_name -> google.protobuf.Timestamp
+	28, // 13: temporal.server.api.schedule.v1.SkippedAction.reason:type_name -> temporal.server.api.enums.v1.ScheduleActionSkipReason
+	2,  // 14: temporal.server.api.schedule.v1.SkippedActions.skipped_actions:type_name -> temporal.server.api.schedule.v1.SkippedAction
+	29, // 15: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	30, // 16: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	31, // 17: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	1,  // 18: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
+	7,  // 19: temporal.server.api.schedule.v1.StartScheduleArgs.calendar_sets:type_name -> temporal.server.api.schedule.v1.CalendarSetReference
+	19, // 20: temporal.server.api.schedule.v1.StartScheduleArgs.dependencies:type_name -> temporal.server.api.schedule.v1.ScheduleDependency
+	29, // 21: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	32, // 22: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	7,  // 23: temporal.server.api.schedule.v1.FullUpdateRequest.calendar_sets:type_name -> temporal.server.api.schedule.v1.CalendarSetReference
+	19, // 24: temporal.server.api.schedule.v1.FullUpdateRequest.dependencies:type_name -> temporal.server.api.schedule.v1.ScheduleDependency
+	29, // 25: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	30, // 26: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	7,  // 27: temporal.server.api.schedule.v1.DescribeResponse.calendar_sets:type_name -> temporal.server.api.schedule.v1.CalendarSetReference
+	19, // 28: temporal.server.api.schedule.v1.DescribeResponse.dependencies:type_name -> temporal.server.api.schedule.v1.ScheduleDependency
+	2,  // 29: temporal.server.api.schedule.v1.RecordSkippedActionsRequest.skipped_actions:type_name -> temporal.server.api.schedule.v1.SkippedAction
+	19, // 30: temporal.server.api.schedule.v1.CheckDependencyRequest.dependency:type_name -> temporal.server.api.schedule.v1.ScheduleDependency
+	23, // 31: temporal.server.api.schedule.v1.CheckDependencyRequest.nominal_time:type_name -> google.protobuf.Timestamp
+	33, // 32: temporal.server.api.schedule.v1.CheckDependencyResponse.status:type_name -> temporal.server.api.enums.v1.ScheduleDependencyStatus
+	34, // 33: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	35, // 34: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	26, // 35: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
+	27, // 36: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
+	23, // 37: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
+	36, // 38: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	23, // 39: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
+	34, // 40: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	34, // 41: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	23, // 42: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
+	29, // 43: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	30, // 44: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	31, // 45: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	19, // 46: temporal.server.api.schedule.v1.SchedulerInternal.dependencies:type_name -> temporal.server.api.schedule.v1.ScheduleDependency
+	7,  // 47: temporal.server.api.schedule.v1.SchedulerInternal.calendar_sets:type_name -> temporal.server.api.schedule.v1.CalendarSetReference
+	37, // 48: temporal.server.api.schedule.v1.ScheduleDependency.timeout:type_name -> google.protobuf.Duration
+	38, // 49: temporal.server.api.schedule.v1.ScheduleDependency.policy:type_name -> temporal.server.api.enums.v1.ScheduleDependencyPolicy
+	37, // 50: temporal.server.api.schedule.v1.ScheduleDependency.window:type_name -> google.protobuf.Duration
+	23, // 51: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	23, // 52: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	39, // 53: temporal.server.api.schedule.v1.InvokerInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerInvokerState
+	0,  // 54: temporal.server.api.schedule.v1.InvokerInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
+	34, // 55: temporal.server.api.schedule.v1.InvokerInternal.cancel_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
+	34, // 56: temporal.server.api.schedule.v1.InvokerInternal.terminate_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
+	23, // 57: temporal.server.api.schedule.v1.InvokerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	2,  // 58: temporal.server.api.schedule.v1.InvokerInternal.pending_skipped_actions:type_name -> temporal.server.api.schedule.v1.SkippedAction
+	25, // 59: temporal.server.api.schedule.v1.BackfillerInternal.backfill_request:type_name -> temporal.api.schedule.v1.BackfillRequest
+	40, // 60: temporal.server.api.schedule.v1.BackfillerInternal.trigger_request:type_name -> temporal.api.schedule.v1.TriggerImmediatelyRequest
+	23, // 61: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	23, // 62: temporal.server.api.schedule.v1.BackfillerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	63, // [63:63] is the sub-list for method output_type
+	63, // [63:63] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_schedule_v1_message_proto_init() }
@@ -1504,11 +2073,11 @@ func file_temporal_server_api_schedule_v1_message_proto_init() {
 	if File_temporal_server_api_schedule_v1_message_proto != nil {
 		return
 	}
-	file_temporal_server_api_schedule_v1_message_proto_msgTypes[6].OneofWrappers = []any{
+	file_temporal_server_api_schedule_v1_message_proto_msgTypes[12].OneofWrappers = []any{
 		(*WatchWorkflowResponse_Result)(nil),
 		(*WatchWorkflowResponse_Failure)(nil),
 	}
-	file_temporal_server_api_schedule_v1_message_proto_msgTypes[15].OneofWrappers = []any{
+	file_temporal_server_api_schedule_v1_message_proto_msgTypes[22].OneofWrappers = []any{
 		(*BackfillerInternal_BackfillRequest)(nil),
 		(*BackfillerInternal_TriggerRequest)(nil),
 	}
@@ -1518,7 +2087,7 @@ func file_temporal_server_api_schedule_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_schedule_v1_message_proto_rawDesc), len(file_temporal_server_api_schedule_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -300,3 +300,77 @@ func (this *TaskForwardInfo) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type FairnessKeyStats to the protobuf v3 wire format
+func (val *FairnessKeyStats) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FairnessKeyStats from the protobuf v3 wire format
+func (val *FairnessKeyStats) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FairnessKeyStats) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FairnessKeyStats values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FairnessKeyStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FairnessKeyStats
+	switch t := that.(type) {
+	case *FairnessKeyStats:
+		that1 = t
+	case FairnessKeyStats:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type PollerDispatchStats to the protobuf v3 wire format
+func (val *PollerDispatchStats) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type PollerDispatchStats from the protobuf v3 wire format
+func (val *PollerDispatchStats) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *PollerDispatchStats) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two PollerDispatchStats values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *PollerDispatchStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *PollerDispatchStats
+	switch t := that.(type) {
+	case *PollerDispatchStats:
+		that1 = t
+	case PollerDispatchStats:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -263,3 +263,77 @@ func (this *NexusOperationCompletion) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ListWorkersNextPageToken to the protobuf v3 wire format
+func (val *ListWorkersNextPageToken) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListWorkersNextPageToken from the protobuf v3 wire format
+func (val *ListWorkersNextPageToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListWorkersNextPageToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListWorkersNextPageToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListWorkersNextPageToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListWorkersNextPageToken
+	switch t := that.(type) {
+	case *ListWorkersNextPageToken:
+		that1 = t
+	case ListWorkersNextPageToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleActionsNextPageToken to the protobuf v3 wire format
+func (val *ListScheduleActionsNextPageToken) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleActionsNextPageToken from the protobuf v3 wire format
+func (val *ListScheduleActionsNextPageToken) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleActionsNextPageToken) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleActionsNextPageToken values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleActionsNextPageToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleActionsNextPageToken
+	switch t := that.(type) {
+	case *ListScheduleActionsNextPageToken:
+		that1 = t
+	case ListScheduleActionsNextPageToken:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
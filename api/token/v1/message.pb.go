@@ -633,6 +633,133 @@ func (x *NexusOperationCompletion) GetRequestId() string {
 	return ""
 }
 
+// ListWorkersNextPageToken is the page token of ListWorkers. Workers are listed in the order of the
+// ORDER BY clause of the query, then in order of their instance key.
+type ListWorkersNextPageToken struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	LastWorkerInstanceKey string                 `protobuf:"bytes,1,opt,name=last_worker_instance_key,json=lastWorkerInstanceKey,proto3" json:"last_worker_instance_key,omitempty"`
+	// Encoded values of the ORDER BY columns of the last worker.
+	LastSortValues []string `protobuf:"bytes,2,rep,name=last_sort_values,json=lastSortValues,proto3" json:"last_sort_values,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWorkersNextPageToken) Reset() {
+	*x = ListWorkersNextPageToken{}
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkersNextPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkersNextPageToken) ProtoMessage() {}
+
+func (x *ListWorkersNextPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkersNextPageToken.ProtoReflect.Descriptor instead.
+func (*ListWorkersNextPageToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_token_v1_message_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListWorkersNextPageToken) GetLastWorkerInstanceKey() string {
+	if x != nil {
+		return x.LastWorkerInstanceKey
+	}
+	return ""
+}
+
+func (x *ListWorkersNextPageToken) GetLastSortValues() []string {
+	if x != nil {
+		return x.LastSortValues
+	}
+	return nil
+}
+
+// ListScheduleActionsNextPageToken is the page token of ListScheduleActions, which paginates the
+// started actions from visibility and the skipped actions from persistence independently.
+type ListScheduleActionsNextPageToken struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StartedActionsToken []byte                 `protobuf:"bytes,1,opt,name=started_actions_token,json=startedActionsToken,proto3" json:"started_actions_token,omitempty"`
+	SkippedActionsToken []byte                 `protobuf:"bytes,2,opt,name=skipped_actions_token,json=skippedActionsToken,proto3" json:"skipped_actions_token,omitempty"`
+	// Set once all the started actions were returned.
+	StartedActionsDone bool `protobuf:"varint,3,opt,name=started_actions_done,json=startedActionsDone,proto3" json:"started_actions_done,omitempty"`
+	// Set once all the skipped actions were returned.
+	SkippedActionsDone bool `protobuf:"varint,4,opt,name=skipped_actions_done,json=skippedActionsDone,proto3" json:"skipped_actions_done,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListScheduleActionsNextPageToken) Reset() {
+	*x = ListScheduleActionsNextPageToken{}
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduleActionsNextPageToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduleActionsNextPageToken) ProtoMessage() {}
+
+func (x *ListScheduleActionsNextPageToken) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_token_v1_message_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduleActionsNextPageToken.ProtoReflect.Descriptor instead.
+func (*ListScheduleActionsNextPageToken) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_token_v1_message_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListScheduleActionsNextPageToken) GetStartedActionsToken() []byte {
+	if x != nil {
+		return x.StartedActionsToken
+	}
+	return nil
+}
+
+func (x *ListScheduleActionsNextPageToken) GetSkippedActionsToken() []byte {
+	if x != nil {
+		return x.SkippedActionsToken
+	}
+	return nil
+}
+
+func (x *ListScheduleActionsNextPageToken) GetStartedActionsDone() bool {
+	if x != nil {
+		return x.StartedActionsDone
+	}
+	return false
+}
+
+func (x *ListScheduleActionsNextPageToken) GetSkippedActionsDone() bool {
+	if x != nil {
+		return x.SkippedActionsDone
+	}
+	return false
+}
+
 var File_temporal_server_api_token_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
@@ -699,7 +826,15 @@ const file_temporal_server_api_token_v1_message_proto_rawDesc = "" +
 	"\x06run_id\x18\x03 \x01(\tR\x05runId\x12E\n" +
 	"\x03ref\x18\x04 \x01(\v23.temporal.server.api.persistence.v1.StateMachineRefR\x03ref\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tR\trequestIdB*Z(go.temporal.io/server/api/token/v1;tokenb\x06proto3"
+	"request_id\x18\x05 \x01(\tR\trequestId\"}\n" +
+	"\x18ListWorkersNextPageToken\x127\n" +
+	"\x18last_worker_instance_key\x18\x01 \x01(\tR\x15lastWorkerInstanceKey\x12(\n" +
+	"\x10last_sort_values\x18\x02 \x03(\tR\x0elastSortValues\"\xee\x01\n" +
+	" ListScheduleActionsNextPageToken\x122\n" +
+	"\x15started_actions_token\x18\x01 \x01(\fR\x13startedActionsToken\x122\n" +
+	"\x15skipped_actions_token\x18\x02 \x01(\fR\x13skippedActionsToken\x120\n" +
+	"\x14started_actions_done\x18\x03 \x01(\bR\x12startedActionsDone\x120\n" +
+	"\x14skipped_actions_done\x18\x04 \x01(\bR\x12skippedActionsDoneB*Z(go.temporal.io/server/api/token/v1;tokenb\x06proto3"
 
 var (
 	file_temporal_server_api_token_v1_message_proto_rawDescOnce sync.Once
@@ -713,31 +848,33 @@ func file_temporal_server_api_token_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_token_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_token_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_temporal_server_api_token_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_temporal_server_api_token_v1_message_proto_goTypes = []any{
-	(*HistoryContinuation)(nil),          // 0: temporal.server.api.token.v1.HistoryContinuation
-	(*RawHistoryContinuation)(nil),       // 1: temporal.server.api.token.v1.RawHistoryContinuation
-	(*Task)(nil),                         // 2: temporal.server.api.token.v1.Task
-	(*QueryTask)(nil),                    // 3: temporal.server.api.token.v1.QueryTask
-	(*NexusTask)(nil),                    // 4: temporal.server.api.token.v1.NexusTask
-	(*HistoryEventRef)(nil),              // 5: temporal.server.api.token.v1.HistoryEventRef
-	(*NexusOperationCompletion)(nil),     // 6: temporal.server.api.token.v1.NexusOperationCompletion
-	(*v1.TransientWorkflowTaskInfo)(nil), // 7: temporal.server.api.history.v1.TransientWorkflowTaskInfo
-	(*v1.VersionHistoryItem)(nil),        // 8: temporal.server.api.history.v1.VersionHistoryItem
-	(*v11.VersionedTransition)(nil),      // 9: temporal.server.api.persistence.v1.VersionedTransition
-	(*v1.VersionHistories)(nil),          // 10: temporal.server.api.history.v1.VersionHistories
-	(*v12.VectorClock)(nil),              // 11: temporal.server.api.clock.v1.VectorClock
-	(*timestamppb.Timestamp)(nil),        // 12: google.protobuf.Timestamp
-	(*v11.StateMachineRef)(nil),          // 13: temporal.server.api.persistence.v1.StateMachineRef
+	(*HistoryContinuation)(nil),              // 0: temporal.server.api.token.v1.HistoryContinuation
+	(*RawHistoryContinuation)(nil),           // 1: temporal.server.api.token.v1.RawHistoryContinuation
+	(*Task)(nil),                             // 2: temporal.server.api.token.v1.Task
+	(*QueryTask)(nil),                        // 3: temporal.server.api.token.v1.QueryTask
+	(*NexusTask)(nil),                        // 4: temporal.server.api.token.v1.NexusTask
+	(*HistoryEventRef)(nil),                  // 5: temporal.server.api.token.v1.HistoryEventRef
+	(*NexusOperationCompletion)(nil),         // 6: temporal.server.api.token.v1.NexusOperationCompletion
+	(*ListWorkersNextPageToken)(nil),         // 7: temporal.server.api.token.v1.ListWorkersNextPageToken
+	(*ListScheduleActionsNextPageToken)(nil), // 8: temporal.server.api.token.v1.ListScheduleActionsNextPageToken
+	(*v1.TransientWorkflowTaskInfo)(nil),     // 9: temporal.server.api.history.v1.TransientWorkflowTaskInfo
+	(*v1.VersionHistoryItem)(nil),            // 10: temporal.server.api.history.v1.VersionHistoryItem
+	(*v11.VersionedTransition)(nil),          // 11: temporal.server.api.persistence.v1.VersionedTransition
+	(*v1.VersionHistories)(nil),              // 12: temporal.server.api.history.v1.VersionHistories
+	(*v12.VectorClock)(nil),                  // 13: temporal.server.api.clock.v1.VectorClock
+	(*timestamppb.Timestamp)(nil),            // 14: google.protobuf.Timestamp
+	(*v11.StateMachineRef)(nil),              // 15: temporal.server.api.persistence.v1.StateMachineRef
 }
 var file_temporal_server_api_token_v1_message_proto_depIdxs = []int32{
-	7,  // 0: temporal.server.api.token.v1.HistoryContinuation.transient_workflow_task:type_name -> temporal.server.api.history.v1.TransientWorkflowTaskInfo
-	8,  // 1: temporal.server.api.token.v1.HistoryContinuation.version_history_item:type_name -> temporal.server.api.history.v1.VersionHistoryItem
-	9,  // 2: temporal.server.api.token.v1.HistoryContinuation.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
-	10, // 3: temporal.server.api.token.v1.RawHistoryContinuation.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
-	11, // 4: temporal.server.api.token.v1.Task.clock:type_name -> temporal.server.api.clock.v1.VectorClock
-	12, // 5: temporal.server.api.token.v1.Task.started_time:type_name -> google.protobuf.Timestamp
-	13, // 6: temporal.server.api.token.v1.NexusOperationCompletion.ref:type_name -> temporal.server.api.persistence.v1.StateMachineRef
+	9,  // 0: temporal.server.api.token.v1.HistoryContinuation.transient_workflow_task:type_name -> temporal.server.api.history.v1.TransientWorkflowTaskInfo
+	10, // 1: temporal.server.api.token.v1.HistoryContinuation.version_history_item:type_name -> temporal.server.api.history.v1.VersionHistoryItem
+	11, // 2: temporal.server.api.token.v1.HistoryContinuation.versioned_transition:type_name -> temporal.server.api.persistence.v1.VersionedTransition
+	12, // 3: temporal.server.api.token.v1.RawHistoryContinuation.version_histories:type_name -> temporal.server.api.history.v1.VersionHistories
+	13, // 4: temporal.server.api.token.v1.Task.clock:type_name -> temporal.server.api.clock.v1.VectorClock
+	14, // 5: temporal.server.api.token.v1.Task.started_time:type_name -> google.protobuf.Timestamp
+	15, // 6: temporal.server.api.token.v1.NexusOperationCompletion.ref:type_name -> temporal.server.api.persistence.v1.StateMachineRef
 	7,  // [7:7] is the sub-list for method output_type
 	7,  // [7:7] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
@@ -756,7 +893,7 @@ func file_temporal_server_api_token_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_token_v1_message_proto_rawDesc), len(file_temporal_server_api_token_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
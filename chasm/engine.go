@@ -41,6 +41,17 @@ type Engine interface {
 		func(MutableContext, Component, any) error,
 		...TransitionOption,
 	) ([]byte, error)
+
+	ListExecutions(
+		context.Context,
+		ComponentRef,
+		*ListExecutionsRequest,
+	) (*ListExecutionsResponse, error)
+	CountExecutions(
+		context.Context,
+		ComponentRef,
+		*CountExecutionsRequest,
+	) (*CountExecutionsResponse, error)
 }
 
 type BusinessIDReusePolicy int
@@ -13,6 +13,8 @@ type (
 		ephemeral     bool
 		singleCluster bool
 		shardingFn    func(EntityKey) string
+
+		searchAttributeAliases map[string]string
 	}
 
 	RegistrableComponentOption func(*RegistrableComponent)
@@ -56,6 +58,17 @@ func WithShardingFn(
 	}
 }
 
+// WithSearchAttributeAliases defines archetype specific names for system search attributes, e.g. "ScheduleId" for
+// "WorkflowId". The aliases can be used in the queries of ListExecutions and CountExecutions for the archetype, and take
+// precedence over custom search attributes with the same name.
+func WithSearchAttributeAliases(
+	aliases map[string]string,
+) RegistrableComponentOption {
+	return func(rc *RegistrableComponent) {
+		rc.searchAttributeAliases = aliases
+	}
+}
+
 // fqType returns the fully qualified name of the component, which is a combination of
 // the library name and the component type. This is used to uniquely identify
 // the component in the registry.
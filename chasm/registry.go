@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+
+	"go.temporal.io/server/common/searchattribute"
 )
 
 var (
@@ -101,6 +103,17 @@ func (r *Registry) registerComponent(
 	if _, ok := r.componentByGoType[rc.goType]; ok {
 		return fmt.Errorf("component type %s is already registered", rc.goType.String())
 	}
+	for alias, name := range rc.searchAttributeAliases {
+		if err := r.validateName(alias); err != nil {
+			return err
+		}
+		if searchattribute.IsReserved(alias) {
+			return fmt.Errorf("search attribute alias %s of component %s is a reserved name", alias, fqn)
+		}
+		if searchattribute.IsMappable(name) {
+			return fmt.Errorf("search attribute alias %s of component %s must stand for a system search attribute, got %s", alias, fqn, name)
+		}
+	}
 
 	rc.library = lib
 	r.componentByType[fqn] = rc
@@ -203,6 +203,47 @@ func TestRegistry_RegisterComponents_Error(t *testing.T) {
 		require.Contains(t, err.Error(), "must be struct or pointer to struct")
 	})
 
+	t.Run("search attribute alias must follow rules", func(t *testing.T) {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSearchAttributeAliases(map[string]string{"bad.alias": "WorkflowId"}),
+			),
+		})
+		r := chasm.NewRegistry()
+
+		err := r.Register(lib)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "name must follow golang identifier rules")
+	})
+
+	t.Run("search attribute alias must not be reserved", func(t *testing.T) {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSearchAttributeAliases(map[string]string{"RunId": "WorkflowId"}),
+			),
+		})
+		r := chasm.NewRegistry()
+
+		err := r.Register(lib)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is a reserved name")
+	})
+
+	t.Run("search attribute alias must stand for a system search attribute", func(t *testing.T) {
+		lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+			chasm.NewRegistrableComponent[*chasm.MockComponent](
+				"Component1",
+				chasm.WithSearchAttributeAliases(map[string]string{"ScheduleId": "CustomKeywordField"}),
+			),
+		})
+		r := chasm.NewRegistry()
+
+		err := r.Register(lib)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "must stand for a system search attribute")
+	})
 }
 
 func TestRegistry_RegisterTasks_Error(t *testing.T) {
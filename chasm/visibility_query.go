@@ -0,0 +1,139 @@
+package chasm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	ListExecutionsRequest struct {
+		PageSize      int
+		NextPageToken []byte
+		// Query is a visibility query in the same format as the one of ListWorkflowExecutions. Besides the search
+		// attributes of the namespace, it can use the search attribute aliases of the archetype.
+		Query string
+	}
+
+	ListExecutionsResponse struct {
+		Executions    []*ExecutionInfo
+		NextPageToken []byte
+	}
+
+	CountExecutionsRequest struct {
+		// Query is a visibility query in the same format as the one of CountWorkflowExecutions. Besides the search
+		// attributes of the namespace, it can use the search attribute aliases of the archetype.
+		Query string
+	}
+
+	CountExecutionsResponse struct {
+		Count  int64
+		Groups []*CountExecutionsGroup
+	}
+
+	CountExecutionsGroup struct {
+		Values []*commonpb.Payload
+		Count  int64
+	}
+
+	// ExecutionInfo is the visibility record of an execution of an archetype.
+	ExecutionInfo struct {
+		BusinessID           string
+		EntityID             string
+		Status               enumspb.WorkflowExecutionStatus
+		StartTime            time.Time
+		CloseTime            time.Time
+		StateTransitionCount int64
+		SearchAttributes     map[string]*commonpb.Payload
+		Memo                 map[string]*commonpb.Payload
+	}
+)
+
+// ListExecutions lists the executions of the archetype of root component C in a namespace. Only executions of that
+// archetype are returned, regardless of the query.
+func ListExecutions[C Component](
+	ctx context.Context,
+	namespaceID string,
+	request *ListExecutionsRequest,
+) (*ListExecutionsResponse, error) {
+	return engineFromContext(ctx).ListExecutions(
+		ctx,
+		NewComponentRef[C](EntityKey{NamespaceID: namespaceID}),
+		request,
+	)
+}
+
+// CountExecutions counts the executions of the archetype of root component C in a namespace. Only executions of that
+// archetype are counted, regardless of the query.
+func CountExecutions[C Component](
+	ctx context.Context,
+	namespaceID string,
+	request *CountExecutionsRequest,
+) (*CountExecutionsResponse, error) {
+	return engineFromContext(ctx).CountExecutions(
+		ctx,
+		NewComponentRef[C](EntityKey{NamespaceID: namespaceID}),
+		request,
+	)
+}
+
+// ArchetypeQuery returns the visibility query that matches the executions of the archetype that also match query.
+// The search attribute aliases of the archetype in query are replaced by the search attributes they stand for.
+func (r *Registry) ArchetypeQuery(
+	archetype Archetype,
+	query string,
+) (string, error) {
+	rc, ok := r.component(archetype.String())
+	if !ok {
+		return "", serviceerror.NewInvalidArgumentf("unknown archetype: %s", archetype)
+	}
+
+	archetypeFilter := fmt.Sprintf("%s = '%s'", searchattribute.TemporalNamespaceDivision, archetype)
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return archetypeFilter, nil
+	}
+
+	lowerQuery := strings.ToLower(query)
+	if !strings.HasPrefix(lowerQuery, "order by ") && !strings.HasPrefix(lowerQuery, "group by ") {
+		query = "where " + query
+	}
+	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
+	stmt, err := sqlparser.Parse("select * from table1 " + query)
+	if err != nil {
+		return "", serviceerror.NewInvalidArgumentf("invalid query: %v", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Limit != nil || sel.Having != nil {
+		return "", serviceerror.NewInvalidArgumentf("invalid query: %s", query)
+	}
+
+	if len(rc.searchAttributeAliases) > 0 {
+		_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			if colName, ok := node.(*sqlparser.ColName); ok {
+				if name, ok := rc.searchAttributeAliases[colName.Name.String()]; ok {
+					colName.Name = sqlparser.NewColIdent(name)
+				}
+			}
+			return true, nil
+		}, sel)
+	}
+
+	var b strings.Builder
+	b.WriteString(archetypeFilter)
+	if sel.Where != nil {
+		b.WriteString(" AND (")
+		b.WriteString(sqlparser.String(sel.Where.Expr))
+		b.WriteString(")")
+	}
+	b.WriteString(sqlparser.String(sel.GroupBy))
+	b.WriteString(sqlparser.String(sel.OrderBy))
+	return b.String(), nil
+}
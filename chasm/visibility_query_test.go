@@ -0,0 +1,84 @@
+package chasm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/chasm"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRegistry_ArchetypeQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lib := chasm.NewMockLibrary(ctrl)
+	lib.EXPECT().Name().Return("TestLibrary").AnyTimes()
+	lib.EXPECT().Components().Return([]*chasm.RegistrableComponent{
+		chasm.NewRegistrableComponent[*chasm.MockComponent](
+			"Component1",
+			chasm.WithSearchAttributeAliases(map[string]string{"ScheduleId": "WorkflowId"}),
+		),
+	})
+	lib.EXPECT().Tasks().Return(nil)
+
+	r := chasm.NewRegistry()
+	require.NoError(t, r.Register(lib))
+	archetype := chasm.Archetype("TestLibrary.Component1")
+
+	testCases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name:     "empty query",
+			query:    "",
+			expected: "TemporalNamespaceDivision = 'TestLibrary.Component1'",
+		},
+		{
+			name:     "where",
+			query:    "ExecutionStatus = 'Running' or CustomKeywordField = 'foo'",
+			expected: "TemporalNamespaceDivision = 'TestLibrary.Component1' AND (ExecutionStatus = 'Running' or CustomKeywordField = 'foo')",
+		},
+		{
+			name:     "alias",
+			query:    "ScheduleId = 'sched' order by ScheduleId",
+			expected: "TemporalNamespaceDivision = 'TestLibrary.Component1' AND (WorkflowId = 'sched') order by WorkflowId asc",
+		},
+		{
+			name:     "order by",
+			query:    "order by StartTime desc",
+			expected: "TemporalNamespaceDivision = 'TestLibrary.Component1' order by StartTime desc",
+		},
+		{
+			name:     "group by",
+			query:    "GROUP BY ExecutionStatus",
+			expected: "TemporalNamespaceDivision = 'TestLibrary.Component1' group by ExecutionStatus",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := r.ArchetypeQuery(archetype, tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, query)
+		})
+	}
+
+	t.Run("unknown archetype", func(t *testing.T) {
+		_, err := r.ArchetypeQuery(chasm.Archetype("TestLibrary.Component2"), "")
+		var invalidArgErr *serviceerror.InvalidArgument
+		require.ErrorAs(t, err, &invalidArgErr)
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		for _, query := range []string{
+			"ExecutionStatus = ",
+			"ExecutionStatus = 'Running' limit 10",
+			"group by ExecutionStatus having count(*) > 1",
+		} {
+			_, err := r.ArchetypeQuery(archetype, query)
+			var invalidArgErr *serviceerror.InvalidArgument
+			require.ErrorAs(t, err, &invalidArgErr, query)
+		}
+	})
+}
@@ -39,6 +39,16 @@ func (c *clientImpl) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -49,6 +59,26 @@ func (c *clientImpl) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *clientImpl) CancelTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.CancelTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelTaskQueueBacklogMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelTaskQueueBacklogMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) CancelVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelVisibilityReindexResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CancelVisibilityReindex(ctx, request, opts...)
+}
+
 func (c *clientImpl) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -59,6 +89,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CountWorkers(
+	ctx context.Context,
+	request *adminservice.CountWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CountWorkersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CountWorkers(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -69,6 +109,26 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -119,6 +179,36 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeSearchAttributeMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeSearchAttributeMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueueBacklogMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskQueueFairnessKeys(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueFairnessKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueFairnessKeysResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskQueueFairnessKeys(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -129,6 +219,26 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityReindexResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeVisibilityReindex(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExplainWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExplainWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -199,6 +309,16 @@ func (c *clientImpl) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNexusEndpointResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetNexusEndpoint(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -269,6 +389,16 @@ func (c *clientImpl) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListCallbacks(
+	ctx context.Context,
+	request *adminservice.ListCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListCallbacksResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListCallbacks(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -299,6 +429,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNexusEndpointsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListNexusEndpoints(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -309,6 +449,36 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListScheduleActions(
+	ctx context.Context,
+	request *adminservice.ListScheduleActionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListScheduleActionsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListScheduleActions(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListScheduleCalendarSets(
+	ctx context.Context,
+	request *adminservice.ListScheduleCalendarSetsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListScheduleCalendarSetsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListScheduleCalendarSets(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -329,6 +499,16 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PreviewSchedule(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PreviewScheduleResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PreviewSchedule(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -349,6 +529,16 @@ func (c *clientImpl) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PutSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.PutSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PutSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.PutSavedVisibilityQuery(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -409,6 +599,16 @@ func (c *clientImpl) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *clientImpl) RenameSearchAttribute(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameSearchAttributeResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RenameSearchAttribute(ctx, request, opts...)
+}
+
 func (c *clientImpl) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -419,6 +619,76 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) RetryCallback(
+	ctx context.Context,
+	request *adminservice.RetryCallbackRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RetryCallbackResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RetryCallback(ctx, request, opts...)
+}
+
+func (c *clientImpl) RunSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.RunSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RunSavedVisibilityQueryResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RunSavedVisibilityQuery(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointAccessPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetNexusEndpointAccessPolicyResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetNexusEndpointAccessPolicy(ctx, request, opts...)
+}
+
+func (c *clientImpl) SetNexusEndpointCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetNexusEndpointCircuitBreakerResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetNexusEndpointCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.StartSearchAttributeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartSearchAttributeMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartSearchAttributeMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.StartTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartTaskQueueBacklogMigrationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartTaskQueueBacklogMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.StartVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityReindexResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StartVisibilityReindex(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -428,3 +698,43 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateScheduleCalendarSet(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleCalendarSetRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleCalendarSetResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateScheduleCalendarSet(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskQueueBlockedPollers(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueBlockedPollersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueBlockedPollersResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskQueueBlockedPollers(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskQueueFairnessWeights(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueFairnessWeightsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueFairnessWeightsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskQueueFairnessWeights(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueuePauseRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueuePauseResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskQueuePause(ctx, request, opts...)
+}
@@ -51,6 +51,20 @@ func (c *metricClient) AddTasks(
 	return c.client.AddTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientAggregateWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -65,6 +79,34 @@ func (c *metricClient) CancelDLQJob(
 	return c.client.CancelDLQJob(ctx, request, opts...)
 }
 
+func (c *metricClient) CancelTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.CancelTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelTaskQueueBacklogMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCancelTaskQueueBacklogMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelTaskQueueBacklogMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) CancelVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CancelVisibilityReindexResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCancelVisibilityReindex")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CancelVisibilityReindex(ctx, request, opts...)
+}
+
 func (c *metricClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -79,6 +121,20 @@ func (c *metricClient) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *metricClient) CountWorkers(
+	ctx context.Context,
+	request *adminservice.CountWorkersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.CountWorkersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientCountWorkers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CountWorkers(ctx, request, opts...)
+}
+
 func (c *metricClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -93,6 +149,34 @@ func (c *metricClient) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *metricClient) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteSavedVisibilityQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteSavedVisibilityQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+}
+
+func (c *metricClient) DeleteTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DeleteTaskQueueTasksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDeleteTaskQueueTasks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DeleteTaskQueueTasks(ctx, request, opts...)
+}
+
 func (c *metricClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -163,6 +247,48 @@ func (c *metricClient) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeSearchAttributeMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeSearchAttributeMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeSearchAttributeMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeTaskQueueBacklogMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeTaskQueueBacklogMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskQueueBacklogMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) DescribeTaskQueueFairnessKeys(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueFairnessKeysRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeTaskQueueFairnessKeysResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeTaskQueueFairnessKeys")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeTaskQueueFairnessKeys(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -177,6 +303,34 @@ func (c *metricClient) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeVisibilityReindexResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientDescribeVisibilityReindex")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVisibilityReindex(ctx, request, opts...)
+}
+
+func (c *metricClient) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExplainWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ExplainWorkflowExecutionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientExplainWorkflowExecutions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ExplainWorkflowExecutions(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -275,6 +429,20 @@ func (c *metricClient) GetNamespaceReplicationMessages(
 	return c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) GetNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.GetNexusEndpointResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientGetNexusEndpoint")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetNexusEndpoint(ctx, request, opts...)
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -373,6 +541,20 @@ func (c *metricClient) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *metricClient) ListCallbacks(
+	ctx context.Context,
+	request *adminservice.ListCallbacksRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListCallbacksResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListCallbacks")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListCallbacks(ctx, request, opts...)
+}
+
 func (c *metricClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -415,6 +597,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListNexusEndpointsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListNexusEndpoints")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListNexusEndpoints(ctx, request, opts...)
+}
+
 func (c *metricClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -429,6 +625,48 @@ func (c *metricClient) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *metricClient) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListSavedVisibilityQueriesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListSavedVisibilityQueries")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+}
+
+func (c *metricClient) ListScheduleActions(
+	ctx context.Context,
+	request *adminservice.ListScheduleActionsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListScheduleActionsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListScheduleActions")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListScheduleActions(ctx, request, opts...)
+}
+
+func (c *metricClient) ListScheduleCalendarSets(
+	ctx context.Context,
+	request *adminservice.ListScheduleCalendarSetsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListScheduleCalendarSetsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientListScheduleCalendarSets")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListScheduleCalendarSets(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -457,6 +695,20 @@ func (c *metricClient) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) PreviewSchedule(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PreviewScheduleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPreviewSchedule")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PreviewSchedule(ctx, request, opts...)
+}
+
 func (c *metricClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -485,6 +737,20 @@ func (c *metricClient) PurgeDLQTasks(
 	return c.client.PurgeDLQTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) PutSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.PutSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.PutSavedVisibilityQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientPutSavedVisibilityQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.PutSavedVisibilityQuery(ctx, request, opts...)
+}
+
 func (c *metricClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -569,6 +835,20 @@ func (c *metricClient) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *metricClient) RenameSearchAttribute(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RenameSearchAttributeResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRenameSearchAttribute")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RenameSearchAttribute(ctx, request, opts...)
+}
+
 func (c *metricClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -583,6 +863,104 @@ func (c *metricClient) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) RetryCallback(
+	ctx context.Context,
+	request *adminservice.RetryCallbackRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RetryCallbackResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRetryCallback")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RetryCallback(ctx, request, opts...)
+}
+
+func (c *metricClient) RunSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.RunSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.RunSavedVisibilityQueryResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientRunSavedVisibilityQuery")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RunSavedVisibilityQuery(ctx, request, opts...)
+}
+
+func (c *metricClient) SetNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointAccessPolicyRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetNexusEndpointAccessPolicyResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetNexusEndpointAccessPolicy")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetNexusEndpointAccessPolicy(ctx, request, opts...)
+}
+
+func (c *metricClient) SetNexusEndpointCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.SetNexusEndpointCircuitBreakerResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientSetNexusEndpointCircuitBreaker")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.SetNexusEndpointCircuitBreaker(ctx, request, opts...)
+}
+
+func (c *metricClient) StartSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.StartSearchAttributeMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartSearchAttributeMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartSearchAttributeMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartSearchAttributeMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) StartTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.StartTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartTaskQueueBacklogMigrationResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartTaskQueueBacklogMigration")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartTaskQueueBacklogMigration(ctx, request, opts...)
+}
+
+func (c *metricClient) StartVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.StartVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.StartVisibilityReindexResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientStartVisibilityReindex")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.StartVisibilityReindex(ctx, request, opts...)
+}
+
 func (c *metricClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -596,3 +974,59 @@ func (c *metricClient) SyncWorkflowState(
 
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *metricClient) UpdateScheduleCalendarSet(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleCalendarSetRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateScheduleCalendarSetResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateScheduleCalendarSet")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateScheduleCalendarSet(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateTaskQueueBlockedPollers(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueBlockedPollersRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateTaskQueueBlockedPollersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateTaskQueueBlockedPollers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueBlockedPollers(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateTaskQueueFairnessWeights(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueFairnessWeightsRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateTaskQueueFairnessWeightsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateTaskQueueFairnessWeights")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueueFairnessWeights(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueuePauseRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.UpdateTaskQueuePauseResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "AdminClientUpdateTaskQueuePause")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueuePause(ctx, request, opts...)
+}
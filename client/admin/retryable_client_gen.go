@@ -56,6 +56,21 @@ func (c *retryableClient) AddTasks(
 	return resp, err
 }
 
+func (c *retryableClient) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.AggregateWorkflowExecutionsResponse, error) {
+	var resp *adminservice.AggregateWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.AggregateWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelDLQJob(
 	ctx context.Context,
 	request *adminservice.CancelDLQJobRequest,
@@ -71,6 +86,36 @@ func (c *retryableClient) CancelDLQJob(
 	return resp, err
 }
 
+func (c *retryableClient) CancelTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.CancelTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelTaskQueueBacklogMigrationResponse, error) {
+	var resp *adminservice.CancelTaskQueueBacklogMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelTaskQueueBacklogMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) CancelVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CancelVisibilityReindexResponse, error) {
+	var resp *adminservice.CancelVisibilityReindexResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CancelVisibilityReindex(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CloseShard(
 	ctx context.Context,
 	request *adminservice.CloseShardRequest,
@@ -86,6 +131,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CountWorkers(
+	ctx context.Context,
+	request *adminservice.CountWorkersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CountWorkersResponse, error) {
+	var resp *adminservice.CountWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CountWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -101,6 +161,36 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.DeleteSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueTasksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+	var resp *adminservice.DeleteTaskQueueTasksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteTaskQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -176,6 +266,51 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeSearchAttributeMigrationResponse, error) {
+	var resp *adminservice.DescribeSearchAttributeMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeSearchAttributeMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueBacklogMigrationResponse, error) {
+	var resp *adminservice.DescribeTaskQueueBacklogMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueBacklogMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskQueueFairnessKeys(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueFairnessKeysRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskQueueFairnessKeysResponse, error) {
+	var resp *adminservice.DescribeTaskQueueFairnessKeysResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeTaskQueueFairnessKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -191,6 +326,36 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeVisibilityReindexResponse, error) {
+	var resp *adminservice.DescribeVisibilityReindexResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVisibilityReindex(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExplainWorkflowExecutionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainWorkflowExecutionsResponse, error) {
+	var resp *adminservice.ExplainWorkflowExecutionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainWorkflowExecutions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -296,6 +461,21 @@ func (c *retryableClient) GetNamespaceReplicationMessages(
 	return resp, err
 }
 
+func (c *retryableClient) GetNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetNexusEndpointResponse, error) {
+	var resp *adminservice.GetNexusEndpointResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetNexusEndpoint(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *adminservice.GetReplicationMessagesRequest,
@@ -401,6 +581,21 @@ func (c *retryableClient) ImportWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ListCallbacks(
+	ctx context.Context,
+	request *adminservice.ListCallbacksRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListCallbacksResponse, error) {
+	var resp *adminservice.ListCallbacksResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListCallbacks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -446,6 +641,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListNexusEndpointsResponse, error) {
+	var resp *adminservice.ListNexusEndpointsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListNexusEndpoints(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListQueues(
 	ctx context.Context,
 	request *adminservice.ListQueuesRequest,
@@ -461,6 +671,51 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListSavedVisibilityQueriesResponse, error) {
+	var resp *adminservice.ListSavedVisibilityQueriesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListSavedVisibilityQueries(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListScheduleActions(
+	ctx context.Context,
+	request *adminservice.ListScheduleActionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListScheduleActionsResponse, error) {
+	var resp *adminservice.ListScheduleActionsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListScheduleActions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListScheduleCalendarSets(
+	ctx context.Context,
+	request *adminservice.ListScheduleCalendarSetsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListScheduleCalendarSetsResponse, error) {
+	var resp *adminservice.ListScheduleCalendarSetsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListScheduleCalendarSets(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -491,6 +746,21 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PreviewSchedule(
+	ctx context.Context,
+	request *adminservice.PreviewScheduleRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PreviewScheduleResponse, error) {
+	var resp *adminservice.PreviewScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PreviewSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -521,6 +791,21 @@ func (c *retryableClient) PurgeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) PutSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.PutSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PutSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.PutSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.PutSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReapplyEvents(
 	ctx context.Context,
 	request *adminservice.ReapplyEventsRequest,
@@ -611,6 +896,21 @@ func (c *retryableClient) RemoveTask(
 	return resp, err
 }
 
+func (c *retryableClient) RenameSearchAttribute(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameSearchAttributeResponse, error) {
+	var resp *adminservice.RenameSearchAttributeResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RenameSearchAttribute(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ResendReplicationTasks(
 	ctx context.Context,
 	request *adminservice.ResendReplicationTasksRequest,
@@ -626,6 +926,111 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) RetryCallback(
+	ctx context.Context,
+	request *adminservice.RetryCallbackRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RetryCallbackResponse, error) {
+	var resp *adminservice.RetryCallbackResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RetryCallback(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RunSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.RunSavedVisibilityQueryRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RunSavedVisibilityQueryResponse, error) {
+	var resp *adminservice.RunSavedVisibilityQueryResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RunSavedVisibilityQuery(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointAccessPolicyRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetNexusEndpointAccessPolicyResponse, error) {
+	var resp *adminservice.SetNexusEndpointAccessPolicyResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetNexusEndpointAccessPolicy(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SetNexusEndpointCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointCircuitBreakerRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetNexusEndpointCircuitBreakerResponse, error) {
+	var resp *adminservice.SetNexusEndpointCircuitBreakerResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetNexusEndpointCircuitBreaker(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.StartSearchAttributeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartSearchAttributeMigrationResponse, error) {
+	var resp *adminservice.StartSearchAttributeMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartSearchAttributeMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.StartTaskQueueBacklogMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartTaskQueueBacklogMigrationResponse, error) {
+	var resp *adminservice.StartTaskQueueBacklogMigrationResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartTaskQueueBacklogMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.StartVisibilityReindexRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartVisibilityReindexResponse, error) {
+	var resp *adminservice.StartVisibilityReindexResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.StartVisibilityReindex(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -640,3 +1045,63 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateScheduleCalendarSet(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleCalendarSetRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleCalendarSetResponse, error) {
+	var resp *adminservice.UpdateScheduleCalendarSetResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateScheduleCalendarSet(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskQueueBlockedPollers(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueBlockedPollersRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueBlockedPollersResponse, error) {
+	var resp *adminservice.UpdateTaskQueueBlockedPollersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueBlockedPollers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskQueueFairnessWeights(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueFairnessWeightsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueueFairnessWeightsResponse, error) {
+	var resp *adminservice.UpdateTaskQueueFairnessWeightsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueueFairnessWeights(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueuePauseRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueuePauseResponse, error) {
+	var resp *adminservice.UpdateTaskQueuePauseResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueuePause(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -81,6 +81,10 @@ func (c *clientImpl) DeepHealthCheck(ctx context.Context, request *historyservic
 	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetOutboundDestinationHealth(ctx context.Context, request *historyservice.GetOutboundDestinationHealthRequest, opts ...grpc.CallOption) (*historyservice.GetOutboundDestinationHealthResponse, error) {
+	return c.connections.getOrCreateClientConn(rpcAddress(request.GetHostAddress())).historyClient.GetOutboundDestinationHealth(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeHistoryHost(
 	ctx context.Context,
 	request *historyservice.DescribeHistoryHostRequest,
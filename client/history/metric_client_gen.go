@@ -247,6 +247,20 @@ func (c *metricClient) GetMutableState(
 	return c.client.GetMutableState(ctx, request, opts...)
 }
 
+func (c *metricClient) GetOutboundDestinationHealth(
+	ctx context.Context,
+	request *historyservice.GetOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.GetOutboundDestinationHealthResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientGetOutboundDestinationHealth")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetOutboundDestinationHealth(ctx, request, opts...)
+}
+
 func (c *metricClient) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
@@ -266,6 +266,21 @@ func (c *retryableClient) GetMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) GetOutboundDestinationHealth(
+	ctx context.Context,
+	request *historyservice.GetOutboundDestinationHealthRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.GetOutboundDestinationHealthResponse, error) {
+	var resp *historyservice.GetOutboundDestinationHealthResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetOutboundDestinationHealth(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetReplicationMessages(
 	ctx context.Context,
 	request *historyservice.GetReplicationMessagesRequest,
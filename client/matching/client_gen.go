@@ -73,13 +73,33 @@ func (c *clientImpl) CheckTaskQueueUserDataPropagation(
 	return client.CheckTaskQueueUserDataPropagation(ctx, request, opts...)
 }
 
+func (c *clientImpl) CountWorkers(
+	ctx context.Context,
+	request *matchingservice.CountWorkersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.CountWorkersResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CountWorkers(ctx, request, opts...)
+}
+
 func (c *clientImpl) CreateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.CreateNexusEndpointRequest,
 	opts ...grpc.CallOption,
 ) (*matchingservice.CreateNexusEndpointResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", "not-applicable", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, tqid.NotApplicableName, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +119,7 @@ func (c *clientImpl) DeleteNexusEndpoint(
 	opts ...grpc.CallOption,
 ) (*matchingservice.DeleteNexusEndpointResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", "not-applicable", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, tqid.NotApplicableName, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +133,23 @@ func (c *clientImpl) DeleteNexusEndpoint(
 	return client.DeleteNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeFairnessKeys(
+	ctx context.Context,
+	request *matchingservice.DescribeFairnessKeysRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeFairnessKeysResponse, error) {
+
+	p := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeFairnessKeys(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -176,7 +213,7 @@ func (c *clientImpl) DescribeWorker(
 	opts ...grpc.CallOption,
 ) (*matchingservice.DescribeWorkerResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +307,7 @@ func (c *clientImpl) GetBuildIdTaskQueueMapping(
 	opts ...grpc.CallOption,
 ) (*matchingservice.GetBuildIdTaskQueueMappingResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName(fmt.Sprintf("not-applicable-%d", rand.Int()), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(fmt.Sprintf("%s-%d", tqid.NotApplicableName, rand.Int()), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -350,7 +387,7 @@ func (c *clientImpl) ListNexusEndpoints(
 	opts ...grpc.CallOption,
 ) (*matchingservice.ListNexusEndpointsResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", "not-applicable", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, tqid.NotApplicableName, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -390,7 +427,7 @@ func (c *clientImpl) ListWorkers(
 	opts ...grpc.CallOption,
 ) (*matchingservice.ListWorkersResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +467,7 @@ func (c *clientImpl) RecordWorkerHeartbeat(
 	opts ...grpc.CallOption,
 ) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -450,7 +487,7 @@ func (c *clientImpl) ReplicateTaskQueueUserData(
 	opts ...grpc.CallOption,
 ) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -544,13 +581,53 @@ func (c *clientImpl) SyncDeploymentUserData(
 	return client.SyncDeploymentUserData(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateBlockedPollers(
+	ctx context.Context,
+	request *matchingservice.UpdateBlockedPollersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateBlockedPollersResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateBlockedPollers(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateFairnessWeights(
+	ctx context.Context,
+	request *matchingservice.UpdateFairnessWeightsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateFairnessWeightsResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateFairnessWeights(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
 	opts ...grpc.CallOption,
 ) (*matchingservice.UpdateNexusEndpointResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", "not-applicable", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, tqid.NotApplicableName, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -584,13 +661,33 @@ func (c *clientImpl) UpdateTaskQueueConfig(
 	return client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePauseRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueuePauseResponse, error) {
+
+	p, err := tqid.NormalPartitionFromRpcName(request.GetTaskQueue(), request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.getClientForTaskQueuePartition(p)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskQueuePause(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
 	opts ...grpc.CallOption,
 ) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
 
-	p, err := tqid.NormalPartitionFromRpcName("not-applicable", request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, request.GetNamespaceId(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
 	if err != nil {
 		return nil, err
 	}
@@ -51,6 +51,20 @@ func (c *metricClient) CheckTaskQueueUserDataPropagation(
 	return c.client.CheckTaskQueueUserDataPropagation(ctx, request, opts...)
 }
 
+func (c *metricClient) CountWorkers(
+	ctx context.Context,
+	request *matchingservice.CountWorkersRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.CountWorkersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientCountWorkers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CountWorkers(ctx, request, opts...)
+}
+
 func (c *metricClient) CreateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.CreateNexusEndpointRequest,
@@ -79,6 +93,20 @@ func (c *metricClient) DeleteNexusEndpoint(
 	return c.client.DeleteNexusEndpoint(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeFairnessKeys(
+	ctx context.Context,
+	request *matchingservice.DescribeFairnessKeysRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.DescribeFairnessKeysResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientDescribeFairnessKeys")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeFairnessKeys(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -387,6 +415,34 @@ func (c *metricClient) SyncDeploymentUserData(
 	return c.client.SyncDeploymentUserData(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateBlockedPollers(
+	ctx context.Context,
+	request *matchingservice.UpdateBlockedPollersRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateBlockedPollersResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateBlockedPollers")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateBlockedPollers(ctx, request, opts...)
+}
+
+func (c *metricClient) UpdateFairnessWeights(
+	ctx context.Context,
+	request *matchingservice.UpdateFairnessWeightsRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateFairnessWeightsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateFairnessWeights")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateFairnessWeights(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
@@ -415,6 +471,20 @@ func (c *metricClient) UpdateTaskQueueConfig(
 	return c.client.UpdateTaskQueueConfig(ctx, request, opts...)
 }
 
+func (c *metricClient) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePauseRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.UpdateTaskQueuePauseResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "MatchingClientUpdateTaskQueuePause")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.UpdateTaskQueuePause(ctx, request, opts...)
+}
+
 func (c *metricClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
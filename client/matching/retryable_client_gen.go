@@ -86,6 +86,21 @@ func (c *retryableClient) CheckTaskQueueUserDataPropagation(
 	return resp, err
 }
 
+func (c *retryableClient) CountWorkers(
+	ctx context.Context,
+	request *matchingservice.CountWorkersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.CountWorkersResponse, error) {
+	var resp *matchingservice.CountWorkersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CountWorkers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CreateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.CreateNexusEndpointRequest,
@@ -116,6 +131,21 @@ func (c *retryableClient) DeleteNexusEndpoint(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeFairnessKeys(
+	ctx context.Context,
+	request *matchingservice.DescribeFairnessKeysRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	var resp *matchingservice.DescribeFairnessKeysResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeFairnessKeys(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -491,6 +521,36 @@ func (c *retryableClient) SyncDeploymentUserData(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateBlockedPollers(
+	ctx context.Context,
+	request *matchingservice.UpdateBlockedPollersRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateBlockedPollersResponse, error) {
+	var resp *matchingservice.UpdateBlockedPollersResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateBlockedPollers(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateFairnessWeights(
+	ctx context.Context,
+	request *matchingservice.UpdateFairnessWeightsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateFairnessWeightsResponse, error) {
+	var resp *matchingservice.UpdateFairnessWeightsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateFairnessWeights(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateNexusEndpoint(
 	ctx context.Context,
 	request *matchingservice.UpdateNexusEndpointRequest,
@@ -521,6 +581,21 @@ func (c *retryableClient) UpdateTaskQueueConfig(
 	return resp, err
 }
 
+func (c *retryableClient) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskQueuePauseRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateTaskQueuePauseResponse, error) {
+	var resp *matchingservice.UpdateTaskQueuePauseResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateTaskQueuePause(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.UpdateTaskQueueUserDataRequest,
@@ -285,16 +285,17 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 	switch t.Name() {
 	case "GetBuildIdTaskQueueMappingRequest":
 		// Pick a random node for this request, it's not associated with a specific task queue.
-		tq = fieldWithPath{path: "fmt.Sprintf(\"not-applicable-%d\", rand.Int())"}
+		tq = fieldWithPath{path: "fmt.Sprintf(\"%s-%d\", tqid.NotApplicableName, rand.Int())"}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
 		nsID = findOneNestedField(t, "NamespaceId", "request", 1)
 	case "UpdateTaskQueueUserDataRequest",
 		"ReplicateTaskQueueUserDataRequest",
 		"RecordWorkerHeartbeatRequest",
 		"ListWorkersRequest",
+		"CountWorkersRequest",
 		"DescribeWorkerRequest":
 		// Always route these requests to the same matching node by namespace.
-		tq = fieldWithPath{path: "\"not-applicable\""}
+		tq = fieldWithPath{path: "tqid.NotApplicableName"}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
 		nsID = findOneNestedField(t, "NamespaceId", "request", 1)
 	case "GetWorkerBuildIdCompatibilityRequest",
@@ -306,7 +307,10 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"ApplyTaskQueueUserDataReplicationEventRequest",
 		"GetWorkerVersioningRulesRequest",
 		"UpdateWorkerVersioningRulesRequest",
-		"UpdateTaskQueueConfigRequest":
+		"UpdateTaskQueueConfigRequest",
+		"UpdateFairnessWeightsRequest",
+		"UpdateTaskQueuePauseRequest",
+		"UpdateBlockedPollersRequest":
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_WORKFLOW"}
 		nsID = findOneNestedField(t, "NamespaceId", "request", 1)
@@ -322,9 +326,9 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		"ListNexusEndpointsRequest",
 		"DeleteNexusEndpointRequest":
 		// Always route these requests to the same matching node for all namespaces.
-		tq = fieldWithPath{path: `"not-applicable"`}
+		tq = fieldWithPath{path: "tqid.NotApplicableName"}
 		tqt = fieldWithPath{path: "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"}
-		nsID = fieldWithPath{path: `"not-applicable"`}
+		nsID = fieldWithPath{path: "tqid.NotApplicableName"}
 	default:
 		tqp = tryFindOneNestedField(t, "TaskQueuePartition", "request", 1)
 		tq = findOneNestedField(t, "TaskQueue", "request", 2)
@@ -91,6 +91,15 @@ var (
 		true,
 		`VisibilityEnableManualPagination is the config to enable manual pagination for Elasticsearch`,
 	)
+	VisibilityQueryCostLimit = NewNamespaceIntSetting(
+		"system.visibilityQueryCostLimit",
+		0,
+		`VisibilityQueryCostLimit is the maximum estimated cost of a visibility query for Elasticsearch. Queries above
+the limit are rejected with an error listing what makes them expensive, e.g. 'order by' on a field the index is not
+sorted by, 'starts_with' with a short prefix or a range spanning a long period of time. Queries of system and
+background callers are never rejected. SQL stores don't estimate query costs, so the limit has no effect on them.
+0 means no limit.`,
+	)
 	VisibilityAllowList = NewNamespaceBoolSetting(
 		"system.visibilityAllowList",
 		true,
@@ -433,6 +442,19 @@ number will fail with a FailedPrecondition error.`,
 		`RedirectRuleMaxUpstreamBuildIDsPerQueue is the max number of compatible redirect rules allowed to be connected
 in one chain in the versioning data for a task queue. Update requests which would cause the versioning data
 to exceed this number will fail with a FailedPrecondition error.`,
+	)
+	FairnessWeightOverrideLimitPerQueue = NewNamespaceIntSetting(
+		"limit.fairnessWeightOverrideLimitPerQueue",
+		1000,
+		`FairnessWeightOverrideLimitPerQueue is the max number of fairness key weight overrides allowed per task queue
+type. Update requests which would cause the task queue user data to exceed this number will fail with a
+FailedPrecondition error.`,
+	)
+	BlockedPollerLimitPerQueue = NewNamespaceIntSetting(
+		"limit.blockedPollerLimitPerQueue",
+		100,
+		`BlockedPollerLimitPerQueue is the max number of blocked poller identities allowed per task queue type. Update
+requests which would cause the task queue user data to exceed this number will fail with a FailedPrecondition error.`,
 	)
 	MatchingDeletedRuleRetentionTime = NewNamespaceDurationSetting(
 		"matching.wv.DeletedRuleRetentionTime",
@@ -531,6 +553,12 @@ is currently processing a task.
 		1000,
 		`NexusEndpointListMaxPageSize is the maximum page size for listing Nexus endpoints.`,
 	)
+	ScheduleMaxSkippedActionBatches = NewNamespaceIntSetting(
+		"limit.scheduleMaxSkippedActionBatches",
+		1000,
+		`ScheduleMaxSkippedActionBatches is the number of batches of skipped actions retained per schedule and listed
+by ListScheduleActions. Older batches are deleted as new ones are recorded. 0 retains all of them.`,
+	)
 
 	RemovableBuildIdDurationSinceDefault = NewGlobalDurationSetting(
 		"worker.removableBuildIdDurationSinceDefault",
@@ -1233,6 +1261,33 @@ duration since last poll exceeds this threshold.`,
 		500*time.Millisecond,
 		`MatchingMembershipUnloadDelay is how long to wait to re-confirm loss of ownership before unloading a task queue.
 Set to zero to disable proactive unload.`,
+	)
+	MatchingWorkerRegistryEntryTTL = NewGlobalDurationSetting(
+		"matching.workerRegistryEntryTTL",
+		24*time.Hour,
+		`MatchingWorkerRegistryEntryTTL is how long a worker stays in the worker heartbeat registry after its last
+heartbeat. Workers that stopped heartbeating are still returned by ListWorkers until then. The registry is kept in
+memory of the matching host that owns the namespace and persisted every MatchingWorkerRegistryPersistInterval, so it
+also bounds how long the heartbeats of a namespace are retained in persistence. Read at startup.`,
+	)
+	MatchingWorkerRegistryPersistInterval = NewGlobalDurationSetting(
+		"matching.workerRegistryPersistInterval",
+		time.Minute,
+		`MatchingWorkerRegistryPersistInterval is how often the worker heartbeat registry persists the heartbeats it
+received since its last write. Heartbeats received by a host that crashes within this interval are lost. Read at
+startup.`,
+	)
+	MatchingWorkerRegistryMaxEntries = NewGlobalIntSetting(
+		"matching.workerRegistryMaxEntries",
+		1_000_000,
+		`MatchingWorkerRegistryMaxEntries is the maximum number of worker heartbeats kept by the worker heartbeat
+registry of a matching host. Read at startup.`,
+	)
+	MatchingListWorkersMaxPageSize = NewNamespaceIntSetting(
+		"matching.listWorkersMaxPageSize",
+		1000,
+		`MatchingListWorkersMaxPageSize is the maximum number of workers returned by a single ListWorkers call. It is
+also the page size used when the request doesn't set one.`,
 	)
 	MatchingQueryWorkflowTaskTimeoutLogRate = NewTaskQueueFloatSetting(
 		"matching.queryWorkflowTaskTimeoutLogRate",
@@ -1944,6 +1999,24 @@ data (eg: search attributes) will be lost after workflow is closed.`,
 		0,
 		`VisibilityProcessorRelocateAttributesMinBlobSize is the minimum size in bytes of memo or search
 attributes.`,
+	)
+	VisibilityIndexCloseFailure = NewNamespaceBoolSetting(
+		"history.visibilityIndexCloseFailure",
+		false,
+		`VisibilityIndexCloseFailure indexes the failure message and type of failed, terminated and timed out
+workflows in the TemporalCloseFailureMessage and TemporalCloseFailureType search attributes`,
+	)
+	VisibilityIndexedMemoFields = NewNamespaceTypedSetting(
+		"history.visibilityIndexedMemoFields",
+		[]string(nil),
+		`VisibilityIndexedMemoFields is the list of memo fields whose values are indexed in the TemporalMemoText
+search attribute for full-text search`,
+	)
+	VisibilityFullTextMaxBytes = NewNamespaceIntSetting(
+		"history.visibilityFullTextMaxBytes",
+		2048,
+		`VisibilityFullTextMaxBytes is the max size in bytes of the text indexed in each of
+TemporalCloseFailureMessage and TemporalMemoText. Longer text is truncated at a word boundary.`,
 	)
 	VisibilityQueueMaxReaderCount = NewGlobalIntSetting(
 		"history.visibilityQueueMaxReaderCount",
@@ -2591,6 +2664,31 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		true,
 		`ExecutionScannerHistoryEventIdValidator is the flag to enable history event id validator`,
 	)
+	ExecutionScannerVisibilityValidator = NewGlobalBoolSetting(
+		"worker.executionEnableVisibilityValidator",
+		false,
+		`ExecutionScannerVisibilityValidator is the flag to enable the validator that compares visibility records with
+mutable state`,
+	)
+	ExecutionScannerVisibilitySampleRate = NewGlobalFloatSetting(
+		"worker.executionVisibilityValidatorSampleRate",
+		1.0,
+		`ExecutionScannerVisibilitySampleRate is the fraction of executions whose visibility record is validated by the
+executions scanner. 1 validates every execution.`,
+	)
+	ExecutionScannerVisibilityMinAge = NewGlobalDurationSetting(
+		"worker.executionVisibilityValidatorMinAge",
+		10*time.Minute,
+		`ExecutionScannerVisibilityMinAge is the time since the last update of an execution before the executions
+scanner validates its visibility record. Recently updated executions are skipped because the visibility queue may
+not have processed their latest update yet.`,
+	)
+	ExecutionScannerVisibilityRepair = NewGlobalBoolSetting(
+		"worker.executionEnableVisibilityRepair",
+		false,
+		`ExecutionScannerVisibilityRepair is the flag to regenerate the tasks, including the visibility task, of
+executions whose visibility record doesn't match their mutable state`,
+	)
 	TaskQueueScannerEnabled = NewGlobalBoolSetting(
 		"worker.taskQueueScannerEnabled",
 		true,
@@ -2736,6 +2834,11 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		10,
 		`Maximum number of workflow rules in a given namespace`,
 	)
+	MaxScheduleCalendarSetsPerNamespace = NewNamespaceIntSetting(
+		"frontend.maxScheduleCalendarSetsPerNamespace",
+		20,
+		`Maximum number of schedule calendar sets in a given namespace`,
+	)
 
 	SlowRequestLoggingThreshold = NewGlobalDurationSetting(
 		"rpc.slowRequestLoggingThreshold",
@@ -221,6 +221,8 @@ const (
 	PersistenceCompleteTaskScope = "CompleteTask"
 	// PersistenceCompleteTasksLessThanScope is the metric scope for persistence.TaskManager.PersistenceCompleteTasksLessThan API
 	PersistenceCompleteTasksLessThanScope = "CompleteTasksLessThan"
+	// PersistenceCompleteTasksScope is the metric scope for persistence.TaskManager.CompleteTasks API
+	PersistenceCompleteTasksScope = "CompleteTasks"
 	// PersistenceCreateTaskQueueScope tracks PersistenceCreateTaskQueueScope calls made by service to persistence layer
 	PersistenceCreateTaskQueueScope = "CreateTaskQueue"
 	// PersistenceUpdateTaskQueueScope tracks PersistenceUpdateTaskQueueScope calls made by service to persistence layer
@@ -282,10 +284,16 @@ const (
 	VisibilityPersistenceScanWorkflowExecutionsScope = "ScanWorkflowExecutions"
 	// VisibilityPersistenceCountWorkflowExecutionsScope tracks CountWorkflowExecutions calls made by service to visibility persistence layer
 	VisibilityPersistenceCountWorkflowExecutionsScope = "CountWorkflowExecutions"
+	// VisibilityPersistenceAggregateWorkflowExecutionsScope tracks AggregateWorkflowExecutions calls made by service to visibility persistence layer
+	VisibilityPersistenceAggregateWorkflowExecutionsScope = "AggregateWorkflowExecutions"
+	// VisibilityPersistenceExplainWorkflowExecutionsScope tracks ExplainWorkflowExecutions calls made by service to visibility persistence layer
+	VisibilityPersistenceExplainWorkflowExecutionsScope = "ExplainWorkflowExecutions"
 	// VisibilityPersistenceGetWorkflowExecutionScope tracks GetWorkflowExecution calls made by service to visibility persistence layer
 	VisibilityPersistenceGetWorkflowExecutionScope = "GetWorkflowExecution"
 	// VisibilityPersistenceAddSearchAttributesScope tracks AddSearchAttributes calls made by service to visibility persistence layer
 	VisibilityPersistenceAddSearchAttributesScope = "AddSearchAttributes"
+	// VisibilityPersistenceMigrateSearchAttributeValuesScope tracks MigrateSearchAttributeValues calls made by service to visibility persistence layer
+	VisibilityPersistenceMigrateSearchAttributeValuesScope = "MigrateSearchAttributeValues"
 )
 
 // Common
@@ -1107,6 +1115,7 @@ var (
 	ScavengerValidationRequestsCount                = NewCounterDef("scavenger_validation_requests")
 	ScavengerValidationFailuresCount                = NewCounterDef("scavenger_validation_failures")
 	ScavengerValidationSkipsCount                   = NewCounterDef("scavenger_validation_skips")
+	ScavengerVisibilityRepairsCount                 = NewCounterDef("scavenger_visibility_repairs")
 	AddSearchAttributesFailuresCount                = NewCounterDef("add_search_attributes_failures")
 
 	// Delete Namespace metrics.
@@ -1161,6 +1170,7 @@ var (
 	ElasticsearchDocumentParseFailuresCount           = NewCounterDef("elasticsearch_document_parse_failures_counter")
 	ElasticsearchDocumentGenerateFailuresCount        = NewCounterDef("elasticsearch_document_generate_failures_counter")
 	ElasticsearchCustomOrderByClauseCount             = NewCounterDef("elasticsearch_custom_order_by_clause_counter")
+	ElasticsearchQueryCostLimitExceededCount          = NewCounterDef("elasticsearch_query_cost_limit_exceeded_counter")
 	CatchUpReadyShardCountGauge                       = NewGaugeDef("catchup_ready_shard_count")
 	HandoverReadyShardCountGauge                      = NewGaugeDef("handover_ready_shard_count")
 	ReplicatorMessages                                = NewCounterDef("replicator_messages")
@@ -1210,6 +1220,10 @@ var (
 		"schedule_action_dropped",
 		WithDescription("The number of schedule actions that failed to start"),
 	)
+	ScheduleDependencySkipped = NewCounterDef(
+		"schedule_dependency_skipped",
+		WithDescription("The number of schedule actions skipped because an upstream schedule's action failed or timed out"),
+	)
 
 	// Worker Versioning
 	WorkerDeploymentCreated                           = NewCounterDef("worker_deployment_created")
@@ -292,6 +292,13 @@ func (ns *Namespace) GetWorkflowRule(ruleID string) (*rulespb.WorkflowRule, bool
 	return result, ok
 }
 
+// GetScheduleCalendarSet returns the named calendar set that schedules of this namespace can
+// reference.
+func (ns *Namespace) GetScheduleCalendarSet(name string) (*persistencespb.ScheduleCalendarSet, bool) {
+	result, ok := ns.config.GetScheduleCalendarSets()[name]
+	return result, ok
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
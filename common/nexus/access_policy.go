@@ -0,0 +1,26 @@
+package nexus
+
+import (
+	"slices"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// CallerNamespaceIDHeader is set by history on requests dispatched to worker target endpoints to identify the namespace
+// of the calling workflow. It is only used to enforce endpoint access policies, authorization of the request itself is
+// left to the frontend authorizer. The frontend only trusts it from callers with system claims and strips it from all
+// other requests.
+const CallerNamespaceIDHeader = "Temporal-Caller-Namespace-Id"
+
+// CallerNamespaceAllowed returns whether the access policy of the endpoint allows callers from the given namespace.
+func CallerNamespaceAllowed(entry *persistencespb.NexusEndpointEntry, namespaceID string) bool {
+	allowed := entry.GetEndpoint().GetAccessPolicy().GetAllowedCallerNamespaceIds()
+	return len(allowed) == 0 || slices.Contains(allowed, namespaceID)
+}
+
+// WorkflowTypeAllowed returns whether the access policy of the endpoint allows workflows of the given type to schedule
+// operations.
+func WorkflowTypeAllowed(entry *persistencespb.NexusEndpointEntry, workflowType string) bool {
+	allowed := entry.GetEndpoint().GetAccessPolicy().GetAllowedWorkflowTypes()
+	return len(allowed) == 0 || slices.Contains(allowed, workflowType)
+}
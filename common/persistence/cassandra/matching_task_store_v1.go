@@ -33,6 +33,13 @@ const (
 		`AND task_queue_type = ? ` +
 		`AND type = ? ` +
 		`AND task_id < ? `
+
+	templateDeleteTaskQuery = `DELETE FROM tasks ` +
+		`WHERE namespace_id = ? ` +
+		`AND task_queue_name = ? ` +
+		`AND task_queue_type = ? ` +
+		`AND type = ? ` +
+		`AND task_id = ? `
 )
 
 type matchingTaskStoreV1 struct {
@@ -206,6 +213,30 @@ func (d *matchingTaskStoreV1) CompleteTasksLessThan(
 	return p.UnknownNumRowsAffected, nil
 }
 
+// CompleteTasks deletes the given tasks regardless of the ack level
+func (d *matchingTaskStoreV1) CompleteTasks(
+	ctx context.Context,
+	request *p.CompleteTasksRequest,
+) error {
+	batch := d.Session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, task := range request.Tasks {
+		if task.TaskPass != 0 {
+			return serviceerror.NewInternal("invalid non-fair queue task with pass number")
+		}
+		batch.Query(templateDeleteTaskQuery,
+			request.NamespaceID,
+			request.TaskQueueName,
+			request.TaskType,
+			rowTypeTaskInSubqueue(request.Subqueue),
+			task.TaskId,
+		)
+	}
+	if err := d.Session.ExecuteBatch(batch); err != nil {
+		return gocql.ConvertError("CompleteTasks", err)
+	}
+	return nil
+}
+
 func (d *matchingTaskStoreV1) GetName() string {
 	return cassandraPersistenceName
 }
@@ -38,6 +38,14 @@ const (
 		`AND task_queue_type = ? ` +
 		`AND type = ? ` +
 		`and (pass, task_id) < (?, ?)`
+
+	templateDeleteTaskQuery_v2 = `DELETE FROM tasks_v2 ` +
+		`WHERE namespace_id = ? ` +
+		`AND task_queue_name = ? ` +
+		`AND task_queue_type = ? ` +
+		`AND type = ? ` +
+		`and pass = ? ` +
+		`and task_id = ?`
 )
 
 // matchingTaskStoreV2 is a fork of matchingTaskStoreV1 that uses a new task schema.
@@ -219,6 +227,31 @@ func (d *matchingTaskStoreV2) CompleteTasksLessThan(
 	return p.UnknownNumRowsAffected, nil
 }
 
+// CompleteTasks deletes the given tasks regardless of the ack level
+func (d *matchingTaskStoreV2) CompleteTasks(
+	ctx context.Context,
+	request *p.CompleteTasksRequest,
+) error {
+	batch := d.Session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, task := range request.Tasks {
+		if task.TaskPass < 1 {
+			return serviceerror.NewInternal("invalid fair queue task without pass number")
+		}
+		batch.Query(templateDeleteTaskQuery_v2,
+			request.NamespaceID,
+			request.TaskQueueName,
+			request.TaskType,
+			rowTypeTaskInSubqueue(request.Subqueue),
+			task.TaskPass,
+			task.TaskId,
+		)
+	}
+	if err := d.Session.ExecuteBatch(batch); err != nil {
+		return gocql.ConvertError("CompleteTasks", err)
+	}
+	return nil
+}
+
 func (d *matchingTaskStoreV2) GetName() string {
 	return cassandraPersistenceName
 }
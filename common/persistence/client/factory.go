@@ -44,6 +44,10 @@ type (
 		NewHistoryTaskQueueManager() (persistence.HistoryTaskQueueManager, error)
 		// NewNexusEndpointManager returns a new manager for nexus endpoints
 		NewNexusEndpointManager() (persistence.NexusEndpointManager, error)
+		// NewWorkerHeartbeatManager returns a new manager for worker heartbeats
+		NewWorkerHeartbeatManager() (persistence.WorkerHeartbeatManager, error)
+		// NewSkippedScheduleActionManager returns a new manager for the skipped actions of schedules
+		NewSkippedScheduleActionManager() (persistence.SkippedScheduleActionManager, error)
 	}
 
 	factoryImpl struct {
@@ -228,6 +232,22 @@ func (f *factoryImpl) NewHistoryTaskQueueManager() (persistence.HistoryTaskQueue
 	return persistence.NewHistoryTaskQueueManager(q, serialization.NewSerializer()), nil
 }
 
+func (f *factoryImpl) NewWorkerHeartbeatManager() (persistence.WorkerHeartbeatManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewWorkerHeartbeatManager(q), nil
+}
+
+func (f *factoryImpl) NewSkippedScheduleActionManager() (persistence.SkippedScheduleActionManager, error) {
+	q, err := f.dataStoreFactory.NewQueueV2()
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewSkippedScheduleActionManager(q), nil
+}
+
 func (f *factoryImpl) NewNexusEndpointManager() (persistence.NexusEndpointManager, error) {
 	store, err := f.dataStoreFactory.NewNexusEndpointStore()
 	if err != nil {
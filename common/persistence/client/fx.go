@@ -69,6 +69,8 @@ var Module = fx.Options(
 	fx.Provide(managerProvider(Factory.NewExecutionManager)),
 	fx.Provide(managerProvider(Factory.NewHistoryTaskQueueManager)),
 	fx.Provide(managerProvider(Factory.NewNexusEndpointManager)),
+	fx.Provide(managerProvider(Factory.NewWorkerHeartbeatManager)),
+	fx.Provide(managerProvider(Factory.NewSkippedScheduleActionManager)),
 
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
@@ -13,8 +13,10 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	workerpb "go.temporal.io/api/worker/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/service/history/tasks"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -630,6 +632,17 @@ type (
 		Limit              int // Limit on the max number of tasks that can be completed. Required param
 	}
 
+	// CompleteTasksRequest contains the request params needed to invoke CompleteTasks API
+	CompleteTasksRequest struct {
+		NamespaceID   string
+		TaskQueueName string
+		TaskType      enumspb.TaskQueueType
+		Subqueue      int
+		// Tasks to delete. Only TaskPass and TaskId are used: TaskPass must be >= 1 for fair task
+		// manager and must be 0 for classic task manager.
+		Tasks []*persistencespb.AllocatedTaskInfo
+	}
+
 	// CreateNamespaceRequest is used to create the namespace
 	CreateNamespaceRequest struct {
 		Namespace         *persistencespb.NamespaceDetail
@@ -1155,6 +1168,11 @@ type (
 		//  - UnknownNumRowsAffected (this means all rows below value are deleted)
 		//  - number of rows deleted, which may be equal to limit
 		CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error)
+		// CompleteTasks deletes specific tasks from a task queue, regardless of the ack level.
+		// It is meant for operator tooling: matching does not learn about deleted tasks, so a
+		// deleted task that was already loaded by a task queue partition may still be dispatched
+		// until that partition is reloaded.
+		CompleteTasks(ctx context.Context, request *CompleteTasksRequest) error
 
 		// GetTaskQueueUserData gets versioned user data.
 		// This data would only exist if a user uses APIs that generate it, such as the worker versioning related APIs.
@@ -1235,6 +1253,34 @@ type (
 		serializer serialization.Serializer
 	}
 
+	// WorkerHeartbeatManager stores the worker heartbeats of each namespace in a queue named after the namespace ID,
+	// so that they outlive the matching host that owns the namespace.
+	WorkerHeartbeatManager interface {
+		Closeable
+		// AppendWorkerHeartbeats appends a message with the given heartbeats to the queue of the namespace. The queue
+		// is created if it doesn't exist yet.
+		AppendWorkerHeartbeats(ctx context.Context, request *AppendWorkerHeartbeatsRequest) (*AppendWorkerHeartbeatsResponse, error)
+		// ReadWorkerHeartbeats returns a page of the messages of the queue of the namespace, oldest first. It returns
+		// no messages if the queue doesn't exist.
+		ReadWorkerHeartbeats(ctx context.Context, request *ReadWorkerHeartbeatsRequest) (*ReadWorkerHeartbeatsResponse, error)
+		// DeleteWorkerHeartbeats deletes the messages of the queue of the namespace up to and including the given one.
+		DeleteWorkerHeartbeats(ctx context.Context, request *DeleteWorkerHeartbeatsRequest) error
+	}
+
+	// SkippedScheduleActionManager stores the actions that each schedule skipped in a queue per schedule.
+	SkippedScheduleActionManager interface {
+		Closeable
+		// AppendSkippedScheduleActions appends a batch of skipped actions to the queue of the schedule, creating it if
+		// it doesn't exist yet, and deletes the oldest batches beyond MaxBatches.
+		AppendSkippedScheduleActions(ctx context.Context, request *AppendSkippedScheduleActionsRequest) error
+		// ReadSkippedScheduleActions returns the skipped actions of up to PageSize batches of the schedule, oldest
+		// first. It returns no skipped actions if the queue doesn't exist.
+		ReadSkippedScheduleActions(
+			ctx context.Context,
+			request *ReadSkippedScheduleActionsRequest,
+		) (*ReadSkippedScheduleActionsResponse, error)
+	}
+
 	// QueueKey identifies a history task queue. It is converted to a queue name using the GetQueueName method.
 	QueueKey struct {
 		QueueType     QueueV2Type
@@ -1314,6 +1360,57 @@ type (
 		Queues        []QueueInfo
 		NextPageToken []byte
 	}
+
+	AppendWorkerHeartbeatsRequest struct {
+		NamespaceID string
+		Heartbeats  []*workerpb.WorkerHeartbeat
+	}
+
+	AppendWorkerHeartbeatsResponse struct {
+		Metadata MessageMetadata
+	}
+
+	ReadWorkerHeartbeatsRequest struct {
+		NamespaceID   string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ReadWorkerHeartbeatsResponse struct {
+		Messages      []WorkerHeartbeatsMessage
+		NextPageToken []byte
+	}
+
+	// WorkerHeartbeatsMessage is a message of the worker heartbeat queue of a namespace.
+	WorkerHeartbeatsMessage struct {
+		MessageMetadata MessageMetadata
+		Heartbeats      []*workerpb.WorkerHeartbeat
+	}
+
+	DeleteWorkerHeartbeatsRequest struct {
+		NamespaceID                 string
+		InclusiveMaxMessageMetadata MessageMetadata
+	}
+
+	AppendSkippedScheduleActionsRequest struct {
+		NamespaceID    string
+		ScheduleID     string
+		SkippedActions []*schedulespb.SkippedAction
+		// MaxBatches is the number of batches retained for the schedule, or 0 to retain all of them.
+		MaxBatches int
+	}
+
+	ReadSkippedScheduleActionsRequest struct {
+		NamespaceID   string
+		ScheduleID    string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	ReadSkippedScheduleActionsResponse struct {
+		SkippedActions []*schedulespb.SkippedAction
+		NextPageToken  []byte
+	}
 )
 
 func (e *InvalidPersistenceRequestError) Error() string {
@@ -657,6 +657,20 @@ func (mr *MockTaskManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTaskManager)(nil).Close))
 }
 
+// CompleteTasks mocks base method.
+func (m *MockTaskManager) CompleteTasks(ctx context.Context, request *CompleteTasksRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTasks", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTasks indicates an expected call of CompleteTasks.
+func (mr *MockTaskManagerMockRecorder) CompleteTasks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTasks", reflect.TypeOf((*MockTaskManager)(nil).CompleteTasks), ctx, request)
+}
+
 // CompleteTasksLessThan mocks base method.
 func (m *MockTaskManager) CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (int, error) {
 	m.ctrl.T.Helper()
@@ -1445,3 +1459,148 @@ func (mr *MockHistoryTaskQueueManagerMockRecorder) ReadTasks(ctx, request any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTasks", reflect.TypeOf((*MockHistoryTaskQueueManager)(nil).ReadTasks), ctx, request)
 }
+
+// MockWorkerHeartbeatManager is a mock of WorkerHeartbeatManager interface.
+type MockWorkerHeartbeatManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkerHeartbeatManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkerHeartbeatManagerMockRecorder is the mock recorder for MockWorkerHeartbeatManager.
+type MockWorkerHeartbeatManagerMockRecorder struct {
+	mock *MockWorkerHeartbeatManager
+}
+
+// NewMockWorkerHeartbeatManager creates a new mock instance.
+func NewMockWorkerHeartbeatManager(ctrl *gomock.Controller) *MockWorkerHeartbeatManager {
+	mock := &MockWorkerHeartbeatManager{ctrl: ctrl}
+	mock.recorder = &MockWorkerHeartbeatManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkerHeartbeatManager) EXPECT() *MockWorkerHeartbeatManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendWorkerHeartbeats mocks base method.
+func (m *MockWorkerHeartbeatManager) AppendWorkerHeartbeats(ctx context.Context, request *AppendWorkerHeartbeatsRequest) (*AppendWorkerHeartbeatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendWorkerHeartbeats", ctx, request)
+	ret0, _ := ret[0].(*AppendWorkerHeartbeatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendWorkerHeartbeats indicates an expected call of AppendWorkerHeartbeats.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) AppendWorkerHeartbeats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendWorkerHeartbeats", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).AppendWorkerHeartbeats), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockWorkerHeartbeatManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).Close))
+}
+
+// DeleteWorkerHeartbeats mocks base method.
+func (m *MockWorkerHeartbeatManager) DeleteWorkerHeartbeats(ctx context.Context, request *DeleteWorkerHeartbeatsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkerHeartbeats", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkerHeartbeats indicates an expected call of DeleteWorkerHeartbeats.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) DeleteWorkerHeartbeats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkerHeartbeats", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).DeleteWorkerHeartbeats), ctx, request)
+}
+
+// ReadWorkerHeartbeats mocks base method.
+func (m *MockWorkerHeartbeatManager) ReadWorkerHeartbeats(ctx context.Context, request *ReadWorkerHeartbeatsRequest) (*ReadWorkerHeartbeatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkerHeartbeats", ctx, request)
+	ret0, _ := ret[0].(*ReadWorkerHeartbeatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkerHeartbeats indicates an expected call of ReadWorkerHeartbeats.
+func (mr *MockWorkerHeartbeatManagerMockRecorder) ReadWorkerHeartbeats(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkerHeartbeats", reflect.TypeOf((*MockWorkerHeartbeatManager)(nil).ReadWorkerHeartbeats), ctx, request)
+}
+
+// MockSkippedScheduleActionManager is a mock of SkippedScheduleActionManager interface.
+type MockSkippedScheduleActionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockSkippedScheduleActionManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockSkippedScheduleActionManagerMockRecorder is the mock recorder for MockSkippedScheduleActionManager.
+type MockSkippedScheduleActionManagerMockRecorder struct {
+	mock *MockSkippedScheduleActionManager
+}
+
+// NewMockSkippedScheduleActionManager creates a new mock instance.
+func NewMockSkippedScheduleActionManager(ctrl *gomock.Controller) *MockSkippedScheduleActionManager {
+	mock := &MockSkippedScheduleActionManager{ctrl: ctrl}
+	mock.recorder = &MockSkippedScheduleActionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSkippedScheduleActionManager) EXPECT() *MockSkippedScheduleActionManagerMockRecorder {
+	return m.recorder
+}
+
+// AppendSkippedScheduleActions mocks base method.
+func (m *MockSkippedScheduleActionManager) AppendSkippedScheduleActions(ctx context.Context, request *AppendSkippedScheduleActionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendSkippedScheduleActions", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendSkippedScheduleActions indicates an expected call of AppendSkippedScheduleActions.
+func (mr *MockSkippedScheduleActionManagerMockRecorder) AppendSkippedScheduleActions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendSkippedScheduleActions", reflect.TypeOf((*MockSkippedScheduleActionManager)(nil).AppendSkippedScheduleActions), ctx, request)
+}
+
+// Close mocks base method.
+func (m *MockSkippedScheduleActionManager) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSkippedScheduleActionManagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSkippedScheduleActionManager)(nil).Close))
+}
+
+// ReadSkippedScheduleActions mocks base method.
+func (m *MockSkippedScheduleActionManager) ReadSkippedScheduleActions(ctx context.Context, request *ReadSkippedScheduleActionsRequest) (*ReadSkippedScheduleActionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSkippedScheduleActions", ctx, request)
+	ret0, _ := ret[0].(*ReadSkippedScheduleActionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSkippedScheduleActions indicates an expected call of ReadSkippedScheduleActions.
+func (mr *MockSkippedScheduleActionManagerMockRecorder) ReadSkippedScheduleActions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSkippedScheduleActions", reflect.TypeOf((*MockSkippedScheduleActionManager)(nil).ReadSkippedScheduleActions), ctx, request)
+}
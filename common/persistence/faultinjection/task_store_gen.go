@@ -31,6 +31,15 @@ func newFaultInjectionTaskStore(
 	}
 }
 
+// CompleteTasks wraps TaskStore.CompleteTasks.
+func (d faultInjectionTaskStore) CompleteTasks(ctx context.Context, request *_sourcePersistence.CompleteTasksRequest) (err error) {
+	err = d.generator.generate("CompleteTasks").inject(func() error {
+		err = d.TaskStore.CompleteTasks(ctx, request)
+		return err
+	})
+	return
+}
+
 // CompleteTasksLessThan wraps TaskStore.CompleteTasksLessThan.
 func (d faultInjectionTaskStore) CompleteTasksLessThan(ctx context.Context, request *_sourcePersistence.CompleteTasksLessThanRequest) (i1 int, err error) {
 	err = d.generator.generate("CompleteTasksLessThan").inject(func() error {
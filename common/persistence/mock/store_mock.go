@@ -332,6 +332,20 @@ func (mr *MockTaskStoreMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTaskStore)(nil).Close))
 }
 
+// CompleteTasks mocks base method.
+func (m *MockTaskStore) CompleteTasks(ctx context.Context, request *persistence.CompleteTasksRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTasks", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTasks indicates an expected call of CompleteTasks.
+func (mr *MockTaskStoreMockRecorder) CompleteTasks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTasks", reflect.TypeOf((*MockTaskStore)(nil).CompleteTasks), ctx, request)
+}
+
 // CompleteTasksLessThan mocks base method.
 func (m *MockTaskStore) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (int, error) {
 	m.ctrl.T.Helper()
@@ -547,6 +547,19 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
+func (p *taskPersistenceClient) CompleteTasks(
+	ctx context.Context,
+	request *CompleteTasksRequest,
+) (retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		p.healthSignals.Record(CallerSegmentMissing, time.Since(startTime), retErr)
+		p.recordRequestMetrics(metrics.PersistenceCompleteTasksScope, caller, time.Since(startTime), retErr)
+	}()
+	return p.persistence.CompleteTasks(ctx, request)
+}
+
 func (p *taskPersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
@@ -542,6 +542,16 @@ func (p *taskRateLimitedPersistenceClient) CompleteTasksLessThan(
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
 
+func (p *taskRateLimitedPersistenceClient) CompleteTasks(
+	ctx context.Context,
+	request *CompleteTasksRequest,
+) error {
+	if err := allow(ctx, "CompleteTasks", CallerSegmentMissing, p.systemRateLimiter, p.namespaceRateLimiter, p.shardRateLimiter); err != nil {
+		return err
+	}
+	return p.persistence.CompleteTasks(ctx, request)
+}
+
 func (p *taskRateLimitedPersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
@@ -650,6 +650,17 @@ func (p *taskRetryablePersistenceClient) CompleteTasksLessThan(
 	return response, err
 }
 
+func (p *taskRetryablePersistenceClient) CompleteTasks(
+	ctx context.Context,
+	request *CompleteTasksRequest,
+) error {
+	op := func(ctx context.Context) error {
+		return p.persistence.CompleteTasks(ctx, request)
+	}
+
+	return backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+}
+
 func (p *taskRetryablePersistenceClient) CreateTaskQueue(
 	ctx context.Context,
 	request *CreateTaskQueueRequest,
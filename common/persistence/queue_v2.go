@@ -8,6 +8,10 @@ const (
 	QueueTypeUnspecified   QueueV2Type = 0
 	QueueTypeHistoryNormal QueueV2Type = 1
 	QueueTypeHistoryDLQ    QueueV2Type = 2
+	// QueueTypeWorkerHeartbeats is the type of the queues of WorkerHeartbeatManager.
+	QueueTypeWorkerHeartbeats QueueV2Type = 3
+	// QueueTypeSkippedScheduleActions is the type of the queues of SkippedScheduleActionManager.
+	QueueTypeSkippedScheduleActions QueueV2Type = 4
 
 	// FirstQueueMessageID is the ID of the first message written to a queue partition.
 	FirstQueueMessageID = 0
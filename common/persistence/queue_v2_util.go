@@ -1,6 +1,8 @@
 package persistence
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"go.temporal.io/api/serviceerror"
@@ -150,3 +152,25 @@ func GetDeleteRange(request DeleteRequest) (DeleteRange, bool) {
 		MessagesToDelete: min(request.LastIDToDeleteInclusive, request.ExistingMessageRange.MaxMessageID) - request.ExistingMessageRange.MinMessageID + 1,
 	}, true
 }
+
+// enqueueMessageCreatingQueue enqueues a message, creating its queue first if it doesn't exist yet. It's for queues
+// that are created by their first write.
+func enqueueMessageCreatingQueue(
+	ctx context.Context,
+	queue QueueV2,
+	request *InternalEnqueueMessageRequest,
+) (*InternalEnqueueMessageResponse, error) {
+	response, err := queue.EnqueueMessage(ctx, request)
+	var notFound *serviceerror.NotFound
+	if !errors.As(err, &notFound) {
+		return response, err
+	}
+	_, err = queue.CreateQueue(ctx, &InternalCreateQueueRequest{
+		QueueType: request.QueueType,
+		QueueName: request.QueueName,
+	})
+	if err != nil && !errors.Is(err, ErrQueueAlreadyExists) {
+		return nil, err
+	}
+	return queue.EnqueueMessage(ctx, request)
+}
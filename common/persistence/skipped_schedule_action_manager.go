@@ -0,0 +1,116 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgSerializeSkippedScheduleActions   = "failed to serialize skipped schedule actions"
+	ErrMsgDeserializeSkippedScheduleActions = "failed to deserialize skipped schedule actions"
+)
+
+var ErrReadSkippedScheduleActionsNonPositivePageSize = errors.New(
+	"page size to read skipped schedule actions must be positive",
+)
+
+type SkippedScheduleActionManagerImpl struct {
+	queue QueueV2
+}
+
+var _ SkippedScheduleActionManager = (*SkippedScheduleActionManagerImpl)(nil)
+
+func NewSkippedScheduleActionManager(queue QueueV2) *SkippedScheduleActionManagerImpl {
+	return &SkippedScheduleActionManagerImpl{
+		queue: queue,
+	}
+}
+
+func (m *SkippedScheduleActionManagerImpl) AppendSkippedScheduleActions(
+	ctx context.Context,
+	request *AppendSkippedScheduleActionsRequest,
+) error {
+	if len(request.SkippedActions) == 0 {
+		return nil
+	}
+	data, err := (&schedulespb.SkippedActions{SkippedActions: request.SkippedActions}).Marshal()
+	if err != nil {
+		return fmt.Errorf("%v: %w", ErrMsgSerializeSkippedScheduleActions, err)
+	}
+	queueName := GetSkippedScheduleActionQueueName(request.NamespaceID, request.ScheduleID)
+	response, err := enqueueMessageCreatingQueue(ctx, m.queue, &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeSkippedScheduleActions,
+		QueueName: queueName,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if request.MaxBatches <= 0 || response.Metadata.ID < int64(request.MaxBatches) {
+		return nil
+	}
+	_, err = m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType: QueueTypeSkippedScheduleActions,
+		QueueName: queueName,
+		InclusiveMaxMessageMetadata: MessageMetadata{
+			ID: response.Metadata.ID - int64(request.MaxBatches),
+		},
+	})
+	return err
+}
+
+func (m *SkippedScheduleActionManagerImpl) ReadSkippedScheduleActions(
+	ctx context.Context,
+	request *ReadSkippedScheduleActionsRequest,
+) (*ReadSkippedScheduleActionsResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadSkippedScheduleActionsNonPositivePageSize, request.PageSize)
+	}
+
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeSkippedScheduleActions,
+		QueueName:     GetSkippedScheduleActionQueueName(request.NamespaceID, request.ScheduleID),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &ReadSkippedScheduleActionsResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var skippedActions []*schedulespb.SkippedAction
+	for _, message := range response.Messages {
+		var batch schedulespb.SkippedActions
+		err := serialization.Proto3Decode(message.Data.GetData(), message.Data.GetEncodingType(), &batch)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeSkippedScheduleActions, err)
+		}
+		skippedActions = append(skippedActions, batch.GetSkippedActions()...)
+	}
+	return &ReadSkippedScheduleActionsResponse{
+		SkippedActions: skippedActions,
+		NextPageToken:  response.NextPageToken,
+	}, nil
+}
+
+func (m *SkippedScheduleActionManagerImpl) Close() {
+}
+
+// GetSkippedScheduleActionQueueName returns the name of the queue of the skipped actions of a schedule. Schedule IDs
+// are hashed to keep the name within the limit of queue names.
+func GetSkippedScheduleActionQueueName(namespaceID string, scheduleID string) string {
+	return namespaceID + "_" + combineUnique(scheduleID)
+}
@@ -22,6 +22,7 @@ type (
 		TaskQueueID        []byte
 		InclusiveMinTaskID *int64
 		ExclusiveMaxTaskID *int64
+		TaskID             *int64
 		Limit              *int
 		PageSize           *int
 	}
@@ -36,6 +37,9 @@ type (
 		// Required filter params:
 		//    - {namespaceID, taskqueueName, taskType, exclusiveMaxTaskID, limit }
 		//    - this will delete upto limit number of tasks less than the given max task id
+		// OR
+		//    - {namespaceID, taskqueueName, taskType, taskID}
+		//    - this will delete the single task with the given id
 		DeleteFromTasks(ctx context.Context, filter TasksFilter) (sql.Result, error)
 	}
 )
@@ -27,6 +27,7 @@ type (
 		TaskQueueID       []byte
 		InclusiveMinLevel *FairLevel
 		ExclusiveMaxLevel *FairLevel
+		TaskLevel         *FairLevel
 		Limit             *int
 		PageSize          *int
 	}
@@ -44,6 +45,9 @@ type (
 		// Required filter params:
 		//    - {RangeHash, TaskQueueID, ExclusiveMaxLevel, Limit}
 		//    - this will delete upto limit number of tasks less than the given max task id
+		// OR
+		//    - {RangeHash, TaskQueueID, TaskLevel}
+		//    - this will delete the single task with the given pass and task id
 		DeleteFromTasksV2(ctx context.Context, filter TasksFilterV2) (sql.Result, error)
 	}
 )
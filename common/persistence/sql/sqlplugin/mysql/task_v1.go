@@ -25,6 +25,9 @@ const (
 	rangeDeleteTaskQry = `DELETE FROM tasks ` +
 		`WHERE range_hash = ? AND task_queue_id = ? AND task_id < ? ` +
 		`ORDER BY task_queue_id,task_id LIMIT ?`
+
+	deleteTaskQry = `DELETE FROM tasks ` +
+		`WHERE range_hash = ? AND task_queue_id = ? AND task_id = ?`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -75,6 +78,14 @@ func (mdb *db) DeleteFromTasks(
 	ctx context.Context,
 	filter sqlplugin.TasksFilter,
 ) (sql.Result, error) {
+	if filter.TaskID != nil {
+		return mdb.ExecContext(ctx,
+			deleteTaskQry,
+			filter.RangeHash,
+			filter.TaskQueueID,
+			*filter.TaskID,
+		)
+	}
 	if filter.ExclusiveMaxTaskID == nil {
 		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskID parameter")
 	}
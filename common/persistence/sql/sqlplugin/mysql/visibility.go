@@ -40,6 +40,10 @@ var (
 		) VALUES (:namespace_id, :run_id, :search_attributes, :_version)` +
 		buildOnDuplicateKeyUpdate("search_attributes", sqlplugin.VersionColumnName)
 
+	templateUpdateCustomSearchAttributes = `
+		UPDATE custom_search_attributes SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
 	templateDeleteWorkflowExecution_v8 = `
 		DELETE FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`
@@ -147,6 +151,14 @@ func (mdb *db) ReplaceIntoVisibility(
 	return result, nil
 }
 
+// UpdateVisibilitySearchAttributes overwrites the custom search attributes of a row if its version didn't change
+func (mdb *db) UpdateVisibilitySearchAttributes(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	return mdb.NamedExecContext(ctx, templateUpdateCustomSearchAttributes, mdb.prepareRowForDB(row))
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -266,6 +278,25 @@ func (mdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (mdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) (_ []sql.NullFloat64, retError error) {
+	defer func() {
+		retError = mdb.handle.ConvertError(retError)
+	}()
+	db, err := mdb.handle.DB()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := db.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregateRow(rows)
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
@@ -26,6 +26,9 @@ const (
 		`WHERE range_hash = $1 AND task_queue_id = $2 AND task_id IN (SELECT task_id FROM
 		 tasks WHERE range_hash = $1 AND task_queue_id = $2 AND task_id < $3 ` +
 		`ORDER BY task_queue_id,task_id LIMIT $4 )`
+
+	deleteTaskQry = `DELETE FROM tasks ` +
+		`WHERE range_hash = $1 AND task_queue_id = $2 AND task_id = $3`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -75,6 +78,14 @@ func (pdb *db) DeleteFromTasks(
 	ctx context.Context,
 	filter sqlplugin.TasksFilter,
 ) (sql.Result, error) {
+	if filter.TaskID != nil {
+		return pdb.ExecContext(ctx,
+			deleteTaskQry,
+			filter.RangeHash,
+			filter.TaskQueueID,
+			*filter.TaskID,
+		)
+	}
 	if filter.ExclusiveMaxTaskID == nil {
 		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskID parameter")
 	}
@@ -26,6 +26,9 @@ const (
 		`WHERE range_hash = $1 AND task_queue_id = $2 AND task_id IN (SELECT task_id FROM ` +
 		`tasks_v2 WHERE range_hash = $1 AND task_queue_id = $2 AND (pass, task_id) < ($3, $4) ` +
 		`ORDER BY task_queue_id,pass,task_id LIMIT $5 )`
+
+	deleteTaskV2Qry = `DELETE FROM tasks_v2 ` +
+		`WHERE range_hash = $1 AND task_queue_id = $2 AND pass = $3 AND task_id = $4`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -78,6 +81,15 @@ func (pdb *db) DeleteFromTasksV2(
 	ctx context.Context,
 	filter sqlplugin.TasksFilterV2,
 ) (sql.Result, error) {
+	if filter.TaskLevel != nil {
+		return pdb.ExecContext(ctx,
+			deleteTaskV2Qry,
+			filter.RangeHash,
+			filter.TaskQueueID,
+			filter.TaskLevel.TaskPass,
+			filter.TaskLevel.TaskID,
+		)
+	}
 	if filter.ExclusiveMaxLevel == nil {
 		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskLevel")
 	}
@@ -27,6 +27,10 @@ var (
 		buildOnDuplicateKeyUpdate(sqlplugin.DbFields...),
 	)
 
+	templateUpdateSearchAttributes = `
+		UPDATE executions_visibility SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
 	templateDeleteWorkflowExecution_v12 = `
 		DELETE FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`
@@ -69,6 +73,15 @@ func (pdb *db) ReplaceIntoVisibility(
 	return pdb.NamedExecContext(ctx, templateUpsertWorkflowExecution, finalRow)
 }
 
+// UpdateVisibilitySearchAttributes overwrites the search attributes of a row if its version didn't change
+func (pdb *db) UpdateVisibilitySearchAttributes(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	finalRow := pdb.prepareRowForDB(row)
+	return pdb.NamedExecContext(ctx, templateUpdateSearchAttributes, finalRow)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (pdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -157,6 +170,19 @@ func (pdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (pdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sql.NullFloat64, error) {
+	filter.Query = pdb.Rebind(filter.Query)
+	rows, err := pdb.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregateRow(rows)
+}
+
 func (pdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
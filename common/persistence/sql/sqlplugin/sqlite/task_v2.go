@@ -26,6 +26,9 @@ const (
 		`WHERE range_hash = ? AND task_queue_id = ? AND task_id IN (SELECT task_id FROM ` +
 		`tasks_v2 WHERE range_hash = ? AND task_queue_id = ? AND (pass, task_id) < (?, ?) ` +
 		`ORDER BY task_queue_id, pass, task_id LIMIT ? ) `
+
+	deleteTaskV2Qry = `DELETE FROM tasks_v2 ` +
+		`WHERE range_hash = ? AND task_queue_id = ? AND pass = ? AND task_id = ?`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks_v2 table
@@ -79,6 +82,15 @@ func (mdb *db) DeleteFromTasksV2(
 	ctx context.Context,
 	filter sqlplugin.TasksFilterV2,
 ) (sql.Result, error) {
+	if filter.TaskLevel != nil {
+		return mdb.conn.ExecContext(ctx,
+			deleteTaskV2Qry,
+			filter.RangeHash,
+			filter.TaskQueueID,
+			filter.TaskLevel.TaskPass,
+			filter.TaskLevel.TaskID,
+		)
+	}
 	if filter.ExclusiveMaxLevel == nil {
 		return nil, serviceerror.NewInternal("missing ExclusiveMaxTaskLevel")
 	}
@@ -29,6 +29,10 @@ var (
 		buildOnDuplicateKeyUpdate(sqlplugin.DbFields...),
 	)
 
+	templateUpdateSearchAttributes = `
+		UPDATE executions_visibility SET search_attributes = :search_attributes
+		WHERE namespace_id = :namespace_id AND run_id = :run_id AND _version = :_version`
+
 	templateDeleteWorkflowExecution = `
 		DELETE FROM executions_visibility
 		WHERE namespace_id = :namespace_id AND run_id = :run_id`
@@ -71,6 +75,15 @@ func (mdb *db) ReplaceIntoVisibility(
 	return mdb.conn.NamedExecContext(ctx, templateUpsertWorkflowExecution, finalRow)
 }
 
+// UpdateVisibilitySearchAttributes overwrites the search attributes of a row if its version didn't change
+func (mdb *db) UpdateVisibilitySearchAttributes(
+	ctx context.Context,
+	row *sqlplugin.VisibilityRow,
+) (sql.Result, error) {
+	finalRow := mdb.prepareRowForDB(row)
+	return mdb.conn.NamedExecContext(ctx, templateUpdateSearchAttributes, finalRow)
+}
+
 // DeleteFromVisibility deletes a row from visibility table if it exist
 func (mdb *db) DeleteFromVisibility(
 	ctx context.Context,
@@ -151,6 +164,18 @@ func (mdb *db) CountGroupByFromVisibility(
 	return sqlplugin.ParseCountGroupByRows(rows, filter.GroupBy)
 }
 
+func (mdb *db) AggregateFromVisibility(
+	ctx context.Context,
+	filter sqlplugin.VisibilitySelectFilter,
+) ([]sql.NullFloat64, error) {
+	rows, err := mdb.db.QueryContext(ctx, filter.Query, filter.QueryArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return sqlplugin.ParseAggregateRow(rows)
+}
+
 func (mdb *db) prepareRowForDB(row *sqlplugin.VisibilityRow) *sqlplugin.VisibilityRow {
 	if row == nil {
 		return nil
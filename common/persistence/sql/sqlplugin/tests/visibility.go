@@ -265,6 +265,51 @@ func (s *visibilitySuite) TestReplaceSelect_Exists() {
 	s.Equal([]sqlplugin.VisibilityRow{visibility}, rows)
 }
 
+func (s *visibilitySuite) TestUpdateSearchAttributes() {
+	namespaceID := primitives.NewUUID()
+	runID := primitives.NewUUID()
+	startTime := s.now()
+
+	visibility := s.newRandomVisibilityRow(
+		namespaceID,
+		runID,
+		shuffle.String(testVisibilityWorkflowTypeName),
+		shuffle.String(testVisibilityWorkflowID),
+		startTime,
+		startTime,
+		int32(0),
+		nil,
+		nil,
+	)
+	visibility.SearchAttributes = &sqlplugin.VisibilitySearchAttributes{"Keyword01": "abc"}
+	_, err := s.store.InsertIntoVisibility(newVisibilityContext(), &visibility)
+	s.NoError(err)
+
+	visibility.SearchAttributes = &sqlplugin.VisibilitySearchAttributes{"Keyword01": "abc", "Text01": "abc"}
+	result, err := s.store.UpdateVisibilitySearchAttributes(newVisibilityContext(), &visibility)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(1, int(rowsAffected))
+
+	// The update is skipped if the row has a different version.
+	stale := visibility
+	stale.Version--
+	stale.SearchAttributes = &sqlplugin.VisibilitySearchAttributes{"Keyword01": "stale"}
+	result, err = s.store.UpdateVisibilitySearchAttributes(newVisibilityContext(), &stale)
+	s.NoError(err)
+	rowsAffected, err = result.RowsAffected()
+	s.NoError(err)
+	s.Equal(0, int(rowsAffected))
+
+	row, err := s.store.GetFromVisibility(newVisibilityContext(), sqlplugin.VisibilityGetFilter{
+		NamespaceID: namespaceID.String(),
+		RunID:       runID.String(),
+	})
+	s.NoError(err)
+	s.Equal(*visibility.SearchAttributes, *row.SearchAttributes)
+}
+
 func (s *visibilitySuite) TestDeleteGet() {
 	namespaceID := primitives.NewUUID()
 	runID := primitives.NewUUID()
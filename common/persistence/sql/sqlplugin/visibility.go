@@ -94,6 +94,9 @@ type (
 		InsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// ReplaceIntoVisibility deletes old row (if it exist) and inserts new row into visibility table
 		ReplaceIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// UpdateVisibilitySearchAttributes overwrites the search attributes of a row if its version didn't change,
+		// i.e. if the row was not updated since it was read.
+		UpdateVisibilitySearchAttributes(ctx context.Context, row *VisibilityRow) (sql.Result, error)
 		// SelectFromVisibility returns one or more rows from visibility table
 		// Required filter params:
 		// - getClosedWorkflowExecution - retrieves single row - {namespaceID, runID, closed=true}
@@ -107,6 +110,9 @@ type (
 		DeleteFromVisibility(ctx context.Context, filter VisibilityDeleteFilter) (sql.Result, error)
 		CountFromVisibility(ctx context.Context, filter VisibilitySelectFilter) (int64, error)
 		CountGroupByFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]VisibilityCountRow, error)
+		// AggregateFromVisibility runs a query returning a single row of numeric columns, e.g. SUM and AVG of a column.
+		// NULL values are returned as invalid sql.NullFloat64.
+		AggregateFromVisibility(ctx context.Context, filter VisibilitySelectFilter) ([]sql.NullFloat64, error)
 	}
 )
 
@@ -169,6 +175,30 @@ func ParseCountGroupByRows(rows *sql.Rows, groupBy []string) ([]VisibilityCountR
 	return res, nil
 }
 
+// ParseAggregateRow parses the single row of numeric columns returned by an aggregate query.
+func ParseAggregateRow(rows *sql.Rows) ([]sql.NullFloat64, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]sql.NullFloat64, len(columns))
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		// No row is returned when there are no values to aggregate.
+		return values, nil
+	}
+	scanArgs := make([]any, len(values))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+	if err := rows.Scan(scanArgs...); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
 func parseCountGroupByGroupValue(fieldName string, value any) (any, error) {
 	switch fieldName {
 	case searchattribute.ExecutionStatus:
@@ -182,6 +182,32 @@ func (m *sqlTaskManagerV1) CompleteTasksLessThan(
 	return int(nRows), nil
 }
 
+func (m *sqlTaskManagerV1) CompleteTasks(
+	ctx context.Context,
+	request *persistence.CompleteTasksRequest,
+) error {
+	nidBytes, err := primitives.ParseUUID(request.NamespaceID)
+	if err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	tqId, tqHash := taskQueueIdAndHash(nidBytes, request.TaskQueueName, request.TaskType, request.Subqueue)
+	return m.SqlStore.txExecute(ctx, "CompleteTasks", func(tx sqlplugin.Tx) error {
+		for _, task := range request.Tasks {
+			if task.TaskPass != 0 {
+				return serviceerror.NewInternal("invalid non-fair queue task with pass number")
+			}
+			if _, err := tx.DeleteFromTasks(ctx, sqlplugin.TasksFilter{
+				RangeHash:   tqHash,
+				TaskQueueID: tqId,
+				TaskID:      &task.TaskId,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func lockTaskQueue(
 	ctx context.Context,
 	tx sqlplugin.Tx,
@@ -190,3 +190,32 @@ func (m *sqlTaskManagerV2) CompleteTasksLessThan(
 	}
 	return int(nRows), nil
 }
+
+func (m *sqlTaskManagerV2) CompleteTasks(
+	ctx context.Context,
+	request *persistence.CompleteTasksRequest,
+) error {
+	nidBytes, err := primitives.ParseUUID(request.NamespaceID)
+	if err != nil {
+		return serviceerror.NewUnavailable(err.Error())
+	}
+	tqId, tqHash := taskQueueIdAndHash(nidBytes, request.TaskQueueName, request.TaskType, request.Subqueue)
+	return m.SqlStore.txExecute(ctx, "CompleteTasks", func(tx sqlplugin.Tx) error {
+		for _, task := range request.Tasks {
+			if task.TaskPass < 1 {
+				return serviceerror.NewInternal("invalid fair queue task without pass number")
+			}
+			if _, err := tx.DeleteFromTasksV2(ctx, sqlplugin.TasksFilterV2{
+				RangeHash:   tqHash,
+				TaskQueueID: tqId,
+				TaskLevel: &sqlplugin.FairLevel{
+					TaskPass: task.TaskPass,
+					TaskID:   task.TaskId,
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -40,6 +40,41 @@ func newTelemetryTaskStore(
 	}
 }
 
+// CompleteTasks wraps TaskStore.CompleteTasks.
+func (d telemetryTaskStore) CompleteTasks(ctx context.Context, request *_sourcePersistence.CompleteTasksRequest) (err error) {
+	ctx, span := d.tracer.Start(
+		ctx,
+		"persistence.TaskStore/CompleteTasks",
+		trace.WithAttributes(
+			attribute.Key("persistence.store").String("TaskStore"),
+			attribute.Key("persistence.method").String("CompleteTasks"),
+		))
+	defer span.End()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		span.SetAttributes(attribute.String("deadline", deadline.Format(time.RFC3339Nano)))
+		span.SetAttributes(attribute.String("timeout", time.Until(deadline).String()))
+	}
+
+	err = d.TaskStore.CompleteTasks(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	if d.debugMode {
+
+		requestPayload, err := json.MarshalIndent(request, "", "    ")
+		if err != nil {
+			d.logger.Error("failed to serialize *_sourcePersistence.CompleteTasksRequest for OTEL span", tag.Error(err))
+		} else {
+			span.SetAttributes(attribute.Key("persistence.request.payload").String(string(requestPayload)))
+		}
+
+	}
+
+	return
+}
+
 // CompleteTasksLessThan wraps TaskStore.CompleteTasksLessThan.
 func (d telemetryTaskStore) CompleteTasksLessThan(ctx context.Context, request *_sourcePersistence.CompleteTasksLessThanRequest) (i1 int, err error) {
 	ctx, span := d.tracer.Start(
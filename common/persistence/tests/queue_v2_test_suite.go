@@ -130,6 +130,14 @@ func RunQueueV2TestSuite(t *testing.T, q persistence.QueueV2) {
 		t.Parallel()
 		RunHistoryTaskQueueManagerTestSuite(t, q)
 	})
+	t.Run("WorkerHeartbeatManagerImpl", func(t *testing.T) {
+		t.Parallel()
+		RunWorkerHeartbeatManagerTestSuite(t, q)
+	})
+	t.Run("SkippedScheduleActionManagerImpl", func(t *testing.T) {
+		t.Parallel()
+		RunSkippedScheduleActionManagerTestSuite(t, q)
+	})
 }
 
 func testHappyPath(
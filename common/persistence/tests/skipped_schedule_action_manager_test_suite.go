@@ -0,0 +1,122 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunSkippedScheduleActionManagerTestSuite runs all tests for the skipped schedule action manager against a given
+// queue provided by a particular database.
+func RunSkippedScheduleActionManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewSkippedScheduleActionManager(queue)
+	t.Run("ReadMissingQueue", func(t *testing.T) {
+		t.Parallel()
+		testSkippedScheduleActionManagerReadMissingQueue(t, manager)
+	})
+	t.Run("AppendRead", func(t *testing.T) {
+		t.Parallel()
+		testSkippedScheduleActionManagerAppendRead(t, manager)
+	})
+	t.Run("Retention", func(t *testing.T) {
+		t.Parallel()
+		testSkippedScheduleActionManagerRetention(t, manager)
+	})
+}
+
+func testSkippedScheduleActionManagerReadMissingQueue(t *testing.T, manager persistence.SkippedScheduleActionManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	response, err := manager.ReadSkippedScheduleActions(ctx, &persistence.ReadSkippedScheduleActionsRequest{
+		NamespaceID: "test-namespace",
+		ScheduleID:  "test-schedule-" + t.Name(),
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, response.SkippedActions)
+	assert.Empty(t, response.NextPageToken)
+}
+
+func testSkippedScheduleActionManagerAppendRead(t *testing.T, manager persistence.SkippedScheduleActionManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	scheduleID := "test-schedule-" + t.Name()
+	appendSkippedScheduleActions(ctx, t, manager, scheduleID, 0, 0, 1)
+	appendSkippedScheduleActions(ctx, t, manager, scheduleID, 0, 2)
+	appendSkippedScheduleActions(ctx, t, manager, scheduleID, 0, 3, 4)
+
+	seconds := readSkippedScheduleActionSeconds(ctx, t, manager, scheduleID, 2)
+	assert.Equal(t, []int64{0, 1, 2, 3, 4}, seconds)
+}
+
+func testSkippedScheduleActionManagerRetention(t *testing.T, manager persistence.SkippedScheduleActionManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	scheduleID := "test-schedule-" + t.Name()
+	for i := range int64(5) {
+		appendSkippedScheduleActions(ctx, t, manager, scheduleID, 2, i)
+	}
+
+	seconds := readSkippedScheduleActionSeconds(ctx, t, manager, scheduleID, 10)
+	assert.Equal(t, []int64{3, 4}, seconds)
+}
+
+func appendSkippedScheduleActions(
+	ctx context.Context,
+	t *testing.T,
+	manager persistence.SkippedScheduleActionManager,
+	scheduleID string,
+	maxBatches int,
+	seconds ...int64,
+) {
+	var skippedActions []*schedulespb.SkippedAction
+	for _, s := range seconds {
+		skippedActions = append(skippedActions, &schedulespb.SkippedAction{
+			NominalTime: timestamppb.New(time.Unix(s, 0)),
+			Reason:      enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP,
+		})
+	}
+	err := manager.AppendSkippedScheduleActions(ctx, &persistence.AppendSkippedScheduleActionsRequest{
+		NamespaceID:    "test-namespace",
+		ScheduleID:     scheduleID,
+		SkippedActions: skippedActions,
+		MaxBatches:     maxBatches,
+	})
+	require.NoError(t, err)
+}
+
+func readSkippedScheduleActionSeconds(
+	ctx context.Context,
+	t *testing.T,
+	manager persistence.SkippedScheduleActionManager,
+	scheduleID string,
+	pageSize int,
+) []int64 {
+	var seconds []int64
+	var nextPageToken []byte
+	for {
+		response, err := manager.ReadSkippedScheduleActions(ctx, &persistence.ReadSkippedScheduleActionsRequest{
+			NamespaceID:   "test-namespace",
+			ScheduleID:    scheduleID,
+			PageSize:      pageSize,
+			NextPageToken: nextPageToken,
+		})
+		require.NoError(t, err)
+		for _, skippedAction := range response.SkippedActions {
+			seconds = append(seconds, skippedAction.GetNominalTime().GetSeconds())
+		}
+		if nextPageToken = response.NextPageToken; len(nextPageToken) == 0 {
+			return seconds
+		}
+	}
+}
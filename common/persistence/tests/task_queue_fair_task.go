@@ -152,6 +152,44 @@ func (s *TaskQueueFairTaskSuite) TestCreateDelete_Range() {
 	s.Nil(resp.NextPageToken)
 }
 
+func (s *TaskQueueFairTaskSuite) TestCreateDelete_Specific() {
+	rangeID := rand.Int63()
+	taskQueue := s.createTaskQueue(rangeID)
+
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for pass := int64(1); pass <= 5; pass++ {
+		tasks = append(tasks, s.randomTask(pass, pass))
+	}
+	_, err := s.taskManager.CreateTasks(s.ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{RangeID: rangeID, Data: taskQueue},
+		Tasks:         tasks,
+	})
+	s.NoError(err)
+
+	err = s.taskManager.CompleteTasks(s.ctx, &p.CompleteTasksRequest{
+		NamespaceID:   s.namespaceID,
+		TaskQueueName: s.taskQueueName,
+		TaskType:      s.taskQueueType,
+		Tasks:         []*persistencespb.AllocatedTaskInfo{tasks[1], tasks[3]},
+	})
+	s.NoError(err)
+
+	resp, err := s.taskManager.GetTasks(s.ctx, &p.GetTasksRequest{
+		NamespaceID:        s.namespaceID,
+		TaskQueue:          s.taskQueueName,
+		TaskType:           s.taskQueueType,
+		InclusiveMinPass:   1,
+		InclusiveMinTaskID: 0,
+		ExclusiveMaxTaskID: math.MaxInt64,
+		PageSize:           10,
+	})
+	s.NoError(err)
+
+	expected := []*persistencespb.AllocatedTaskInfo{tasks[0], tasks[2], tasks[4]}
+	protorequire.ProtoSliceEqual(s.T(), expected, resp.Tasks)
+	s.Nil(resp.NextPageToken)
+}
+
 func (s *TaskQueueFairTaskSuite) createTaskQueue(rangeID int64) *persistencespb.TaskQueueInfo {
 	taskQueueKind := enumspb.TaskQueueKind(rand.Int31n(int32(len(enumspb.TaskQueueKind_name)) + 1))
 	taskQueue := s.randomTaskQueueInfo(taskQueueKind)
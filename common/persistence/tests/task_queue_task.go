@@ -233,6 +233,46 @@ func (s *TaskQueueTaskSuite) TestCreateDelete_Multiple() {
 	s.Nil(resp.NextPageToken)
 }
 
+func (s *TaskQueueTaskSuite) TestCreateDelete_Specific() {
+	rangeID := rand.Int63()
+	taskQueue := s.createTaskQueue(rangeID)
+
+	minTaskID := rand.Int63()
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for i := int64(0); i < 5; i++ {
+		tasks = append(tasks, s.randomTask(minTaskID+i))
+	}
+	_, err := s.taskManager.CreateTasks(s.ctx, &p.CreateTasksRequest{
+		TaskQueueInfo: &p.PersistedTaskQueueInfo{
+			RangeID: rangeID,
+			Data:    taskQueue,
+		},
+		Tasks: tasks,
+	})
+	s.NoError(err)
+
+	err = s.taskManager.CompleteTasks(s.ctx, &p.CompleteTasksRequest{
+		NamespaceID:   s.namespaceID,
+		TaskQueueName: s.taskQueueName,
+		TaskType:      s.taskQueueType,
+		Tasks:         []*persistencespb.AllocatedTaskInfo{tasks[1], tasks[3]},
+	})
+	s.NoError(err)
+
+	resp, err := s.taskManager.GetTasks(s.ctx, &p.GetTasksRequest{
+		NamespaceID:        s.namespaceID,
+		TaskQueue:          s.taskQueueName,
+		TaskType:           s.taskQueueType,
+		InclusiveMinTaskID: minTaskID,
+		ExclusiveMaxTaskID: minTaskID + 5,
+		PageSize:           100,
+		NextPageToken:      nil,
+	})
+	s.NoError(err)
+	protorequire.ProtoSliceEqual(s.T(), []*persistencespb.AllocatedTaskInfo{tasks[0], tasks[2], tasks[4]}, resp.Tasks)
+	s.Nil(resp.NextPageToken)
+}
+
 func (s *TaskQueueTaskSuite) createTaskQueue(
 	rangeID int64,
 ) *persistencespb.TaskQueueInfo {
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/debug"
@@ -78,6 +79,7 @@ func (s *VisibilityPersistenceSuite) SetupSuite() {
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+		dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		metrics.NoopMetricsHandler,
 		s.Logger,
 	)
@@ -1050,6 +1052,190 @@ func (s *VisibilityPersistenceSuite) TestCountGroupByWorkflowExecutions() {
 	s.Equal(int64(5), resp.Count)
 }
 
+func (s *VisibilityPersistenceSuite) TestAggregateWorkflowExecutions() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	closeTime := time.Now().UTC()
+
+	for i := 1; i <= 4; i++ {
+		startTime := closeTime.Add(-time.Duration(i) * time.Second)
+		startReq := s.createOpenWorkflowRecord(
+			testNamespaceUUID,
+			"visibility-workflow-test",
+			"visibility-workflow",
+			startTime,
+			startTime,
+			"test-queue",
+		)
+		s.createClosedWorkflowRecord(startReq, closeTime, enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	}
+	// Open workflows don't have an execution duration and are ignored.
+	s.createOpenWorkflowRecord(
+		testNamespaceUUID,
+		"visibility-workflow-test",
+		"visibility-workflow",
+		closeTime,
+		closeTime,
+		"test-queue",
+	)
+
+	resp, err := s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "WorkflowType = 'visibility-workflow'",
+			FieldName:   searchattribute.ExecutionDuration,
+			Percentiles: []float64{0, 25, 50, 62.5, 100},
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(4), resp.Count)
+	s.InDelta(float64(10*time.Second), resp.Sum, 1)
+	s.InDelta(float64(2500*time.Millisecond), resp.Avg, 1)
+	s.InDelta(float64(time.Second), resp.Min, 1)
+	s.InDelta(float64(4*time.Second), resp.Max, 1)
+	s.Len(resp.PercentileValues, 5)
+	s.InDelta(float64(time.Second), resp.PercentileValues[0], 1)
+	s.InDelta(float64(time.Second), resp.PercentileValues[1], 1)
+	s.InDelta(float64(2*time.Second), resp.PercentileValues[2], 1)
+	// the rank of 62.5% of 4 values is rounded up to 3
+	s.InDelta(float64(3*time.Second), resp.PercentileValues[3], 1)
+	s.InDelta(float64(4*time.Second), resp.PercentileValues[4], 1)
+
+	resp, err = s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			Query:       "WorkflowType = 'unknown-workflow'",
+			FieldName:   searchattribute.ExecutionDuration,
+			Percentiles: []float64{50},
+		},
+	)
+	s.NoError(err)
+	s.Equal(int64(0), resp.Count)
+	s.Equal([]float64{0}, resp.PercentileValues)
+
+	_, err = s.VisibilityMgr.AggregateWorkflowExecutions(
+		s.ctx,
+		&manager.AggregateWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			FieldName:   searchattribute.WorkflowType,
+		},
+	)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *VisibilityPersistenceSuite) TestExplainWorkflowExecutions() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+
+	resp, err := s.VisibilityMgr.ExplainWorkflowExecutions(
+		s.ctx,
+		&manager.ExplainWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			PageSize:    10,
+			Query:       "WorkflowType = 'visibility-workflow'",
+		},
+	)
+	s.NoError(err)
+	s.Contains(resp.StoreQuery, testNamespaceUUID.String())
+	s.Contains(resp.StoreQuery, "visibility-workflow")
+
+	_, err = s.VisibilityMgr.ExplainWorkflowExecutions(
+		s.ctx,
+		&manager.ExplainWorkflowExecutionsRequest{
+			NamespaceID: testNamespaceUUID,
+			PageSize:    10,
+			Query:       "invalid query",
+		},
+	)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
+func (s *VisibilityPersistenceSuite) TestMigrateSearchAttributeValues() {
+	testNamespaceUUID := namespace.ID(uuid.New())
+	startTime := time.Now().UTC()
+
+	for i, value := range []string{"1", "2", "x"} {
+		s.taskID++
+		keyword, err := searchattribute.EncodeValue(value, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+		s.NoError(err)
+		err = s.VisibilityMgr.RecordWorkflowExecutionStarted(s.ctx, &manager.RecordWorkflowExecutionStartedRequest{
+			VisibilityRequestBase: &manager.VisibilityRequestBase{
+				NamespaceID: testNamespaceUUID,
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: fmt.Sprintf("visibility-workflow-test-%d", i),
+					RunId:      uuid.New(),
+				},
+				WorkflowTypeName: "visibility-workflow",
+				StartTime:        startTime.Add(-time.Duration(i) * time.Second),
+				ExecutionTime:    startTime.Add(-time.Duration(i) * time.Second),
+				Status:           enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				TaskID:           s.taskID,
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{"Keyword01": keyword}},
+			},
+		})
+		s.NoError(err)
+	}
+	// Executions without a value are not migrated.
+	s.createOpenWorkflowRecord(testNamespaceUUID, "visibility-workflow-test", "visibility-workflow", startTime, startTime, "test-queue")
+
+	request := &manager.MigrateSearchAttributeValuesRequest{
+		NamespaceID: testNamespaceUUID,
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		DryRun:      true,
+		PageSize:    10,
+	}
+	resp, err := s.VisibilityMgr.MigrateSearchAttributeValues(s.ctx, request)
+	s.NoError(err)
+	s.Equal(int64(3), resp.Processed)
+	s.Len(resp.InvalidValues, 1)
+	s.Equal("visibility-workflow-test-2", resp.InvalidValues[0].WorkflowID)
+	s.Equal("x", resp.InvalidValues[0].Value)
+	s.Nil(resp.NextPageToken)
+
+	request.DryRun = false
+	request.TargetField = "Int01"
+	request.PageSize = 2
+	var processed int64
+	for {
+		resp, err = s.VisibilityMgr.MigrateSearchAttributeValues(s.ctx, request)
+		s.NoError(err)
+		processed += resp.Processed
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	s.Equal(int64(3), processed)
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(s.ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       "Int01 IS NOT NULL",
+	})
+	s.NoError(err)
+	s.Equal(int64(2), countResp.Count)
+	countResp, err = s.VisibilityMgr.CountWorkflowExecutions(s.ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       "Int01 = 2 AND Keyword01 = '2'",
+	})
+	s.NoError(err)
+	s.Equal(int64(1), countResp.Count)
+
+	_, err = s.VisibilityMgr.MigrateSearchAttributeValues(s.ctx, &manager.MigrateSearchAttributeValuesRequest{
+		NamespaceID: testNamespaceUUID,
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		TargetField: "Int01",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    10,
+	})
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
 func (s *VisibilityPersistenceSuite) listWithPagination(namespaceID namespace.ID, pageSize int) []*workflowpb.WorkflowExecutionInfo {
 	var executions []*workflowpb.WorkflowExecutionInfo
 	resp, err := s.VisibilityMgr.ListWorkflowExecutions(s.ctx, &manager.ListWorkflowExecutionsRequestV2{
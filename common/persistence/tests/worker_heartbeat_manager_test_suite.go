@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/server/common/persistence"
+)
+
+// RunWorkerHeartbeatManagerTestSuite runs all tests for the worker heartbeat manager against a given queue provided by
+// a particular database.
+func RunWorkerHeartbeatManagerTestSuite(t *testing.T, queue persistence.QueueV2) {
+	manager := persistence.NewWorkerHeartbeatManager(queue)
+	t.Run("ReadMissingQueue", func(t *testing.T) {
+		t.Parallel()
+		testWorkerHeartbeatManagerReadMissingQueue(t, manager)
+	})
+	t.Run("AppendReadDelete", func(t *testing.T) {
+		t.Parallel()
+		testWorkerHeartbeatManagerAppendReadDelete(t, manager)
+	})
+}
+
+func testWorkerHeartbeatManagerReadMissingQueue(t *testing.T, manager persistence.WorkerHeartbeatManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	response, err := manager.ReadWorkerHeartbeats(ctx, &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID: "test-namespace-" + t.Name(),
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, response.Messages)
+	assert.Empty(t, response.NextPageToken)
+}
+
+func testWorkerHeartbeatManagerAppendReadDelete(t *testing.T, manager persistence.WorkerHeartbeatManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nsID := "test-namespace-" + t.Name()
+	var ids []int64
+	for _, keys := range [][]string{{"worker-1", "worker-2"}, {"worker-1"}, {"worker-3"}} {
+		var heartbeats []*workerpb.WorkerHeartbeat
+		for _, key := range keys {
+			heartbeats = append(heartbeats, &workerpb.WorkerHeartbeat{WorkerInstanceKey: key})
+		}
+		response, err := manager.AppendWorkerHeartbeats(ctx, &persistence.AppendWorkerHeartbeatsRequest{
+			NamespaceID: nsID,
+			Heartbeats:  heartbeats,
+		})
+		require.NoError(t, err)
+		ids = append(ids, response.Metadata.ID)
+	}
+
+	var messages []persistence.WorkerHeartbeatsMessage
+	var nextPageToken []byte
+	for {
+		response, err := manager.ReadWorkerHeartbeats(ctx, &persistence.ReadWorkerHeartbeatsRequest{
+			NamespaceID:   nsID,
+			PageSize:      2,
+			NextPageToken: nextPageToken,
+		})
+		require.NoError(t, err)
+		messages = append(messages, response.Messages...)
+		if nextPageToken = response.NextPageToken; len(nextPageToken) == 0 {
+			break
+		}
+	}
+	require.Len(t, messages, 3)
+	for i, message := range messages {
+		assert.Equal(t, ids[i], message.MessageMetadata.ID)
+	}
+	require.Len(t, messages[0].Heartbeats, 2)
+	assert.Equal(t, "worker-2", messages[0].Heartbeats[1].GetWorkerInstanceKey())
+	assert.Equal(t, "worker-3", messages[2].Heartbeats[0].GetWorkerInstanceKey())
+
+	err := manager.DeleteWorkerHeartbeats(ctx, &persistence.DeleteWorkerHeartbeatsRequest{
+		NamespaceID:                 nsID,
+		InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: ids[1]},
+	})
+	require.NoError(t, err)
+	response, err := manager.ReadWorkerHeartbeats(ctx, &persistence.ReadWorkerHeartbeatsRequest{
+		NamespaceID: nsID,
+		PageSize:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Messages, 1)
+	assert.Equal(t, ids[2], response.Messages[0].MessageMetadata.ID)
+}
@@ -1,6 +1,7 @@
 package visibility
 
 import (
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/postgresql"
@@ -33,3 +34,16 @@ func AllowListForValidation(
 		return allowList
 	}
 }
+
+// HasRecord returns true if executions in the given state have a visibility record. Zombie, void and corrupted runs
+// never get one.
+func HasRecord(state enumsspb.WorkflowExecutionState) bool {
+	switch state {
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,125 @@
+package visibility
+
+import (
+	"context"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+const (
+	closeFailureTypeTerminated = "Terminated"
+	closeFailureTypeTimeout    = "Timeout"
+)
+
+// AddMemoText indexes the values of the given memo fields in the TemporalMemoText search attribute. String values are
+// indexed as is, other JSON values by their JSON text. Values with other encodings, e.g. encrypted ones, are ignored.
+func AddMemoText(request *manager.VisibilityRequestBase, fields []string, maxBytes int) {
+	if len(fields) == 0 || len(request.Memo.GetFields()) == 0 {
+		return
+	}
+	var values []string
+	for _, field := range fields {
+		p, ok := request.Memo.GetFields()[field]
+		if !ok || string(p.GetMetadata()[converter.MetadataEncoding]) != converter.MetadataEncodingJSON {
+			continue
+		}
+		var value string
+		if err := payload.Decode(p, &value); err != nil {
+			value = string(p.GetData())
+		}
+		values = append(values, value)
+	}
+	text := searchattribute.NormalizeFullText(strings.Join(values, " "), maxBytes)
+	setFullTextSearchAttribute(request, searchattribute.TemporalMemoText, text)
+}
+
+// AddCloseFailure indexes the failure of failed, terminated and timed out workflows in the
+// TemporalCloseFailureMessage and TemporalCloseFailureType search attributes. The completion event is only loaded for
+// failed and terminated workflows.
+func AddCloseFailure(
+	ctx context.Context,
+	request *manager.VisibilityRequestBase,
+	getCompletionEvent func(context.Context) (*historypb.HistoryEvent, error),
+	maxBytes int,
+) error {
+	var message, failureType string
+	switch request.Status {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_FAILED:
+		event, err := getCompletionEvent(ctx)
+		if err != nil {
+			return err
+		}
+		failure := event.GetWorkflowExecutionFailedEventAttributes().GetFailure()
+		failureType = getFailureType(failure)
+		var messages []string
+		for ; failure != nil; failure = failure.GetCause() {
+			messages = append(messages, failure.GetMessage())
+		}
+		message = strings.Join(messages, " ")
+	case enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED:
+		event, err := getCompletionEvent(ctx)
+		if err != nil {
+			return err
+		}
+		failureType = closeFailureTypeTerminated
+		message = event.GetWorkflowExecutionTerminatedEventAttributes().GetReason()
+	case enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
+		failureType = closeFailureTypeTimeout
+	default:
+		return nil
+	}
+
+	setFullTextSearchAttribute(request, searchattribute.TemporalCloseFailureType, failureType)
+	setFullTextSearchAttribute(
+		request,
+		searchattribute.TemporalCloseFailureMessage,
+		searchattribute.NormalizeFullText(message, maxBytes),
+	)
+	return nil
+}
+
+// getFailureType returns the application defined type of the failure, or the kind of failure otherwise.
+func getFailureType(failure *failurepb.Failure) string {
+	switch info := failure.GetFailureInfo().(type) {
+	case *failurepb.Failure_ApplicationFailureInfo:
+		if info.ApplicationFailureInfo.GetType() != "" {
+			return info.ApplicationFailureInfo.GetType()
+		}
+		return "ApplicationFailure"
+	case *failurepb.Failure_TimeoutFailureInfo:
+		return closeFailureTypeTimeout
+	case *failurepb.Failure_CanceledFailureInfo:
+		return "Canceled"
+	case *failurepb.Failure_TerminatedFailureInfo:
+		return closeFailureTypeTerminated
+	case *failurepb.Failure_ServerFailureInfo:
+		return "ServerFailure"
+	case *failurepb.Failure_ActivityFailureInfo:
+		return "ActivityFailure"
+	case *failurepb.Failure_ChildWorkflowExecutionFailureInfo:
+		return "ChildWorkflowExecutionFailure"
+	default:
+		return ""
+	}
+}
+
+func setFullTextSearchAttribute(request *manager.VisibilityRequestBase, name string, value string) {
+	if value == "" {
+		return
+	}
+	if request.SearchAttributes == nil {
+		request.SearchAttributes = &commonpb.SearchAttributes{}
+	}
+	if request.SearchAttributes.IndexedFields == nil {
+		request.SearchAttributes.IndexedFields = make(map[string]*commonpb.Payload)
+	}
+	request.SearchAttributes.IndexedFields[name] = payload.EncodeString(value)
+}
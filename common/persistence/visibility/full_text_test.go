@@ -0,0 +1,126 @@
+package visibility
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+func TestGetFailureType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "PaymentError", getFailureType(&failurepb.Failure{
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Type: "PaymentError",
+		}},
+	}))
+	assert.Equal(t, "ApplicationFailure", getFailureType(&failurepb.Failure{
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{}},
+	}))
+	assert.Equal(t, "Timeout", getFailureType(&failurepb.Failure{
+		FailureInfo: &failurepb.Failure_TimeoutFailureInfo{TimeoutFailureInfo: &failurepb.TimeoutFailureInfo{}},
+	}))
+	assert.Equal(t, "", getFailureType(nil))
+}
+
+func TestAddCloseFailure(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name            string
+		status          enumspb.WorkflowExecutionStatus
+		completionEvent *historypb.HistoryEvent
+		expectedType    string
+		expectedMessage string
+	}{
+		{
+			name:   "failed",
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			completionEvent: &historypb.HistoryEvent{
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionFailedEventAttributes{
+					WorkflowExecutionFailedEventAttributes: &historypb.WorkflowExecutionFailedEventAttributes{
+						Failure: &failurepb.Failure{
+							Message: "Payment declined",
+							FailureInfo: &failurepb.Failure_ApplicationFailureInfo{
+								ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{Type: "PaymentError"},
+							},
+							Cause: &failurepb.Failure{Message: "card expired"},
+						},
+					},
+				},
+			},
+			expectedType:    "PaymentError",
+			expectedMessage: "payment declined card expired",
+		},
+		{
+			name:   "terminated",
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+			completionEvent: &historypb.HistoryEvent{
+				Attributes: &historypb.HistoryEvent_WorkflowExecutionTerminatedEventAttributes{
+					WorkflowExecutionTerminatedEventAttributes: &historypb.WorkflowExecutionTerminatedEventAttributes{
+						Reason: "Stuck!",
+					},
+				},
+			},
+			expectedType:    "Terminated",
+			expectedMessage: "stuck",
+		},
+		{
+			name:         "timed_out",
+			status:       enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+			expectedType: "Timeout",
+		},
+		{
+			name:   "completed",
+			status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &manager.VisibilityRequestBase{Status: tc.status}
+			err := AddCloseFailure(context.Background(), request, func(context.Context) (*historypb.HistoryEvent, error) {
+				require.NotNil(t, tc.completionEvent, "the completion event is not needed")
+				return tc.completionEvent, nil
+			}, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedType, decodeString(t, request, searchattribute.TemporalCloseFailureType))
+			assert.Equal(t, tc.expectedMessage, decodeString(t, request, searchattribute.TemporalCloseFailureMessage))
+		})
+	}
+}
+
+func TestAddMemoText(t *testing.T) {
+	t.Parallel()
+
+	encrypted := payload.EncodeString("secret")
+	encrypted.Metadata["encoding"] = []byte("binary/encrypted")
+	request := &manager.VisibilityRequestBase{
+		Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			"customer": payload.EncodeString("ACME Corp."),
+			"order":    payload.EncodeString("order-1"),
+			"secret":   encrypted,
+		}},
+	}
+	AddMemoText(request, []string{"customer", "secret", "missing"}, 0)
+	assert.Equal(t, "acme corp", decodeString(t, request, searchattribute.TemporalMemoText))
+}
+
+func decodeString(t *testing.T, request *manager.VisibilityRequestBase, name string) string {
+	p, ok := request.SearchAttributes.GetIndexedFields()[name]
+	if !ok {
+		return ""
+	}
+	var value string
+	require.NoError(t, payload.Decode(p, &value))
+	return value
+}
@@ -35,10 +35,13 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error)
+		ExplainWorkflowExecutions(ctx context.Context, request *ExplainWorkflowExecutionsRequest) (*ExplainWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error)
 
 		// Admin APIs
 		AddSearchAttributes(ctx context.Context, request *AddSearchAttributesRequest) error
+		MigrateSearchAttributeValues(ctx context.Context, request *MigrateSearchAttributeValuesRequest) (*MigrateSearchAttributeValuesResponse, error)
 	}
 
 	VisibilityRequestBase struct {
@@ -124,6 +127,85 @@ type (
 		Groups []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup
 	}
 
+	// AggregateWorkflowExecutionsRequest is request from AggregateWorkflowExecutions
+	AggregateWorkflowExecutionsRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		Query       string
+		// Search attribute to aggregate, of type Int or Double. Executions without a value are ignored.
+		FieldName string
+		// Percentiles to compute, between 0 and 100.
+		Percentiles []float64
+	}
+
+	// AggregateWorkflowExecutionsResponse is response to AggregateWorkflowExecutions.
+	// All values are zero if Count is zero.
+	AggregateWorkflowExecutionsResponse struct {
+		Count int64 // number of executions matching the query with a value for the field
+		Sum   float64
+		Avg   float64
+		Min   float64
+		Max   float64
+		// Values of the requested percentiles, in the same order. They might be approximate, depending on the store.
+		PercentileValues []float64
+	}
+
+	// ExplainWorkflowExecutionsRequest is request from ExplainWorkflowExecutions
+	ExplainWorkflowExecutionsRequest struct {
+		NamespaceID namespace.ID
+		Namespace   namespace.Name // namespace.Name is not persisted.
+		PageSize    int
+		Query       string
+	}
+
+	// ExplainWorkflowExecutionsResponse is response to ExplainWorkflowExecutions.
+	ExplainWorkflowExecutionsResponse struct {
+		// Query the visibility query translates to in the store: the body of the Elasticsearch search request as
+		// JSON, or the SQL statement followed by its arguments.
+		StoreQuery string
+		// Estimated cost of the query and what contributes to it. Only Elasticsearch estimates the cost of queries, the
+		// cost fields are always empty for SQL stores.
+		Cost        int64
+		CostReasons []string
+		// Maximum cost of queries of the namespace, 0 if not limited.
+		CostLimit int64
+	}
+
+	// MigrateSearchAttributeValuesRequest is request to MigrateSearchAttributeValues. It reads a page of executions
+	// that have a value for SourceField and copies the value, converted to TargetType, to TargetField. Values of
+	// TargetField are not overwritten, and executions that were updated concurrently are skipped.
+	MigrateSearchAttributeValuesRequest struct {
+		// Name of the visibility store to migrate. Required if a secondary visibility store is configured.
+		StoreName   string
+		NamespaceID namespace.ID // Empty for all namespaces, only supported by stores without per namespace aliases.
+		Namespace   namespace.Name
+		SourceField string
+		SourceType  enumspb.IndexedValueType
+		// TargetField is not required if DryRun is set.
+		TargetField string
+		TargetType  enumspb.IndexedValueType
+		// DryRun only validates that the values can be converted.
+		DryRun        bool
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// MigrateSearchAttributeValuesResponse is response to MigrateSearchAttributeValues.
+	MigrateSearchAttributeValuesResponse struct {
+		// Number of executions of the page.
+		Processed int64
+		// Values that can't be converted to TargetType. They are not copied.
+		InvalidValues []InvalidSearchAttributeValue
+		NextPageToken []byte
+	}
+
+	InvalidSearchAttributeValue struct {
+		WorkflowID string
+		RunID      string
+		Value      any
+		Error      string
+	}
+
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
 	VisibilityDeleteWorkflowExecutionRequest struct {
 		NamespaceID namespace.ID
@@ -55,6 +55,21 @@ func (mr *MockVisibilityManagerMockRecorder) AddSearchAttributes(ctx, request an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockVisibilityManager)(nil).AddSearchAttributes), ctx, request)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockVisibilityManager) AggregateWorkflowExecutions(ctx context.Context, request *AggregateWorkflowExecutionsRequest) (*AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockVisibilityManagerMockRecorder) AggregateWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).AggregateWorkflowExecutions), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityManager) Close() {
 	m.ctrl.T.Helper()
@@ -96,6 +111,21 @@ func (mr *MockVisibilityManagerMockRecorder) DeleteWorkflowExecution(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockVisibilityManager)(nil).DeleteWorkflowExecution), ctx, request)
 }
 
+// ExplainWorkflowExecutions mocks base method.
+func (m *MockVisibilityManager) ExplainWorkflowExecutions(ctx context.Context, request *ExplainWorkflowExecutionsRequest) (*ExplainWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*ExplainWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainWorkflowExecutions indicates an expected call of ExplainWorkflowExecutions.
+func (mr *MockVisibilityManagerMockRecorder) ExplainWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).ExplainWorkflowExecutions), ctx, request)
+}
+
 // GetIndexName mocks base method.
 func (m *MockVisibilityManager) GetIndexName() string {
 	m.ctrl.T.Helper()
@@ -182,6 +212,21 @@ func (mr *MockVisibilityManagerMockRecorder) ListWorkflowExecutions(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockVisibilityManager)(nil).ListWorkflowExecutions), ctx, request)
 }
 
+// MigrateSearchAttributeValues mocks base method.
+func (m *MockVisibilityManager) MigrateSearchAttributeValues(ctx context.Context, request *MigrateSearchAttributeValuesRequest) (*MigrateSearchAttributeValuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSearchAttributeValues", ctx, request)
+	ret0, _ := ret[0].(*MigrateSearchAttributeValuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSearchAttributeValues indicates an expected call of MigrateSearchAttributeValues.
+func (mr *MockVisibilityManagerMockRecorder) MigrateSearchAttributeValues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSearchAttributeValues", reflect.TypeOf((*MockVisibilityManager)(nil).MigrateSearchAttributeValues), ctx, request)
+}
+
 // RecordWorkflowExecutionClosed mocks base method.
 func (m *MockVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *RecordWorkflowExecutionClosedRequest) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+)
+
+func TestNewAwsHttpClient_Disabled(t *testing.T) {
+	httpClient, err := NewAwsHttpClient(ESAWSRequestSigningConfig{})
+	require.NoError(t, err)
+	require.Nil(t, httpClient)
+}
+
+func TestNewAwsHttpClient_UnknownCredentialProvider(t *testing.T) {
+	_, err := NewAwsHttpClient(ESAWSRequestSigningConfig{
+		Enabled:            true,
+		Region:             "us-east-1",
+		CredentialProvider: "unknown",
+	})
+	require.Error(t, err)
+}
+
+// TestNewAwsHttpClient_SignsClientRequests sends requests with the Elasticsearch and OpenSearch clients to a stub that
+// verifies the AWS V4 signature the same way Amazon OpenSearch Service does, by signing the request again.
+func TestNewAwsHttpClient_SignsClientRequests(t *testing.T) {
+	creds := credentials.NewStaticCredentials("key", "secret", "")
+	for _, version := range []string{"v7", VersionOpenSearch2} {
+		t.Run(version, func(t *testing.T) {
+			var signatureErr error
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				signatureErr = verifyAwsSignature(r, creds, "us-east-1")
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"count":3}`))
+			}))
+			defer server.Close()
+			u, err := url.Parse(server.URL)
+			require.NoError(t, err)
+
+			httpClient, err := NewAwsHttpClient(ESAWSRequestSigningConfig{
+				Enabled:            true,
+				Region:             "us-east-1",
+				CredentialProvider: "static",
+				Static: ESAWSStaticCredentialProvider{
+					AccessKeyID:     "key",
+					SecretAccessKey: "secret",
+				},
+			})
+			require.NoError(t, err)
+			esClient, err := NewClient(&Config{Version: version, URL: *u}, httpClient, log.NewNoopLogger())
+			require.NoError(t, err)
+
+			count, err := esClient.Count(context.Background(), "temporal_visibility_v1", elastic.NewTermQuery("NamespaceId", "my-namespace-id"))
+			require.NoError(t, err)
+			require.EqualValues(t, 3, count)
+			require.NoError(t, signatureErr)
+		})
+	}
+}
+
+// verifyAwsSignature signs a copy of the request with the headers listed in its signature and compares the result.
+func verifyAwsSignature(r *http.Request, creds *credentials.Credentials, region string) error {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 ") {
+		return fmt.Errorf("request is not signed: %q", authorization)
+	}
+	signTime, err := time.Parse("20060102T150405Z", r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		return err
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	expected, err := http.NewRequest(r.Method, "http://"+r.Host+r.URL.RequestURI(), nil)
+	if err != nil {
+		return err
+	}
+	_, signedHeaders, _ := strings.Cut(authorization, "SignedHeaders=")
+	signedHeaders, _, _ = strings.Cut(signedHeaders, ",")
+	for _, name := range strings.Split(signedHeaders, ";") {
+		if name != "host" && name != "x-amz-date" {
+			expected.Header[http.CanonicalHeaderKey(name)] = r.Header.Values(name)
+		}
+	}
+	if _, err := v4.NewSigner(creds).Sign(expected, bytes.NewReader(body), "es", region, signTime); err != nil {
+		return err
+	}
+	if expected.Header.Get("Authorization") != authorization {
+		return fmt.Errorf("signature mismatch: expected %q, got %q", expected.Header.Get("Authorization"), authorization)
+	}
+	return nil
+}
@@ -37,6 +37,16 @@ type (
 		Index       string
 		ID          string
 		Version     int64
+		// VersionType is the Elasticsearch version type of the request. Empty means external, i.e. the request is
+		// only applied if Version is greater than the version of the document.
+		VersionType string
 		Doc         map[string]interface{}
 	}
 )
+
+func (r *BulkableRequest) versionType() string {
+	if r.VersionType == "" {
+		return versionTypeExternal
+	}
+	return r.VersionType
+}
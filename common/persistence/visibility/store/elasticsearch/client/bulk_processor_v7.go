@@ -51,7 +51,7 @@ func (p *bulkProcessorImpl) Add(request *BulkableRequest) {
 		bulkIndexRequest := elastic.NewBulkIndexRequest().
 			Index(request.Index).
 			Id(request.ID).
-			VersionType(versionTypeExternal).
+			VersionType(request.versionType()).
 			Version(request.Version).
 			Doc(request.Doc)
 		p.esBulkProcessor.Add(bulkIndexRequest)
@@ -59,7 +59,7 @@ func (p *bulkProcessorImpl) Add(request *BulkableRequest) {
 		bulkDeleteRequest := elastic.NewBulkDeleteRequest().
 			Index(request.Index).
 			Id(request.ID).
-			VersionType(versionTypeExternal).
+			VersionType(request.versionType()).
 			Version(request.Version)
 		p.esBulkProcessor.Add(bulkDeleteRequest)
 	}
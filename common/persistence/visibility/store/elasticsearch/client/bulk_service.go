@@ -1,12 +1,16 @@
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination bulk_service_mock.go
+
 package client
 
 import (
 	"context"
+
+	"github.com/olivere/elastic/v7"
 )
 
 type (
 	BulkService interface {
-		Do(ctx context.Context) error
+		Do(ctx context.Context) (*elastic.BulkResponse, error)
 		NumberOfActions() int
 		Add(request *BulkableRequest)
 	}
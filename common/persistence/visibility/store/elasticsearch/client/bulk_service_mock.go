@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: bulk_service.go
+//
+// Generated by this command:
+//
+//	mockgen -package client -source bulk_service.go -destination bulk_service_mock.go
+//
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	elastic "github.com/olivere/elastic/v7"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBulkService is a mock of BulkService interface.
+type MockBulkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBulkServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockBulkServiceMockRecorder is the mock recorder for MockBulkService.
+type MockBulkServiceMockRecorder struct {
+	mock *MockBulkService
+}
+
+// NewMockBulkService creates a new mock instance.
+func NewMockBulkService(ctrl *gomock.Controller) *MockBulkService {
+	mock := &MockBulkService{ctrl: ctrl}
+	mock.recorder = &MockBulkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBulkService) EXPECT() *MockBulkServiceMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockBulkService) Add(request *BulkableRequest) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Add", request)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockBulkServiceMockRecorder) Add(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockBulkService)(nil).Add), request)
+}
+
+// Do mocks base method.
+func (m *MockBulkService) Do(ctx context.Context) (*elastic.BulkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx)
+	ret0, _ := ret[0].(*elastic.BulkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockBulkServiceMockRecorder) Do(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockBulkService)(nil).Do), ctx)
+}
+
+// NumberOfActions mocks base method.
+func (m *MockBulkService) NumberOfActions() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NumberOfActions")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// NumberOfActions indicates an expected call of NumberOfActions.
+func (mr *MockBulkServiceMockRecorder) NumberOfActions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NumberOfActions", reflect.TypeOf((*MockBulkService)(nil).NumberOfActions))
+}
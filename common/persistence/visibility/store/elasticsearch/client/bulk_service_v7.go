@@ -18,9 +18,8 @@ func newBulkService(esBulkService *elastic.BulkService) *bulkServiceImpl {
 	}
 }
 
-func (b *bulkServiceImpl) Do(ctx context.Context) error {
-	_, err := b.esBulkService.Do(ctx)
-	return err
+func (b *bulkServiceImpl) Do(ctx context.Context) (*elastic.BulkResponse, error) {
+	return b.esBulkService.Do(ctx)
 }
 
 func (b *bulkServiceImpl) NumberOfActions() int {
@@ -33,7 +32,7 @@ func (b *bulkServiceImpl) Add(request *BulkableRequest) {
 		bulkDeleteRequest := elastic.NewBulkIndexRequest().
 			Index(request.Index).
 			Id(request.ID).
-			VersionType(versionTypeExternal).
+			VersionType(request.versionType()).
 			Version(request.Version).
 			Doc(request.Doc)
 		b.esBulkService.Add(bulkDeleteRequest)
@@ -41,7 +40,7 @@ func (b *bulkServiceImpl) Add(request *BulkableRequest) {
 		bulkDeleteRequest := elastic.NewBulkDeleteRequest().
 			Index(request.Index).
 			Id(request.ID).
-			VersionType(versionTypeExternal).
+			VersionType(request.versionType()).
 			Version(request.Version)
 		b.esBulkService.Add(bulkDeleteRequest)
 	}
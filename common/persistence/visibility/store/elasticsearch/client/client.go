@@ -11,7 +11,10 @@ import (
 )
 
 const (
-	versionTypeExternal                 = "external"
+	versionTypeExternal = "external"
+	// VersionTypeExternalGTE applies a request if its version is greater than or equal to the version of the document.
+	VersionTypeExternalGTE = "external_gte"
+
 	minimumCloseIdleConnectionsInterval = 15 * time.Second
 )
 
@@ -22,7 +25,9 @@ type (
 		Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error)
 		Count(ctx context.Context, index string, query elastic.Query) (int64, error)
 		CountGroupBy(ctx context.Context, index string, query elastic.Query, aggName string, agg elastic.Aggregation) (*elastic.SearchResult, error)
+		Aggregate(ctx context.Context, index string, query elastic.Query, aggs map[string]elastic.Aggregation) (*elastic.SearchResult, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error)
+		Bulk() BulkService
 
 		// TODO (alex): move this to some admin client (and join with IntegrationTestsClient)
 		PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error)
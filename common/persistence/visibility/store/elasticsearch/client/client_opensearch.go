@@ -0,0 +1,44 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// openSearchClientImpl implements Client for OpenSearch 2.x. OpenSearch is API compatible with
+	// Elasticsearch 7.10 for everything the visibility store uses except for the APIs overridden here.
+	openSearchClientImpl struct {
+		*clientImpl
+	}
+)
+
+var _ Client = (*openSearchClientImpl)(nil)
+
+// newOpenSearchClient create an OpenSearch client
+func newOpenSearchClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*openSearchClientImpl, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &openSearchClientImpl{clientImpl: client}, nil
+}
+
+// IsPointInTimeSupported always returns false. OpenSearch has its own point in time API, but
+// scanning with it requires a unique tiebreaker sort field which OpenSearch 2.x doesn't provide
+// for the _doc sort that is used for scans. Scroll is used instead.
+func (c *openSearchClientImpl) IsPointInTimeSupported(_ context.Context) bool {
+	return false
+}
+
+// IndexPutTemplate creates a composable index template. Legacy index templates are deprecated in
+// OpenSearch 2.x and the templates in schema/opensearch use the composable format.
+func (c *openSearchClientImpl) IndexPutTemplate(ctx context.Context, templateName string, bodyString string) (bool, error) {
+	resp, err := c.esClient.IndexPutIndexTemplate(templateName).BodyString(bodyString).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	return resp.Acknowledged, nil
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+)
+
+func TestNewClient_OpenSearch(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	esClient, err := NewClient(&Config{Version: VersionOpenSearch2, URL: *u}, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &openSearchClientImpl{}, esClient)
+
+	esClient, err = NewClient(&Config{Version: "v7", URL: *u}, nil, log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &clientImpl{}, esClient)
+
+	_, err = NewClient(&Config{Version: "opensearch1", URL: *u}, nil, log.NewNoopLogger())
+	require.Error(t, err)
+}
+
+func TestOpenSearchClient_IsPointInTimeSupported(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":{"distribution":"opensearch","number":"2.11.0"}}`))
+	}))
+	defer server.Close()
+
+	esClient := newTestOpenSearchClient(t, server.URL)
+	require.False(t, esClient.IsPointInTimeSupported(context.Background()))
+	require.Zero(t, requests)
+}
+
+func TestOpenSearchClient_IndexPutTemplate(t *testing.T) {
+	var (
+		method string
+		path   string
+		body   string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		reader := io.Reader(r.Body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gzipReader, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			reader = gzipReader
+		}
+		b, _ := io.ReadAll(reader)
+		body = string(b)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer server.Close()
+
+	esClient := newTestOpenSearchClient(t, server.URL)
+	template := `{"index_patterns":["temporal_visibility_v1*"],"template":{}}`
+	ack, err := esClient.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", template)
+	require.NoError(t, err)
+	require.True(t, ack)
+	require.Equal(t, http.MethodPut, method)
+	require.Equal(t, "/_index_template/temporal_visibility_v1_template", path)
+	require.Equal(t, template, body)
+}
+
+func newTestOpenSearchClient(t *testing.T, serverURL string) IntegrationTestsClient {
+	u, err := url.Parse(serverURL)
+	require.NoError(t, err)
+	esClient, err := NewFunctionalTestsClient(&Config{
+		Version: VersionOpenSearch2,
+		URL:     *u,
+	}, log.NewNoopLogger())
+	require.NoError(t, err)
+	return esClient
+}
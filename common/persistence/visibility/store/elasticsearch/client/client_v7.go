@@ -244,6 +244,22 @@ func (c *clientImpl) CountGroupBy(
 	return c.esClient.Search(index).SearchSource(searchSource).Do(ctx)
 }
 
+func (c *clientImpl) Aggregate(
+	ctx context.Context,
+	index string,
+	query elastic.Query,
+	aggs map[string]elastic.Aggregation,
+) (*elastic.SearchResult, error) {
+	searchSource := elastic.NewSearchSource().
+		Query(query).
+		Size(0).
+		TrackTotalHits(false)
+	for name, agg := range aggs {
+		searchSource.Aggregation(name, agg)
+	}
+	return c.esClient.Search(index).SearchSource(searchSource).Do(ctx)
+}
+
 func (c *clientImpl) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
 	esBulkProcessor, err := c.esClient.BulkProcessor().
 		Name(p.Name).
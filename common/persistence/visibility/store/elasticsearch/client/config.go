@@ -14,6 +14,9 @@ const (
 	// VisibilityAppName is used to find ES indexName for visibility
 	VisibilityAppName          = "visibility"
 	SecondaryVisibilityAppName = "secondary_visibility"
+
+	// VersionOpenSearch2 is the Config.Version that connects to OpenSearch 2.x instead of Elasticsearch.
+	VersionOpenSearch2 = "opensearch2"
 )
 
 // Config for connecting to Elasticsearch
@@ -78,6 +81,11 @@ func (cfg *Config) GetSecondaryVisibilityIndex() string {
 	return cfg.Indices[SecondaryVisibilityAppName]
 }
 
+// IsOpenSearch returns true if the config connects to OpenSearch instead of Elasticsearch.
+func (cfg *Config) IsOpenSearch() bool {
+	return cfg != nil && cfg.Version == VersionOpenSearch2
+}
+
 func (cfg *Config) SetHttpClient(httpClient *http.Client) {
 	cfg.httpClient = httpClient
 }
@@ -12,21 +12,24 @@ import (
 )
 
 var errorCases = map[string]string{
-	"delete":                                 query.MalformedSqlQueryErrMessage,
-	"update x":                               query.MalformedSqlQueryErrMessage,
-	"insert ":                                query.MalformedSqlQueryErrMessage,
-	"insert into a values(1,2)":              query.NotSupportedErrMessage,
-	"update a set id = 1":                    query.NotSupportedErrMessage,
-	"delete from a where id=1":               query.NotSupportedErrMessage,
-	"select * from a where NOT(id=1)":        query.NotSupportedErrMessage,
-	"select * from a where 1 = 1":            query.InvalidExpressionErrMessage,
-	"select * from a where 1=a":              query.InvalidExpressionErrMessage,
-	"select * from a where zz(k=2)":          query.NotSupportedErrMessage,
-	"select * from a group by k, m":          query.NotSupportedErrMessage,
-	"select * from a group by k order by id": query.NotSupportedErrMessage,
-	"select * from a where a like '%a%'":     "operator 'like' not allowed in comparison expression",
-	"select * from a where a not like '%a%'": "operator 'not like' not allowed in comparison expression",
-	"invalid query":                          query.MalformedSqlQueryErrMessage,
+	"delete":                                         query.MalformedSqlQueryErrMessage,
+	"update x":                                       query.MalformedSqlQueryErrMessage,
+	"insert ":                                        query.MalformedSqlQueryErrMessage,
+	"insert into a values(1,2)":                      query.NotSupportedErrMessage,
+	"update a set id = 1":                            query.NotSupportedErrMessage,
+	"delete from a where id=1":                       query.NotSupportedErrMessage,
+	"select * from a where NOT(id=1)":                query.NotSupportedErrMessage,
+	"select * from a where 1 = 1":                    query.InvalidExpressionErrMessage,
+	"select * from a where 1=a":                      query.InvalidExpressionErrMessage,
+	"select * from a where zz(k=2)":                  query.NotSupportedErrMessage,
+	"select * from a group by k, k":                  query.InvalidExpressionErrMessage,
+	"select * from a group by zz('hour', k)":         query.NotSupportedErrMessage,
+	"select * from a group by date_trunc(k)":         query.InvalidExpressionErrMessage,
+	"select * from a group by date_trunc('week', k)": query.InvalidExpressionErrMessage,
+	"select * from a group by k order by id":         query.NotSupportedErrMessage,
+	"select * from a where a like '%a%'":             "operator 'like' not allowed in comparison expression",
+	"select * from a where a not like '%a%'":         "operator 'not like' not allowed in comparison expression",
+	"invalid query":                                  query.MalformedSqlQueryErrMessage,
 	"select * from a where  a= 1 and multi_match(zz=1, query='this is a test', fields=(title,title.origin), type=phrase)": query.NotSupportedErrMessage,
 }
 
@@ -92,15 +95,23 @@ var supportedWhereOrderCases = map[string]struct {
 
 var supportedWhereGroupByCases = map[string]struct {
 	query   string
-	groupBy []string
+	groupBy []query.GroupByField
 }{
 	"group by status": {
 		query:   ``,
-		groupBy: []string{"status"},
+		groupBy: []query.GroupByField{{FieldName: "status"}},
 	},
 	"id = 1 group by status": {
 		query:   `{"bool":{"filter":{"term":{"id":1}}}}`,
-		groupBy: []string{"status"},
+		groupBy: []query.GroupByField{{FieldName: "status"}},
+	},
+	"group by status, channel, date_trunc('Hour', create_time)": {
+		query: ``,
+		groupBy: []query.GroupByField{
+			{FieldName: "status"},
+			{FieldName: "channel"},
+			{FieldName: "create_time", TimeBucket: query.TimeBucketHour},
+		},
 	},
 }
 
@@ -95,12 +95,28 @@ func (ni *nameInterceptor) Name(name string, usage query.FieldNameUsage) (string
 			)
 		}
 	case query.FieldNameGroupBy:
-		if fieldName != searchattribute.ExecutionStatus {
+		if !query.IsGroupByTypeSupported(fieldType) {
 			return "", query.NewConverterError(
-				"'group by' clause is only supported for %s search attribute",
-				searchattribute.ExecutionStatus,
+				"'group by' clause is only supported for search attributes of type %s, %s and %s, or %s with '%s'",
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				enumspb.INDEXED_VALUE_TYPE_BOOL,
+				enumspb.INDEXED_VALUE_TYPE_INT,
+				enumspb.INDEXED_VALUE_TYPE_DATETIME,
+				query.TimeBucketFuncName,
 			)
 		}
+	case query.FieldNameTimeBucket:
+		if fieldType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+			return "", query.NewConverterError(
+				"'%s' is only supported for search attributes of type %s",
+				query.TimeBucketFuncName,
+				enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			)
+		}
+	case query.FieldNameAggregate:
+		if !query.IsAggregateTypeSupported(fieldType) {
+			return "", query.NewAggregateTypeError(name)
+		}
 	}
 
 	return fieldName, nil
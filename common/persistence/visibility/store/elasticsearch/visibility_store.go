@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +21,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -37,6 +40,13 @@ const (
 	delimiter                    = "~"
 	scrollKeepAliveInterval      = "1m"
 	pointInTimeKeepAliveInterval = "1m"
+
+	// countGroupByTermsSize is the maximum number of distinct values returned per 'group by' field. The total number of
+	// groups is further limited by the search.max_buckets cluster setting.
+	countGroupByTermsSize = 1000
+
+	aggregateStatsAggName       = "stats"
+	aggregatePercentilesAggName = "percentiles"
 )
 
 type (
@@ -49,6 +59,7 @@ type (
 		processorAckTimeout            dynamicconfig.DurationPropertyFn
 		disableOrderByClause           dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		enableManualPagination         dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		queryCostLimit                 dynamicconfig.IntPropertyFnWithNamespaceFilter
 		metricsHandler                 metrics.Handler
 	}
 
@@ -104,6 +115,13 @@ var (
 	docSorter = []elastic.Sorter{
 		elastic.SortByDoc{},
 	}
+
+	timeBucketCalendarIntervals = map[query.TimeBucket]string{
+		query.TimeBucketMinute: "1m",
+		query.TimeBucketHour:   "1h",
+		query.TimeBucketDay:    "1d",
+		query.TimeBucketMonth:  "1M",
+	}
 )
 
 // NewVisibilityStore create a visibility store connecting to ElasticSearch
@@ -114,6 +132,7 @@ func NewVisibilityStore(
 	searchAttributesMapperProvider searchattribute.MapperProvider,
 	disableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	enableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	queryCostLimit dynamicconfig.IntPropertyFnWithNamespaceFilter,
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) (*VisibilityStore, error) {
@@ -148,6 +167,7 @@ func NewVisibilityStore(
 		processorAckTimeout:            processorAckTimeout,
 		disableOrderByClause:           disableOrderByClause,
 		enableManualPagination:         enableManualPagination,
+		queryCostLimit:                 queryCostLimit,
 		metricsHandler:                 metricsHandler.WithTags(metrics.OperationTag(metrics.ElasticsearchVisibility)),
 	}, nil
 }
@@ -355,7 +375,7 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	p, err := s.BuildSearchParametersV2(request, s.GetListFieldSorter)
+	p, err := s.BuildSearchParametersV2(ctx, request, s.GetListFieldSorter)
 	if err != nil {
 		return nil, err
 	}
@@ -388,7 +408,7 @@ func (s *VisibilityStore) scanWorkflowExecutionsWithScroll(
 		scrollErr    error
 	)
 
-	p, err := s.BuildSearchParametersV2(request, s.getScanFieldSorter)
+	p, err := s.BuildSearchParametersV2(ctx, request, s.getScanFieldSorter)
 	if err != nil {
 		return nil, err
 	}
@@ -421,7 +441,7 @@ func (s *VisibilityStore) scanWorkflowExecutionsWithPit(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 ) (*store.InternalListWorkflowExecutionsResponse, error) {
-	p, err := s.BuildSearchParametersV2(request, s.getScanFieldSorter)
+	p, err := s.BuildSearchParametersV2(ctx, request, s.getScanFieldSorter)
 	if err != nil {
 		return nil, err
 	}
@@ -457,7 +477,7 @@ func (s *VisibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *manager.CountWorkflowExecutionsRequest,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
-	queryParams, err := s.convertQuery(request.Namespace, request.NamespaceID, request.Query)
+	queryParams, err := s.convertQuery(ctx, request.Namespace, request.NamespaceID, request.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -480,7 +500,22 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	queryParams *query.QueryParams,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
 	groupByFields := queryParams.GroupBy
+	esResponse, err := s.esClient.CountGroupBy(
+		ctx,
+		s.index,
+		queryParams.Query,
+		groupByFields[0].FieldName,
+		newGroupByAggregations(groupByFields),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s.parseCountGroupByResponse(esResponse, groupByFields)
+}
 
+// newGroupByAggregations creates the aggregation counting executions grouped by the fields, named after the first
+// field.
+func newGroupByAggregations(groupByFields []query.GroupByField) elastic.Aggregation {
 	// Elasticsearch aggregation is nested. so need to loop backwards to build it.
 	// Example: when grouping by (field1, field2), the object looks like
 	// {
@@ -499,23 +534,171 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	//     }
 	//   }
 	// }
-	termsAgg := elastic.NewTermsAggregation().Field(groupByFields[len(groupByFields)-1])
-	for i := len(groupByFields) - 2; i >= 0; i-- {
-		termsAgg = elastic.NewTermsAggregation().
-			Field(groupByFields[i]).
-			SubAggregation(groupByFields[i+1], termsAgg)
+	var agg elastic.Aggregation
+	for i := len(groupByFields) - 1; i >= 0; i-- {
+		subAggName := ""
+		if i+1 < len(groupByFields) {
+			subAggName = groupByFields[i+1].FieldName
+		}
+		agg = newGroupByAggregation(groupByFields[i], subAggName, agg)
 	}
-	esResponse, err := s.esClient.CountGroupBy(
-		ctx,
-		s.index,
-		queryParams.Query,
-		groupByFields[0],
-		termsAgg,
-	)
+	return agg
+}
+
+// newGroupByAggregation creates a terms aggregation for the field, or a date histogram if its values are grouped into
+// time buckets, nesting subAgg if not nil. Buckets without documents are omitted as in a SQL 'group by' clause.
+func newGroupByAggregation(field query.GroupByField, subAggName string, subAgg elastic.Aggregation) elastic.Aggregation {
+	if field.TimeBucket != "" {
+		agg := elastic.NewDateHistogramAggregation().
+			Field(field.FieldName).
+			CalendarInterval(timeBucketCalendarIntervals[field.TimeBucket]).
+			MinDocCount(1)
+		if subAgg != nil {
+			agg = agg.SubAggregation(subAggName, subAgg)
+		}
+		return agg
+	}
+	agg := elastic.NewTermsAggregation().Field(field.FieldName).Size(countGroupByTermsSize)
+	if subAgg != nil {
+		agg = agg.SubAggregation(subAggName, subAgg)
+	}
+	return agg
+}
+
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	queryParams, err := s.convertQuery(ctx, request.Namespace, request.NamespaceID, request.Query)
 	if err != nil {
 		return nil, err
 	}
-	return s.parseCountGroupByResponse(esResponse, groupByFields)
+	if len(queryParams.GroupBy) > 0 {
+		return nil, serviceerror.NewInvalidArgument("GROUP BY clause is not supported")
+	}
+	fieldName, err := s.convertAggregateFieldName(request.Namespace, request.FieldName)
+	if err != nil {
+		return nil, err
+	}
+
+	aggs := map[string]elastic.Aggregation{
+		aggregateStatsAggName: elastic.NewStatsAggregation().Field(fieldName),
+	}
+	if len(request.Percentiles) > 0 {
+		// Percentiles are approximated by Elasticsearch with the TDigest algorithm.
+		aggs[aggregatePercentilesAggName] = elastic.NewPercentilesAggregation().
+			Field(fieldName).
+			Percentiles(request.Percentiles...)
+	}
+	searchResult, err := s.esClient.Aggregate(ctx, s.index, queryParams.Query, aggs)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("AggregateWorkflowExecutions failed", err)
+	}
+	return parseAggregateResponse(searchResult.Aggregations, request.Percentiles)
+}
+
+func (s *VisibilityStore) ExplainWorkflowExecutions(
+	_ context.Context,
+	request *manager.ExplainWorkflowExecutionsRequest,
+) (*manager.ExplainWorkflowExecutionsResponse, error) {
+	queryParams, queryCost, err := s.convertQueryWithCost(request.Namespace, request.NamespaceID, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	searchSource := elastic.NewSearchSource().Query(queryParams.Query)
+	if len(queryParams.GroupBy) > 0 {
+		// Queries with 'group by' clause are only supported by CountWorkflowExecutions.
+		searchSource.Size(0).Aggregation(queryParams.GroupBy[0].FieldName, newGroupByAggregations(queryParams.GroupBy))
+	} else {
+		sorter, err := s.GetListFieldSorter(queryParams.Sorter)
+		if err != nil {
+			return nil, err
+		}
+		searchSource.SortBy(sorter...).Size(request.PageSize)
+	}
+	src, err := searchSource.Source()
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to build Elasticsearch search source: %v", err)
+	}
+	body, err := json.Marshal(src)
+	if err != nil {
+		return nil, serviceerror.NewInternalf("unable to serialize Elasticsearch search source: %v", err)
+	}
+
+	return &manager.ExplainWorkflowExecutionsResponse{
+		StoreQuery:  string(body),
+		Cost:        queryCost.Cost,
+		CostReasons: queryCost.Reasons,
+		CostLimit:   int64(s.queryCostLimit(request.Namespace.String())),
+	}, nil
+}
+
+func (s *VisibilityStore) convertAggregateFieldName(namespace namespace.Name, name string) (string, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
+	if err != nil {
+		return "", serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
+	}
+	fieldName, err := NewNameInterceptor(namespace, saTypeMap, s.searchAttributesMapperProvider).
+		Name(name, query.FieldNameAggregate)
+	if err != nil {
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return "", converterErr.ToInvalidArgument()
+		}
+		return "", err
+	}
+	return fieldName, nil
+}
+
+func parseAggregateResponse(
+	aggs elastic.Aggregations,
+	percentiles []float64,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	stats, ok := aggs.Stats(aggregateStatsAggName)
+	if !ok {
+		return nil, serviceerror.NewInternal("AggregateWorkflowExecutions failed: stats aggregation is missing from the response")
+	}
+	response := &manager.AggregateWorkflowExecutionsResponse{
+		Count:            stats.Count,
+		PercentileValues: make([]float64, len(percentiles)),
+	}
+	if stats.Count == 0 {
+		return response, nil
+	}
+	if stats.Sum != nil {
+		response.Sum = *stats.Sum
+	}
+	if stats.Avg != nil {
+		response.Avg = *stats.Avg
+	}
+	if stats.Min != nil {
+		response.Min = *stats.Min
+	}
+	if stats.Max != nil {
+		response.Max = *stats.Max
+	}
+	if len(percentiles) == 0 {
+		return response, nil
+	}
+
+	percentilesResult, ok := aggs.Percentiles(aggregatePercentilesAggName)
+	if !ok {
+		return nil, serviceerror.NewInternal("AggregateWorkflowExecutions failed: percentiles aggregation is missing from the response")
+	}
+	// Keys are formatted by Elasticsearch (e.g. "95.0"), so they are parsed back to match the requested percentiles.
+	for key, value := range percentilesResult.Values {
+		percentile, err := strconv.ParseFloat(key, 64)
+		if err != nil {
+			return nil, serviceerror.NewInternalf("AggregateWorkflowExecutions failed: unexpected percentile %q: %v", key, err)
+		}
+		for i, p := range percentiles {
+			if p == percentile {
+				response.PercentileValues[i] = value
+			}
+		}
+	}
+	return response, nil
 }
 
 func (s *VisibilityStore) GetWorkflowExecution(
@@ -603,10 +786,12 @@ func (s *VisibilityStore) buildSearchParameters(
 }
 
 func (s *VisibilityStore) BuildSearchParametersV2(
+	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
 	getFieldSorter func([]elastic.Sorter) ([]elastic.Sorter, error),
 ) (*client.SearchParameters, error) {
 	queryParams, err := s.convertQuery(
+		ctx,
 		request.Namespace,
 		request.NamespaceID,
 		request.Query,
@@ -719,14 +904,48 @@ func (s *VisibilityStore) processPageToken(
 	return nil
 }
 
+// convertQuery converts the visibility query of the namespace to Elasticsearch query params, rejecting the query if
+// its estimated cost exceeds the limit of the namespace. Queries of system and background callers are never rejected,
+// like they are not throttled by the visibility rate limiter.
 func (s *VisibilityStore) convertQuery(
+	ctx context.Context,
 	namespace namespace.Name,
 	namespaceID namespace.ID,
 	requestQueryStr string,
 ) (*query.QueryParams, error) {
+	queryParams, queryCost, err := s.convertQueryWithCost(namespace, namespaceID, requestQueryStr)
+	if err != nil {
+		return nil, err
+	}
+	if isQueryCostLimitExempt(headers.GetCallerInfo(ctx)) {
+		return queryParams, nil
+	}
+	if limit := int64(s.queryCostLimit(namespace.String())); limit > 0 && queryCost.Cost > limit {
+		s.metricsHandler.WithTags(metrics.NamespaceTag(namespace.String())).
+			Counter(metrics.ElasticsearchQueryCostLimitExceededCount.Name()).Record(1)
+		return nil, query.NewQueryCostLimitExceededError(queryCost, limit)
+	}
+	return queryParams, nil
+}
+
+func isQueryCostLimitExempt(callerInfo headers.CallerInfo) bool {
+	switch callerInfo.CallerType {
+	case headers.CallerTypeBackgroundHigh, headers.CallerTypeBackgroundLow, headers.CallerTypePreemptable:
+		return true
+	}
+	return callerInfo.CallerName == headers.CallerNameSystem
+}
+
+// convertQueryWithCost converts the visibility query of the namespace to Elasticsearch query params and estimates its
+// cost. The cost doesn't include the namespace filter added to the query.
+func (s *VisibilityStore) convertQueryWithCost(
+	namespace namespace.Name,
+	namespaceID namespace.ID,
+	requestQueryStr string,
+) (*query.QueryParams, *query.QueryCost, error) {
 	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
 	if err != nil {
-		return nil, serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
+		return nil, nil, serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
 	}
 	nameInterceptor := NewNameInterceptor(namespace, saTypeMap, s.searchAttributesMapperProvider)
 	queryConverter := NewQueryConverter(
@@ -739,9 +958,13 @@ func (s *VisibilityStore) convertQuery(
 		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
 		var converterErr *query.ConverterError
 		if errors.As(err, &converterErr) {
-			return nil, converterErr.ToInvalidArgument()
+			return nil, nil, converterErr.ToInvalidArgument()
 		}
-		return nil, err
+		return nil, nil, err
+	}
+	queryCost, err := query.EstimateCost(queryParams, time.Now().UTC())
+	if err != nil {
+		return nil, nil, serviceerror.NewInternalf("unable to estimate query cost: %v", err)
 	}
 
 	// Create a new bool query because a request query might have only "should" (="or") queries.
@@ -758,7 +981,7 @@ func (s *VisibilityStore) convertQuery(
 	}
 
 	queryParams.Query = namespaceFilterQuery
-	return queryParams, nil
+	return queryParams, queryCost, nil
 }
 
 func (s *VisibilityStore) getScanFieldSorter(fieldSorts []elastic.Sorter) ([]elastic.Sorter, error) {
@@ -890,7 +1113,8 @@ func (s *VisibilityStore) GenerateESDoc(
 		return nil, serviceerror.NewUnavailablef("unable to read search attribute types: %v", err)
 	}
 
-	searchAttributes, err := searchattribute.Decode(request.SearchAttributes, &typeMap, true)
+	remappedSearchAttributes := searchattribute.ApplyFieldRemaps(request.SearchAttributes, typeMap, request.NamespaceID)
+	searchAttributes, err := searchattribute.Decode(remappedSearchAttributes, &typeMap, true)
 	if err != nil {
 		metrics.ElasticsearchDocumentGenerateFailuresCount.With(s.metricsHandler).Record(1)
 		return nil, serviceerror.NewInternalf("unable to decode search attributes: %v", err)
@@ -1090,7 +1314,7 @@ func (s *VisibilityStore) ParseESDoc(
 //nolint:revive // cognitive complexity 27 (> max enabled 25)
 func (s *VisibilityStore) parseCountGroupByResponse(
 	searchResult *elastic.SearchResult,
-	groupByFields []string,
+	groupByFields []query.GroupByField,
 ) (*manager.CountWorkflowExecutionsResponse, error) {
 	response := &manager.CountWorkflowExecutionsResponse{}
 	typeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.index, false)
@@ -1100,8 +1324,8 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 		)
 	}
 	groupByTypes := make([]enumspb.IndexedValueType, len(groupByFields))
-	for i, saName := range groupByFields {
-		tp, err := typeMap.GetType(saName)
+	for i, field := range groupByFields {
+		tp, err := typeMap.GetType(field.FieldName)
 		if err != nil {
 			return nil, err
 		}
@@ -1139,11 +1363,11 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 		}
 
 		index := len(bucketValues)
-		fieldName := groupByFields[index]
-		buckets := aggs[fieldName].(map[string]any)["buckets"].([]any)
+		field := groupByFields[index]
+		buckets := aggs[field.FieldName].(map[string]any)["buckets"].([]any)
 		for i := range buckets {
 			bucket := buckets[i].(map[string]any)
-			value, err := finishParseJSONValue(bucket["key"], groupByTypes[index])
+			value, err := parseGroupByBucketKey(bucket, field, groupByTypes[index])
 			if err != nil {
 				return fmt.Errorf("unable to parse value %v: %w", bucket["key"], err)
 			}
@@ -1160,17 +1384,47 @@ func (s *VisibilityStore) parseCountGroupByResponse(
 	}
 
 	var bucketsJson map[string]any
-	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[groupByFields[0]]))
+	dec := json.NewDecoder(bytes.NewReader(searchResult.Aggregations[groupByFields[0].FieldName]))
 	dec.UseNumber()
 	if err := dec.Decode(&bucketsJson); err != nil {
 		return nil, serviceerror.NewInternalf("unable to unmarshal json response: %v", err)
 	}
-	if err := parseInternal(map[string]any{groupByFields[0]: bucketsJson}, nil); err != nil {
+	if err := parseInternal(map[string]any{groupByFields[0].FieldName: bucketsJson}, nil); err != nil {
 		return nil, err
 	}
 	return response, nil
 }
 
+// parseGroupByBucketKey parses the value of a bucket of a terms or date histogram aggregation.
+func parseGroupByBucketKey(
+	bucket map[string]any,
+	field query.GroupByField,
+	valueType enumspb.IndexedValueType,
+) (any, error) {
+	switch {
+	case field.TimeBucket != "":
+		// Date histogram keys are the start of the bucket in epoch milliseconds.
+		millis, isNumber := bucket["key"].(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf("%w: expected json.Number got %T", errUnexpectedJSONFieldType, bucket["key"])
+		}
+		ms, err := millis.Int64()
+		if err != nil {
+			return nil, err
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	case valueType == enumspb.INDEXED_VALUE_TYPE_BOOL:
+		// Terms aggregation keys of boolean fields are 0 or 1, the boolean value is in key_as_string.
+		keyAsString, isString := bucket["key_as_string"].(string)
+		if !isString {
+			return nil, fmt.Errorf("%w: expected string got %T", errUnexpectedJSONFieldType, bucket["key_as_string"])
+		}
+		return strconv.ParseBool(keyAsString)
+	default:
+		return finishParseJSONValue(bucket["key"], valueType)
+	}
+}
+
 // finishParseJSONValue finishes JSON parsing after json.Decode.
 // json.Decode returns:
 //
@@ -1224,6 +1478,158 @@ func finishParseJSONValue(val interface{}, t enumspb.IndexedValueType) (interfac
 	panic(fmt.Sprintf("Unknown field type: %v", t))
 }
 
+func (s *VisibilityStore) MigrateSearchAttributeValues(
+	ctx context.Context,
+	request *manager.MigrateSearchAttributeValuesRequest,
+) (*manager.MigrateSearchAttributeValuesResponse, error) {
+	boolQuery := elastic.NewBoolQuery().Filter(elastic.NewExistsQuery(request.SourceField))
+	if request.NamespaceID != "" {
+		boolQuery.Filter(elastic.NewTermQuery(searchattribute.NamespaceID, request.NamespaceID.String()))
+	}
+	p := &client.SearchParameters{
+		Index:    s.index,
+		Query:    boolQuery,
+		PageSize: request.PageSize,
+		// The run ID makes the sort order unique, so search_after doesn't skip documents with the same times.
+		Sorter: append(slices.Clone(defaultSorter), elastic.NewFieldSort(searchattribute.RunID)),
+	}
+	token, err := s.deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		p.SearchAfter = token.SearchAfter
+	}
+	searchResult, err := s.esClient.Search(ctx, p)
+	if err != nil {
+		return nil, ConvertElasticsearchClientError("MigrateSearchAttributeValues failed", err)
+	}
+	if searchResult.Hits == nil || len(searchResult.Hits.Hits) == 0 {
+		return &manager.MigrateSearchAttributeValuesResponse{}, nil
+	}
+
+	response := &manager.MigrateSearchAttributeValuesResponse{Processed: int64(len(searchResult.Hits.Hits))}
+	bulkService := s.esClient.Bulk()
+	for _, hit := range searchResult.Hits.Hits {
+		var doc map[string]any
+		d := json.NewDecoder(bytes.NewReader(hit.Source))
+		d.UseNumber()
+		if err := d.Decode(&doc); err != nil {
+			return nil, serviceerror.NewInternalf("unable to unmarshal JSON from Elasticsearch document(%s): %v", hit.Id, err)
+		}
+		if _, ok := doc[request.TargetField]; ok && !request.DryRun {
+			continue
+		}
+		value, err := convertDocValue(doc[request.SourceField], request.SourceType, request.TargetType)
+		if err != nil {
+			workflowID, _ := doc[searchattribute.WorkflowID].(string)
+			runID, _ := doc[searchattribute.RunID].(string)
+			response.InvalidValues = append(response.InvalidValues, manager.InvalidSearchAttributeValue{
+				WorkflowID: workflowID,
+				RunID:      runID,
+				Value:      doc[request.SourceField],
+				Error:      err.Error(),
+			})
+			continue
+		}
+		if request.DryRun || value == nil {
+			continue
+		}
+		version, err := parseVisibilityTaskVersion(doc[searchattribute.VisibilityTaskKey])
+		if err != nil {
+			return nil, serviceerror.NewInternalf("unable to parse version of Elasticsearch document(%s): %v", hit.Id, err)
+		}
+		doc[request.TargetField] = value
+		// The document is written with its current version, so that it is not overwritten if it was updated since it
+		// was read. Version conflicts are ignored because the newer document already has the remapped value.
+		bulkService.Add(&client.BulkableRequest{
+			RequestType: client.BulkableRequestTypeIndex,
+			Index:       s.index,
+			ID:          hit.Id,
+			Version:     version,
+			VersionType: client.VersionTypeExternalGTE,
+			Doc:         doc,
+		})
+	}
+
+	if bulkService.NumberOfActions() > 0 {
+		bulkResponse, err := bulkService.Do(ctx)
+		if err != nil {
+			return nil, ConvertElasticsearchClientError("MigrateSearchAttributeValues failed", err)
+		}
+		for _, item := range bulkResponse.Failed() {
+			if item.Status == http.StatusConflict {
+				continue
+			}
+			return nil, serviceerror.NewUnavailablef(
+				"MigrateSearchAttributeValues failed to update Elasticsearch document(%s): %s", item.Id, item.Error.Reason)
+		}
+	}
+
+	if len(searchResult.Hits.Hits) == request.PageSize {
+		response.NextPageToken, err = s.serializePageToken(&visibilityPageToken{
+			SearchAfter: searchResult.Hits.Hits[len(searchResult.Hits.Hits)-1].Sort,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// convertDocValue converts a search attribute value of an Elasticsearch document to the type to.
+func convertDocValue(value any, from, to enumspb.IndexedValueType) (any, error) {
+	value, err := finishParseJSONValue(value, from)
+	if err != nil {
+		return nil, err
+	}
+	values, isArray := value.([]any)
+	if from == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		if !isArray {
+			values = []any{value}
+		}
+		keywords := make([]string, len(values))
+		for i, v := range values {
+			keywords[i] = v.(string)
+		}
+		return searchattribute.ConvertValue(keywords, from, to)
+	}
+	if !isArray {
+		return searchattribute.ConvertValue(value, from, to)
+	}
+
+	// Custom search attributes support arrays of a particular type.
+	var keywords []string
+	result := make([]any, len(values))
+	for i, v := range values {
+		converted, err := searchattribute.ConvertValue(v, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if to == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			keywords = append(keywords, converted.([]string)...)
+		}
+		result[i] = converted
+	}
+	if to == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		return keywords, nil
+	}
+	return result, nil
+}
+
+// parseVisibilityTaskVersion returns the task ID of a visibility task key, which is the version of the document.
+func parseVisibilityTaskVersion(visibilityTaskKey any) (int64, error) {
+	key, ok := visibilityTaskKey.(string)
+	if !ok {
+		return 0, fmt.Errorf("%w: expected string got %T", errUnexpectedJSONFieldType, visibilityTaskKey)
+	}
+	_, taskID, found := strings.Cut(key, delimiter)
+	if !found {
+		return 0, fmt.Errorf("invalid visibility task key %q", key)
+	}
+	return strconv.ParseInt(taskID, 10, 64)
+}
+
 func ConvertElasticsearchClientError(message string, err error) error {
 	errMessage := fmt.Sprintf("%s: %s", message, detailedErrorMessage(err))
 	switch e := err.(type) {
@@ -20,6 +20,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -125,6 +126,7 @@ func (s *ESVisibilitySuite) SetupTest() {
 		processorAckTimeout:            esProcessorAckTimeout,
 		disableOrderByClause:           visibilityDisableOrderByClause,
 		enableManualPagination:         visibilityEnableManualPagination,
+		queryCostLimit:                 dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 		metricsHandler:                 s.mockMetricsHandler,
 	}
 }
@@ -280,7 +282,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	request.Query = `WorkflowId="guid-2208"`
 	filterQuery := elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowID, "guid-2208"))
 	boolQuery := elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery).MustNot(namespaceDivisionExists)
-	p, err := s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.GetListFieldSorter)
+	p, err := s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.GetListFieldSorter)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -297,7 +299,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	// note namespace division appears in the filterQuery, not the boolQuery like the negative version
 	filterQuery = elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowID, "guid-2208"), matchNSDivision)
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery)
-	p, err = s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.GetListFieldSorter)
+	p, err = s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.GetListFieldSorter)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -314,7 +316,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery).MustNot(namespaceDivisionExists)
 	s.mockMetricsHandler.EXPECT().WithTags(metrics.NamespaceTag(request.Namespace.String())).Return(s.mockMetricsHandler)
 	s.mockMetricsHandler.EXPECT().Counter(metrics.ElasticsearchCustomOrderByClauseCount.Name()).Return(metrics.NoopCounterMetricFunc)
-	p, err = s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.GetListFieldSorter)
+	p, err = s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.GetListFieldSorter)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -333,7 +335,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	request.Query = `WorkflowId="guid-2208"`
 	filterQuery = elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowID, "guid-2208"))
 	boolQuery = elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery).MustNot(namespaceDivisionExists)
-	p, err = s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.getScanFieldSorter)
+	p, err = s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.getScanFieldSorter)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -349,14 +351,14 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2() {
 	request.Query = `Order bY WorkflowId`
 	s.mockMetricsHandler.EXPECT().WithTags(metrics.NamespaceTag(request.Namespace.String())).Return(s.mockMetricsHandler)
 	s.mockMetricsHandler.EXPECT().Counter(metrics.ElasticsearchCustomOrderByClauseCount.Name()).Return(metrics.NoopCounterMetricFunc)
-	p, err = s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.getScanFieldSorter)
+	p, err = s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.getScanFieldSorter)
 	s.Error(err)
 	s.Nil(p)
 	request.Query = ""
 
 	// test for wrong query
 	request.Query = "invalid query"
-	p, err = s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.getScanFieldSorter)
+	p, err = s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.getScanFieldSorter)
 	s.Nil(p)
 	s.Error(err)
 	request.Query = ""
@@ -378,7 +380,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2DisableOrderByClause() {
 	request.Query = `WorkflowId="guid-2208"`
 	filterQuery := elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowID, "guid-2208"))
 	boolQuery := elastic.NewBoolQuery().Filter(matchNamespaceQuery, filterQuery).MustNot(namespaceDivisionExists)
-	p, err := s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.GetListFieldSorter)
+	p, err := s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.GetListFieldSorter)
 	s.NoError(err)
 	s.Equal(&client.SearchParameters{
 		Index:       testIndex,
@@ -392,7 +394,7 @@ func (s *ESVisibilitySuite) TestBuildSearchParametersV2DisableOrderByClause() {
 
 	// test invalid query with ORDER BY
 	request.Query = `ORDER BY WorkflowId`
-	p, err = s.visibilityStore.BuildSearchParametersV2(request, s.visibilityStore.GetListFieldSorter)
+	p, err = s.visibilityStore.BuildSearchParametersV2(context.Background(), request, s.visibilityStore.GetListFieldSorter)
 	s.Nil(p)
 	s.Error(err)
 	var invalidArgumentErr *serviceerror.InvalidArgument
@@ -423,104 +425,104 @@ func (s *ESVisibilitySuite) sorterToJSON(sorters []elastic.Sorter) string {
 
 func (s *ESVisibilitySuite) Test_convertQuery() {
 	query := `WorkflowId = 'wid'`
-	queryParams, err := s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err := s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"term":{"WorkflowId":"wid"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `WorkflowId = 'wid' or WorkflowId = 'another-wid'`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"should":[{"term":{"WorkflowId":"wid"}},{"term":{"WorkflowId":"another-wid"}}]}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `WorkflowId = 'wid' order by StartTime desc`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"term":{"WorkflowId":"wid"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"StartTime":{"order":"desc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `WorkflowId = 'wid' and CloseTime is null`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":[{"term":{"WorkflowId":"wid"}},{"bool":{"must_not":{"exists":{"field":"CloseTime"}}}}]}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `WorkflowId = 'wid' or CloseTime is null`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"should":[{"term":{"WorkflowId":"wid"}},{"bool":{"must_not":{"exists":{"field":"CloseTime"}}}}]}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `CloseTime is null order by CloseTime desc`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"must_not":{"exists":{"field":"CloseTime"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"CloseTime":{"order":"desc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `StartTime = "2018-06-07T15:04:05.123456789-08:00"`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"match":{"StartTime":{"query":"2018-06-07T15:04:05.123456789-08:00"}}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `WorkflowId = 'wid' and StartTime > "2018-06-07T15:04:05+00:00"`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":[{"term":{"WorkflowId":"wid"}},{"range":{"StartTime":{"from":"2018-06-07T15:04:05+00:00","include_lower":false,"include_upper":true,"to":null}}}]}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `ExecutionTime < 1000000`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"range":{"ExecutionTime":{"from":null,"include_lower":true,"include_upper":false,"to":"1970-01-01T00:00:00.001Z"}}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `ExecutionTime between 1 and 2`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"range":{"ExecutionTime":{"from":"1970-01-01T00:00:00.000000001Z","include_lower":true,"include_upper":true,"to":"1970-01-01T00:00:00.000000002Z"}}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `ExecutionTime < 1000000 or ExecutionTime > 2000000`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"should":[{"range":{"ExecutionTime":{"from":null,"include_lower":true,"include_upper":false,"to":"1970-01-01T00:00:00.001Z"}}},{"range":{"ExecutionTime":{"from":"1970-01-01T00:00:00.002Z","include_lower":false,"include_upper":true,"to":null}}}]}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `order by ExecutionTime`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"ExecutionTime":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `order by StartTime desc, CloseTime asc`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"StartTime":{"order":"desc"}},{"CloseTime":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `order by CustomTextField desc`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.IsType(&serviceerror.InvalidArgument{}, err)
 	s.Equal(err.(*serviceerror.InvalidArgument).Error(), "invalid query: unable to convert 'order by' column name: unable to sort by field of Text type, use field of type Keyword")
 
 	query = `order by CustomIntField asc`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"CustomIntField":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `ExecutionTime < "unable to parse"`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.IsType(&serviceerror.InvalidArgument{}, err)
 	s.Equal(err.Error(), "invalid query: unable to convert filter expression: unable to convert values of comparison expression: invalid value for search attribute ExecutionTime of type Datetime: \"unable to parse\"")
 
 	// invalid union injection
 	query = `WorkflowId = 'wid' union select * from dummy`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.Nil(queryParams)
 }
@@ -532,56 +534,56 @@ func (s *ESVisibilitySuite) Test_convertQuery_Mapper() {
 	s.visibilityStore.searchAttributesMapperProvider = s.mockSearchAttributesMapperProvider
 
 	query := `WorkflowId = 'wid'`
-	queryParams, err := s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err := s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"term":{"WorkflowId":"wid"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = "`AliasForCustomKeywordField` = 'pid'"
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"term":{"CustomKeywordField":"pid"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = "`AliasWithHyphenFor-CustomKeywordField` = 'pid'"
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"term":{"CustomKeywordField":"pid"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `CustomKeywordField = 'pid'`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	var invalidArgumentErr *serviceerror.InvalidArgument
 	s.ErrorAs(err, &invalidArgumentErr)
 	s.EqualError(err, "mapper error")
 
 	query = `AliasForUnknownField = 'pid'`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.ErrorAs(err, &invalidArgumentErr)
 	s.EqualError(err, "invalid query: unable to convert filter expression: unable to convert left side of \"AliasForUnknownField = 'pid'\": invalid search attribute: AliasForUnknownField")
 
 	query = `order by ExecutionTime`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"ExecutionTime":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `order by AliasForCustomKeywordField asc`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"CustomKeywordField":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `order by CustomKeywordField asc`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.ErrorAs(err, &invalidArgumentErr)
 	s.EqualError(err, "mapper error")
 
 	query = `order by AliasForUnknownField asc`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.ErrorAs(err, &invalidArgumentErr)
 	s.EqualError(err, "invalid query: unable to convert 'order by' column name: invalid search attribute: AliasForUnknownField")
@@ -595,26 +597,26 @@ func (s *ESVisibilitySuite) Test_convertQuery_Mapper_Error() {
 	s.visibilityStore.searchAttributesMapperProvider = s.mockSearchAttributesMapperProvider
 
 	query := `WorkflowId = 'wid'`
-	queryParams, err := s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err := s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":[{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},{"bool":{"filter":{"term":{"WorkflowId":"wid"}}}}],"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Nil(queryParams.Sorter)
 
 	query = `ProductId = 'pid'`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	var invalidArgumentErr *serviceerror.InvalidArgument
 	s.ErrorAs(err, &invalidArgumentErr)
 	s.EqualError(err, "mapper error")
 
 	query = `order by ExecutionTime`
-	queryParams, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	queryParams, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.NoError(err)
 	s.Equal(`{"bool":{"filter":{"term":{"NamespaceId":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},"must_not":{"exists":{"field":"TemporalNamespaceDivision"}}}}`, s.queryToJSON(queryParams.Query))
 	s.Equal(`[{"ExecutionTime":{"order":"asc"}}]`, s.sorterToJSON(queryParams.Sorter))
 
 	query = `order by CustomIntField asc`
-	_, err = s.visibilityStore.convertQuery(testNamespace, testNamespaceID, query)
+	_, err = s.visibilityStore.convertQuery(context.Background(), testNamespace, testNamespaceID, query)
 	s.Error(err)
 	s.ErrorAs(err, &invalidArgumentErr)
 	s.EqualError(err, "mapper error")
@@ -1231,7 +1233,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 				Filter(elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String())).
 				MustNot(namespaceDivisionExists),
 			searchattribute.ExecutionStatus,
-			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize),
 		).
 		Return(
 			&elastic.SearchResult{
@@ -1270,21 +1272,168 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
 		resp),
 	)
 
-	// test only allowed to group by a single field
-	request.Query = "GROUP BY ExecutionStatus, WorkflowType"
+	// test datetime fields can only be grouped into time buckets
+	request.Query = "GROUP BY StartTime"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause supports only a single field")
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of type Keyword, Bool and Int, or Datetime with 'date_trunc'")
 	s.Nil(resp)
 
-	// test only allowed to group by ExecutionStatus
-	request.Query = "GROUP BY WorkflowType"
+	// test time buckets are only supported for datetime fields
+	request.Query = "GROUP BY date_trunc('hour', WorkflowType)"
 	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "'date_trunc' is only supported for search attributes of type Datetime")
 	s.Nil(resp)
 }
 
+func (s *ESVisibilitySuite) TestAggregateWorkflowExecutions() {
+	s.mockESClient.EXPECT().Aggregate(gomock.Any(), testIndex, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index string, query elastic.Query, aggs map[string]elastic.Aggregation) (*elastic.SearchResult, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+					elastic.NewBoolQuery().Filter(elastic.NewTermQuery(searchattribute.WorkflowType, "OrderWorkflow")),
+				).MustNot(namespaceDivisionExists),
+				query,
+			)
+			s.Equal(
+				map[string]elastic.Aggregation{
+					aggregateStatsAggName: elastic.NewStatsAggregation().Field(searchattribute.ExecutionDuration),
+					aggregatePercentilesAggName: elastic.NewPercentilesAggregation().
+						Field(searchattribute.ExecutionDuration).
+						Percentiles(50, 99.9),
+				},
+				aggs,
+			)
+			return &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					aggregateStatsAggName:       json.RawMessage(`{"count":4,"min":10,"max":40,"avg":25,"sum":100}`),
+					aggregatePercentilesAggName: json.RawMessage(`{"values":{"50.0":20,"99.9":39.5}}`),
+				},
+			}, nil
+		})
+
+	request := &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `WorkflowType = "OrderWorkflow"`,
+		FieldName:   searchattribute.ExecutionDuration,
+		Percentiles: []float64{50, 99.9},
+	}
+	resp, err := s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(
+		&manager.AggregateWorkflowExecutionsResponse{
+			Count:            4,
+			Sum:              100,
+			Avg:              25,
+			Min:              10,
+			Max:              40,
+			PercentileValues: []float64{20, 39.5},
+		},
+		resp,
+	)
+
+	// no values
+	s.mockESClient.EXPECT().Aggregate(gomock.Any(), testIndex, gomock.Any(), gomock.Any()).Return(
+		&elastic.SearchResult{
+			Aggregations: map[string]json.RawMessage{
+				aggregateStatsAggName:       json.RawMessage(`{"count":0,"min":null,"max":null,"avg":null,"sum":0}`),
+				aggregatePercentilesAggName: json.RawMessage(`{"values":{"50.0":null,"99.9":null}}`),
+			},
+		}, nil)
+	resp, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(
+		&manager.AggregateWorkflowExecutionsResponse{PercentileValues: []float64{0, 0}},
+		resp,
+	)
+
+	// unsupported type
+	request.FieldName = "CustomKeywordField"
+	_, err = s.visibilityStore.AggregateWorkflowExecutions(context.Background(), request)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+	s.Contains(err.Error(), "only search attributes of type Int and Double are supported")
+}
+
+func (s *ESVisibilitySuite) TestQueryCostLimit() {
+	s.visibilityStore.queryCostLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+
+	// under the limit
+	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).Return(int64(1), nil)
+	request := &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `WorkflowId STARTS_WITH "order-"`,
+	}
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Equal(int64(1), resp.Count)
+
+	// over the limit
+	s.mockMetricsHandler.EXPECT().WithTags(metrics.NamespaceTag(request.Namespace.String())).Return(s.mockMetricsHandler)
+	s.mockMetricsHandler.EXPECT().Counter(metrics.ElasticsearchQueryCostLimitExceededCount.Name()).Return(metrics.NoopCounterMetricFunc)
+	request.Query = `WorkflowId STARTS_WITH "o"`
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+	s.Equal(
+		`query is too expensive: estimated cost 20 exceeds the limit of 10: 'starts_with' on WorkflowId with prefix "o" shorter than 3 characters matches most values (cost 20)`,
+		err.Error(),
+	)
+
+	// system and background callers are exempt
+	for _, callerInfo := range []headers.CallerInfo{
+		headers.SystemBackgroundHighCallerInfo,
+		headers.NewCallerInfo(testNamespace.String(), headers.CallerTypeBackgroundLow, ""),
+	} {
+		s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).Return(int64(1), nil)
+		_, err = s.visibilityStore.CountWorkflowExecutions(headers.SetCallerInfo(context.Background(), callerInfo), request)
+		s.NoError(err)
+	}
+}
+
+func (s *ESVisibilitySuite) TestExplainWorkflowExecutions() {
+	s.visibilityStore.queryCostLimit = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+
+	request := &manager.ExplainWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    10,
+		Query:       `WorkflowType = "OrderWorkflow" ORDER BY CustomKeywordField`,
+	}
+	resp, err := s.visibilityStore.ExplainWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.JSONEq(
+		`{
+			"query": {"bool": {
+				"filter": [
+					{"term": {"NamespaceId": "bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}},
+					{"bool": {"filter": {"term": {"WorkflowType": "OrderWorkflow"}}}}
+				],
+				"must_not": {"exists": {"field": "TemporalNamespaceDivision"}}
+			}},
+			"size": 10,
+			"sort": [{"CustomKeywordField": {"order": "asc"}}, {"RunId": {"order": "desc"}}]
+		}`,
+		resp.StoreQuery,
+	)
+	s.Equal(int64(11), resp.Cost)
+	s.Equal(
+		[]string{"'order by CustomKeywordField' does not follow the sort order of the index (cost 10)"},
+		resp.CostReasons,
+	)
+	s.Equal(int64(10), resp.CostLimit)
+
+	request.Query = "GROUP BY ExecutionStatus"
+	resp, err = s.visibilityStore.ExplainWorkflowExecutions(context.Background(), request)
+	s.NoError(err)
+	s.Contains(resp.StoreQuery, `"aggregations":{"ExecutionStatus":{"terms":{"field":"ExecutionStatus","size":1000}}}`)
+	s.Equal(int64(5), resp.Cost)
+}
+
 func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	statusCompletedPayload, _ := searchattribute.EncodeValue(
 		enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
@@ -1301,10 +1450,16 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	wfId3Payload, _ := searchattribute.EncodeValue("wf-id-3", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
 	wfId4Payload, _ := searchattribute.EncodeValue("wf-id-4", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
 	wfId5Payload, _ := searchattribute.EncodeValue("wf-id-5", enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	hourPayload, _ := searchattribute.EncodeValue(
+		time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		enumspb.INDEXED_VALUE_TYPE_DATETIME,
+	)
+	truePayload, _ := searchattribute.EncodeValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	falsePayload, _ := searchattribute.EncodeValue(false, enumspb.INDEXED_VALUE_TYPE_BOOL)
 
 	testCases := []struct {
 		name         string
-		groupBy      []string
+		groupBy      []query.GroupByField
 		aggName      string
 		agg          elastic.Aggregation
 		mockResponse *elastic.SearchResult
@@ -1312,9 +1467,9 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	}{
 		{
 			name:    "group by one field",
-			groupBy: []string{searchattribute.ExecutionStatus},
+			groupBy: []query.GroupByField{{FieldName: searchattribute.ExecutionStatus}},
 			aggName: searchattribute.ExecutionStatus,
-			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus),
+			agg:     elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
 					searchattribute.ExecutionStatus: json.RawMessage(
@@ -1349,12 +1504,15 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 		},
 
 		{
-			name:    "group by two fields",
-			groupBy: []string{searchattribute.ExecutionStatus, searchattribute.WorkflowType},
+			name: "group by two fields",
+			groupBy: []query.GroupByField{
+				{FieldName: searchattribute.ExecutionStatus},
+				{FieldName: searchattribute.WorkflowType},
+			},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType),
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(countGroupByTermsSize),
 			),
 			mockResponse: &elastic.SearchResult{
 				Aggregations: map[string]json.RawMessage{
@@ -1423,17 +1581,17 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 
 		{
 			name: "group by three fields",
-			groupBy: []string{
-				searchattribute.ExecutionStatus,
-				searchattribute.WorkflowType,
-				searchattribute.WorkflowID,
+			groupBy: []query.GroupByField{
+				{FieldName: searchattribute.ExecutionStatus},
+				{FieldName: searchattribute.WorkflowType},
+				{FieldName: searchattribute.WorkflowID},
 			},
 			aggName: searchattribute.ExecutionStatus,
-			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).SubAggregation(
+			agg: elastic.NewTermsAggregation().Field(searchattribute.ExecutionStatus).Size(countGroupByTermsSize).SubAggregation(
 				searchattribute.WorkflowType,
-				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).SubAggregation(
+				elastic.NewTermsAggregation().Field(searchattribute.WorkflowType).Size(countGroupByTermsSize).SubAggregation(
 					searchattribute.WorkflowID,
-					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID),
+					elastic.NewTermsAggregation().Field(searchattribute.WorkflowID).Size(countGroupByTermsSize),
 				),
 			),
 			mockResponse: &elastic.SearchResult{
@@ -1540,6 +1698,64 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 				},
 			},
 		},
+		{
+			name: "group by time bucket and bool field",
+			groupBy: []query.GroupByField{
+				{FieldName: searchattribute.StartTime, TimeBucket: query.TimeBucketHour},
+				{FieldName: "CustomBoolField"},
+			},
+			aggName: searchattribute.StartTime,
+			agg: elastic.NewDateHistogramAggregation().
+				Field(searchattribute.StartTime).
+				CalendarInterval("1h").
+				MinDocCount(1).
+				SubAggregation(
+					"CustomBoolField",
+					elastic.NewTermsAggregation().Field("CustomBoolField").Size(countGroupByTermsSize),
+				),
+			mockResponse: &elastic.SearchResult{
+				Aggregations: map[string]json.RawMessage{
+					searchattribute.StartTime: json.RawMessage(
+						`{
+							"buckets":[
+								{
+									"key_as_string": "2025-01-01T10:00:00.000Z",
+									"key": 1735725600000,
+									"doc_count": 4,
+									"CustomBoolField": {
+										"buckets": [
+											{
+												"key": 1,
+												"key_as_string": "true",
+												"doc_count": 3
+											},
+											{
+												"key": 0,
+												"key_as_string": "false",
+												"doc_count": 1
+											}
+										]
+									}
+								}
+							]
+						}`,
+					),
+				},
+			},
+			response: &manager.CountWorkflowExecutionsResponse{
+				Count: 4,
+				Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+					{
+						GroupValues: []*commonpb.Payload{hourPayload, truePayload},
+						Count:       3,
+					},
+					{
+						GroupValues: []*commonpb.Payload{hourPayload, falsePayload},
+						Count:       1,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1568,6 +1784,98 @@ func (s *ESVisibilitySuite) TestCountGroupByWorkflowExecutions() {
 	}
 }
 
+func (s *ESVisibilitySuite) TestMigrateSearchAttributeValues() {
+	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, p *client.SearchParameters) (*elastic.SearchResult, error) {
+			s.Equal(
+				elastic.NewBoolQuery().Filter(
+					elastic.NewExistsQuery("CustomKeywordField"),
+					elastic.NewTermQuery(searchattribute.NamespaceID, testNamespaceID.String()),
+				),
+				p.Query,
+			)
+			s.Equal(3, p.PageSize)
+			return &elastic.SearchResult{
+				Hits: &elastic.SearchHits{
+					Hits: []*elastic.SearchHit{
+						{
+							Id:     "wid1~rid1",
+							Source: json.RawMessage(`{"WorkflowId":"wid1","RunId":"rid1","VisibilityTaskKey":"1~100","CustomKeywordField":"42"}`),
+							Sort:   []any{1, 1, "rid1"},
+						},
+						{
+							Id:     "wid2~rid2",
+							Source: json.RawMessage(`{"WorkflowId":"wid2","RunId":"rid2","VisibilityTaskKey":"2~200","CustomKeywordField":"abc"}`),
+							Sort:   []any{2, 2, "rid2"},
+						},
+						{
+							Id:     "wid3~rid3",
+							Source: json.RawMessage(`{"WorkflowId":"wid3","RunId":"rid3","VisibilityTaskKey":"3~300","CustomKeywordField":"1","CustomIntField":7}`),
+							Sort:   []any{3, 3, "rid3"},
+						},
+					},
+				},
+			}, nil
+		})
+	mockBulkService := client.NewMockBulkService(s.controller)
+	s.mockESClient.EXPECT().Bulk().Return(mockBulkService)
+	mockBulkService.EXPECT().Add(&client.BulkableRequest{
+		RequestType: client.BulkableRequestTypeIndex,
+		Index:       testIndex,
+		ID:          "wid1~rid1",
+		Version:     100,
+		VersionType: client.VersionTypeExternalGTE,
+		Doc: map[string]any{
+			"WorkflowId":         "wid1",
+			"RunId":              "rid1",
+			"VisibilityTaskKey":  "1~100",
+			"CustomKeywordField": "42",
+			"CustomIntField":     int64(42),
+		},
+	})
+	mockBulkService.EXPECT().NumberOfActions().Return(1)
+	mockBulkService.EXPECT().Do(gomock.Any()).Return(&elastic.BulkResponse{
+		Items: []map[string]*elastic.BulkResponseItem{
+			{"index": {Id: "wid1~rid1", Status: 409}},
+		},
+	}, nil)
+
+	resp, err := s.visibilityStore.MigrateSearchAttributeValues(context.Background(), &manager.MigrateSearchAttributeValuesRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		SourceField: "CustomKeywordField",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "CustomIntField",
+		TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+		PageSize:    3,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.Processed)
+	s.Len(resp.InvalidValues, 1)
+	s.Equal("wid2", resp.InvalidValues[0].WorkflowID)
+	s.Equal("rid2", resp.InvalidValues[0].RunID)
+	token, err := s.visibilityStore.deserializePageToken(resp.NextPageToken)
+	s.NoError(err)
+	s.Len(token.SearchAfter, 3)
+}
+
+func (s *ESVisibilitySuite) Test_convertDocValue() {
+	value, err := convertDocValue([]any{"a", "b"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST)
+	s.NoError(err)
+	s.Equal([]string{"a", "b"}, value)
+
+	value, err = convertDocValue([]any{json.Number("1"), json.Number("2")}, enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE)
+	s.NoError(err)
+	s.Equal([]any{1.0, 2.0}, value)
+
+	value, err = convertDocValue("a", enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_TEXT)
+	s.NoError(err)
+	s.Equal("a", value)
+
+	_, err = convertDocValue(json.Number("1.5"), enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.Error(err)
+}
+
 func (s *ESVisibilitySuite) TestGetWorkflowExecution() {
 	s.mockESClient.EXPECT().Get(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index string, docID string) (*elastic.GetResult, error) {
@@ -1767,6 +2075,7 @@ func (s *ESVisibilitySuite) TestProcessPageToken() {
 				processorAckTimeout:            dynamicconfig.GetDurationPropertyFn(1 * time.Minute * debug.TimeoutMultiplier),
 				disableOrderByClause:           dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 				enableManualPagination:         dynamicconfig.GetBoolPropertyFnFilteredByNamespace(tc.manualPagination),
+				queryCostLimit:                 dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
 				metricsHandler:                 s.mockMetricsHandler,
 			}
 			params := &client.SearchParameters{
@@ -0,0 +1,28 @@
+package query
+
+import (
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// IsAggregateTypeSupported returns whether values of the given type can be aggregated with
+// AggregateWorkflowExecutions.
+func IsAggregateTypeSupported(t enumspb.IndexedValueType) bool {
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewAggregateTypeError returns the error for aggregating a search attribute of an unsupported type.
+func NewAggregateTypeError(name string) error {
+	return NewConverterError(
+		"%s: unable to aggregate search attribute '%s', only search attributes of type %s and %s are supported",
+		NotSupportedErrMessage,
+		name,
+		enumspb.INDEXED_VALUE_TYPE_INT,
+		enumspb.INDEXED_VALUE_TYPE_DOUBLE,
+	)
+}
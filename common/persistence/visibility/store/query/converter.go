@@ -61,7 +61,7 @@ type (
 	QueryParams struct {
 		Query   elastic.Query
 		Sorter  []elastic.Sorter
-		GroupBy []string
+		GroupBy []GroupByField
 	}
 )
 
@@ -218,15 +218,21 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 		queryParams.Query = query
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return nil, NewConverterError("%s: 'group by' clause supports only a single field", NotSupportedErrMessage)
-	}
 	for _, groupByExpr := range sel.GroupBy {
-		_, colName, err := convertColName(c.fnInterceptor, groupByExpr, FieldNameGroupBy)
+		groupByField, err := c.convertGroupByExpr(groupByExpr)
 		if err != nil {
 			return nil, wrapConverterError("unable to convert 'group by' column name", err)
 		}
-		queryParams.GroupBy = append(queryParams.GroupBy, colName)
+		for _, f := range queryParams.GroupBy {
+			if f.FieldName == groupByField.FieldName {
+				return nil, NewConverterError(
+					"%s: field '%s' appears more than once in 'group by' clause",
+					InvalidExpressionErrMessage,
+					sqlparser.String(groupByExpr),
+				)
+			}
+		}
+		queryParams.GroupBy = append(queryParams.GroupBy, groupByField)
 	}
 
 	for _, orderByExpr := range sel.OrderBy {
@@ -251,6 +257,26 @@ func (c *Converter) convertSelect(sel *sqlparser.Select) (*QueryParams, error) {
 	return queryParams, nil
 }
 
+func (c *Converter) convertGroupByExpr(expr sqlparser.Expr) (GroupByField, error) {
+	funcExpr, isFuncExpr := expr.(*sqlparser.FuncExpr)
+	if !isFuncExpr {
+		_, fieldName, err := convertColName(c.fnInterceptor, expr, FieldNameGroupBy)
+		if err != nil {
+			return GroupByField{}, err
+		}
+		return GroupByField{FieldName: fieldName}, nil
+	}
+	colNameExpr, timeBucket, err := ParseTimeBucketFunc(funcExpr)
+	if err != nil {
+		return GroupByField{}, err
+	}
+	_, fieldName, err := convertColName(c.fnInterceptor, colNameExpr, FieldNameTimeBucket)
+	if err != nil {
+		return GroupByField{}, err
+	}
+	return GroupByField{FieldName: fieldName, TimeBucket: timeBucket}, nil
+}
+
 func (w *WhereConverter) Convert(expr sqlparser.Expr) (elastic.Query, error) {
 	if expr == nil {
 		return nil, errors.New("cannot be nil")
@@ -0,0 +1,206 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/searchattribute"
+)
+
+type (
+	// QueryCost is the estimated cost of running the Elasticsearch query of QueryParams, in arbitrary units.
+	QueryCost struct {
+		Cost int64
+		// Reasons lists the parts of the query that contribute to the cost beyond a plain filter.
+		Reasons []string
+	}
+)
+
+const (
+	// clauseCost is the cost of a filter that can be answered directly from the index, e.g. a term or a range.
+	clauseCost = 1
+	// negationCost is added for each 'must_not' clause, which has to be checked against every candidate document.
+	negationCost = 2
+	// termsValuesPerCost is the number of values of an 'in' clause that add one to the cost.
+	termsValuesPerCost = 10
+	// fullTextMatchCost is the cost of a match on a Text field, which is analyzed and scored.
+	fullTextMatchCost = 5
+	// prefixCost is the cost of a 'starts_with' clause, which expands to all the terms with the prefix.
+	prefixCost = 3
+	// shortPrefixCost is the cost of a 'starts_with' clause with a prefix shorter than minPrefixLength, which
+	// expands to most terms of the field, like a leading wildcard.
+	shortPrefixCost = 20
+	minPrefixLength = 3
+	// timeRangeDaysPerCost is the number of days spanned by a range on a datetime field that add one to the cost.
+	timeRangeDaysPerCost = 30
+	// unboundedTimeRangeCost is the cost of a range on a datetime field without lower bound.
+	unboundedTimeRangeCost = 20
+	// unsortedOrderByCost is the cost of sorting by a field the index is not sorted by.
+	unsortedOrderByCost = 10
+	// groupByCost is the cost of each field of the 'group by' clause, which runs a nested aggregation.
+	groupByCost = 5
+)
+
+// indexSortFields are the fields the visibility index is sorted by, which can be sorted by cheaply.
+var indexSortFields = map[string]struct{}{
+	searchattribute.CloseTime: {},
+	searchattribute.StartTime: {},
+}
+
+// EstimateCost estimates the cost of running the query of params from the shape of the query: the kinds of clauses,
+// the number of values, the time ranges spanned, the sort fields and the 'group by' fields. Time ranges without upper
+// bound end at now.
+func EstimateCost(params *QueryParams, now time.Time) (*QueryCost, error) {
+	qc := &QueryCost{}
+	if params.Query != nil {
+		src, err := params.Query.Source()
+		if err != nil {
+			return nil, err
+		}
+		qc.addQuery(src, now)
+	}
+
+	for _, sorter := range params.Sorter {
+		src, err := sorter.Source()
+		if err != nil {
+			return nil, err
+		}
+		fields, _ := src.(map[string]any)
+		for field := range fields {
+			if _, ok := indexSortFields[field]; ok {
+				qc.Cost += clauseCost
+				continue
+			}
+			qc.add(unsortedOrderByCost, "'order by %s' does not follow the sort order of the index", field)
+		}
+	}
+
+	for _, field := range params.GroupBy {
+		qc.add(groupByCost, "'group by %s' runs an aggregation over all matching executions", field.FieldName)
+	}
+	return qc, nil
+}
+
+func (qc *QueryCost) add(cost int64, reasonFormat string, args ...any) {
+	qc.Cost += cost
+	qc.Reasons = append(qc.Reasons, fmt.Sprintf("%s (cost %d)", fmt.Sprintf(reasonFormat, args...), cost))
+}
+
+// addQuery adds the cost of the source of an Elasticsearch query as built by the converter.
+func (qc *QueryCost) addQuery(src any, now time.Time) {
+	switch s := src.(type) {
+	case []any:
+		for _, clause := range s {
+			qc.addQuery(clause, now)
+		}
+		return
+	case map[string]any:
+		if boolClauses, ok := s["bool"].(map[string]any); ok {
+			// Clause kinds are visited in a fixed order to keep the reasons stable.
+			for _, kind := range []string{"must", "filter", "should"} {
+				if clauses, ok := boolClauses[kind]; ok {
+					qc.addQuery(clauses, now)
+				}
+			}
+			if clauses, ok := boolClauses["must_not"]; ok {
+				qc.addNegation(clauses, now)
+			}
+			return
+		}
+		for kind, params := range s {
+			qc.addLeafQuery(kind, params, now)
+		}
+	}
+}
+
+func (qc *QueryCost) addNegation(src any, now time.Time) {
+	if clauses, ok := src.([]any); ok {
+		for _, clause := range clauses {
+			qc.addNegation(clause, now)
+		}
+		return
+	}
+	qc.Cost += negationCost
+	qc.addQuery(src, now)
+}
+
+func (qc *QueryCost) addLeafQuery(kind string, src any, now time.Time) {
+	fields, _ := src.(map[string]any)
+	for field, value := range fields {
+		switch kind {
+		case "terms":
+			values, _ := value.([]any)
+			qc.Cost += clauseCost + int64(len(values)/termsValuesPerCost)
+		case "match":
+			qc.add(fullTextMatchCost, "full-text match on %s", field)
+		case "prefix":
+			prefix, _ := value.(string)
+			if len(prefix) < minPrefixLength {
+				qc.add(
+					shortPrefixCost,
+					"'starts_with' on %s with prefix %q shorter than %d characters matches most values",
+					field,
+					prefix,
+					minPrefixLength,
+				)
+			} else {
+				qc.Cost += prefixCost
+			}
+		case "range":
+			qc.addRange(field, value, now)
+		default:
+			qc.Cost += clauseCost
+		}
+	}
+}
+
+func (qc *QueryCost) addRange(field string, src any, now time.Time) {
+	params, _ := src.(map[string]any)
+	from, isFromTime := parseRangeTime(params["from"])
+	to, isToTime := parseRangeTime(params["to"])
+	if !isFromTime && !isToTime {
+		// Not a datetime range.
+		qc.Cost += clauseCost
+		return
+	}
+	if params["from"] == nil {
+		qc.add(unboundedTimeRangeCost, "range on %s has no lower bound", field)
+		return
+	}
+	if params["to"] == nil {
+		to = now
+	}
+	days := int64(to.Sub(from) / (24 * time.Hour))
+	if cost := days / timeRangeDaysPerCost; cost > 0 {
+		qc.add(clauseCost+cost, "range on %s spans %d days", field, days)
+		return
+	}
+	qc.Cost += clauseCost
+}
+
+// parseRangeTime parses a bound of a range on a datetime field, which the converter formats as RFC3339Nano.
+func parseRangeTime(value any) (time.Time, bool) {
+	str, ok := value.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// NewQueryCostLimitExceededError returns the error for a query whose estimated cost exceeds the limit of the
+// namespace, explaining what makes the query expensive.
+func NewQueryCostLimitExceededError(qc *QueryCost, limit int64) error {
+	return serviceerror.NewInvalidArgumentf(
+		"%s: estimated cost %d exceeds the limit of %d: %s",
+		QueryTooExpensiveErrMessage,
+		qc.Cost,
+		limit,
+		strings.Join(qc.Reasons, "; "),
+	)
+}
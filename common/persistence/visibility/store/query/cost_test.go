@@ -0,0 +1,120 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateCost(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	formatTime := func(t time.Time) string { return t.Format(time.RFC3339Nano) }
+
+	testCases := []struct {
+		name            string
+		params          *QueryParams
+		expectedCost    int64
+		expectedReasons []string
+	}{
+		{
+			name:         "empty",
+			params:       &QueryParams{},
+			expectedCost: 0,
+		},
+		{
+			name: "plain filters",
+			params: &QueryParams{
+				Query: elastic.NewBoolQuery().Filter(
+					elastic.NewTermQuery("WorkflowType", "wt"),
+					elastic.NewTermsQuery("ExecutionStatus", "Running", "Completed"),
+					elastic.NewPrefixQuery("WorkflowId", "order-"),
+				),
+			},
+			expectedCost: 5,
+		},
+		{
+			name: "negation",
+			params: &QueryParams{
+				Query: elastic.NewBoolQuery().MustNot(elastic.NewTermQuery("WorkflowType", "wt")),
+			},
+			expectedCost: 3,
+		},
+		{
+			name: "many values",
+			params: &QueryParams{
+				Query: elastic.NewTermsQuery("WorkflowId", make([]any, 25)...),
+			},
+			expectedCost: 3,
+		},
+		{
+			name: "short prefix and full-text match",
+			params: &QueryParams{
+				Query: elastic.NewBoolQuery().Should(
+					elastic.NewPrefixQuery("WorkflowId", "a"),
+					elastic.NewMatchQuery("CustomTextField", "foo"),
+				),
+			},
+			expectedCost: 25,
+			expectedReasons: []string{
+				`'starts_with' on WorkflowId with prefix "a" shorter than 3 characters matches most values (cost 20)`,
+				"full-text match on CustomTextField (cost 5)",
+			},
+		},
+		{
+			name: "time ranges",
+			params: &QueryParams{
+				Query: elastic.NewBoolQuery().Filter(
+					elastic.NewRangeQuery("StartTime").Gte(formatTime(now.AddDate(0, 0, -7))),
+					elastic.NewRangeQuery("CloseTime").Gte(formatTime(now.AddDate(0, 0, -300))),
+					elastic.NewRangeQuery("ExecutionTime").Lt(formatTime(now)),
+					elastic.NewRangeQuery("CustomIntField").Gte(1).Lte(1000000),
+				),
+			},
+			expectedCost: 33,
+			expectedReasons: []string{
+				"range on CloseTime spans 300 days (cost 11)",
+				"range on ExecutionTime has no lower bound (cost 20)",
+			},
+		},
+		{
+			name: "order by",
+			params: &QueryParams{
+				Sorter: []elastic.Sorter{
+					elastic.NewFieldSort("StartTime").Desc(),
+					elastic.NewFieldSort("CustomKeywordField"),
+				},
+			},
+			expectedCost: 11,
+			expectedReasons: []string{
+				"'order by CustomKeywordField' does not follow the sort order of the index (cost 10)",
+			},
+		},
+		{
+			name: "group by",
+			params: &QueryParams{
+				GroupBy: []GroupByField{{FieldName: "ExecutionStatus"}, {FieldName: "WorkflowType"}},
+			},
+			expectedCost: 10,
+			expectedReasons: []string{
+				"'group by ExecutionStatus' runs an aggregation over all matching executions (cost 5)",
+				"'group by WorkflowType' runs an aggregation over all matching executions (cost 5)",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			qc, err := EstimateCost(tc.params, now)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCost, qc.Cost)
+			assert.Equal(t, tc.expectedReasons, qc.Reasons)
+		})
+	}
+}
+
+func TestNewQueryCostLimitExceededError(t *testing.T) {
+	err := NewQueryCostLimitExceededError(&QueryCost{Cost: 30, Reasons: []string{"a (cost 20)", "b (cost 10)"}}, 25)
+	assert.EqualError(t, err, "query is too expensive: estimated cost 30 exceeds the limit of 25: a (cost 20); b (cost 10)")
+}
@@ -17,6 +17,7 @@ var (
 	MalformedSqlQueryErrMessage = "malformed SQL query"
 	NotSupportedErrMessage      = "operation is not supported"
 	InvalidExpressionErrMessage = "invalid expression"
+	QueryTooExpensiveErrMessage = "query is too expensive"
 )
 
 func NewConverterError(format string, a ...interface{}) error {
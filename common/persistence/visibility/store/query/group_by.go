@@ -0,0 +1,95 @@
+package query
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+type (
+	// GroupByField is a field of the 'group by' clause.
+	GroupByField struct {
+		FieldName string
+		// TimeBucket is the unit datetime values are truncated to before grouping. Empty if values are grouped as is.
+		TimeBucket TimeBucket
+	}
+
+	// TimeBucket is a unit of the time bucket function of the 'group by' clause.
+	TimeBucket string
+)
+
+const (
+	// TimeBucketFuncName is the name of the function grouping datetime values into buckets, as in
+	// "GROUP BY date_trunc('hour', StartTime)".
+	TimeBucketFuncName = "date_trunc"
+
+	TimeBucketMinute TimeBucket = "minute"
+	TimeBucketHour   TimeBucket = "hour"
+	TimeBucketDay    TimeBucket = "day"
+	TimeBucketMonth  TimeBucket = "month"
+)
+
+var supportedTimeBuckets = []TimeBucket{
+	TimeBucketMinute,
+	TimeBucketHour,
+	TimeBucketDay,
+	TimeBucketMonth,
+}
+
+// IsGroupByTypeSupported returns whether values of the given type can be grouped as is.
+// Datetime values can only be grouped into time buckets.
+func IsGroupByTypeSupported(t enumspb.IndexedValueType) bool {
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		enumspb.INDEXED_VALUE_TYPE_BOOL,
+		enumspb.INDEXED_VALUE_TYPE_INT:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseTimeBucketFunc parses a time bucket function of the 'group by' clause and returns its column expression and unit.
+func ParseTimeBucketFunc(funcExpr *sqlparser.FuncExpr) (sqlparser.Expr, TimeBucket, error) {
+	if !funcExpr.Name.EqualString(TimeBucketFuncName) {
+		return nil, "", NewConverterError(
+			"%s: function '%s' in 'group by' clause, only '%s' is supported",
+			NotSupportedErrMessage,
+			funcExpr.Name.String(),
+			TimeBucketFuncName,
+		)
+	}
+	if funcExpr.Distinct || len(funcExpr.Exprs) != 2 {
+		return nil, "", NewConverterError(
+			"%s: '%s' expects a unit and a column name, e.g. %s('hour', StartTime)",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+			TimeBucketFuncName,
+		)
+	}
+	unitExpr, isUnitExpr := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+	colExpr, isColExpr := funcExpr.Exprs[1].(*sqlparser.AliasedExpr)
+	if !isUnitExpr || !isColExpr {
+		return nil, "", NewConverterError("%s: invalid arguments of '%s'", InvalidExpressionErrMessage, TimeBucketFuncName)
+	}
+	unitVal, isUnitVal := unitExpr.Expr.(*sqlparser.SQLVal)
+	if !isUnitVal || unitVal.Type != sqlparser.StrVal {
+		return nil, "", NewConverterError(
+			"%s: unit of '%s' must be a string literal",
+			InvalidExpressionErrMessage,
+			TimeBucketFuncName,
+		)
+	}
+	unit := TimeBucket(strings.ToLower(string(unitVal.Val)))
+	if !slices.Contains(supportedTimeBuckets, unit) {
+		return nil, "", NewConverterError(
+			"%s: unknown unit '%s' of '%s', supported units are: minute, hour, day, month",
+			InvalidExpressionErrMessage,
+			string(unitVal.Val),
+			TimeBucketFuncName,
+		)
+	}
+	return colExpr.Expr, unit, nil
+}
@@ -32,6 +32,14 @@ type (
 
 		buildCountStmt(namespaceID namespace.ID, queryString string, groupBy []string) (string, []any)
 
+		// buildAggregateStmt returns a statement selecting the given expressions over the executions matching the
+		// query string, followed by the given suffix (e.g. ORDER BY and LIMIT clauses).
+		buildAggregateStmt(namespaceID namespace.ID, queryString string, selectExprs []string, suffix string) (string, []any)
+
+		// buildTimeBucketExpr returns an expression formatting the start of the time bucket the datetime expression
+		// falls into with timeBucketLayout.
+		buildTimeBucketExpr(expr sqlparser.Expr, timeBucket query.TimeBucket) sqlparser.Expr
+
 		getDatetimeFormat() string
 
 		getCoalesceCloseTimeExpr() sqlparser.Expr
@@ -52,6 +60,8 @@ type (
 		queryString string
 		// List of search attributes to group by (field name, not db name).
 		groupBy []string
+		// SQL expressions of the search attributes to group by.
+		groupByExprs []string
 	}
 )
 
@@ -62,6 +72,10 @@ const (
 	// Thus, in order to avoid having specific code for each DB, it's better to
 	// set the escape char to a simpler char that doesn't require escaping.
 	defaultLikeEscapeChar = '!'
+
+	// timeBucketLayout is the layout of the start time of the time buckets returned by the DB when grouping by
+	// date_trunc. Values are in UTC.
+	timeBucketLayout = "2006-01-02 15:04:05"
 )
 
 var (
@@ -160,11 +174,7 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	if err != nil {
 		return nil, err
 	}
-	groupByDbNames := make([]string, len(qp.groupBy))
-	for i, fieldName := range qp.groupBy {
-		groupByDbNames[i] = searchattribute.GetSqlDbColName(fieldName)
-	}
-	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, groupByDbNames)
+	queryString, queryArgs := c.buildCountStmt(c.namespaceID, qp.queryString, qp.groupByExprs)
 	return &sqlplugin.VisibilitySelectFilter{
 		Query:     queryString,
 		QueryArgs: queryArgs,
@@ -172,6 +182,82 @@ func (c *QueryConverter) BuildCountStmt() (*sqlplugin.VisibilitySelectFilter, er
 	}, nil
 }
 
+// BuildAggregateStmt builds a statement returning the count, sum, average, minimum and maximum of the non-null values
+// of the field over the executions matching the query, followed by one column per requested percentile. Percentiles
+// use the nearest-rank method: the value of a percentile p is the smallest value whose 1-based rank in ascending order
+// is at least p/100 of the count. They are computed in the same statement as the count, so that both are based on the
+// same rows, and the values are only sorted if percentiles are requested.
+func (c *QueryConverter) BuildAggregateStmt(
+	fieldName string,
+	percentiles []float64,
+) (*sqlplugin.VisibilitySelectFilter, error) {
+	qp, colName, err := c.convertAggregateQuery(fieldName)
+	if err != nil {
+		return nil, err
+	}
+	if len(percentiles) == 0 {
+		queryString, queryArgs := c.buildAggregateStmt(
+			c.namespaceID,
+			qp.queryString,
+			[]string{
+				fmt.Sprintf("COUNT(%s)", colName),
+				fmt.Sprintf("SUM(%s)", colName),
+				fmt.Sprintf("AVG(%s)", colName),
+				fmt.Sprintf("MIN(%s)", colName),
+				fmt.Sprintf("MAX(%s)", colName),
+			},
+			"",
+		)
+		return &sqlplugin.VisibilitySelectFilter{Query: queryString, QueryArgs: queryArgs}, nil
+	}
+
+	queryString := fmt.Sprintf("%s IS NOT NULL", colName)
+	if len(qp.queryString) > 0 {
+		queryString = fmt.Sprintf("%s AND %s", qp.queryString, queryString)
+	}
+	rankedQuery, queryArgs := c.buildAggregateStmt(
+		c.namespaceID,
+		queryString,
+		[]string{
+			fmt.Sprintf("%s AS value", colName),
+			fmt.Sprintf("ROW_NUMBER() OVER (ORDER BY %s) AS row_num", colName),
+			"COUNT(*) OVER () AS row_count",
+		},
+		"",
+	)
+	selectExprs := []string{"COUNT(value)", "SUM(value)", "AVG(value)", "MIN(value)", "MAX(value)"}
+	for _, percentile := range percentiles {
+		// The rank is compared without a division, which would truncate to an integer in some databases.
+		selectExprs = append(selectExprs, fmt.Sprintf(
+			"MIN(CASE WHEN row_num * 100 >= %s * row_count THEN value END)",
+			strconv.FormatFloat(percentile, 'f', -1, 64),
+		))
+	}
+	return &sqlplugin.VisibilitySelectFilter{
+		Query:     fmt.Sprintf("SELECT %s FROM (%s) ranked", strings.Join(selectExprs, ", "), rankedQuery),
+		QueryArgs: queryArgs,
+	}, nil
+}
+
+func (c *QueryConverter) convertAggregateQuery(fieldName string) (*queryParams, string, error) {
+	qp, err := c.convertWhereString(c.queryString)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(qp.groupBy) > 0 {
+		return nil, "", query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage)
+	}
+	var expr sqlparser.Expr = &sqlparser.ColName{Name: sqlparser.NewColIdent(fieldName)}
+	colName, err := c.convertColName(&expr)
+	if err != nil {
+		return nil, "", err
+	}
+	if !query.IsAggregateTypeSupported(colName.valueType) {
+		return nil, "", query.NewAggregateTypeError(fieldName)
+	}
+	return qp, sqlparser.String(expr), nil
+}
+
 func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, error) {
 	where := strings.TrimSpace(queryString)
 	if where != "" &&
@@ -197,9 +283,14 @@ func (c *QueryConverter) convertWhereString(queryString string) (*queryParams, e
 		res.queryString = sqlparser.String(selectStmt.Where.Expr)
 	}
 	for _, groupByExpr := range selectStmt.GroupBy {
-		// The parser already ensures the type is saColName.
-		colName := groupByExpr.(*saColName)
-		res.groupBy = append(res.groupBy, colName.fieldName)
+		// convertGroupByExpr already ensures the type is saColName or timeBucketExpr.
+		switch e := groupByExpr.(type) {
+		case *saColName:
+			res.groupBy = append(res.groupBy, e.fieldName)
+		case *timeBucketExpr:
+			res.groupBy = append(res.groupBy, e.fieldName)
+		}
+		res.groupByExprs = append(res.groupByExprs, sqlparser.String(groupByExpr))
 	}
 	return res, nil
 }
@@ -258,29 +349,71 @@ func (c *QueryConverter) convertSelectStmt(sel *sqlparser.Select) error {
 		}
 	}
 
-	if len(sel.GroupBy) > 1 {
-		return query.NewConverterError(
-			"%s: 'group by' clause supports only a single field",
-			query.NotSupportedErrMessage,
-		)
-	}
+	seenGroupBy := make(map[string]bool, len(sel.GroupBy))
 	for k := range sel.GroupBy {
-		colName, err := c.convertColName(&sel.GroupBy[k])
+		fieldName, err := c.convertGroupByExpr(&sel.GroupBy[k])
 		if err != nil {
 			return err
 		}
-		if colName.fieldName != searchattribute.ExecutionStatus {
+		if seenGroupBy[fieldName] {
 			return query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
-				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				"%s: field '%s' appears more than once in 'group by' clause",
+				query.InvalidExpressionErrMessage,
+				fieldName,
 			)
 		}
+		seenGroupBy[fieldName] = true
 	}
 
 	return nil
 }
 
+// convertGroupByExpr converts a column name or time bucket function of the 'group by' clause and returns the field name
+// of its search attribute.
+func (c *QueryConverter) convertGroupByExpr(exprRef *sqlparser.Expr) (string, error) {
+	funcExpr, isFuncExpr := (*exprRef).(*sqlparser.FuncExpr)
+	if !isFuncExpr {
+		colName, err := c.convertColName(exprRef)
+		if err != nil {
+			return "", err
+		}
+		if !query.IsGroupByTypeSupported(colName.valueType) {
+			return "", query.NewConverterError(
+				"%s: 'group by' clause is only supported for search attributes of type %s, %s and %s, or %s with '%s'",
+				query.NotSupportedErrMessage,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				enumspb.INDEXED_VALUE_TYPE_BOOL,
+				enumspb.INDEXED_VALUE_TYPE_INT,
+				enumspb.INDEXED_VALUE_TYPE_DATETIME,
+				query.TimeBucketFuncName,
+			)
+		}
+		return colName.fieldName, nil
+	}
+
+	colExpr, timeBucket, err := query.ParseTimeBucketFunc(funcExpr)
+	if err != nil {
+		return "", err
+	}
+	colName, err := c.convertColName(&colExpr)
+	if err != nil {
+		return "", err
+	}
+	if colName.valueType != enumspb.INDEXED_VALUE_TYPE_DATETIME {
+		return "", query.NewConverterError(
+			"%s: '%s' is only supported for search attributes of type %s",
+			query.NotSupportedErrMessage,
+			query.TimeBucketFuncName,
+			enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		)
+	}
+	*exprRef = &timeBucketExpr{
+		Expr:      c.buildTimeBucketExpr(colExpr, timeBucket),
+		fieldName: colName.fieldName,
+	}
+	return colName.fieldName, nil
+}
+
 func (c *QueryConverter) convertWhereExpr(expr *sqlparser.Expr) error {
 	if expr == nil || *expr == nil {
 		return errors.New("cannot be nil")
@@ -370,6 +503,11 @@ func (c *QueryConverter) convertComparisonExpr(exprRef *sqlparser.Expr) error {
 		}
 		*exprRef = newExpr
 	case enumspb.INDEXED_VALUE_TYPE_TEXT:
+		// Values of the predefined full-text search attributes are stored normalized, and the query value must match
+		// their tokens.
+		if valueExpr, ok := expr.Right.(*unsafeSQLString); ok && searchattribute.IsNormalizedText(saColNameExpr.fieldName) {
+			valueExpr.Val = searchattribute.NormalizeFullText(valueExpr.Val, 0)
+		}
 		newExpr, err := c.convertTextComparisonExpr(expr)
 		if err != nil {
 			return err
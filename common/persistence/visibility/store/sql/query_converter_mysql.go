@@ -45,6 +45,13 @@ var _ sqlparser.Expr = (*jsonOverlapsExpr)(nil)
 
 var _ pluginQueryConverter = (*mysqlQueryConverter)(nil)
 
+var mysqlTimeBucketFormats = map[query.TimeBucket]string{
+	query.TimeBucketMinute: "%Y-%m-%d %H:%i:00",
+	query.TimeBucketHour:   "%Y-%m-%d %H:00:00",
+	query.TimeBucketDay:    "%Y-%m-%d 00:00:00",
+	query.TimeBucketMonth:  "%Y-%m-01 00:00:00",
+}
+
 func (node *castExpr) Format(buf *sqlparser.TrackedBuffer) {
 	buf.Myprintf("cast(%v as %v)", node.Value, node.Type)
 }
@@ -89,6 +96,10 @@ func (c *mysqlQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *mysqlQueryConverter) buildTimeBucketExpr(expr sqlparser.Expr, timeBucket query.TimeBucket) sqlparser.Expr {
+	return newFuncExpr("date_format", expr, newUnsafeSQLString(mysqlTimeBucketFormats[timeBucket]))
+}
+
 func (c *mysqlQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -285,3 +296,37 @@ func (c *mysqlQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *mysqlQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+	suffix string,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	return fmt.Sprintf(
+		`SELECT %s
+		FROM executions_visibility ev
+		LEFT JOIN custom_search_attributes
+		USING (%s, %s)
+		WHERE %s
+		%s`,
+		strings.Join(selectExprs, ", "),
+		searchattribute.GetSqlDbColName(searchattribute.NamespaceID),
+		searchattribute.GetSqlDbColName(searchattribute.RunID),
+		strings.Join(whereClauses, " AND "),
+		suffix,
+	), queryArgs
+}
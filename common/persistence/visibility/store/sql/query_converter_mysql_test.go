@@ -32,6 +32,13 @@ func (s *mysqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *mysqlQueryConverterSuite) TestBuildTimeBucketExpr() {
+	expr := s.queryConverter.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketHour)
+	s.Equal("date_format(start_time, '%Y-%m-%d %H:00:00')", sqlparser.String(expr))
+	expr = s.queryConverter.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketMonth)
+	s.Equal("date_format(start_time, '%Y-%m-01 00:00:00')", sqlparser.String(expr))
+}
+
 func (s *mysqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
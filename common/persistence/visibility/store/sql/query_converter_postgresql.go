@@ -34,6 +34,13 @@ var (
 var _ sqlparser.Expr = (*pgCastExpr)(nil)
 var _ pluginQueryConverter = (*pgQueryConverter)(nil)
 
+var pgTimeBucketFormats = map[query.TimeBucket]string{
+	query.TimeBucketMinute: "YYYY-MM-DD HH24:MI:00",
+	query.TimeBucketHour:   "YYYY-MM-DD HH24:00:00",
+	query.TimeBucketDay:    "YYYY-MM-DD 00:00:00",
+	query.TimeBucketMonth:  "YYYY-MM-01 00:00:00",
+}
+
 func (node *pgCastExpr) Format(buf *sqlparser.TrackedBuffer) {
 	buf.Myprintf("%v::%v", node.Value, node.Type)
 }
@@ -67,6 +74,10 @@ func (c *pgQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *pgQueryConverter) buildTimeBucketExpr(expr sqlparser.Expr, timeBucket query.TimeBucket) sqlparser.Expr {
+	return newFuncExpr("to_char", expr, newUnsafeSQLString(pgTimeBucketFormats[timeBucket]))
+}
+
 func (c *pgQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -281,3 +292,30 @@ func (c *pgQueryConverter) buildCountStmt(
 		groupByClause,
 	), queryArgs
 }
+
+func (c *pgQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+	suffix string,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s %s",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+		suffix,
+	), queryArgs
+}
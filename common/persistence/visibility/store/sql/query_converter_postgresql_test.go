@@ -32,6 +32,13 @@ func (s *postgresqlQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *postgresqlQueryConverterSuite) TestBuildTimeBucketExpr() {
+	expr := s.queryConverter.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketHour)
+	s.Equal("to_char(start_time, 'YYYY-MM-DD HH24:00:00')", sqlparser.String(expr))
+	expr = s.queryConverter.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketMonth)
+	s.Equal("to_char(start_time, 'YYYY-MM-01 00:00:00')", sqlparser.String(expr))
+}
+
 func (s *postgresqlQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -114,6 +121,12 @@ func (s *postgresqlQueryConverterSuite) TestConvertTextComparisonExpr() {
 			output: "not Text01 @@ 'foo | bar'::tsquery",
 			err:    nil,
 		},
+		{
+			name:   "normalized full-text search attribute",
+			input:  "TemporalCloseFailureMessage = 'Card_Expired: Payment'",
+			output: "TemporalCloseFailureMessage @@ 'card | expired | payment'::tsquery",
+			err:    nil,
+		},
 	}
 
 	for _, tc := range tests {
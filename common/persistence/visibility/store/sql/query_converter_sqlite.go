@@ -17,6 +17,14 @@ type (
 
 var _ pluginQueryConverter = (*sqliteQueryConverter)(nil)
 
+// sqliteTimeBucketFormats are applied with strftime, which converts datetime values with time zone offsets to UTC.
+var sqliteTimeBucketFormats = map[query.TimeBucket]string{
+	query.TimeBucketMinute: "%Y-%m-%d %H:%M:00",
+	query.TimeBucketHour:   "%Y-%m-%d %H:00:00",
+	query.TimeBucketDay:    "%Y-%m-%d 00:00:00",
+	query.TimeBucketMonth:  "%Y-%m-01 00:00:00",
+}
+
 const (
 	keywordListTypeFtsTableName = "executions_visibility_fts_keyword_list"
 	textTypeFtsTableName        = "executions_visibility_fts_text"
@@ -51,6 +59,10 @@ func (c *sqliteQueryConverter) getCoalesceCloseTimeExpr() sqlparser.Expr {
 	)
 }
 
+func (c *sqliteQueryConverter) buildTimeBucketExpr(expr sqlparser.Expr, timeBucket query.TimeBucket) sqlparser.Expr {
+	return newFuncExpr("strftime", newUnsafeSQLString(sqliteTimeBucketFormats[timeBucket]), expr)
+}
+
 func (c *sqliteQueryConverter) convertKeywordListComparisonExpr(
 	expr *sqlparser.ComparisonExpr,
 ) (sqlparser.Expr, error) {
@@ -322,6 +334,33 @@ func (c *sqliteQueryConverter) buildCountStmt(
 	), queryArgs
 }
 
+func (c *sqliteQueryConverter) buildAggregateStmt(
+	namespaceID namespace.ID,
+	queryString string,
+	selectExprs []string,
+	suffix string,
+) (string, []any) {
+	var whereClauses []string
+	var queryArgs []any
+
+	whereClauses = append(
+		whereClauses,
+		fmt.Sprintf("(%s = ?)", searchattribute.GetSqlDbColName(searchattribute.NamespaceID)),
+	)
+	queryArgs = append(queryArgs, namespaceID.String())
+
+	if len(queryString) > 0 {
+		whereClauses = append(whereClauses, queryString)
+	}
+
+	return fmt.Sprintf(
+		"SELECT %s FROM executions_visibility WHERE %s %s",
+		strings.Join(selectExprs, ", "),
+		strings.Join(whereClauses, " AND "),
+		suffix,
+	), queryArgs
+}
+
 func buildFtsQueryString(colname string, values ...string) string {
 	// FTS query format: 'colname : ("token1" OR "token2" OR ...)'
 	return fmt.Sprintf(`%s : ("%s")`, colname, strings.Join(values, `" OR "`))
@@ -32,6 +32,13 @@ func (s *sqliteQueryConverterSuite) TestGetCoalesceCloseTimeExpr() {
 	)
 }
 
+func (s *sqliteQueryConverterSuite) TestBuildTimeBucketExpr() {
+	expr := s.queryConverter.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketHour)
+	s.Equal("strftime('%Y-%m-%d %H:00:00', start_time)", sqlparser.String(expr))
+	expr = s.queryConverter.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketMonth)
+	s.Equal("strftime('%Y-%m-01 00:00:00', start_time)", sqlparser.String(expr))
+}
+
 func (s *sqliteQueryConverterSuite) TestConvertKeywordListComparisonExpr() {
 	var tests = []testCase{
 		{
@@ -97,29 +97,80 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 		{
 			name:  "group by one field",
 			input: "GROUP BY ExecutionStatus",
+			output: &queryParams{
+				queryString:  "TemporalNamespaceDivision is null",
+				groupBy:      []string{searchattribute.ExecutionStatus},
+				groupByExprs: []string{"status"},
+			},
+			err: nil,
+		},
+		{
+			name:  "group by multiple fields",
+			input: "GROUP BY ExecutionStatus, WorkflowType, AliasForBool01",
+			output: &queryParams{
+				queryString:  "TemporalNamespaceDivision is null",
+				groupBy:      []string{searchattribute.ExecutionStatus, searchattribute.WorkflowType, "Bool01"},
+				groupByExprs: []string{"status", "workflow_type_name", "Bool01"},
+			},
+			err: nil,
+		},
+		{
+			name:  "group by time bucket",
+			input: "GROUP BY ExecutionStatus, date_trunc('hour', StartTime)",
 			output: &queryParams{
 				queryString: "TemporalNamespaceDivision is null",
-				groupBy:     []string{searchattribute.ExecutionStatus},
+				groupBy:     []string{searchattribute.ExecutionStatus, searchattribute.StartTime},
+				groupByExprs: []string{
+					"status",
+					sqlparser.String(s.pqc.buildTimeBucketExpr(newColName("start_time"), query.TimeBucketHour)),
+				},
 			},
 			err: nil,
 		},
 		{
-			name:   "group by two fields not supported",
-			input:  "GROUP BY ExecutionStatus, WorkflowType",
+			name:   "group by same field twice",
+			input:  "GROUP BY WorkflowType, WorkflowType",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause supports only a single field",
+				"%s: field '%s' appears more than once in 'group by' clause",
+				query.InvalidExpressionErrMessage,
+				searchattribute.WorkflowType,
+			),
+		},
+		{
+			name:   "group by unsupported type",
+			input:  "GROUP BY StartTime",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: 'group by' clause is only supported for search attributes of type %s, %s and %s, or %s with '%s'",
 				query.NotSupportedErrMessage,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				enumspb.INDEXED_VALUE_TYPE_BOOL,
+				enumspb.INDEXED_VALUE_TYPE_INT,
+				enumspb.INDEXED_VALUE_TYPE_DATETIME,
+				query.TimeBucketFuncName,
 			),
 		},
 		{
-			name:   "group by non ExecutionStatus",
-			input:  "GROUP BY WorkflowType",
+			name:   "group by time bucket of non datetime field",
+			input:  "GROUP BY date_trunc('hour', WorkflowType)",
 			output: nil,
 			err: query.NewConverterError(
-				"%s: 'group by' clause is only supported for %s search attribute",
+				"%s: '%s' is only supported for search attributes of type %s",
 				query.NotSupportedErrMessage,
-				searchattribute.ExecutionStatus,
+				query.TimeBucketFuncName,
+				enumspb.INDEXED_VALUE_TYPE_DATETIME,
+			),
+		},
+		{
+			name:   "group by unknown time bucket unit",
+			input:  "GROUP BY date_trunc('week', StartTime)",
+			output: nil,
+			err: query.NewConverterError(
+				"%s: unknown unit '%s' of '%s', supported units are: minute, hour, day, month",
+				query.InvalidExpressionErrMessage,
+				"week",
+				query.TimeBucketFuncName,
 			),
 		},
 		{
@@ -158,6 +209,46 @@ func (s *queryConverterSuite) TestConvertWhereString() {
 	}
 }
 
+func (s *queryConverterSuite) TestBuildAggregateStmt() {
+	newConverter := func(queryString string) *QueryConverter {
+		return newQueryConverterInternal(
+			s.pqc,
+			testNamespaceName,
+			testNamespaceID,
+			searchattribute.TestNameTypeMap,
+			&searchattribute.TestMapper{},
+			queryString,
+		)
+	}
+
+	filter, err := newConverter("AliasForKeyword01 = 'foo'").BuildAggregateStmt(searchattribute.ExecutionDuration, nil)
+	s.NoError(err)
+	s.Contains(
+		filter.Query,
+		"COUNT(execution_duration), SUM(execution_duration), AVG(execution_duration), MIN(execution_duration), MAX(execution_duration)",
+	)
+	s.Contains(filter.Query, "(Keyword01 = 'foo') and TemporalNamespaceDivision is null")
+	s.Equal([]any{testNamespaceID.String()}, filter.QueryArgs)
+
+	filter, err = newConverter("AliasForKeyword01 = 'foo'").BuildAggregateStmt("AliasForInt01", []float64{50, 99.9})
+	s.NoError(err)
+	s.Contains(
+		filter.Query,
+		"SELECT COUNT(value), SUM(value), AVG(value), MIN(value), MAX(value), "+
+			"MIN(CASE WHEN row_num * 100 >= 50 * row_count THEN value END), "+
+			"MIN(CASE WHEN row_num * 100 >= 99.9 * row_count THEN value END) FROM (",
+	)
+	s.Contains(filter.Query, "Int01 AS value, ROW_NUMBER() OVER (ORDER BY Int01) AS row_num, COUNT(*) OVER () AS row_count")
+	s.Contains(filter.Query, "(Keyword01 = 'foo') and TemporalNamespaceDivision is null AND Int01 IS NOT NULL")
+	s.Equal([]any{testNamespaceID.String()}, filter.QueryArgs)
+
+	_, err = newConverter("").BuildAggregateStmt(searchattribute.WorkflowType, nil)
+	s.Equal(query.NewAggregateTypeError(searchattribute.WorkflowType), err)
+
+	_, err = newConverter("GROUP BY ExecutionStatus").BuildAggregateStmt(searchattribute.ExecutionDuration, nil)
+	s.Equal(query.NewConverterError("%s: 'group by' clause", query.NotSupportedErrMessage), err)
+}
+
 func (s *queryConverterSuite) TestConvertAndExpr() {
 	var tests = []testCase{
 		{
@@ -30,6 +30,12 @@ type (
 		fieldName string
 		valueType enumspb.IndexedValueType
 	}
+
+	// timeBucketExpr is a time bucket function of the 'group by' clause converted to the DB specific expression.
+	timeBucketExpr struct {
+		sqlparser.Expr
+		fieldName string
+	}
 )
 
 const (
@@ -39,6 +45,7 @@ const (
 var _ sqlparser.Expr = (*unsafeSQLString)(nil)
 var _ sqlparser.Expr = (*colName)(nil)
 var _ sqlparser.Expr = (*saColName)(nil)
+var _ sqlparser.Expr = (*timeBucketExpr)(nil)
 
 var (
 	maxDatetimeValue = getMaxDatetimeValue()
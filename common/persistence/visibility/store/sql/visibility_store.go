@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -206,21 +207,9 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 		}
 	}
 
-	var nextPageToken []byte
-	if len(rows) == request.PageSize {
-		lastRow := rows[len(rows)-1]
-		closeTime := maxTime
-		if lastRow.CloseTime != nil {
-			closeTime = *lastRow.CloseTime
-		}
-		nextPageToken, err = serializePageToken(&pageToken{
-			CloseTime: closeTime,
-			StartTime: lastRow.StartTime,
-			RunID:     lastRow.RunID,
-		})
-		if err != nil {
-			return nil, err
-		}
+	nextPageToken, err := buildNextPageToken(rows, request.PageSize)
+	if err != nil {
+		return nil, err
 	}
 	return &store.InternalListWorkflowExecutionsResponse{
 		Executions:    infos,
@@ -228,6 +217,22 @@ func (s *VisibilityStore) ListWorkflowExecutions(
 	}, nil
 }
 
+func buildNextPageToken(rows []sqlplugin.VisibilityRow, pageSize int) ([]byte, error) {
+	if len(rows) < pageSize {
+		return nil, nil
+	}
+	lastRow := rows[len(rows)-1]
+	closeTime := maxTime
+	if lastRow.CloseTime != nil {
+		closeTime = *lastRow.CloseTime
+	}
+	return serializePageToken(&pageToken{
+		CloseTime: closeTime,
+		StartTime: lastRow.StartTime,
+		RunID:     lastRow.RunID,
+	})
+}
+
 func (s *VisibilityStore) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *manager.ListWorkflowExecutionsRequestV2,
@@ -306,6 +311,10 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	for _, row := range rows {
 		groupValues := make([]*commonpb.Payload, len(row.GroupValues))
 		for i, val := range row.GroupValues {
+			val, err = parseGroupByValue(val, groupByTypes[i])
+			if err != nil {
+				return nil, err
+			}
 			groupValues[i], err = searchattribute.EncodeValue(val, groupByTypes[i])
 			if err != nil {
 				return nil, err
@@ -323,6 +332,141 @@ func (s *VisibilityStore) countGroupByWorkflowExecutions(
 	return resp, nil
 }
 
+func (s *VisibilityStore) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	selectFilter, err := converter.BuildAggregateStmt(request.FieldName, request.Percentiles)
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	values, err := s.sqlStore.Db.AggregateFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("AggregateWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+	if len(values) != 5+len(request.Percentiles) {
+		return nil, serviceerror.NewInternalf(
+			"AggregateWorkflowExecutions operation failed. Query returned %d values, expected %d.",
+			len(values), 5+len(request.Percentiles))
+	}
+	resp := &manager.AggregateWorkflowExecutionsResponse{
+		Count:            int64(values[0].Float64),
+		Sum:              values[1].Float64,
+		Avg:              values[2].Float64,
+		Min:              values[3].Float64,
+		Max:              values[4].Float64,
+		PercentileValues: make([]float64, len(request.Percentiles)),
+	}
+	if resp.Count == 0 {
+		return resp, nil
+	}
+	for i, value := range values[5:] {
+		if !value.Valid {
+			return nil, serviceerror.NewInternalf(
+				"AggregateWorkflowExecutions operation failed. No value found for percentile %v of %d values.",
+				request.Percentiles[i], resp.Count)
+		}
+		resp.PercentileValues[i] = value.Float64
+	}
+	return resp, nil
+}
+
+// ExplainWorkflowExecutions only returns the SQL statement of the query. Unlike Elasticsearch, SQL stores don't estimate
+// the cost of queries and don't limit it.
+func (s *VisibilityStore) ExplainWorkflowExecutions(
+	_ context.Context,
+	request *manager.ExplainWorkflowExecutionsRequest,
+) (*manager.ExplainWorkflowExecutionsResponse, error) {
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	saMapper, err := s.searchAttributesMapperProvider.GetMapper(request.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		saMapper,
+		request.Query,
+	)
+	// Queries with 'group by' clause are only supported by CountWorkflowExecutions.
+	selectFilter, err := converter.BuildCountStmt()
+	if err == nil && len(selectFilter.GroupBy) == 0 {
+		selectFilter, err = converter.BuildSelectStmt(request.PageSize, nil)
+	}
+	if err != nil {
+		// Convert ConverterError to InvalidArgument and pass through all other errors (which should be only mapper errors).
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+
+	return &manager.ExplainWorkflowExecutionsResponse{
+		StoreQuery: fmt.Sprintf("%s\n-- args: %v", selectFilter.Query, selectFilter.QueryArgs),
+	}, nil
+}
+
+// parseGroupByValue converts a group value returned by the DB driver to the Go type of the search attribute.
+// Depending on the driver, values can be returned as raw bytes, and time buckets are returned as strings.
+func parseGroupByValue(val any, valueType enumspb.IndexedValueType) (any, error) {
+	if bytes, ok := val.([]byte); ok {
+		val = string(bytes)
+	}
+	str, isString := val.(string)
+	if !isString {
+		if valueType == enumspb.INDEXED_VALUE_TYPE_BOOL {
+			// Some DBs store booleans as integers.
+			if intVal, ok := val.(int64); ok {
+				return intVal != 0, nil
+			}
+		}
+		return val, nil
+	}
+	switch valueType {
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		return strconv.ParseInt(str, 10, 64)
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		return strconv.ParseBool(str)
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return time.ParseInLocation(timeBucketLayout, str, time.UTC)
+	default:
+		return str, nil
+	}
+}
+
 func (s *VisibilityStore) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -388,8 +532,9 @@ func (s *VisibilityStore) prepareSearchAttributesForDb(
 			fmt.Sprintf("Unable to read search attributes types: %v", err))
 	}
 
+	remappedSearchAttributes := searchattribute.ApplyFieldRemaps(request.SearchAttributes, saTypeMap, request.NamespaceID)
 	var searchAttributes sqlplugin.VisibilitySearchAttributes
-	searchAttributes, err = searchattribute.Decode(request.SearchAttributes, &saTypeMap, false)
+	searchAttributes, err = searchattribute.Decode(remappedSearchAttributes, &saTypeMap, false)
 	if err != nil {
 		return nil, err
 	}
@@ -524,3 +669,116 @@ func (s *VisibilityStore) AddSearchAttributes(
 	// SQL Visibility does not support modifying schema to add search attributes at this moment.
 	return serviceerror.NewUnimplemented("AddSearchAttributes operation not supported in SQL visibility")
 }
+
+func (s *VisibilityStore) MigrateSearchAttributeValues(
+	ctx context.Context,
+	request *manager.MigrateSearchAttributeValuesRequest,
+) (*manager.MigrateSearchAttributeValuesResponse, error) {
+	if request.NamespaceID == "" {
+		return nil, serviceerror.NewInvalidArgument("SQL visibility requires a namespace to migrate search attribute values")
+	}
+	saTypeMap, err := s.searchAttributesProvider.GetSearchAttributes(s.GetIndexName(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The query refers to the field directly instead of an alias because the source field might not be aliased anymore.
+	// It also filters on TemporalNamespaceDivision to include executions of all divisions, e.g. of schedules.
+	converter := NewQueryConverter(
+		s.GetName(),
+		request.Namespace,
+		request.NamespaceID,
+		saTypeMap,
+		fieldNameMapper{},
+		fmt.Sprintf(
+			"%s IS NOT NULL AND (%s IS NULL OR %s IS NOT NULL)",
+			request.SourceField,
+			searchattribute.TemporalNamespaceDivision,
+			searchattribute.TemporalNamespaceDivision,
+		),
+	)
+	selectFilter, err := converter.BuildSelectStmt(request.PageSize, request.NextPageToken)
+	if err != nil {
+		var converterErr *query.ConverterError
+		if errors.As(err, &converterErr) {
+			return nil, converterErr.ToInvalidArgument()
+		}
+		return nil, err
+	}
+	rows, err := s.sqlStore.Db.SelectFromVisibility(ctx, *selectFilter)
+	if err != nil {
+		return nil, serviceerror.NewUnavailable(
+			fmt.Sprintf("MigrateSearchAttributeValues operation failed. Select failed: %v", err))
+	}
+
+	response := &manager.MigrateSearchAttributeValuesResponse{Processed: int64(len(rows))}
+	for _, row := range rows {
+		if row.SearchAttributes == nil {
+			continue
+		}
+		searchAttributes := *row.SearchAttributes
+		if _, ok := searchAttributes[request.TargetField]; ok && !request.DryRun {
+			continue
+		}
+		value, err := convertRowValue(searchAttributes[request.SourceField], request.SourceType, request.TargetType)
+		if err != nil {
+			response.InvalidValues = append(response.InvalidValues, manager.InvalidSearchAttributeValue{
+				WorkflowID: row.WorkflowID,
+				RunID:      row.RunID,
+				Value:      searchAttributes[request.SourceField],
+				Error:      err.Error(),
+			})
+			continue
+		}
+		if request.DryRun || value == nil {
+			continue
+		}
+		searchAttributes[request.TargetField] = value
+		if _, err := s.sqlStore.Db.UpdateVisibilitySearchAttributes(ctx, &row); err != nil {
+			return nil, serviceerror.NewUnavailable(
+				fmt.Sprintf("MigrateSearchAttributeValues operation failed. Update failed: %v", err))
+		}
+	}
+
+	response.NextPageToken, err = buildNextPageToken(rows, request.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// convertRowValue converts a search attribute value read from the database to the type to in the format it is
+// written to the database.
+func convertRowValue(value any, from, to enumspb.IndexedValueType) (any, error) {
+	// In SQLite, keyword list can return a string when there's only one element.
+	if v, ok := value.(string); ok && from == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		value = []string{v}
+	}
+	p, err := searchattribute.EncodeValue(value, from)
+	if err != nil {
+		return nil, err
+	}
+	value, err = searchattribute.DecodeValue(p, from, false)
+	if err != nil {
+		return nil, err
+	}
+	value, err = searchattribute.ConvertValue(value, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if dt, ok := value.(time.Time); ok {
+		return dt.Format(time.RFC3339Nano), nil
+	}
+	return value, nil
+}
+
+// fieldNameMapper is a search attribute mapper that doesn't map field names.
+type fieldNameMapper struct{}
+
+func (fieldNameMapper) GetAlias(fieldName string, _ string) (string, error) {
+	return fieldName, nil
+}
+
+func (fieldNameMapper) GetFieldName(alias string, _ string) (string, error) {
+	return alias, nil
+}
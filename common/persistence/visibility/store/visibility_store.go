@@ -35,6 +35,8 @@ type (
 		ListWorkflowExecutions(ctx context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*InternalListWorkflowExecutionsResponse, error)
 		ScanWorkflowExecutions(ctx context.Context, request *manager.ListWorkflowExecutionsRequestV2) (*InternalListWorkflowExecutionsResponse, error)
 		CountWorkflowExecutions(ctx context.Context, request *manager.CountWorkflowExecutionsRequest) (*manager.CountWorkflowExecutionsResponse, error)
+		AggregateWorkflowExecutions(ctx context.Context, request *manager.AggregateWorkflowExecutionsRequest) (*manager.AggregateWorkflowExecutionsResponse, error)
+		ExplainWorkflowExecutions(ctx context.Context, request *manager.ExplainWorkflowExecutionsRequest) (*manager.ExplainWorkflowExecutionsResponse, error)
 		GetWorkflowExecution(ctx context.Context, request *manager.GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error)
 
 		// Admin APIs
@@ -43,6 +45,9 @@ type (
 		// idempotent, ie., if a search attribute already exists, this function must be no-op, and must
 		// not return any error.
 		AddSearchAttributes(ctx context.Context, request *manager.AddSearchAttributesRequest) error
+		// MigrateSearchAttributeValues copies the values of a page of executions from one custom search attribute
+		// field to another. See manager.MigrateSearchAttributeValuesRequest.
+		MigrateSearchAttributeValues(ctx context.Context, request *manager.MigrateSearchAttributeValuesRequest) (*manager.MigrateSearchAttributeValuesResponse, error)
 	}
 
 	// InternalWorkflowExecutionInfo is visibility info for internal response
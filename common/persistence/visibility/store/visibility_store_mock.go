@@ -55,6 +55,21 @@ func (mr *MockVisibilityStoreMockRecorder) AddSearchAttributes(ctx, request any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSearchAttributes", reflect.TypeOf((*MockVisibilityStore)(nil).AddSearchAttributes), ctx, request)
 }
 
+// AggregateWorkflowExecutions mocks base method.
+func (m *MockVisibilityStore) AggregateWorkflowExecutions(ctx context.Context, request *manager.AggregateWorkflowExecutionsRequest) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*manager.AggregateWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateWorkflowExecutions indicates an expected call of AggregateWorkflowExecutions.
+func (mr *MockVisibilityStoreMockRecorder) AggregateWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateWorkflowExecutions", reflect.TypeOf((*MockVisibilityStore)(nil).AggregateWorkflowExecutions), ctx, request)
+}
+
 // Close mocks base method.
 func (m *MockVisibilityStore) Close() {
 	m.ctrl.T.Helper()
@@ -96,6 +111,21 @@ func (mr *MockVisibilityStoreMockRecorder) DeleteWorkflowExecution(ctx, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockVisibilityStore)(nil).DeleteWorkflowExecution), ctx, request)
 }
 
+// ExplainWorkflowExecutions mocks base method.
+func (m *MockVisibilityStore) ExplainWorkflowExecutions(ctx context.Context, request *manager.ExplainWorkflowExecutionsRequest) (*manager.ExplainWorkflowExecutionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainWorkflowExecutions", ctx, request)
+	ret0, _ := ret[0].(*manager.ExplainWorkflowExecutionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainWorkflowExecutions indicates an expected call of ExplainWorkflowExecutions.
+func (mr *MockVisibilityStoreMockRecorder) ExplainWorkflowExecutions(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainWorkflowExecutions", reflect.TypeOf((*MockVisibilityStore)(nil).ExplainWorkflowExecutions), ctx, request)
+}
+
 // GetIndexName mocks base method.
 func (m *MockVisibilityStore) GetIndexName() string {
 	m.ctrl.T.Helper()
@@ -154,6 +184,21 @@ func (mr *MockVisibilityStoreMockRecorder) ListWorkflowExecutions(ctx, request a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflowExecutions", reflect.TypeOf((*MockVisibilityStore)(nil).ListWorkflowExecutions), ctx, request)
 }
 
+// MigrateSearchAttributeValues mocks base method.
+func (m *MockVisibilityStore) MigrateSearchAttributeValues(ctx context.Context, request *manager.MigrateSearchAttributeValuesRequest) (*manager.MigrateSearchAttributeValuesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSearchAttributeValues", ctx, request)
+	ret0, _ := ret[0].(*manager.MigrateSearchAttributeValuesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSearchAttributeValues indicates an expected call of MigrateSearchAttributeValues.
+func (mr *MockVisibilityStoreMockRecorder) MigrateSearchAttributeValues(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSearchAttributeValues", reflect.TypeOf((*MockVisibilityStore)(nil).MigrateSearchAttributeValues), ctx, request)
+}
+
 // RecordWorkflowExecutionClosed mocks base method.
 func (m *MockVisibilityStore) RecordWorkflowExecutionClosed(ctx context.Context, request *InternalRecordWorkflowExecutionClosedRequest) error {
 	m.ctrl.T.Helper()
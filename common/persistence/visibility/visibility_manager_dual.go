@@ -5,6 +5,7 @@ import (
 	"errors"
 	"sync"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -185,6 +186,32 @@ func (v *VisibilityManagerDual) CountWorkflowExecutions(
 	)
 }
 
+func (v *VisibilityManagerDual) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	return dualReadWrapper(
+		ctx,
+		v,
+		request,
+		request.Namespace,
+		manager.VisibilityManager.AggregateWorkflowExecutions,
+	)
+}
+
+func (v *VisibilityManagerDual) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ExplainWorkflowExecutionsRequest,
+) (*manager.ExplainWorkflowExecutionsResponse, error) {
+	return dualReadWrapper(
+		ctx,
+		v,
+		request,
+		request.Namespace,
+		manager.VisibilityManager.ExplainWorkflowExecutions,
+	)
+}
+
 func (v *VisibilityManagerDual) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -208,6 +235,21 @@ func (v *VisibilityManagerDual) AddSearchAttributes(
 	return v.secondaryVisibilityManager.AddSearchAttributes(ctx, request)
 }
 
+// MigrateSearchAttributeValues migrates the values of the store named in the request. The stores are migrated
+// separately because each of them has its own pagination.
+func (v *VisibilityManagerDual) MigrateSearchAttributeValues(
+	ctx context.Context,
+	request *manager.MigrateSearchAttributeValuesRequest,
+) (*manager.MigrateSearchAttributeValuesResponse, error) {
+	for _, m := range []manager.VisibilityManager{v.visibilityManager, v.secondaryVisibilityManager} {
+		if m.HasStoreName(request.StoreName) {
+			return m.MigrateSearchAttributeValues(ctx, request)
+		}
+	}
+	return nil, serviceerror.NewInvalidArgumentf(
+		"unknown visibility store %q, configured stores are %v", request.StoreName, v.GetStoreNames())
+}
+
 func dualWriteWrapper[RequestT any](
 	ctx context.Context,
 	v *VisibilityManagerDual,
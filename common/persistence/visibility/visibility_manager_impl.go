@@ -164,6 +164,20 @@ func (p *visibilityManagerImpl) CountWorkflowExecutions(
 	return response, err
 }
 
+func (p *visibilityManagerImpl) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	return p.store.AggregateWorkflowExecutions(ctx, request)
+}
+
+func (p *visibilityManagerImpl) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ExplainWorkflowExecutionsRequest,
+) (*manager.ExplainWorkflowExecutionsResponse, error) {
+	return p.store.ExplainWorkflowExecutions(ctx, request)
+}
+
 func (p *visibilityManagerImpl) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -186,6 +200,22 @@ func (p *visibilityManagerImpl) AddSearchAttributes(
 	return p.store.AddSearchAttributes(ctx, request)
 }
 
+func (p *visibilityManagerImpl) MigrateSearchAttributeValues(
+	ctx context.Context,
+	request *manager.MigrateSearchAttributeValuesRequest,
+) (*manager.MigrateSearchAttributeValuesResponse, error) {
+	if request.StoreName != "" && request.StoreName != p.store.GetName() {
+		return nil, serviceerror.NewInvalidArgumentf("unknown visibility store %q", request.StoreName)
+	}
+	if err := searchattribute.ValidateTypeChange(request.SourceType, request.TargetType); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+	if request.TargetField == "" && !request.DryRun {
+		return nil, serviceerror.NewInvalidArgument("target field is required")
+	}
+	return p.store.MigrateSearchAttributeValues(ctx, request)
+}
+
 func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(
 	request *manager.VisibilityRequestBase,
 ) (*store.InternalVisibilityRequestBase, error) {
@@ -136,6 +136,26 @@ func (m *visibilityManagerRateLimited) CountWorkflowExecutions(
 	return m.delegate.CountWorkflowExecutions(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *manager.AggregateWorkflowExecutionsRequest,
+) (*manager.AggregateWorkflowExecutionsResponse, error) {
+	if ok := allow(ctx, "AggregateWorkflowExecutions", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.AggregateWorkflowExecutions(ctx, request)
+}
+
+func (m *visibilityManagerRateLimited) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *manager.ExplainWorkflowExecutionsRequest,
+) (*manager.ExplainWorkflowExecutionsResponse, error) {
+	if ok := allow(ctx, "ExplainWorkflowExecutions", m.readRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.ExplainWorkflowExecutions(ctx, request)
+}
+
 func (m *visibilityManagerRateLimited) GetWorkflowExecution(
 	ctx context.Context,
 	request *manager.GetWorkflowExecutionRequest,
@@ -156,6 +176,16 @@ func (m *visibilityManagerRateLimited) AddSearchAttributes(
 	return m.delegate.AddSearchAttributes(ctx, request)
 }
 
+func (m *visibilityManagerRateLimited) MigrateSearchAttributeValues(
+	ctx context.Context,
+	request *manager.MigrateSearchAttributeValuesRequest,
+) (*manager.MigrateSearchAttributeValuesResponse, error) {
+	if ok := allow(ctx, "MigrateSearchAttributeValues", m.writeRateLimiter); !ok {
+		return nil, persistence.ErrPersistenceSystemLimitExceeded
+	}
+	return m.delegate.MigrateSearchAttributeValues(ctx, request)
+}
+
 func allow(
 	ctx context.Context,
 	api string,
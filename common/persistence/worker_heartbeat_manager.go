@@ -0,0 +1,109 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	ErrMsgSerializeWorkerHeartbeats   = "failed to serialize worker heartbeats"
+	ErrMsgDeserializeWorkerHeartbeats = "failed to deserialize worker heartbeats"
+)
+
+var ErrReadWorkerHeartbeatsNonPositivePageSize = errors.New("page size to read worker heartbeats must be positive")
+
+type WorkerHeartbeatManagerImpl struct {
+	queue QueueV2
+}
+
+var _ WorkerHeartbeatManager = (*WorkerHeartbeatManagerImpl)(nil)
+
+func NewWorkerHeartbeatManager(queue QueueV2) *WorkerHeartbeatManagerImpl {
+	return &WorkerHeartbeatManagerImpl{
+		queue: queue,
+	}
+}
+
+func (m *WorkerHeartbeatManagerImpl) AppendWorkerHeartbeats(
+	ctx context.Context,
+	request *AppendWorkerHeartbeatsRequest,
+) (*AppendWorkerHeartbeatsResponse, error) {
+	data, err := (&persistencespb.WorkerHeartbeats{Heartbeats: request.Heartbeats}).Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", ErrMsgSerializeWorkerHeartbeats, err)
+	}
+	response, err := enqueueMessageCreatingQueue(ctx, m.queue, &InternalEnqueueMessageRequest{
+		QueueType: QueueTypeWorkerHeartbeats,
+		QueueName: request.NamespaceID,
+		Blob: &commonpb.DataBlob{
+			EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+			Data:         data,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AppendWorkerHeartbeatsResponse{Metadata: response.Metadata}, nil
+}
+
+func (m *WorkerHeartbeatManagerImpl) ReadWorkerHeartbeats(
+	ctx context.Context,
+	request *ReadWorkerHeartbeatsRequest,
+) (*ReadWorkerHeartbeatsResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrReadWorkerHeartbeatsNonPositivePageSize, request.PageSize)
+	}
+
+	response, err := m.queue.ReadMessages(ctx, &InternalReadMessagesRequest{
+		QueueType:     QueueTypeWorkerHeartbeats,
+		QueueName:     request.NamespaceID,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return &ReadWorkerHeartbeatsResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]WorkerHeartbeatsMessage, len(response.Messages))
+	for i, message := range response.Messages {
+		var heartbeats persistencespb.WorkerHeartbeats
+		err := serialization.Proto3Decode(message.Data.GetData(), message.Data.GetEncodingType(), &heartbeats)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", ErrMsgDeserializeWorkerHeartbeats, err)
+		}
+		messages[i] = WorkerHeartbeatsMessage{
+			MessageMetadata: message.MetaData,
+			Heartbeats:      heartbeats.GetHeartbeats(),
+		}
+	}
+	return &ReadWorkerHeartbeatsResponse{
+		Messages:      messages,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+func (m *WorkerHeartbeatManagerImpl) DeleteWorkerHeartbeats(
+	ctx context.Context,
+	request *DeleteWorkerHeartbeatsRequest,
+) error {
+	_, err := m.queue.RangeDeleteMessages(ctx, &InternalRangeDeleteMessagesRequest{
+		QueueType:                   QueueTypeWorkerHeartbeats,
+		QueueName:                   request.NamespaceID,
+		InclusiveMaxMessageMetadata: request.InclusiveMaxMessageMetadata,
+	})
+	return err
+}
+
+func (m *WorkerHeartbeatManagerImpl) Close() {
+}
@@ -21,18 +21,42 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.AddTasksResponse:
 		return nil
+	case *adminservice.AggregateWorkflowExecutionsRequest:
+		return nil
+	case *adminservice.AggregateWorkflowExecutionsResponse:
+		return nil
 	case *adminservice.CancelDLQJobRequest:
 		return nil
 	case *adminservice.CancelDLQJobResponse:
 		return nil
+	case *adminservice.CancelTaskQueueBacklogMigrationRequest:
+		return nil
+	case *adminservice.CancelTaskQueueBacklogMigrationResponse:
+		return nil
+	case *adminservice.CancelVisibilityReindexRequest:
+		return nil
+	case *adminservice.CancelVisibilityReindexResponse:
+		return nil
 	case *adminservice.CloseShardRequest:
 		return nil
 	case *adminservice.CloseShardResponse:
 		return nil
+	case *adminservice.CountWorkersRequest:
+		return nil
+	case *adminservice.CountWorkersResponse:
+		return nil
 	case *adminservice.DeepHealthCheckRequest:
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.DeleteSavedVisibilityQueryResponse:
+		return nil
+	case *adminservice.DeleteTaskQueueTasksRequest:
+		return nil
+	case *adminservice.DeleteTaskQueueTasksResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -62,10 +86,30 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeSearchAttributeMigrationRequest:
+		return nil
+	case *adminservice.DescribeSearchAttributeMigrationResponse:
+		return nil
+	case *adminservice.DescribeTaskQueueBacklogMigrationRequest:
+		return nil
+	case *adminservice.DescribeTaskQueueBacklogMigrationResponse:
+		return nil
+	case *adminservice.DescribeTaskQueueFairnessKeysRequest:
+		return nil
+	case *adminservice.DescribeTaskQueueFairnessKeysResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.DescribeVisibilityReindexRequest:
+		return nil
+	case *adminservice.DescribeVisibilityReindexResponse:
+		return nil
+	case *adminservice.ExplainWorkflowExecutionsRequest:
+		return nil
+	case *adminservice.ExplainWorkflowExecutionsResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -97,6 +141,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetNamespaceReplicationMessagesResponse:
 		return nil
+	case *adminservice.GetNexusEndpointRequest:
+		return nil
+	case *adminservice.GetNexusEndpointResponse:
+		return nil
 	case *adminservice.GetReplicationMessagesRequest:
 		return nil
 	case *adminservice.GetReplicationMessagesResponse:
@@ -134,6 +182,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ImportWorkflowExecutionResponse:
 		return nil
+	case *adminservice.ListCallbacksRequest:
+		return nil
+	case *adminservice.ListCallbacksResponse:
+		return nil
 	case *adminservice.ListClusterMembersRequest:
 		return nil
 	case *adminservice.ListClusterMembersResponse:
@@ -146,10 +198,26 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListHistoryTasksResponse:
 		return nil
+	case *adminservice.ListNexusEndpointsRequest:
+		return nil
+	case *adminservice.ListNexusEndpointsResponse:
+		return nil
 	case *adminservice.ListQueuesRequest:
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListSavedVisibilityQueriesRequest:
+		return nil
+	case *adminservice.ListSavedVisibilityQueriesResponse:
+		return nil
+	case *adminservice.ListScheduleActionsRequest:
+		return nil
+	case *adminservice.ListScheduleActionsResponse:
+		return nil
+	case *adminservice.ListScheduleCalendarSetsRequest:
+		return nil
+	case *adminservice.ListScheduleCalendarSetsResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -158,6 +226,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.PreviewScheduleRequest:
+		return nil
+	case *adminservice.PreviewScheduleResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -166,6 +238,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.PurgeDLQTasksResponse:
 		return nil
+	case *adminservice.PutSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.PutSavedVisibilityQueryResponse:
+		return nil
 	case *adminservice.ReapplyEventsRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowExecution().GetWorkflowId()),
@@ -199,6 +275,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.RemoveTaskResponse:
 		return nil
+	case *adminservice.RenameSearchAttributeRequest:
+		return nil
+	case *adminservice.RenameSearchAttributeResponse:
+		return nil
 	case *adminservice.ResendReplicationTasksRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetWorkflowId()),
@@ -206,6 +286,46 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.RetryCallbackRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.RetryCallbackResponse:
+		return nil
+	case *adminservice.RunSavedVisibilityQueryRequest:
+		return nil
+	case *adminservice.RunSavedVisibilityQueryResponse:
+		return nil
+	case *adminservice.SetNexusEndpointAccessPolicyRequest:
+		return nil
+	case *adminservice.SetNexusEndpointAccessPolicyResponse:
+		return nil
+	case *adminservice.SetNexusEndpointCircuitBreakerRequest:
+		return nil
+	case *adminservice.SetNexusEndpointCircuitBreakerResponse:
+		return nil
+	case *adminservice.StartSearchAttributeMigrationRequest:
+		return nil
+	case *adminservice.StartSearchAttributeMigrationResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.StartTaskQueueBacklogMigrationRequest:
+		return nil
+	case *adminservice.StartTaskQueueBacklogMigrationResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
+	case *adminservice.StartVisibilityReindexRequest:
+		return nil
+	case *adminservice.StartVisibilityReindexResponse:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetWorkflowId()),
+			tag.WorkflowRunID(r.GetRunId()),
+		}
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -213,6 +333,22 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateScheduleCalendarSetRequest:
+		return nil
+	case *adminservice.UpdateScheduleCalendarSetResponse:
+		return nil
+	case *adminservice.UpdateTaskQueueBlockedPollersRequest:
+		return nil
+	case *adminservice.UpdateTaskQueueBlockedPollersResponse:
+		return nil
+	case *adminservice.UpdateTaskQueueFairnessWeightsRequest:
+		return nil
+	case *adminservice.UpdateTaskQueueFairnessWeightsResponse:
+		return nil
+	case *adminservice.UpdateTaskQueuePauseRequest:
+		return nil
+	case *adminservice.UpdateTaskQueuePauseResponse:
+		return nil
 	default:
 		return nil
 	}
@@ -109,6 +109,10 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
 			tag.WorkflowRunID(r.GetExecution().GetRunId()),
 		}
+	case *historyservice.GetOutboundDestinationHealthRequest:
+		return nil
+	case *historyservice.GetOutboundDestinationHealthResponse:
+		return nil
 	case *historyservice.GetReplicationMessagesRequest:
 		return nil
 	case *historyservice.GetReplicationMessagesResponse:
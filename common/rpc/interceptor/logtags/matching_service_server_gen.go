@@ -35,6 +35,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.CheckTaskQueueUserDataPropagationResponse:
 		return nil
+	case *matchingservice.CountWorkersRequest:
+		return nil
+	case *matchingservice.CountWorkersResponse:
+		return nil
 	case *matchingservice.CreateNexusEndpointRequest:
 		return nil
 	case *matchingservice.CreateNexusEndpointResponse:
@@ -43,6 +47,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.DeleteNexusEndpointResponse:
 		return nil
+	case *matchingservice.DescribeFairnessKeysRequest:
+		return nil
+	case *matchingservice.DescribeFairnessKeysResponse:
+		return nil
 	case *matchingservice.DescribeTaskQueueRequest:
 		return nil
 	case *matchingservice.DescribeTaskQueueResponse:
@@ -152,6 +160,14 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.SyncDeploymentUserDataResponse:
 		return nil
+	case *matchingservice.UpdateBlockedPollersRequest:
+		return nil
+	case *matchingservice.UpdateBlockedPollersResponse:
+		return nil
+	case *matchingservice.UpdateFairnessWeightsRequest:
+		return nil
+	case *matchingservice.UpdateFairnessWeightsResponse:
+		return nil
 	case *matchingservice.UpdateNexusEndpointRequest:
 		return nil
 	case *matchingservice.UpdateNexusEndpointResponse:
@@ -160,6 +176,10 @@ func (wt *WorkflowTags) extractFromMatchingServiceServerMessage(message any) []t
 		return nil
 	case *matchingservice.UpdateTaskQueueConfigResponse:
 		return nil
+	case *matchingservice.UpdateTaskQueuePauseRequest:
+		return nil
+	case *matchingservice.UpdateTaskQueuePauseResponse:
+		return nil
 	case *matchingservice.UpdateTaskQueueUserDataRequest:
 		return nil
 	case *matchingservice.UpdateTaskQueueUserDataResponse:
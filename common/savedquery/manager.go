@@ -0,0 +1,200 @@
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination manager_mock.go
+
+// Package savedquery stores named visibility queries per namespace in cluster metadata.
+package savedquery
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// MaxQueriesPerNamespace is the maximum number of saved queries of a namespace.
+	MaxQueriesPerNamespace = 100
+	// MaxNameLength is the maximum length of the name of a saved query.
+	MaxNameLength = 255
+	// MinAlertInterval is the minimum interval between two evaluations of an alert.
+	MinAlertInterval = time.Minute
+)
+
+type (
+	// Manager reads and writes the saved visibility queries of namespaces. Queries are read from persistence every
+	// time since they are only used by admin APIs and alerts that are evaluated at most once a minute.
+	Manager interface {
+		ListQueries(ctx context.Context, namespaceID namespace.ID) ([]*persistencespb.SavedVisibilityQuery, error)
+		// GetQuery returns a serviceerror.NotFound error if the query doesn't exist.
+		GetQuery(ctx context.Context, namespaceID namespace.ID, name string) (*persistencespb.SavedVisibilityQuery, error)
+		// PutQuery creates a query or replaces the query with the same name. The create and update time are set by
+		// PutQuery.
+		PutQuery(ctx context.Context, namespaceID namespace.ID, query *persistencespb.SavedVisibilityQuery) error
+		// DeleteQuery returns a serviceerror.NotFound error if the query doesn't exist.
+		DeleteQuery(ctx context.Context, namespaceID namespace.ID, name string) error
+	}
+
+	managerImpl struct {
+		timeSource             clock.TimeSource
+		clusterMetadataManager persistence.ClusterMetadataManager
+	}
+)
+
+var _ Manager = (*managerImpl)(nil)
+
+func NewManager(
+	timeSource clock.TimeSource,
+	clusterMetadataManager persistence.ClusterMetadataManager,
+) Manager {
+	return &managerImpl{
+		timeSource:             timeSource,
+		clusterMetadataManager: clusterMetadataManager,
+	}
+}
+
+func (m *managerImpl) ListQueries(
+	ctx context.Context,
+	namespaceID namespace.ID,
+) ([]*persistencespb.SavedVisibilityQuery, error) {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ClusterMetadata.GetSavedVisibilityQueries()[namespaceID.String()].GetQueries(), nil
+}
+
+func (m *managerImpl) GetQuery(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	name string,
+) (*persistencespb.SavedVisibilityQuery, error) {
+	queries, err := m.ListQueries(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if i := indexOf(queries, name); i >= 0 {
+		return queries[i], nil
+	}
+	return nil, newNotFoundError(name)
+}
+
+func (m *managerImpl) PutQuery(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	query *persistencespb.SavedVisibilityQuery,
+) error {
+	if err := Validate(query); err != nil {
+		return err
+	}
+	query = common.CloneProto(query)
+	now := timestamppb.New(m.timeSource.Now())
+	query.CreateTime = now
+	query.UpdateTime = now
+
+	return m.update(ctx, namespaceID, func(queries []*persistencespb.SavedVisibilityQuery) ([]*persistencespb.SavedVisibilityQuery, error) {
+		if i := indexOf(queries, query.GetName()); i >= 0 {
+			query.CreateTime = queries[i].GetCreateTime()
+			queries[i] = query
+			return queries, nil
+		}
+		if len(queries) >= MaxQueriesPerNamespace {
+			return nil, serviceerror.NewFailedPreconditionf(
+				"Namespace can't have more than %d saved queries.", MaxQueriesPerNamespace)
+		}
+		return append(queries, query), nil
+	})
+}
+
+func (m *managerImpl) DeleteQuery(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	name string,
+) error {
+	return m.update(ctx, namespaceID, func(queries []*persistencespb.SavedVisibilityQuery) ([]*persistencespb.SavedVisibilityQuery, error) {
+		i := indexOf(queries, name)
+		if i < 0 {
+			return nil, newNotFoundError(name)
+		}
+		return slices.Delete(queries, i, i+1), nil
+	})
+}
+
+// update applies fn to the saved queries of the namespace and writes them back. It fails if the cluster metadata was
+// changed concurrently.
+func (m *managerImpl) update(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	fn func([]*persistencespb.SavedVisibilityQuery) ([]*persistencespb.SavedVisibilityQuery, error),
+) error {
+	resp, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	clusterMetadata := resp.ClusterMetadata
+	queries, err := fn(clusterMetadata.GetSavedVisibilityQueries()[namespaceID.String()].GetQueries())
+	if err != nil {
+		return err
+	}
+	if clusterMetadata.SavedVisibilityQueries == nil {
+		clusterMetadata.SavedVisibilityQueries = map[string]*persistencespb.SavedVisibilityQueries{}
+	}
+	if len(queries) == 0 {
+		delete(clusterMetadata.SavedVisibilityQueries, namespaceID.String())
+	} else {
+		clusterMetadata.SavedVisibilityQueries[namespaceID.String()] = &persistencespb.SavedVisibilityQueries{Queries: queries}
+	}
+	_, err = m.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         resp.Version,
+	})
+	return err
+}
+
+// Validate returns a serviceerror.InvalidArgument error if the query can't be saved.
+func Validate(query *persistencespb.SavedVisibilityQuery) error {
+	switch {
+	case query == nil:
+		return serviceerror.NewInvalidArgument("Query is not set.")
+	case query.GetName() == "":
+		return serviceerror.NewInvalidArgument("Name of the saved query is not set.")
+	case len(query.GetName()) > MaxNameLength:
+		return serviceerror.NewInvalidArgumentf("Name of the saved query is longer than %d characters.", MaxNameLength)
+	case strings.TrimSpace(query.GetQuery()) == "":
+		return serviceerror.NewInvalidArgument("Query of the saved query is not set.")
+	}
+
+	alert := query.GetAlert()
+	if alert == nil {
+		return nil
+	}
+	if alert.GetThreshold() < 0 {
+		return serviceerror.NewInvalidArgument("Threshold of the alert must not be negative.")
+	}
+	if alert.GetInterval().AsDuration() < MinAlertInterval {
+		return serviceerror.NewInvalidArgumentf("Interval of the alert must be at least %v.", MinAlertInterval)
+	}
+	webhookURL, err := url.Parse(alert.GetWebhookUrl())
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return serviceerror.NewInvalidArgumentf("Webhook URL of the alert %q is not a valid HTTP URL.", alert.GetWebhookUrl())
+	}
+	return nil
+}
+
+func indexOf(queries []*persistencespb.SavedVisibilityQuery, name string) int {
+	return slices.IndexFunc(queries, func(q *persistencespb.SavedVisibilityQuery) bool {
+		return q.GetName() == name
+	})
+}
+
+func newNotFoundError(name string) error {
+	return serviceerror.NewNotFoundf("Saved query %s not found.", name)
+}
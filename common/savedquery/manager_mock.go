@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: manager.go
+//
+// Generated by this command:
+//
+//	mockgen -package savedquery -source manager.go -destination manager_mock.go
+//
+
+// Package savedquery is a generated GoMock package.
+package savedquery
+
+import (
+	context "context"
+	reflect "reflect"
+
+	persistence "go.temporal.io/server/api/persistence/v1"
+	namespace "go.temporal.io/server/common/namespace"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockManager is a mock of Manager interface.
+type MockManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockManagerMockRecorder is the mock recorder for MockManager.
+type MockManagerMockRecorder struct {
+	mock *MockManager
+}
+
+// NewMockManager creates a new mock instance.
+func NewMockManager(ctrl *gomock.Controller) *MockManager {
+	mock := &MockManager{ctrl: ctrl}
+	mock.recorder = &MockManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManager) EXPECT() *MockManagerMockRecorder {
+	return m.recorder
+}
+
+// DeleteQuery mocks base method.
+func (m *MockManager) DeleteQuery(ctx context.Context, namespaceID namespace.ID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuery", ctx, namespaceID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuery indicates an expected call of DeleteQuery.
+func (mr *MockManagerMockRecorder) DeleteQuery(ctx, namespaceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuery", reflect.TypeOf((*MockManager)(nil).DeleteQuery), ctx, namespaceID, name)
+}
+
+// GetQuery mocks base method.
+func (m *MockManager) GetQuery(ctx context.Context, namespaceID namespace.ID, name string) (*persistence.SavedVisibilityQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuery", ctx, namespaceID, name)
+	ret0, _ := ret[0].(*persistence.SavedVisibilityQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuery indicates an expected call of GetQuery.
+func (mr *MockManagerMockRecorder) GetQuery(ctx, namespaceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuery", reflect.TypeOf((*MockManager)(nil).GetQuery), ctx, namespaceID, name)
+}
+
+// ListQueries mocks base method.
+func (m *MockManager) ListQueries(ctx context.Context, namespaceID namespace.ID) ([]*persistence.SavedVisibilityQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQueries", ctx, namespaceID)
+	ret0, _ := ret[0].([]*persistence.SavedVisibilityQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQueries indicates an expected call of ListQueries.
+func (mr *MockManagerMockRecorder) ListQueries(ctx, namespaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueries", reflect.TypeOf((*MockManager)(nil).ListQueries), ctx, namespaceID)
+}
+
+// PutQuery mocks base method.
+func (m *MockManager) PutQuery(ctx context.Context, namespaceID namespace.ID, query *persistence.SavedVisibilityQuery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutQuery", ctx, namespaceID, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutQuery indicates an expected call of PutQuery.
+func (mr *MockManagerMockRecorder) PutQuery(ctx, namespaceID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutQuery", reflect.TypeOf((*MockManager)(nil).PutQuery), ctx, namespaceID, query)
+}
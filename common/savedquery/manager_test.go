@@ -0,0 +1,248 @@
+package savedquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	savedQueryManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+
+		timeSource                 *clock.EventTimeSource
+		mockClusterMetadataManager *persistence.MockClusterMetadataManager
+		manager                    Manager
+	}
+)
+
+const testNamespaceID = namespace.ID("test-namespace-id")
+
+func TestSavedQueryManagerSuite(t *testing.T) {
+	suite.Run(t, &savedQueryManagerSuite{})
+}
+
+func (s *savedQueryManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.timeSource = clock.NewEventTimeSource()
+	s.timeSource.Update(time.Date(2020, 8, 22, 1, 0, 0, 0, time.UTC))
+	s.mockClusterMetadataManager = persistence.NewMockClusterMetadataManager(s.controller)
+	s.manager = NewManager(s.timeSource, s.mockClusterMetadataManager)
+}
+
+func (s *savedQueryManagerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *savedQueryManagerSuite) expectGet(queries ...*persistencespb.SavedVisibilityQuery) {
+	clusterMetadata := &persistencespb.ClusterMetadata{ClusterName: "active"}
+	if len(queries) > 0 {
+		clusterMetadata.SavedVisibilityQueries = map[string]*persistencespb.SavedVisibilityQueries{
+			testNamespaceID.String(): {Queries: queries},
+		}
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: clusterMetadata,
+		Version:         7,
+	}, nil)
+}
+
+func (s *savedQueryManagerSuite) expectSave(expected ...*persistencespb.SavedVisibilityQuery) {
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.Equal(int64(7), request.Version)
+			s.Equal("active", request.ClusterMetadata.GetClusterName())
+			actual := request.ClusterMetadata.GetSavedVisibilityQueries()[testNamespaceID.String()].GetQueries()
+			s.Len(actual, len(expected))
+			for i := range expected {
+				protorequire.ProtoEqual(s.T(), expected[i], actual[i])
+			}
+			return true, nil
+		})
+}
+
+func (s *savedQueryManagerSuite) TestGetQuery() {
+	query := &persistencespb.SavedVisibilityQuery{Name: "stuck", Query: "ExecutionStatus = 'Running'"}
+
+	s.expectGet(query)
+	actual, err := s.manager.GetQuery(context.Background(), testNamespaceID, "stuck")
+	s.NoError(err)
+	protorequire.ProtoEqual(s.T(), query, actual)
+
+	s.expectGet(query)
+	_, err = s.manager.GetQuery(context.Background(), testNamespaceID, "other")
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
+}
+
+func (s *savedQueryManagerSuite) TestPutQuery_Create() {
+	existing := &persistencespb.SavedVisibilityQuery{Name: "a", Query: "WorkflowType = 'a'"}
+	now := timestamppb.New(s.timeSource.Now())
+
+	s.expectGet(existing)
+	s.expectSave(existing, &persistencespb.SavedVisibilityQuery{
+		Name:       "b",
+		Query:      "WorkflowType = 'b'",
+		CreateTime: now,
+		UpdateTime: now,
+	})
+	s.NoError(s.manager.PutQuery(context.Background(), testNamespaceID, &persistencespb.SavedVisibilityQuery{
+		Name:  "b",
+		Query: "WorkflowType = 'b'",
+	}))
+}
+
+func (s *savedQueryManagerSuite) TestPutQuery_Replace() {
+	createTime := timestamppb.New(s.timeSource.Now().Add(-time.Hour))
+	existing := &persistencespb.SavedVisibilityQuery{
+		Name:       "a",
+		Query:      "WorkflowType = 'a'",
+		CreateTime: createTime,
+		UpdateTime: createTime,
+	}
+
+	s.expectGet(existing)
+	s.expectSave(&persistencespb.SavedVisibilityQuery{
+		Name:        "a",
+		Query:       "WorkflowType = 'b'",
+		Description: "updated",
+		CreateTime:  createTime,
+		UpdateTime:  timestamppb.New(s.timeSource.Now()),
+	})
+	s.NoError(s.manager.PutQuery(context.Background(), testNamespaceID, &persistencespb.SavedVisibilityQuery{
+		Name:        "a",
+		Query:       "WorkflowType = 'b'",
+		Description: "updated",
+	}))
+}
+
+func (s *savedQueryManagerSuite) TestPutQuery_TooManyQueries() {
+	var queries []*persistencespb.SavedVisibilityQuery
+	for i := 0; i < MaxQueriesPerNamespace; i++ {
+		queries = append(queries, &persistencespb.SavedVisibilityQuery{Name: string(rune('a' + i)), Query: "q"})
+	}
+
+	s.expectGet(queries...)
+	err := s.manager.PutQuery(context.Background(), testNamespaceID, &persistencespb.SavedVisibilityQuery{
+		Name:  "new",
+		Query: "q",
+	})
+	var failedPreconditionErr *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPreconditionErr)
+}
+
+func (s *savedQueryManagerSuite) TestDeleteQuery() {
+	a := &persistencespb.SavedVisibilityQuery{Name: "a", Query: "q"}
+	b := &persistencespb.SavedVisibilityQuery{Name: "b", Query: "q"}
+
+	s.expectGet(a, b)
+	s.expectSave(b)
+	s.NoError(s.manager.DeleteQuery(context.Background(), testNamespaceID, "a"))
+
+	s.expectGet(b)
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			s.NotContains(request.ClusterMetadata.GetSavedVisibilityQueries(), testNamespaceID.String())
+			return true, nil
+		})
+	s.NoError(s.manager.DeleteQuery(context.Background(), testNamespaceID, "b"))
+
+	s.expectGet()
+	err := s.manager.DeleteQuery(context.Background(), testNamespaceID, "a")
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
+}
+
+func TestValidate(t *testing.T) {
+	validAlert := func() *persistencespb.SavedVisibilityQueryAlert {
+		return &persistencespb.SavedVisibilityQueryAlert{
+			Threshold:  10,
+			Interval:   durationpb.New(5 * time.Minute),
+			WebhookUrl: "https://example.com/hook",
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		query   *persistencespb.SavedVisibilityQuery
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			query: &persistencespb.SavedVisibilityQuery{Name: "a", Query: "q"},
+		},
+		{
+			name:  "valid with alert",
+			query: &persistencespb.SavedVisibilityQuery{Name: "a", Query: "q", Alert: validAlert()},
+		},
+		{
+			name:    "nil",
+			wantErr: true,
+		},
+		{
+			name:    "empty name",
+			query:   &persistencespb.SavedVisibilityQuery{Query: "q"},
+			wantErr: true,
+		},
+		{
+			name:    "empty query",
+			query:   &persistencespb.SavedVisibilityQuery{Name: "a", Query: "  "},
+			wantErr: true,
+		},
+		{
+			name: "negative threshold",
+			query: func() *persistencespb.SavedVisibilityQuery {
+				alert := validAlert()
+				alert.Threshold = -1
+				return &persistencespb.SavedVisibilityQuery{Name: "a", Query: "q", Alert: alert}
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "interval too short",
+			query: func() *persistencespb.SavedVisibilityQuery {
+				alert := validAlert()
+				alert.Interval = durationpb.New(time.Second)
+				return &persistencespb.SavedVisibilityQuery{Name: "a", Query: "q", Alert: alert}
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "invalid webhook url",
+			query: func() *persistencespb.SavedVisibilityQuery {
+				alert := validAlert()
+				alert.WebhookUrl = "ftp://example.com"
+				return &persistencespb.SavedVisibilityQuery{Name: "a", Query: "q", Alert: alert}
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.query)
+			if !tc.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			var invalidArgumentErr *serviceerror.InvalidArgument
+			require.ErrorAs(t, err, &invalidArgumentErr)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package searchattribute
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+// ValidateTypeChange returns an error if values of type from can never be converted to type to. Conversions that are
+// allowed by ValidateTypeChange can still fail for individual values, e.g. a Keyword that is not a number can't be
+// converted to Int.
+func ValidateTypeChange(from, to enumspb.IndexedValueType) error {
+	if !isValidType(from) || !isValidType(to) {
+		return fmt.Errorf("%w: %v to %v", ErrInvalidType, from, to)
+	}
+	if from == to || isStringType(from) || isStringType(to) {
+		return nil
+	}
+	if isNumberType(from) && isNumberType(to) {
+		return nil
+	}
+	return fmt.Errorf("%w: unable to convert %v to %v", ErrInvalidType, from, to)
+}
+
+// ConvertValue converts a decoded search attribute value of type from to type to. Lists of values, which are allowed
+// for all types in Elasticsearch, are converted element by element.
+func ConvertValue(value any, from, to enumspb.IndexedValueType) (any, error) {
+	if err := ValidateTypeChange(from, to); err != nil {
+		return nil, err
+	}
+	if value == nil || from == to {
+		return value, nil
+	}
+
+	if from == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		values, ok := value.([]string)
+		if !ok {
+			return nil, fmt.Errorf("%w: unexpected value %v of type %T for %v", ErrInvalidType, value, value, from)
+		}
+		switch len(values) {
+		case 0:
+			return nil, nil
+		case 1:
+			return ConvertValue(values[0], enumspb.INDEXED_VALUE_TYPE_KEYWORD, to)
+		default:
+			return nil, fmt.Errorf("unable to convert a list of %d values to %v", len(values), to)
+		}
+	}
+
+	var err error
+	switch v := value.(type) {
+	case []string:
+		value, err = convertList(v, to)
+	case []int64:
+		value, err = convertList(v, to)
+	case []float64:
+		value, err = convertList(v, to)
+	case []bool:
+		value, err = convertList(v, to)
+	case []time.Time:
+		value, err = convertList(v, to)
+	default:
+		value, err = convertScalar(v, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if to == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+		switch v := value.(type) {
+		case string:
+			return []string{v}, nil
+		case []any:
+			values := make([]string, len(v))
+			for i, item := range v {
+				values[i] = item.(string)
+			}
+			return values, nil
+		}
+	}
+	return value, nil
+}
+
+// ApplyFieldRemaps copies the values of fields that have a field remap for the namespace to the target fields of the
+// remaps. Values are converted to the type of the target field and dropped if that fails. A value that is already set
+// for a target field takes precedence. The source value is kept as long as the source field is still defined, so that
+// the old search attribute keeps working until the migration to the target field is finished.
+// If no remap applies, searchAttributes is returned as is.
+func ApplyFieldRemaps(
+	searchAttributes *commonpb.SearchAttributes,
+	typeMap NameTypeMap,
+	namespaceID string,
+) *commonpb.SearchAttributes {
+	if len(searchAttributes.GetIndexedFields()) == 0 {
+		return searchAttributes
+	}
+	remaps := typeMap.FieldRemaps(namespaceID)
+	if len(remaps) == 0 {
+		return searchAttributes
+	}
+
+	var indexedFields map[string]*commonpb.Payload
+	for saName, saPayload := range searchAttributes.GetIndexedFields() {
+		remap, ok := remaps[saName]
+		if !ok {
+			continue
+		}
+		if indexedFields == nil {
+			indexedFields = make(map[string]*commonpb.Payload, len(searchAttributes.GetIndexedFields()))
+			for name, p := range searchAttributes.GetIndexedFields() {
+				indexedFields[name] = p
+			}
+		}
+		if _, err := typeMap.getType(saName, customCategory); err != nil {
+			delete(indexedFields, saName)
+		}
+		if _, ok := searchAttributes.GetIndexedFields()[remap.TargetField]; ok {
+			continue
+		}
+		targetPayload, err := convertPayload(saPayload, remap, typeMap)
+		if err != nil || targetPayload == nil {
+			continue
+		}
+		indexedFields[remap.TargetField] = targetPayload
+	}
+	if indexedFields == nil {
+		return searchAttributes
+	}
+	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
+}
+
+func convertPayload(p *commonpb.Payload, remap FieldRemap, typeMap NameTypeMap) (*commonpb.Payload, error) {
+	targetType, err := typeMap.getType(remap.TargetField, customCategory)
+	if err != nil {
+		return nil, err
+	}
+	value, err := DecodeValue(p, remap.SourceType, true)
+	if err != nil {
+		return nil, err
+	}
+	value, err = ConvertValue(value, remap.SourceType, targetType)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return EncodeValue(value, targetType)
+}
+
+func convertList[T any](values []T, to enumspb.IndexedValueType) ([]any, error) {
+	result := make([]any, len(values))
+	for i, value := range values {
+		var err error
+		result[i], err = convertScalar(value, to)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func convertScalar(value any, to enumspb.IndexedValueType) (any, error) {
+	switch to {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case time.Time:
+			return v.Format(time.RFC3339Nano), nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		switch v := value.(type) {
+		case int64:
+			return v, nil
+		case float64:
+			if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+				return nil, fmt.Errorf("unable to convert %v to %v without losing precision", v, to)
+			}
+			return int64(v), nil
+		case string:
+			return strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case int64:
+			return float64(v), nil
+		case string:
+			return strconv.ParseFloat(strings.TrimSpace(v), 64)
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(strings.TrimSpace(v))
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			return time.Parse(time.RFC3339Nano, strings.TrimSpace(v))
+		}
+	}
+	return nil, fmt.Errorf("%w: unable to convert %v of type %T to %v", ErrInvalidType, value, value, to)
+}
+
+func isValidType(t enumspb.IndexedValueType) bool {
+	_, ok := enumspb.IndexedValueType_name[int32(t)]
+	return ok && t != enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED
+}
+
+func isStringType(t enumspb.IndexedValueType) bool {
+	return t == enumspb.INDEXED_VALUE_TYPE_KEYWORD ||
+		t == enumspb.INDEXED_VALUE_TYPE_TEXT ||
+		t == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST
+}
+
+func isNumberType(t enumspb.IndexedValueType) bool {
+	return t == enumspb.INDEXED_VALUE_TYPE_INT || t == enumspb.INDEXED_VALUE_TYPE_DOUBLE
+}
@@ -0,0 +1,149 @@
+package searchattribute
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+func Test_ValidateTypeChange(t *testing.T) {
+	s := assert.New(t)
+
+	s.NoError(ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT))
+	s.NoError(ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT))
+	s.NoError(ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE))
+	s.NoError(ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT))
+	s.NoError(ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST))
+	s.NoError(ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_BOOL))
+
+	err := ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_BOOL)
+	s.True(errors.Is(err, ErrInvalidType))
+	err = ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.True(errors.Is(err, ErrInvalidType))
+	err = ValidateTypeChange(enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.True(errors.Is(err, ErrInvalidType))
+}
+
+func Test_ConvertValue(t *testing.T) {
+	s := assert.New(t)
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 10, time.UTC)
+
+	testCases := []struct {
+		value    any
+		from, to enumspb.IndexedValueType
+		expected any
+	}{
+		{"abc", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT, "abc"},
+		{"abc", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, []string{"abc"}},
+		{[]string{"abc"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "abc"},
+		{[]string{}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD, nil},
+		{" 42", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT, int64(42)},
+		{"1.5", enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, 1.5},
+		{"true", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_BOOL, true},
+		{ts.Format(time.RFC3339Nano), enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_DATETIME, ts},
+		{int64(7), enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DOUBLE, 7.0},
+		{7.0, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT, int64(7)},
+		{1.25, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_KEYWORD, "1.25"},
+		{ts, enumspb.INDEXED_VALUE_TYPE_DATETIME, enumspb.INDEXED_VALUE_TYPE_KEYWORD, ts.Format(time.RFC3339Nano)},
+		{[]int64{1, 2}, enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_KEYWORD, []any{"1", "2"}},
+		{[]int64{1, 2}, enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, []string{"1", "2"}},
+		{nil, enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT, nil},
+	}
+	for _, tc := range testCases {
+		value, err := ConvertValue(tc.value, tc.from, tc.to)
+		s.NoError(err, "%v: %v -> %v", tc.value, tc.from, tc.to)
+		s.Equal(tc.expected, value, "%v: %v -> %v", tc.value, tc.from, tc.to)
+	}
+
+	_, err := ConvertValue("abc", enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.Error(err)
+	_, err = ConvertValue(1.5, enumspb.INDEXED_VALUE_TYPE_DOUBLE, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.Error(err)
+	_, err = ConvertValue([]string{"a", "b"}, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.Error(err)
+	_, err = ConvertValue(true, enumspb.INDEXED_VALUE_TYPE_BOOL, enumspb.INDEXED_VALUE_TYPE_INT)
+	s.True(errors.Is(err, ErrInvalidType))
+}
+
+func Test_ApplyFieldRemaps(t *testing.T) {
+	s := assert.New(t)
+	typeMap := NameTypeMap{
+		customSearchAttributes: map[string]enumspb.IndexedValueType{
+			"Keyword01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"Keyword02": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			"Int01":     enumspb.INDEXED_VALUE_TYPE_INT,
+			"Int02":     enumspb.INDEXED_VALUE_TYPE_INT,
+			"Text01":    enumspb.INDEXED_VALUE_TYPE_TEXT,
+		},
+		fieldRemaps: []FieldRemap{
+			{NamespaceID: "ns-1", SourceField: "Keyword01", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Int01"},
+			{NamespaceID: "ns-1", SourceField: "Keyword02", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Int02"},
+			{SourceField: "Removed", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Text01"},
+		},
+	}
+	encode := func(value any, t enumspb.IndexedValueType) *commonpb.Payload {
+		p, err := EncodeValue(value, t)
+		s.NoError(err)
+		return p
+	}
+	decode := func(sas *commonpb.SearchAttributes) map[string]any {
+		values, err := Decode(sas, &typeMap, false)
+		s.NoError(err)
+		return values
+	}
+
+	sas := &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+		"Keyword01": encode("42", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		"Keyword02": encode("not a number", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+		"Removed":   encode("text", enumspb.INDEXED_VALUE_TYPE_KEYWORD),
+	}}
+
+	// Remaps of other namespaces don't apply, and values of fields that are not defined anymore are dropped.
+	result := ApplyFieldRemaps(sas, typeMap, "ns-2")
+	s.Equal(map[string]any{"Keyword01": "42", "Keyword02": "not a number", "Text01": "text"}, decode(result))
+
+	result = ApplyFieldRemaps(sas, typeMap, "ns-1")
+	s.Equal(map[string]any{
+		"Keyword01": "42",
+		"Keyword02": "not a number",
+		"Int01":     int64(42),
+		"Text01":    "text",
+	}, decode(result))
+	// The input is not modified.
+	s.Len(sas.GetIndexedFields(), 3)
+
+	// A value of the target field takes precedence.
+	sas.IndexedFields["Int01"] = encode(int64(1), enumspb.INDEXED_VALUE_TYPE_INT)
+	result = ApplyFieldRemaps(sas, typeMap, "ns-1")
+	s.Equal(int64(1), decode(result)["Int01"])
+
+	noRemaps := &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+		"Text01": encode("text", enumspb.INDEXED_VALUE_TYPE_TEXT),
+	}}
+	s.Same(noRemaps, ApplyFieldRemaps(noRemaps, typeMap, "ns-1"))
+}
+
+func Test_FieldRemaps(t *testing.T) {
+	s := assert.New(t)
+	typeMap := NameTypeMap{
+		fieldRemaps: []FieldRemap{
+			{NamespaceID: "ns-1", SourceField: "Keyword01", TargetField: "Int01"},
+			{SourceField: "Keyword01", TargetField: "Text01"},
+			{NamespaceID: "ns-2", SourceField: "Keyword02", TargetField: "Int02"},
+		},
+	}
+	s.Equal(map[string]FieldRemap{
+		"Keyword01": {NamespaceID: "ns-1", SourceField: "Keyword01", TargetField: "Int01"},
+	}, typeMap.FieldRemaps("ns-1"))
+	s.Equal(map[string]FieldRemap{
+		"Keyword01": {SourceField: "Keyword01", TargetField: "Text01"},
+	}, typeMap.FieldRemaps("ns-3"))
+	s.Nil(NameTypeMap{}.FieldRemaps("ns-1"))
+
+	s.Equal(map[string]struct{}{"Keyword01": {}, "Int01": {}}, typeMap.RemappedFields("ns-1"))
+	s.Equal(map[string]struct{}{"Keyword01": {}, "Text01": {}}, typeMap.RemappedFields("ns-3"))
+}
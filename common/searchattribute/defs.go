@@ -92,6 +92,21 @@ const (
 	// execution. It is updated at workflow task completion when the server gets the
 	// behavior (`auto_upgrade` or `pinned`) from the SDK. Empty for unversioned workflows.
 	TemporalWorkflowVersioningBehavior = "TemporalWorkflowVersioningBehavior"
+
+	// TemporalCloseFailureMessage stores the failure message of a failed workflow, including
+	// the messages of its causes, or the reason of a terminated workflow. It is only set if
+	// indexing of close failures is enabled for the namespace, and holds the text normalized
+	// by NormalizeFullText.
+	TemporalCloseFailureMessage = "TemporalCloseFailureMessage"
+
+	// TemporalCloseFailureType stores the type of the failure of a closed workflow: the type of
+	// an application failure, or the kind of failure otherwise, e.g. "Timeout" or "Terminated".
+	// It is only set if indexing of close failures is enabled for the namespace.
+	TemporalCloseFailureType = "TemporalCloseFailureType"
+
+	// TemporalMemoText stores the text of the memo fields that are configured to be indexed for
+	// the namespace, normalized by NormalizeFullText.
+	TemporalMemoText = "TemporalMemoText"
 )
 
 var (
@@ -153,6 +168,9 @@ var (
 		TemporalWorkerDeploymentVersion:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		TemporalWorkflowVersioningBehavior: enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 		TemporalWorkerDeployment:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalCloseFailureMessage:        enumspb.INDEXED_VALUE_TYPE_TEXT,
+		TemporalCloseFailureType:           enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TemporalMemoText:                   enumspb.INDEXED_VALUE_TYPE_TEXT,
 	}
 
 	// reserved are internal field names that can't be used as search attribute names.
@@ -0,0 +1,45 @@
+package searchattribute
+
+import (
+	"strings"
+	"unicode"
+)
+
+// normalizedText are the predefined Text search attributes whose values are written by the server in the form
+// returned by NormalizeFullText.
+var normalizedText = map[string]struct{}{
+	TemporalCloseFailureMessage: {},
+	TemporalMemoText:            {},
+}
+
+// IsNormalizedText returns true if the values of the search attribute are normalized by NormalizeFullText. Query
+// values for these search attributes must be normalized the same way by visibility stores that don't analyze text.
+func IsNormalizedText(name string) bool {
+	_, ok := normalizedText[name]
+	return ok
+}
+
+// NormalizeFullText lowercases text and reduces it to words of letters and digits separated by single spaces, so that
+// it can be indexed by the full-text search of every visibility store. If maxBytes is positive, the result is cut at
+// the last word that fits.
+func NormalizeFullText(text string, maxBytes int) string {
+	var b strings.Builder
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		word = strings.ToLower(word)
+		size := len(word)
+		if b.Len() > 0 {
+			size++
+		}
+		if maxBytes > 0 && b.Len()+size > maxBytes {
+			break
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(word)
+	}
+	return b.String()
+}
@@ -0,0 +1,54 @@
+package searchattribute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeFullText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		maxBytes int
+		want     string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "punctuation and case",
+			text: `Activity error: read tcp 10.0.0.1:7233: Connection RESET by peer ("retry")`,
+			want: "activity error read tcp 10 0 0 1 7233 connection reset by peer retry",
+		},
+		{
+			name: "unicode",
+			text: "Überweisung fehlgeschlagen — 支付失败",
+			want: "überweisung fehlgeschlagen 支付失败",
+		},
+		{
+			name:     "truncated at word boundary",
+			text:     "connection reset by peer",
+			maxBytes: 18,
+			want:     "connection reset",
+		},
+		{
+			name:     "first word too long",
+			text:     "connection reset",
+			maxBytes: 5,
+			want:     "",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, NormalizeFullText(tc.text, tc.maxBytes))
+		})
+	}
+}
+
+func TestIsNormalizedText(t *testing.T) {
+	assert.True(t, IsNormalizedText(TemporalCloseFailureMessage))
+	assert.True(t, IsNormalizedText(TemporalMemoText))
+	assert.False(t, IsNormalizedText(TemporalCloseFailureType))
+	assert.False(t, IsNormalizedText("Text01"))
+}
@@ -3,6 +3,7 @@ package searchattribute
 import (
 	"context"
 	"maps"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -90,6 +91,7 @@ func (m *managerImpl) GetSearchAttributes(
 	indexSearchAttributes, ok := saCache.searchAttributes[indexName]
 	if ok {
 		result.customSearchAttributes = maps.Clone(indexSearchAttributes.customSearchAttributes)
+		result.fieldRemaps = slices.Clone(indexSearchAttributes.fieldRemaps)
 	}
 
 	// TODO (rodrigozhou): remove following block for v1.21.
@@ -105,6 +107,7 @@ func (m *managerImpl) GetSearchAttributes(
 			} else {
 				maps.Copy(result.customSearchAttributes, indexSearchAttributes.customSearchAttributes)
 			}
+			result.fieldRemaps = append(result.fieldRemaps, indexSearchAttributes.fieldRemaps...)
 		}
 	}
 	return result, nil
@@ -171,7 +174,49 @@ func (m *managerImpl) SaveSearchAttributes(
 	if clusterMetadata.IndexSearchAttributes == nil {
 		clusterMetadata.IndexSearchAttributes = map[string]*persistencespb.IndexSearchAttributes{indexName: nil}
 	}
-	clusterMetadata.IndexSearchAttributes[indexName] = &persistencespb.IndexSearchAttributes{CustomSearchAttributes: newCustomSearchAttributes}
+	clusterMetadata.IndexSearchAttributes[indexName] = &persistencespb.IndexSearchAttributes{
+		CustomSearchAttributes: newCustomSearchAttributes,
+		FieldRemaps:            clusterMetadata.IndexSearchAttributes[indexName].GetFieldRemaps(),
+	}
+	_, err = m.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: clusterMetadata,
+		Version:         clusterMetadataResponse.Version,
+	})
+	// Flush local cache, even if there was an error, which is most likely version mismatch (=stale cache).
+	m.cache.Store(cache{})
+
+	return err
+}
+
+// SaveFieldRemap saves a field remap to cluster metadata. It replaces an existing remap of the same source field and
+// namespace.
+func (m *managerImpl) SaveFieldRemap(
+	ctx context.Context,
+	indexName string,
+	remap FieldRemap,
+) error {
+
+	clusterMetadataResponse, err := m.clusterMetadataManager.GetCurrentClusterMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	clusterMetadata := clusterMetadataResponse.ClusterMetadata
+	if clusterMetadata.IndexSearchAttributes == nil {
+		clusterMetadata.IndexSearchAttributes = map[string]*persistencespb.IndexSearchAttributes{}
+	}
+	indexSearchAttributes := clusterMetadata.IndexSearchAttributes[indexName]
+	if indexSearchAttributes == nil {
+		indexSearchAttributes = &persistencespb.IndexSearchAttributes{}
+		clusterMetadata.IndexSearchAttributes[indexName] = indexSearchAttributes
+	}
+	indexSearchAttributes.FieldRemaps = slices.DeleteFunc(
+		indexSearchAttributes.FieldRemaps,
+		func(r *persistencespb.SearchAttributeFieldRemap) bool {
+			return r.GetNamespaceId() == remap.NamespaceID && r.GetSourceField() == remap.SourceField
+		},
+	)
+	indexSearchAttributes.FieldRemaps = append(indexSearchAttributes.FieldRemaps, remap.toPersistence())
 	_, err = m.clusterMetadataManager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
 		ClusterMetadata: clusterMetadata,
 		Version:         clusterMetadataResponse.Version,
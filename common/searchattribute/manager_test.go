@@ -326,3 +326,79 @@ func (s *searchAttributesManagerSuite) TestSaveSearchAttributesCache_EmptyIndex(
 	})
 	s.NoError(err)
 }
+
+func (s *searchAttributesManagerSuite) TestSaveFieldRemap() {
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					FieldRemaps: []*persistencespb.SearchAttributeFieldRemap{
+						{NamespaceId: "ns-1", SourceField: "Keyword01", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Text01"},
+						{NamespaceId: "ns-2", SourceField: "Keyword01", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Text01"},
+					}}},
+		},
+		Version: 1,
+	}, nil)
+
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					FieldRemaps: []*persistencespb.SearchAttributeFieldRemap{
+						{NamespaceId: "ns-2", SourceField: "Keyword01", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Text01"},
+						{NamespaceId: "ns-1", SourceField: "Keyword01", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "Int01"},
+					}}},
+		},
+		Version: 1,
+	}).Return(false, nil)
+
+	err := s.manager.SaveFieldRemap(context.Background(), "index-name", FieldRemap{
+		NamespaceID: "ns-1",
+		SourceField: "Keyword01",
+		SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		TargetField: "Int01",
+	})
+	s.NoError(err)
+}
+
+func (s *searchAttributesManagerSuite) TestSaveSearchAttributes_KeepFieldRemaps() {
+	fieldRemaps := []*persistencespb.SearchAttributeFieldRemap{
+		{SourceField: "OrderIdOld", SourceType: enumspb.INDEXED_VALUE_TYPE_KEYWORD, TargetField: "OrderId"},
+	}
+	s.mockClusterMetadataManager.EXPECT().GetCurrentClusterMetadata(gomock.Any()).Return(&persistence.GetClusterMetadataResponse{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderIdOld": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					FieldRemaps: fieldRemaps,
+				}},
+		},
+		Version: 1,
+	}, nil)
+
+	s.mockClusterMetadataManager.EXPECT().SaveClusterMetadata(gomock.Any(), &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: &persistencespb.ClusterMetadata{
+			IndexSearchAttributes: map[string]*persistencespb.IndexSearchAttributes{
+				"index-name": {
+					CustomSearchAttributes: map[string]enumspb.IndexedValueType{
+						"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					},
+					FieldRemaps: fieldRemaps,
+				}},
+		},
+		Version: 1,
+	}).Return(false, nil)
+
+	err := s.manager.SaveSearchAttributes(context.Background(), "index-name", map[string]enumspb.IndexedValueType{
+		"OrderId": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+	})
+	s.NoError(err)
+}
@@ -11,6 +11,17 @@ type (
 	NameTypeMap struct {
 		// customSearchAttributes are defined by cluster admin per cluster level and passed and stored in SearchAttributes object.
 		customSearchAttributes map[string]enumspb.IndexedValueType
+		fieldRemaps            []FieldRemap
+	}
+
+	// FieldRemap redirects values of a custom search attribute field to another field. Values are converted from
+	// SourceType to the type of TargetField. See ApplyFieldRemaps.
+	FieldRemap struct {
+		// NamespaceID is empty if the remap applies to all namespaces.
+		NamespaceID string
+		SourceField string
+		SourceType  enumspb.IndexedValueType
+		TargetField string
 	}
 
 	category int32
@@ -27,11 +38,37 @@ func buildIndexNameTypeMap(indexSearchAttributes map[string]*persistencespb.Inde
 	for indexName, customSearchAttributes := range indexSearchAttributes {
 		indexNameTypeMap[indexName] = NameTypeMap{
 			customSearchAttributes: customSearchAttributes.GetCustomSearchAttributes(),
+			fieldRemaps:            fieldRemapsFromPersistence(customSearchAttributes.GetFieldRemaps()),
 		}
 	}
 	return indexNameTypeMap
 }
 
+func fieldRemapsFromPersistence(remaps []*persistencespb.SearchAttributeFieldRemap) []FieldRemap {
+	if len(remaps) == 0 {
+		return nil
+	}
+	result := make([]FieldRemap, len(remaps))
+	for i, remap := range remaps {
+		result[i] = FieldRemap{
+			NamespaceID: remap.GetNamespaceId(),
+			SourceField: remap.GetSourceField(),
+			SourceType:  remap.GetSourceType(),
+			TargetField: remap.GetTargetField(),
+		}
+	}
+	return result
+}
+
+func (r FieldRemap) toPersistence() *persistencespb.SearchAttributeFieldRemap {
+	return &persistencespb.SearchAttributeFieldRemap{
+		NamespaceId: r.NamespaceID,
+		SourceField: r.SourceField,
+		SourceType:  r.SourceType,
+		TargetField: r.TargetField,
+	}
+}
+
 func (m NameTypeMap) System() map[string]enumspb.IndexedValueType {
 	allSystem := make(map[string]enumspb.IndexedValueType, len(system)+len(predefined))
 	for saName, saType := range system {
@@ -61,6 +98,36 @@ func (m NameTypeMap) All() map[string]enumspb.IndexedValueType {
 	return allSearchAttributes
 }
 
+// FieldRemaps returns the field remaps that apply to the namespace keyed by source field. Remaps of the namespace take
+// precedence over remaps of all namespaces.
+func (m NameTypeMap) FieldRemaps(namespaceID string) map[string]FieldRemap {
+	var result map[string]FieldRemap
+	for _, remap := range m.fieldRemaps {
+		if remap.NamespaceID != "" && remap.NamespaceID != namespaceID {
+			continue
+		}
+		if _, ok := result[remap.SourceField]; ok && remap.NamespaceID == "" {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]FieldRemap)
+		}
+		result[remap.SourceField] = remap
+	}
+	return result
+}
+
+// RemappedFields returns the source and target fields of the field remaps that apply to the namespace. These fields
+// must not be allocated to new search attributes while a remap exists.
+func (m NameTypeMap) RemappedFields(namespaceID string) map[string]struct{} {
+	result := make(map[string]struct{})
+	for source, remap := range m.FieldRemaps(namespaceID) {
+		result[source] = struct{}{}
+		result[remap.TargetField] = struct{}{}
+	}
+	return result
+}
+
 // GetType returns type of search attribute from type map.
 func (m NameTypeMap) GetType(name string) (enumspb.IndexedValueType, error) {
 	return m.getType(name, systemCategory|predefinedCategory|customCategory)
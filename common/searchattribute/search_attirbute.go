@@ -23,6 +23,7 @@ type (
 	Manager interface {
 		Provider
 		SaveSearchAttributes(ctx context.Context, indexName string, newCustomSearchAttributes map[string]enumspb.IndexedValueType) error
+		SaveFieldRemap(ctx context.Context, indexName string, remap FieldRemap) error
 	}
 )
 
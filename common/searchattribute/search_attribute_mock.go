@@ -95,6 +95,20 @@ func (mr *MockManagerMockRecorder) GetSearchAttributes(indexName, forceRefreshCa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchAttributes", reflect.TypeOf((*MockManager)(nil).GetSearchAttributes), indexName, forceRefreshCache)
 }
 
+// SaveFieldRemap mocks base method.
+func (m *MockManager) SaveFieldRemap(ctx context.Context, indexName string, remap FieldRemap) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFieldRemap", ctx, indexName, remap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFieldRemap indicates an expected call of SaveFieldRemap.
+func (mr *MockManagerMockRecorder) SaveFieldRemap(ctx, indexName, remap any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFieldRemap", reflect.TypeOf((*MockManager)(nil).SaveFieldRemap), ctx, indexName, remap)
+}
+
 // SaveSearchAttributes mocks base method.
 func (m *MockManager) SaveSearchAttributes(ctx context.Context, indexName string, newCustomSearchAttributes map[string]enums.IndexedValueType) error {
 	m.ctrl.T.Helper()
@@ -129,6 +129,6 @@ func NewTestMapperProvider(customMapper Mapper) MapperProvider {
 	return NewMapperProvider(customMapper, nil, NewTestProvider(), false)
 }
 
-func NewNameTypeMapStub(attributes map[string]enumspb.IndexedValueType) NameTypeMap {
-	return NameTypeMap{customSearchAttributes: attributes}
+func NewNameTypeMapStub(attributes map[string]enumspb.IndexedValueType, fieldRemaps ...FieldRemap) NameTypeMap {
+	return NameTypeMap{customSearchAttributes: attributes, fieldRemaps: fieldRemaps}
 }
@@ -48,6 +48,8 @@ func FromStatus(st *status.Status) error {
 			return newObsoleteMatchingTask(st)
 		case *errordetailsspb.ActivityStartDuringTransitionFailure:
 			return newActivityStartDuringTransition(st)
+		case *errordetailsspb.TaskQueueDrainingFailure:
+			return newTaskQueueDraining(st)
 		}
 	}
 
@@ -24,3 +24,15 @@ func TestFromToStatus(t *testing.T) {
 	assert.Equal(t, err.Message, solErr.Message)
 	assert.Equal(t, err.OwnerHost, solErr.OwnerHost)
 }
+
+func TestFromToStatus_TaskQueueDraining(t *testing.T) {
+	err := NewTaskQueueDraining("draining")
+
+	st := serviceerror.ToStatus(err)
+	err1 := FromStatus(st)
+	var drainingErr *TaskQueueDraining
+	if !errors.As(err1, &drainingErr) {
+		assert.Fail(t, "Returned error is not of type *TaskQueueDraining")
+	}
+	assert.Equal(t, "draining", drainingErr.Message)
+}
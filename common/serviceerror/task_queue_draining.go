@@ -0,0 +1,47 @@
+package serviceerror
+
+import (
+	errordetailsspb "go.temporal.io/server/api/errordetails/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	// TaskQueueDraining happens when Matching rejects a new task because an operator set the
+	// task queue to drain its backlog. Unlike throttling, retrying the task doesn't help until
+	// the operator removes the drain.
+	TaskQueueDraining struct {
+		Message string
+		st      *status.Status
+	}
+)
+
+func NewTaskQueueDraining(msg string) error {
+	return &TaskQueueDraining{
+		Message: msg,
+	}
+}
+
+// Error returns string message.
+func (e *TaskQueueDraining) Error() string {
+	return e.Message
+}
+
+func (e *TaskQueueDraining) Status() *status.Status {
+	if e.st != nil {
+		return e.st
+	}
+
+	st := status.New(codes.FailedPrecondition, e.Message)
+	st, _ = st.WithDetails(
+		&errordetailsspb.TaskQueueDrainingFailure{},
+	)
+	return st
+}
+
+func newTaskQueueDraining(st *status.Status) error {
+	return &TaskQueueDraining{
+		Message: st.Message(),
+		st:      st,
+	}
+}
@@ -16,6 +16,10 @@ const (
 	// nonRootPartitionPrefix is the prefix for all mangled task queue names.
 	nonRootPartitionPrefix = "/_sys/"
 	partitionDelimiter     = "/"
+
+	// NotApplicableName is used in place of the task queue name, and of the namespace ID if needed,
+	// to route matching requests that aren't associated with a task queue.
+	NotApplicableName = "not-applicable"
 )
 
 type (
@@ -15,35 +15,57 @@ var RequestTimeout = dynamicconfig.NewDestinationDurationSetting(
 	`RequestTimeout is the timeout for executing a single callback request.`,
 )
 
-var RetryPolicyInitialInterval = dynamicconfig.NewGlobalDurationSetting(
+var RetryPolicyInitialInterval = dynamicconfig.NewNamespaceDurationSetting(
 	"component.callbacks.retryPolicy.initialInterval",
 	time.Second,
 	`The initial backoff interval between every callback request attempt for a given callback.`,
 )
 
-var RetryPolicyMaximumInterval = dynamicconfig.NewGlobalDurationSetting(
+var RetryPolicyMaximumInterval = dynamicconfig.NewNamespaceDurationSetting(
 	"component.callbacks.retryPolicy.maxInterval",
 	time.Hour,
 	`The maximum backoff interval between every callback request attempt for a given callback.`,
 )
 
+var RetryPolicyMaximumAttempts = dynamicconfig.NewNamespaceIntSetting(
+	"component.callbacks.retryPolicy.maxAttempts",
+	0,
+	`The maximum number of attempts for a given callback, after which it is marked as failed. Failed callbacks can be
+retried with the admin RetryCallback API. Zero means unlimited attempts.`,
+)
+
+var NonRetryableStatusCodes = dynamicconfig.NewNamespaceTypedSetting(
+	"component.callbacks.nonRetryableStatusCodes",
+	[]int(nil),
+	`HTTP status codes of Nexus callback responses that fail the callback without retrying, in addition to the 4xx codes
+other than 408 and 429. For example, [501, 505].`,
+)
+
 type Config struct {
-	RequestTimeout dynamicconfig.DurationPropertyFnWithDestinationFilter
-	RetryPolicy    func() backoff.RetryPolicy
+	RequestTimeout          dynamicconfig.DurationPropertyFnWithDestinationFilter
+	RetryPolicy             func(namespace string) backoff.RetryPolicy
+	MaxAttempts             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	NonRetryableStatusCodes dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]int]
+	AllowedAddresses        dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]AddressMatchRule]
 }
 
 func ConfigProvider(dc *dynamicconfig.Collection) *Config {
+	initialInterval := RetryPolicyInitialInterval.Get(dc)
+	maximumInterval := RetryPolicyMaximumInterval.Get(dc)
 	return &Config{
 		RequestTimeout: RequestTimeout.Get(dc),
-		RetryPolicy: func() backoff.RetryPolicy {
+		RetryPolicy: func(namespace string) backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
-				RetryPolicyInitialInterval.Get(dc)(),
+				initialInterval(namespace),
 			).WithMaximumInterval(
-				RetryPolicyMaximumInterval.Get(dc)(),
+				maximumInterval(namespace),
 			).WithExpirationInterval(
 				backoff.NoInterval,
 			)
 		},
+		MaxAttempts:             RetryPolicyMaximumAttempts.Get(dc),
+		NonRetryableStatusCodes: NonRetryableStatusCodes.Get(dc),
+		AllowedAddresses:        AllowedAddresses.Get(dc),
 	}
 }
 
@@ -56,17 +78,56 @@ URLs are checked against each in order when starting a workflow with attached ca
 Default is no address rules, meaning all callbacks will be rejected. Any invalid entries are ignored. Each entry is a map with possible values:
 	 - "Pattern":string (required) the host:port pattern to which this config applies.
 		Wildcards, '*', are supported and can match any number of characters (e.g. '*' matches everything, 'prefix.*.domain' matches 'prefix.a.domain' as well as 'prefix.a.b.domain').
-	 - "AllowInsecure":bool (optional, default=false) indicates whether https is required`)
+	 - "AllowInsecure":bool (optional, default=false) indicates whether https is required
+	 - "HMACKeys":list (optional) keys used to sign callback requests to matching addresses, each a map with "ID":string and
+		"SecretFile":string, the path of a file holding the secret (e.g. a mounted Kubernetes secret). Secrets are never
+		read from dynamic config itself, and the files are reloaded when they change on disk. Requests are signed with
+		every listed key, which allows rotating keys by adding the new key, updating the receiving services and then
+		removing the old key. See the Temporal-Callback-Signature header.
+	 - "ClientCertFile":string and "ClientKeyFile":string (optional) paths of a PEM encoded client certificate and key
+		presented to matching addresses over mTLS. The files are reloaded when they change on disk.`)
 
 type AddressMatchRule struct {
 	Regexp        *regexp.Regexp
 	AllowInsecure bool
+	// HMACKeys sign outbound callback requests, see signCallbackRequest.
+	HMACKeys []HMACKey
+	// ClientCertificate is presented over mTLS if set.
+	ClientCertificate *ClientCertificate
+}
+
+type HMACKey struct {
+	ID string
+	// SecretFile is the path of the file holding the secret.
+	SecretFile string
+}
+
+type ClientCertificate struct {
+	CertFile string
+	KeyFile  string
+}
+
+// MatchAddressRule returns the first rule matching the given host:port, if any.
+func MatchAddressRule(rules []AddressMatchRule, host string) (AddressMatchRule, bool) {
+	for _, rule := range rules {
+		if rule.Regexp.MatchString(host) {
+			return rule, true
+		}
+	}
+	return AddressMatchRule{}, false
 }
 
 func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
+	type keyEntry struct {
+		ID         string
+		SecretFile string
+	}
 	type entry struct {
-		Pattern       string
-		AllowInsecure bool
+		Pattern        string
+		AllowInsecure  bool
+		HMACKeys       []keyEntry
+		ClientCertFile string
+		ClientKeyFile  string
 	}
 	intermediate, err := dynamicconfig.ConvertStructure([]entry{})(val)
 	if err != nil {
@@ -84,10 +145,30 @@ func allowedAddressConverter(val any) ([]AddressMatchRule, error) {
 			// Skip configs with malformed Pattern
 			continue
 		}
-		configs = append(configs, AddressMatchRule{
+		rule := AddressMatchRule{
 			Regexp:        re,
 			AllowInsecure: e.AllowInsecure,
-		})
+		}
+		valid := true
+		for _, k := range e.HMACKeys {
+			if k.ID == "" || k.SecretFile == "" || strings.ContainsAny(k.ID, ",=") {
+				// Skip configs with malformed keys
+				valid = false
+				break
+			}
+			rule.HMACKeys = append(rule.HMACKeys, HMACKey{ID: k.ID, SecretFile: k.SecretFile})
+		}
+		if e.ClientCertFile != "" || e.ClientKeyFile != "" {
+			if e.ClientCertFile == "" || e.ClientKeyFile == "" {
+				// Skip configs with a partial client certificate
+				valid = false
+			}
+			rule.ClientCertificate = &ClientCertificate{CertFile: e.ClientCertFile, KeyFile: e.ClientKeyFile}
+		}
+		if !valid {
+			continue
+		}
+		configs = append(configs, rule)
 	}
 	return configs, nil
 }
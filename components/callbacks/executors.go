@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
@@ -24,7 +25,10 @@ func RegisterExecutor(
 	registry *hsm.Registry,
 	executorOptions TaskExecutorOptions,
 ) error {
-	exec := taskExecutor{executorOptions}
+	exec := taskExecutor{
+		TaskExecutorOptions: executorOptions,
+		hmacSecrets:         collection.NewOnceMap(newSecretFileLoader),
+	}
 	if err := hsm.RegisterImmediateExecutor(
 		registry,
 		exec.executeInvocationTask,
@@ -51,6 +55,8 @@ type TaskExecutorOptions struct {
 
 type taskExecutor struct {
 	TaskExecutorOptions
+	// hmacSecrets caches the HMAC secrets of the callback allowlist by file path.
+	hmacSecrets *collection.OnceMap[string, *secretFileLoader]
 }
 
 // invocationResult is a marker for the callbackInvokable.Invoke result to indicate to the executor how to handle the
@@ -123,7 +129,7 @@ func (e taskExecutor) executeInvocationTask(
 	defer cancel()
 
 	result := invokable.Invoke(callCtx, ns, e, task)
-	saveErr := e.saveResult(ctx, env, ref, result)
+	saveErr := e.saveResult(ctx, env, ref, ns, result)
 	return invokable.WrapError(result, saveErr)
 }
 
@@ -182,8 +188,10 @@ func (e taskExecutor) saveResult(
 	ctx context.Context,
 	env hsm.Environment,
 	ref hsm.Ref,
+	ns *namespace.Namespace,
 	result invocationResult,
 ) error {
+	nsName := ns.Name().String()
 	return env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
 		return hsm.MachineTransition(node, func(callback Callback) (hsm.TransitionOutput, error) {
 			switch result.(type) {
@@ -192,12 +200,20 @@ func (e taskExecutor) saveResult(
 					Time: env.Now(),
 				})
 			case invocationResultRetry:
+				if maxAttempts := e.Config.MaxAttempts(nsName); maxAttempts > 0 && int(callback.Attempt)+1 >= maxAttempts {
+					e.MetricsHandler.Counter(FailedCounter.Name()).Record(1, metrics.NamespaceTag(nsName))
+					return TransitionFailed.Apply(callback, EventFailed{
+						Time: env.Now(),
+						Err:  fmt.Errorf("callback failed after %d attempts: %w", maxAttempts, result.error()),
+					})
+				}
 				return TransitionAttemptFailed.Apply(callback, EventAttemptFailed{
 					Time:        env.Now(),
 					Err:         result.error(),
-					RetryPolicy: e.Config.RetryPolicy(),
+					RetryPolicy: e.Config.RetryPolicy(nsName),
 				})
 			case invocationResultFail:
+				e.MetricsHandler.Counter(FailedCounter.Name()).Record(1, metrics.NamespaceTag(nsName))
 				return TransitionFailed.Apply(callback, EventFailed{
 					Time: env.Now(),
 					Err:  result.error(),
@@ -2,9 +2,17 @@ package callbacks_test
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -64,11 +73,13 @@ func (ms mutableState) GetHSMCompletionCallbackArg(ctx context.Context) (*persis
 
 func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 	cases := []struct {
-		name                  string
-		caller                callbacks.HTTPCaller
-		destinationDown       bool
-		expectedMetricOutcome string
-		assertOutcome         func(*testing.T, callbacks.Callback)
+		name                    string
+		caller                  callbacks.HTTPCaller
+		maxAttempts             int
+		nonRetryableStatusCodes []int
+		destinationDown         bool
+		expectedMetricOutcome   string
+		assertOutcome           func(*testing.T, callbacks.Callback)
 	}{
 		{
 			name: "success",
@@ -114,6 +125,31 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
 			},
 		},
+		{
+			name: "non-retryable-status-code",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 501, Body: http.NoBody}, nil
+			},
+			nonRetryableStatusCodes: []int{501},
+			destinationDown:         false,
+			expectedMetricOutcome:   "status:501",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+			},
+		},
+		{
+			name: "max-attempts-reached",
+			caller: func(r *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 500, Body: http.NoBody}, nil
+			},
+			maxAttempts:           1,
+			destinationDown:       true,
+			expectedMetricOutcome: "status:500",
+			assertOutcome: func(t *testing.T, cb callbacks.Callback) {
+				require.Equal(t, enumsspb.CALLBACK_STATE_FAILED, cb.State())
+				require.Contains(t, cb.LastAttemptFailure.Message, "callback failed after 1 attempts")
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -143,6 +179,7 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 				metrics.NamespaceTag("namespace-name"),
 				metrics.DestinationTag("http://localhost"),
 				metrics.OutcomeTag(tc.expectedMetricOutcome))
+			metricsHandler.EXPECT().Counter(callbacks.FailedCounter.Name()).Return(metrics.NoopCounterMetricFunc).AnyTimes()
 
 			root := newRoot(t)
 			cb := callbacks.Callback{
@@ -173,12 +210,7 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 						return tc.caller
 					},
 					Logger: log.NewNoopLogger(),
-					Config: &callbacks.Config{
-						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-						RetryPolicy: func() backoff.RetryPolicy {
-							return backoff.NewExponentialRetryPolicy(time.Second)
-						},
-					},
+					Config: newConfig(tc.maxAttempts, tc.nonRetryableStatusCodes),
 				},
 			))
 
@@ -213,6 +245,96 @@ func TestProcessInvocationTaskNexus_Outcomes(t *testing.T) {
 	}
 }
 
+func TestProcessInvocationTaskNexus_SignsRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	namespaceRegistryMock := namespace.NewMockRegistry(ctrl)
+	namespaceRegistryMock.EXPECT().GetNamespaceByID(namespace.ID("namespace-id")).Return(
+		namespace.FromPersistentState(&persistencespb.NamespaceDetail{
+			Info: &persistencespb.NamespaceInfo{
+				Id:   "namespace-id",
+				Name: "namespace-name",
+			},
+			Config: &persistencespb.NamespaceConfig{},
+		}),
+		nil,
+	)
+
+	root := newRoot(t)
+	node, err := callbacks.MachineCollection(root).Add("ID", callbacks.Callback{
+		CallbackInfo: &persistencespb.CallbackInfo{
+			Callback: &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Nexus_{
+					Nexus: &persistencespb.Callback_Nexus{
+						Url: "http://localhost/callback",
+						// Caller supplied headers must not override the signature.
+						Header: map[string]string{callbacks.CallbackSignatureHeader: "forged"},
+					},
+				},
+			},
+			State: enumsspb.CALLBACK_STATE_SCHEDULED,
+		},
+	})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	secrets := map[string]string{"old": "old-secret", "new": "new-secret"}
+	var keys []callbacks.HMACKey
+	for _, id := range []string{"old", "new"} {
+		path := filepath.Join(dir, id)
+		// The trailing newline isn't part of the secret.
+		require.NoError(t, os.WriteFile(path, []byte(secrets[id]+"\n"), 0o600))
+		keys = append(keys, callbacks.HMACKey{ID: id, SecretFile: path})
+	}
+	config := newConfig(0, nil)
+	config.AllowedAddresses = dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]callbacks.AddressMatchRule{
+		{Regexp: regexp.MustCompile("^localhost$"), AllowInsecure: true, HMACKeys: keys},
+	})
+
+	var request *http.Request
+	var body []byte
+	reg := hsm.NewRegistry()
+	require.NoError(t, callbacks.RegisterExecutor(
+		reg,
+		callbacks.TaskExecutorOptions{
+			NamespaceRegistry: namespaceRegistryMock,
+			MetricsHandler:    metrics.NoopMetricsHandler,
+			HTTPCallerProvider: func(nid queues.NamespaceIDAndDestination) callbacks.HTTPCaller {
+				return func(r *http.Request) (*http.Response, error) {
+					request = r
+					body, err = io.ReadAll(r.Body)
+					require.NoError(t, err)
+					return &http.Response{StatusCode: 200, Body: http.NoBody}, nil
+				}
+			},
+			Logger: log.NewNoopLogger(),
+			Config: config,
+		},
+	))
+	err = reg.ExecuteImmediateTask(
+		context.Background(),
+		fakeEnv{node},
+		hsm.Ref{
+			WorkflowKey: definition.NewWorkflowKey("namespace-id", "", ""),
+			StateMachineRef: &persistencespb.StateMachineRef{
+				Path: []*persistencespb.StateMachineKey{{Type: callbacks.StateMachineType, Id: "ID"}},
+			},
+		},
+		callbacks.NewInvocationTask("http://localhost"),
+	)
+	require.NoError(t, err)
+
+	timestamp := request.Header.Get(callbacks.CallbackTimestampHeader)
+	require.NotEmpty(t, timestamp)
+	var expected []string
+	for _, key := range keys {
+		mac := hmac.New(sha256.New, []byte(secrets[key.ID]))
+		_, _ = fmt.Fprintf(mac, "%s\n%s\n%s\n", timestamp, http.MethodPost, "http://localhost/callback")
+		_, _ = mac.Write(body)
+		expected = append(expected, key.ID+"="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	require.Equal(t, strings.Join(expected, ","), request.Header.Get(callbacks.CallbackSignatureHeader))
+}
+
 func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 	cases := []struct {
 		name                  string
@@ -273,6 +395,7 @@ func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 				metrics.NamespaceTag("namespace-name"),
 				metrics.DestinationTag(""),
 				metrics.OutcomeTag(fmt.Sprintf("status:%d", tc.expectedMetricOutcome)))
+			metricsHandler.EXPECT().Counter(callbacks.FailedCounter.Name()).Return(metrics.NoopCounterMetricFunc).AnyTimes()
 
 			root := newRoot(t)
 			ref := &persistencespb.StateMachineRef{
@@ -332,12 +455,7 @@ func TestProcessInvocationTaskHsm_Outcomes(t *testing.T) {
 					MetricsHandler:    metricsHandler,
 					HistoryClient:     historyClientMock,
 					Logger:            log.NewNoopLogger(),
-					Config: &callbacks.Config{
-						RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-						RetryPolicy: func() backoff.RetryPolicy {
-							return backoff.NewExponentialRetryPolicy(time.Second)
-						},
-					},
+					Config:            newConfig(0, nil),
 				},
 			))
 
@@ -394,12 +512,7 @@ func TestProcessBackoffTask(t *testing.T) {
 				return nil
 			},
 			Logger: log.NewNoopLogger(),
-			Config: &callbacks.Config{
-				RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
-				RetryPolicy: func() backoff.RetryPolicy {
-					return backoff.NewExponentialRetryPolicy(time.Second)
-				},
-			},
+			Config: newConfig(0, nil),
 		},
 	))
 
@@ -415,6 +528,65 @@ func TestProcessBackoffTask(t *testing.T) {
 	require.Equal(t, enumsspb.CALLBACK_STATE_SCHEDULED, cb.State())
 }
 
+func TestRetryFailedCallback(t *testing.T) {
+	root := newRoot(t)
+	cb := callbacks.Callback{
+		CallbackInfo: &persistencespb.CallbackInfo{
+			Callback: &persistencespb.Callback{
+				Variant: &persistencespb.Callback_Nexus_{
+					Nexus: &persistencespb.Callback_Nexus{
+						Url: "http://localhost",
+					},
+				},
+			},
+			State:   enumsspb.CALLBACK_STATE_FAILED,
+			Attempt: 3,
+		},
+	}
+	coll := callbacks.MachineCollection(root)
+	node, err := coll.Add("ID", cb)
+	require.NoError(t, err)
+	env := fakeEnv{node}
+
+	reg := hsm.NewRegistry()
+	require.NoError(t, callbacks.RegisterRemoteMethods(reg))
+	ref := hsm.Ref{
+		WorkflowKey: definition.NewWorkflowKey("namespace-id", "", ""),
+		StateMachineRef: &persistencespb.StateMachineRef{
+			Path: []*persistencespb.StateMachineKey{
+				{
+					Type: callbacks.StateMachineType,
+					Id:   "ID",
+				},
+			},
+		},
+	}
+
+	_, err = reg.ExecuteRemoteMethod(context.Background(), env, ref, callbacks.RetryMethodName, nil)
+	require.NoError(t, err)
+	cb, err = coll.Data("ID")
+	require.NoError(t, err)
+	require.Equal(t, enumsspb.CALLBACK_STATE_SCHEDULED, cb.State())
+	require.Equal(t, int32(0), cb.Attempt)
+
+	// Only failed callbacks can be retried.
+	_, err = reg.ExecuteRemoteMethod(context.Background(), env, ref, callbacks.RetryMethodName, nil)
+	var failedPreconditionErr *serviceerror.FailedPrecondition
+	require.ErrorAs(t, err, &failedPreconditionErr)
+}
+
+func newConfig(maxAttempts int, nonRetryableStatusCodes []int) *callbacks.Config {
+	return &callbacks.Config{
+		RequestTimeout: dynamicconfig.GetDurationPropertyFnFilteredByDestination(time.Second),
+		RetryPolicy: func(string) backoff.RetryPolicy {
+			return backoff.NewExponentialRetryPolicy(time.Second)
+		},
+		MaxAttempts:             dynamicconfig.GetIntPropertyFnFilteredByNamespace(maxAttempts),
+		NonRetryableStatusCodes: dynamicconfig.GetTypedPropertyFnFilteredByNamespace(nonRetryableStatusCodes),
+		AllowedAddresses:        dynamicconfig.GetTypedPropertyFnFilteredByNamespace([]callbacks.AddressMatchRule(nil)),
+	}
+}
+
 func newMutableState(t *testing.T) mutableState {
 	completionNexus, err := nexus.NewOperationCompletionSuccessful(nil, nexus.OperationCompletionSuccessfulOptions{})
 	require.NoError(t, err)
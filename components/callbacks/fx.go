@@ -23,6 +23,7 @@ var Module = fx.Module(
 	fx.Invoke(RegisterTaskSerializers),
 	fx.Invoke(RegisterStateMachine),
 	fx.Invoke(RegisterExecutor),
+	fx.Invoke(RegisterRemoteMethods),
 )
 
 func HTTPCallerProviderProvider(
@@ -36,6 +37,9 @@ func HTTPCallerProviderProvider(
 		return nil, fmt.Errorf("cannot create local frontend HTTP client: %w", err)
 	}
 
+	// Clients presenting a client certificate are shared by all destinations configured with the same certificate.
+	certClients := collection.NewOnceMap(newClientCertificateHTTPClient)
+
 	m := collection.NewOnceMap(func(queues.NamespaceIDAndDestination) HTTPCaller {
 		// Create this once and reuse for all outgoing requests.
 		// Note that this may not ever be used but it cheap enough to create and is better to avoid the complexities of
@@ -44,6 +48,9 @@ func HTTPCallerProviderProvider(
 
 		return func(r *http.Request) (*http.Response, error) {
 			if r.Header == nil || r.Header.Get(callbackSourceHeader) == "" {
+				if cert, ok := clientCertificateFromContext(r.Context()); ok {
+					return certClients.Get(cert).Do(r)
+				}
 				return client.Do(r)
 			}
 
@@ -10,3 +10,7 @@ var RequestLatencyHistogram = metrics.NewTimerDef(
 	"callback_outbound_latency",
 	metrics.WithDescription("Latency histogram of outbound callback requests made by the history service."),
 )
+var FailedCounter = metrics.NewCounterDef(
+	"callback_failed",
+	metrics.WithDescription("The number of callbacks that failed permanently, either with a non-retryable error or after exhausting their attempts."),
+)
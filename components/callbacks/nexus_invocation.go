@@ -9,6 +9,7 @@ import (
 	"mime"
 	"net/http"
 	"net/http/httptrace"
+	"net/url"
 	"slices"
 	"time"
 
@@ -75,6 +76,14 @@ func (n nexusInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e
 		}
 	}
 
+	var rule AddressMatchRule
+	if u, err := url.Parse(n.nexus.Url); err == nil {
+		rule, _ = MatchAddressRule(e.Config.AllowedAddresses(ns.Name().String()), u.Host)
+	}
+	if rule.ClientCertificate != nil {
+		ctx = withClientCertificate(ctx, *rule.ClientCertificate)
+	}
+
 	request, err := nexus.NewCompletionHTTPRequest(ctx, n.nexus.Url, n.completion)
 	if err != nil {
 		return invocationResultFail{queues.NewUnprocessableTaskError(
@@ -87,6 +96,13 @@ func (n nexusInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e
 	for k, v := range n.nexus.Header {
 		request.Header.Set(k, v)
 	}
+	// Sign after setting the caller supplied headers so they can't override the signature.
+	if len(rule.HMACKeys) > 0 {
+		loadSecret := func(path string) ([]byte, error) { return e.hmacSecrets.Get(path).load() }
+		if err := signCallbackRequest(request, rule.HMACKeys, loadSecret, time.Now()); err != nil {
+			return invocationResultRetry{err}
+		}
+	}
 
 	caller := e.HTTPCallerProvider(queues.NamespaceIDAndDestination{
 		NamespaceID: ns.ID().String(),
@@ -120,7 +136,8 @@ func (n nexusInvocation) Invoke(ctx context.Context, ns *namespace.Namespace, e
 		return invocationResultOK{}
 	}
 
-	retryable := isRetryableHTTPResponse(response)
+	retryable := isRetryableHTTPResponse(response) &&
+		!slices.Contains(e.Config.NonRetryableStatusCodes(ns.Name().String()), response.StatusCode)
 	err = readHandlerErrFromResponse(response, e.Logger)
 	e.Logger.Error("Callback request failed", tag.Error(err), tag.NewStringTag("status", response.Status), tag.NewBoolTag("retryable", retryable))
 	if retryable {
@@ -0,0 +1,45 @@
+package callbacks
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/service/history/hsm"
+)
+
+// RetryMethodName is the name of the remote method that retries a failed callback. It takes no input and returns no
+// output.
+const RetryMethodName = "callbacks.Retry"
+
+type retryMethod struct{}
+
+var _ hsm.RemoteMethod = retryMethod{}
+
+func (retryMethod) Name() string {
+	return RetryMethodName
+}
+
+func (retryMethod) SerializeOutput(any) ([]byte, error) {
+	return nil, nil
+}
+
+func (retryMethod) DeserializeInput([]byte) (any, error) {
+	return nil, nil
+}
+
+func RegisterRemoteMethods(registry *hsm.Registry) error {
+	return hsm.RegisterRemoteMethod(registry, retryMethod{}, executeRetry)
+}
+
+func executeRetry(ctx context.Context, env hsm.Environment, ref hsm.Ref, _ any) (any, error) {
+	return nil, env.Access(ctx, ref, hsm.AccessWrite, func(node *hsm.Node) error {
+		return hsm.MachineTransition(node, func(callback Callback) (hsm.TransitionOutput, error) {
+			if callback.State() != enumsspb.CALLBACK_STATE_FAILED {
+				return hsm.TransitionOutput{}, serviceerror.NewFailedPreconditionf(
+					"callback is in state %v, only failed callbacks can be retried", callback.State())
+			}
+			return TransitionRetried.Apply(callback, EventRetried{})
+		})
+	})
+}
@@ -0,0 +1,171 @@
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/auth"
+)
+
+const (
+	// CallbackTimestampHeader holds the unix time in seconds at which a signed callback request was sent. Receivers
+	// should reject requests with a timestamp too far in the past to prevent replays.
+	CallbackTimestampHeader = "Temporal-Callback-Timestamp"
+	// CallbackSignatureHeader holds a comma separated list of <key ID>=<hex encoded signature> pairs, one per configured
+	// key. Each signature is HMAC-SHA256 over "<timestamp>\n<method>\n<url>\n<body>". Receivers should accept the request
+	// if any signature made with a key they know matches.
+	CallbackSignatureHeader = "Temporal-Callback-Signature"
+)
+
+// signCallbackRequest signs the request with all the given keys, reading the request body into memory. loadSecret
+// returns the secret stored in the file at the given path.
+func signCallbackRequest(
+	request *http.Request,
+	keys []HMACKey,
+	loadSecret func(path string) ([]byte, error),
+	now time.Time,
+) error {
+	secrets := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		secret, err := loadSecret(key.SecretFile)
+		if err != nil {
+			return fmt.Errorf("failed to load callback signing key %q: %w", key.ID, err)
+		}
+		secrets = append(secrets, secret)
+	}
+
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		_ = request.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read callback request body: %w", err)
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		request.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		request.ContentLength = int64(len(body))
+	}
+
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	signatures := make([]string, 0, len(keys))
+	for i, key := range keys {
+		signatures = append(signatures, key.ID+"="+computeCallbackSignature(secrets[i], timestamp, request.Method, request.URL.String(), body))
+	}
+	request.Header.Set(CallbackTimestampHeader, timestamp)
+	request.Header.Set(CallbackSignatureHeader, strings.Join(signatures, ","))
+	return nil
+}
+
+func computeCallbackSignature(secret []byte, timestamp, method, url string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = fmt.Fprintf(mac, "%s\n%s\n%s\n", timestamp, method, url)
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type clientCertificateContextKey struct{}
+
+// withClientCertificate marks requests made with the returned context to present the given certificate over mTLS.
+func withClientCertificate(ctx context.Context, cert ClientCertificate) context.Context {
+	return context.WithValue(ctx, clientCertificateContextKey{}, cert)
+}
+
+func clientCertificateFromContext(ctx context.Context) (ClientCertificate, bool) {
+	cert, ok := ctx.Value(clientCertificateContextKey{}).(ClientCertificate)
+	return cert, ok
+}
+
+// newClientCertificateHTTPClient creates a client that presents the given certificate, reloading it whenever the
+// certificate or key file is modified to support rotation.
+func newClientCertificateHTTPClient(cert ClientCertificate) *http.Client {
+	loader := &certificateLoader{cert: cert}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = auth.NewDynamicTLSClientConfig(loader.load, nil, "", true)
+	return &http.Client{Transport: transport}
+}
+
+type certificateLoader struct {
+	cert ClientCertificate
+
+	mu                      sync.Mutex
+	loaded                  *tls.Certificate
+	certModTime, keyModTime time.Time
+}
+
+func (l *certificateLoader) load() (*tls.Certificate, error) {
+	certInfo, err := os.Stat(l.cert.CertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat callback client certificate: %w", err)
+	}
+	keyInfo, err := os.Stat(l.cert.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat callback client key: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.loaded != nil && certInfo.ModTime().Equal(l.certModTime) && keyInfo.ModTime().Equal(l.keyModTime) {
+		return l.loaded, nil
+	}
+	cert, err := tls.LoadX509KeyPair(l.cert.CertFile, l.cert.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load callback client certificate: %w", err)
+	}
+	l.loaded = &cert
+	l.certModTime = certInfo.ModTime()
+	l.keyModTime = keyInfo.ModTime()
+	return l.loaded, nil
+}
+
+// secretFileLoader reads a secret from a file, reloading it whenever the file is modified to support rotation.
+type secretFileLoader struct {
+	path string
+
+	mu      sync.Mutex
+	loaded  []byte
+	modTime time.Time
+}
+
+func newSecretFileLoader(path string) *secretFileLoader {
+	return &secretFileLoader{path: path}
+}
+
+func (l *secretFileLoader) load() ([]byte, error) {
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat secret file: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.loaded != nil && info.ModTime().Equal(l.modTime) {
+		return l.loaded, nil
+	}
+	contents, err := os.ReadFile(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret file: %w", err)
+	}
+	// Files written by editors or "echo" end with a newline that isn't part of the secret.
+	secret := bytes.TrimRight(contents, "\r\n")
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret file %q is empty", l.path)
+	}
+	l.loaded = secret
+	l.modTime = info.ModTime()
+	return l.loaded, nil
+}
@@ -269,3 +269,17 @@ var TransitionSucceeded = hsm.NewTransition(
 		return cb.output()
 	},
 )
+
+// EventRetried is triggered when an operator retries a failed callback.
+type EventRetried struct{}
+
+var TransitionRetried = hsm.NewTransition(
+	[]enumsspb.CallbackState{enumsspb.CALLBACK_STATE_FAILED},
+	enumsspb.CALLBACK_STATE_SCHEDULED,
+	func(cb Callback, event EventRetried) (hsm.TransitionOutput, error) {
+		// Start over with a full set of attempts. The last failure is kept until the next attempt completes.
+		cb.CallbackInfo.Attempt = 0
+		cb.CallbackInfo.NextAttemptScheduleTime = nil
+		return cb.output()
+	},
+)
@@ -8,6 +8,7 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/rpc/interceptor"
 )
 
@@ -83,6 +84,7 @@ var defaultDisallowedOperationHeaders = []string{
 	headers.CallerNameHeaderName,
 	headers.CallerTypeHeaderName,
 	headers.CallOriginHeaderName,
+	commonnexus.CallerNamespaceIDHeader,
 }
 
 var DisallowedOperationHeaders = dynamicconfig.NewGlobalTypedSetting(
@@ -98,6 +98,7 @@ func ClientProviderFactory(
 	return func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
 		var url string
 		var httpClient *http.Client
+		isWorkerTarget := false
 		switch variant := entry.Endpoint.Spec.Target.Variant.(type) {
 		case *persistencespb.NexusEndpointTarget_External_:
 			url = variant.External.GetUrl()
@@ -109,14 +110,23 @@ func ClientProviderFactory(
 		case *persistencespb.NexusEndpointTarget_Worker_:
 			url = cl.BaseURL() + "/" + commonnexus.RouteDispatchNexusTaskByEndpoint.Path(entry.Id)
 			httpClient = &cl.Client
+			isWorkerTarget = true
 		default:
 			return nil, serviceerror.NewInternal("got unexpected endpoint target")
 		}
 		httpCaller := httpClient.Do
+		if isWorkerTarget {
+			// Identify the caller to let the frontend enforce the endpoint's access policy.
+			httpCaller = func(r *http.Request) (*http.Response, error) {
+				r.Header.Set(commonnexus.CallerNamespaceIDHeader, namespaceID)
+				return httpClient.Do(r)
+			}
+		}
 		if clusterInfo, ok := clusterMetadata.GetAllClusterInfo()[clusterMetadata.GetCurrentClusterName()]; ok {
+			innerCaller := httpCaller
 			httpCaller = func(r *http.Request) (*http.Response, error) {
 				r.Header.Set(NexusCallbackSourceHeader, clusterInfo.ClusterID)
-				resp, callErr := httpClient.Do(r)
+				resp, callErr := innerCaller(r)
 				commonnexus.SetFailureSourceOnContext(ctx, resp)
 				return resp, callErr
 			}
@@ -67,6 +67,18 @@ func (ch *commandHandler) HandleScheduleCommand(
 			return err
 		}
 	} else {
+		if !commonnexus.CallerNamespaceAllowed(endpoint, ns.ID().String()) {
+			return workflow.FailWorkflowTaskError{
+				Cause:   enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_NEXUS_OPERATION_ATTRIBUTES,
+				Message: fmt.Sprintf("namespace %q is not allowed to call endpoint %q", nsName, attrs.Endpoint),
+			}
+		}
+		if workflowType := ms.GetExecutionInfo().GetWorkflowTypeName(); !commonnexus.WorkflowTypeAllowed(endpoint, workflowType) {
+			return workflow.FailWorkflowTaskError{
+				Cause:   enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_NEXUS_OPERATION_ATTRIBUTES,
+				Message: fmt.Sprintf("workflow type %q is not allowed to call endpoint %q", workflowType, attrs.Endpoint),
+			}
+		}
 		endpointID = endpoint.Id
 	}
 
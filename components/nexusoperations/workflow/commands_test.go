@@ -59,11 +59,23 @@ var defaultConfig = &nexusoperations.Config{
 func newTestContext(t *testing.T, cfg *nexusoperations.Config) testContext {
 	endpointReg := nexustest.FakeEndpointRegistry{
 		OnGetByName: func(ctx context.Context, namespaceID namespace.ID, endpointName string) (*persistencespb.NexusEndpointEntry, error) {
-			if endpointName != "endpoint" {
-				return nil, serviceerror.NewNotFound("endpoint not found")
+			switch endpointName {
+			case "endpoint":
+				// Only the ID is taken here.
+				return &persistencespb.NexusEndpointEntry{Id: "endpoint-id"}, nil
+			case "restricted-namespace":
+				return &persistencespb.NexusEndpointEntry{Id: "restricted-namespace-id", Endpoint: &persistencespb.NexusEndpoint{
+					AccessPolicy: &persistencespb.NexusEndpointAccessPolicy{AllowedCallerNamespaceIds: []string{"other-namespace-id"}},
+				}}, nil
+			case "restricted-workflow-type":
+				return &persistencespb.NexusEndpointEntry{Id: "restricted-workflow-type-id", Endpoint: &persistencespb.NexusEndpoint{
+					AccessPolicy: &persistencespb.NexusEndpointAccessPolicy{
+						AllowedCallerNamespaceIds: []string{tests.NamespaceID.String()},
+						AllowedWorkflowTypes:      []string{"allowed-type"},
+					},
+				}}, nil
 			}
-			// Only the ID is taken here.
-			return &persistencespb.NexusEndpointEntry{Id: "endpoint-id"}, nil
+			return nil, serviceerror.NewNotFound("endpoint not found")
 		},
 	}
 	chReg := workflow.NewCommandHandlerRegistry()
@@ -168,6 +180,49 @@ func TestHandleScheduleCommand(t *testing.T) {
 		require.Equal(t, 1, len(tcx.history.Events))
 	})
 
+	t.Run("caller namespace not allowed", func(t *testing.T) {
+		tcx := newTestContext(t, defaultConfig)
+		err := tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 1}, 1, &commandpb.Command{
+			Attributes: &commandpb.Command_ScheduleNexusOperationCommandAttributes{
+				ScheduleNexusOperationCommandAttributes: &commandpb.ScheduleNexusOperationCommandAttributes{
+					Endpoint:  "restricted-namespace",
+					Service:   "service",
+					Operation: "op",
+				},
+			},
+		})
+		var failWFTErr workflow.FailWorkflowTaskError
+		require.ErrorAs(t, err, &failWFTErr)
+		require.Equal(t, enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_NEXUS_OPERATION_ATTRIBUTES, failWFTErr.Cause)
+		require.Contains(t, failWFTErr.Message, "is not allowed to call endpoint \"restricted-namespace\"")
+		require.Equal(t, 0, len(tcx.history.Events))
+	})
+
+	t.Run("workflow type not allowed", func(t *testing.T) {
+		tcx := newTestContext(t, defaultConfig)
+		schedule := func() error {
+			return tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 1}, 1, &commandpb.Command{
+				Attributes: &commandpb.Command_ScheduleNexusOperationCommandAttributes{
+					ScheduleNexusOperationCommandAttributes: &commandpb.ScheduleNexusOperationCommandAttributes{
+						Endpoint:  "restricted-workflow-type",
+						Service:   "service",
+						Operation: "op",
+					},
+				},
+			})
+		}
+		tcx.execInfo.WorkflowTypeName = "other-type"
+		var failWFTErr workflow.FailWorkflowTaskError
+		require.ErrorAs(t, schedule(), &failWFTErr)
+		require.Equal(t, enumspb.WORKFLOW_TASK_FAILED_CAUSE_BAD_SCHEDULE_NEXUS_OPERATION_ATTRIBUTES, failWFTErr.Cause)
+		require.Equal(t, `workflow type "other-type" is not allowed to call endpoint "restricted-workflow-type"`, failWFTErr.Message)
+		require.Equal(t, 0, len(tcx.history.Events))
+
+		tcx.execInfo.WorkflowTypeName = "allowed-type"
+		require.NoError(t, schedule())
+		require.Equal(t, 1, len(tcx.history.Events))
+	})
+
 	t.Run("exceeds max service length", func(t *testing.T) {
 		tcx := newTestContext(t, defaultConfig)
 		err := tcx.scheduleHandler(context.Background(), tcx.ms, commandValidator{maxPayloadSize: 1}, 1, &commandpb.Command{
@@ -105,7 +105,7 @@ func (e backfillerTaskExecutor) executeBackfillTask(env hsm.Environment, node *h
 
 	// Enqueue new BufferedStarts on the Invoker, if we have any.
 	if len(result.BufferedStarts) > 0 {
-		err = scheduler.EnqueueBufferedStarts(schedulerNode, result.BufferedStarts)
+		err = scheduler.EnqueueBufferedStarts(schedulerNode, result.BufferedStarts, nil)
 		if err != nil {
 			logger.Error("Failed to enqueue BufferedStarts", tag.Error(err))
 			return err
@@ -15,6 +15,7 @@ type (
 		CanceledTerminatedCountAsFailures bool          // Whether cancelled+terminated count for pause-on-failure
 		RecentActionCount                 int           // Number of recent actions taken (workflow execution results) recorded in the ScheduleInfo metadata.
 		MaxActionsPerExecution            int           // Limits the number of actions (startWorkflow, terminate/cancel) taken by ExecuteTask in a single iteration
+		DependencyPollInterval            time.Duration // How often to check whether the upstream actions of a buffered start completed
 
 		// TODO - incomplete tweakables list
 	}
@@ -24,6 +25,8 @@ type (
 		Tweakables         dynamicconfig.TypedPropertyFnWithNamespaceFilter[Tweakables]
 		ServiceCallTimeout dynamicconfig.DurationPropertyFn
 		RetryPolicy        func() backoff.RetryPolicy
+		// Number of batches of skipped actions retained per schedule.
+		MaxSkippedActionBatches dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -58,6 +61,7 @@ var (
 		CanceledTerminatedCountAsFailures: false,
 		RecentActionCount:                 10,
 		MaxActionsPerExecution:            10,
+		DependencyPollInterval:            30 * time.Second,
 	}
 )
 
@@ -74,5 +78,6 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 				backoff.NoInterval,
 			)
 		},
+		MaxSkippedActionBatches: dynamicconfig.ScheduleMaxSkippedActionBatches.Get(dc),
 	}
 }
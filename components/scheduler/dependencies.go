@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/hsm"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// awaitDependencies checks the upstream actions of a buffered start, returning
+// true if the start can be executed. Otherwise, a copy of the start is added to
+// result as waiting, skipped, or failed. start itself isn't modified, since it
+// belongs to the Invoker state read by the task.
+func (e invokerTaskExecutor) awaitDependencies(
+	ctx invokerTaskExecutorContext,
+	logger log.Logger,
+	env hsm.Environment,
+	scheduler Scheduler,
+	start *schedulespb.BufferedStart,
+	result *executeResult,
+) bool {
+	dependency, status, reason, err := e.checkDependencies(ctx, env, scheduler, start)
+	if err != nil {
+		logger.Error("Failed to check schedule dependencies", tag.Error(err))
+		if isRetryableError(err) {
+			retry := common.CloneProto(start)
+			e.applyBackoff(env, retry, err)
+			result.RetryableStarts = append(result.RetryableStarts, retry)
+		} else {
+			result.FailedStarts = append(result.FailedStarts, start)
+		}
+		return false
+	}
+
+	switch status {
+	case enumsspb.SCHEDULE_DEPENDENCY_STATUS_SATISFIED:
+		return true
+	case enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING:
+		tweakables := e.Config.Tweakables(scheduler.Namespace)
+		waiting := common.CloneProto(start)
+		waiting.BackoffTime = timestamppb.New(env.Now().Add(tweakables.DependencyPollInterval))
+		result.WaitingStarts = append(result.WaitingStarts, waiting)
+		return false
+	default:
+	}
+
+	logger.Warn("Skipping scheduled action after upstream schedule action failed",
+		tag.NewStringTag("upstream-schedule-id", dependency.GetScheduleId()),
+		tag.NewStringTag("reason", reason))
+	e.MetricsHandler.Counter(metrics.ScheduleDependencySkipped.Name()).Record(1)
+	result.SkippedStarts = append(result.SkippedStarts, start)
+	if dependency.GetPolicy() == enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL && result.PauseNotes == "" {
+		result.PauseNotes = fmt.Sprintf(
+			"Paused because the action for %s was skipped: %s",
+			start.GetNominalTime().AsTime().Format(time.RFC3339),
+			reason,
+		)
+	}
+	return false
+}
+
+// checkDependencies returns the combined status of the upstream actions of a
+// buffered start. Upstream actions that are pending past the dependency's
+// timeout are considered failed. When the status is failed, the
+// failed dependency and a reason are returned as well.
+func (e invokerTaskExecutor) checkDependencies(
+	ctx invokerTaskExecutorContext,
+	env hsm.Environment,
+	scheduler Scheduler,
+	start *schedulespb.BufferedStart,
+) (*schedulespb.ScheduleDependency, enumsspb.ScheduleDependencyStatus, string, error) {
+	tweakables := e.Config.Tweakables(scheduler.Namespace)
+	status := enumsspb.SCHEDULE_DEPENDENCY_STATUS_SATISFIED
+	for _, dependency := range scheduler.GetDependencies() {
+		upstreamStatus, reason, err := scheduler1.UpstreamActionStatus(
+			ctx,
+			e.FrontendClient,
+			scheduler.Namespace,
+			dependency,
+			start.GetNominalTime().AsTime(),
+		)
+		if err != nil {
+			return nil, enumsspb.SCHEDULE_DEPENDENCY_STATUS_UNSPECIFIED, "", err
+		}
+
+		deadline := scheduler1.DependencyDeadline(dependency, start.GetActualTime().AsTime(), catchupWindow(scheduler, tweakables))
+		if upstreamStatus == enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING && env.Now().After(deadline) {
+			upstreamStatus = enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED
+			reason = fmt.Sprintf("timed out waiting for the action of schedule %q", dependency.GetScheduleId())
+		}
+
+		switch upstreamStatus {
+		case enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED:
+			return dependency, upstreamStatus, reason, nil
+		case enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING:
+			status = upstreamStatus
+		default:
+		}
+	}
+	return nil, status, "", nil
+}
@@ -80,7 +80,7 @@ func (e generatorTaskExecutor) executeBufferTask(env hsm.Environment, node *hsm.
 	}
 
 	// Transition the invoker sub state machine to execute the new buffered actions.
-	err = scheduler.EnqueueBufferedStarts(schedulerNode, res.BufferedStarts)
+	err = scheduler.EnqueueBufferedStarts(schedulerNode, res.BufferedStarts, res.SkippedActions)
 	if err != nil {
 		return fmt.Errorf(
 			"%w: %w",
@@ -91,7 +91,7 @@ func newSchedulerTree(
 	patch *schedulepb.SchedulePatch,
 ) *hsm.Node {
 	// Add Scheduler root node
-	s := scheduler.NewScheduler(namespace, namespaceID, scheduleID, sched, patch)
+	s := scheduler.NewScheduler(namespace, namespaceID, scheduleID, sched, patch, nil, nil)
 	schedulerNode, err := root.AddChild(hsm.Key{
 		Type: scheduler.SchedulerMachineType,
 		ID:   scheduleID,
@@ -154,6 +154,9 @@ func defaultConfig() *scheduler.Config {
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(1 * time.Second)
 		},
+		MaxSkippedActionBatches: func(_ string) int {
+			return 10
+		},
 	}
 }
 
@@ -27,6 +27,9 @@ type (
 const (
 	// Unique identifier for the Invoker sub state machine.
 	InvokerMachineType = "scheduler.Invoker"
+
+	// Maximum number of skipped actions to keep in state until they're persisted.
+	maxPendingSkippedActions = 100
 )
 
 var (
@@ -90,6 +93,7 @@ var TransitionEnqueue = hsm.NewTransition(
 	enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
 	func(i Invoker, event EventEnqueue) (hsm.TransitionOutput, error) {
 		i.BufferedStarts = append(i.GetBufferedStarts(), event.BufferedStarts...)
+		i.addPendingSkippedActions(event.SkippedActions...)
 		return i.output()
 	},
 )
@@ -173,12 +177,14 @@ func (i Invoker) update(result *processBufferResult) {
 	i.InvokerInternal.BufferedStarts = starts
 	i.InvokerInternal.CancelWorkflows = append(i.GetCancelWorkflows(), result.CancelWorkflows...)
 	i.InvokerInternal.TerminateWorkflows = append(i.GetTerminateWorkflows(), result.TerminateWorkflows...)
+	i.addPendingSkippedActions(result.SkippedActions...)
 }
 
 func (i Invoker) recordExecution(result *executeResult) {
 	completed := make(map[string]bool)                       // request ID -> is present
 	failed := make(map[string]bool)                          // request ID -> is present
 	retryable := make(map[string]*schedulespb.BufferedStart) // request ID -> *BufferedStart
+	waiting := make(map[string]*schedulespb.BufferedStart)   // request ID -> *BufferedStart
 	canceled := make(map[string]bool)                        // run ID -> is present
 	terminated := make(map[string]bool)                      // run ID -> is present
 
@@ -188,9 +194,15 @@ func (i Invoker) recordExecution(result *executeResult) {
 	for _, start := range result.FailedStarts {
 		failed[start.RequestId] = true
 	}
+	for _, start := range result.SkippedStarts {
+		failed[start.RequestId] = true
+	}
 	for _, start := range result.RetryableStarts {
 		retryable[start.RequestId] = start
 	}
+	for _, start := range result.WaitingStarts {
+		waiting[start.RequestId] = start
+	}
 	for _, wf := range result.CompletedCancels {
 		canceled[wf.RunId] = true
 	}
@@ -209,12 +221,43 @@ func (i Invoker) recordExecution(result *executeResult) {
 		return !terminated[we.RunId]
 	})
 
+	// Drop skipped actions that were persisted, and add the ones skipped for their
+	// dependencies to be persisted by the next ExecuteTask.
+	persisted := min(result.PersistedSkippedActions, len(i.GetPendingSkippedActions()))
+	i.InvokerInternal.PendingSkippedActions = i.GetPendingSkippedActions()[persisted:]
+	for _, start := range result.SkippedStarts {
+		i.addPendingSkippedActions(newSkippedAction(start, enumsspb.SCHEDULE_ACTION_SKIP_REASON_DEPENDENCY))
+	}
+
 	// Update attempt counts and backoffs for failed/retrying starts.
 	for _, start := range i.GetBufferedStarts() {
 		if retry, ok := retryable[start.RequestId]; ok {
 			start.Attempt++
 			start.BackoffTime = retry.GetBackoffTime()
 		}
+		if wait, ok := waiting[start.RequestId]; ok {
+			start.BackoffTime = wait.GetBackoffTime()
+		}
+	}
+}
+
+// addPendingSkippedActions adds skipped actions to be persisted, dropping the oldest ones
+// beyond maxPendingSkippedActions if they can't be persisted.
+func (i Invoker) addPendingSkippedActions(actions ...*schedulespb.SkippedAction) {
+	i.InvokerInternal.PendingSkippedActions = util.SliceTail(
+		append(i.GetPendingSkippedActions(), actions...),
+		maxPendingSkippedActions,
+	)
+}
+
+func newSkippedAction(
+	start *schedulespb.BufferedStart,
+	reason enumsspb.ScheduleActionSkipReason,
+) *schedulespb.SkippedAction {
+	return &schedulespb.SkippedAction{
+		NominalTime: start.GetNominalTime(),
+		ActualTime:  start.GetActualTime(),
+		Reason:      reason,
 	}
 }
 
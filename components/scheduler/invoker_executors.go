@@ -11,12 +11,14 @@ import (
 	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/service/history/hsm"
@@ -34,6 +36,8 @@ type (
 		BaseLogger     log.Logger
 		HistoryClient  resource.HistoryClient
 		FrontendClient workflowservice.WorkflowServiceClient
+		// SkippedActionManager stores the skipped actions listed by ListScheduleActions.
+		SkippedActionManager persistence.SkippedScheduleActionManager
 	}
 
 	invokerTaskExecutor struct {
@@ -116,12 +120,23 @@ func (e invokerTaskExecutor) executeExecuteTask(
 
 	// If we have nothing to do, we can return without any additional writes.
 	eligibleStarts := invoker.getEligibleBufferedStarts()
+	skippedActions := invoker.GetPendingSkippedActions()
 	if len(invoker.GetTerminateWorkflows())+
 		len(invoker.GetCancelWorkflows())+
-		len(eligibleStarts) == 0 {
+		len(eligibleStarts)+
+		len(skippedActions) == 0 {
 		return nil
 	}
 
+	// Persist skipped actions first, so that they're retried with the task if this fails.
+	if len(skippedActions) > 0 {
+		if err := e.recordSkippedActions(ctx, *scheduler, skippedActions); err != nil {
+			logger.Error("Failed to persist skipped actions", tag.Error(err))
+			return err
+		}
+		result.PersistedSkippedActions = len(skippedActions)
+	}
+
 	// Terminate, cancel, and start workflows. The result struct contains the
 	// complete outcome of all requests executed in a single batch.
 	ictx := e.newInvokerTaskExecutorContext(ctx, *scheduler)
@@ -147,6 +162,7 @@ func (e invokerTaskExecutor) executeExecuteTask(
 			return TransitionRecordAction.Apply(s, EventRecordAction{
 				ActionCount: int64(len(startResults)),
 				Results:     startResults,
+				PauseNotes:  result.PauseNotes,
 			})
 		})
 	})
@@ -220,6 +236,14 @@ func (e invokerTaskExecutor) startWorkflows(
 		metrics.StringTag(metrics.ScheduleActionTypeTag, metrics.ScheduleActionStartWorkflow))
 
 	for _, start := range starts {
+		// Scheduled starts wait for the actions of upstream schedules for the same nominal
+		// time. Starts that keep waiting don't count against MaxActionsPerExecution.
+		if !start.Manual && len(scheduler.GetDependencies()) > 0 {
+			if !e.awaitDependencies(ctx, logger, env, scheduler, start, &result) {
+				continue
+			}
+		}
+
 		// Starts that haven't been executed yet will remain in `BufferedStarts`,
 		// without change, so another ExecuteTask will be immediately created to continue
 		// processing in a new task.
@@ -297,10 +321,11 @@ func (e invokerTaskExecutor) executeProcessBufferTask(env hsm.Environment, node
 		return err
 	}
 
-	// If any BufferedStarts are past their first attempt, we can retry after a backoff.
+	// If any BufferedStarts are past their first attempt, or waiting for upstream
+	// actions, we can retry after a backoff.
 	backingOff := false
 	for _, start := range invoker.GetBufferedStarts() {
-		if start.Attempt > 1 {
+		if start.Attempt > 1 || start.GetBackoffTime().AsTime().After(env.Now()) {
 			backingOff = true
 			break
 		}
@@ -359,6 +384,20 @@ func (e invokerTaskExecutor) processBuffer(
 	// Update result metrics.
 	result.OverlapSkipped = action.OverlapSkipped
 
+	// Starts that are neither kept in the buffer nor ready were skipped by the overlap policy.
+	readyIDs := make(map[string]struct{})
+	for _, start := range readyStarts {
+		readyIDs[start.GetRequestId()] = struct{}{}
+	}
+	for _, start := range pendingBufferedStarts {
+		_, kept := keepStarts[start.GetRequestId()]
+		_, ready := readyIDs[start.GetRequestId()]
+		if !kept && !ready {
+			result.SkippedActions = append(result.SkippedActions,
+				newSkippedAction(start, enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP))
+		}
+	}
+
 	// Add starting workflows to result, trim others.
 	for _, start := range readyStarts {
 		// Ensure we can take more actions. Manual actions are always allowed.
@@ -371,6 +410,8 @@ func (e invokerTaskExecutor) processBuffer(
 		if env.Now().After(e.startWorkflowDeadline(scheduler, start)) {
 			// Drop expired starts.
 			result.MissedCatchupWindow++
+			result.SkippedActions = append(result.SkippedActions,
+				newSkippedAction(start, enumsspb.SCHEDULE_ACTION_SKIP_REASON_CATCHUP_WINDOW))
 			result.DiscardStarts = append(result.DiscardStarts, start)
 			continue
 		}
@@ -444,8 +485,7 @@ func (e invokerTaskExecutor) startWorkflow(
 	start *schedulespb.BufferedStart,
 ) (*schedulepb.ScheduleActionResult, error) {
 	requestSpec := scheduler.GetSchedule().GetAction().GetStartWorkflow()
-	nominalTimeSec := start.NominalTime.AsTime().Truncate(time.Second)
-	workflowID := fmt.Sprintf("%s-%s", requestSpec.WorkflowId, nominalTimeSec.Format(time.RFC3339))
+	workflowID := scheduler1.ScheduledWorkflowID(requestSpec.WorkflowId, start.NominalTime.AsTime())
 
 	if start.Attempt >= InvokerMaxStartAttempts {
 		return nil, errRetryLimitExceeded
@@ -463,7 +503,6 @@ func (e invokerTaskExecutor) startWorkflow(
 	}
 
 	// TODO - set last completion result/continued failure
-	// TODO - set search attributes
 	request := &workflowservice.StartWorkflowExecutionRequest{
 		Namespace:                scheduler.Namespace,
 		WorkflowId:               workflowID,
@@ -478,7 +517,7 @@ func (e invokerTaskExecutor) startWorkflow(
 		WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		RetryPolicy:              requestSpec.RetryPolicy,
 		Memo:                     requestSpec.Memo,
-		SearchAttributes:         nil,
+		SearchAttributes:         scheduler1.ScheduledSearchAttributes(requestSpec.SearchAttributes, scheduler.ScheduleId, start.NominalTime.AsTime()),
 		Header:                   requestSpec.Header,
 		LastCompletionResult:     nil,
 		ContinuedFailure:         nil,
@@ -538,6 +577,23 @@ func (e invokerTaskExecutor) cancelWorkflow(
 	return err
 }
 
+// recordSkippedActions appends skipped actions to the skipped actions of the schedule
+// listed by ListScheduleActions.
+func (e invokerTaskExecutor) recordSkippedActions(
+	ctx context.Context,
+	scheduler Scheduler,
+	actions []*schedulespb.SkippedAction,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, e.Config.ServiceCallTimeout())
+	defer cancel()
+	return e.SkippedActionManager.AppendSkippedScheduleActions(ctx, &persistence.AppendSkippedScheduleActionsRequest{
+		NamespaceID:    scheduler.NamespaceId,
+		ScheduleID:     scheduler.ScheduleId,
+		SkippedActions: actions,
+		MaxBatches:     e.Config.MaxSkippedActionBatches(scheduler.Namespace),
+	})
+}
+
 // getRateLimiterPermission returns a delay for which the caller should wait
 // before proceeding. If an error is returned, execution should not proceed, and
 // reservation should be retried.
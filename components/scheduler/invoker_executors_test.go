@@ -3,6 +3,7 @@ package scheduler_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,7 +11,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -19,12 +22,16 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/components/scheduler"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -34,6 +41,7 @@ type invokerExecutorsSuite struct {
 
 	mockFrontendClient *workflowservicemock.MockWorkflowServiceClient
 	mockHistoryClient  *historyservicemock.MockHistoryServiceClient
+	mockSkippedActions *persistence.MockSkippedScheduleActionManager
 
 	env           *fakeEnv
 	registry      *hsm.Registry
@@ -65,14 +73,16 @@ func (e *invokerExecutorsSuite) SetupTest() {
 	// Set up service mocks.
 	e.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(e.controller)
 	e.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(e.controller)
+	e.mockSkippedActions = persistence.NewMockSkippedScheduleActionManager(e.controller)
 
 	// Register the task executor with mocks injected.
 	require.NoError(e.T(), scheduler.RegisterInvokerExecutors(e.registry, scheduler.InvokerTaskExecutorOptions{
-		Config:         defaultConfig(),
-		MetricsHandler: metrics.NoopMetricsHandler,
-		BaseLogger:     log.NewTestLogger(),
-		HistoryClient:  e.mockHistoryClient,
-		FrontendClient: e.mockFrontendClient,
+		Config:               defaultConfig(),
+		MetricsHandler:       metrics.NoopMetricsHandler,
+		BaseLogger:           log.NewTestLogger(),
+		HistoryClient:        e.mockHistoryClient,
+		FrontendClient:       e.mockFrontendClient,
+		SkippedActionManager: e.mockSkippedActions,
 	}))
 }
 
@@ -215,7 +225,12 @@ func (e *invokerExecutorsSuite) TestProcessBufferTask_MissedCatchupWindow() {
 		ExpectedOverlapSkipped:      0,
 		ExpectedMissedCatchupWindow: 1,
 		ExpectedState:               enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
-		ExpectedTasks:               map[string]int{},
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeExecute: 1,
+		},
+		Validate: func(t *testing.T, i scheduler.Invoker) {
+			requireSkippedActionReasons(t, i, enumsspb.SCHEDULE_ACTION_SKIP_REASON_CATCHUP_WINDOW)
+		},
 	})
 }
 
@@ -264,6 +279,7 @@ func (e *invokerExecutorsSuite) TestProcessBufferTask_BufferOne() {
 			require.Equal(t, 1, len(util.FilterSlice(i.GetBufferedStarts(), func(start *schedulespb.BufferedStart) bool {
 				return start.Attempt > 0
 			})))
+			requireSkippedActionReasons(t, i, enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP)
 		},
 	})
 }
@@ -707,6 +723,370 @@ func (e *invokerExecutorsSuite) TestExecuteTask_ExceedsMaxActionsPerExecution()
 	})
 }
 
+// A buffered start waits while the upstream schedule's action is running.
+func (e *invokerExecutorsSuite) TestExecuteTask_DependencyPending() {
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		},
+	}
+	e.expectUpstreamWorkflow(startTime.AsTime(), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+	e.runTestCase(&testCase{
+		TaskType:               scheduler.TaskTypeExecute,
+		InitialBufferedStarts:  bufferedStarts,
+		InitialDependencies:    []*schedulespb.ScheduleDependency{{ScheduleId: "upstream"}},
+		InitialState:           enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts: 1,
+		ExpectedState:          enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+		Validate: func(t *testing.T, i scheduler.Invoker) {
+			// Waiting doesn't use up an attempt.
+			start := i.BufferedStarts[0]
+			require.Equal(t, int64(1), start.Attempt)
+			require.Equal(t, e.env.Now().Add(scheduler.DefaultTweakables.DependencyPollInterval), start.BackoffTime.AsTime())
+
+			tasks := e.opLogTaskMap()
+			require.Equal(t, start.BackoffTime.AsTime(), tasks[scheduler.TaskTypeProcessBuffer][0].Deadline())
+		},
+	})
+}
+
+// A buffered start is executed once the upstream schedule's action completed.
+func (e *invokerExecutorsSuite) TestExecuteTask_DependencySatisfied() {
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		},
+	}
+	e.expectUpstreamWorkflow(startTime.AsTime(), enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED)
+	e.mockFrontendClient.EXPECT().
+		StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(&workflowservice.StartWorkflowExecutionResponse{
+			RunId: "run-id",
+		}, nil)
+
+	e.runTestCase(&testCase{
+		TaskType:                 scheduler.TaskTypeExecute,
+		InitialBufferedStarts:    bufferedStarts,
+		InitialDependencies:      []*schedulespb.ScheduleDependency{{ScheduleId: "upstream"}},
+		InitialState:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts:   0,
+		ExpectedRunningWorkflows: 1,
+		ExpectedActionCount:      1,
+		ExpectedState:            enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+	})
+}
+
+// A buffered start is skipped and the schedule paused when the upstream
+// schedule's action failed, with the fail policy.
+func (e *invokerExecutorsSuite) TestExecuteTask_DependencyFailed() {
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		},
+	}
+	e.expectUpstreamWorkflow(startTime.AsTime(), enumspb.WORKFLOW_EXECUTION_STATUS_FAILED)
+
+	e.runTestCase(&testCase{
+		TaskType:              scheduler.TaskTypeExecute,
+		InitialBufferedStarts: bufferedStarts,
+		InitialDependencies: []*schedulespb.ScheduleDependency{{
+			ScheduleId: "upstream",
+			Policy:     enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL,
+		}},
+		InitialState:           enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts: 0,
+		ExpectedState:          enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+			scheduler.TaskTypeExecute:       1,
+		},
+		Validate: func(t *testing.T, i scheduler.Invoker) {
+			s, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
+			require.NoError(t, err)
+			require.True(t, s.Schedule.State.Paused)
+			require.Contains(t, s.Schedule.State.Notes, "closed with status Failed")
+			requireSkippedActionReasons(t, i, enumsspb.SCHEDULE_ACTION_SKIP_REASON_DEPENDENCY)
+		},
+	})
+}
+
+// A buffered start is skipped without pausing the schedule when the upstream
+// schedule's action doesn't complete within the timeout.
+func (e *invokerExecutorsSuite) TestExecuteTask_DependencyTimeout() {
+	startTime := timestamppb.New(e.env.Now().Add(-2 * time.Hour))
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		},
+	}
+	e.expectUpstreamWorkflow(startTime.AsTime(), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING)
+
+	e.runTestCase(&testCase{
+		TaskType:              scheduler.TaskTypeExecute,
+		InitialBufferedStarts: bufferedStarts,
+		InitialDependencies: []*schedulespb.ScheduleDependency{{
+			ScheduleId: "upstream",
+			Timeout:    durationpb.New(time.Hour),
+			Policy:     enumsspb.SCHEDULE_DEPENDENCY_POLICY_SKIP,
+		}},
+		InitialState:           enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts: 0,
+		ExpectedState:          enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+			scheduler.TaskTypeExecute:       1,
+		},
+		Validate: func(t *testing.T, i scheduler.Invoker) {
+			s, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
+			require.NoError(t, err)
+			require.False(t, s.Schedule.State.Paused)
+			requireSkippedActionReasons(t, i, enumsspb.SCHEDULE_ACTION_SKIP_REASON_DEPENDENCY)
+		},
+	})
+}
+
+// An upstream workflow that continued as new is still running its action, so
+// the buffered start keeps waiting.
+func (e *invokerExecutorsSuite) TestExecuteTask_DependencyContinuedAsNew() {
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		},
+	}
+	e.expectUpstreamWorkflow(startTime.AsTime(), enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW)
+
+	e.runTestCase(&testCase{
+		TaskType:               scheduler.TaskTypeExecute,
+		InitialBufferedStarts:  bufferedStarts,
+		InitialDependencies:    []*schedulespb.ScheduleDependency{{ScheduleId: "upstream"}},
+		InitialState:           enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts: 1,
+		ExpectedState:          enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+		Validate: func(t *testing.T, i scheduler.Invoker) {
+			require.Equal(t, e.env.Now().Add(scheduler.DefaultTweakables.DependencyPollInterval), i.BufferedStarts[0].BackoffTime.AsTime())
+		},
+	})
+}
+
+// Starts that wait for their dependencies don't use up the actions allowed per
+// execution, so a start behind a full batch of waiting starts is still executed.
+func (e *invokerExecutorsSuite) TestExecuteTask_WaitingStartsDontTakeActions() {
+	base := e.env.Now().Add(-time.Duration(scheduler.DefaultTweakables.MaxActionsPerExecution) * time.Minute)
+	var bufferedStarts []*schedulespb.BufferedStart
+	for i := 0; i <= scheduler.DefaultTweakables.MaxActionsPerExecution; i++ {
+		startTime := timestamppb.New(base.Add(time.Duration(i) * time.Minute))
+		bufferedStarts = append(bufferedStarts, &schedulespb.BufferedStart{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     fmt.Sprintf("req%d", i),
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		})
+	}
+
+	// Only the upstream workflow of the last start completed.
+	lastWorkflowID := scheduler1.ScheduledWorkflowID("upstream-wf", bufferedStarts[len(bufferedStarts)-1].NominalTime.AsTime().Add(-upstreamOffset))
+	e.mockFrontendClient.EXPECT().
+		DescribeSchedule(gomock.Any(), gomock.Any()).
+		Times(len(bufferedStarts)).
+		Return(&workflowservice.DescribeScheduleResponse{
+			Schedule: &schedulepb.Schedule{
+				Action: &schedulepb.ScheduleAction{
+					Action: &schedulepb.ScheduleAction_StartWorkflow{
+						StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{WorkflowId: "upstream-wf"},
+					},
+				},
+			},
+		}, nil)
+	e.expectListUpstreamActions(len(bufferedStarts))
+	e.mockFrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Times(len(bufferedStarts)).
+		DoAndReturn(func(_ context.Context, req *workflowservice.DescribeWorkflowExecutionRequest, _ ...any) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+			status := enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+			if req.Execution.WorkflowId == lastWorkflowID {
+				status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+			}
+			return &workflowservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: status},
+			}, nil
+		})
+	e.mockFrontendClient.EXPECT().
+		StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(&workflowservice.StartWorkflowExecutionResponse{
+			RunId: "run-id",
+		}, nil)
+
+	e.runTestCase(&testCase{
+		TaskType:              scheduler.TaskTypeExecute,
+		InitialBufferedStarts: bufferedStarts,
+		InitialDependencies: []*schedulespb.ScheduleDependency{{
+			ScheduleId: "upstream",
+			Timeout:    durationpb.New(time.Hour),
+		}},
+		InitialState:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts:   scheduler.DefaultTweakables.MaxActionsPerExecution,
+		ExpectedRunningWorkflows: 1,
+		ExpectedActionCount:      1,
+		ExpectedState:            enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+	})
+}
+
+// upstreamOffset is how long before a start the "upstream" schedule starts its
+// action, so that the nominal times of the schedules never match.
+const upstreamOffset = 30 * time.Minute
+
+// expectUpstreamWorkflow sets up the "upstream" schedule, whose workflow for the
+// start with the nominal time has the given status.
+// Execute persists pending skipped actions and removes them from the Invoker.
+func (e *invokerExecutorsSuite) TestExecuteTask_PersistsSkippedActions() {
+	skipped := []*schedulespb.SkippedAction{{
+		NominalTime: timestamppb.New(e.env.Now()),
+		ActualTime:  timestamppb.New(e.env.Now()),
+		Reason:      enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP,
+	}}
+	e.mockSkippedActions.EXPECT().AppendSkippedScheduleActions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *persistence.AppendSkippedScheduleActionsRequest) error {
+			require.Equal(e.T(), scheduleID, req.ScheduleID)
+			require.Equal(e.T(), 10, req.MaxBatches)
+			require.Len(e.T(), req.SkippedActions, 1)
+			return nil
+		})
+
+	e.runTestCase(&testCase{
+		TaskType:              scheduler.TaskTypeExecute,
+		InitialSkippedActions: skipped,
+		InitialState:          enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedState:         enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+		Validate: func(t *testing.T, i scheduler.Invoker) {
+			require.Empty(t, i.GetPendingSkippedActions())
+		},
+	})
+}
+
+// Execute fails without starting workflows when pending skipped actions can't be
+// persisted, so that the task is retried.
+func (e *invokerExecutorsSuite) TestExecuteTask_PersistSkippedActionsFailure() {
+	invoker, err := hsm.MachineData[scheduler.Invoker](e.invokerNode)
+	require.NoError(e.T(), err)
+	invoker.PendingSkippedActions = []*schedulespb.SkippedAction{{
+		Reason: enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP,
+	}}
+	e.mockSkippedActions.EXPECT().AppendSkippedScheduleActions(gomock.Any(), gomock.Any()).
+		Return(serviceerror.NewUnavailable("unavailable"))
+
+	err = e.registry.ExecuteImmediateTask(
+		context.Background(),
+		e.env,
+		hsm.Ref{
+			WorkflowKey:     definition.NewWorkflowKey("ns-id", "wf-id", "run-id"),
+			StateMachineRef: &persistencespb.StateMachineRef{},
+		},
+		scheduler.ExecuteTask{})
+	require.Error(e.T(), err)
+	require.Len(e.T(), invoker.GetPendingSkippedActions(), 1)
+}
+
+func (e *invokerExecutorsSuite) expectUpstreamWorkflow(nominal time.Time, status enumspb.WorkflowExecutionStatus) {
+	e.mockFrontendClient.EXPECT().
+		DescribeSchedule(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(&workflowservice.DescribeScheduleResponse{
+			Schedule: &schedulepb.Schedule{
+				Action: &schedulepb.ScheduleAction{
+					Action: &schedulepb.ScheduleAction_StartWorkflow{
+						StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{WorkflowId: "upstream-wf"},
+					},
+				},
+			},
+		}, nil)
+	e.expectListUpstreamActions(1)
+	e.mockFrontendClient.EXPECT().
+		DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, req *workflowservice.DescribeWorkflowExecutionRequest, _ ...any) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+			require.Equal(e.T(), scheduler1.ScheduledWorkflowID("upstream-wf", nominal.Add(-upstreamOffset)), req.Execution.WorkflowId)
+			return &workflowservice.DescribeWorkflowExecutionResponse{
+				WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: status},
+			}, nil
+		})
+}
+
+// expectListUpstreamActions returns the action of the "upstream" schedule that
+// precedes the end of the queried window by upstreamOffset.
+func (e *invokerExecutorsSuite) expectListUpstreamActions(times int) {
+	e.mockFrontendClient.EXPECT().
+		ListWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Times(times).
+		DoAndReturn(func(_ context.Context, req *workflowservice.ListWorkflowExecutionsRequest, _ ...any) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+			_, end, ok := strings.Cut(req.Query, searchattribute.TemporalScheduledStartTime+" <= '")
+			require.True(e.T(), ok, req.Query)
+			nominal, err := time.Parse(time.RFC3339Nano, strings.TrimSuffix(end, "'"))
+			require.NoError(e.T(), err)
+			action := nominal.Add(-upstreamOffset)
+			return &workflowservice.ListWorkflowExecutionsResponse{
+				Executions: []*workflowpb.WorkflowExecutionInfo{{
+					Execution:        &commonpb.WorkflowExecution{WorkflowId: scheduler1.ScheduledWorkflowID("upstream-wf", action)},
+					SearchAttributes: scheduler1.ScheduledSearchAttributes(nil, "upstream", action),
+				}},
+			}, nil
+		})
+}
+
 type testCase struct {
 	TaskType string
 
@@ -714,6 +1094,8 @@ type testCase struct {
 	InitialCancelWorkflows    []*commonpb.WorkflowExecution
 	InitialTerminateWorkflows []*commonpb.WorkflowExecution
 	InitialRunningWorkflows   []*commonpb.WorkflowExecution
+	InitialDependencies       []*schedulespb.ScheduleDependency
+	InitialSkippedActions     []*schedulespb.SkippedAction
 	InitialState              enumsspb.SchedulerInvokerState
 
 	ExpectedBufferedStarts      int
@@ -735,12 +1117,14 @@ func (e *invokerExecutorsSuite) runTestCase(c *testCase) {
 	schedulerSm, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
 	require.NoError(t, err)
 	schedulerSm.Info.RunningWorkflows = c.InitialRunningWorkflows
+	schedulerSm.Dependencies = c.InitialDependencies
 
 	invoker, err := hsm.MachineData[scheduler.Invoker](e.invokerNode)
 	require.NoError(t, err)
 	invoker.BufferedStarts = c.InitialBufferedStarts
 	invoker.CancelWorkflows = c.InitialCancelWorkflows
 	invoker.TerminateWorkflows = c.InitialTerminateWorkflows
+	invoker.PendingSkippedActions = c.InitialSkippedActions
 	invoker.SetState(c.InitialState)
 	invoker.LastProcessedTime = timestamppb.New(e.env.Now())
 
@@ -774,6 +1158,20 @@ func (e *invokerExecutorsSuite) runTestCase(c *testCase) {
 	}
 }
 
+// requireSkippedActionReasons requires the Invoker's pending skipped actions to
+// have the given reasons.
+func requireSkippedActionReasons(
+	t *testing.T,
+	i scheduler.Invoker,
+	reasons ...enumsspb.ScheduleActionSkipReason,
+) {
+	var actual []enumsspb.ScheduleActionSkipReason
+	for _, action := range i.GetPendingSkippedActions() {
+		actual = append(actual, action.GetReason())
+	}
+	require.Equal(t, reasons, actual)
+}
+
 // opLogTaskMap returns a map from task type -> []hsm.Task{}.
 func (e *invokerExecutorsSuite) opLogTaskMap() map[string][]hsm.Task {
 	result := make(map[string][]hsm.Task)
@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"cmp"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -19,6 +20,9 @@ type ProcessBufferTask struct {
 // For retrying execution, use EventRetry.
 type EventEnqueue struct {
 	BufferedStarts []*schedulespb.BufferedStart
+
+	// SkippedActions are added to the Invoker's pending skipped actions.
+	SkippedActions []*schedulespb.SkippedAction
 }
 
 type processBufferResult struct {
@@ -35,6 +39,10 @@ type processBufferResult struct {
 	// Nunmber of buffered starts dropped from missing the catchup window.
 	MissedCatchupWindow int64
 
+	// Buffered starts dropped due to overlap policy or from missing the catchup window,
+	// to be persisted by the next ExecuteTask.
+	SkippedActions []*schedulespb.SkippedAction
+
 	// Number of buffered starts dropped due to the max buffer size having been exceeded.
 	// TODO - set this from Generator/Backfiller
 	BufferDropped int64
@@ -50,6 +58,21 @@ type executeResult struct {
 	// Starts that failed with a non-retryable error can be removed from the buffer.
 	FailedStarts []*schedulespb.BufferedStart
 
+	// Starts whose upstream actions haven't completed yet are kept in the buffer until their
+	// backoff time, without using up an attempt.
+	WaitingStarts []*schedulespb.BufferedStart
+
+	// Starts skipped because an upstream action failed or timed out can be removed from the buffer.
+	SkippedStarts []*schedulespb.BufferedStart
+
+	// If set, the schedule is paused with these notes, because an upstream action with the
+	// fail policy failed or timed out.
+	PauseNotes string
+
+	// Number of the Invoker's oldest pending skipped actions that were persisted, which can
+	// be removed from its state.
+	PersistedSkippedActions int
+
 	CompletedCancels    []*commonpb.WorkflowExecution
 	CompletedTerminates []*commonpb.WorkflowExecution
 }
@@ -57,11 +80,15 @@ type executeResult struct {
 // Append combines two executeResults (no deduplication is done).
 func (e executeResult) Append(o executeResult) executeResult {
 	return executeResult{
-		CompletedStarts:     append(e.CompletedStarts, o.CompletedStarts...),
-		RetryableStarts:     append(e.RetryableStarts, o.RetryableStarts...),
-		FailedStarts:        append(e.FailedStarts, o.FailedStarts...),
-		CompletedCancels:    append(e.CompletedCancels, o.CompletedCancels...),
-		CompletedTerminates: append(e.CompletedTerminates, o.CompletedTerminates...),
+		CompletedStarts:         append(e.CompletedStarts, o.CompletedStarts...),
+		RetryableStarts:         append(e.RetryableStarts, o.RetryableStarts...),
+		FailedStarts:            append(e.FailedStarts, o.FailedStarts...),
+		WaitingStarts:           append(e.WaitingStarts, o.WaitingStarts...),
+		SkippedStarts:           append(e.SkippedStarts, o.SkippedStarts...),
+		PauseNotes:              cmp.Or(e.PauseNotes, o.PauseNotes),
+		PersistedSkippedActions: e.PersistedSkippedActions + o.PersistedSkippedActions,
+		CompletedCancels:        append(e.CompletedCancels, o.CompletedCancels...),
+		CompletedTerminates:     append(e.CompletedTerminates, o.CompletedTerminates...),
 	}
 }
 
@@ -152,8 +179,12 @@ func (i Invoker) tasks() (tasks []hsm.Task, err error) {
 	// and backing off starts can become eligible for an execution task.
 	readyStarts := i.getEligibleBufferedStarts()
 
-	// Add an ExecuteTask if any actions are pending execution.
-	if len(i.CancelWorkflows) > 0 || len(i.TerminateWorkflows) > 0 || len(readyStarts) > 0 {
+	// Add an ExecuteTask if any actions are pending execution, or skipped actions are
+	// pending persistence.
+	if len(i.CancelWorkflows) > 0 ||
+		len(i.TerminateWorkflows) > 0 ||
+		len(readyStarts) > 0 ||
+		len(i.PendingSkippedActions) > 0 {
 		tasks = append(tasks, ExecuteTask{})
 	}
 
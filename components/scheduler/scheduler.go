@@ -58,11 +58,14 @@ var (
 )
 
 // NewScheduler returns an initialized Scheduler state machine (without any sub
-// state machines).
+// state machines). dependencies and calendarSets are the ones split out of the
+// schedule's spec by the frontend.
 func NewScheduler(
 	namespace, namespaceID, scheduleID string,
 	sched *schedulepb.Schedule,
 	patch *schedulepb.SchedulePatch,
+	dependencies []*schedulespb.ScheduleDependency,
+	calendarSets []*schedulespb.CalendarSetReference,
 ) *Scheduler {
 	var zero time.Time
 	return &Scheduler{
@@ -85,6 +88,8 @@ func NewScheduler(
 			NamespaceId:   namespaceID,
 			ScheduleId:    scheduleID,
 			ConflictToken: scheduler.InitialConflictToken,
+			Dependencies:  dependencies,
+			CalendarSets:  calendarSets,
 		},
 		cacheConflictToken: scheduler.InitialConflictToken,
 		compiledSpec:       nil,
@@ -179,7 +184,7 @@ func (s *Scheduler) getCompiledSpec(specBuilder *scheduler.SpecBuilder) (*schedu
 
 	// Cache compiled spec.
 	if s.compiledSpec == nil {
-		cspec, err := specBuilder.NewCompiledSpec(s.Schedule.Spec)
+		cspec, err := specBuilder.NewCompiledSpecWithCalendarSets(s.Schedule.Spec, s.CalendarSets)
 		if err != nil {
 			return nil, err
 		}
@@ -190,7 +195,7 @@ func (s *Scheduler) getCompiledSpec(specBuilder *scheduler.SpecBuilder) (*schedu
 }
 
 func (s Scheduler) jitterSeed() string {
-	return fmt.Sprintf("%s-%s", s.NamespaceId, s.ScheduleId)
+	return scheduler.JitterSeed(s.NamespaceId, s.ScheduleId)
 }
 
 func (s Scheduler) identity() string {
@@ -233,10 +238,11 @@ func (s *Scheduler) updateConflictToken() {
 }
 
 // EnqueueBufferedStarts enqueues the given starts onto a scheduler tree's
-// Invoker for execution.
+// Invoker for execution, along with skipped actions for it to persist.
 func (s *Scheduler) EnqueueBufferedStarts(
 	node *hsm.Node,
 	starts []*schedulespb.BufferedStart,
+	skippedActions []*schedulespb.SkippedAction,
 ) error {
 	invokerNode, err := node.Child([]hsm.Key{InvokerMachineKey})
 	if err != nil {
@@ -245,6 +251,7 @@ func (s *Scheduler) EnqueueBufferedStarts(
 	err = hsm.MachineTransition(invokerNode, func(e Invoker) (hsm.TransitionOutput, error) {
 		return TransitionEnqueue.Apply(e, EventEnqueue{
 			BufferedStarts: starts,
+			SkippedActions: skippedActions,
 		})
 	})
 	return err
@@ -306,6 +313,9 @@ type EventRecordAction struct {
 	BufferDropped       int64
 	MissedCatchupWindow int64
 	Results             []*schedulepb.ScheduleActionResult
+
+	// If set, the schedule is paused with these notes.
+	PauseNotes string
 }
 
 // Fired when an action has been taken by the state machine scheduler and should
@@ -329,6 +339,12 @@ var TransitionRecordAction = hsm.NewTransition(
 			}
 		}
 
+		if event.PauseNotes != "" {
+			s.Schedule.State.Paused = true
+			s.Schedule.State.Notes = event.PauseNotes
+			s.updateConflictToken()
+		}
+
 		return hsm.TransitionOutput{}, nil
 	},
 )
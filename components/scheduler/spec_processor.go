@@ -4,6 +4,7 @@ import (
 	"time"
 
 	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -50,6 +51,8 @@ type (
 		NextWakeupTime time.Time
 		LastActionTime time.Time
 		BufferedStarts []*schedulespb.BufferedStart
+		// Actions that missed the catchup window, and weren't buffered.
+		SkippedActions []*schedulespb.SkippedAction
 	}
 )
 
@@ -94,6 +97,7 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 	var next scheduler1.GetNextTimeResult
 	var err error
 	var bufferedStarts []*schedulespb.BufferedStart
+	var skippedActions []*schedulespb.SkippedAction
 	for next, err = s.getNextTime(scheduler, start); err == nil && !(next.Next.IsZero() || next.Next.After(end)); next, err = s.getNextTime(scheduler, next.Next) {
 		if scheduler.Info.UpdateTime.AsTime().After(next.Next) {
 			// If we've received an update that took effect after the LastProcessedTime high
@@ -106,6 +110,11 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 				tag.NewTimeTag("now", end),
 				tag.NewTimeTag("time", next.Next))
 			s.MetricsHandler.Counter(metrics.ScheduleMissedCatchupWindow.Name()).Record(1)
+			skippedActions = append(skippedActions, &schedulespb.SkippedAction{
+				NominalTime: timestamppb.New(next.Nominal),
+				ActualTime:  timestamppb.New(next.Next),
+				Reason:      enumsspb.SCHEDULE_ACTION_SKIP_REASON_CATCHUP_WINDOW,
+			})
 
 			// TODO - update Info.MissedCatchupWindow
 			// s.Info.MissedCatchupWindow++
@@ -133,6 +142,7 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 		NextWakeupTime: next.Next,
 		LastActionTime: lastAction,
 		BufferedStarts: bufferedStarts,
+		SkippedActions: skippedActions,
 	}, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	enumspb "go.temporal.io/api/enums/v1"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/components/scheduler"
@@ -52,7 +53,7 @@ func setupSpecProcessor(t *testing.T) *testSpecProcessor {
 
 func TestProcessTimeRange_LimitedActions(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval)
 
@@ -84,7 +85,7 @@ func TestProcessTimeRange_LimitedActions(t *testing.T) {
 
 func TestProcessTimeRange_UpdateAfterHighWatermark(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil, nil)
 
 	// Below window would give 6 actions, but the update time halves that.
 	base := time.Now()
@@ -111,7 +112,7 @@ func TestProcessTimeRange_UpdateBetweenNominalAndJitter(t *testing.T) {
 		}},
 		Jitter: durationpb.New(1 * time.Hour),
 	}
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, schedule, nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, schedule, nil, nil, nil)
 
 	// Generate a start with a long jitter period.
 	base := time.Date(2025, 03, 31, 1, 0, 0, 0, time.UTC)
@@ -136,7 +137,7 @@ func TestProcessTimeRange_UpdateBetweenNominalAndJitter(t *testing.T) {
 
 func TestProcessTimeRange_CatchupWindow(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil, nil)
 
 	// When an action would fall outside of the schedule's catchup window, it should
 	// be dropped.
@@ -146,11 +147,16 @@ func TestProcessTimeRange_CatchupWindow(t *testing.T) {
 	res, err := processor.ProcessTimeRange(s, start, end, enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, "", false, nil)
 	require.NoError(t, err)
 	require.Equal(t, 5, len(res.BufferedStarts))
+	require.NotEmpty(t, res.SkippedActions)
+	for _, skipped := range res.SkippedActions {
+		require.Equal(t, enumsspb.SCHEDULE_ACTION_SKIP_REASON_CATCHUP_WINDOW, skipped.Reason)
+		require.True(t, end.Sub(skipped.ActualTime.AsTime()) > defaultCatchupWindow)
+	}
 }
 
 func TestProcessTimeRange_Limit(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval * 5)
 
@@ -167,7 +173,7 @@ func TestProcessTimeRange_Limit(t *testing.T) {
 
 func TestProcessTimeRange_OverlapPolicy(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval * 5)
 
@@ -195,7 +201,7 @@ func TestProcessTimeRange_OverlapPolicy(t *testing.T) {
 
 func TestProcessTimeRange_Basic(t *testing.T) {
 	processor := setupSpecProcessor(t)
-	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil)
+	s := *scheduler.NewScheduler(namespace, namespaceID, scheduleID, defaultSchedule(), nil, nil, nil)
 	end := time.Now()
 	start := end.Add(-defaultInterval * 5)
 
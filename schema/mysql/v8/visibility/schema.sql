@@ -49,6 +49,9 @@ CREATE TABLE executions_visibility (
   TemporalWorkerDeploymentVersion    VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>"$.TemporalWorkerDeploymentVersion"),
   TemporalWorkflowVersioningBehavior VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>"$.TemporalWorkflowVersioningBehavior"),
   TemporalWorkerDeployment           VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>"$.TemporalWorkerDeployment"),
+  TemporalCloseFailureType           VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>"$.TemporalCloseFailureType"),
+  TemporalCloseFailureMessage        TEXT            GENERATED ALWAYS AS (search_attributes->>"$.TemporalCloseFailureMessage") STORED,
+  TemporalMemoText                   TEXT            GENERATED ALWAYS AS (search_attributes->>"$.TemporalMemoText") STORED,
 
   PRIMARY KEY (namespace_id, run_id)
 );
@@ -81,6 +84,9 @@ CREATE INDEX by_temporal_scheduled_start_time ON executions_visibility (namespac
 CREATE INDEX by_temporal_scheduled_by_id      ON executions_visibility (namespace_id, TemporalScheduledById,      (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_schedule_paused      ON executions_visibility (namespace_id, TemporalSchedulePaused,     (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_namespace_division   ON executions_visibility (namespace_id, TemporalNamespaceDivision,  (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_close_failure_type   ON executions_visibility (namespace_id, TemporalCloseFailureType,   (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
+CREATE FULLTEXT INDEX by_temporal_close_failure_message ON executions_visibility (TemporalCloseFailureMessage);
+CREATE FULLTEXT INDEX by_temporal_memo_text             ON executions_visibility (TemporalMemoText);
 
 
 CREATE TABLE custom_search_attributes (
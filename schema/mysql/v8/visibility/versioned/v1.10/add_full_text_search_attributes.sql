@@ -0,0 +1,7 @@
+ALTER TABLE executions_visibility ADD COLUMN TemporalCloseFailureType    VARCHAR(255) GENERATED ALWAYS AS (search_attributes->>"$.TemporalCloseFailureType");
+ALTER TABLE executions_visibility ADD COLUMN TemporalCloseFailureMessage TEXT         GENERATED ALWAYS AS (search_attributes->>"$.TemporalCloseFailureMessage") STORED;
+ALTER TABLE executions_visibility ADD COLUMN TemporalMemoText            TEXT         GENERATED ALWAYS AS (search_attributes->>"$.TemporalMemoText") STORED;
+
+CREATE INDEX by_temporal_close_failure_type   ON executions_visibility (namespace_id, TemporalCloseFailureType,   (COALESCE(close_time, CAST('9999-12-31 23:59:59' AS DATETIME))) DESC, start_time DESC, run_id);
+CREATE FULLTEXT INDEX by_temporal_close_failure_message ON executions_visibility (TemporalCloseFailureMessage);
+CREATE FULLTEXT INDEX by_temporal_memo_text             ON executions_visibility (TemporalMemoText);
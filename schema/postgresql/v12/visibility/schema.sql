@@ -48,6 +48,9 @@ CREATE TABLE executions_visibility (
   TemporalWorkerDeploymentVersion    VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>'TemporalWorkerDeploymentVersion')          STORED,
   TemporalWorkflowVersioningBehavior VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>'TemporalWorkflowVersioningBehavior')       STORED,
   TemporalWorkerDeployment           VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>'TemporalWorkerDeployment')                 STORED,
+  TemporalCloseFailureType           VARCHAR(255)    GENERATED ALWAYS AS (search_attributes->>'TemporalCloseFailureType')                 STORED,
+  TemporalCloseFailureMessage        TSVECTOR        GENERATED ALWAYS AS ((search_attributes->>'TemporalCloseFailureMessage')::tsvector)  STORED,
+  TemporalMemoText                   TSVECTOR        GENERATED ALWAYS AS ((search_attributes->>'TemporalMemoText')::tsvector)             STORED,
 
   -- Pre-allocated custom search attributes
   Bool01          BOOLEAN         GENERATED ALWAYS AS ((search_attributes->'Bool01')::boolean)        STORED,
@@ -110,6 +113,9 @@ CREATE INDEX by_temporal_scheduled_start_time ON executions_visibility (namespac
 CREATE INDEX by_temporal_scheduled_by_id      ON executions_visibility (namespace_id, TemporalScheduledById,      (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_schedule_paused      ON executions_visibility (namespace_id, TemporalSchedulePaused,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_namespace_division   ON executions_visibility (namespace_id, TemporalNamespaceDivision,  (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_close_failure_type   ON executions_visibility (namespace_id, TemporalCloseFailureType,   (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_close_failure_message ON executions_visibility USING GIN (namespace_id, TemporalCloseFailureMessage);
+CREATE INDEX by_temporal_memo_text            ON executions_visibility USING GIN (namespace_id, TemporalMemoText);
 
 -- Indexes for the pre-allocated custom search attributes
 CREATE INDEX by_bool_01         ON executions_visibility (namespace_id, Bool01,     (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
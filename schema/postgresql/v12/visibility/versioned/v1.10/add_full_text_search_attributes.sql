@@ -0,0 +1,7 @@
+ALTER TABLE executions_visibility ADD COLUMN TemporalCloseFailureType    VARCHAR(255) GENERATED ALWAYS AS (search_attributes->>'TemporalCloseFailureType') STORED;
+ALTER TABLE executions_visibility ADD COLUMN TemporalCloseFailureMessage TSVECTOR     GENERATED ALWAYS AS ((search_attributes->>'TemporalCloseFailureMessage')::tsvector) STORED;
+ALTER TABLE executions_visibility ADD COLUMN TemporalMemoText            TSVECTOR     GENERATED ALWAYS AS ((search_attributes->>'TemporalMemoText')::tsvector) STORED;
+
+CREATE INDEX by_temporal_close_failure_type ON executions_visibility (namespace_id, TemporalCloseFailureType, (COALESCE(close_time, '9999-12-31 23:59:59')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_close_failure_message ON executions_visibility USING GIN (namespace_id, TemporalCloseFailureMessage);
+CREATE INDEX by_temporal_memo_text ON executions_visibility USING GIN (namespace_id, TemporalMemoText);
@@ -34,6 +34,9 @@ CREATE TABLE executions_visibility (
   TemporalWorkerDeploymentVersion VARCHAR(255)        GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalWorkerDeploymentVersion")),
   TemporalWorkflowVersioningBehavior VARCHAR(255)     GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalWorkflowVersioningBehavior")),
   TemporalWorkerDeployment        VARCHAR(255)        GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalWorkerDeployment")),
+  TemporalCloseFailureType        VARCHAR(255)        GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalCloseFailureType")),
+  TemporalCloseFailureMessage     TEXT                GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalCloseFailureMessage")) STORED,
+  TemporalMemoText                TEXT                GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.TemporalMemoText"))            STORED,
 
   -- Pre-allocated custom search attributes
   Bool01          BOOLEAN         GENERATED ALWAYS AS (JSON_EXTRACT(search_attributes, "$.Bool01")),
@@ -93,6 +96,7 @@ CREATE INDEX by_temporal_pause_info           ON executions_visibility (namespac
 CREATE INDEX by_temporal_worker_deployment_version ON executions_visibility (namespace_id, TemporalWorkerDeploymentVersion,  (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_workflow_versioning_behavior ON executions_visibility (namespace_id, TemporalWorkflowVersioningBehavior,  (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
 CREATE INDEX by_temporal_worker_deployment    ON executions_visibility (namespace_id, TemporalWorkerDeployment,  (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
+CREATE INDEX by_temporal_close_failure_type  ON executions_visibility (namespace_id, TemporalCloseFailureType,  (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
 
 -- Indexes for the pre-allocated custom search attributes
 CREATE INDEX by_bool_01     ON executions_visibility (namespace_id, Bool01,     (COALESCE(close_time, '9999-12-31 23:59:59+00:00')) DESC, start_time DESC, run_id);
@@ -123,6 +127,8 @@ CREATE VIRTUAL TABLE executions_visibility_fts_text USING fts5 (
   Text01,
   Text02,
   Text03,
+  TemporalCloseFailureMessage,
+  TemporalMemoText,
   content='executions_visibility',
   tokenize="unicode61 remove_diacritics 2"
 );
@@ -151,12 +157,16 @@ BEGIN
     rowid,
     Text01,
     Text02,
-    Text03
+    Text03,
+    TemporalCloseFailureMessage,
+    TemporalMemoText
   ) VALUES (
     NEW.rowid,
     NEW.Text01,
     NEW.Text02,
-    NEW.Text03
+    NEW.Text03,
+    NEW.TemporalCloseFailureMessage,
+    NEW.TemporalMemoText
   );
   -- insert into fts_keyword_list table
   INSERT INTO executions_visibility_fts_keyword_list (
@@ -188,13 +198,17 @@ BEGIN
     rowid,
     Text01,
     Text02,
-    Text03
+    Text03,
+    TemporalCloseFailureMessage,
+    TemporalMemoText
   ) VALUES (
     'delete',
     OLD.rowid,
     OLD.Text01,
     OLD.Text02,
-    OLD.Text03
+    OLD.Text03,
+    OLD.TemporalCloseFailureMessage,
+    OLD.TemporalMemoText
   );
   -- delete from fts_keyword_list table
   INSERT INTO executions_visibility_fts_keyword_list (
@@ -228,24 +242,32 @@ BEGIN
     rowid,
     Text01,
     Text02,
-    Text03
+    Text03,
+    TemporalCloseFailureMessage,
+    TemporalMemoText
   ) VALUES (
     'delete',
     OLD.rowid,
     OLD.Text01,
     OLD.Text02,
-    OLD.Text03
+    OLD.Text03,
+    OLD.TemporalCloseFailureMessage,
+    OLD.TemporalMemoText
   );
   INSERT INTO executions_visibility_fts_text (
     rowid,
     Text01,
     Text02,
-    Text03
+    Text03,
+    TemporalCloseFailureMessage,
+    TemporalMemoText
   ) VALUES (
     NEW.rowid,
     NEW.Text01,
     NEW.Text02,
-    NEW.Text03
+    NEW.Text03,
+    NEW.TemporalCloseFailureMessage,
+    NEW.TemporalMemoText
   );
   -- update fts_keyword_list table
   INSERT INTO executions_visibility_fts_keyword_list (
@@ -1,6 +1,7 @@
 package frontend
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -8,7 +9,9 @@ import (
 	"maps"
 	"math"
 	"net"
+	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -17,7 +20,9 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	replicationpb "go.temporal.io/api/replication/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
@@ -29,11 +34,14 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
@@ -52,15 +60,25 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
+	"go.temporal.io/server/common/savedquery"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/backlogmigration"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/savedqueryalert"
+	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/searchattributemigration"
+	"go.temporal.io/server/service/worker/visibilityreindex"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -68,6 +86,20 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+
+	defaultPreviewScheduleActionCount = 100
+	maxPreviewScheduleActionCount     = 1000
+
+	defaultListScheduleActionsPageSize = 100
+	maxListScheduleActionsPageSize     = 1000
+
+	defaultListCallbacksPageSize = 100
+	maxListCallbacksPageSize     = 1000
+
+	defaultNexusEndpointCircuitBreakerOverrideDuration = time.Hour
+	listNexusEndpointsPageSize                         = 100
+
+	maxAggregatePercentiles = 10
 )
 
 type (
@@ -101,9 +133,14 @@ type (
 		saManager                  searchattribute.Manager
 		saMapperProvider           searchattribute.MapperProvider
 		saValidator                *searchattribute.Validator
+		savedQueryManager          savedquery.Manager
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		timeSource                 clock.TimeSource
+		scheduleSpecBuilder        *scheduler.SpecBuilder
+		skippedActionManager       persistence.SkippedScheduleActionManager
+		namespaceHandler           *namespaceHandler
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -138,6 +175,10 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		ScheduleSpecBuilder                 *scheduler.SpecBuilder
+		ArchivalMetadata                    archiver.ArchivalMetadata
+		ArchiverProvider                    provider.ArchiverProvider
+		SkippedScheduleActionManager        persistence.SkippedScheduleActionManager
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -219,9 +260,23 @@ func NewAdminHandler(
 			),
 			args.Config.SuppressErrorSetSystemSearchAttribute,
 		),
+		savedQueryManager:    savedquery.NewManager(args.TimeSource, args.ClusterMetadataManager),
 		clusterMetadata:      args.ClusterMetadata,
 		healthServer:         args.HealthServer,
 		historyHealthChecker: historyHealthChecker,
+		timeSource:           args.TimeSource,
+		scheduleSpecBuilder:  args.ScheduleSpecBuilder,
+		skippedActionManager: args.SkippedScheduleActionManager,
+		namespaceHandler: newNamespaceHandler(
+			args.Logger,
+			args.PersistenceMetadataManager,
+			args.ClusterMetadata,
+			nsreplication.NewReplicator(args.ReplicatorNamespaceReplicationQueue, args.Logger),
+			args.ArchivalMetadata,
+			args.ArchiverProvider,
+			args.TimeSource,
+			args.Config,
+		),
 		taskCategoryRegistry: args.CategoryRegistry,
 		matchingClient:       args.matchingClient,
 	}
@@ -385,6 +440,7 @@ func (adh *AdminHandler) addSearchAttributesSQL(
 	upsertFieldToAliasMap := make(map[string]string)
 	fieldToAliasMap := resp.Config.CustomSearchAttributeAliases
 	aliasToFieldMap := util.InverseMap(fieldToAliasMap)
+	remappedFields := currentSearchAttributes.RemappedFields(resp.GetNamespaceInfo().GetId())
 	for saName, saType := range request.GetSearchAttributes() {
 		// check if alias is already in use
 		if _, ok := aliasToFieldMap[saName]; ok {
@@ -405,6 +461,9 @@ func (adh *AdminHandler) addSearchAttributesSQL(
 			}
 			if _, ok := fieldToAliasMap[fieldName]; ok {
 				cntUsed++
+			} else if _, ok := remappedFields[fieldName]; ok {
+				// fields of an ongoing search attribute migration are reserved
+				cntUsed++
 			} else if _, ok := upsertFieldToAliasMap[fieldName]; ok {
 				cntUsed++
 			} else {
@@ -1568,15 +1627,12 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 		return nil, err
 	}
 
-	var taskManager persistence.TaskManager
+	taskManager, err := adh.getTaskQueueTaskManager(request.GetMinPass())
+	if err != nil {
+		return nil, err
+	}
 	if request.GetMinPass() != 0 {
-		if adh.fairTaskManager == nil {
-			return nil, serviceerror.NewInvalidArgument("Fairness table is not available on this cluster")
-		}
-		taskManager = adh.fairTaskManager
 		request.MaxTaskId = math.MaxInt64 // required for fairness GetTasks call
-	} else {
-		taskManager = adh.taskManager
 	}
 
 	resp, err := taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
@@ -1594,12 +1650,335 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 		return nil, err
 	}
 
+	tasks := resp.Tasks
+	if request.GetFilter() != nil {
+		matcher := newTaskQueueTaskMatcher(request.GetFilter(), request.GetTaskQueueType(), adh.historyClient)
+		tasks = nil
+		for _, task := range resp.Tasks {
+			ok, err := matcher.matches(ctx, task)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
 	return &adminservice.GetTaskQueueTasksResponse{
-		Tasks:         resp.Tasks,
+		Tasks:         tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// DeleteTaskQueueTasks deletes tasks matching a filter from one page of a task queue backlog,
+// optionally adding them to another task queue first
+func (adh *AdminHandler) DeleteTaskQueueTasks(
+	ctx context.Context,
+	request *adminservice.DeleteTaskQueueTasksRequest,
+) (_ *adminservice.DeleteTaskQueueTasksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetDestinationTaskQueue() == request.GetTaskQueue() {
+		return nil, serviceerror.NewInvalidArgument("DestinationTaskQueue must differ from TaskQueue.")
+	}
+	if request.GetFilter() == nil && len(request.GetTaskIds()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("Either Filter or TaskIds must be set on request.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	taskManager, err := adh.getTaskQueueTaskManager(request.GetMinPass())
+	if err != nil {
+		return nil, err
+	}
+	maxTaskID := request.GetMaxTaskId()
+	if request.GetMinPass() != 0 || maxTaskID == 0 {
+		maxTaskID = math.MaxInt64
+	}
+
+	resp, err := taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+		NamespaceID:        namespaceID.String(),
+		TaskQueue:          request.GetTaskQueue(),
+		TaskType:           request.GetTaskQueueType(),
+		InclusiveMinTaskID: request.GetMinTaskId(),
+		ExclusiveMaxTaskID: maxTaskID,
+		InclusiveMinPass:   request.GetMinPass(),
+		Subqueue:           int(request.GetSubqueue()),
+		PageSize:           int(request.GetBatchSize()),
+		NextPageToken:      request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskIDs := make(map[int64]struct{}, len(request.GetTaskIds()))
+	for _, taskID := range request.GetTaskIds() {
+		taskIDs[taskID] = struct{}{}
+	}
+	matcher := newTaskQueueTaskMatcher(request.GetFilter(), request.GetTaskQueueType(), adh.historyClient)
+	var tasks []*persistencespb.AllocatedTaskInfo
+	for _, task := range resp.Tasks {
+		if _, ok := taskIDs[task.GetTaskId()]; len(taskIDs) > 0 && !ok {
+			continue
+		}
+		ok, err := matcher.matches(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			tasks = append(tasks, task)
+		}
+	}
+
+	if !request.GetDryRun() && len(tasks) > 0 {
+		if request.GetDestinationTaskQueue() != "" {
+			// Tasks are added before they are deleted, so a failure part way through may
+			// leave duplicates but never loses a task. History rejects duplicate starts.
+			for _, task := range tasks {
+				if err := adh.addTaskToTaskQueue(ctx, request.GetDestinationTaskQueue(), request.GetTaskQueueType(), task); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := taskManager.CompleteTasks(ctx, &persistence.CompleteTasksRequest{
+			NamespaceID:   namespaceID.String(),
+			TaskQueueName: request.GetTaskQueue(),
+			TaskType:      request.GetTaskQueueType(),
+			Subqueue:      int(request.GetSubqueue()),
+			Tasks:         tasks,
+		}); err != nil {
+			return nil, err
+		}
+		adh.logger.Info("Deleted task queue tasks",
+			tag.WorkflowNamespace(request.GetNamespace()),
+			tag.WorkflowTaskQueueName(request.GetTaskQueue()),
+			tag.WorkflowTaskQueueType(request.GetTaskQueueType()),
+			tag.Counter(len(tasks)),
+		)
+	}
+
+	return &adminservice.DeleteTaskQueueTasksResponse{
+		Tasks:         tasks,
 		NextPageToken: resp.NextPageToken,
 	}, nil
 }
 
+func (adh *AdminHandler) getTaskQueueTaskManager(minPass int64) (persistence.TaskManager, error) {
+	if minPass == 0 {
+		return adh.taskManager, nil
+	}
+	if adh.fairTaskManager == nil {
+		return nil, serviceerror.NewInvalidArgument("Fairness table is not available on this cluster")
+	}
+	return adh.fairTaskManager, nil
+}
+
+func (adh *AdminHandler) addTaskToTaskQueue(
+	ctx context.Context,
+	taskQueue string,
+	taskType enumspb.TaskQueueType,
+	task *persistencespb.AllocatedTaskInfo,
+) error {
+	data := task.GetData()
+	var scheduleToStartTimeout *durationpb.Duration
+	if expiry := data.GetExpiryTime(); expiry != nil && expiry.AsTime().Unix() > 0 {
+		remaining := time.Until(expiry.AsTime())
+		if remaining <= 0 {
+			// already expired, matching would drop it anyway
+			return nil
+		}
+		scheduleToStartTimeout = durationpb.New(remaining)
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: data.GetWorkflowId(),
+		RunId:      data.GetRunId(),
+	}
+	destination := &taskqueuepb.TaskQueue{
+		Name: taskQueue,
+		Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+	}
+
+	switch taskType {
+	case enumspb.TASK_QUEUE_TYPE_ACTIVITY:
+		_, err := adh.matchingClient.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destination,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  data.GetClock(),
+			VersionDirective:       data.GetVersionDirective(),
+			Stamp:                  data.GetStamp(),
+			Priority:               data.GetPriority(),
+		})
+		return err
+	case enumspb.TASK_QUEUE_TYPE_WORKFLOW:
+		_, err := adh.matchingClient.AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:            data.GetNamespaceId(),
+			Execution:              execution,
+			TaskQueue:              destination,
+			ScheduledEventId:       data.GetScheduledEventId(),
+			ScheduleToStartTimeout: scheduleToStartTimeout,
+			Clock:                  data.GetClock(),
+			VersionDirective:       data.GetVersionDirective(),
+			Priority:               data.GetPriority(),
+		})
+		return err
+	default:
+		return serviceerror.NewInvalidArgumentf("Tasks of type %v cannot be moved.", taskType)
+	}
+}
+
+// StartTaskQueueBacklogMigration starts the system workflow that moves the backlog of a task queue to another task queue
+func (adh *AdminHandler) StartTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.StartTaskQueueBacklogMigrationRequest,
+) (_ *adminservice.StartTaskQueueBacklogMigrationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetSourceTaskQueue()) == 0 || len(request.GetDestinationTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetSourceTaskQueue() == request.GetDestinationTaskQueue() {
+		return nil, serviceerror.NewInvalidArgument("DestinationTaskQueue must differ from SourceTaskQueue.")
+	}
+	if request.GetBatchSize() < 0 || request.GetBatchSize() > backlogmigration.MaxBatchSize {
+		return nil, serviceerror.NewInvalidArgumentf("BatchSize must be between 0 and %d.", backlogmigration.MaxBatchSize)
+	}
+	if request.GetTasksPerSecond() < 0 {
+		return nil, serviceerror.NewInvalidArgument("TasksPerSecond must not be negative.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := backlogmigration.WorkflowID(request.GetNamespace(), request.GetSourceTaskQueue())
+	client := adh.sdkClientFactory.GetSystemClient()
+	run, err := client.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:                                       workflowID,
+		TaskQueue:                                primitives.DefaultWorkerTaskQueue,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}, backlogmigration.WorkflowName, backlogmigration.WorkflowParams{
+		Namespace:            request.GetNamespace(),
+		NamespaceID:          namespaceID.String(),
+		SourceTaskQueue:      request.GetSourceTaskQueue(),
+		DestinationTaskQueue: request.GetDestinationTaskQueue(),
+		BatchSize:            int(request.GetBatchSize()),
+		TasksPerSecond:       float64(request.GetTasksPerSecond()),
+		Reason:               request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartTaskQueueBacklogMigrationResponse{
+		WorkflowId: workflowID,
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeTaskQueueBacklogMigration returns the progress of the latest backlog migration of a task queue
+func (adh *AdminHandler) DescribeTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueBacklogMigrationRequest,
+) (_ *adminservice.DescribeTaskQueueBacklogMigrationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetSourceTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	workflowID := backlogmigration.WorkflowID(request.GetNamespace(), request.GetSourceTaskQueue())
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	response, err := client.QueryWorkflow(ctx, workflowID, runID, backlogmigration.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress backlogmigration.Progress
+	if err := response.Get(&progress); err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeTaskQueueBacklogMigrationResponse{
+		DestinationTaskQueue: progress.DestinationTaskQueue,
+		Status:               execution.GetWorkflowExecutionInfo().GetStatus(),
+		TasksMigrated:        progress.TasksMigrated,
+		BacklogsTotal:        int32(progress.BacklogsTotal),
+		BacklogsDrained:      int32(progress.BacklogsDrained),
+		StartTime:            execution.GetWorkflowExecutionInfo().GetStartTime(),
+		CloseTime:            execution.GetWorkflowExecutionInfo().GetCloseTime(),
+	}, nil
+}
+
+// CancelTaskQueueBacklogMigration terminates a running backlog migration of a task queue
+func (adh *AdminHandler) CancelTaskQueueBacklogMigration(
+	ctx context.Context,
+	request *adminservice.CancelTaskQueueBacklogMigrationRequest,
+) (_ *adminservice.CancelTaskQueueBacklogMigrationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetSourceTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+
+	workflowID := backlogmigration.WorkflowID(request.GetNamespace(), request.GetSourceTaskQueue())
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	if execution.GetWorkflowExecutionInfo().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return &adminservice.CancelTaskQueueBacklogMigrationResponse{Canceled: false}, nil
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	// Cancel rather than terminate, so that the workflow removes the pause it set on the source task queue.
+	if err := client.CancelWorkflow(ctx, workflowID, runID); err != nil {
+		return nil, err
+	}
+	adh.logger.Info("Canceled task queue backlog migration.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.WorkflowTaskQueueName(request.GetSourceTaskQueue()),
+		tag.NewStringTag("reason", request.GetReason()),
+	)
+	return &adminservice.CancelTaskQueueBacklogMigrationResponse{Canceled: true}, nil
+}
+
 // DescribeTaskQueuePartition returns information for a given task queue partition of the task queue
 func (adh *AdminHandler) DescribeTaskQueuePartition(
 	ctx context.Context,
@@ -1673,6 +2052,1585 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// UpdateTaskQueueFairnessWeights sets fairness key weight overrides and the default fairness weight of a task queue
+func (adh *AdminHandler) UpdateTaskQueueFairnessWeights(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueFairnessWeightsRequest,
+) (_ *adminservice.UpdateTaskQueueFairnessWeightsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument("TaskQueueType is not set on request.")
+	}
+	for key, weight := range request.GetSetOverrides() {
+		if len(key) == 0 {
+			return nil, serviceerror.NewInvalidArgument("Fairness key of a weight override must not be empty.")
+		}
+		if !(weight > 0) || math.IsInf(float64(weight), 0) {
+			return nil, serviceerror.NewInvalidArgumentf("Weight of fairness key %q must be positive.", key)
+		}
+	}
+	if defaultWeight := request.GetDefaultWeight(); defaultWeight < 0 || math.IsNaN(float64(defaultWeight)) || math.IsInf(float64(defaultWeight), 0) {
+		return nil, serviceerror.NewInvalidArgument("DefaultWeight must not be negative.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.UpdateFairnessWeights(ctx, &matchingservice.UpdateFairnessWeightsRequest{
+		NamespaceId:         namespaceID.String(),
+		TaskQueue:           request.GetTaskQueue(),
+		TaskQueueType:       request.GetTaskQueueType(),
+		SetOverrides:        request.GetSetOverrides(),
+		UnsetOverrides:      request.GetUnsetOverrides(),
+		UpdateDefaultWeight: request.GetUpdateDefaultWeight(),
+		DefaultWeight:       request.GetDefaultWeight(),
+		Identity:            request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateTaskQueueFairnessWeightsResponse{
+		FairnessWeights: resp.GetFairnessWeights(),
+	}, nil
+}
+
+// DescribeTaskQueueFairnessKeys returns the fairness keys of a task queue partition with the largest backlogs and dispatch rates
+func (adh *AdminHandler) DescribeTaskQueueFairnessKeys(
+	ctx context.Context,
+	request *adminservice.DescribeTaskQueueFairnessKeysRequest,
+) (_ *adminservice.DescribeTaskQueueFairnessKeysResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueuePartition().GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetTopN() < 0 {
+		return nil, serviceerror.NewInvalidArgument("TopN must not be negative.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.DescribeFairnessKeys(ctx, &matchingservice.DescribeFairnessKeysRequest{
+		NamespaceId:        namespaceID.String(),
+		TaskQueuePartition: request.GetTaskQueuePartition(),
+		TopN:               request.GetTopN(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeTaskQueueFairnessKeysResponse{
+		TopByBacklog:      resp.GetTopByBacklog(),
+		TopByDispatchRate: resp.GetTopByDispatchRate(),
+		FairnessWeights:   resp.GetFairnessWeights(),
+	}, nil
+}
+
+// UpdateTaskQueuePause pauses or drains a task queue, or removes its pause
+func (adh *AdminHandler) UpdateTaskQueuePause(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueuePauseRequest,
+) (_ *adminservice.UpdateTaskQueuePauseResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument("TaskQueueType is not set on request.")
+	}
+	if _, ok := enumsspb.TaskQueuePauseMode_name[int32(request.GetMode())]; !ok {
+		return nil, serviceerror.NewInvalidArgumentf("Unknown pause mode %v.", request.GetMode())
+	}
+	if request.GetStartTime() != nil && request.GetEndTime() != nil &&
+		!request.GetEndTime().AsTime().After(request.GetStartTime().AsTime()) {
+		return nil, serviceerror.NewInvalidArgument("EndTime must be after StartTime.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	var pause *persistencespb.TaskQueuePause
+	if request.GetMode() != enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED {
+		pause = &persistencespb.TaskQueuePause{
+			Mode:      request.GetMode(),
+			StartTime: request.GetStartTime(),
+			EndTime:   request.GetEndTime(),
+			Reason:    request.GetReason(),
+		}
+	}
+	resp, err := adh.matchingClient.UpdateTaskQueuePause(ctx, &matchingservice.UpdateTaskQueuePauseRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     request.GetTaskQueue(),
+		TaskQueueType: request.GetTaskQueueType(),
+		Pause:         pause,
+		Identity:      request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateTaskQueuePauseResponse{
+		Pause: resp.GetPause(),
+	}, nil
+}
+
+// UpdateTaskQueueBlockedPollers blocks poller identities from receiving tasks from a task queue, or unblocks them
+func (adh *AdminHandler) UpdateTaskQueueBlockedPollers(
+	ctx context.Context,
+	request *adminservice.UpdateTaskQueueBlockedPollersRequest,
+) (_ *adminservice.UpdateTaskQueueBlockedPollersResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetTaskQueue()) == 0 {
+		return nil, errTaskQueueNotSet
+	}
+	if request.GetTaskQueueType() == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return nil, serviceerror.NewInvalidArgument("TaskQueueType is not set on request.")
+	}
+	if slices.Contains(request.GetBlockIdentities(), "") || slices.Contains(request.GetUnblockIdentities(), "") {
+		return nil, serviceerror.NewInvalidArgument("Poller identities must not be empty.")
+	}
+	if len(request.GetBlockIdentities()) > 0 && request.GetBlockDuration().AsDuration() <= 0 {
+		return nil, serviceerror.NewInvalidArgument("BlockDuration must be positive.")
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.UpdateBlockedPollers(ctx, &matchingservice.UpdateBlockedPollersRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskQueue:         request.GetTaskQueue(),
+		TaskQueueType:     request.GetTaskQueueType(),
+		BlockIdentities:   request.GetBlockIdentities(),
+		BlockDuration:     request.GetBlockDuration(),
+		Reason:            request.GetReason(),
+		UnblockIdentities: request.GetUnblockIdentities(),
+		Identity:          request.GetIdentity(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateTaskQueueBlockedPollersResponse{
+		BlockedPollers: resp.GetBlockedPollers(),
+	}, nil
+}
+
+// CountWorkers counts the workers of a namespace that match a query, optionally grouped by worker properties
+func (adh *AdminHandler) CountWorkers(
+	ctx context.Context,
+	request *adminservice.CountWorkersRequest,
+) (_ *adminservice.CountWorkersResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.CountWorkers(ctx, &matchingservice.CountWorkersRequest{
+		NamespaceId: namespaceID.String(),
+		Query:       request.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]*adminservice.CountWorkersResponse_AggregationGroup, len(resp.GetGroups()))
+	for i, group := range resp.GetGroups() {
+		groups[i] = &adminservice.CountWorkersResponse_AggregationGroup{
+			GroupValues: group.GetGroupValues(),
+			Count:       group.GetCount(),
+		}
+	}
+	return &adminservice.CountWorkersResponse{
+		Count:  resp.GetCount(),
+		Groups: groups,
+	}, nil
+}
+
+// PreviewSchedule returns the actions that a schedule would take in a time range, computed like the schedulers do
+func (adh *AdminHandler) PreviewSchedule(
+	_ context.Context,
+	request *adminservice.PreviewScheduleRequest,
+) (_ *adminservice.PreviewScheduleResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	startTime := request.GetStartTime().AsTime()
+	endTime := request.GetEndTime().AsTime()
+	if request.GetStartTime() == nil || request.GetEndTime() == nil || !endTime.After(startTime) {
+		return nil, errInvalidTimeRange
+	}
+
+	ns, err := adh.namespaceRegistry.GetNamespace(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	spec, calendarSets, err := scheduler.SplitCalendarSetReferences(request.GetSpec(), ns.GetScheduleCalendarSet)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+	}
+	// Dependencies don't change the action times, only whether the actions run.
+	spec, _, err = scheduler.SplitScheduleDependencies(spec, "")
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+	}
+	if spec == nil {
+		spec = &schedulepb.ScheduleSpec{}
+	}
+	cspec, err := adh.scheduleSpecBuilder.NewCompiledSpecWithCalendarSets(spec, calendarSets)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+	}
+
+	maxActions := int(request.GetMaximumActionCount())
+	if maxActions <= 0 {
+		maxActions = defaultPreviewScheduleActionCount
+	}
+	maxActions = min(maxActions, maxPreviewScheduleActionCount)
+
+	jitterSeed := ""
+	if request.GetScheduleId() != "" {
+		jitterSeed = scheduler.JitterSeed(ns.ID().String(), request.GetScheduleId())
+	}
+	actions, truncated := scheduler.PreviewActions(cspec, scheduler.PreviewParams{
+		JitterSeed:  jitterSeed,
+		Policies:    request.GetPolicies(),
+		Start:       startTime,
+		End:         endTime,
+		Now:         adh.timeSource.Now().UTC(),
+		RunDuration: request.GetRunDuration().AsDuration(),
+		MaxActions:  maxActions,
+	})
+
+	resp := &adminservice.PreviewScheduleResponse{
+		CanonicalSpec: cspec.CanonicalForm(),
+		Actions:       make([]*adminservice.PreviewScheduleResponse_Action, len(actions)),
+		Truncated:     truncated,
+	}
+	for i, action := range actions {
+		resp.Actions[i] = &adminservice.PreviewScheduleResponse_Action{
+			NominalTime:         timestamppb.New(action.Nominal),
+			ActualTime:          timestamppb.New(action.Actual),
+			OverlapSkipped:      action.OverlapSkipped,
+			CancelsRunning:      action.CancelsRunning,
+			MissedCatchupWindow: action.MissedCatchupWindow,
+		}
+		if !action.Start.IsZero() {
+			resp.Actions[i].StartTime = timestamppb.New(action.Start)
+		}
+	}
+	return resp, nil
+}
+
+// ListScheduleActions lists the workflows started by a schedule from visibility, and the actions it skipped from
+// persistence. Both are paginated with the same page token.
+func (adh *AdminHandler) ListScheduleActions(
+	ctx context.Context,
+	request *adminservice.ListScheduleActionsRequest,
+) (_ *adminservice.ListScheduleActionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetScheduleId()) == 0 {
+		return nil, errScheduleIDNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListScheduleActionsPageSize
+	}
+	pageSize = min(pageSize, maxListScheduleActionsPageSize)
+
+	var token tokenspb.ListScheduleActionsNextPageToken
+	if len(request.GetNextPageToken()) > 0 {
+		if err := token.Unmarshal(request.GetNextPageToken()); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidListScheduleActionsToken, err)
+		}
+	}
+	nextToken := &tokenspb.ListScheduleActionsNextPageToken{
+		StartedActionsDone: token.GetStartedActionsDone(),
+		SkippedActionsDone: token.GetSkippedActionsDone(),
+	}
+	resp := &adminservice.ListScheduleActionsResponse{}
+
+	if !token.GetStartedActionsDone() {
+		listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   namespaceID,
+			Namespace:     namespaceName,
+			PageSize:      pageSize,
+			NextPageToken: token.GetStartedActionsToken(),
+			Query: fmt.Sprintf("%s = '%s'",
+				searchattribute.TemporalScheduledById,
+				strings.ReplaceAll(request.GetScheduleId(), "'", "\\'"),
+			),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, execution := range listResp.Executions {
+			action := &adminservice.ListScheduleActionsResponse_StartedAction{
+				WorkflowId: execution.GetExecution().GetWorkflowId(),
+				RunId:      execution.GetExecution().GetRunId(),
+				Status:     execution.GetStatus(),
+				StartTime:  execution.GetStartTime(),
+				CloseTime:  execution.GetCloseTime(),
+			}
+			if p := execution.GetSearchAttributes().GetIndexedFields()[searchattribute.TemporalScheduledStartTime]; p != nil {
+				nominalTime, err := searchattribute.DecodeValue(p, enumspb.INDEXED_VALUE_TYPE_DATETIME, false)
+				if t, ok := nominalTime.(time.Time); err == nil && ok {
+					action.NominalTime = timestamppb.New(t)
+				}
+			}
+			resp.StartedActions = append(resp.StartedActions, action)
+		}
+		nextToken.StartedActionsToken = listResp.NextPageToken
+		nextToken.StartedActionsDone = len(listResp.NextPageToken) == 0
+	}
+
+	if !token.GetSkippedActionsDone() {
+		readResp, err := adh.skippedActionManager.ReadSkippedScheduleActions(ctx, &persistence.ReadSkippedScheduleActionsRequest{
+			NamespaceID:   namespaceID.String(),
+			ScheduleID:    request.GetScheduleId(),
+			PageSize:      pageSize,
+			NextPageToken: token.GetSkippedActionsToken(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.SkippedActions = readResp.SkippedActions
+		nextToken.SkippedActionsToken = readResp.NextPageToken
+		nextToken.SkippedActionsDone = len(readResp.NextPageToken) == 0
+	}
+
+	if !nextToken.StartedActionsDone || !nextToken.SkippedActionsDone {
+		if resp.NextPageToken, err = nextToken.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// UpdateScheduleCalendarSet creates, replaces or deletes a calendar set of a namespace. The sets are stored in the
+// namespace config and replicated with it.
+func (adh *AdminHandler) UpdateScheduleCalendarSet(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleCalendarSetRequest,
+) (_ *adminservice.UpdateScheduleCalendarSetResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetName()) == 0 {
+		return nil, errCalendarSetNameNotSet
+	}
+	if err := scheduler.ValidateCalendarSetDays(request.GetDays()); err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	err = adh.namespaceHandler.UpdateScheduleCalendarSet(
+		ctx,
+		request.GetNamespace(),
+		request.GetName(),
+		request.GetDays(),
+		request.GetIdentity(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateScheduleCalendarSetResponse{}, nil
+}
+
+// ListScheduleCalendarSets returns the calendar sets of a namespace.
+func (adh *AdminHandler) ListScheduleCalendarSets(
+	ctx context.Context,
+	request *adminservice.ListScheduleCalendarSetsRequest,
+) (_ *adminservice.ListScheduleCalendarSetsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	getNamespaceResponse, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{
+		Name: request.GetNamespace(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListScheduleCalendarSetsResponse{
+		CalendarSets: getNamespaceResponse.Namespace.GetConfig().GetScheduleCalendarSets(),
+	}, nil
+}
+
+// ListCallbacks lists the callbacks of the workflows matching a visibility query
+func (adh *AdminHandler) ListCallbacks(
+	ctx context.Context,
+	request *adminservice.ListCallbacksRequest,
+) (_ *adminservice.ListCallbacksResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultListCallbacksPageSize
+	}
+	pageSize = min(pageSize, maxListCallbacksPageSize)
+
+	listResp, err := adh.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   namespaceID,
+		Namespace:     namespaceName,
+		PageSize:      pageSize,
+		NextPageToken: request.GetNextPageToken(),
+		Query:         request.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.ListCallbacksResponse{
+		NextPageToken: listResp.NextPageToken,
+	}
+	for _, execution := range listResp.Executions {
+		msResp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+			NamespaceId:     namespaceID.String(),
+			Execution:       execution.GetExecution(),
+			SkipForceReload: true,
+		})
+		if common.IsNotFoundError(err) {
+			// The workflow was deleted after it was listed.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		machines := describedMutableState(msResp).GetExecutionInfo().GetSubStateMachinesByType()[callbacks.StateMachineType].GetMachinesById()
+		for _, id := range slices.Sorted(maps.Keys(machines)) {
+			info := &persistencespb.CallbackInfo{}
+			if err := proto.Unmarshal(machines[id].GetData(), info); err != nil {
+				return nil, serviceerror.NewInternalf("failed to deserialize callback %s: %v", id, err)
+			}
+			if len(request.GetStates()) > 0 && !slices.Contains(request.GetStates(), info.GetState()) {
+				continue
+			}
+			resp.Callbacks = append(resp.Callbacks, &adminservice.ListCallbacksResponse_Callback{
+				Execution:  execution.GetExecution(),
+				CallbackId: id,
+				Info:       info,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// RetryCallback schedules a failed callback to be delivered again
+func (adh *AdminHandler) RetryCallback(
+	ctx context.Context,
+	request *adminservice.RetryCallbackRequest,
+) (_ *adminservice.RetryCallbackResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := validateExecution(request.GetExecution()); err != nil {
+		return nil, err
+	}
+	if len(request.GetCallbackId()) == 0 {
+		return nil, errCallbackIDNotSet
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := adh.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.GetExecution(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ms := describedMutableState(msResp)
+	node, ok := ms.GetExecutionInfo().GetSubStateMachinesByType()[callbacks.StateMachineType].GetMachinesById()[request.GetCallbackId()]
+	if !ok {
+		return nil, serviceerror.NewNotFoundf("callback %s not found", request.GetCallbackId())
+	}
+
+	// Reference the current version of the callback, like the tasks of the callback do.
+	ref := &persistencespb.StateMachineRef{
+		Path: []*persistencespb.StateMachineKey{{
+			Type: callbacks.StateMachineType,
+			Id:   request.GetCallbackId(),
+		}},
+		MachineInitialVersionedTransition:    node.GetInitialVersionedTransition(),
+		MachineLastUpdateVersionedTransition: node.GetLastUpdateVersionedTransition(),
+	}
+	if transitionHistory := ms.GetExecutionInfo().GetTransitionHistory(); len(transitionHistory) > 0 {
+		ref.MutableStateVersionedTransition = transitionHistory[len(transitionHistory)-1]
+	}
+
+	_, err = adh.historyClient.InvokeStateMachineMethod(ctx, &historyservice.InvokeStateMachineMethodRequest{
+		NamespaceId: namespaceID.String(),
+		WorkflowId:  request.GetExecution().GetWorkflowId(),
+		RunId:       ms.GetExecutionState().GetRunId(),
+		Ref:         ref,
+		MethodName:  callbacks.RetryMethodName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RetryCallbackResponse{}, nil
+}
+
+// describedMutableState returns the most recent mutable state of a DescribeMutableState response.
+func describedMutableState(resp *historyservice.DescribeMutableStateResponse) *persistencespb.WorkflowMutableState {
+	if resp.GetCacheMutableState() != nil {
+		return resp.GetCacheMutableState()
+	}
+	return resp.GetDatabaseMutableState()
+}
+
+// GetNexusEndpoint returns a Nexus endpoint along with the health of calls to it, aggregated over all history hosts
+func (adh *AdminHandler) GetNexusEndpoint(
+	ctx context.Context,
+	request *adminservice.GetNexusEndpointRequest,
+) (_ *adminservice.GetNexusEndpointResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetEndpoint() == "" {
+		return nil, errEndpointNotSet
+	}
+
+	entry, err := adh.getNexusEndpointByName(ctx, request.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	health, failedHosts, err := adh.getNexusEndpointHealth(ctx, []string{request.GetEndpoint()})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.GetNexusEndpointResponse{
+		Entry:       entry,
+		Health:      health[request.GetEndpoint()],
+		FailedHosts: failedHosts,
+	}, nil
+}
+
+// ListNexusEndpoints lists Nexus endpoints along with the health of calls to them, aggregated over all history hosts
+func (adh *AdminHandler) ListNexusEndpoints(
+	ctx context.Context,
+	request *adminservice.ListNexusEndpointsRequest,
+) (_ *adminservice.ListNexusEndpointsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = listNexusEndpointsPageSize
+	}
+
+	resp, err := adh.matchingClient.ListNexusEndpoints(ctx, &matchingservice.ListNexusEndpointsRequest{
+		NextPageToken: request.GetNextPageToken(),
+		PageSize:      pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &adminservice.ListNexusEndpointsResponse{NextPageToken: resp.GetNextPageToken()}
+	if len(resp.GetEntries()) == 0 {
+		return response, nil
+	}
+
+	names := make([]string, len(resp.GetEntries()))
+	for i, entry := range resp.GetEntries() {
+		names[i] = entry.GetEndpoint().GetSpec().GetName()
+	}
+	health, failedHosts, err := adh.getNexusEndpointHealth(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	response.FailedHosts = failedHosts
+	for i, entry := range resp.GetEntries() {
+		response.Endpoints = append(response.Endpoints, &adminservice.ListNexusEndpointsResponse_Endpoint{
+			Entry:  entry,
+			Health: health[names[i]],
+		})
+	}
+	return response, nil
+}
+
+// getNexusEndpointHealth aggregates the health of calls to the given endpoints over all history hosts. Every endpoint
+// has an entry in the result, closed with no calls if it wasn't called recently.
+func (adh *AdminHandler) getNexusEndpointHealth(
+	ctx context.Context,
+	names []string,
+) (map[string]*adminservice.NexusEndpointHealth, []string, error) {
+	type endpointHealth struct {
+		*adminservice.NexusEndpointHealth
+		latencyBuckets map[time.Duration]int64
+	}
+	endpoints := make(map[string]*endpointHealth, len(names))
+	for _, name := range names {
+		endpoints[name] = &endpointHealth{
+			NexusEndpointHealth: &adminservice.NexusEndpointHealth{
+				CircuitBreakerState: enumsspb.CIRCUIT_BREAKER_STATE_CLOSED,
+			},
+			latencyBuckets: make(map[time.Duration]int64),
+		}
+	}
+
+	var mu sync.Mutex
+	failedHosts, err := adh.forEachHistoryHost(ctx, func(ctx context.Context, hostAddress string) error {
+		resp, err := adh.historyClient.GetOutboundDestinationHealth(ctx, &historyservice.GetOutboundDestinationHealthRequest{
+			HostAddress:  hostAddress,
+			Destinations: names,
+		})
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, destination := range resp.GetDestinations() {
+			// Callbacks share the outbound queue but their destinations are URLs rather than endpoints.
+			if destination.GetTaskGroup() != nexusoperations.TaskTypeInvocation &&
+				destination.GetTaskGroup() != nexusoperations.TaskTypeCancelation {
+				continue
+			}
+			endpoint, ok := endpoints[destination.GetDestination()]
+			if !ok {
+				continue
+			}
+			endpoint.SuccessCount += destination.GetSuccessCount()
+			endpoint.FailureCount += destination.GetFailureCount()
+			for _, bucket := range destination.GetLatencyBuckets() {
+				// The unbounded bucket is keyed by -1 so it sorts first, see latencyPercentile.
+				bound := time.Duration(-1)
+				if bucket.GetUpperBound() != nil {
+					bound = bucket.GetUpperBound().AsDuration()
+				}
+				endpoint.latencyBuckets[bound] += bucket.GetCount()
+			}
+			nsName := destination.GetNamespaceId()
+			if name, err := adh.namespaceRegistry.GetNamespaceName(namespace.ID(nsName)); err == nil {
+				nsName = name.String()
+			}
+			endpoint.Circuits = append(endpoint.Circuits, &adminservice.NexusEndpointHealth_Circuit{
+				HostAddress: hostAddress,
+				Namespace:   nsName,
+				TaskGroup:   destination.GetTaskGroup(),
+				State:       destination.GetCircuitBreakerState(),
+			})
+			endpoint.CircuitBreakerState = max(endpoint.CircuitBreakerState, destination.GetCircuitBreakerState())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make(map[string]*adminservice.NexusEndpointHealth, len(endpoints))
+	for name, endpoint := range endpoints {
+		if total := endpoint.SuccessCount + endpoint.FailureCount; total > 0 {
+			endpoint.SuccessRate = float64(endpoint.SuccessCount) / float64(total)
+			endpoint.LatencyP50 = latencyPercentile(endpoint.latencyBuckets, total, 0.5)
+			endpoint.LatencyP90 = latencyPercentile(endpoint.latencyBuckets, total, 0.9)
+			endpoint.LatencyP99 = latencyPercentile(endpoint.latencyBuckets, total, 0.99)
+		}
+		slices.SortFunc(endpoint.Circuits, func(a, b *adminservice.NexusEndpointHealth_Circuit) int {
+			return cmp.Or(
+				cmp.Compare(a.GetHostAddress(), b.GetHostAddress()),
+				cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+				cmp.Compare(a.GetTaskGroup(), b.GetTaskGroup()),
+			)
+		})
+		result[name] = endpoint.NexusEndpointHealth
+	}
+	return result, failedHosts, nil
+}
+
+// latencyPercentile returns the upper bound of the latency bucket that the given percentile of calls falls into.
+// Percentiles falling into the unbounded bucket, keyed by -1, are reported as the largest bound, or not at all if there
+// is no other bucket.
+func latencyPercentile(buckets map[time.Duration]int64, total int64, percentile float64) *durationpb.Duration {
+	bounds := slices.Sorted(maps.Keys(buckets))
+	rank := int64(math.Ceil(percentile * float64(total)))
+	var seen int64
+	for _, bound := range bounds {
+		if bound < 0 {
+			continue
+		}
+		seen += buckets[bound]
+		if seen >= rank {
+			return durationpb.New(bound)
+		}
+	}
+	if largest := bounds[len(bounds)-1]; largest >= 0 {
+		return durationpb.New(largest)
+	}
+	return nil
+}
+
+// SetNexusEndpointCircuitBreaker manually opens or closes the circuit breakers of a Nexus endpoint by storing an
+// override on the endpoint, which history hosts pick up through the endpoint registry
+func (adh *AdminHandler) SetNexusEndpointCircuitBreaker(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointCircuitBreakerRequest,
+) (_ *adminservice.SetNexusEndpointCircuitBreakerResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetEndpoint() == "" {
+		return nil, errEndpointNotSet
+	}
+	duration := defaultNexusEndpointCircuitBreakerOverrideDuration
+	if request.GetDuration() != nil {
+		duration = request.GetDuration().AsDuration()
+		if duration <= 0 {
+			return nil, serviceerror.NewInvalidArgument("Duration must be positive.")
+		}
+	}
+
+	entry, err := adh.getNexusEndpointByName(ctx, request.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:      entry.GetId(),
+		Version: entry.GetVersion(),
+		Spec:    entry.GetEndpoint().GetSpec(),
+		CircuitBreakerOverride: &persistencespb.NexusEndpointCircuitBreakerOverride{
+			Override:       request.GetOverride(),
+			ExpirationTime: timestamppb.New(adh.timeSource.Now().Add(duration)),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SetNexusEndpointCircuitBreakerResponse{Version: resp.GetEntry().GetVersion()}, nil
+}
+
+// SetNexusEndpointAccessPolicy replaces the allowlist of caller namespaces and workflow types of a Nexus endpoint
+func (adh *AdminHandler) SetNexusEndpointAccessPolicy(
+	ctx context.Context,
+	request *adminservice.SetNexusEndpointAccessPolicyRequest,
+) (_ *adminservice.SetNexusEndpointAccessPolicyResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetEndpoint() == "" {
+		return nil, errEndpointNotSet
+	}
+
+	accessPolicy := &persistencespb.NexusEndpointAccessPolicy{
+		AllowedWorkflowTypes: request.GetAllowedWorkflowTypes(),
+	}
+	for _, nsName := range request.GetAllowedCallerNamespaces() {
+		nsID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(nsName))
+		if err != nil {
+			return nil, err
+		}
+		accessPolicy.AllowedCallerNamespaceIds = append(accessPolicy.AllowedCallerNamespaceIds, nsID.String())
+	}
+
+	entry, err := adh.getNexusEndpointByName(ctx, request.GetEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	resp, err := adh.matchingClient.UpdateNexusEndpoint(ctx, &matchingservice.UpdateNexusEndpointRequest{
+		Id:           entry.GetId(),
+		Version:      entry.GetVersion(),
+		Spec:         entry.GetEndpoint().GetSpec(),
+		AccessPolicy: accessPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.SetNexusEndpointAccessPolicyResponse{Version: resp.GetEntry().GetVersion()}, nil
+}
+
+// getNexusEndpointByName reads the latest version of an endpoint from matching, bypassing the endpoint registry cache to
+// avoid version conflicts on update.
+func (adh *AdminHandler) getNexusEndpointByName(
+	ctx context.Context,
+	name string,
+) (*persistencespb.NexusEndpointEntry, error) {
+	var nextPageToken []byte
+	var tableVersion int64
+	for {
+		resp, err := adh.matchingClient.ListNexusEndpoints(ctx, &matchingservice.ListNexusEndpointsRequest{
+			NextPageToken:         nextPageToken,
+			PageSize:              listNexusEndpointsPageSize,
+			LastKnownTableVersion: tableVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.GetEntries() {
+			if entry.GetEndpoint().GetSpec().GetName() == name {
+				return entry, nil
+			}
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			return nil, serviceerror.NewNotFoundf("Nexus endpoint %q not found.", name)
+		}
+		nextPageToken = resp.GetNextPageToken()
+		tableVersion = resp.GetTableVersion()
+	}
+}
+
+// AggregateWorkflowExecutions returns the count, sum, average, min, max and the requested percentiles of a numeric
+// search attribute over the workflow executions matching the query.
+func (adh *AdminHandler) AggregateWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.AggregateWorkflowExecutionsRequest,
+) (_ *adminservice.AggregateWorkflowExecutionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+	if len(request.GetSearchAttribute()) == 0 {
+		return nil, serviceerror.NewInvalidArgument("Search attribute is not set on request.")
+	}
+	if len(request.GetPercentiles()) > maxAggregatePercentiles {
+		return nil, serviceerror.NewInvalidArgumentf("At most %d percentiles can be requested.", maxAggregatePercentiles)
+	}
+	for _, percentile := range request.GetPercentiles() {
+		if percentile < 0 || percentile > 100 {
+			return nil, serviceerror.NewInvalidArgumentf("Percentile %v is not between 0 and 100.", percentile)
+		}
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	aggResp, err := adh.visibilityMgr.AggregateWorkflowExecutions(ctx, &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		Query:       request.GetQuery(),
+		FieldName:   request.GetSearchAttribute(),
+		Percentiles: request.GetPercentiles(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminservice.AggregateWorkflowExecutionsResponse{
+		Count:       aggResp.Count,
+		Sum:         aggResp.Sum,
+		Avg:         aggResp.Avg,
+		Min:         aggResp.Min,
+		Max:         aggResp.Max,
+		Percentiles: make([]*adminservice.AggregateWorkflowExecutionsResponse_Percentile, len(aggResp.PercentileValues)),
+	}
+	for i, value := range aggResp.PercentileValues {
+		resp.Percentiles[i] = &adminservice.AggregateWorkflowExecutionsResponse_Percentile{
+			Percentile: request.GetPercentiles()[i],
+			Value:      value,
+		}
+	}
+	return resp, nil
+}
+
+// StartVisibilityReindex starts the system workflow that rebuilds visibility records from mutable state
+func (adh *AdminHandler) StartVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.StartVisibilityReindexRequest,
+) (_ *adminservice.StartVisibilityReindexResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetPageSize() < 0 || request.GetPageSize() > visibilityreindex.MaxPageSize {
+		return nil, serviceerror.NewInvalidArgumentf("PageSize must be between 0 and %d.", visibilityreindex.MaxPageSize)
+	}
+	if request.GetExecutionsPerSecond() < 0 {
+		return nil, serviceerror.NewInvalidArgument("ExecutionsPerSecond must not be negative.")
+	}
+	if request.GetSkipReindex() && !request.GetVerify() {
+		return nil, serviceerror.NewInvalidArgument("SkipReindex requires Verify.")
+	}
+	if len(request.GetTargetStore()) != 0 && !adh.visibilityMgr.HasStoreName(request.GetTargetStore()) {
+		return nil, serviceerror.NewInvalidArgumentf(
+			"Unknown visibility store %q, configured stores are %v.", request.GetTargetStore(), adh.visibilityMgr.GetStoreNames())
+	}
+
+	var namespaceID namespace.ID
+	if len(request.GetNamespace()) != 0 {
+		namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := adh.sdkClientFactory.GetSystemClient()
+	run, err := client.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:                                       visibilityreindex.WorkflowID,
+		TaskQueue:                                primitives.DefaultWorkerTaskQueue,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}, visibilityreindex.WorkflowName, visibilityreindex.WorkflowParams{
+		NamespaceID:         namespaceID.String(),
+		TargetStore:         request.GetTargetStore(),
+		PageSize:            int(request.GetPageSize()),
+		ExecutionsPerSecond: float64(request.GetExecutionsPerSecond()),
+		Verify:              request.GetVerify(),
+		SkipReindex:         request.GetSkipReindex(),
+		Reason:              request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartVisibilityReindexResponse{
+		WorkflowId: visibilityreindex.WorkflowID,
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeVisibilityReindex returns the progress of the latest visibility reindex
+func (adh *AdminHandler) DescribeVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.DescribeVisibilityReindexRequest,
+) (_ *adminservice.DescribeVisibilityReindexResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, visibilityreindex.WorkflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	response, err := client.QueryWorkflow(ctx, visibilityreindex.WorkflowID, runID, visibilityreindex.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress visibilityreindex.Progress
+	if err := response.Get(&progress); err != nil {
+		return nil, err
+	}
+	mismatchSamples := make([]*adminservice.DescribeVisibilityReindexResponse_Mismatch, 0, len(progress.MismatchSamples))
+	for _, mismatch := range progress.MismatchSamples {
+		mismatchSamples = append(mismatchSamples, &adminservice.DescribeVisibilityReindexResponse_Mismatch{
+			NamespaceId: mismatch.NamespaceID,
+			WorkflowId:  mismatch.WorkflowID,
+			RunId:       mismatch.RunID,
+			Reason:      mismatch.Reason,
+		})
+	}
+	unrecoverableSamples := make([]*adminservice.DescribeVisibilityReindexResponse_UnrecoverableExecution, 0, len(progress.UnrecoverableSamples))
+	for _, unrecoverable := range progress.UnrecoverableSamples {
+		unrecoverableSamples = append(unrecoverableSamples, &adminservice.DescribeVisibilityReindexResponse_UnrecoverableExecution{
+			NamespaceId: unrecoverable.NamespaceID,
+			WorkflowId:  unrecoverable.WorkflowID,
+			RunId:       unrecoverable.RunID,
+			Reason:      unrecoverable.Reason,
+		})
+	}
+	return &adminservice.DescribeVisibilityReindexResponse{
+		Status:                  execution.GetWorkflowExecutionInfo().GetStatus(),
+		TargetStore:             progress.TargetStore,
+		NumShards:               progress.NumShards,
+		ShardsReindexed:         progress.ShardsReindexed,
+		ExecutionsReindexed:     progress.ExecutionsReindexed,
+		ExecutionsSkipped:       progress.ExecutionsSkipped,
+		ShardsVerified:          progress.ShardsVerified,
+		ExecutionsVerified:      progress.ExecutionsVerified,
+		Mismatches:              progress.Mismatches,
+		MismatchSamples:         mismatchSamples,
+		StartTime:               execution.GetWorkflowExecutionInfo().GetStartTime(),
+		CloseTime:               execution.GetWorkflowExecutionInfo().GetCloseTime(),
+		ExecutionsUnrecoverable: progress.ExecutionsUnrecoverable,
+		UnrecoverableSamples:    unrecoverableSamples,
+	}, nil
+}
+
+// CancelVisibilityReindex terminates a running visibility reindex
+func (adh *AdminHandler) CancelVisibilityReindex(
+	ctx context.Context,
+	request *adminservice.CancelVisibilityReindexRequest,
+) (_ *adminservice.CancelVisibilityReindexResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, visibilityreindex.WorkflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	if execution.GetWorkflowExecutionInfo().GetStatus() != enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return &adminservice.CancelVisibilityReindexResponse{Canceled: false}, nil
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	if err := client.TerminateWorkflow(ctx, visibilityreindex.WorkflowID, runID, request.GetReason()); err != nil {
+		return nil, err
+	}
+	return &adminservice.CancelVisibilityReindexResponse{Canceled: true}, nil
+}
+
+// RenameSearchAttribute renames the alias of a custom search attribute of a namespace. Search attributes of
+// Elasticsearch are not aliased and can only be renamed with StartSearchAttributeMigration.
+func (adh *AdminHandler) RenameSearchAttribute(
+	ctx context.Context,
+	request *adminservice.RenameSearchAttributeRequest,
+) (_ *adminservice.RenameSearchAttributeResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	nsName := request.GetNamespace()
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" || request.GetNewName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Name and NewName are required.")
+	}
+	if adh.visibilityMgr.HasStoreName(elasticsearch.PersistenceName) {
+		return nil, serviceerror.NewInvalidArgument(
+			"Search attributes of Elasticsearch can't be renamed in place, use StartSearchAttributeMigration with NewName.")
+	}
+	if searchattribute.IsReserved(request.GetNewName()) {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeIsReservedMessage, request.GetNewName())
+	}
+
+	currentSearchAttributes, err := adh.saProvider.GetSearchAttributes(adh.visibilityMgr.GetIndexName(), true)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToGetSearchAttributesMessage, err)
+	}
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToCreateFrontendClientMessage, err)
+	}
+	resp, err := client.DescribeNamespace(
+		ctx,
+		&workflowservice.DescribeNamespaceRequest{Namespace: nsName},
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToGetNamespaceInfoMessage, nsName, err)
+	}
+
+	aliasToFieldMap := util.InverseMap(resp.GetConfig().GetCustomSearchAttributeAliases())
+	fieldName, ok := aliasToFieldMap[request.GetName()]
+	if !ok {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeDoesntExistMessage, request.GetName())
+	}
+	if _, ok := aliasToFieldMap[request.GetNewName()]; ok || currentSearchAttributes.IsDefined(request.GetNewName()) {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeAlreadyExistsMessage, request.GetNewName())
+	}
+	if _, ok := currentSearchAttributes.RemappedFields(resp.GetNamespaceInfo().GetId())[fieldName]; ok {
+		return nil, serviceerror.NewFailedPreconditionf(
+			"Search attribute %s is being migrated and can't be renamed.", request.GetName())
+	}
+
+	// The alias of a field can't be changed in a single update, it has to be removed first.
+	for _, alias := range []string{"", request.GetNewName()} {
+		_, err = client.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+			Namespace: nsName,
+			Config: &namespacepb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{fieldName: alias},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &adminservice.RenameSearchAttributeResponse{}, nil
+}
+
+// StartSearchAttributeMigration starts the system workflow that changes the type or the name of a custom search
+// attribute and copies the existing values
+func (adh *AdminHandler) StartSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.StartSearchAttributeMigrationRequest,
+) (_ *adminservice.StartSearchAttributeMigrationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Name is required.")
+	}
+	if request.GetPageSize() < 0 || request.GetPageSize() > searchattributemigration.MaxPageSize {
+		return nil, serviceerror.NewInvalidArgumentf("PageSize must be between 0 and %d.", searchattributemigration.MaxPageSize)
+	}
+	if _, ok := enumspb.IndexedValueType_name[int32(request.GetNewType())]; !ok {
+		return nil, serviceerror.NewInvalidArgumentf(errUnknownSearchAttributeTypeMessage, request.GetNewType())
+	}
+	if request.GetNewName() != "" && searchattribute.IsReserved(request.GetNewName()) {
+		return nil, serviceerror.NewInvalidArgumentf(errSearchAttributeIsReservedMessage, request.GetNewName())
+	}
+
+	var namespaceID namespace.ID
+	if len(request.GetNamespace()) != 0 {
+		namespaceID, err = adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := adh.sdkClientFactory.GetSystemClient()
+	run, err := client.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:                                       searchattributemigration.WorkflowID,
+		TaskQueue:                                primitives.DefaultWorkerTaskQueue,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}, searchattributemigration.WorkflowName, searchattributemigration.WorkflowParams{
+		NamespaceID:       namespaceID.String(),
+		Namespace:         request.GetNamespace(),
+		Name:              request.GetName(),
+		NewName:           request.GetNewName(),
+		NewType:           request.GetNewType(),
+		SkipInvalidValues: request.GetSkipInvalidValues(),
+		DryRun:            request.GetDryRun(),
+		PageSize:          int(request.GetPageSize()),
+		Reason:            request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.StartSearchAttributeMigrationResponse{
+		WorkflowId: searchattributemigration.WorkflowID,
+		RunId:      run.GetRunID(),
+	}, nil
+}
+
+// DescribeSearchAttributeMigration returns the progress of the latest search attribute migration
+func (adh *AdminHandler) DescribeSearchAttributeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeSearchAttributeMigrationRequest,
+) (_ *adminservice.DescribeSearchAttributeMigrationResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	client := adh.sdkClientFactory.GetSystemClient()
+	execution, err := client.DescribeWorkflowExecution(ctx, searchattributemigration.WorkflowID, "")
+	if err != nil {
+		return nil, err
+	}
+	runID := execution.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+	response, err := client.QueryWorkflow(ctx, searchattributemigration.WorkflowID, runID, searchattributemigration.QueryTypeProgress)
+	if err != nil {
+		return nil, err
+	}
+	var progress searchattributemigration.Progress
+	if err := response.Get(&progress); err != nil {
+		return nil, err
+	}
+	invalidValueSamples := make([]*adminservice.DescribeSearchAttributeMigrationResponse_InvalidValue, 0, len(progress.InvalidValueSamples))
+	for _, value := range progress.InvalidValueSamples {
+		invalidValueSamples = append(invalidValueSamples, &adminservice.DescribeSearchAttributeMigrationResponse_InvalidValue{
+			WorkflowId: value.WorkflowID,
+			RunId:      value.RunID,
+			Value:      value.Value,
+			Error:      value.Error,
+		})
+	}
+	return &adminservice.DescribeSearchAttributeMigrationResponse{
+		Status:              execution.GetWorkflowExecutionInfo().GetStatus(),
+		Namespace:           progress.Namespace,
+		Name:                progress.Name,
+		NewName:             progress.NewName,
+		Type:                progress.Type,
+		NewType:             progress.NewType,
+		Phase:               progress.Phase,
+		ExecutionsValidated: progress.ExecutionsValidated,
+		ExecutionsMigrated:  progress.ExecutionsMigrated,
+		InvalidValues:       progress.InvalidValues,
+		InvalidValueSamples: invalidValueSamples,
+		StartTime:           execution.GetWorkflowExecutionInfo().GetStartTime(),
+		CloseTime:           execution.GetWorkflowExecutionInfo().GetCloseTime(),
+	}, nil
+}
+
+// PutSavedVisibilityQuery creates or replaces a saved visibility query of a namespace and starts the workflow that
+// evaluates its alert
+func (adh *AdminHandler) PutSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.PutSavedVisibilityQueryRequest,
+) (_ *adminservice.PutSavedVisibilityQueryResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	nsName := request.GetNamespace()
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	if err := savedquery.Validate(request.GetQuery()); err != nil {
+		return nil, err
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(nsName))
+	if err != nil {
+		return nil, err
+	}
+
+	// Count the matching executions once, so that invalid queries are rejected before they are saved.
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToCreateFrontendClientMessage, err)
+	}
+	if _, err := client.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: nsName,
+		Query:     request.GetQuery().GetQuery(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := adh.savedQueryManager.PutQuery(ctx, namespaceID, request.GetQuery()); err != nil {
+		return nil, err
+	}
+	if request.GetQuery().GetAlert() == nil {
+		// A running alert workflow stops on its next evaluation.
+		return &adminservice.PutSavedVisibilityQueryResponse{}, nil
+	}
+
+	name := request.GetQuery().GetName()
+	_, err = adh.sdkClientFactory.GetSystemClient().SignalWithStartWorkflow(
+		ctx,
+		savedqueryalert.WorkflowID(namespaceID.String(), name),
+		savedqueryalert.SignalNameRefresh,
+		nil,
+		sdkclient.StartWorkflowOptions{
+			TaskQueue:                primitives.DefaultWorkerTaskQueue,
+			WorkflowIDReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			WorkflowIDConflictPolicy: enumspb.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+		},
+		savedqueryalert.WorkflowName,
+		savedqueryalert.WorkflowParams{
+			NamespaceID: namespaceID.String(),
+			Name:        name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.PutSavedVisibilityQueryResponse{}, nil
+}
+
+// DeleteSavedVisibilityQuery deletes a saved visibility query of a namespace
+func (adh *AdminHandler) DeleteSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.DeleteSavedVisibilityQueryRequest,
+) (_ *adminservice.DeleteSavedVisibilityQueryResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Name is required.")
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	query, err := adh.savedQueryManager.GetQuery(ctx, namespaceID, request.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if err := adh.savedQueryManager.DeleteQuery(ctx, namespaceID, request.GetName()); err != nil {
+		return nil, err
+	}
+
+	if query.GetAlert() != nil {
+		// Wake up the alert workflow, so that it stops right away instead of on its next evaluation.
+		err = adh.sdkClientFactory.GetSystemClient().SignalWorkflow(
+			ctx,
+			savedqueryalert.WorkflowID(namespaceID.String(), request.GetName()),
+			"",
+			savedqueryalert.SignalNameRefresh,
+			nil,
+		)
+		var notFoundErr *serviceerror.NotFound
+		if err != nil && !errors.As(err, &notFoundErr) {
+			adh.logger.Warn("Unable to signal saved query alert workflow.",
+				tag.WorkflowNamespace(request.GetNamespace()),
+				tag.Error(err),
+			)
+		}
+	}
+	return &adminservice.DeleteSavedVisibilityQueryResponse{}, nil
+}
+
+// ListSavedVisibilityQueries returns the saved visibility queries of a namespace
+func (adh *AdminHandler) ListSavedVisibilityQueries(
+	ctx context.Context,
+	request *adminservice.ListSavedVisibilityQueriesRequest,
+) (_ *adminservice.ListSavedVisibilityQueriesResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+	queries, err := adh.savedQueryManager.ListQueries(ctx, namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListSavedVisibilityQueriesResponse{Queries: queries}, nil
+}
+
+// RunSavedVisibilityQuery counts or lists the workflow executions matching a saved visibility query
+func (adh *AdminHandler) RunSavedVisibilityQuery(
+	ctx context.Context,
+	request *adminservice.RunSavedVisibilityQueryRequest,
+) (_ *adminservice.RunSavedVisibilityQueryResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	nsName := request.GetNamespace()
+	if nsName == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, serviceerror.NewInvalidArgument("Name is required.")
+	}
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(nsName))
+	if err != nil {
+		return nil, err
+	}
+	query, err := adh.savedQueryManager.GetQuery(ctx, namespaceID, request.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	// Run the query through the frontend, so that it is subject to the same rate limits and validation as any other
+	// visibility request.
+	_, client, err := adh.clientFactory.NewLocalFrontendClientWithTimeout(
+		frontend.DefaultTimeout,
+		frontend.DefaultLongPollTimeout,
+	)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef(errUnableToCreateFrontendClientMessage, err)
+	}
+	if request.GetCountOnly() {
+		resp, err := client.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: nsName,
+			Query:     query.GetQuery(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &adminservice.RunSavedVisibilityQueryResponse{Count: resp.GetCount()}, nil
+	}
+	resp, err := client.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     nsName,
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+		Query:         query.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.RunSavedVisibilityQueryResponse{
+		Executions:    resp.GetExecutions(),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+func (adh *AdminHandler) ExplainWorkflowExecutions(
+	ctx context.Context,
+	request *adminservice.ExplainWorkflowExecutionsRequest,
+) (_ *adminservice.ExplainWorkflowExecutionsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if len(request.GetNamespace()) == 0 {
+		return nil, errNamespaceNotSet
+	}
+
+	namespaceName := namespace.Name(request.GetNamespace())
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := request.GetPageSize()
+	if pageSize <= 0 {
+		pageSize = int32(adh.config.VisibilityMaxPageSize(namespaceName.String()))
+	}
+	explainResp, err := adh.visibilityMgr.ExplainWorkflowExecutions(ctx, &manager.ExplainWorkflowExecutionsRequest{
+		NamespaceID: namespaceID,
+		Namespace:   namespaceName,
+		PageSize:    int(pageSize),
+		Query:       request.GetQuery(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminservice.ExplainWorkflowExecutionsResponse{
+		Store:       adh.visibilityMgr.GetReadStoreName(namespaceName),
+		StoreQuery:  explainResp.StoreQuery,
+		Cost:        explainResp.Cost,
+		CostReasons: explainResp.CostReasons,
+		CostLimit:   explainResp.CostLimit,
+	}, nil
+}
+
+// forEachHistoryHost calls fn concurrently for every history host and returns the hosts for which it failed. An error
+// is returned if it failed for all hosts.
+func (adh *AdminHandler) forEachHistoryHost(
+	ctx context.Context,
+	fn func(ctx context.Context, hostAddress string) error,
+) ([]string, error) {
+	resolver, err := adh.membershipMonitor.GetResolver(primitives.HistoryService)
+	if err != nil {
+		return nil, err
+	}
+	hosts := resolver.AvailableMembers()
+
+	var mu sync.Mutex
+	var failedHosts []string
+	var lastErr error
+	var wg sync.WaitGroup
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(hostAddress string) {
+			defer wg.Done()
+			if err := fn(ctx, hostAddress); err != nil {
+				adh.logger.Warn("history host request failed", tag.Address(hostAddress), tag.Error(err))
+				mu.Lock()
+				failedHosts = append(failedHosts, hostAddress)
+				lastErr = err
+				mu.Unlock()
+			}
+		}(host.GetAddress())
+	}
+	wg.Wait()
+
+	if len(hosts) > 0 && len(failedHosts) == len(hosts) {
+		return nil, lastErr
+	}
+	slices.Sort(failedHosts)
+	return failedHosts, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +18,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -30,9 +32,13 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
@@ -46,20 +52,32 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/savedquery"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
 	test "go.temporal.io/server/common/testing"
 	"go.temporal.io/server/common/testing/historyrequire"
 	"go.temporal.io/server/common/testing/mocksdk"
+	"go.temporal.io/server/common/testing/protomock"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
+	"go.temporal.io/server/components/callbacks"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/backlogmigration"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/savedqueryalert"
+	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/searchattributemigration"
+	"go.temporal.io/server/service/worker/visibilityreindex"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type (
@@ -83,6 +101,7 @@ type (
 		mockProducer               *persistence.MockNamespaceReplicationQueue
 		mockMatchingClient         *matchingservicemock.MockMatchingServiceClient
 		mockSaMapper               *searchattribute.MockMapper
+		mockSkippedActionMgr       *persistence.MockSkippedScheduleActionManager
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -127,6 +146,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
 	s.mockMatchingClient = s.mockResource.MatchingClient
+	s.mockSkippedActionMgr = persistence.NewMockSkippedScheduleActionManager(s.controller)
 
 	mockSaMapperProvider := searchattribute.NewMockMapperProvider(s.controller)
 	s.mockSaMapper = searchattribute.NewMockMapper(s.controller)
@@ -144,6 +164,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		SearchAttributesTotalSizeLimit:        dynamicconfig.GetIntPropertyFnFilteredByNamespace(10),
 		VisibilityAllowList:                   dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
 		SuppressErrorSetSystemSearchAttribute: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		VisibilityMaxPageSize:                 dynamicconfig.GetIntPropertyFnFilteredByNamespace(1000),
 	}
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -172,6 +193,10 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		scheduler.NewSpecBuilder(),
+		s.mockResource.GetArchivalMetadata(),
+		s.mockResource.GetArchiverProvider(),
+		s.mockSkippedActionMgr,
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -1717,6 +1742,987 @@ func (s *adminHandlerSuite) TestForceUnloadTaskQueuePartition() {
 	s.True(resp.WasLoaded)
 }
 
+func (s *adminHandlerSuite) TestGetTaskQueueTasks_Filter() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	tasks := []*persistencespb.AllocatedTaskInfo{
+		{TaskId: 1, Data: &persistencespb.TaskInfo{NamespaceId: s.namespaceID.String(), WorkflowId: "wf-1", RunId: "run-1", ScheduledEventId: 5}},
+		{TaskId: 2, Data: &persistencespb.TaskInfo{NamespaceId: s.namespaceID.String(), WorkflowId: "wf-2", RunId: "run-2", ScheduledEventId: 5}},
+		{TaskId: 3, Data: &persistencespb.TaskInfo{NamespaceId: s.namespaceID.String(), WorkflowId: "wf-1", RunId: "run-1", ScheduledEventId: 7}},
+	}
+	s.mockResource.TaskMgr.EXPECT().GetTasks(ctx, gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: tasks}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId:     s.namespaceID.String(),
+		Execution:       &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"},
+		SkipForceReload: true,
+	}).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{WorkflowTypeName: "my-workflow"},
+			ActivityInfos: map[int64]*persistencespb.ActivityInfo{
+				5: {ActivityType: &commonpb.ActivityType{Name: "poison"}},
+				7: {ActivityType: &commonpb.ActivityType{Name: "healthy"}},
+			},
+		},
+	}, nil).Times(1)
+
+	resp, err := s.handler.GetTaskQueueTasks(ctx, &adminservice.GetTaskQueueTasksRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		MaxTaskId:     100,
+		BatchSize:     10,
+		Filter: &adminservice.TaskQueueTaskFilter{
+			WorkflowId:   "wf-1",
+			ActivityType: "poison",
+		},
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(int64(1), resp.Tasks[0].TaskId)
+}
+
+func (s *adminHandlerSuite) TestDeleteTaskQueueTasks() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.DeleteTaskQueueTasks(ctx, &adminservice.DeleteTaskQueueTasksRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "test-tq",
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Either Filter or TaskIds must be set on request."}, err)
+
+	tasks := []*persistencespb.AllocatedTaskInfo{
+		{TaskId: 1, Data: &persistencespb.TaskInfo{NamespaceId: s.namespaceID.String(), WorkflowId: "wf-1", RunId: "run-1", ScheduledEventId: 5}},
+		{TaskId: 2, Data: &persistencespb.TaskInfo{NamespaceId: s.namespaceID.String(), WorkflowId: "wf-2", RunId: "run-2", ScheduledEventId: 5}},
+	}
+	s.mockResource.TaskMgr.EXPECT().GetTasks(ctx, gomock.Any()).Return(&persistence.GetTasksResponse{Tasks: tasks}, nil).Times(2)
+
+	// dry run does not touch persistence or matching
+	resp, err := s.handler.DeleteTaskQueueTasks(ctx, &adminservice.DeleteTaskQueueTasksRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		Filter:        &adminservice.TaskQueueTaskFilter{WorkflowId: "wf-2"},
+		DryRun:        true,
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+	s.Equal(int64(2), resp.Tasks[0].TaskId)
+
+	// move adds the task to the destination before deleting it
+	gomock.InOrder(
+		s.mockMatchingClient.EXPECT().AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+			NamespaceId:      s.namespaceID.String(),
+			Execution:        &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"},
+			TaskQueue:        &taskqueuepb.TaskQueue{Name: "other-tq", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			ScheduledEventId: 5,
+		}).Return(&matchingservice.AddWorkflowTaskResponse{}, nil),
+		s.mockResource.TaskMgr.EXPECT().CompleteTasks(ctx, &persistence.CompleteTasksRequest{
+			NamespaceID:   s.namespaceID.String(),
+			TaskQueueName: "test-tq",
+			TaskType:      enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			Tasks:         tasks[1:],
+		}).Return(nil),
+	)
+	resp, err = s.handler.DeleteTaskQueueTasks(ctx, &adminservice.DeleteTaskQueueTasksRequest{
+		Namespace:            s.namespace.String(),
+		TaskQueue:            "test-tq",
+		TaskQueueType:        enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		TaskIds:              []int64{2},
+		DestinationTaskQueue: "other-tq",
+	})
+	s.NoError(err)
+	s.Len(resp.Tasks, 1)
+}
+
+func (s *adminHandlerSuite) TestStartTaskQueueBacklogMigration() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+
+	_, err := s.handler.StartTaskQueueBacklogMigration(ctx, &adminservice.StartTaskQueueBacklogMigrationRequest{
+		Namespace:            s.namespace.String(),
+		SourceTaskQueue:      "test-tq",
+		DestinationTaskQueue: "test-tq",
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "DestinationTaskQueue must differ from SourceTaskQueue."}, err)
+
+	_, err = s.handler.StartTaskQueueBacklogMigration(ctx, &adminservice.StartTaskQueueBacklogMigrationRequest{
+		Namespace:            s.namespace.String(),
+		SourceTaskQueue:      "test-tq",
+		DestinationTaskQueue: "other-tq",
+		BatchSize:            backlogmigration.MaxBatchSize + 1,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		ctx,
+		gomock.Any(),
+		backlogmigration.WorkflowName,
+		backlogmigration.WorkflowParams{
+			Namespace:            s.namespace.String(),
+			NamespaceID:          s.namespaceID.String(),
+			SourceTaskQueue:      "test-tq",
+			DestinationTaskQueue: "other-tq",
+			TasksPerSecond:       10,
+		},
+	).Return(mockRun, nil)
+	resp, err := s.handler.StartTaskQueueBacklogMigration(ctx, &adminservice.StartTaskQueueBacklogMigrationRequest{
+		Namespace:            s.namespace.String(),
+		SourceTaskQueue:      "test-tq",
+		DestinationTaskQueue: "other-tq",
+		TasksPerSecond:       10,
+	})
+	s.NoError(err)
+	s.Equal(backlogmigration.WorkflowID(s.namespace.String(), "test-tq"), resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestCancelTaskQueueBacklogMigration() {
+	ctx := context.Background()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+	workflowID := backlogmigration.WorkflowID(s.namespace.String(), "test-tq")
+	request := &adminservice.CancelTaskQueueBacklogMigrationRequest{
+		Namespace:       s.namespace.String(),
+		SourceTaskQueue: "test-tq",
+		Reason:          "test",
+	}
+
+	// already completed
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(ctx, workflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}, nil)
+	resp, err := s.handler.CancelTaskQueueBacklogMigration(ctx, request)
+	s.NoError(err)
+	s.False(resp.GetCanceled())
+
+	// running
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(ctx, workflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: "run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}, nil)
+	mockSdkClient.EXPECT().CancelWorkflow(ctx, workflowID, "run-id").Return(nil)
+	resp, err = s.handler.CancelTaskQueueBacklogMigration(ctx, request)
+	s.NoError(err)
+	s.True(resp.GetCanceled())
+}
+
+func (s *adminHandlerSuite) TestUpdateTaskQueueFairnessWeights() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.UpdateTaskQueueFairnessWeights(ctx, &adminservice.UpdateTaskQueueFairnessWeightsRequest{
+		Namespace: s.namespace.String(),
+		TaskQueue: "test-tq",
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.handler.UpdateTaskQueueFairnessWeights(ctx, &adminservice.UpdateTaskQueueFairnessWeightsRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetOverrides:  map[string]float32{"key": -1},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: `Weight of fairness key "key" must be positive.`}, err)
+
+	_, err = s.handler.UpdateTaskQueueFairnessWeights(ctx, &adminservice.UpdateTaskQueueFairnessWeightsRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetOverrides:  map[string]float32{"": 1},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	weights := &persistencespb.FairnessWeights{
+		DefaultWeight: 2,
+		Overrides:     map[string]float32{"key": 3},
+	}
+	s.mockMatchingClient.EXPECT().UpdateFairnessWeights(ctx, &matchingservice.UpdateFairnessWeightsRequest{
+		NamespaceId:         s.namespaceID.String(),
+		TaskQueue:           "test-tq",
+		TaskQueueType:       enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetOverrides:        map[string]float32{"key": 3},
+		UnsetOverrides:      []string{"other-key"},
+		UpdateDefaultWeight: true,
+		DefaultWeight:       2,
+		Identity:            "test-identity",
+	}).Return(&matchingservice.UpdateFairnessWeightsResponse{FairnessWeights: weights}, nil)
+	resp, err := s.handler.UpdateTaskQueueFairnessWeights(ctx, &adminservice.UpdateTaskQueueFairnessWeightsRequest{
+		Namespace:           s.namespace.String(),
+		TaskQueue:           "test-tq",
+		TaskQueueType:       enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		SetOverrides:        map[string]float32{"key": 3},
+		UnsetOverrides:      []string{"other-key"},
+		UpdateDefaultWeight: true,
+		DefaultWeight:       2,
+		Identity:            "test-identity",
+	})
+	s.NoError(err)
+	s.Equal(weights, resp.GetFairnessWeights())
+}
+
+func (s *adminHandlerSuite) TestUpdateTaskQueuePause() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	now := time.Now()
+
+	_, err := s.handler.UpdateTaskQueuePause(ctx, &adminservice.UpdateTaskQueuePauseRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Mode:          enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED,
+		StartTime:     timestamppb.New(now),
+		EndTime:       timestamppb.New(now),
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "EndTime must be after StartTime."}, err)
+
+	pause := &persistencespb.TaskQueuePause{
+		Mode:    enumsspb.TASK_QUEUE_PAUSE_MODE_DRAINING,
+		EndTime: timestamppb.New(now),
+		Reason:  "maintenance",
+	}
+	s.mockMatchingClient.EXPECT().UpdateTaskQueuePause(ctx, &matchingservice.UpdateTaskQueuePauseRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Pause:         pause,
+		Identity:      "test-identity",
+	}).Return(&matchingservice.UpdateTaskQueuePauseResponse{Pause: pause}, nil)
+	resp, err := s.handler.UpdateTaskQueuePause(ctx, &adminservice.UpdateTaskQueuePauseRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		Mode:          enumsspb.TASK_QUEUE_PAUSE_MODE_DRAINING,
+		EndTime:       timestamppb.New(now),
+		Reason:        "maintenance",
+		Identity:      "test-identity",
+	})
+	s.NoError(err)
+	s.Equal(pause, resp.GetPause())
+
+	// unspecified mode removes the pause
+	s.mockMatchingClient.EXPECT().UpdateTaskQueuePause(ctx, &matchingservice.UpdateTaskQueuePauseRequest{
+		NamespaceId:   s.namespaceID.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	}).Return(&matchingservice.UpdateTaskQueuePauseResponse{}, nil)
+	resp, err = s.handler.UpdateTaskQueuePause(ctx, &adminservice.UpdateTaskQueuePauseRequest{
+		Namespace:     s.namespace.String(),
+		TaskQueue:     "test-tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+	})
+	s.NoError(err)
+	s.Nil(resp.GetPause())
+}
+
+func (s *adminHandlerSuite) TestUpdateTaskQueueBlockedPollers() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.UpdateTaskQueueBlockedPollers(ctx, &adminservice.UpdateTaskQueueBlockedPollersRequest{
+		Namespace:       s.namespace.String(),
+		TaskQueue:       "test-tq",
+		TaskQueueType:   enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		BlockIdentities: []string{"bad-worker"},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "BlockDuration must be positive."}, err)
+
+	_, err = s.handler.UpdateTaskQueueBlockedPollers(ctx, &adminservice.UpdateTaskQueueBlockedPollersRequest{
+		Namespace:         s.namespace.String(),
+		TaskQueue:         "test-tq",
+		TaskQueueType:     enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		UnblockIdentities: []string{""},
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "Poller identities must not be empty."}, err)
+
+	blocked := []*persistencespb.BlockedPoller{{Identity: "bad-worker", Reason: "bad deploy"}}
+	s.mockMatchingClient.EXPECT().UpdateBlockedPollers(ctx, &matchingservice.UpdateBlockedPollersRequest{
+		NamespaceId:     s.namespaceID.String(),
+		TaskQueue:       "test-tq",
+		TaskQueueType:   enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		BlockIdentities: []string{"bad-worker"},
+		BlockDuration:   durationpb.New(time.Hour),
+		Reason:          "bad deploy",
+		Identity:        "test-identity",
+	}).Return(&matchingservice.UpdateBlockedPollersResponse{BlockedPollers: blocked}, nil)
+	resp, err := s.handler.UpdateTaskQueueBlockedPollers(ctx, &adminservice.UpdateTaskQueueBlockedPollersRequest{
+		Namespace:       s.namespace.String(),
+		TaskQueue:       "test-tq",
+		TaskQueueType:   enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+		BlockIdentities: []string{"bad-worker"},
+		BlockDuration:   durationpb.New(time.Hour),
+		Reason:          "bad deploy",
+		Identity:        "test-identity",
+	})
+	s.NoError(err)
+	s.Equal(blocked, resp.GetBlockedPollers())
+}
+
+func (s *adminHandlerSuite) TestCountWorkers() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.CountWorkers(ctx, &adminservice.CountWorkersRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	s.mockMatchingClient.EXPECT().CountWorkers(ctx, &matchingservice.CountWorkersRequest{
+		NamespaceId: s.namespaceID.String(),
+		Query:       "GROUP BY SdkVersion",
+	}).Return(&matchingservice.CountWorkersResponse{
+		Count: 3,
+		Groups: []*matchingservice.CountWorkersResponse_AggregationGroup{
+			{GroupValues: []string{"1.1"}, Count: 2},
+			{GroupValues: []string{"1.0"}, Count: 1},
+		},
+	}, nil)
+	resp, err := s.handler.CountWorkers(ctx, &adminservice.CountWorkersRequest{
+		Namespace: s.namespace.String(),
+		Query:     "GROUP BY SdkVersion",
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.GetCount())
+	s.Len(resp.GetGroups(), 2)
+	s.Equal([]string{"1.1"}, resp.GetGroups()[0].GetGroupValues())
+	s.Equal(int64(2), resp.GetGroups()[0].GetCount())
+}
+
+func (s *adminHandlerSuite) TestPreviewSchedule() {
+	ctx := context.Background()
+	nsEntry := namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		&persistencespb.NamespaceConfig{
+			ScheduleCalendarSets: map[string]*persistencespb.ScheduleCalendarSet{
+				"holidays": {Days: []string{"2027-01-06"}},
+			},
+		},
+		"",
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nsEntry, nil).AnyTimes()
+	startTime := time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	_, err := s.handler.PreviewSchedule(ctx, &adminservice.PreviewScheduleRequest{
+		Namespace: s.namespace.String(),
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(startTime),
+	})
+	s.Equal(errInvalidTimeRange, err)
+
+	_, err = s.handler.PreviewSchedule(ctx, &adminservice.PreviewScheduleRequest{
+		Namespace: s.namespace.String(),
+		Spec:      &schedulepb.ScheduleSpec{CronString: []string{"invalid"}},
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(startTime.Add(time.Hour)),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	resp, err := s.handler.PreviewSchedule(ctx, &adminservice.PreviewScheduleRequest{
+		Namespace:          s.namespace.String(),
+		Spec:               &schedulepb.ScheduleSpec{CronString: []string{"0 9 * * *"}},
+		Policies:           &schedulepb.SchedulePolicies{OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP},
+		StartTime:          timestamppb.New(startTime),
+		EndTime:            timestamppb.New(startTime.Add(72 * time.Hour)),
+		RunDuration:        durationpb.New(time.Hour),
+		MaximumActionCount: 2,
+	})
+	s.NoError(err)
+	s.True(resp.GetTruncated())
+	s.Len(resp.GetActions(), 2)
+	s.Len(resp.GetCanonicalSpec().GetStructuredCalendar(), 1)
+	s.Empty(resp.GetCanonicalSpec().GetCronString())
+	s.Equal(startTime.Add(9*time.Hour), resp.GetActions()[0].GetNominalTime().AsTime())
+	s.Equal(startTime.Add(9*time.Hour), resp.GetActions()[0].GetStartTime().AsTime())
+	s.Equal(startTime.Add(33*time.Hour), resp.GetActions()[1].GetActualTime().AsTime())
+
+	calendarSetSpec := &schedulepb.ScheduleSpec{
+		CronString: []string{"0 9 * * *"},
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Comment: "calendar-set=holidays"},
+		},
+	}
+	resp, err = s.handler.PreviewSchedule(ctx, &adminservice.PreviewScheduleRequest{
+		Namespace: s.namespace.String(),
+		Spec:      calendarSetSpec,
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(startTime.Add(72 * time.Hour)),
+	})
+	s.NoError(err)
+	s.Len(resp.GetActions(), 2)
+	s.Equal(startTime.Add(9*time.Hour), resp.GetActions()[0].GetNominalTime().AsTime())
+	s.Equal(startTime.Add(57*time.Hour), resp.GetActions()[1].GetNominalTime().AsTime())
+
+	calendarSetSpec.ExcludeStructuredCalendar[0].Comment = "calendar-set=unknown"
+	_, err = s.handler.PreviewSchedule(ctx, &adminservice.PreviewScheduleRequest{
+		Namespace: s.namespace.String(),
+		Spec:      calendarSetSpec,
+		StartTime: timestamppb.New(startTime),
+		EndTime:   timestamppb.New(startTime.Add(72 * time.Hour)),
+	})
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestUpdateScheduleCalendarSet() {
+	ctx := context.Background()
+	s.handler.config.MaxScheduleCalendarSetsPerNamespace = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+
+	_, err := s.handler.UpdateScheduleCalendarSet(ctx, &adminservice.UpdateScheduleCalendarSetRequest{
+		Namespace: s.namespace.String(),
+		Name:      "holidays",
+		Days:      []string{"2027-1-1"},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	detail := &persistencespb.NamespaceDetail{
+		Info: &persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		Config: &persistencespb.NamespaceConfig{
+			ScheduleCalendarSets: map[string]*persistencespb.ScheduleCalendarSet{
+				"holidays": {Days: []string{"2027-01-01"}},
+			},
+		},
+		ConfigVersion: 3,
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{
+		Name: s.namespace.String(),
+	}).DoAndReturn(func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+		return &persistence.GetNamespaceResponse{Namespace: common.CloneProto(detail)}, nil
+	}).AnyTimes()
+
+	// a second set is over the limit
+	_, err = s.handler.UpdateScheduleCalendarSet(ctx, &adminservice.UpdateScheduleCalendarSetRequest{
+		Namespace: s.namespace.String(),
+		Name:      "closures",
+		Days:      []string{"2027-03-01"},
+	})
+	s.ErrorAs(err, &invalidArgument)
+
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(4), request.Namespace.ConfigVersion)
+			s.Equal(int64(7), request.NotificationVersion)
+			set := request.Namespace.Config.ScheduleCalendarSets["holidays"]
+			s.Equal([]string{"2027-01-01", "2027-12-24"}, set.GetDays())
+			s.Equal("operator", set.GetUpdateIdentity())
+			return nil
+		})
+	_, err = s.handler.UpdateScheduleCalendarSet(ctx, &adminservice.UpdateScheduleCalendarSetRequest{
+		Namespace: s.namespace.String(),
+		Name:      "holidays",
+		Days:      []string{"2027-01-01", "2027-12-24"},
+		Identity:  "operator",
+	})
+	s.NoError(err)
+
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateNamespaceRequest) error {
+			s.Empty(request.Namespace.Config.ScheduleCalendarSets)
+			return nil
+		})
+	_, err = s.handler.UpdateScheduleCalendarSet(ctx, &adminservice.UpdateScheduleCalendarSetRequest{
+		Namespace: s.namespace.String(),
+		Name:      "holidays",
+	})
+	s.NoError(err)
+
+	resp, err := s.handler.ListScheduleCalendarSets(ctx, &adminservice.ListScheduleCalendarSetsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.ProtoEqual(detail.Config.ScheduleCalendarSets["holidays"], resp.GetCalendarSets()["holidays"])
+}
+
+func (s *adminHandlerSuite) TestUpdateScheduleCalendarSet_GlobalNamespace() {
+	ctx := context.Background()
+	s.handler.config.MaxScheduleCalendarSetsPerNamespace = dynamicconfig.GetIntPropertyFnFilteredByNamespace(1)
+	currentClusterName := s.mockMetadata.GetCurrentClusterName()
+	detail := &persistencespb.NamespaceDetail{
+		Info:   &persistencespb.NamespaceInfo{Name: s.namespace.String(), Id: s.namespaceID.String()},
+		Config: &persistencespb.NamespaceConfig{},
+		ReplicationConfig: &persistencespb.NamespaceReplicationConfig{
+			ActiveClusterName: cluster.TestAlternativeClusterName,
+			Clusters:          []string{currentClusterName, cluster.TestAlternativeClusterName},
+		},
+		ConfigVersion:   3,
+		FailoverVersion: 2,
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).AnyTimes()
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *persistence.GetNamespaceRequest) (*persistence.GetNamespaceResponse, error) {
+			return &persistence.GetNamespaceResponse{Namespace: common.CloneProto(detail), IsGlobalNamespace: true}, nil
+		}).AnyTimes()
+	request := &adminservice.UpdateScheduleCalendarSetRequest{
+		Namespace: s.namespace.String(),
+		Name:      "holidays",
+		Days:      []string{"2027-01-01"},
+	}
+
+	// the namespace is active in another cluster
+	_, err := s.handler.UpdateScheduleCalendarSet(ctx, request)
+	var namespaceNotActive *serviceerror.NamespaceNotActive
+	s.ErrorAs(err, &namespaceNotActive)
+
+	// the change is replicated from the active cluster
+	detail.ReplicationConfig.ActiveClusterName = currentClusterName
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).Return(nil)
+	s.mockProducer.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, task *replicationspb.ReplicationTask) error {
+			attributes := task.GetNamespaceTaskAttributes()
+			s.Equal(enumsspb.NAMESPACE_OPERATION_UPDATE, attributes.GetNamespaceOperation())
+			s.Equal(int64(4), attributes.GetConfigVersion())
+			s.Equal(int64(2), attributes.GetFailoverVersion())
+			return nil
+		})
+	_, err = s.handler.UpdateScheduleCalendarSet(ctx, request)
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestListScheduleActions() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	nominalTime := time.Date(2027, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	_, err := s.handler.ListScheduleActions(ctx, &adminservice.ListScheduleActionsRequest{Namespace: s.namespace.String()})
+	s.Equal(errScheduleIDNotSet, err)
+
+	_, err = s.handler.ListScheduleActions(ctx, &adminservice.ListScheduleActionsRequest{
+		Namespace:     s.namespace.String(),
+		ScheduleId:    "my'schedule",
+		NextPageToken: []byte("not a token"),
+	})
+	s.ErrorIs(err, errInvalidListScheduleActionsToken)
+
+	nominalTimePayload, err := searchattribute.EncodeValue(nominalTime, enumspb.INDEXED_VALUE_TYPE_DATETIME)
+	s.NoError(err)
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    defaultListScheduleActionsPageSize,
+		Query:       "TemporalScheduledById = 'my\\'schedule'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+				searchattribute.TemporalScheduledStartTime: nominalTimePayload,
+			}},
+		}},
+		NextPageToken: []byte("visibility-token"),
+	}, nil)
+	s.mockSkippedActionMgr.EXPECT().ReadSkippedScheduleActions(gomock.Any(), &persistence.ReadSkippedScheduleActionsRequest{
+		NamespaceID: s.namespaceID.String(),
+		ScheduleID:  "my'schedule",
+		PageSize:    defaultListScheduleActionsPageSize,
+	}).Return(&persistence.ReadSkippedScheduleActionsResponse{
+		SkippedActions: []*schedulespb.SkippedAction{{
+			NominalTime: timestamppb.New(nominalTime.Add(time.Hour)),
+			Reason:      enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP,
+		}},
+	}, nil)
+
+	resp, err := s.handler.ListScheduleActions(ctx, &adminservice.ListScheduleActionsRequest{
+		Namespace:  s.namespace.String(),
+		ScheduleId: "my'schedule",
+	})
+	s.NoError(err)
+	s.Len(resp.GetStartedActions(), 1)
+	s.Equal("run-1", resp.GetStartedActions()[0].GetRunId())
+	s.Equal(nominalTime, resp.GetStartedActions()[0].GetNominalTime().AsTime())
+	s.Len(resp.GetSkippedActions(), 1)
+	s.Equal(enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP, resp.GetSkippedActions()[0].GetReason())
+
+	var token tokenspb.ListScheduleActionsNextPageToken
+	s.NoError(token.Unmarshal(resp.GetNextPageToken()))
+	s.Equal([]byte("visibility-token"), token.GetStartedActionsToken())
+	s.False(token.GetStartedActionsDone())
+	s.True(token.GetSkippedActionsDone())
+
+	// skipped actions are exhausted, so only visibility is read for the second page
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   s.namespaceID,
+		Namespace:     s.namespace,
+		PageSize:      defaultListScheduleActionsPageSize,
+		NextPageToken: []byte("visibility-token"),
+		Query:         "TemporalScheduledById = 'my\\'schedule'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{}, nil)
+	resp, err = s.handler.ListScheduleActions(ctx, &adminservice.ListScheduleActionsRequest{
+		Namespace:     s.namespace.String(),
+		ScheduleId:    "my'schedule",
+		NextPageToken: resp.GetNextPageToken(),
+	})
+	s.NoError(err)
+	s.Empty(resp.GetStartedActions())
+	s.Empty(resp.GetSkippedActions())
+	s.Empty(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) TestListCallbacks() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	callbackNode := func(state enumsspb.CallbackState) *persistencespb.StateMachineNode {
+		data, err := proto.Marshal(&persistencespb.CallbackInfo{State: state, Attempt: 3})
+		s.NoError(err)
+		return &persistencespb.StateMachineNode{Data: data}
+	}
+	s.mockVisibilityMgr.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    defaultListCallbacksPageSize,
+		Query:       "ExecutionStatus = 'Completed'",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-1"}},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-2", RunId: "run-2"}},
+		},
+		NextPageToken: []byte("token"),
+	}, nil)
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.DescribeMutableStateRequest, _ ...grpc.CallOption) (*historyservice.DescribeMutableStateResponse, error) {
+			if req.GetExecution().GetWorkflowId() == "wf-2" {
+				return nil, serviceerror.NewNotFound("deleted")
+			}
+			return &historyservice.DescribeMutableStateResponse{
+				CacheMutableState: &persistencespb.WorkflowMutableState{
+					ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+						SubStateMachinesByType: map[string]*persistencespb.StateMachineMap{
+							callbacks.StateMachineType: {MachinesById: map[string]*persistencespb.StateMachineNode{
+								"cb-1": callbackNode(enumsspb.CALLBACK_STATE_FAILED),
+								"cb-2": callbackNode(enumsspb.CALLBACK_STATE_SUCCEEDED),
+								"cb-3": callbackNode(enumsspb.CALLBACK_STATE_BACKING_OFF),
+							}},
+						},
+					},
+				},
+			}, nil
+		}).Times(2)
+
+	resp, err := s.handler.ListCallbacks(ctx, &adminservice.ListCallbacksRequest{
+		Namespace: s.namespace.String(),
+		Query:     "ExecutionStatus = 'Completed'",
+		States:    []enumsspb.CallbackState{enumsspb.CALLBACK_STATE_FAILED, enumsspb.CALLBACK_STATE_BACKING_OFF},
+	})
+	s.NoError(err)
+	s.Equal([]byte("token"), resp.GetNextPageToken())
+	s.Len(resp.GetCallbacks(), 2)
+	s.Equal("cb-1", resp.GetCallbacks()[0].GetCallbackId())
+	s.Equal("wf-1", resp.GetCallbacks()[0].GetExecution().GetWorkflowId())
+	s.Equal(enumsspb.CALLBACK_STATE_FAILED, resp.GetCallbacks()[0].GetInfo().GetState())
+	s.Equal("cb-3", resp.GetCallbacks()[1].GetCallbackId())
+}
+
+func (s *adminHandlerSuite) TestRetryCallback() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wf-1"}
+
+	_, err := s.handler.RetryCallback(ctx, &adminservice.RetryCallbackRequest{
+		Namespace: s.namespace.String(),
+		Execution: execution,
+	})
+	s.Equal(errCallbackIDNotSet, err)
+
+	initialTransition := &persistencespb.VersionedTransition{NamespaceFailoverVersion: 1, TransitionCount: 2}
+	currentTransition := &persistencespb.VersionedTransition{NamespaceFailoverVersion: 1, TransitionCount: 5}
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				TransitionHistory: []*persistencespb.VersionedTransition{currentTransition},
+				SubStateMachinesByType: map[string]*persistencespb.StateMachineMap{
+					callbacks.StateMachineType: {MachinesById: map[string]*persistencespb.StateMachineNode{
+						"cb-1": {
+							InitialVersionedTransition:    initialTransition,
+							LastUpdateVersionedTransition: currentTransition,
+						},
+					}},
+				},
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-1"},
+		},
+	}, nil).Times(2)
+
+	_, err = s.handler.RetryCallback(ctx, &adminservice.RetryCallbackRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		CallbackId: "cb-2",
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+
+	s.mockHistoryClient.EXPECT().InvokeStateMachineMethod(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.InvokeStateMachineMethodRequest, _ ...grpc.CallOption) (*historyservice.InvokeStateMachineMethodResponse, error) {
+			s.Equal("run-1", req.GetRunId())
+			s.Equal(callbacks.RetryMethodName, req.GetMethodName())
+			s.Equal("cb-1", req.GetRef().GetPath()[0].GetId())
+			s.Equal(initialTransition, req.GetRef().GetMachineInitialVersionedTransition())
+			s.Equal(currentTransition, req.GetRef().GetMutableStateVersionedTransition())
+			return &historyservice.InvokeStateMachineMethodResponse{}, nil
+		})
+	_, err = s.handler.RetryCallback(ctx, &adminservice.RetryCallbackRequest{
+		Namespace:  s.namespace.String(),
+		Execution:  execution,
+		CallbackId: "cb-1",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestListNexusEndpoints() {
+	ctx := context.Background()
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("host-1"),
+		membership.NewHostInfoFromAddress("host-2"),
+		membership.NewHostInfoFromAddress("host-3"),
+	})
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.namespaceID).Return(s.namespace, nil).AnyTimes()
+
+	entries := []*persistencespb.NexusEndpointEntry{
+		{Id: "endpoint-id", Version: 1, Endpoint: &persistencespb.NexusEndpoint{Spec: &persistencespb.NexusEndpointSpec{Name: "endpoint"}}},
+		{Id: "idle-id", Version: 1, Endpoint: &persistencespb.NexusEndpoint{Spec: &persistencespb.NexusEndpointSpec{Name: "idle"}}},
+	}
+	s.mockMatchingClient.EXPECT().ListNexusEndpoints(ctx, protomock.Eq(&matchingservice.ListNexusEndpointsRequest{
+		PageSize:      2,
+		NextPageToken: []byte("endpoint-id"),
+	})).Return(&matchingservice.ListNexusEndpointsResponse{
+		Entries:       entries,
+		NextPageToken: []byte("next-id"),
+	}, nil)
+
+	buckets := func(counts ...int64) []*historyservice.GetOutboundDestinationHealthResponse_LatencyBucket {
+		bounds := []time.Duration{10 * time.Millisecond, 100 * time.Millisecond, time.Second}
+		result := make([]*historyservice.GetOutboundDestinationHealthResponse_LatencyBucket, len(counts))
+		for i, count := range counts {
+			result[i] = &historyservice.GetOutboundDestinationHealthResponse_LatencyBucket{Count: count}
+			if i < len(bounds) {
+				result[i].UpperBound = durationpb.New(bounds[i])
+			}
+		}
+		return result
+	}
+	s.mockHistoryClient.EXPECT().GetOutboundDestinationHealth(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.GetOutboundDestinationHealthRequest, _ ...grpc.CallOption) (*historyservice.GetOutboundDestinationHealthResponse, error) {
+			s.Equal([]string{"endpoint", "idle"}, req.GetDestinations())
+			switch req.GetHostAddress() {
+			case "host-1":
+				return &historyservice.GetOutboundDestinationHealthResponse{
+					Destinations: []*historyservice.GetOutboundDestinationHealthResponse_Destination{
+						{
+							NamespaceId:         s.namespaceID.String(),
+							TaskGroup:           nexusoperations.TaskTypeInvocation,
+							Destination:         "endpoint",
+							CircuitBreakerState: enumsspb.CIRCUIT_BREAKER_STATE_CLOSED,
+							SuccessCount:        8,
+							FailureCount:        1,
+							LatencyBuckets:      buckets(5, 4, 0, 0),
+						},
+						{
+							NamespaceId:         s.namespaceID.String(),
+							TaskGroup:           callbacks.TaskTypeInvocation,
+							Destination:         "endpoint",
+							CircuitBreakerState: enumsspb.CIRCUIT_BREAKER_STATE_OPEN,
+							SuccessCount:        1,
+							LatencyBuckets:      buckets(1, 0, 0, 0),
+						},
+					},
+				}, nil
+			case "host-2":
+				return &historyservice.GetOutboundDestinationHealthResponse{
+					Destinations: []*historyservice.GetOutboundDestinationHealthResponse_Destination{
+						{
+							NamespaceId:         s.namespaceID.String(),
+							TaskGroup:           nexusoperations.TaskTypeInvocation,
+							Destination:         "endpoint",
+							CircuitBreakerState: enumsspb.CIRCUIT_BREAKER_STATE_OPEN,
+							FailureCount:        1,
+							LatencyBuckets:      buckets(0, 0, 0, 1),
+						},
+					},
+				}, nil
+			default:
+				return nil, serviceerror.NewUnavailable("host unavailable")
+			}
+		}).Times(3)
+
+	resp, err := s.handler.ListNexusEndpoints(ctx, &adminservice.ListNexusEndpointsRequest{
+		PageSize:      2,
+		NextPageToken: []byte("endpoint-id"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next-id"), resp.GetNextPageToken())
+	s.Equal([]string{"host-3"}, resp.GetFailedHosts())
+	s.Len(resp.GetEndpoints(), 2)
+	s.Equal(entries[0], resp.GetEndpoints()[0].GetEntry())
+	health := resp.GetEndpoints()[0].GetHealth()
+	s.Equal(int64(8), health.GetSuccessCount())
+	s.Equal(int64(2), health.GetFailureCount())
+	s.InDelta(0.8, health.GetSuccessRate(), 0.0001)
+	s.Equal(10*time.Millisecond, health.GetLatencyP50().AsDuration())
+	s.Equal(100*time.Millisecond, health.GetLatencyP90().AsDuration())
+	s.Equal(time.Second, health.GetLatencyP99().AsDuration())
+	s.Equal(enumsspb.CIRCUIT_BREAKER_STATE_OPEN, health.GetCircuitBreakerState())
+	s.Len(health.GetCircuits(), 2)
+	s.Equal("host-1", health.GetCircuits()[0].GetHostAddress())
+	s.Equal(s.namespace.String(), health.GetCircuits()[0].GetNamespace())
+	s.Equal("host-2", health.GetCircuits()[1].GetHostAddress())
+
+	// Endpoints that weren't called recently are reported as closed with no calls.
+	s.Equal(entries[1], resp.GetEndpoints()[1].GetEntry())
+	idle := resp.GetEndpoints()[1].GetHealth()
+	s.Equal(enumsspb.CIRCUIT_BREAKER_STATE_CLOSED, idle.GetCircuitBreakerState())
+	s.Zero(idle.GetSuccessCount() + idle.GetFailureCount())
+	s.Nil(idle.GetLatencyP50())
+	s.Empty(idle.GetCircuits())
+}
+
+func (s *adminHandlerSuite) TestGetNexusEndpoint() {
+	ctx := context.Background()
+
+	_, err := s.handler.GetNexusEndpoint(ctx, &adminservice.GetNexusEndpointRequest{})
+	s.Equal(errEndpointNotSet, err)
+
+	entry := &persistencespb.NexusEndpointEntry{
+		Id:      "endpoint-id",
+		Version: 3,
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+			CircuitBreakerOverride: &persistencespb.NexusEndpointCircuitBreakerOverride{
+				Override:       enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN,
+				ExpirationTime: timestamppb.New(time.Now().Add(time.Hour)),
+			},
+		},
+	}
+	s.mockMatchingClient.EXPECT().ListNexusEndpoints(ctx, gomock.Any()).Return(&matchingservice.ListNexusEndpointsResponse{
+		Entries: []*persistencespb.NexusEndpointEntry{entry},
+	}, nil)
+	s.mockResource.HistoryServiceResolver.EXPECT().AvailableMembers().Return([]membership.HostInfo{
+		membership.NewHostInfoFromAddress("host-1"),
+	})
+	s.mockHistoryClient.EXPECT().GetOutboundDestinationHealth(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *historyservice.GetOutboundDestinationHealthRequest, _ ...grpc.CallOption) (*historyservice.GetOutboundDestinationHealthResponse, error) {
+			s.Equal([]string{"endpoint"}, req.GetDestinations())
+			return &historyservice.GetOutboundDestinationHealthResponse{
+				Destinations: []*historyservice.GetOutboundDestinationHealthResponse_Destination{
+					{
+						NamespaceId:         s.namespaceID.String(),
+						TaskGroup:           nexusoperations.TaskTypeCancelation,
+						Destination:         "endpoint",
+						CircuitBreakerState: enumsspb.CIRCUIT_BREAKER_STATE_OPEN,
+					},
+				},
+			}, nil
+		})
+	s.mockNamespaceCache.EXPECT().GetNamespaceName(s.namespaceID).Return(s.namespace, nil)
+
+	resp, err := s.handler.GetNexusEndpoint(ctx, &adminservice.GetNexusEndpointRequest{Endpoint: "endpoint"})
+	s.NoError(err)
+	s.Equal(entry, resp.GetEntry())
+	s.Equal(enumsspb.CIRCUIT_BREAKER_STATE_OPEN, resp.GetHealth().GetCircuitBreakerState())
+	s.Empty(resp.GetFailedHosts())
+}
+
+func (s *adminHandlerSuite) TestSetNexusEndpointCircuitBreaker() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetNexusEndpointCircuitBreaker(ctx, &adminservice.SetNexusEndpointCircuitBreakerRequest{})
+	s.Equal(errEndpointNotSet, err)
+	_, err = s.handler.SetNexusEndpointCircuitBreaker(ctx, &adminservice.SetNexusEndpointCircuitBreakerRequest{
+		Endpoint: "endpoint",
+		Duration: durationpb.New(-time.Minute),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	spec := &persistencespb.NexusEndpointSpec{Name: "endpoint"}
+	s.mockMatchingClient.EXPECT().ListNexusEndpoints(ctx, gomock.Any()).Return(&matchingservice.ListNexusEndpointsResponse{
+		Entries: []*persistencespb.NexusEndpointEntry{
+			{Id: "endpoint-id", Version: 3, Endpoint: &persistencespb.NexusEndpoint{Spec: spec}},
+		},
+	}, nil)
+	now := time.Now()
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *matchingservice.UpdateNexusEndpointRequest, _ ...grpc.CallOption) (*matchingservice.UpdateNexusEndpointResponse, error) {
+			s.Equal("endpoint-id", req.GetId())
+			s.Equal(int64(3), req.GetVersion())
+			s.Equal(spec, req.GetSpec())
+			s.Nil(req.GetAccessPolicy())
+			s.Equal(enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED, req.GetCircuitBreakerOverride().GetOverride())
+			s.WithinDuration(now.Add(10*time.Minute), req.GetCircuitBreakerOverride().GetExpirationTime().AsTime(), time.Minute)
+			return &matchingservice.UpdateNexusEndpointResponse{
+				Entry: &persistencespb.NexusEndpointEntry{Id: "endpoint-id", Version: 4},
+			}, nil
+		})
+	resp, err := s.handler.SetNexusEndpointCircuitBreaker(ctx, &adminservice.SetNexusEndpointCircuitBreakerRequest{
+		Endpoint: "endpoint",
+		Override: enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED,
+		Duration: durationpb.New(10 * time.Minute),
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.GetVersion())
+}
+
+func (s *adminHandlerSuite) TestSetNexusEndpointAccessPolicy() {
+	ctx := context.Background()
+
+	_, err := s.handler.SetNexusEndpointAccessPolicy(ctx, &adminservice.SetNexusEndpointAccessPolicyRequest{})
+	s.Equal(errEndpointNotSet, err)
+
+	spec := &persistencespb.NexusEndpointSpec{Name: "endpoint"}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).Times(2)
+	s.mockMatchingClient.EXPECT().ListNexusEndpoints(ctx, protomock.Eq(&matchingservice.ListNexusEndpointsRequest{
+		PageSize: listNexusEndpointsPageSize,
+	})).Return(&matchingservice.ListNexusEndpointsResponse{
+		Entries: []*persistencespb.NexusEndpointEntry{
+			{Id: "other-id", Version: 1, Endpoint: &persistencespb.NexusEndpoint{Spec: &persistencespb.NexusEndpointSpec{Name: "other"}}},
+		},
+		NextPageToken: []byte("other-id"),
+		TableVersion:  5,
+	}, nil).Times(2)
+	s.mockMatchingClient.EXPECT().ListNexusEndpoints(ctx, protomock.Eq(&matchingservice.ListNexusEndpointsRequest{
+		PageSize:              listNexusEndpointsPageSize,
+		NextPageToken:         []byte("other-id"),
+		LastKnownTableVersion: 5,
+	})).Return(&matchingservice.ListNexusEndpointsResponse{
+		Entries: []*persistencespb.NexusEndpointEntry{
+			{Id: "endpoint-id", Version: 3, Endpoint: &persistencespb.NexusEndpoint{Spec: spec}},
+		},
+		TableVersion: 5,
+	}, nil).Times(2)
+	s.mockMatchingClient.EXPECT().UpdateNexusEndpoint(ctx, protomock.Eq(&matchingservice.UpdateNexusEndpointRequest{
+		Id:      "endpoint-id",
+		Version: 3,
+		Spec:    spec,
+		AccessPolicy: &persistencespb.NexusEndpointAccessPolicy{
+			AllowedCallerNamespaceIds: []string{s.namespaceID.String()},
+			AllowedWorkflowTypes:      []string{"workflow-type"},
+		},
+	})).Return(&matchingservice.UpdateNexusEndpointResponse{
+		Entry: &persistencespb.NexusEndpointEntry{Id: "endpoint-id", Version: 4},
+	}, nil)
+	resp, err := s.handler.SetNexusEndpointAccessPolicy(ctx, &adminservice.SetNexusEndpointAccessPolicyRequest{
+		Endpoint:                "endpoint",
+		AllowedCallerNamespaces: []string{s.namespace.String()},
+		AllowedWorkflowTypes:    []string{"workflow-type"},
+	})
+	s.NoError(err)
+	s.Equal(int64(4), resp.GetVersion())
+
+	_, err = s.handler.SetNexusEndpointAccessPolicy(ctx, &adminservice.SetNexusEndpointAccessPolicyRequest{
+		Endpoint:                "missing",
+		AllowedCallerNamespaces: []string{s.namespace.String()},
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
 func (s *adminHandlerSuite) TestDescribeTaskQueuePartition() {
 	handler := s.handler
 	ctx := context.Background()
@@ -2086,3 +3092,510 @@ func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQu
 	s.Equal(expectedPhysicalTaskQueueInfo.GetTaskQueueStats(), responsePhysicalTaskQueueInfo.GetTaskQueueStats())
 	s.Equal(expectedPhysicalTaskQueueInfo.GetInternalTaskQueueStatus(), responsePhysicalTaskQueueInfo.GetInternalTaskQueueStatus())
 }
+
+func (s *adminHandlerSuite) TestAggregateWorkflowExecutions() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.handler.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:       s.namespace.String(),
+		SearchAttribute: "ExecutionDuration",
+		Percentiles:     []float64{50, 101},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.handler.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:       s.namespace.String(),
+		SearchAttribute: "ExecutionDuration",
+		Percentiles:     make([]float64, maxAggregatePercentiles+1),
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockVisibilityMgr.EXPECT().AggregateWorkflowExecutions(gomock.Any(), &manager.AggregateWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		Query:       "WorkflowType = 'OrderWorkflow'",
+		FieldName:   "ExecutionDuration",
+		Percentiles: []float64{50, 95},
+	}).Return(&manager.AggregateWorkflowExecutionsResponse{
+		Count:            4,
+		Sum:              100,
+		Avg:              25,
+		Min:              10,
+		Max:              40,
+		PercentileValues: []float64{20, 40},
+	}, nil)
+
+	resp, err := s.handler.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:       s.namespace.String(),
+		Query:           "WorkflowType = 'OrderWorkflow'",
+		SearchAttribute: "ExecutionDuration",
+		Percentiles:     []float64{50, 95},
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.AggregateWorkflowExecutionsResponse{
+		Count: 4,
+		Sum:   100,
+		Avg:   25,
+		Min:   10,
+		Max:   40,
+		Percentiles: []*adminservice.AggregateWorkflowExecutionsResponse_Percentile{
+			{Percentile: 50, Value: 20},
+			{Percentile: 95, Value: 40},
+		},
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestExplainWorkflowExecutions() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+
+	_, err := s.handler.ExplainWorkflowExecutions(ctx, &adminservice.ExplainWorkflowExecutionsRequest{})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockVisibilityMgr.EXPECT().ExplainWorkflowExecutions(gomock.Any(), &manager.ExplainWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceID,
+		Namespace:   s.namespace,
+		PageSize:    1000,
+		Query:       "WorkflowId STARTS_WITH 'o'",
+	}).Return(&manager.ExplainWorkflowExecutionsResponse{
+		StoreQuery:  `{"query":{}}`,
+		Cost:        20,
+		CostReasons: []string{"short prefix"},
+		CostLimit:   10,
+	}, nil)
+	s.mockVisibilityMgr.EXPECT().GetReadStoreName(s.namespace).Return(elasticsearch.PersistenceName)
+
+	resp, err := s.handler.ExplainWorkflowExecutions(ctx, &adminservice.ExplainWorkflowExecutionsRequest{
+		Namespace: s.namespace.String(),
+		Query:     "WorkflowId STARTS_WITH 'o'",
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.ExplainWorkflowExecutionsResponse{
+		Store:       elasticsearch.PersistenceName,
+		StoreQuery:  `{"query":{}}`,
+		Cost:        20,
+		CostReasons: []string{"short prefix"},
+		CostLimit:   10,
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestStartVisibilityReindex() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+
+	_, err := s.handler.StartVisibilityReindex(ctx, &adminservice.StartVisibilityReindexRequest{
+		SkipReindex: true,
+	})
+	s.Equal(&serviceerror.InvalidArgument{Message: "SkipReindex requires Verify."}, err)
+
+	_, err = s.handler.StartVisibilityReindex(ctx, &adminservice.StartVisibilityReindexRequest{
+		PageSize: visibilityreindex.MaxPageSize + 1,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockVisibilityMgr.EXPECT().HasStoreName("unknown").Return(false)
+	s.mockVisibilityMgr.EXPECT().GetStoreNames().Return([]string{"sqlite"})
+	_, err = s.handler.StartVisibilityReindex(ctx, &adminservice.StartVisibilityReindexRequest{
+		TargetStore: "unknown",
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockVisibilityMgr.EXPECT().HasStoreName("elasticsearch").Return(true)
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		ctx,
+		gomock.Any(),
+		visibilityreindex.WorkflowName,
+		visibilityreindex.WorkflowParams{
+			NamespaceID:         s.namespaceID.String(),
+			TargetStore:         "elasticsearch",
+			ExecutionsPerSecond: 100,
+			Verify:              true,
+		},
+	).Return(mockRun, nil)
+	resp, err := s.handler.StartVisibilityReindex(ctx, &adminservice.StartVisibilityReindexRequest{
+		Namespace:           s.namespace.String(),
+		TargetStore:         "elasticsearch",
+		ExecutionsPerSecond: 100,
+		Verify:              true,
+	})
+	s.NoError(err)
+	s.Equal(visibilityreindex.WorkflowID, resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestCancelVisibilityReindex() {
+	ctx := context.Background()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+	request := &adminservice.CancelVisibilityReindexRequest{Reason: "test"}
+
+	// already completed
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(ctx, visibilityreindex.WorkflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: visibilityreindex.WorkflowID, RunId: "run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}, nil)
+	resp, err := s.handler.CancelVisibilityReindex(ctx, request)
+	s.NoError(err)
+	s.False(resp.GetCanceled())
+
+	// running
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(ctx, visibilityreindex.WorkflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: visibilityreindex.WorkflowID, RunId: "run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	}, nil)
+	mockSdkClient.EXPECT().TerminateWorkflow(ctx, visibilityreindex.WorkflowID, "run-id", "test").Return(nil)
+	resp, err = s.handler.CancelVisibilityReindex(ctx, request)
+	s.NoError(err)
+	s.True(resp.GetCanceled())
+}
+
+func (s *adminHandlerSuite) TestRenameSearchAttribute() {
+	ctx := context.Background()
+	s.mockVisibilityMgr.EXPECT().GetIndexName().Return(testIndexName).AnyTimes()
+	s.mockResource.SearchAttributesProvider.EXPECT().GetSearchAttributes(testIndexName, true).Return(searchattribute.TestNameTypeMap, nil).AnyTimes()
+	s.mockResource.ClientFactory.EXPECT().
+		NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
+		Return(nil, s.mockResource.GetFrontendClient(), nil).
+		AnyTimes()
+	s.mockResource.FrontendClient.EXPECT().
+		DescribeNamespace(gomock.Any(), &workflowservice.DescribeNamespaceRequest{Namespace: s.namespace.String()}).
+		Return(&workflowservice.DescribeNamespaceResponse{
+			Config: &namespacepb.NamespaceConfig{CustomSearchAttributeAliases: searchattribute.TestAliases},
+		}, nil).
+		AnyTimes()
+
+	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(true)
+	_, err := s.handler.RenameSearchAttribute(ctx, &adminservice.RenameSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "CustomKeywordField",
+		NewName:   "RenamedKeywordField",
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	s.mockVisibilityMgr.EXPECT().HasStoreName(elasticsearch.PersistenceName).Return(false).AnyTimes()
+	_, err = s.handler.RenameSearchAttribute(ctx, &adminservice.RenameSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "UnknownField",
+		NewName:   "RenamedKeywordField",
+	})
+	s.Equal(serviceerror.NewInvalidArgumentf(errSearchAttributeDoesntExistMessage, "UnknownField"), err)
+
+	_, err = s.handler.RenameSearchAttribute(ctx, &adminservice.RenameSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "CustomKeywordField",
+		NewName:   "CustomTextField",
+	})
+	s.Equal(serviceerror.NewInvalidArgumentf(errSearchAttributeAlreadyExistsMessage, "CustomTextField"), err)
+
+	// the alias is removed before it is set again
+	gomock.InOrder(
+		s.mockResource.FrontendClient.EXPECT().UpdateNamespace(gomock.Any(), &workflowservice.UpdateNamespaceRequest{
+			Namespace: s.namespace.String(),
+			Config: &namespacepb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"Keyword01": ""},
+			},
+		}).Return(&workflowservice.UpdateNamespaceResponse{}, nil),
+		s.mockResource.FrontendClient.EXPECT().UpdateNamespace(gomock.Any(), &workflowservice.UpdateNamespaceRequest{
+			Namespace: s.namespace.String(),
+			Config: &namespacepb.NamespaceConfig{
+				CustomSearchAttributeAliases: map[string]string{"Keyword01": "RenamedKeywordField"},
+			},
+		}).Return(&workflowservice.UpdateNamespaceResponse{}, nil),
+	)
+	_, err = s.handler.RenameSearchAttribute(ctx, &adminservice.RenameSearchAttributeRequest{
+		Namespace: s.namespace.String(),
+		Name:      "CustomKeywordField",
+		NewName:   "RenamedKeywordField",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestStartSearchAttributeMigration() {
+	ctx := context.Background()
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+
+	_, err := s.handler.StartSearchAttributeMigration(ctx, &adminservice.StartSearchAttributeMigrationRequest{})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.handler.StartSearchAttributeMigration(ctx, &adminservice.StartSearchAttributeMigrationRequest{
+		Name:     "CustomKeywordField",
+		PageSize: searchattributemigration.MaxPageSize + 1,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	_, err = s.handler.StartSearchAttributeMigration(ctx, &adminservice.StartSearchAttributeMigrationRequest{
+		Name:    "CustomKeywordField",
+		NewName: searchattribute.WorkflowID,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	mockRun := mocksdk.NewMockWorkflowRun(s.controller)
+	mockRun.EXPECT().GetRunID().Return("run-id")
+	mockSdkClient.EXPECT().ExecuteWorkflow(
+		ctx,
+		gomock.Any(),
+		searchattributemigration.WorkflowName,
+		searchattributemigration.WorkflowParams{
+			NamespaceID: s.namespaceID.String(),
+			Namespace:   s.namespace.String(),
+			Name:        "CustomKeywordField",
+			NewType:     enumspb.INDEXED_VALUE_TYPE_TEXT,
+			DryRun:      true,
+			Reason:      "test",
+		},
+	).Return(mockRun, nil)
+	resp, err := s.handler.StartSearchAttributeMigration(ctx, &adminservice.StartSearchAttributeMigrationRequest{
+		Namespace: s.namespace.String(),
+		Name:      "CustomKeywordField",
+		NewType:   enumspb.INDEXED_VALUE_TYPE_TEXT,
+		DryRun:    true,
+		Reason:    "test",
+	})
+	s.NoError(err)
+	s.Equal(searchattributemigration.WorkflowID, resp.GetWorkflowId())
+	s.Equal("run-id", resp.GetRunId())
+}
+
+func (s *adminHandlerSuite) TestDescribeSearchAttributeMigration() {
+	ctx := context.Background()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient)
+	startTime := timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(ctx, searchattributemigration.WorkflowID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: searchattributemigration.WorkflowID, RunId: "run-id"},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: startTime,
+		},
+	}, nil)
+	mockValue := mocksdk.NewMockEncodedValue(s.controller)
+	mockValue.EXPECT().Get(gomock.Any()).Do(func(result any) {
+		*(result.(*searchattributemigration.Progress)) = searchattributemigration.Progress{
+			Namespace:           s.namespace.String(),
+			Name:                "CustomKeywordField",
+			NewType:             enumspb.INDEXED_VALUE_TYPE_INT,
+			Phase:               searchattributemigration.PhaseMigrating,
+			Type:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			ExecutionsValidated: 10,
+			ExecutionsMigrated:  5,
+			InvalidValues:       1,
+			InvalidValueSamples: []searchattributemigration.InvalidValue{
+				{WorkflowID: "workflow-id", RunID: "run-id", Value: "abc", Error: "invalid syntax"},
+			},
+		}
+	})
+	mockSdkClient.EXPECT().QueryWorkflow(ctx, searchattributemigration.WorkflowID, "run-id", searchattributemigration.QueryTypeProgress).
+		Return(mockValue, nil)
+
+	resp, err := s.handler.DescribeSearchAttributeMigration(ctx, &adminservice.DescribeSearchAttributeMigrationRequest{})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.DescribeSearchAttributeMigrationResponse{
+		Status:              enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		Namespace:           s.namespace.String(),
+		Name:                "CustomKeywordField",
+		Type:                enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		NewType:             enumspb.INDEXED_VALUE_TYPE_INT,
+		Phase:               searchattributemigration.PhaseMigrating,
+		ExecutionsValidated: 10,
+		ExecutionsMigrated:  5,
+		InvalidValues:       1,
+		InvalidValueSamples: []*adminservice.DescribeSearchAttributeMigrationResponse_InvalidValue{
+			{WorkflowId: "workflow-id", RunId: "run-id", Value: "abc", Error: "invalid syntax"},
+		},
+		StartTime: startTime,
+	}, resp)
+}
+
+func (s *adminHandlerSuite) TestPutSavedVisibilityQuery() {
+	ctx := context.Background()
+	mockSavedQueryManager := savedquery.NewMockManager(s.controller)
+	s.handler.savedQueryManager = mockSavedQueryManager
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockResource.ClientFactory.EXPECT().
+		NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
+		Return(nil, s.mockResource.GetFrontendClient(), nil).
+		AnyTimes()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+
+	_, err := s.handler.PutSavedVisibilityQuery(ctx, &adminservice.PutSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Query:     &persistencespb.SavedVisibilityQuery{Name: "failed"},
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	// invalid queries are rejected by the frontend
+	invalidQuery := &persistencespb.SavedVisibilityQuery{Name: "failed", Query: "Foo = 'bar'"}
+	s.mockResource.FrontendClient.EXPECT().
+		CountWorkflowExecutions(gomock.Any(), &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: s.namespace.String(),
+			Query:     invalidQuery.GetQuery(),
+		}).
+		Return(nil, serviceerror.NewInvalidArgument("unknown search attribute Foo"))
+	_, err = s.handler.PutSavedVisibilityQuery(ctx, &adminservice.PutSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Query:     invalidQuery,
+	})
+	s.ErrorAs(err, new(*serviceerror.InvalidArgument))
+
+	// without alert
+	query := &persistencespb.SavedVisibilityQuery{Name: "failed", Query: "ExecutionStatus = 'Failed'"}
+	s.mockResource.FrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil).
+		Times(2)
+	mockSavedQueryManager.EXPECT().PutQuery(ctx, s.namespaceID, query).Return(nil)
+	_, err = s.handler.PutSavedVisibilityQuery(ctx, &adminservice.PutSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Query:     query,
+	})
+	s.NoError(err)
+
+	// with alert
+	alertQuery := &persistencespb.SavedVisibilityQuery{
+		Name:  "failed",
+		Query: "ExecutionStatus = 'Failed'",
+		Alert: &persistencespb.SavedVisibilityQueryAlert{
+			Threshold:  10,
+			Interval:   durationpb.New(5 * time.Minute),
+			WebhookUrl: "https://example.com/hook",
+		},
+	}
+	mockSavedQueryManager.EXPECT().PutQuery(ctx, s.namespaceID, alertQuery).Return(nil)
+	mockSdkClient.EXPECT().SignalWithStartWorkflow(
+		ctx,
+		savedqueryalert.WorkflowID(s.namespaceID.String(), "failed"),
+		savedqueryalert.SignalNameRefresh,
+		nil,
+		gomock.Any(),
+		savedqueryalert.WorkflowName,
+		savedqueryalert.WorkflowParams{NamespaceID: s.namespaceID.String(), Name: "failed"},
+	).Return(mocksdk.NewMockWorkflowRun(s.controller), nil)
+	_, err = s.handler.PutSavedVisibilityQuery(ctx, &adminservice.PutSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Query:     alertQuery,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestDeleteSavedVisibilityQuery() {
+	ctx := context.Background()
+	mockSavedQueryManager := savedquery.NewMockManager(s.controller)
+	s.handler.savedQueryManager = mockSavedQueryManager
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().GetSystemClient().Return(mockSdkClient).AnyTimes()
+
+	mockSavedQueryManager.EXPECT().GetQuery(ctx, s.namespaceID, "missing").Return(nil, serviceerror.NewNotFound("not found"))
+	_, err := s.handler.DeleteSavedVisibilityQuery(ctx, &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "missing",
+	})
+	s.ErrorAs(err, new(*serviceerror.NotFound))
+
+	mockSavedQueryManager.EXPECT().GetQuery(ctx, s.namespaceID, "failed").Return(&persistencespb.SavedVisibilityQuery{
+		Name:  "failed",
+		Query: "ExecutionStatus = 'Failed'",
+		Alert: &persistencespb.SavedVisibilityQueryAlert{Threshold: 10},
+	}, nil)
+	mockSavedQueryManager.EXPECT().DeleteQuery(ctx, s.namespaceID, "failed").Return(nil)
+	mockSdkClient.EXPECT().SignalWorkflow(
+		ctx,
+		savedqueryalert.WorkflowID(s.namespaceID.String(), "failed"),
+		"",
+		savedqueryalert.SignalNameRefresh,
+		nil,
+	).Return(serviceerror.NewNotFound("workflow not found"))
+	_, err = s.handler.DeleteSavedVisibilityQuery(ctx, &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "failed",
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestListSavedVisibilityQueries() {
+	ctx := context.Background()
+	mockSavedQueryManager := savedquery.NewMockManager(s.controller)
+	s.handler.savedQueryManager = mockSavedQueryManager
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+
+	queries := []*persistencespb.SavedVisibilityQuery{
+		{Name: "failed", Query: "ExecutionStatus = 'Failed'"},
+		{Name: "running", Query: "ExecutionStatus = 'Running'"},
+	}
+	mockSavedQueryManager.EXPECT().ListQueries(ctx, s.namespaceID).Return(queries, nil)
+	resp, err := s.handler.ListSavedVisibilityQueries(ctx, &adminservice.ListSavedVisibilityQueriesRequest{
+		Namespace: s.namespace.String(),
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.ListSavedVisibilityQueriesResponse{Queries: queries}, resp)
+}
+
+func (s *adminHandlerSuite) TestRunSavedVisibilityQuery() {
+	ctx := context.Background()
+	mockSavedQueryManager := savedquery.NewMockManager(s.controller)
+	s.handler.savedQueryManager = mockSavedQueryManager
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockResource.ClientFactory.EXPECT().
+		NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
+		Return(nil, s.mockResource.GetFrontendClient(), nil).
+		AnyTimes()
+	query := &persistencespb.SavedVisibilityQuery{Name: "failed", Query: "ExecutionStatus = 'Failed'"}
+	mockSavedQueryManager.EXPECT().GetQuery(ctx, s.namespaceID, "failed").Return(query, nil).Times(2)
+
+	s.mockResource.FrontendClient.EXPECT().
+		CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+			Namespace: s.namespace.String(),
+			Query:     query.GetQuery(),
+		}).
+		Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 42}, nil)
+	resp, err := s.handler.RunSavedVisibilityQuery(ctx, &adminservice.RunSavedVisibilityQueryRequest{
+		Namespace: s.namespace.String(),
+		Name:      "failed",
+		CountOnly: true,
+	})
+	s.NoError(err)
+	s.Equal(int64(42), resp.GetCount())
+
+	executions := []*workflowpb.WorkflowExecutionInfo{
+		{Execution: &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"}},
+	}
+	s.mockResource.FrontendClient.EXPECT().
+		ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     s.namespace.String(),
+			PageSize:      10,
+			NextPageToken: []byte("token"),
+			Query:         query.GetQuery(),
+		}).
+		Return(&workflowservice.ListWorkflowExecutionsResponse{
+			Executions:    executions,
+			NextPageToken: []byte("next-token"),
+		}, nil)
+	resp, err = s.handler.RunSavedVisibilityQuery(ctx, &adminservice.RunSavedVisibilityQueryRequest{
+		Namespace:     s.namespace.String(),
+		Name:          "failed",
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+	})
+	s.NoError(err)
+	s.ProtoEqual(&adminservice.RunSavedVisibilityQueryResponse{
+		Executions:    executions,
+		NextPageToken: []byte("next-token"),
+	}, resp)
+}
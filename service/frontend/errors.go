@@ -77,15 +77,21 @@ var (
 	errMultiOpNotStartAndUpdate                           = serviceerror.NewInvalidArgument("Operations have to be exactly [Start, Update].")
 	errMultiOpAborted                                     = serviceerror.NewMultiOperationAborted("Operation was aborted.")
 
-	errUpdateMetaNotSet       = serviceerror.NewInvalidArgument("Update meta is not set on request.")
-	errUpdateInputNotSet      = serviceerror.NewInvalidArgument("Update input is not set on request.")
-	errUpdateNameNotSet       = serviceerror.NewInvalidArgument("Update name is not set on request.")
-	errUpdateIDTooLong        = serviceerror.NewInvalidArgument("UpdateId length exceeds limit.")
-	errUpdateRefNotSet        = serviceerror.NewInvalidArgument("UpdateRef is not set on request.")
-	errUpdateWaitPolicyNotSet = serviceerror.NewInvalidArgument("WaitPolicy is not set on request.")
-	errSourceClusterNotSet    = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
-	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
-	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errUpdateMetaNotSet                = serviceerror.NewInvalidArgument("Update meta is not set on request.")
+	errUpdateInputNotSet               = serviceerror.NewInvalidArgument("Update input is not set on request.")
+	errUpdateNameNotSet                = serviceerror.NewInvalidArgument("Update name is not set on request.")
+	errUpdateIDTooLong                 = serviceerror.NewInvalidArgument("UpdateId length exceeds limit.")
+	errUpdateRefNotSet                 = serviceerror.NewInvalidArgument("UpdateRef is not set on request.")
+	errUpdateWaitPolicyNotSet          = serviceerror.NewInvalidArgument("WaitPolicy is not set on request.")
+	errSourceClusterNotSet             = serviceerror.NewInvalidArgument("SourceCluster is not set on request.")
+	errTargetClusterNotSet             = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
+	errInvalidDLQJobToken              = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
+	errInvalidTimeRange                = serviceerror.NewInvalidArgument("EndTime is not after StartTime.")
+	errScheduleIDNotSet                = serviceerror.NewInvalidArgument("ScheduleId is not set on request.")
+	errInvalidListScheduleActionsToken = serviceerror.NewInvalidArgument("Invalid ListScheduleActions page token.")
+	errCallbackIDNotSet                = serviceerror.NewInvalidArgument("CallbackId is not set on request.")
+	errEndpointNotSet                  = serviceerror.NewInvalidArgument("Endpoint is not set on request.")
+	errCalendarSetNameNotSet           = serviceerror.NewInvalidArgument("Calendar set name is not set on request.")
 
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
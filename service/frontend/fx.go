@@ -570,6 +570,7 @@ func VisibilityManagerProvider(
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // frontend visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		serviceConfig.VisibilityQueryCostLimit,
 		metricsHandler,
 		logger,
 	)
@@ -620,6 +621,10 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	scheduleSpecBuilder *scheduler.SpecBuilder,
+	archivalMetadata archiver.ArchivalMetadata,
+	archiverProvider provider.ArchiverProvider,
+	skippedScheduleActionManager persistence.SkippedScheduleActionManager,
 	taskCategoryRegistry tasks.TaskCategoryRegistry,
 	matchingClient resource.MatchingClient,
 ) *AdminHandler {
@@ -650,6 +655,10 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		scheduleSpecBuilder,
+		archivalMetadata,
+		archiverProvider,
+		skippedScheduleActionManager,
 		taskCategoryRegistry,
 		matchingClient,
 	}
@@ -839,6 +839,86 @@ func (d *namespaceHandler) ListWorkflowRules(
 	return workflowRules, nil
 }
 
+// UpdateScheduleCalendarSet creates or replaces the calendar set with the given name, or deletes it if days is
+// empty. Global namespaces can only be changed in their active cluster, and the change is replicated like any other
+// namespace config change.
+func (d *namespaceHandler) UpdateScheduleCalendarSet(
+	ctx context.Context,
+	nsName string,
+	name string,
+	days []string,
+	identity string,
+) error {
+	metadata, err := d.metadataMgr.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	getNamespaceResponse, err := d.metadataMgr.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: nsName})
+	if err != nil {
+		return err
+	}
+
+	existingNamespace := getNamespaceResponse.Namespace
+	replicationConfig := existingNamespace.ReplicationConfig
+	if getNamespaceResponse.IsGlobalNamespace {
+		currentClusterName := d.clusterMetadata.GetCurrentClusterName()
+		if replicationConfig.GetActiveClusterName() != currentClusterName {
+			return serviceerror.NewNamespaceNotActive(nsName, currentClusterName, replicationConfig.GetActiveClusterName())
+		}
+	}
+
+	config := existingNamespace.Config
+	if len(days) == 0 {
+		if _, ok := config.ScheduleCalendarSets[name]; !ok {
+			return nil
+		}
+		delete(config.ScheduleCalendarSets, name)
+	} else {
+		if config.ScheduleCalendarSets == nil {
+			config.ScheduleCalendarSets = make(map[string]*persistencespb.ScheduleCalendarSet)
+		}
+		maxSets := d.config.MaxScheduleCalendarSetsPerNamespace(nsName)
+		if _, ok := config.ScheduleCalendarSets[name]; !ok && len(config.ScheduleCalendarSets) >= maxSets {
+			return serviceerror.NewInvalidArgumentf("Calendar set limit exceeded. Max: %v", maxSets)
+		}
+		config.ScheduleCalendarSets[name] = &persistencespb.ScheduleCalendarSet{
+			Days:           days,
+			UpdateTime:     timestamppb.New(d.timeSource.Now()),
+			UpdateIdentity: identity,
+		}
+	}
+
+	configVersion := existingNamespace.ConfigVersion + 1
+	err = d.metadataMgr.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      config,
+			ReplicationConfig:           replicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   getNamespaceResponse.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.namespaceReplicator.HandleTransmissionTask(
+		ctx,
+		enumsspb.NAMESPACE_OPERATION_UPDATE,
+		existingNamespace.Info,
+		config,
+		replicationConfig,
+		false,
+		configVersion,
+		existingNamespace.FailoverVersion,
+		getNamespaceResponse.IsGlobalNamespace,
+		replicationConfig.GetFailoverHistory(),
+	)
+}
+
 func (d *namespaceHandler) createResponse(
 	info *persistencespb.NamespaceInfo,
 	config *persistencespb.NamespaceConfig,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
@@ -161,6 +162,8 @@ func (h *NexusHTTPHandler) dispatchNexusTaskByNamespaceAndTaskQueue(w http.Respo
 		h.writeNexusFailure(w, http.StatusUnauthorized, &nexus.Failure{Message: "unauthorized"})
 		return
 	}
+	// Only endpoint dispatch enforces access policies, make sure the header never reaches workers.
+	r.Header.Del(commonnexus.CallerNamespaceIDHeader)
 
 	u, err := mux.CurrentRoute(r).URL("namespace", params.Namespace, "task_queue", params.TaskQueue)
 	if err != nil {
@@ -225,6 +228,15 @@ func (h *NexusHTTPHandler) dispatchNexusTaskByEndpoint(w http.ResponseWriter, r
 		return
 	}
 
+	if callerNamespaceID := callerNamespaceID(r, nc.claims); !commonnexus.CallerNamespaceAllowed(endpointEntry, callerNamespaceID) {
+		message := fmt.Sprintf("caller namespace is not allowed to call Nexus endpoint %q", endpointEntry.Endpoint.Spec.GetName())
+		if callerNamespaceID == "" {
+			message = fmt.Sprintf("Nexus endpoint %q only accepts calls from allowed namespaces", endpointEntry.Endpoint.Spec.GetName())
+		}
+		h.writeNexusFailure(w, http.StatusForbidden, &nexus.Failure{Message: message})
+		return
+	}
+
 	u, err := mux.CurrentRoute(r).URL("endpoint", endpointIDEscaped)
 	if err != nil {
 		h.logger.Error("invalid URL", tag.Error(err))
@@ -276,6 +288,19 @@ func (h *NexusHTTPHandler) nexusContextFromEndpoint(entry *persistencespb.NexusE
 	}
 }
 
+// callerNamespaceID returns the namespace ID of the workflow calling an endpoint, as set by history on requests it
+// dispatches. The header is removed from the request so it isn't forwarded to workers, and is only trusted from callers
+// with system wide write access, which history has. Other callers could otherwise claim to be any namespace to get
+// around the endpoint's access policy.
+func callerNamespaceID(r *http.Request, claims *authorization.Claims) string {
+	namespaceID := r.Header.Get(commonnexus.CallerNamespaceIDHeader)
+	r.Header.Del(commonnexus.CallerNamespaceIDHeader)
+	if claims == nil || claims.System&(authorization.RoleWriter|authorization.RoleAdmin) == 0 {
+		return ""
+	}
+	return namespaceID
+}
+
 func prepareRequest[T any](route routing.Route[T], w http.ResponseWriter, r *http.Request) T {
 	// Limit the request body to max allowed Payload size.
 	// Content headers are transformed to Payload metadata and contribute to the Payload size as well. A separate
@@ -0,0 +1,145 @@
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.uber.org/mock/gomock"
+)
+
+// testEndpointRegistry serves endpoints from a map, keyed by ID.
+type testEndpointRegistry map[string]*persistencespb.NexusEndpointEntry
+
+func (r testEndpointRegistry) GetByName(context.Context, namespace.ID, string) (*persistencespb.NexusEndpointEntry, error) {
+	return nil, serviceerror.NewUnimplemented("not implemented")
+}
+
+func (r testEndpointRegistry) GetByID(_ context.Context, id string) (*persistencespb.NexusEndpointEntry, error) {
+	entry, ok := r[id]
+	if !ok {
+		return nil, serviceerror.NewNotFoundf("could not find Nexus endpoint by ID: %v", id)
+	}
+	return entry, nil
+}
+
+func (r testEndpointRegistry) StartLifecycle() {}
+
+func (r testEndpointRegistry) StopLifecycle() {}
+
+// testClaimMapper grants system wide admin access to callers authenticating as "system", and access to a single
+// namespace to everyone else.
+type testClaimMapper struct{}
+
+func (testClaimMapper) GetClaims(authInfo *authorization.AuthInfo) (*authorization.Claims, error) {
+	if authInfo.AuthToken == "system" {
+		return &authorization.Claims{System: authorization.RoleAdmin}, nil
+	}
+	return &authorization.Claims{Namespaces: map[string]authorization.Role{"caller": authorization.RoleWriter}}, nil
+}
+
+func TestDispatchNexusTaskByEndpoint_CallerNamespace(t *testing.T) {
+	const allowedNamespaceID = "allowed-namespace-id"
+
+	entry := &persistencespb.NexusEndpointEntry{
+		Id: "endpoint-id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{
+				Name: "endpoint",
+				Target: &persistencespb.NexusEndpointTarget{
+					Variant: &persistencespb.NexusEndpointTarget_Worker_{
+						Worker: &persistencespb.NexusEndpointTarget_Worker{
+							NamespaceId: "target-namespace-id",
+							TaskQueue:   "task-queue",
+						},
+					},
+				},
+			},
+			AccessPolicy: &persistencespb.NexusEndpointAccessPolicy{
+				AllowedCallerNamespaceIds: []string{allowedNamespaceID},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		authToken      string
+		callerHeader   string
+		expectedStatus int
+	}{
+		{
+			name:           "history caller from allowed namespace",
+			authToken:      "system",
+			callerHeader:   allowedNamespaceID,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "history caller from other namespace",
+			authToken:      "system",
+			callerHeader:   "other-namespace-id",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "spoofed header",
+			authToken:      "user",
+			callerHeader:   allowedNamespaceID,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "no header",
+			authToken:      "user",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespaceRegistry := namespace.NewMockRegistry(gomock.NewController(t))
+			namespaceRegistry.EXPECT().GetNamespaceName(namespace.ID("target-namespace-id")).Return(namespace.Name("target"), nil)
+
+			var dispatched *http.Request
+			h := &NexusHTTPHandler{
+				logger:            log.NewNoopLogger(),
+				enpointRegistry:   testEndpointRegistry{entry.Id: entry},
+				namespaceRegistry: namespaceRegistry,
+				auth: authorization.NewInterceptor(
+					testClaimMapper{}, mockAuthorizer{}, metrics.NoopMetricsHandler, log.NewNoopLogger(), nil, nil, "", "",
+				),
+				preprocessErrorCounter: metrics.NoopMetricsHandler.Counter("test").Record,
+				enabled:                dynamicconfig.GetBoolPropertyFn(true),
+				nexusHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					dispatched = r
+					w.WriteHeader(http.StatusOK)
+				}),
+			}
+			router := mux.NewRouter()
+			h.RegisterRoutes(router)
+
+			r := httptest.NewRequest(http.MethodPost, "/"+commonnexus.RouteDispatchNexusTaskByEndpoint.Path(entry.Id)+"/service/operation", nil)
+			r.Header.Set("Authorization", tc.authToken)
+			if tc.callerHeader != "" {
+				r.Header.Set(commonnexus.CallerNamespaceIDHeader, tc.callerHeader)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, r)
+
+			require.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedStatus == http.StatusOK {
+				require.NotNil(t, dispatched)
+				require.Empty(t, dispatched.Header.Get(commonnexus.CallerNamespaceIDHeader))
+			} else {
+				require.Nil(t, dispatched)
+			}
+		})
+	}
+}
@@ -276,6 +276,7 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 	upsertFieldToAliasMap := make(map[string]string)
 	fieldToAliasMap := resp.Config.CustomSearchAttributeAliases
 	aliasToFieldMap := util.InverseMap(fieldToAliasMap)
+	remappedFields := currentSearchAttributes.RemappedFields(resp.GetNamespaceInfo().GetId())
 	for saName, saType := range request.GetSearchAttributes() {
 		// check if alias is already in use
 		if _, ok := aliasToFieldMap[saName]; ok {
@@ -299,6 +300,9 @@ func (h *OperatorHandlerImpl) addSearchAttributesSQL(
 			}
 			if _, ok := fieldToAliasMap[fieldName]; ok {
 				cntUsed++
+			} else if _, ok := remappedFields[fieldName]; ok {
+				// fields of an ongoing search attribute migration are reserved
+				cntUsed++
 			} else if _, ok := upsertFieldToAliasMap[fieldName]; ok {
 				cntUsed++
 			} else {
@@ -614,6 +614,7 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 		name                        string
 		request                     *operatorservice.AddSearchAttributesRequest
 		customSearchAttributesToAdd []string
+		fieldRemaps                 []searchattribute.FieldRemap
 		getFrontendClientErr        error
 		describeNamespaceCalled     bool
 		describeNamespaceErr        error
@@ -710,6 +711,27 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
 			),
 		},
+		{
+			name: "fail: fields of a search attribute migration are reserved",
+			request: &operatorservice.AddSearchAttributesRequest{
+				SearchAttributes: map[string]enumspb.IndexedValueType{
+					"CustomAttr1": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					"CustomAttr2": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				},
+				Namespace: testNamespace,
+			},
+			fieldRemaps: []searchattribute.FieldRemap{{
+				SourceField: "Keyword02",
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetField: "Int02",
+			}},
+			describeNamespaceCalled: true,
+			expectedErrMsg: fmt.Sprintf(
+				errTooManySearchAttributesMessage,
+				3,
+				enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+			),
+		},
 		{
 			name: "fail: cannot update namespace",
 			request: &operatorservice.AddSearchAttributesRequest{
@@ -748,7 +770,7 @@ func (s *operatorHandlerSuite) Test_AddSearchAttributesSQL() {
 			s.mockResource.VisibilityManager.EXPECT().GetIndexName().Return(testIndexName)
 			s.mockResource.SearchAttributesManager.EXPECT().
 				GetSearchAttributes(testIndexName, true).
-				Return(searchattribute.TestNameTypeMap, nil)
+				Return(searchattribute.NewNameTypeMapStub(searchattribute.TestNameTypeMap.Custom(), tc.fieldRemaps...), nil)
 
 			s.mockResource.ClientFactory.EXPECT().
 				NewLocalFrontendClientWithTimeout(gomock.Any(), gomock.Any()).
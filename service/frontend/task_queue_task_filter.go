@@ -0,0 +1,116 @@
+package frontend
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+type (
+	// taskQueueTaskMatcher evaluates an admin TaskQueueTaskFilter against persisted task queue
+	// tasks. Workflow and activity types are not persisted with tasks, so they are resolved
+	// from mutable state, which is cached per run for the lifetime of the matcher.
+	taskQueueTaskMatcher struct {
+		filter        *adminservice.TaskQueueTaskFilter
+		taskType      enumspb.TaskQueueType
+		historyClient historyservice.HistoryServiceClient
+
+		mutableStates map[string]*persistencespb.WorkflowMutableState
+	}
+)
+
+func newTaskQueueTaskMatcher(
+	filter *adminservice.TaskQueueTaskFilter,
+	taskType enumspb.TaskQueueType,
+	historyClient historyservice.HistoryServiceClient,
+) *taskQueueTaskMatcher {
+	return &taskQueueTaskMatcher{
+		filter:        filter,
+		taskType:      taskType,
+		historyClient: historyClient,
+		mutableStates: make(map[string]*persistencespb.WorkflowMutableState),
+	}
+}
+
+func (m *taskQueueTaskMatcher) matches(
+	ctx context.Context,
+	task *persistencespb.AllocatedTaskInfo,
+) (bool, error) {
+	filter := m.filter
+	if filter == nil {
+		return true, nil
+	}
+
+	data := task.GetData()
+	if filter.GetWorkflowId() != "" && data.GetWorkflowId() != filter.GetWorkflowId() {
+		return false, nil
+	}
+	if filter.GetRunId() != "" && data.GetRunId() != filter.GetRunId() {
+		return false, nil
+	}
+	if filter.GetPriorityKey() != 0 && data.GetPriority().GetPriorityKey() != filter.GetPriorityKey() {
+		return false, nil
+	}
+	if filter.GetFairnessKey() != "" && data.GetPriority().GetFairnessKey() != filter.GetFairnessKey() {
+		return false, nil
+	}
+	if filter.GetWorkflowType() == "" && filter.GetActivityType() == "" {
+		return true, nil
+	}
+	if filter.GetActivityType() != "" && m.taskType != enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		return false, nil
+	}
+
+	mutableState, err := m.getMutableState(ctx, data)
+	if err != nil {
+		return false, err
+	}
+	if mutableState == nil {
+		// workflow no longer exists, so its type is unknown
+		return false, nil
+	}
+	if filter.GetWorkflowType() != "" &&
+		mutableState.GetExecutionInfo().GetWorkflowTypeName() != filter.GetWorkflowType() {
+		return false, nil
+	}
+	if filter.GetActivityType() != "" {
+		activityInfo, ok := mutableState.GetActivityInfos()[data.GetScheduledEventId()]
+		if !ok || activityInfo.GetActivityType().GetName() != filter.GetActivityType() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (m *taskQueueTaskMatcher) getMutableState(
+	ctx context.Context,
+	data *persistencespb.TaskInfo,
+) (*persistencespb.WorkflowMutableState, error) {
+	if mutableState, ok := m.mutableStates[data.GetRunId()]; ok {
+		return mutableState, nil
+	}
+
+	resp, err := m.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: data.GetNamespaceId(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: data.GetWorkflowId(),
+			RunId:      data.GetRunId(),
+		},
+		SkipForceReload: true,
+	})
+	switch err.(type) {
+	case nil:
+		m.mutableStates[data.GetRunId()] = resp.GetDatabaseMutableState()
+		return resp.GetDatabaseMutableState(), nil
+	case *serviceerror.NotFound:
+		m.mutableStates[data.GetRunId()] = nil
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
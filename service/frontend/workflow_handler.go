@@ -68,6 +68,7 @@ import (
 	"go.temporal.io/server/common/tqid"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
+	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deployment"
@@ -3093,7 +3094,7 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 	if request.Schedule == nil {
 		request.Schedule = &schedulepb.Schedule{}
 	}
-	err = wh.canonicalizeScheduleSpec(request.Schedule)
+	schedule, calendarSets, dependencies, err := wh.canonicalizeSchedule(namespaceName, request.ScheduleId, request.Schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -3106,7 +3107,7 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 		return nil, err
 	}
 
-	if err = wh.validateStartWorkflowArgsForSchedule(namespaceName, request.GetSchedule().GetAction().GetStartWorkflow()); err != nil {
+	if err = wh.validateStartWorkflowArgsForSchedule(namespaceName, schedule.GetAction().GetStartWorkflow()); err != nil {
 		return nil, err
 	}
 
@@ -3116,7 +3117,7 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 
 	// Set up input to scheduler workflow
 	input := &schedulespb.StartScheduleArgs{
-		Schedule:     request.Schedule,
+		Schedule:     schedule,
 		InitialPatch: request.InitialPatch,
 		State: &schedulespb.InternalState{
 			Namespace:     namespaceName.String(),
@@ -3124,6 +3125,8 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 			ScheduleId:    request.ScheduleId,
 			ConflictToken: scheduler.InitialConflictToken,
 		},
+		CalendarSets: calendarSets,
+		Dependencies: dependencies,
 	}
 	inputPayloads, err := sdk.PreferProtoDataConverter.ToPayloads(input)
 	if err != nil {
@@ -3700,6 +3703,8 @@ func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *workfl
 	memo = wh.cleanScheduleMemo(memo)
 
 	scheduler.CleanSpec(queryResponse.Schedule.Spec)
+	scheduler.JoinCalendarSetReferences(queryResponse.Schedule.Spec, queryResponse.CalendarSets)
+	scheduler.JoinScheduleDependencies(queryResponse.Schedule.Spec, queryResponse.Dependencies)
 
 	return &workflowservice.DescribeScheduleResponse{
 		Schedule:         queryResponse.Schedule,
@@ -3795,7 +3800,7 @@ func (wh *WorkflowHandler) UpdateSchedule(
 	if request.Schedule == nil {
 		request.Schedule = &schedulepb.Schedule{}
 	}
-	err = wh.canonicalizeScheduleSpec(request.Schedule)
+	schedule, calendarSets, dependencies, err := wh.canonicalizeSchedule(namespaceName, request.ScheduleId, request.Schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -3810,14 +3815,16 @@ func (wh *WorkflowHandler) UpdateSchedule(
 
 	if err = wh.validateStartWorkflowArgsForSchedule(
 		namespaceName,
-		request.GetSchedule().GetAction().GetStartWorkflow(),
+		schedule.GetAction().GetStartWorkflow(),
 	); err != nil {
 		return nil, err
 	}
 
 	input := &schedulespb.FullUpdateRequest{
-		Schedule:         request.Schedule,
+		Schedule:         schedule,
 		SearchAttributes: request.SearchAttributes,
+		CalendarSets:     calendarSets,
+		Dependencies:     dependencies,
 	}
 	if len(request.ConflictToken) >= 8 {
 		input.ConflictToken = int64(binary.BigEndian.Uint64(request.ConflictToken))
@@ -5238,15 +5245,14 @@ func (wh *WorkflowHandler) validateCallbackURL(ns namespace.Name, rawURL string)
 	if !(u.Scheme == "http" || u.Scheme == "https") {
 		return status.Errorf(codes.InvalidArgument, "invalid url: unknown scheme: %v", u)
 	}
-	for _, cfg := range wh.config.CallbackEndpointConfigs(ns.String()) {
-		if cfg.Regexp.MatchString(u.Host) {
-			if u.Scheme == "http" && !cfg.AllowInsecure {
-				return status.Errorf(codes.InvalidArgument, "invalid url: callback address does not allow insecure connections: %v", u)
-			}
-			return nil
-		}
+	cfg, ok := callbacks.MatchAddressRule(wh.config.CallbackEndpointConfigs(ns.String()), u.Host)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid url: url does not match any configured callback address: %v", u)
+	}
+	if u.Scheme == "http" && !cfg.AllowInsecure {
+		return status.Errorf(codes.InvalidArgument, "invalid url: callback address does not allow insecure connections: %v", u)
 	}
-	return status.Errorf(codes.InvalidArgument, "invalid url: url does not match any configured callback address: %v", u)
+	return nil
 }
 
 type buildIdAndFlag interface {
@@ -5572,18 +5578,38 @@ func (wh *WorkflowHandler) validateWorkflowID(
 	return nil
 }
 
-func (wh *WorkflowHandler) canonicalizeScheduleSpec(schedule *schedulepb.Schedule) error {
-	if schedule.Spec == nil {
-		schedule.Spec = &schedulepb.ScheduleSpec{}
+// canonicalizeSchedule returns a copy of schedule with the canonical form of its spec, the
+// calendar set references of the spec with the days of the calendar sets of the namespace, and
+// the dependencies of the spec on other schedules.
+func (wh *WorkflowHandler) canonicalizeSchedule(
+	namespaceName namespace.Name,
+	scheduleID string,
+	schedule *schedulepb.Schedule,
+) (*schedulepb.Schedule, []*schedulespb.CalendarSetReference, []*schedulespb.ScheduleDependency, error) {
+	ns, err := wh.namespaceRegistry.GetNamespace(namespaceName)
+	if err != nil {
+		return nil, nil, nil, err
 	}
-	compiledSpec, err := wh.scheduleSpecBuilder.NewCompiledSpec(schedule.Spec)
+	spec, calendarSets, err := scheduler.SplitCalendarSetReferences(schedule.GetSpec(), ns.GetScheduleCalendarSet)
 	if err != nil {
-		return serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+		return nil, nil, nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
 	}
-	// This mutates a part of the request message, but it's safe even in the presence of
-	// retries (reusing the same message) because canonicalization is idempotent.
+	spec, dependencies, err := scheduler.SplitScheduleDependencies(spec, scheduleID)
+	if err != nil {
+		return nil, nil, nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+	}
+	if spec == nil {
+		spec = &schedulepb.ScheduleSpec{}
+	}
+	compiledSpec, err := wh.scheduleSpecBuilder.NewCompiledSpecWithCalendarSets(spec, calendarSets)
+	if err != nil {
+		return nil, nil, nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+	}
+	// Calendar set and dependency references are moved out of the spec, which isn't idempotent,
+	// so this doesn't mutate the request message: retries may reuse it.
+	schedule = common.CloneProto(schedule)
 	schedule.Spec = compiledSpec.CanonicalForm()
-	return nil
+	return schedule, calendarSets, dependencies, nil
 }
 
 func (wh *WorkflowHandler) decodeScheduleListInfo(memo *commonpb.Memo) *schedulepb.ScheduleListInfo {
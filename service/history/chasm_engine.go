@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/locks"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
@@ -28,10 +29,12 @@ import (
 
 type (
 	ChasmEngine struct {
-		entityCache     cache.Cache
-		shardController shard.Controller
-		registry        *chasm.Registry
-		config          *configs.Config
+		entityCache       cache.Cache
+		shardController   shard.Controller
+		registry          *chasm.Registry
+		config            *configs.Config
+		namespaceRegistry namespace.Registry
+		visibilityMgr     manager.VisibilityManager
 	}
 
 	newEntityParams struct {
@@ -67,11 +70,15 @@ func newChasmEngine(
 	entityCache cache.Cache,
 	registry *chasm.Registry,
 	config *configs.Config,
+	namespaceRegistry namespace.Registry,
+	visibilityMgr manager.VisibilityManager,
 ) *ChasmEngine {
 	return &ChasmEngine{
-		entityCache: entityCache,
-		registry:    registry,
-		config:      config,
+		entityCache:       entityCache,
+		registry:          registry,
+		config:            config,
+		namespaceRegistry: namespaceRegistry,
+		visibilityMgr:     visibilityMgr,
 	}
 }
 
@@ -251,6 +258,104 @@ func (e *ChasmEngine) PollComponent(
 	return nil, serviceerror.NewUnimplemented("PollComponent is not yet supported")
 }
 
+func (e *ChasmEngine) ListExecutions(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+	request *chasm.ListExecutionsRequest,
+) (*chasm.ListExecutionsResponse, error) {
+	if request.PageSize <= 0 {
+		return nil, serviceerror.NewInvalidArgument("page size must be positive")
+	}
+	namespaceEntry, query, err := e.archetypeQuery(ref, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.visibilityMgr.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   namespaceEntry.ID(),
+		Namespace:     namespaceEntry.Name(),
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+		Query:         query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]*chasm.ExecutionInfo, 0, len(resp.Executions))
+	for _, info := range resp.Executions {
+		execution := &chasm.ExecutionInfo{
+			BusinessID:           info.GetExecution().GetWorkflowId(),
+			EntityID:             info.GetExecution().GetRunId(),
+			Status:               info.GetStatus(),
+			StartTime:            info.GetStartTime().AsTime(),
+			StateTransitionCount: info.GetStateTransitionCount(),
+			SearchAttributes:     info.GetSearchAttributes().GetIndexedFields(),
+			Memo:                 info.GetMemo().GetFields(),
+		}
+		if info.GetCloseTime() != nil {
+			execution.CloseTime = info.GetCloseTime().AsTime()
+		}
+		executions = append(executions, execution)
+	}
+	return &chasm.ListExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+func (e *ChasmEngine) CountExecutions(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+	request *chasm.CountExecutionsRequest,
+) (*chasm.CountExecutionsResponse, error) {
+	namespaceEntry, query, err := e.archetypeQuery(ref, request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.visibilityMgr.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: namespaceEntry.ID(),
+		Namespace:   namespaceEntry.Name(),
+		Query:       query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*chasm.CountExecutionsGroup, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, &chasm.CountExecutionsGroup{
+			Values: group.GetGroupValues(),
+			Count:  group.GetCount(),
+		})
+	}
+	return &chasm.CountExecutionsResponse{
+		Count:  resp.Count,
+		Groups: groups,
+	}, nil
+}
+
+// archetypeQuery returns the namespace of the ref and the visibility query restricted to the archetype of the ref.
+func (e *ChasmEngine) archetypeQuery(
+	ref chasm.ComponentRef,
+	query string,
+) (*namespace.Namespace, string, error) {
+	namespaceEntry, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(ref.NamespaceID))
+	if err != nil {
+		return nil, "", err
+	}
+	archetype, err := ref.Archetype(e.registry)
+	if err != nil {
+		return nil, "", err
+	}
+	query, err = e.registry.ArchetypeQuery(archetype, query)
+	if err != nil {
+		return nil, "", err
+	}
+	return namespaceEntry, query, nil
+}
+
 func (e *ChasmEngine) constructTransitionOptions(
 	opts ...chasm.TransitionOption,
 ) chasm.TransitionOptions {
@@ -10,6 +10,8 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -20,6 +22,7 @@ import (
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/configs"
@@ -46,6 +49,7 @@ type chasmEngineSuite struct {
 	mockExecutionManager  *persistence.MockExecutionManager
 	mockNamespaceRegistry *namespace.MockRegistry
 	mockClusterMetadata   *cluster.MockMetadata
+	mockVisibilityManager *manager.MockVisibilityManager
 
 	namespaceEntry *namespace.Namespace
 	entityCache    wcache.Cache
@@ -87,6 +91,7 @@ func (s *chasmEngineSuite) SetupTest() {
 	s.mockExecutionManager = s.mockShard.Resource.ExecutionMgr
 	s.mockClusterMetadata = s.mockShard.Resource.ClusterMetadata
 	s.mockNamespaceRegistry = s.mockShard.Resource.NamespaceCache
+	s.mockVisibilityManager = manager.NewMockVisibilityManager(s.controller)
 	s.mockShardController.EXPECT().GetShardByID(gomock.Any()).Return(s.mockShard, nil).AnyTimes()
 	s.mockClusterMetadata.EXPECT().IsVersionFromSameCluster(cluster.TestCurrentClusterInitialFailoverVersion, tests.Version).Return(true).AnyTimes()
 	s.mockClusterMetadata.EXPECT().IsGlobalNamespaceEnabled().Return(true).AnyTimes()
@@ -115,6 +120,8 @@ func (s *chasmEngineSuite) SetupTest() {
 		s.entityCache,
 		s.registry,
 		s.config,
+		s.mockNamespaceRegistry,
+		s.mockVisibilityManager,
 	)
 	s.engine.SetShardController(s.mockShardController)
 }
@@ -543,6 +550,96 @@ func (s *chasmEngineSuite) TestReadComponent_Success() {
 	s.NoError(err)
 }
 
+func (s *chasmEngineSuite) TestListExecutions() {
+	tv := testvars.New(s.T())
+	tv = tv.WithRunID(tv.Any().RunID())
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+		},
+	)
+	startTime := time.Now().UTC()
+	memo := map[string]*commonpb.Payload{"key": tv.Any().Payload()}
+
+	s.mockVisibilityManager.EXPECT().ListWorkflowExecutions(gomock.Any(), &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   s.namespaceEntry.ID(),
+		Namespace:     s.namespaceEntry.Name(),
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+		Query:         "TemporalNamespaceDivision = 'TestLibrary.test_component' AND (ExecutionStatus = 'Running')",
+	}).Return(&manager.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{
+				Execution:            tv.WorkflowExecution(),
+				Status:               enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				StartTime:            timestamppb.New(startTime),
+				StateTransitionCount: 3,
+				Memo:                 &commonpb.Memo{Fields: memo},
+			},
+		},
+		NextPageToken: []byte("next"),
+	}, nil).Times(1)
+
+	resp, err := s.engine.ListExecutions(context.Background(), ref, &chasm.ListExecutionsRequest{
+		PageSize:      10,
+		NextPageToken: []byte("token"),
+		Query:         "ExecutionStatus = 'Running'",
+	})
+	s.NoError(err)
+	s.Equal([]byte("next"), resp.NextPageToken)
+	s.Len(resp.Executions, 1)
+	execution := resp.Executions[0]
+	s.Equal(tv.WorkflowID(), execution.BusinessID)
+	s.Equal(tv.RunID(), execution.EntityID)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, execution.Status)
+	s.Equal(startTime, execution.StartTime)
+	s.True(execution.CloseTime.IsZero())
+	s.Equal(int64(3), execution.StateTransitionCount)
+	s.Equal(memo, execution.Memo)
+}
+
+func (s *chasmEngineSuite) TestListExecutions_InvalidPageSize() {
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+		},
+	)
+
+	_, err := s.engine.ListExecutions(context.Background(), ref, &chasm.ListExecutionsRequest{})
+	var invalidArgErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgErr)
+}
+
+func (s *chasmEngineSuite) TestCountExecutions() {
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+		},
+	)
+	groupValues := []*commonpb.Payload{testvars.New(s.T()).Any().Payload()}
+
+	s.mockVisibilityManager.EXPECT().CountWorkflowExecutions(gomock.Any(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: s.namespaceEntry.ID(),
+		Namespace:   s.namespaceEntry.Name(),
+		Query:       "TemporalNamespaceDivision = 'TestLibrary.test_component' group by ExecutionStatus",
+	}).Return(&manager.CountWorkflowExecutionsResponse{
+		Count: 5,
+		Groups: []*workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			{GroupValues: groupValues, Count: 5},
+		},
+	}, nil).Times(1)
+
+	resp, err := s.engine.CountExecutions(context.Background(), ref, &chasm.CountExecutionsRequest{
+		Query: "GROUP BY ExecutionStatus",
+	})
+	s.NoError(err)
+	s.Equal(int64(5), resp.Count)
+	s.Len(resp.Groups, 1)
+	s.Equal(groupValues, resp.Groups[0].Values)
+	s.Equal(int64(5), resp.Groups[0].Count)
+}
+
 func (s *chasmEngineSuite) buildPersistenceMutableState(
 	key chasm.EntityKey,
 	componentState proto.Message,
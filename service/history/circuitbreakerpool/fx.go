@@ -2,9 +2,14 @@ package circuitbreakerpool
 
 import (
 	"fmt"
+	"slices"
 
+	"github.com/sony/gobreaker"
 	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tasks"
 	"go.uber.org/fx"
@@ -14,14 +19,25 @@ var Module = fx.Options(
 	fx.Provide(OutboundQueueCircuitBreakerPoolProvider),
 )
 
-type OutboundQueueCircuitBreakerPool struct {
-	*CircuitBreakerPool[tasks.TaskGroupNamespaceIDAndDestination]
-}
+type (
+	OutboundQueueCircuitBreakerPool struct {
+		*CircuitBreakerPool[tasks.TaskGroupNamespaceIDAndDestination]
+	}
+
+	// OutboundDestinationHealth is the health of the calls of one task group of a namespace to a destination.
+	OutboundDestinationHealth struct {
+		Key tasks.TaskGroupNamespaceIDAndDestination
+		DestinationHealth
+	}
+)
 
 func OutboundQueueCircuitBreakerPoolProvider(
 	namespaceRegistry namespace.Registry,
 	config *configs.Config,
+	timeSource clock.TimeSource,
+	endpointRegistry commonnexus.EndpointRegistry,
 ) *OutboundQueueCircuitBreakerPool {
+	overrides := newEndpointOverrides(endpointRegistry, timeSource)
 	return &OutboundQueueCircuitBreakerPool{
 		CircuitBreakerPool: NewCircuitBreakerPool(
 			func(key tasks.TaskGroupNamespaceIDAndDestination) circuitbreaker.TwoStepCircuitBreaker {
@@ -45,8 +61,34 @@ func OutboundQueueCircuitBreakerPoolProvider(
 				)
 				cb.UpdateSettings(initial)
 				_ = cancel // OnceMap never deletes anything. use this if we support deletion
-				return cb
+				// Only Nexus operations call endpoints, the destinations of the other task groups are URLs.
+				if key.TaskGroup != nexusoperations.TaskTypeInvocation &&
+					key.TaskGroup != nexusoperations.TaskTypeCancelation {
+					return newHealthTrackingCircuitBreaker(cb, key.Destination, nil, timeSource)
+				}
+				return newHealthTrackingCircuitBreaker(cb, key.Destination, overrides, timeSource)
 			},
 		),
 	}
 }
+
+// Health returns the health of the circuit breakers of the given destinations, or of all destinations if none are
+// given. Circuit breakers that are closed and had no calls completed recently are omitted.
+func (p *OutboundQueueCircuitBreakerPool) Health(destinations ...string) []OutboundDestinationHealth {
+	var result []OutboundDestinationHealth
+	p.m.Range(func(key tasks.TaskGroupNamespaceIDAndDestination, cb circuitbreaker.TwoStepCircuitBreaker) bool {
+		if len(destinations) > 0 && !slices.Contains(destinations, key.Destination) {
+			return true
+		}
+		tracker, ok := cb.(*healthTrackingCircuitBreaker)
+		if !ok {
+			return true
+		}
+		health, called := tracker.Health()
+		if called || health.State != gobreaker.StateClosed {
+			result = append(result, OutboundDestinationHealth{Key: key, DestinationHealth: health})
+		}
+		return true
+	})
+	return result
+}
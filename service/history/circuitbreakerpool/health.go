@@ -0,0 +1,229 @@
+package circuitbreakerpool
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/clock"
+	commonnexus "go.temporal.io/server/common/nexus"
+)
+
+const (
+	// healthSlotDuration is the granularity at which call outcomes are aggregated.
+	healthSlotDuration = time.Minute
+	// healthSlotCount is the number of slots that make up the health window.
+	healthSlotCount = 5
+	// overrideLookupTimeout bounds how long a call waits for the endpoint registry to load when checking for an
+	// override. The registry serves lookups from memory once loaded.
+	overrideLookupTimeout = time.Second
+)
+
+// LatencyBucketBounds are the inclusive upper bounds of the latency histogram of DestinationHealth. Latencies above the
+// last bound fall into an additional unbounded bucket.
+var LatencyBucketBounds = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+}
+
+type (
+	// DestinationHealth summarizes the calls completed within the health window by a single circuit breaker.
+	DestinationHealth struct {
+		State        gobreaker.State
+		SuccessCount int64
+		FailureCount int64
+		// LatencyBucketCounts has one entry per LatencyBucketBounds plus one for the unbounded bucket.
+		LatencyBucketCounts []int64
+	}
+
+	// Override is a manual circuit breaker override for a destination.
+	Override struct {
+		Override       enumsspb.CircuitBreakerOverride
+		ExpirationTime time.Time
+	}
+
+	// endpointOverrides reads the manual circuit breaker overrides stored on Nexus endpoints.
+	endpointOverrides struct {
+		registry   commonnexus.EndpointRegistry
+		timeSource clock.TimeSource
+	}
+
+	healthSlot struct {
+		start               time.Time
+		successCount        int64
+		failureCount        int64
+		latencyBucketCounts []int64
+	}
+
+	// healthTrackingCircuitBreaker records the outcome and latency of calls allowed by the wrapped circuit breaker
+	// and applies manual overrides of its destination.
+	healthTrackingCircuitBreaker struct {
+		circuitbreaker.TwoStepCircuitBreaker
+		destination string
+		// overrides is nil for destinations that aren't Nexus endpoints.
+		overrides  *endpointOverrides
+		timeSource clock.TimeSource
+
+		mu    sync.Mutex
+		slots [healthSlotCount]healthSlot
+	}
+)
+
+var _ circuitbreaker.TwoStepCircuitBreaker = (*healthTrackingCircuitBreaker)(nil)
+
+// StateToProto converts a circuit breaker state to its API representation.
+func StateToProto(state gobreaker.State) enumsspb.CircuitBreakerState {
+	switch state {
+	case gobreaker.StateClosed:
+		return enumsspb.CIRCUIT_BREAKER_STATE_CLOSED
+	case gobreaker.StateHalfOpen:
+		return enumsspb.CIRCUIT_BREAKER_STATE_HALF_OPEN
+	case gobreaker.StateOpen:
+		return enumsspb.CIRCUIT_BREAKER_STATE_OPEN
+	default:
+		return enumsspb.CIRCUIT_BREAKER_STATE_UNSPECIFIED
+	}
+}
+
+func newEndpointOverrides(registry commonnexus.EndpointRegistry, timeSource clock.TimeSource) *endpointOverrides {
+	return &endpointOverrides{
+		registry:   registry,
+		timeSource: timeSource,
+	}
+}
+
+// get returns the unexpired override of an endpoint. Endpoints that can't be looked up, e.g. because they were
+// deleted or Nexus is disabled, have no override.
+func (o *endpointOverrides) get(endpointName string) (Override, bool) {
+	if o == nil {
+		return Override{}, false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), overrideLookupTimeout)
+	defer cancel()
+	// The registry is global to the cluster and ignores the namespace ID.
+	entry, err := o.registry.GetByName(ctx, "", endpointName)
+	if err != nil {
+		return Override{}, false
+	}
+	override := entry.GetEndpoint().GetCircuitBreakerOverride()
+	if override.GetOverride() == enumsspb.CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED ||
+		!o.timeSource.Now().Before(override.GetExpirationTime().AsTime()) {
+		return Override{}, false
+	}
+	return Override{
+		Override:       override.GetOverride(),
+		ExpirationTime: override.GetExpirationTime().AsTime(),
+	}, true
+}
+
+func newHealthTrackingCircuitBreaker(
+	cb circuitbreaker.TwoStepCircuitBreaker,
+	destination string,
+	overrides *endpointOverrides,
+	timeSource clock.TimeSource,
+) *healthTrackingCircuitBreaker {
+	return &healthTrackingCircuitBreaker{
+		TwoStepCircuitBreaker: cb,
+		destination:           destination,
+		overrides:             overrides,
+		timeSource:            timeSource,
+	}
+}
+
+func (c *healthTrackingCircuitBreaker) State() gobreaker.State {
+	if override, ok := c.overrides.get(c.destination); ok {
+		if override.Override == enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN {
+			return gobreaker.StateOpen
+		}
+		return gobreaker.StateClosed
+	}
+	return c.TwoStepCircuitBreaker.State()
+}
+
+func (c *healthTrackingCircuitBreaker) Allow() (done func(success bool), err error) {
+	override, overridden := c.overrides.get(c.destination)
+	if overridden && override.Override == enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN {
+		return nil, gobreaker.ErrOpenState
+	}
+	// A forced closed circuit breaker bypasses the wrapped one, so it doesn't trip or limit half open calls.
+	innerDone := func(bool) {}
+	if !overridden {
+		innerDone, err = c.TwoStepCircuitBreaker.Allow()
+		if err != nil {
+			return nil, err
+		}
+	}
+	start := c.timeSource.Now()
+	return func(success bool) {
+		innerDone(success)
+		c.record(success, c.timeSource.Now().Sub(start))
+	}, nil
+}
+
+func (c *healthTrackingCircuitBreaker) record(success bool, latency time.Duration) {
+	now := c.timeSource.Now()
+	slotStart := now.Truncate(healthSlotDuration)
+	bucket := len(LatencyBucketBounds)
+	for i, bound := range LatencyBucketBounds {
+		if latency <= bound {
+			bucket = i
+			break
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	slot := &c.slots[slotStart.Unix()/int64(healthSlotDuration/time.Second)%healthSlotCount]
+	if !slot.start.Equal(slotStart) {
+		*slot = healthSlot{
+			start:               slotStart,
+			latencyBucketCounts: make([]int64, len(LatencyBucketBounds)+1),
+		}
+	}
+	if success {
+		slot.successCount++
+	} else {
+		slot.failureCount++
+	}
+	slot.latencyBucketCounts[bucket]++
+}
+
+// Health returns the state of the circuit breaker and the calls completed within the health window, and whether any
+// call was completed.
+func (c *healthTrackingCircuitBreaker) Health() (DestinationHealth, bool) {
+	windowStart := c.timeSource.Now().Truncate(healthSlotDuration).Add(-(healthSlotCount - 1) * healthSlotDuration)
+	health := DestinationHealth{
+		State:               c.State(),
+		LatencyBucketCounts: make([]int64, len(LatencyBucketBounds)+1),
+	}
+	called := false
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, slot := range c.slots {
+		if slot.start.Before(windowStart) {
+			continue
+		}
+		called = true
+		health.SuccessCount += slot.successCount
+		health.FailureCount += slot.failureCount
+		for i, count := range slot.latencyBucketCounts {
+			health.LatencyBucketCounts[i] += count
+		}
+	}
+	return health, called
+}
@@ -0,0 +1,132 @@
+package circuitbreakerpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/circuitbreaker"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testEndpointRegistry serves endpoints from a map, keyed by name.
+type testEndpointRegistry map[string]*persistencespb.NexusEndpointEntry
+
+var _ commonnexus.EndpointRegistry = testEndpointRegistry(nil)
+
+func (r testEndpointRegistry) GetByName(_ context.Context, _ namespace.ID, name string) (*persistencespb.NexusEndpointEntry, error) {
+	entry, ok := r[name]
+	if !ok {
+		return nil, serviceerror.NewNotFoundf("could not find Nexus endpoint by name: %v", name)
+	}
+	return entry, nil
+}
+
+func (r testEndpointRegistry) GetByID(context.Context, string) (*persistencespb.NexusEndpointEntry, error) {
+	return nil, serviceerror.NewUnimplemented("not implemented")
+}
+
+func (r testEndpointRegistry) StartLifecycle() {}
+
+func (r testEndpointRegistry) StopLifecycle() {}
+
+func (r testEndpointRegistry) setOverride(name string, override enumsspb.CircuitBreakerOverride, expirationTime time.Time) {
+	r[name] = &persistencespb.NexusEndpointEntry{
+		Endpoint: &persistencespb.NexusEndpoint{
+			Spec: &persistencespb.NexusEndpointSpec{Name: name},
+			CircuitBreakerOverride: &persistencespb.NexusEndpointCircuitBreakerOverride{
+				Override:       override,
+				ExpirationTime: timestamppb.New(expirationTime),
+			},
+		},
+	}
+}
+
+func newTestCircuitBreaker(timeSource clock.TimeSource, overrides *endpointOverrides) *healthTrackingCircuitBreaker {
+	cb := circuitbreaker.NewTwoStepCircuitBreakerWithDynamicSettings(circuitbreaker.Settings{
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= 2
+		},
+	})
+	cb.UpdateSettings(dynamicconfig.CircuitBreakerSettings{Timeout: time.Hour})
+	return newHealthTrackingCircuitBreaker(cb, "endpoint", overrides, timeSource)
+}
+
+func call(t *testing.T, timeSource *clock.EventTimeSource, cb *healthTrackingCircuitBreaker, latency time.Duration, success bool) {
+	done, err := cb.Allow()
+	require.NoError(t, err)
+	timeSource.Advance(latency)
+	done(success)
+}
+
+func TestHealthTrackingCircuitBreaker_Health(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cb := newTestCircuitBreaker(timeSource, nil)
+
+	_, called := cb.Health()
+	require.False(t, called)
+
+	call(t, timeSource, cb, 3*time.Millisecond, true)
+	call(t, timeSource, cb, 200*time.Millisecond, true)
+	timeSource.Advance(time.Minute)
+	call(t, timeSource, cb, 2*time.Minute, false)
+
+	health, called := cb.Health()
+	require.True(t, called)
+	require.Equal(t, gobreaker.StateClosed, health.State)
+	require.Equal(t, int64(2), health.SuccessCount)
+	require.Equal(t, int64(1), health.FailureCount)
+	expectedBuckets := make([]int64, len(LatencyBucketBounds)+1)
+	expectedBuckets[0] = 1                        // 3ms
+	expectedBuckets[5] = 1                        // 200ms
+	expectedBuckets[len(LatencyBucketBounds)] = 1 // 2m
+	require.Equal(t, expectedBuckets, health.LatencyBucketCounts)
+
+	// Calls age out of the window.
+	timeSource.Advance(healthSlotCount * healthSlotDuration)
+	_, called = cb.Health()
+	require.False(t, called)
+}
+
+func TestHealthTrackingCircuitBreaker_Overrides(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	registry := testEndpointRegistry{}
+	cb := newTestCircuitBreaker(timeSource, newEndpointOverrides(registry, timeSource))
+
+	registry.setOverride("endpoint", enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN, timeSource.Now().Add(time.Hour))
+	require.Equal(t, gobreaker.StateOpen, cb.State())
+	_, err := cb.Allow()
+	require.ErrorIs(t, err, gobreaker.ErrOpenState)
+
+	// Failures don't trip a forced closed circuit breaker.
+	registry.setOverride("endpoint", enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED, timeSource.Now().Add(time.Hour))
+	for range 3 {
+		call(t, timeSource, cb, time.Millisecond, false)
+	}
+	require.Equal(t, gobreaker.StateClosed, cb.State())
+
+	// The wrapped circuit breaker takes over once the override expires.
+	timeSource.Advance(time.Hour)
+	call(t, timeSource, cb, time.Millisecond, false)
+	call(t, timeSource, cb, time.Millisecond, false)
+	require.Equal(t, gobreaker.StateOpen, cb.State())
+
+	registry.setOverride("endpoint", enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED, timeSource.Now().Add(time.Hour))
+	require.Equal(t, gobreaker.StateClosed, cb.State())
+	registry.setOverride("endpoint", enumsspb.CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED, timeSource.Now().Add(time.Hour))
+	require.Equal(t, gobreaker.StateOpen, cb.State())
+
+	// Deleted endpoints have no override.
+	registry.setOverride("endpoint", enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED, timeSource.Now().Add(time.Hour))
+	delete(registry, "endpoint")
+	require.Equal(t, gobreaker.StateOpen, cb.State())
+}
@@ -33,6 +33,7 @@ type Config struct {
 	SecondaryVisibilityWritingMode          dynamicconfig.StringPropertyFn
 	VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityQueryCostLimit                dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityAllowList                     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	SuppressErrorSetSystemSearchAttribute   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 
@@ -309,6 +310,9 @@ type Config struct {
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	VisibilityProcessorRelocateAttributesMinBlobSize      dynamicconfig.IntPropertyFnWithNamespaceFilter
 	VisibilityQueueMaxReaderCount                         dynamicconfig.IntPropertyFn
+	VisibilityIndexCloseFailure                           dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	VisibilityIndexedMemoFields                           dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+	VisibilityFullTextMaxBytes                            dynamicconfig.IntPropertyFnWithNamespaceFilter
 
 	// Disable fetching memo and search attributes from visibility in the event that they were removed
 	// from the mutable state in the close execution visibility task clean up.
@@ -405,6 +409,7 @@ func NewConfig(
 		SecondaryVisibilityWritingMode:          dynamicconfig.SecondaryVisibilityWritingMode.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
+		VisibilityQueryCostLimit:                dynamicconfig.VisibilityQueryCostLimit.Get(dc),
 		VisibilityAllowList:                     dynamicconfig.VisibilityAllowList.Get(dc),
 		SuppressErrorSetSystemSearchAttribute:   dynamicconfig.SuppressErrorSetSystemSearchAttribute.Get(dc),
 
@@ -631,6 +636,9 @@ func NewConfig(
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup.Get(dc),
 		VisibilityProcessorRelocateAttributesMinBlobSize:      dynamicconfig.VisibilityProcessorRelocateAttributesMinBlobSize.Get(dc),
 		VisibilityQueueMaxReaderCount:                         dynamicconfig.VisibilityQueueMaxReaderCount.Get(dc),
+		VisibilityIndexCloseFailure:                           dynamicconfig.VisibilityIndexCloseFailure.Get(dc),
+		VisibilityIndexedMemoFields:                           dynamicconfig.VisibilityIndexedMemoFields.Get(dc),
+		VisibilityFullTextMaxBytes:                            dynamicconfig.VisibilityFullTextMaxBytes.Get(dc),
 
 		DisableFetchRelocatableAttributesFromVisibility: dynamicconfig.DisableFetchRelocatableAttributesFromVisibility.Get(dc),
 
@@ -47,6 +47,7 @@ import (
 	"go.temporal.io/server/service/history/api/forcedeleteworkflowexecution"
 	"go.temporal.io/server/service/history/api/getdlqtasks"
 	"go.temporal.io/server/service/history/api/listqueues"
+	"go.temporal.io/server/service/history/circuitbreakerpool"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -57,6 +58,7 @@ import (
 	"go.uber.org/fx"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type (
@@ -94,6 +96,7 @@ type (
 		taskCategoryRegistry         tasks.TaskCategoryRegistry
 		dlqMetricsEmitter            *persistence.DLQMetricsEmitter
 		chasmEngine                  chasm.Engine
+		outboundQueueCBPool          *circuitbreakerpool.OutboundQueueCircuitBreakerPool
 
 		replicationTaskFetcherFactory    replication.TaskFetcherFactory
 		replicationTaskConverterProvider replication.SourceTaskConverterProvider
@@ -129,6 +132,7 @@ type (
 		TaskCategoryRegistry         tasks.TaskCategoryRegistry
 		DLQMetricsEmitter            *persistence.DLQMetricsEmitter
 		ChasmEngine                  chasm.Engine
+		OutboundQueueCBPool          *circuitbreakerpool.OutboundQueueCircuitBreakerPool
 
 		ReplicationTaskFetcherFactory   replication.TaskFetcherFactory
 		ReplicationTaskConverterFactory replication.SourceTaskConverterProvider
@@ -245,6 +249,35 @@ func (h *Handler) checkHistoryHealthSignals() *historyservice.DeepHealthCheckRes
 	return nil
 }
 
+// GetOutboundDestinationHealth returns the health of the calls made by the outbound queue of this host
+func (h *Handler) GetOutboundDestinationHealth(
+	_ context.Context,
+	request *historyservice.GetOutboundDestinationHealthRequest,
+) (_ *historyservice.GetOutboundDestinationHealthResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+
+	response := &historyservice.GetOutboundDestinationHealthResponse{}
+	for _, health := range h.outboundQueueCBPool.Health(request.GetDestinations()...) {
+		buckets := make([]*historyservice.GetOutboundDestinationHealthResponse_LatencyBucket, len(health.LatencyBucketCounts))
+		for i, count := range health.LatencyBucketCounts {
+			buckets[i] = &historyservice.GetOutboundDestinationHealthResponse_LatencyBucket{Count: count}
+			if i < len(circuitbreakerpool.LatencyBucketBounds) {
+				buckets[i].UpperBound = durationpb.New(circuitbreakerpool.LatencyBucketBounds[i])
+			}
+		}
+		response.Destinations = append(response.Destinations, &historyservice.GetOutboundDestinationHealthResponse_Destination{
+			NamespaceId:         health.Key.NamespaceID,
+			TaskGroup:           health.Key.TaskGroup,
+			Destination:         health.Key.Destination,
+			CircuitBreakerState: circuitbreakerpool.StateToProto(health.State),
+			SuccessCount:        health.SuccessCount,
+			FailureCount:        health.FailureCount,
+			LatencyBuckets:      buckets,
+		})
+	}
+	return response, nil
+}
+
 // IsWorkflowTaskValid - whether workflow task is still valid
 func (h *Handler) IsWorkflowTaskValid(ctx context.Context, request *historyservice.IsWorkflowTaskValidRequest) (_ *historyservice.IsWorkflowTaskValidResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
@@ -156,4 +157,5 @@ type unusedDependencies struct {
 	persistence.HistoryTaskQueueManager
 	cache.Cache
 	chasm.Engine
+	commonnexus.EndpointRegistry
 }
@@ -577,7 +577,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		Priority:               priority,
 	})
 	if err != nil {
-		return err
+		return discardIfTaskQueueDraining(task, err, t.logger)
 	}
 
 	if useWfBuildId {
@@ -2,6 +2,7 @@ package history
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	commonpb "go.temporal.io/api/common/v1"
@@ -21,6 +22,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/deletemanager"
@@ -124,7 +126,7 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 	}
 
 	if err != nil {
-		return err
+		return discardIfTaskQueueDraining(task, err, t.logger)
 	}
 
 	if directive.GetUseAssignmentRules() == nil {
@@ -177,7 +179,7 @@ func (t *transferQueueTaskExecutorBase) pushWorkflowTask(
 	}
 
 	if err != nil {
-		return err
+		return discardIfTaskQueueDraining(task, err, t.logger)
 	}
 
 	if directive.GetUseAssignmentRules() == nil {
@@ -300,3 +302,15 @@ func (t *transferQueueTaskExecutorBase) isCloseExecutionTaskPending(ms historyi.
 	}
 	return !queues.IsTaskAcked(fakeCloseTransferTask, transferQueueState)
 }
+
+// discardIfTaskQueueDraining discards a task rejected by Matching because an operator set its task
+// queue to drain. Retrying doesn't help until the drain is removed. The activity or workflow task
+// stays scheduled: activities recover through their timeouts, workflow tasks have to be reset.
+func discardIfTaskQueueDraining(task tasks.Task, err error, logger log.Logger) error {
+	var drainingErr *serviceerrors.TaskQueueDraining
+	if errors.As(err, &drainingErr) {
+		tasks.InitializeLogger(task, logger).Warn("Discarded task rejected by draining task queue", tag.Error(err))
+		return consts.ErrTaskDiscarded
+	}
+	return err
+}
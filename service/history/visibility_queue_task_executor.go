@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
@@ -36,6 +37,9 @@ type (
 		ensureCloseBeforeDelete       dynamicconfig.BoolPropertyFn
 		enableCloseWorkflowCleanup    dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		relocateAttributesMinBlobSize dynamicconfig.IntPropertyFnWithNamespaceFilter
+		indexCloseFailure             dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		indexedMemoFields             dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+		fullTextMaxBytes              dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -50,6 +54,9 @@ func newVisibilityQueueTaskExecutor(
 	ensureCloseBeforeDelete dynamicconfig.BoolPropertyFn,
 	enableCloseWorkflowCleanup dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	relocateAttributesMinBlobSize dynamicconfig.IntPropertyFnWithNamespaceFilter,
+	indexCloseFailure dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	indexedMemoFields dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string],
+	fullTextMaxBytes dynamicconfig.IntPropertyFnWithNamespaceFilter,
 ) queues.Executor {
 	return &visibilityQueueTaskExecutor{
 		shardContext:   shardContext,
@@ -61,6 +68,9 @@ func newVisibilityQueueTaskExecutor(
 		ensureCloseBeforeDelete:       ensureCloseBeforeDelete,
 		enableCloseWorkflowCleanup:    enableCloseWorkflowCleanup,
 		relocateAttributesMinBlobSize: relocateAttributesMinBlobSize,
+		indexCloseFailure:             indexCloseFailure,
+		indexedMemoFields:             indexedMemoFields,
+		fullTextMaxBytes:              fullTextMaxBytes,
 	}
 }
 
@@ -163,6 +173,7 @@ func (t *visibilityQueueTaskExecutor) processStartExecution(
 		mutableState.GetExecutionInfo().Memo,
 		mutableState.GetExecutionInfo().SearchAttributes,
 	)
+	t.addMemoText(requestBase)
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -211,6 +222,7 @@ func (t *visibilityQueueTaskExecutor) processUpsertExecution(
 		mutableState.GetExecutionInfo().Memo,
 		mutableState.GetExecutionInfo().SearchAttributes,
 	)
+	t.addMemoText(requestBase)
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -272,6 +284,13 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	if err != nil {
 		return err
 	}
+	// The full-text search attributes are derived from the mutable state and are not part of the search attributes
+	// that are cleaned up, so the decision is made before adding them.
+	runCleanUp := t.needRunCleanUp(requestBase)
+	t.addMemoText(requestBase)
+	if err := t.addCloseFailure(ctx, requestBase, mutableState); err != nil {
+		return err
+	}
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
@@ -288,7 +307,7 @@ func (t *visibilityQueueTaskExecutor) processCloseExecution(
 	// Therefore, ctx timeout might be already expired
 	// and parentCtx (which doesn't have timeout) must be used everywhere bellow.
 
-	if t.needRunCleanUp(requestBase) {
+	if runCleanUp {
 		return t.cleanupExecutionInfo(parentCtx, task)
 	}
 	return nil
@@ -481,6 +500,26 @@ func (t *visibilityQueueTaskExecutor) getVisibilityRequestBase(
 	}
 }
 
+// addMemoText indexes the values of the memo fields configured for the namespace in the TemporalMemoText search
+// attribute.
+func (t *visibilityQueueTaskExecutor) addMemoText(request *manager.VisibilityRequestBase) {
+	nsName := request.Namespace.String()
+	visibility.AddMemoText(request, t.indexedMemoFields(nsName), t.fullTextMaxBytes(nsName))
+}
+
+// addCloseFailure indexes the failure of failed, terminated and timed out workflows if enabled for the namespace.
+func (t *visibilityQueueTaskExecutor) addCloseFailure(
+	ctx context.Context,
+	request *manager.VisibilityRequestBase,
+	mutableState historyi.MutableState,
+) error {
+	nsName := request.Namespace.String()
+	if !t.indexCloseFailure(nsName) {
+		return nil
+	}
+	return visibility.AddCloseFailure(ctx, request, mutableState.GetCompletionEvent, t.fullTextMaxBytes(nsName))
+}
+
 func (t *visibilityQueueTaskExecutor) getClosedVisibilityRequest(
 	ctx context.Context,
 	base *manager.VisibilityRequestBase,
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -34,6 +35,7 @@ import (
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/telemetry"
 	"go.temporal.io/server/common/testing/protomock"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/events"
@@ -71,6 +73,8 @@ type (
 		visibilityQueueTaskExecutor queues.Executor
 
 		enableCloseWorkflowCleanup bool
+		indexCloseFailure          bool
+		indexedMemoFields          []string
 	}
 )
 
@@ -157,6 +161,8 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 	s.mockShard.SetEngineForTesting(h)
 
 	s.enableCloseWorkflowCleanup = false
+	s.indexCloseFailure = false
+	s.indexedMemoFields = nil
 	s.visibilityQueueTaskExecutor = newVisibilityQueueTaskExecutor(
 		s.mockShard,
 		s.workflowCache,
@@ -166,6 +172,9 @@ func (s *visibilityQueueTaskExecutorSuite) SetupTest() {
 		config.VisibilityProcessorEnsureCloseBeforeDelete,
 		func(_ string) bool { return s.enableCloseWorkflowCleanup },
 		config.VisibilityProcessorRelocateAttributesMinBlobSize,
+		func(_ string) bool { return s.indexCloseFailure },
+		func(_ string) []string { return s.indexedMemoFields },
+		config.VisibilityFullTextMaxBytes,
 	)
 }
 
@@ -258,6 +267,81 @@ func (s *visibilityQueueTaskExecutorSuite) TestProcessCloseExecution() {
 	s.Nil(resp.ExecutionErr)
 }
 
+func (s *visibilityQueueTaskExecutorSuite) TestProcessCloseExecution_FullTextSearchAttributes() {
+	s.indexCloseFailure = true
+	s.indexedMemoFields = []string{"customer", "order", "missing"}
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      uuid.New(),
+	}
+	taskQueueName := "some random task queue"
+	orderPayload, err := payload.Encode(map[string]string{"item": "Blue-Widget"})
+	s.NoError(err)
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+		"customer": payload.EncodeString("ACME Corp."),
+		"order":    orderPayload,
+		"secret":   payload.EncodeString("not indexed"),
+	}}
+
+	mutableState := workflow.TestGlobalMutableState(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetWorkflowId(), execution.GetRunId())
+	_, err = mutableState.AddWorkflowExecutionStartedEvent(
+		execution,
+		&historyservice.StartWorkflowExecutionRequest{
+			Attempt:     1,
+			NamespaceId: s.namespaceID.String(),
+			StartRequest: &workflowservice.StartWorkflowExecutionRequest{
+				WorkflowType:             &commonpb.WorkflowType{Name: "some random workflow type"},
+				TaskQueue:                &taskqueuepb.TaskQueue{Name: taskQueueName},
+				WorkflowExecutionTimeout: durationpb.New(2 * time.Second),
+				WorkflowTaskTimeout:      durationpb.New(1 * time.Second),
+				Memo:                     memo,
+			},
+		},
+	)
+	s.NoError(err)
+
+	wt := addWorkflowTaskScheduledEvent(mutableState)
+	event := addWorkflowTaskStartedEvent(mutableState, wt.ScheduledEventID, taskQueueName, uuid.New())
+	wt.StartedEventID = event.GetEventId()
+	event = addWorkflowTaskCompletedEvent(&s.Suite, mutableState, wt.ScheduledEventID, wt.StartedEventID, "some random identity")
+	event = addFailWorkflowEvent(mutableState, event.GetEventId(), &failurepb.Failure{
+		Message: "Payment declined",
+		FailureInfo: &failurepb.Failure_ApplicationFailureInfo{ApplicationFailureInfo: &failurepb.ApplicationFailureInfo{
+			Type: "PaymentError",
+		}},
+		Cause: &failurepb.Failure{Message: "card_expired: 2024-01"},
+	}, enumspb.RETRY_STATE_RETRY_POLICY_NOT_SET)
+
+	visibilityTask := &tasks.CloseExecutionVisibilityTask{
+		WorkflowKey: definition.NewWorkflowKey(
+			s.namespaceID.String(),
+			execution.GetWorkflowId(),
+			execution.GetRunId(),
+		),
+		VisibilityTimestamp: time.Now().UTC(),
+		Version:             s.version,
+		TaskID:              int64(59),
+	}
+
+	persistenceMutableState := s.createPersistenceMutableState(mutableState, event.GetEventId(), event.GetVersion())
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_FAILED, request.Status)
+			protorequire.ProtoEqual(s.T(), memo, request.Memo)
+			searchAttributes, err := searchattribute.Decode(request.SearchAttributes, &searchattribute.NameTypeMap{}, false)
+			s.NoError(err)
+			s.Equal("payment declined card expired 2024 01", searchAttributes[searchattribute.TemporalCloseFailureMessage])
+			s.Equal("PaymentError", searchAttributes[searchattribute.TemporalCloseFailureType])
+			s.Equal("acme corp item blue widget", searchAttributes[searchattribute.TemporalMemoText])
+			return nil
+		},
+	)
+
+	s.NoError(s.execute(visibilityTask))
+}
+
 func (s *visibilityQueueTaskExecutorSuite) TestProcessCloseExecutionWithWorkflowClosedCleanup() {
 	s.enableCloseWorkflowCleanup = true
 
@@ -298,6 +298,52 @@ func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_IncrementedBySpool
 		"backlog count should match the number of tasks")
 }
 
+func (s *BacklogManagerTestSuite) TestFairnessKeyBacklog_DecrementedByDroppedTask() {
+	if !s.fairness {
+		s.T().Skip("fairness key stats are only tracked by the fair backlog manager")
+	}
+	blm := s.blm.(*fairBacklogManagerImpl)
+	blm.Start()
+	defer blm.Stop()
+	s.NoError(blm.WaitUntilInitialized(context.Background()))
+
+	var lock sync.Mutex
+	var tasks []*internalTask
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).DoAndReturn(func(task *internalTask) error {
+		lock.Lock()
+		defer lock.Unlock()
+		tasks = append(tasks, task)
+		return nil
+	}).AnyTimes()
+	for range 3 {
+		s.NoError(blm.SpoolTask(&persistencespb.TaskInfo{
+			ExpiryTime: timestamp.TimeNowPtrUtcAddSeconds(3000),
+			CreateTime: timestamp.TimeNowPtrUtc(),
+			Priority:   &commonpb.Priority{FairnessKey: "key"},
+		}))
+	}
+	s.Eventually(func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(tasks) == 3
+	}, 10*time.Second, time.Millisecond)
+
+	byBacklog, _ := blm.keyTracker.top(10)
+	s.Len(byBacklog, 1)
+	s.EqualValues(3, byBacklog[0].GetApproximateBacklogCount())
+
+	// one task is dispatched, the others expire or are rejected by history
+	tasks[0].finish(nil, true)
+	tasks[1].finish(nil, false)
+	tasks[2].finish(nil, false)
+
+	byBacklog, byDispatchRate := blm.keyTracker.top(10)
+	s.Empty(byBacklog)
+	s.Len(byDispatchRate, 1)
+	// dropped tasks are not counted as dispatched
+	s.EqualValues(1, byDispatchRate[0].GetDispatchRate())
+}
+
 func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_IncrementedBySpoolTask_Unavailable() {
 	if s.fairness {
 		// fairBacklogManager is smarter about backlog count: it can sometimes reset
@@ -48,8 +48,13 @@ var (
 		"/temporal.server.api.matchingservice.v1.MatchingService/SyncDeploymentUserData":                 1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/RecordWorkerHeartbeat":                  1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/ListWorkers":                            1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/CountWorkers":                           1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueueConfig":                  1,
 		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeWorker":                         1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateFairnessWeights":                  1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/DescribeFairnessKeys":                   1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateTaskQueuePause":                   1,
+		"/temporal.server.api.matchingservice.v1.MatchingService/UpdateBlockedPollers":                   1,
 	}
 
 	APIPrioritiesOrdered = []int{0, 1}
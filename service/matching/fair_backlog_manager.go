@@ -14,6 +14,7 @@ import (
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/future"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -30,6 +31,7 @@ type (
 		tqCtx      context.Context
 		db         *taskQueueDB
 		taskWriter *fairTaskWriter
+		keyTracker *fairnessKeyTracker
 
 		subqueueLock        sync.Mutex
 		subqueues           []*fairTaskReader // subqueue index -> fairTaskReader
@@ -76,6 +78,7 @@ func newFairBacklogManager(
 		logger:              logger,
 		throttledLogger:     throttledLogger,
 		initializedError:    future.NewFuture[struct{}](),
+		keyTracker:          newFairnessKeyTracker(clock.NewRealTimeSource(), counterFactory),
 	}
 	bmg.taskWriter = newFairTaskWriter(bmg, counterFactory)
 	return bmg
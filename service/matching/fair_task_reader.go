@@ -130,6 +130,7 @@ func (tr *fairTaskReader) completeTask(task *internalTask, res taskResponse) {
 	if err == nil {
 		tr.completeTaskLocked(task)
 		tr.lock.Unlock()
+		tr.backlogMgr.keyTracker.recordCompleted(task.event.AllocatedTaskInfo, !res.dropped)
 		return
 	}
 
@@ -158,6 +159,7 @@ func (tr *fairTaskReader) completeTask(task *internalTask, res taskResponse) {
 	}
 
 	// If we re-spooled successfully, remove the old version of the task.
+	tr.backlogMgr.keyTracker.recordCompleted(task.event.AllocatedTaskInfo, false)
 	tr.lock.Lock()
 	defer tr.lock.Unlock()
 	tr.completeTaskLocked(task)
@@ -263,6 +265,7 @@ func (tr *fairTaskReader) readTaskBatch(readLevel fairLevel, loadedTasks int) er
 	tasks := slices.DeleteFunc(res.Tasks, func(t *persistencespb.AllocatedTaskInfo) bool {
 		if IsTaskExpired(t) {
 			metrics.ExpiredTasksPerTaskQueueCounter.With(tr.backlogMgr.metricsHandler).Record(1, metrics.TaskExpireStageReadTag)
+			tr.backlogMgr.keyTracker.recordCompleted(t, false)
 			return true
 		}
 		return false
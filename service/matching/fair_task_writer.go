@@ -113,13 +113,12 @@ func (w *fairTaskWriter) allocTaskIDs(reqs []*writeTaskRequest) error {
 }
 
 func (w *fairTaskWriter) pickPasses(tasks []*writeTaskRequest, bases []fairLevel) {
-	// TODO(fairness): get this from config
-	var overrides fairnessWeightOverrides
+	weights := w.backlogMgr.keyTracker.getWeights()
 
 	for i, task := range tasks {
 		pri := task.taskInfo.Priority
 		key := pri.GetFairnessKey()
-		weight := getEffectiveWeight(overrides, pri)
+		weight := getEffectiveWeight(weights, pri)
 		inc := max(1, int64(strideFactor/weight))
 		base := bases[task.subqueue].pass
 		cntr := w.counters[task.subqueue]
@@ -198,6 +197,10 @@ func (w *fairTaskWriter) writeBatch(reqs []*writeTaskRequest) (retErr error) {
 	w.pickPasses(reqs, bases)
 	resp, err := w.db.CreateFairTasks(w.backlogMgr.tqCtx, reqs)
 	if err == nil {
+		// record before the tasks can be read and completed
+		for _, tasks := range resp {
+			w.backlogMgr.keyTracker.recordWritten(tasks)
+		}
 		w.backlogMgr.wroteNewTasks(resp) // must be called before unpin()
 	} else {
 		w.logger.Error("Persistent store operation failure", tag.StoreOperationCreateTask, tag.Error(err))
@@ -0,0 +1,201 @@
+package matching
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/service/matching/counter"
+)
+
+const (
+	// Dispatch rates are averaged over the current and the previous window.
+	fairnessKeyRateWindow = time.Minute
+	// Number of fairness keys whose stats can be reported. We track up to twice this many and
+	// prune the ones with the smallest backlog and dispatch count when we go over.
+	maxReportedFairnessKeys = 100
+	defaultFairnessKeysTopN = 10
+)
+
+type (
+	// fairnessKeyTracker holds the fairness weights of a fair backlog and approximate per-key
+	// stats. Counts are kept in counter.Counters, so they may overestimate for a large number of
+	// keys, and only include tasks written since the backlog was loaded. Task ids only grow, so
+	// tasks written before the backlog was loaded are recognized by an id below the first one
+	// written since, and their completions aren't subtracted from the backlog.
+	fairnessKeyTracker struct {
+		weights atomic.Pointer[persistencespb.FairnessWeights]
+
+		lock           sync.Mutex
+		timeSource     clock.TimeSource
+		counterFactory func() counter.Counter
+		written        counter.Counter // tasks written per key
+		completed      counter.Counter // tasks removed from the backlog per key
+		dispatched     counter.Counter // tasks dispatched per key in the current window
+		prevDispatched counter.Counter // tasks dispatched per key in the previous window, may be nil
+		windowStart    time.Time
+		keys           map[string]struct{} // keys whose stats can be reported
+		firstWrittenID int64               // id of the first task written since load, 0 if none
+	}
+)
+
+func newFairnessKeyTracker(timeSource clock.TimeSource, counterFactory func() counter.Counter) *fairnessKeyTracker {
+	return &fairnessKeyTracker{
+		timeSource:     timeSource,
+		counterFactory: counterFactory,
+		written:        counterFactory(),
+		completed:      counterFactory(),
+		dispatched:     counterFactory(),
+		windowStart:    timeSource.Now(),
+		keys:           make(map[string]struct{}),
+	}
+}
+
+// getWeights returns the weights from task queue user data. The result may be nil.
+func (t *fairnessKeyTracker) getWeights() *persistencespb.FairnessWeights {
+	return t.weights.Load()
+}
+
+func (t *fairnessKeyTracker) setWeights(weights *persistencespb.FairnessWeights) {
+	t.weights.Store(weights)
+}
+
+// recordWritten is called after tasks were written to the backlog, before they can be read.
+func (t *fairnessKeyTracker) recordWritten(tasks []*persistencespb.AllocatedTaskInfo) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, task := range tasks {
+		if t.firstWrittenID == 0 || task.GetTaskId() < t.firstWrittenID {
+			t.firstWrittenID = task.GetTaskId()
+		}
+		key := task.GetData().GetPriority().GetFairnessKey()
+		_ = t.written.GetPass(key, 0, 1)
+		t.keys[key] = struct{}{}
+	}
+	if len(t.keys) > 2*maxReportedFairnessKeys {
+		t.pruneLocked()
+	}
+}
+
+// recordCompleted is called when a task is removed from the backlog: when it was dispatched, or
+// when it expired, was dropped as invalid or was re-spooled, in which case dispatched is false.
+func (t *fairnessKeyTracker) recordCompleted(task *persistencespb.AllocatedTaskInfo, dispatched bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := task.GetData().GetPriority().GetFairnessKey()
+	// Only tasks that were counted as written are subtracted from the backlog.
+	if t.firstWrittenID != 0 && task.GetTaskId() >= t.firstWrittenID {
+		_ = t.completed.GetPass(key, 0, 1)
+	}
+	if dispatched {
+		t.rotateLocked()
+		_ = t.dispatched.GetPass(key, 0, 1)
+	}
+}
+
+// top returns stats for the n keys with the largest backlogs and the n keys with the highest
+// dispatch rates.
+func (t *fairnessKeyTracker) top(n int) (byBacklog, byDispatchRate []*taskqueuespb.FairnessKeyStats) {
+	if n <= 0 {
+		n = defaultFairnessKeysTopN
+	}
+	weights := t.getWeights()
+
+	t.lock.Lock()
+	t.rotateLocked()
+	elapsed := t.timeSource.Now().Sub(t.windowStart)
+	if t.prevDispatched != nil {
+		elapsed += fairnessKeyRateWindow
+	}
+	seconds := max(elapsed.Seconds(), 1)
+
+	all := make([]*taskqueuespb.FairnessKeyStats, 0, len(t.keys))
+	for key := range t.keys {
+		stats := &taskqueuespb.FairnessKeyStats{
+			FairnessKey:             key,
+			ApproximateBacklogCount: t.backlogLocked(key),
+			DispatchRate:            float32(float64(t.dispatchCountLocked(key)) / seconds),
+			Weight:                  getEffectiveWeight(weights, &commonpb.Priority{FairnessKey: key}),
+		}
+		if stats.ApproximateBacklogCount > 0 || stats.DispatchRate > 0 {
+			all = append(all, stats)
+		}
+	}
+	t.lock.Unlock()
+
+	byBacklog = slices.Clone(all)
+	slices.SortFunc(byBacklog, func(a, b *taskqueuespb.FairnessKeyStats) int {
+		return cmp.Or(
+			cmp.Compare(b.ApproximateBacklogCount, a.ApproximateBacklogCount),
+			cmp.Compare(a.FairnessKey, b.FairnessKey),
+		)
+	})
+	byBacklog = slices.DeleteFunc(byBacklog, func(s *taskqueuespb.FairnessKeyStats) bool {
+		return s.ApproximateBacklogCount == 0
+	})
+
+	byDispatchRate = all
+	slices.SortFunc(byDispatchRate, func(a, b *taskqueuespb.FairnessKeyStats) int {
+		return cmp.Or(
+			cmp.Compare(b.DispatchRate, a.DispatchRate),
+			cmp.Compare(a.FairnessKey, b.FairnessKey),
+		)
+	})
+	byDispatchRate = slices.DeleteFunc(byDispatchRate, func(s *taskqueuespb.FairnessKeyStats) bool {
+		return s.DispatchRate == 0
+	})
+
+	return byBacklog[:min(n, len(byBacklog))], byDispatchRate[:min(n, len(byDispatchRate))]
+}
+
+func (t *fairnessKeyTracker) rotateLocked() {
+	now := t.timeSource.Now()
+	if now.Sub(t.windowStart) < fairnessKeyRateWindow {
+		return
+	}
+	if now.Sub(t.windowStart) < 2*fairnessKeyRateWindow {
+		t.prevDispatched = t.dispatched
+	} else {
+		// nothing was recorded for a whole window
+		t.prevDispatched = t.counterFactory()
+	}
+	t.dispatched = t.counterFactory()
+	t.windowStart = now
+}
+
+// pruneLocked drops the keys with the smallest backlog and dispatch count from the reported keys.
+func (t *fairnessKeyTracker) pruneLocked() {
+	type scored struct {
+		key   string
+		score int64
+	}
+	all := make([]scored, 0, len(t.keys))
+	for key := range t.keys {
+		all = append(all, scored{key: key, score: t.backlogLocked(key) + t.dispatchCountLocked(key)})
+	}
+	slices.SortFunc(all, func(a, b scored) int { return cmp.Compare(b.score, a.score) })
+	for _, s := range all[maxReportedFairnessKeys:] {
+		delete(t.keys, s.key)
+	}
+}
+
+func (t *fairnessKeyTracker) backlogLocked(key string) int64 {
+	// Counters may overestimate, so clip at zero.
+	return max(0, t.written.GetPass(key, 0, 0)-t.completed.GetPass(key, 0, 0))
+}
+
+func (t *fairnessKeyTracker) dispatchCountLocked(key string) int64 {
+	count := t.dispatched.GetPass(key, 0, 0)
+	if t.prevDispatched != nil {
+		count += t.prevDispatched.GetPass(key, 0, 0)
+	}
+	return count
+}
@@ -0,0 +1,145 @@
+package matching
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/service/matching/counter"
+)
+
+func TestGetEffectiveWeight(t *testing.T) {
+	t.Parallel()
+
+	weights := &persistencespb.FairnessWeights{
+		DefaultWeight: 2,
+		Overrides:     map[string]float32{"key-a": 5, "key-b": 0.00001},
+	}
+	for _, tc := range []struct {
+		name     string
+		weights  *persistencespb.FairnessWeights
+		pri      *commonpb.Priority
+		expected float32
+	}{
+		{"no_weights", nil, &commonpb.Priority{FairnessKey: "key-a"}, 1},
+		{"task_weight", nil, &commonpb.Priority{FairnessKey: "key-a", FairnessWeight: 3}, 3},
+		{"override", weights, &commonpb.Priority{FairnessKey: "key-a", FairnessWeight: 3}, 5},
+		{"override_clamped", weights, &commonpb.Priority{FairnessKey: "key-b"}, minWeight},
+		{"task_weight_over_default", weights, &commonpb.Priority{FairnessKey: "key-c", FairnessWeight: 3}, 3},
+		{"default_weight", weights, &commonpb.Priority{FairnessKey: "key-c"}, 2},
+		{"nil_priority", weights, nil, 2},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, getEffectiveWeight(tc.weights, tc.pri))
+		})
+	}
+}
+
+func TestFairnessKeyTracker_Top(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	tracker := newFairnessKeyTracker(timeSource, func() counter.Counter { return counter.NewMapCounter() })
+	tracker.setWeights(&persistencespb.FairnessWeights{Overrides: map[string]float32{"key-b": 4}})
+
+	tracker.recordWritten(newFairnessTestTasks(1, "key-a", "key-a", "key-a", "key-b", "key-b", "key-c"))
+	tracker.recordCompleted(newFairnessTestTask(4, "key-b"), true)
+	tracker.recordCompleted(newFairnessTestTask(5, "key-b"), true)
+	tracker.recordCompleted(newFairnessTestTask(1, "key-a"), true)
+	tracker.recordCompleted(newFairnessTestTask(6, "key-c"), false)
+	timeSource.Advance(10 * time.Second)
+
+	byBacklog, byDispatchRate := tracker.top(10)
+	require.Len(t, byBacklog, 1)
+	require.Equal(t, "key-a", byBacklog[0].GetFairnessKey())
+	require.EqualValues(t, 2, byBacklog[0].GetApproximateBacklogCount())
+	require.EqualValues(t, 1, byBacklog[0].GetWeight())
+
+	require.Len(t, byDispatchRate, 2)
+	require.Equal(t, "key-b", byDispatchRate[0].GetFairnessKey())
+	require.InEpsilon(t, 0.2, byDispatchRate[0].GetDispatchRate(), 0.001)
+	require.EqualValues(t, 4, byDispatchRate[0].GetWeight())
+	require.Equal(t, "key-a", byDispatchRate[1].GetFairnessKey())
+
+	byBacklog, byDispatchRate = tracker.top(1)
+	require.Len(t, byBacklog, 1)
+	require.Len(t, byDispatchRate, 1)
+
+	// dispatches age out after two windows
+	timeSource.Advance(fairnessKeyRateWindow)
+	_, byDispatchRate = tracker.top(10)
+	require.Len(t, byDispatchRate, 2)
+	timeSource.Advance(2 * fairnessKeyRateWindow)
+	byBacklog, byDispatchRate = tracker.top(10)
+	require.Empty(t, byDispatchRate)
+	require.Len(t, byBacklog, 1)
+}
+
+func TestFairnessKeyTracker_Prune(t *testing.T) {
+	t.Parallel()
+
+	tracker := newFairnessKeyTracker(clock.NewEventTimeSource(), func() counter.Counter { return counter.NewMapCounter() })
+	for i := range 2*maxReportedFairnessKeys + 1 {
+		keys := []string{fmt.Sprintf("key-%d", i)}
+		if i == 0 {
+			keys = append(keys, keys[0])
+		}
+		tracker.recordWritten(newFairnessTestTasks(int64(10*i+1), keys...))
+	}
+
+	byBacklog, _ := tracker.top(2 * maxReportedFairnessKeys)
+	require.Len(t, byBacklog, maxReportedFairnessKeys)
+	require.Equal(t, "key-0", byBacklog[0].GetFairnessKey())
+	require.EqualValues(t, 2, byBacklog[0].GetApproximateBacklogCount())
+}
+
+func TestFairnessKeyTracker_ExistingBacklog(t *testing.T) {
+	t.Parallel()
+
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	tracker := newFairnessKeyTracker(timeSource, func() counter.Counter { return counter.NewMapCounter() })
+
+	// tasks 1-3 were written before the backlog was loaded, and are completed before any new
+	// task is written
+	tracker.recordCompleted(newFairnessTestTask(1, "key-a"), true)
+	tracker.recordWritten(newFairnessTestTasks(10, "key-a", "key-a"))
+	// completions of tasks written before the load must not hide the new tasks
+	tracker.recordCompleted(newFairnessTestTask(2, "key-a"), true)
+	tracker.recordCompleted(newFairnessTestTask(3, "key-a"), false)
+	timeSource.Advance(10 * time.Second)
+
+	byBacklog, byDispatchRate := tracker.top(10)
+	require.Len(t, byBacklog, 1)
+	require.Equal(t, "key-a", byBacklog[0].GetFairnessKey())
+	require.EqualValues(t, 2, byBacklog[0].GetApproximateBacklogCount())
+	// dispatches are counted either way
+	require.Len(t, byDispatchRate, 1)
+	require.InEpsilon(t, 0.2, byDispatchRate[0].GetDispatchRate(), 0.001)
+
+	tracker.recordCompleted(newFairnessTestTask(10, "key-a"), true)
+	byBacklog, _ = tracker.top(10)
+	require.Len(t, byBacklog, 1)
+	require.EqualValues(t, 1, byBacklog[0].GetApproximateBacklogCount())
+}
+
+func newFairnessTestTask(id int64, key string) *persistencespb.AllocatedTaskInfo {
+	return &persistencespb.AllocatedTaskInfo{
+		TaskId: id,
+		Data:   &persistencespb.TaskInfo{Priority: &commonpb.Priority{FairnessKey: key}},
+	}
+}
+
+// newFairnessTestTasks returns tasks with consecutive ids starting at firstID.
+func newFairnessTestTasks(firstID int64, keys ...string) []*persistencespb.AllocatedTaskInfo {
+	tasks := make([]*persistencespb.AllocatedTaskInfo, len(keys))
+	for i, key := range keys {
+		tasks[i] = newFairnessTestTask(firstID+int64(i), key)
+	}
+	return tasks
+}
@@ -1,6 +1,9 @@
 package matching
 
-import commonpb "go.temporal.io/api/common/v1"
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
 
 const (
 	// minWeight * strideFactor must be >= 1
@@ -8,13 +11,17 @@ const (
 	minWeight    = 0.001
 )
 
-type fairnessWeightOverrides map[string]float32
-
-func getEffectiveWeight(overrides fairnessWeightOverrides, pri *commonpb.Priority) float32 {
+// getEffectiveWeight returns the weight of a task. Overrides from the task queue user data take
+// precedence over the weight set on the task, which takes precedence over the default weight from
+// user data. weights may be nil.
+func getEffectiveWeight(weights *persistencespb.FairnessWeights, pri *commonpb.Priority) float32 {
 	key := pri.GetFairnessKey()
-	weight, ok := overrides[key]
+	weight, ok := weights.GetOverrides()[key]
 	if !ok {
 		weight = pri.GetFairnessWeight()
+		if weight <= 0.0 {
+			weight = weights.GetDefaultWeight()
+		}
 	}
 	// zero means default weight (1.0). negative doesn't make sense, map it to 1.0 also.
 	if weight <= 0.0 {
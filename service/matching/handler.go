@@ -553,17 +553,25 @@ func (h *Handler) RecordWorkerHeartbeat(
 ) (*matchingservice.RecordWorkerHeartbeatResponse, error) {
 	nsID := namespace.ID(request.GetNamespaceId())
 
-	h.workersRegistry.RecordWorkerHeartbeats(nsID, request.GetHeartbeartRequest().GetWorkerHeartbeat())
+	if request.GetHandover() {
+		h.workersRegistry.HandoverWorkerHeartbeats(nsID, request.GetHeartbeartRequest().GetWorkerHeartbeat())
+	} else {
+		h.workersRegistry.RecordWorkerHeartbeats(nsID, request.GetHeartbeartRequest().GetWorkerHeartbeat())
+	}
 	return &matchingservice.RecordWorkerHeartbeatResponse{}, nil
 }
 
 // ListWorkers retrieves a list of workers in the specified namespace that match the provided filters.
 func (h *Handler) ListWorkers(
-	_ context.Context, request *matchingservice.ListWorkersRequest,
+	ctx context.Context, request *matchingservice.ListWorkersRequest,
 ) (*matchingservice.ListWorkersResponse, error) {
 	nsID := namespace.ID(request.GetNamespaceId())
-	workersHeartbeats, err := h.workersRegistry.ListWorkers(
-		nsID, request.GetListRequest().GetQuery(), request.GetListRequest().GetNextPageToken())
+	pageSize := h.config.ListWorkersMaxPageSize(h.namespaceName(nsID).String())
+	if size := int(request.GetListRequest().GetPageSize()); size > 0 && size < pageSize {
+		pageSize = size
+	}
+	workersHeartbeats, nextPageToken, err := h.workersRegistry.ListWorkers(
+		ctx, nsID, request.GetListRequest().GetQuery(), pageSize, request.GetListRequest().GetNextPageToken())
 	if err != nil {
 		return nil, err
 	}
@@ -574,10 +582,30 @@ func (h *Handler) ListWorkers(
 		})
 	}
 	return &matchingservice.ListWorkersResponse{
-		WorkersInfo: workersInfo,
+		WorkersInfo:   workersInfo,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// CountWorkers counts the workers in the specified namespace that match the provided query.
+func (h *Handler) CountWorkers(
+	ctx context.Context, request *matchingservice.CountWorkersRequest,
+) (*matchingservice.CountWorkersResponse, error) {
+	nsID := namespace.ID(request.GetNamespaceId())
+	count, groups, err := h.workersRegistry.CountWorkers(ctx, nsID, request.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	resp := &matchingservice.CountWorkersResponse{Count: count}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, &matchingservice.CountWorkersResponse_AggregationGroup{
+			GroupValues: group.Values,
+			Count:       group.Count,
+		})
+	}
+	return resp, nil
+}
+
 func (h *Handler) namespaceName(id namespace.ID) namespace.Name {
 	entry, err := h.namespaceRegistry.GetNamespaceByID(id)
 	if err != nil {
@@ -602,12 +630,36 @@ func (h *Handler) UpdateTaskQueueConfig(
 	return h.engine.UpdateTaskQueueConfig(ctx, request)
 }
 
+func (h *Handler) UpdateFairnessWeights(
+	ctx context.Context, request *matchingservice.UpdateFairnessWeightsRequest,
+) (*matchingservice.UpdateFairnessWeightsResponse, error) {
+	return h.engine.UpdateFairnessWeights(ctx, request)
+}
+
+func (h *Handler) DescribeFairnessKeys(
+	ctx context.Context, request *matchingservice.DescribeFairnessKeysRequest,
+) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	return h.engine.DescribeFairnessKeys(ctx, request)
+}
+
+func (h *Handler) UpdateTaskQueuePause(
+	ctx context.Context, request *matchingservice.UpdateTaskQueuePauseRequest,
+) (*matchingservice.UpdateTaskQueuePauseResponse, error) {
+	return h.engine.UpdateTaskQueuePause(ctx, request)
+}
+
+func (h *Handler) UpdateBlockedPollers(
+	ctx context.Context, request *matchingservice.UpdateBlockedPollersRequest,
+) (*matchingservice.UpdateBlockedPollersResponse, error) {
+	return h.engine.UpdateBlockedPollers(ctx, request)
+}
+
 func (h *Handler) DescribeWorker(
-	_ context.Context, request *matchingservice.DescribeWorkerRequest,
+	ctx context.Context, request *matchingservice.DescribeWorkerRequest,
 ) (*matchingservice.DescribeWorkerResponse, error) {
 	nsID := namespace.ID(request.GetNamespaceId())
 	hb, err := h.workersRegistry.DescribeWorker(
-		nsID, request.Request.GetWorkerInstanceKey())
+		ctx, nsID, request.Request.GetWorkerInstanceKey())
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
@@ -96,9 +97,9 @@ type taskPQ struct {
 	// must also be, and so we don't have to "skip over" the head of the queue due to rate
 	// limits. This isn't true in situations where weights have changed in between writing and
 	// reading. We'll handle that situation better in the future.
-	perKeyLimit     simpleLimiterParams
-	perKeyOverrides fairnessWeightOverrides // TODO(fairness): get this from config
-	perKeyReady     cache.Cache
+	perKeyLimit   simpleLimiterParams
+	perKeyWeights *persistencespb.FairnessWeights
+	perKeyReady   cache.Cache
 }
 
 func (t *taskPQ) Add(task *internalTask) {
@@ -138,7 +139,7 @@ func (t *taskPQ) consumeTokens(now int64, task *internalTask, tokens int64) {
 	if t.perKeyLimit.limited() {
 		pri := task.getPriority()
 		key := pri.GetFairnessKey()
-		weight := getEffectiveWeight(t.perKeyOverrides, pri)
+		weight := getEffectiveWeight(t.perKeyWeights, pri)
 		p := t.perKeyLimit
 		p.interval = time.Duration(float32(p.interval) / weight) // scale by weight
 		var sl simpleLimiter
@@ -302,6 +303,13 @@ func (d *matcherData) UpdateRateLimit(rate float64, burstDuration time.Duration)
 	d.tasks.wholeQueueReady = d.tasks.wholeQueueReady.clip(d.tasks.wholeQueueLimit, now, maxTokens)
 }
 
+func (d *matcherData) UpdateFairnessWeights(weights *persistencespb.FairnessWeights) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.tasks.perKeyWeights = weights
+}
+
 func (d *matcherData) UpdatePerKeyRateLimit(rate float64, burstDuration time.Duration) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -176,7 +177,7 @@ var (
 	identityKey identityCtxKey = "identity"
 
 	// The routing key for the single partition used to route Nexus endpoints CRUD RPCs to.
-	nexusEndpointsTablePartitionRoutingKey = tqid.MustNormalPartitionFromRpcName("not-applicable", "not-applicable", enumspb.TASK_QUEUE_TYPE_UNSPECIFIED).RoutingKey()
+	nexusEndpointsTablePartitionRoutingKey = tqid.MustNormalPartitionFromRpcName(tqid.NotApplicableName, tqid.NotApplicableName, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED).RoutingKey()
 
 	// Options for batching user data updates.
 	userDataBatcherOptions = stream_batcher.BatcherOptions{
@@ -233,7 +234,7 @@ func NewEngine(
 		clusterMeta:                   clusterMeta,
 		timeSource:                    clock.NewRealTimeSource(), // No need to mock this at the moment
 		visibilityManager:             visibilityManager,
-		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager),
+		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager, clock.NewRealTimeSource()),
 		nexusEndpointsOwnershipLostCh: make(chan struct{}),
 		saProvider:                    saProvider,
 		saMapperProvider:              saMapperProvider,
@@ -2366,11 +2367,13 @@ func (e *matchingEngineImpl) CreateNexusEndpoint(ctx context.Context, request *m
 func (e *matchingEngineImpl) UpdateNexusEndpoint(ctx context.Context, request *matchingservice.UpdateNexusEndpointRequest) (*matchingservice.UpdateNexusEndpointResponse, error) {
 	// Write API, let persistence verify table ownership.
 	res, err := e.nexusEndpointClient.UpdateNexusEndpoint(ctx, &internalUpdateNexusEndpointRequest{
-		endpointID: request.GetId(),
-		version:    request.GetVersion(),
-		spec:       request.GetSpec(),
-		clusterID:  e.clusterMeta.GetClusterID(),
-		timeSource: e.timeSource,
+		endpointID:             request.GetId(),
+		version:                request.GetVersion(),
+		spec:                   request.GetSpec(),
+		accessPolicy:           request.GetAccessPolicy(),
+		circuitBreakerOverride: request.GetCircuitBreakerOverride(),
+		clusterID:              e.clusterMeta.GetClusterID(),
+		timeSource:             e.timeSource,
 	})
 	if err != nil {
 		e.logger.Error("Failed to update Nexus endpoint", tag.Error(err), tag.Endpoint(request.GetSpec().GetName()))
@@ -3048,3 +3051,203 @@ func (e *matchingEngineImpl) UpdateTaskQueueConfig(ctx context.Context, request
 		UpdatedTaskqueueConfig: userData.GetData().GetPerType()[int32(taskQueueType)].GetConfig(),
 	}, nil
 }
+
+func (e *matchingEngineImpl) UpdateFairnessWeights(ctx context.Context, request *matchingservice.UpdateFairnessWeightsRequest) (*matchingservice.UpdateFairnessWeightsResponse, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	taskQueueType := request.GetTaskQueueType()
+	// User data is owned by the root workflow partition, like for UpdateTaskQueueConfig.
+	tqm, _, err := e.getTaskQueuePartitionManager(ctx,
+		taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(),
+		true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	maxOverrides := e.config.FairnessWeightOverrideLimitPerQueue(ns.Name().String())
+	updateOptions := UserDataUpdateOptions{Source: "UpdateFairnessWeights"}
+	_, err = tqm.GetUserDataManager().UpdateUserData(ctx, updateOptions,
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data := prepareTaskQueueUserData(tqud, taskQueueType)
+			existingClock := data.Clock
+			if existingClock == nil {
+				existingClock = hlc.Zero(e.clusterMeta.GetClusterID())
+			}
+			now := hlc.Next(existingClock, e.timeSource)
+
+			perType := data.PerType[int32(taskQueueType)]
+			weights := perType.GetFairnessWeights()
+			if weights == nil {
+				weights = &persistencespb.FairnessWeights{}
+			}
+			if weights.Overrides == nil {
+				weights.Overrides = make(map[string]float32)
+			}
+			for _, key := range request.GetUnsetOverrides() {
+				delete(weights.Overrides, key)
+			}
+			for key, weight := range request.GetSetOverrides() {
+				weights.Overrides[key] = weight
+			}
+			if len(weights.Overrides) > maxOverrides {
+				return nil, false, serviceerror.NewFailedPreconditionf(
+					"update would exceed number of fairness weight overrides permitted in namespace dynamic config (%v/%v)",
+					len(weights.Overrides), maxOverrides)
+			}
+			if request.GetUpdateDefaultWeight() {
+				weights.DefaultWeight = request.GetDefaultWeight()
+			}
+			weights.UpdateTime = hlc.ProtoTimestamp(now)
+			weights.UpdateIdentity = request.GetIdentity()
+			perType.FairnessWeights = weights
+
+			data.Clock = now
+			return data, true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateFairnessWeightsResponse{
+		FairnessWeights: userData.GetData().GetPerType()[int32(taskQueueType)].GetFairnessWeights(),
+	}, nil
+}
+
+func (e *matchingEngineImpl) DescribeFairnessKeys(ctx context.Context, request *matchingservice.DescribeFairnessKeysRequest) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	partition := tqid.PartitionFromPartitionProto(request.GetTaskQueuePartition(), request.GetNamespaceId())
+	pm, _, err := e.getTaskQueuePartitionManager(ctx, partition, true, loadCauseDescribe)
+	if err != nil {
+		return nil, err
+	}
+	return pm.DescribeFairnessKeys(int(request.GetTopN()))
+}
+
+func (e *matchingEngineImpl) UpdateTaskQueuePause(ctx context.Context, request *matchingservice.UpdateTaskQueuePauseRequest) (*matchingservice.UpdateTaskQueuePauseResponse, error) {
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	taskQueueType := request.GetTaskQueueType()
+	// User data is owned by the root workflow partition, like for UpdateTaskQueueConfig.
+	tqm, _, err := e.getTaskQueuePartitionManager(ctx,
+		taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(),
+		true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := UserDataUpdateOptions{Source: "UpdateTaskQueuePause"}
+	_, err = tqm.GetUserDataManager().UpdateUserData(ctx, updateOptions,
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data := prepareTaskQueueUserData(tqud, taskQueueType)
+			existingClock := data.Clock
+			if existingClock == nil {
+				existingClock = hlc.Zero(e.clusterMeta.GetClusterID())
+			}
+			now := hlc.Next(existingClock, e.timeSource)
+
+			var pause *persistencespb.TaskQueuePause
+			if request.GetPause() != nil {
+				pause = common.CloneProto(request.GetPause())
+				pause.UpdateTime = hlc.ProtoTimestamp(now)
+				pause.UpdateIdentity = request.GetIdentity()
+			}
+			data.PerType[int32(taskQueueType)].Pause = pause
+
+			data.Clock = now
+			return data, true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateTaskQueuePauseResponse{
+		Pause: userData.GetData().GetPerType()[int32(taskQueueType)].GetPause(),
+	}, nil
+}
+
+func (e *matchingEngineImpl) UpdateBlockedPollers(ctx context.Context, request *matchingservice.UpdateBlockedPollersRequest) (*matchingservice.UpdateBlockedPollersResponse, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	taskQueueFamily, err := tqid.NewTaskQueueFamily(request.GetNamespaceId(), request.GetTaskQueue())
+	if err != nil {
+		return nil, err
+	}
+	taskQueueType := request.GetTaskQueueType()
+	// User data is owned by the root workflow partition, like for UpdateTaskQueueConfig.
+	tqm, _, err := e.getTaskQueuePartitionManager(ctx,
+		taskQueueFamily.TaskQueue(enumspb.TASK_QUEUE_TYPE_WORKFLOW).RootPartition(),
+		true, loadCauseOtherWrite)
+	if err != nil {
+		return nil, err
+	}
+	maxBlockedPollers := e.config.BlockedPollerLimitPerQueue(ns.Name().String())
+	updateOptions := UserDataUpdateOptions{Source: "UpdateBlockedPollers"}
+	_, err = tqm.GetUserDataManager().UpdateUserData(ctx, updateOptions,
+		func(tqud *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, bool, error) {
+			data := prepareTaskQueueUserData(tqud, taskQueueType)
+			existingClock := data.Clock
+			if existingClock == nil {
+				existingClock = hlc.Zero(e.clusterMeta.GetClusterID())
+			}
+			now := hlc.Next(existingClock, e.timeSource)
+			nowTime := hlc.UTC(now)
+
+			remove := make(map[string]struct{}, len(request.GetBlockIdentities())+len(request.GetUnblockIdentities()))
+			for _, identity := range request.GetBlockIdentities() {
+				remove[identity] = struct{}{}
+			}
+			for _, identity := range request.GetUnblockIdentities() {
+				remove[identity] = struct{}{}
+			}
+			perType := data.PerType[int32(taskQueueType)]
+			// drop expired blocks and the ones that are replaced or removed
+			blocked := slices.DeleteFunc(perType.GetBlockedPollers(), func(b *persistencespb.BlockedPoller) bool {
+				_, ok := remove[b.GetIdentity()]
+				return ok || !nowTime.Before(b.GetExpireTime().AsTime())
+			})
+			for _, identity := range request.GetBlockIdentities() {
+				blocked = append(blocked, &persistencespb.BlockedPoller{
+					Identity:       identity,
+					ExpireTime:     timestamppb.New(nowTime.Add(request.GetBlockDuration().AsDuration())),
+					Reason:         request.GetReason(),
+					UpdateTime:     hlc.ProtoTimestamp(now),
+					UpdateIdentity: request.GetIdentity(),
+				})
+			}
+			if len(blocked) > maxBlockedPollers {
+				return nil, false, serviceerror.NewFailedPreconditionf(
+					"update would exceed number of blocked pollers permitted in namespace dynamic config (%v/%v)",
+					len(blocked), maxBlockedPollers)
+			}
+			perType.BlockedPollers = blocked
+
+			data.Clock = now
+			return data, true, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqm.GetUserDataManager().GetUserData()
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.UpdateBlockedPollersResponse{
+		BlockedPollers: userData.GetData().GetPerType()[int32(taskQueueType)].GetBlockedPollers(),
+	}, nil
+}
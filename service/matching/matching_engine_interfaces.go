@@ -46,5 +46,9 @@ type (
 		GetWorkerVersioningRules(ctx context.Context, request *matchingservice.GetWorkerVersioningRulesRequest) (*matchingservice.GetWorkerVersioningRulesResponse, error)
 		DescribeVersionedTaskQueues(ctx context.Context, request *matchingservice.DescribeVersionedTaskQueuesRequest) (*matchingservice.DescribeVersionedTaskQueuesResponse, error)
 		UpdateTaskQueueConfig(ctx context.Context, request *matchingservice.UpdateTaskQueueConfigRequest) (*matchingservice.UpdateTaskQueueConfigResponse, error)
+		UpdateFairnessWeights(ctx context.Context, request *matchingservice.UpdateFairnessWeightsRequest) (*matchingservice.UpdateFairnessWeightsResponse, error)
+		DescribeFairnessKeys(ctx context.Context, request *matchingservice.DescribeFairnessKeysRequest) (*matchingservice.DescribeFairnessKeysResponse, error)
+		UpdateTaskQueuePause(ctx context.Context, request *matchingservice.UpdateTaskQueuePauseRequest) (*matchingservice.UpdateTaskQueuePauseResponse, error)
+		UpdateBlockedPollers(ctx context.Context, request *matchingservice.UpdateBlockedPollersRequest) (*matchingservice.UpdateBlockedPollersResponse, error)
 	}
 )
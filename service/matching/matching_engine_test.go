@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	clockspb "go.temporal.io/server/api/clock/v1"
 	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -255,7 +256,7 @@ func newMatchingEngine(
 		clusterMeta:                   clustertest.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(false, true)),
 		timeSource:                    clock.NewRealTimeSource(),
 		visibilityManager:             mockVisibilityManager,
-		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager),
+		nexusEndpointClient:           newEndpointClient(config.NexusEndpointsRefreshInterval, nexusEndpointManager, clock.NewRealTimeSource()),
 		nexusEndpointsOwnershipLostCh: make(chan struct{}),
 	}
 }
@@ -871,6 +872,348 @@ func (s *matchingEngineSuite) TestQueryWorkflowDoesNotLoadSticky() {
 	s.Equal(0, len(s.matchingEngine.partitions))
 }
 
+func (s *matchingEngineSuite) TestTaskQueuePause() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(10 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	addRequest := &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+		ScheduledEventId:       5,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	}
+	pollRequest := &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "nobody",
+		},
+	}
+	// user data is owned by the workflow root partition, so test with workflow tasks
+	updatePause := func(pause *persistencespb.TaskQueuePause) {
+		resp, err := s.matchingEngine.UpdateTaskQueuePause(context.Background(), &matchingservice.UpdateTaskQueuePauseRequest{
+			NamespaceId:   namespaceId,
+			TaskQueue:     taskQueue.GetName(),
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			Pause:         pause,
+			Identity:      "operator",
+		})
+		s.NoError(err)
+		s.Equal(pause.GetMode(), resp.GetPause().GetMode())
+		if pause != nil {
+			s.Equal("operator", resp.GetPause().GetUpdateIdentity())
+		}
+	}
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordWorkflowTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           5,
+			Attempt:                    1,
+			WorkflowExecutionTaskQueue: taskQueue,
+			History:                    &historypb.History{},
+		}, nil).AnyTimes()
+
+	// draining rejects new tasks
+	updatePause(&persistencespb.TaskQueuePause{Mode: enumsspb.TASK_QUEUE_PAUSE_MODE_DRAINING})
+	_, _, err := s.matchingEngine.AddWorkflowTask(context.Background(), addRequest)
+	s.ErrorIs(err, errTaskQueueDraining)
+
+	// a pause that hasn't started yet doesn't affect the task queue
+	updatePause(&persistencespb.TaskQueuePause{
+		Mode:      enumsspb.TASK_QUEUE_PAUSE_MODE_DRAINING,
+		StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), addRequest)
+	s.NoError(err)
+
+	// paused accepts new tasks but doesn't dispatch them
+	updatePause(&persistencespb.TaskQueuePause{Mode: enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED})
+	addRequest.ScheduledEventId = 6
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), addRequest)
+	s.NoError(err)
+	ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+100*time.Millisecond)
+	defer cancel()
+	result, err := s.matchingEngine.PollWorkflowTaskQueue(ctx, pollRequest, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.Empty(result.GetTaskToken())
+
+	// removing the pause dispatches the backlog
+	updatePause(nil)
+	result, err = s.matchingEngine.PollWorkflowTaskQueue(context.Background(), pollRequest, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.NotEmpty(result.GetTaskToken())
+}
+
+func (s *matchingEngineSuite) TestTaskQueuePause_EvictsWaitingPoll() {
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	pollRequest := &matchingservice.PollWorkflowTaskQueueRequest{
+		NamespaceId: namespaceId,
+		PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+			TaskQueue: taskQueue,
+			Identity:  "nobody",
+		},
+	}
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordWorkflowTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           5,
+			Attempt:                    1,
+			WorkflowExecutionTaskQueue: taskQueue,
+			History:                    &historypb.History{},
+		}, nil).AnyTimes()
+
+	prtn := newRootPartition(namespaceId, taskQueue.GetName(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	tqm, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), prtn, true, loadCauseUnspecified)
+	s.Require().NoError(err)
+	pqm := tqm.(*taskQueuePartitionManagerImpl).defaultQueue.(*physicalTaskQueueManagerImpl)
+
+	// the poll is waiting in the matcher when the task queue is paused
+	type pollResult struct {
+		resp *matchingservice.PollWorkflowTaskQueueResponse
+		err  error
+	}
+	resultC := make(chan pollResult, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+time.Second)
+		defer cancel()
+		resp, err := s.matchingEngine.PollWorkflowTaskQueue(ctx, pollRequest, metrics.NoopMetricsHandler)
+		resultC <- pollResult{resp: resp, err: err}
+	}()
+	s.Eventually(func() bool { return pqm.currentPolls.Load() == 1 }, time.Second, time.Millisecond)
+
+	_, err = s.matchingEngine.UpdateTaskQueuePause(context.Background(), &matchingservice.UpdateTaskQueuePauseRequest{
+		NamespaceId:   namespaceId,
+		TaskQueue:     taskQueue.GetName(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		Pause:         &persistencespb.TaskQueuePause{Mode: enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED},
+		Identity:      "operator",
+	})
+	s.NoError(err)
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+		ScheduledEventId:       5,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	})
+	s.NoError(err)
+
+	result := <-resultC
+	s.NoError(result.err)
+	s.Empty(result.resp.GetTaskToken())
+
+	// the task was kept and is dispatched once the pause is removed
+	_, err = s.matchingEngine.UpdateTaskQueuePause(context.Background(), &matchingservice.UpdateTaskQueuePauseRequest{
+		NamespaceId:   namespaceId,
+		TaskQueue:     taskQueue.GetName(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(context.Background(), pollRequest, metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.NotEmpty(resp.GetTaskToken())
+}
+
+func (s *matchingEngineSuite) TestTaskQueuePause_EvictsWaitingPollAtStartTime() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(3 * time.Second)
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	prtn := newRootPartition(namespaceId, taskQueue.GetName(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	tqm, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), prtn, true, loadCauseUnspecified)
+	s.Require().NoError(err)
+	pqm := tqm.(*taskQueuePartitionManagerImpl).defaultQueue.(*physicalTaskQueueManagerImpl)
+	numBlockablePolls := func() int {
+		pqm.blockablePollsLock.Lock()
+		defer pqm.blockablePollsLock.Unlock()
+		return len(pqm.blockablePolls)
+	}
+
+	// the pause is scheduled before the poll starts
+	_, err = s.matchingEngine.UpdateTaskQueuePause(context.Background(), &matchingservice.UpdateTaskQueuePauseRequest{
+		NamespaceId:   namespaceId,
+		TaskQueue:     taskQueue.GetName(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		Pause: &persistencespb.TaskQueuePause{
+			Mode:      enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED,
+			StartTime: timestamppb.New(time.Now().Add(300 * time.Millisecond)),
+		},
+		Identity: "operator",
+	})
+	s.NoError(err)
+
+	errC := make(chan error, 1)
+	go func() {
+		_, err := s.matchingEngine.PollWorkflowTaskQueue(context.Background(), &matchingservice.PollWorkflowTaskQueueRequest{
+			NamespaceId: namespaceId,
+			PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{TaskQueue: taskQueue, Identity: "nobody"},
+		}, metrics.NoopMetricsHandler)
+		errC <- err
+	}()
+	s.Eventually(func() bool { return numBlockablePolls() == 1 }, time.Second, time.Millisecond)
+
+	// the poll is evicted from the matcher when the pause starts, and keeps waiting for the pause
+	// to end
+	s.Eventually(func() bool { return numBlockablePolls() == 0 }, time.Second, 10*time.Millisecond)
+	s.EqualValues(1, pqm.currentPolls.Load())
+	s.NoError(<-errC)
+}
+
+func (s *matchingEngineSuite) TestBlockedPollers() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueue(10 * time.Millisecond)
+
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	execution := &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"}
+	pollRequest := func(identity string) *matchingservice.PollWorkflowTaskQueueRequest {
+		return &matchingservice.PollWorkflowTaskQueueRequest{
+			NamespaceId: namespaceId,
+			PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  identity,
+			},
+		}
+	}
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordWorkflowTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           5,
+			Attempt:                    1,
+			WorkflowExecutionTaskQueue: taskQueue,
+			History:                    &historypb.History{},
+		}, nil).AnyTimes()
+
+	// user data is owned by the workflow root partition, so test with workflow tasks
+	resp, err := s.matchingEngine.UpdateBlockedPollers(context.Background(), &matchingservice.UpdateBlockedPollersRequest{
+		NamespaceId:     namespaceId,
+		TaskQueue:       taskQueue.GetName(),
+		TaskQueueType:   enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		BlockIdentities: []string{"bad-worker"},
+		BlockDuration:   durationpb.New(time.Hour),
+		Reason:          "bad deploy",
+		Identity:        "operator",
+	})
+	s.NoError(err)
+	s.Len(resp.GetBlockedPollers(), 1)
+	s.Equal("bad-worker", resp.GetBlockedPollers()[0].GetIdentity())
+
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              execution,
+		ScheduledEventId:       5,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	})
+	s.NoError(err)
+
+	// the blocked poller doesn't get the task
+	ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+100*time.Millisecond)
+	defer cancel()
+	result, err := s.matchingEngine.PollWorkflowTaskQueue(ctx, pollRequest("bad-worker"), metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.Empty(result.GetTaskToken())
+
+	result, err = s.matchingEngine.PollWorkflowTaskQueue(context.Background(), pollRequest("good-worker"), metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.NotEmpty(result.GetTaskToken())
+
+	describeResp, err := s.matchingEngine.DescribeTaskQueuePartition(context.Background(), &matchingservice.DescribeTaskQueuePartitionRequest{
+		NamespaceId: namespaceId,
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     taskQueue.GetName(),
+			TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		},
+		Versions:      &taskqueuepb.TaskQueueVersionSelection{Unversioned: true},
+		ReportPollers: true,
+	})
+	s.NoError(err)
+	stats := describeResp.GetVersionsInfoInternal()[""].GetPhysicalTaskQueueInfo().GetPollerDispatchStats()
+	s.Len(stats, 1)
+	s.Equal("good-worker", stats[0].GetIdentity())
+	s.EqualValues(1, stats[0].GetTasksDispatched())
+	s.Equal(execution.GetWorkflowId(), stats[0].GetLastWorkflowExecution().GetWorkflowId())
+
+	// unblocking removes the entry
+	resp, err = s.matchingEngine.UpdateBlockedPollers(context.Background(), &matchingservice.UpdateBlockedPollersRequest{
+		NamespaceId:       namespaceId,
+		TaskQueue:         taskQueue.GetName(),
+		TaskQueueType:     enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		UnblockIdentities: []string{"bad-worker"},
+	})
+	s.NoError(err)
+	s.Empty(resp.GetBlockedPollers())
+}
+
+func (s *matchingEngineSuite) TestBlockedPollers_EvictsWaitingPoll() {
+	namespaceId := uuid.New()
+	taskQueue := &taskqueuepb.TaskQueue{Name: "makeToast", Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	pollRequest := func(identity string) *matchingservice.PollWorkflowTaskQueueRequest {
+		return &matchingservice.PollWorkflowTaskQueueRequest{
+			NamespaceId: namespaceId,
+			PollRequest: &workflowservice.PollWorkflowTaskQueueRequest{
+				TaskQueue: taskQueue,
+				Identity:  identity,
+			},
+		}
+	}
+	s.mockHistoryClient.EXPECT().RecordWorkflowTaskStarted(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&historyservice.RecordWorkflowTaskStartedResponse{
+			WorkflowType:               &commonpb.WorkflowType{Name: "workflow"},
+			ScheduledEventId:           5,
+			Attempt:                    1,
+			WorkflowExecutionTaskQueue: taskQueue,
+			History:                    &historypb.History{},
+		}, nil).AnyTimes()
+
+	prtn := newRootPartition(namespaceId, taskQueue.GetName(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	tqm, _, err := s.matchingEngine.getTaskQueuePartitionManager(context.Background(), prtn, true, loadCauseUnspecified)
+	s.Require().NoError(err)
+	pqm := tqm.(*taskQueuePartitionManagerImpl).defaultQueue.(*physicalTaskQueueManagerImpl)
+
+	// the poll is waiting in the matcher when its identity is blocked
+	type pollResult struct {
+		resp *matchingservice.PollWorkflowTaskQueueResponse
+		err  error
+	}
+	resultC := make(chan pollResult, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+time.Second)
+		defer cancel()
+		resp, err := s.matchingEngine.PollWorkflowTaskQueue(ctx, pollRequest("bad-worker"), metrics.NoopMetricsHandler)
+		resultC <- pollResult{resp: resp, err: err}
+	}()
+	s.Eventually(func() bool { return pqm.currentPolls.Load() == 1 }, time.Second, time.Millisecond)
+
+	_, err = s.matchingEngine.UpdateBlockedPollers(context.Background(), &matchingservice.UpdateBlockedPollersRequest{
+		NamespaceId:     namespaceId,
+		TaskQueue:       taskQueue.GetName(),
+		TaskQueueType:   enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		BlockIdentities: []string{"bad-worker"},
+		BlockDuration:   durationpb.New(time.Hour),
+		Identity:        "operator",
+	})
+	s.NoError(err)
+	_, _, err = s.matchingEngine.AddWorkflowTask(context.Background(), &matchingservice.AddWorkflowTaskRequest{
+		NamespaceId:            namespaceId,
+		Execution:              &commonpb.WorkflowExecution{RunId: uuid.NewRandom().String(), WorkflowId: "workflow1"},
+		ScheduledEventId:       5,
+		TaskQueue:              taskQueue,
+		ScheduleToStartTimeout: timestamp.DurationFromSeconds(100),
+	})
+	s.NoError(err)
+
+	result := <-resultC
+	s.NoError(result.err)
+	s.Empty(result.resp.GetTaskToken())
+
+	// the task was kept for the other pollers
+	resp, err := s.matchingEngine.PollWorkflowTaskQueue(context.Background(), pollRequest("good-worker"), metrics.NoopMetricsHandler)
+	s.NoError(err)
+	s.NotEmpty(resp.GetTaskToken())
+}
+
 func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	if s.newMatcher {
 		s.T().Skip("not supported by new matcher; flaky")
@@ -3827,6 +4170,27 @@ func (m *testTaskManager) CompleteTasksLessThan(
 	return persistence.UnknownNumRowsAffected, nil
 }
 
+func (m *testTaskManager) CompleteTasks(
+	_ context.Context,
+	request *persistence.CompleteTasksRequest,
+) error {
+	m.delay()
+	defer m.delay()
+
+	tlm := m.getQueueData(request.TaskQueueName, request.NamespaceID, request.TaskType)
+	tlm.Lock()
+	defer tlm.Unlock()
+	for _, task := range request.Tasks {
+		if m.fairness && task.TaskPass < 1 {
+			return serviceerror.NewInternal("invalid CompleteTasks request on fair queue")
+		} else if !m.fairness && task.TaskPass != 0 {
+			return serviceerror.NewInternal("invalid CompleteTasks request on queue")
+		}
+		tlm.tasks.Remove(fairLevelFromAllocatedTask(task))
+	}
+	return nil
+}
+
 func (m *testTaskManager) ListTaskQueue(
 	_ context.Context,
 	_ *persistence.ListTaskQueueRequest,
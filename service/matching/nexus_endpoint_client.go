@@ -8,11 +8,14 @@ import (
 	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
@@ -34,8 +37,12 @@ type (
 		endpointID string
 		version    int64
 		spec       *persistencespb.NexusEndpointSpec
-		clusterID  int64
-		timeSource clock.TimeSource
+		// accessPolicy replaces the policy of the previous entry when set.
+		accessPolicy *persistencespb.NexusEndpointAccessPolicy
+		// circuitBreakerOverride replaces the override of the previous entry when set.
+		circuitBreakerOverride *persistencespb.NexusEndpointCircuitBreakerOverride
+		clusterID              int64
+		timeSource             clock.TimeSource
 	}
 
 	internalCreateNexusEndpointRequest struct {
@@ -67,16 +74,19 @@ type (
 		endpointsRefreshInterval dynamicconfig.DurationPropertyFn
 
 		persistence p.NexusEndpointManager
+		timeSource  clock.TimeSource
 	}
 )
 
 func newEndpointClient(
 	endpointsRefreshInterval dynamicconfig.DurationPropertyFn,
 	persistence p.NexusEndpointManager,
+	timeSource clock.TimeSource,
 ) *nexusEndpointClient {
 	return &nexusEndpointClient{
 		endpointsRefreshInterval: endpointsRefreshInterval,
 		persistence:              persistence,
+		timeSource:               timeSource,
 		tableVersionChanged:      make(chan struct{}),
 	}
 }
@@ -156,21 +166,44 @@ func (m *nexusEndpointClient) UpdateNexusEndpoint(
 		return nil, serviceerror.NewFailedPreconditionf("nexus endpoint version mismatch. received: %v expected %v", request.version, previous.Version)
 	}
 
+	accessPolicy := previous.Endpoint.AccessPolicy
+	if request.accessPolicy != nil {
+		accessPolicy = request.accessPolicy
+	}
+	override := previous.Endpoint.CircuitBreakerOverride
+	if request.circuitBreakerOverride != nil {
+		override = request.circuitBreakerOverride
+	}
+	if !overrideActive(override, request.timeSource.Now()) {
+		override = nil
+	}
+
 	entry := &persistencespb.NexusEndpointEntry{
 		Version: previous.Version,
 		Id:      previous.Id,
 		Endpoint: &persistencespb.NexusEndpoint{
-			Clock: hlc.Next(previous.Endpoint.Clock, request.timeSource),
-			Spec:  request.spec,
+			Clock:                  hlc.Next(previous.Endpoint.Clock, request.timeSource),
+			Spec:                   request.spec,
+			AccessPolicy:           accessPolicy,
+			CircuitBreakerOverride: override,
 		},
 	}
+	if err := m.updateEndpointLocked(ctx, entry); err != nil {
+		return nil, err
+	}
 
+	return &matchingservice.UpdateNexusEndpointResponse{
+		Entry: entry,
+	}, nil
+}
+
+func (m *nexusEndpointClient) updateEndpointLocked(ctx context.Context, entry *persistencespb.NexusEndpointEntry) error {
 	resp, err := m.persistence.CreateOrUpdateNexusEndpoint(ctx, &p.CreateOrUpdateNexusEndpointRequest{
 		LastKnownTableVersion: m.tableVersion,
 		Entry:                 entry,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	entry.Version = resp.Version
@@ -181,10 +214,13 @@ func (m *nexusEndpointClient) UpdateNexusEndpoint(
 	ch := m.tableVersionChanged
 	m.tableVersionChanged = make(chan struct{})
 	close(ch)
+	return nil
+}
 
-	return &matchingservice.UpdateNexusEndpointResponse{
-		Entry: entry,
-	}, nil
+// overrideActive returns whether a circuit breaker override is set and hasn't expired at the given time.
+func overrideActive(override *persistencespb.NexusEndpointCircuitBreakerOverride, now time.Time) bool {
+	return override.GetOverride() != enumsspb.CIRCUIT_BREAKER_OVERRIDE_UNSPECIFIED &&
+		now.Before(override.GetExpirationTime().AsTime())
 }
 
 func (m *nexusEndpointClient) insertEndpointLocked(entry *persistencespb.NexusEndpointEntry) {
@@ -380,6 +416,7 @@ func (m *nexusEndpointClient) notifyOwnershipChanged(isOwner bool) {
 func (m *nexusEndpointClient) refreshTableVersion(ctx context.Context) error {
 	for ctx.Err() == nil {
 		m.checkTableVersion(ctx)
+		m.clearExpiredOverrides(ctx)
 		util.InterruptibleSleep(ctx, backoff.Jitter(m.endpointsRefreshInterval(), 0.2))
 	}
 	return ctx.Err()
@@ -401,3 +438,28 @@ func (m *nexusEndpointClient) checkTableVersion(ctx context.Context) {
 		close(ch)
 	}
 }
+
+// clearExpiredOverrides removes expired circuit breaker overrides from the endpoints. Failures are left for the next
+// refresh, expired overrides have no effect in the meantime.
+func (m *nexusEndpointClient) clearExpiredOverrides(ctx context.Context) {
+	if !m.hasLoadedEndpoints.Load() {
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	now := m.timeSource.Now()
+	for _, previous := range m.endpointEntries {
+		override := previous.GetEndpoint().GetCircuitBreakerOverride()
+		if override == nil || overrideActive(override, now) {
+			continue
+		}
+		entry := common.CloneProto(previous)
+		entry.Endpoint.Clock = hlc.Next(previous.Endpoint.Clock, m.timeSource)
+		entry.Endpoint.CircuitBreakerOverride = nil
+		if err := m.updateEndpointLocked(ctx, entry); err != nil {
+			return
+		}
+	}
+}
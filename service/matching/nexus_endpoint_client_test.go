@@ -0,0 +1,116 @@
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	p "go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestEndpointClient(
+	t *testing.T,
+	timeSource clock.TimeSource,
+	entry *persistencespb.NexusEndpointEntry,
+) (*nexusEndpointClient, *p.MockNexusEndpointManager) {
+	persistence := p.NewMockNexusEndpointManager(gomock.NewController(t))
+	persistence.EXPECT().ListNexusEndpoints(gomock.Any(), gomock.Any()).Return(&p.ListNexusEndpointsResponse{
+		TableVersion: 1,
+		Entries:      []*persistencespb.NexusEndpointEntry{entry},
+	}, nil)
+	client := newEndpointClient(dynamicconfig.GetDurationPropertyFn(time.Minute), persistence, timeSource)
+	require.NoError(t, client.loadEndpoints(context.Background()))
+	return client, persistence
+}
+
+func newTestEndpointEntry(override *persistencespb.NexusEndpointCircuitBreakerOverride) *persistencespb.NexusEndpointEntry {
+	return &persistencespb.NexusEndpointEntry{
+		Version: 1,
+		Id:      "endpoint-id",
+		Endpoint: &persistencespb.NexusEndpoint{
+			Clock:                  hlc.Zero(1),
+			Spec:                   &persistencespb.NexusEndpointSpec{Name: "endpoint"},
+			CircuitBreakerOverride: override,
+		},
+	}
+}
+
+func TestNexusEndpointClient_UpdateCircuitBreakerOverride(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	active := &persistencespb.NexusEndpointCircuitBreakerOverride{
+		Override:       enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN,
+		ExpirationTime: timestamppb.New(timeSource.Now().Add(time.Hour)),
+	}
+	expired := &persistencespb.NexusEndpointCircuitBreakerOverride{
+		Override:       enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_OPEN,
+		ExpirationTime: timestamppb.New(timeSource.Now().Add(-time.Minute)),
+	}
+
+	testCases := []struct {
+		name     string
+		previous *persistencespb.NexusEndpointCircuitBreakerOverride
+		request  *persistencespb.NexusEndpointCircuitBreakerOverride
+		expected *persistencespb.NexusEndpointCircuitBreakerOverride
+	}{
+		{name: "set", request: active, expected: active},
+		{name: "preserve", previous: active, expected: active},
+		{
+			name:     "clear",
+			previous: active,
+			request:  &persistencespb.NexusEndpointCircuitBreakerOverride{},
+		},
+		{name: "drop expired", previous: expired},
+		{name: "ignore expired", request: expired},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			previous := newTestEndpointEntry(tc.previous)
+			client, persistence := newTestEndpointClient(t, timeSource, previous)
+			persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).Return(&p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil)
+
+			resp, err := client.UpdateNexusEndpoint(context.Background(), &internalUpdateNexusEndpointRequest{
+				endpointID:             previous.Id,
+				version:                previous.Version,
+				spec:                   previous.Endpoint.Spec,
+				circuitBreakerOverride: tc.request,
+				timeSource:             timeSource,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resp.GetEntry().GetEndpoint().GetCircuitBreakerOverride())
+		})
+	}
+}
+
+func TestNexusEndpointClient_ClearExpiredOverrides(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	client, persistence := newTestEndpointClient(t, timeSource, newTestEndpointEntry(&persistencespb.NexusEndpointCircuitBreakerOverride{
+		Override:       enumsspb.CIRCUIT_BREAKER_OVERRIDE_FORCE_CLOSED,
+		ExpirationTime: timestamppb.New(timeSource.Now().Add(time.Hour)),
+	}))
+
+	// Active overrides are kept.
+	client.clearExpiredOverrides(context.Background())
+
+	timeSource.Advance(time.Hour)
+	persistence.EXPECT().CreateOrUpdateNexusEndpoint(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *p.CreateOrUpdateNexusEndpointRequest) (*p.CreateOrUpdateNexusEndpointResponse, error) {
+			require.Equal(t, int64(1), request.LastKnownTableVersion)
+			require.Nil(t, request.Entry.GetEndpoint().GetCircuitBreakerOverride())
+			require.Equal(t, "endpoint", request.Entry.GetEndpoint().GetSpec().GetName())
+			return &p.CreateOrUpdateNexusEndpointResponse{Version: 2}, nil
+		})
+	client.clearExpiredOverrides(context.Background())
+	require.Nil(t, client.endpointsByID["endpoint-id"].GetEndpoint().GetCircuitBreakerOverride())
+	require.Equal(t, int64(2), client.endpointsByID["endpoint-id"].GetVersion())
+
+	// Nothing is left to clear.
+	client.clearExpiredOverrides(context.Background())
+}
@@ -73,6 +73,7 @@ type (
 		cancelMatcherSub  func()
 		cancelFairnessSub func()
 		backlogMgr        backlogManager
+		fairnessKeys      *fairnessKeyTracker // only set when fairness is enabled
 		liveness          *liveness
 		oldMatcher        *TaskMatcher // TODO(pri): old matcher cleanup
 		priMatcher        *priTaskMatcher
@@ -94,6 +95,11 @@ type (
 		taskTrackerLock sync.RWMutex
 		tasksAdded      map[priorityKey]*taskTracker
 		tasksDispatched map[priorityKey]*taskTracker
+
+		blockablePollsLock sync.Mutex
+		blockablePolls     map[*blockablePoll]struct{}
+		pauseStart         time.Time // start of a scheduled pause, zero if there is none
+		pauseStartTimer    clock.Timer
 	}
 
 	// TODO(pri): old matcher cleanup
@@ -196,7 +202,7 @@ func newPhysicalTaskQueueManager(
 			return counter.NewHybridCounter(config.FairnessCounter(), src)
 		}
 
-		pqMgr.backlogMgr = newFairBacklogManager(
+		fairBacklogMgr := newFairBacklogManager(
 			tqCtx,
 			pqMgr,
 			config,
@@ -207,6 +213,8 @@ func newPhysicalTaskQueueManager(
 			newFairMetricsHandler(taggedMetricsHandler),
 			counterFactory,
 		)
+		pqMgr.backlogMgr = fairBacklogMgr
+		pqMgr.fairnessKeys = fairBacklogMgr.keyTracker
 		var fwdr *priForwarder
 		var err error
 		if isChild {
@@ -308,6 +316,8 @@ func (c *physicalTaskQueueManagerImpl) Start() {
 		return
 	}
 	c.liveness.Start()
+	// user data may have been loaded before this queue, so we won't necessarily get UserDataChanged
+	c.updateFairnessWeights()
 	c.backlogMgr.Start()
 	c.matcher.Start()
 	c.logger.Info("Started physicalTaskQueueManager", tag.LifeCycleStarted, tag.Cause(c.config.loadCause.String()))
@@ -335,6 +345,7 @@ func (c *physicalTaskQueueManagerImpl) Stop(unloadCause unloadCause) {
 	c.backlogMgr.Stop()
 	c.matcher.Stop()
 	c.liveness.Stop()
+	c.stopPollEviction()
 	c.tqCtxCancel()
 	c.logger.Info("Stopped physicalTaskQueueManager", tag.LifeCycleStopped, tag.Cause(unloadCause.String()))
 	c.metricsHandler.Counter(metrics.TaskQueueStoppedCounter.Name()).Record(1)
@@ -383,13 +394,28 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 		}
 	}
 
-	if !namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) {
-		return c.matcher.PollForQuery(ctx, pollMetadata)
-	}
-
+	identity, _ := ctx.Value(identityKey).(string)
+	isActive := namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName())
 	for {
-		task, err := c.matcher.Poll(ctx, pollMetadata)
+		// Hold the poll while an operator paused the task queue or blocked the poller. New tasks are
+		// still accepted.
+		if err = c.waitUntilDispatchAllowed(ctx, identity); err != nil {
+			return nil, err
+		}
+
+		pollCtx, stopEviction := c.evictWhenDispatchBlocked(ctx, identity)
+		var task *internalTask
+		if isActive {
+			task, err = c.matcher.Poll(pollCtx, pollMetadata)
+		} else {
+			task, err = c.matcher.PollForQuery(pollCtx, pollMetadata)
+		}
+		stopEviction()
 		if err != nil {
+			if errors.Is(context.Cause(pollCtx), errPollEvicted) && ctx.Err() == nil {
+				// go back to waiting until tasks can be dispatched again
+				continue
+			}
 			return nil, err
 		}
 
@@ -407,12 +433,26 @@ func (c *physicalTaskQueueManagerImpl) PollTask(
 			continue
 		}
 
+		if !task.isStarted() && c.isDispatchBlocked(identity) {
+			// Put the task back: backlog tasks are matched again, sync match tasks are matched
+			// again or spooled by the caller. Started tasks were forwarded by the parent partition,
+			// which checked the pause and the poller block itself.
+			task.finish(errReprocessTask, true)
+			continue
+		}
+		if !isActive {
+			return task, nil
+		}
+
 		task.namespace = c.partitionMgr.ns.Name()
 		task.backlogCountHint = c.backlogCountHint
 
 		if pollMetadata.forwardedFrom == "" && // only track the original polls, not forwarded ones.
 			(!task.isStarted() || !task.started.hasEmptyResponse()) { // Need to filter out the empty "started" ones
 			c.getOrCreateTaskTracker(c.tasksDispatched, priorityKey(task.getPriority().GetPriorityKey())).incrementTaskCount()
+			if identity != "" {
+				c.pollerHistory.recordDispatch(pollerIdentity(identity), task, c.partitionMgr.engine.timeSource.Now())
+			}
 		}
 		return task, nil
 	}
@@ -467,9 +507,32 @@ func (c *physicalTaskQueueManagerImpl) AddSpooledTaskToMatcher(task *internalTas
 }
 
 func (c *physicalTaskQueueManagerImpl) UserDataChanged() {
+	c.updateFairnessWeights()
+	c.evictBlockedPolls()
 	c.matcher.ReprocessAllTasks()
 }
 
+// updateFairnessWeights applies the fairness weights from user data to the backlog and matcher.
+func (c *physicalTaskQueueManagerImpl) updateFairnessWeights() {
+	if c.fairnessKeys == nil {
+		return
+	}
+	perType, _, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		return
+	}
+	weights := perType.GetFairnessWeights()
+	c.fairnessKeys.setWeights(weights)
+	c.priMatcher.UpdateFairnessWeights(weights)
+}
+
+func (c *physicalTaskQueueManagerImpl) GetFairnessKeyStats(topN int) (byBacklog, byDispatchRate []*taskqueuespb.FairnessKeyStats) {
+	if c.fairnessKeys == nil {
+		return nil, nil
+	}
+	return c.fairnessKeys.top(topN)
+}
+
 // DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 // if dispatched to local poller then nil and nil is returned.
 func (c *physicalTaskQueueManagerImpl) DispatchQueryTask(
@@ -522,6 +585,10 @@ func (c *physicalTaskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerI
 	return res
 }
 
+func (c *physicalTaskQueueManagerImpl) GetPollerDispatchStats() []*taskqueuespb.PollerDispatchStats {
+	return c.pollerHistory.getDispatchStats()
+}
+
 func (c *physicalTaskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	if c.currentPolls.Load() > 0 {
 		return true
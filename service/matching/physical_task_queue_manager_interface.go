@@ -44,11 +44,16 @@ type (
 		DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error)
 		UpdatePollerInfo(pollerIdentity, *pollMetadata)
 		GetAllPollerInfo() []*taskqueuepb.PollerInfo
+		// GetPollerDispatchStats returns the tasks dispatched to each poller identity that polled recently
+		GetPollerDispatchStats() []*taskqueuespb.PollerDispatchStats
 		HasPollerAfter(accessTime time.Time) bool
 		// LegacyDescribeTaskQueue returns pollers info and legacy TaskQueueStatus for this physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		GetStatsByPriority() map[int32]*taskqueuepb.TaskQueueStats
 		GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus
+		// GetFairnessKeyStats returns the fairness keys with the largest backlogs and dispatch rates.
+		// It returns nothing when fairness is not enabled.
+		GetFairnessKeyStats(topN int) (byBacklog, byDispatchRate []*taskqueuespb.FairnessKeyStats)
 		UnloadFromPartitionManager(unloadCause)
 		QueueKey() *PhysicalTaskQueueKey
 		// MakePollerScalingDecision makes a decision on whether to scale pollers up or down based on the current state
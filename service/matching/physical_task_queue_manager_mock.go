@@ -129,6 +129,21 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllPollerInfo() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllPollerInfo))
 }
 
+// GetFairnessKeyStats mocks base method.
+func (m *MockphysicalTaskQueueManager) GetFairnessKeyStats(topN int) ([]*taskqueue0.FairnessKeyStats, []*taskqueue0.FairnessKeyStats) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFairnessKeyStats", topN)
+	ret0, _ := ret[0].([]*taskqueue0.FairnessKeyStats)
+	ret1, _ := ret[1].([]*taskqueue0.FairnessKeyStats)
+	return ret0, ret1
+}
+
+// GetFairnessKeyStats indicates an expected call of GetFairnessKeyStats.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetFairnessKeyStats(topN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFairnessKeyStats", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetFairnessKeyStats), topN)
+}
+
 // GetInternalTaskQueueStatus mocks base method.
 func (m *MockphysicalTaskQueueManager) GetInternalTaskQueueStatus() []*taskqueue0.InternalTaskQueueStatus {
 	m.ctrl.T.Helper()
@@ -143,6 +158,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetInternalTaskQueueStatus()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalTaskQueueStatus", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetInternalTaskQueueStatus))
 }
 
+// GetPollerDispatchStats mocks base method.
+func (m *MockphysicalTaskQueueManager) GetPollerDispatchStats() []*taskqueue0.PollerDispatchStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPollerDispatchStats")
+	ret0, _ := ret[0].([]*taskqueue0.PollerDispatchStats)
+	return ret0
+}
+
+// GetPollerDispatchStats indicates an expected call of GetPollerDispatchStats.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetPollerDispatchStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPollerDispatchStats", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetPollerDispatchStats))
+}
+
 // GetStatsByPriority mocks base method.
 func (m *MockphysicalTaskQueueManager) GetStatsByPriority() map[int32]*taskqueue.TaskQueueStats {
 	m.ctrl.T.Helper()
@@ -1,9 +1,13 @@
 package matching
 
 import (
+	"sync"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/cache"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -19,12 +23,22 @@ type (
 	pollerInfo struct {
 		pollMetadata
 	}
+
+	pollerDispatchStats struct {
+		sync.Mutex
+		tasksDispatched       int64
+		lastDispatchTime      time.Time
+		lastWorkflowExecution *commonpb.WorkflowExecution
+		lastTaskSource        enumsspb.TaskSource
+	}
 )
 
 type pollerHistory struct {
 	// poller ID -> pollerInfo
 	// pollers map[pollerID]pollerInfo
 	history cache.Cache
+	// poller ID -> *pollerDispatchStats
+	dispatchStats cache.Cache
 }
 
 func newPollerHistory(pollerHistoryTTL time.Duration) *pollerHistory {
@@ -34,7 +48,8 @@ func newPollerHistory(pollerHistoryTTL time.Duration) *pollerHistory {
 	}
 
 	return &pollerHistory{
-		history: cache.New(pollerHistoryInitMaxSize, opts),
+		history:       cache.New(pollerHistoryInitMaxSize, opts),
+		dispatchStats: cache.New(pollerHistoryInitMaxSize, opts),
 	}
 }
 
@@ -66,6 +81,47 @@ func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*tas
 	return result
 }
 
+func (pollers *pollerHistory) recordDispatch(id pollerIdentity, task *internalTask, now time.Time) {
+	v, err := pollers.dispatchStats.PutIfNotExist(id, &pollerDispatchStats{})
+	if err != nil {
+		return
+	}
+	stats := v.(*pollerDispatchStats)
+	stats.Lock()
+	stats.tasksDispatched++
+	stats.lastDispatchTime = now
+	stats.lastWorkflowExecution = nil
+	if execution := task.workflowExecution(); execution.GetWorkflowId() != "" {
+		stats.lastWorkflowExecution = execution
+	}
+	stats.lastTaskSource = task.source
+	stats.Unlock()
+	// put it again to extend the TTL while the poller receives tasks
+	pollers.dispatchStats.Put(id, stats)
+}
+
+func (pollers *pollerHistory) getDispatchStats() []*taskqueuespb.PollerDispatchStats {
+	var result []*taskqueuespb.PollerDispatchStats
+
+	ite := pollers.dispatchStats.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		stats := entry.Value().(*pollerDispatchStats)
+		stats.Lock()
+		result = append(result, &taskqueuespb.PollerDispatchStats{
+			Identity:              string(entry.Key().(pollerIdentity)),
+			TasksDispatched:       stats.tasksDispatched,
+			LastDispatchTime:      timestamppb.New(stats.lastDispatchTime),
+			LastWorkflowExecution: stats.lastWorkflowExecution,
+			LastTaskSource:        stats.lastTaskSource,
+		})
+		stats.Unlock()
+	}
+
+	return result
+}
+
 func defaultRPS(wrapper *wrapperspb.DoubleValue) float64 {
 	if wrapper != nil {
 		return wrapper.Value
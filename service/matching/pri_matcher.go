@@ -9,6 +9,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -506,6 +507,11 @@ func (tm *priTaskMatcher) UpdateRatelimit(rps float64) {
 	tm.setLimitLocked()
 }
 
+// UpdateFairnessWeights sets the fairness key weights used to scale per-key rate limits.
+func (tm *priTaskMatcher) UpdateFairnessWeights(weights *persistencespb.FairnessWeights) {
+	tm.data.UpdateFairnessWeights(weights)
+}
+
 func (tm *priTaskMatcher) setAdminNsRate(rps float64) {
 	tm.limiterLock.Lock()
 	defer tm.limiterLock.Unlock()
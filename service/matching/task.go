@@ -81,6 +81,9 @@ type (
 		forwardRes any // note this may be a non-nil "any" containing a nil pointer
 		forwardErr error
 		startErr   error
+		// dropped is set when the task was removed without being dispatched, e.g. because it
+		// expired or history rejected it as invalid.
+		dropped bool
 	}
 )
 
@@ -308,7 +311,7 @@ func (task *internalTask) setEvicted() {
 // so finish will call the rate limiter's RecycleToken to give the unused token back to any process
 // that is waiting on the token, if one exists.
 func (task *internalTask) finish(err error, wasValid bool) {
-	res := taskResponse{startErr: err}
+	res := taskResponse{startErr: err, dropped: err == nil && !wasValid}
 	task.finishInternal(res, wasValid)
 }
 
@@ -164,6 +164,12 @@ func (pm *taskQueuePartitionManagerImpl) AddTask(
 ) (buildId string, syncMatched bool, err error) {
 	var spoolQueue, syncMatchQueue physicalTaskQueueManager
 	directive := params.taskInfo.GetVersionDirective()
+	if params.forwardInfo == nil {
+		// Forwarded tasks were already accepted by a child partition.
+		if err := pm.checkNotDraining(); err != nil {
+			return "", false, err
+		}
+	}
 	// spoolQueue will be nil iff task is forwarded.
 reredirectTask:
 	spoolQueue, syncMatchQueue, _, err = pm.getPhysicalQueuesForAdd(ctx, directive, params.forwardInfo, params.taskInfo.GetRunId(), params.taskInfo.GetWorkflowId(), false)
@@ -206,7 +212,8 @@ reredirectTask:
 			return "", syncMatched, err
 		} else if errors.Is(err, errReprocessTask) {
 			// We get this if userdata changed while the task was blocked in TrySyncMatch
-			// (only for backlog tasks forwarded to root with the new matcher)
+			// (only for backlog tasks forwarded to root with the new matcher), or if the task
+			// queue was paused after the task was matched with a poller.
 			goto reredirectTask
 		}
 		// other errors are ignored and we try to spool the task
@@ -227,6 +234,10 @@ reredirectTask:
 }
 
 func (pm *taskQueuePartitionManagerImpl) shouldBacklogSyncMatchTaskOnError(err error) bool {
+	if errors.Is(err, errReprocessTask) {
+		// The poller gave the task back because the task queue was paused after the match.
+		return true
+	}
 	var resourceExhaustedErr *serviceerror.ResourceExhausted
 	if err != nil && errors.As(err, &resourceExhaustedErr) {
 		if resourceExhaustedErr.Cause == enumspb.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW {
@@ -625,6 +636,19 @@ func (pm *taskQueuePartitionManagerImpl) LegacyDescribeTaskQueue(includeTaskQueu
 	return resp, nil
 }
 
+func (pm *taskQueuePartitionManagerImpl) DescribeFairnessKeys(topN int) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	perType, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return nil, err
+	}
+	byBacklog, byDispatchRate := pm.defaultQueue.GetFairnessKeyStats(topN)
+	return &matchingservice.DescribeFairnessKeysResponse{
+		TopByBacklog:      byBacklog,
+		TopByDispatchRate: byDispatchRate,
+		FairnessWeights:   perType.GetFairnessWeights(),
+	}, nil
+}
+
 // In order to accommodate the changes brought in by versioning-3.1, `buildIDs` will now also accept versionID's that represent worker-deployment versions.
 func (pm *taskQueuePartitionManagerImpl) Describe(
 	ctx context.Context,
@@ -694,6 +718,7 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 		}
 		if reportPollers {
 			vInfo.PhysicalTaskQueueInfo.Pollers = physicalQueue.GetAllPollerInfo()
+			vInfo.PhysicalTaskQueueInfo.PollerDispatchStats = physicalQueue.GetPollerDispatchStats()
 		}
 		if reportStats {
 			vInfo.PhysicalTaskQueueInfo.TaskQueueStatsByPriorityKey = physicalQueue.GetStatsByPriority()
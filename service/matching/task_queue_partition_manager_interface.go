@@ -57,6 +57,8 @@ type (
 		// LegacyDescribeTaskQueue returns information about all pollers of this partition and the status of its unversioned physical queue
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) (*matchingservice.DescribeTaskQueueResponse, error)
 		Describe(ctx context.Context, buildIds map[string]bool, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus bool) (*matchingservice.DescribeTaskQueuePartitionResponse, error)
+		// DescribeFairnessKeys returns the fairness weights and the top fairness keys of the unversioned queue.
+		DescribeFairnessKeys(topN int) (*matchingservice.DescribeFairnessKeysResponse, error)
 		Partition() tqid.Partition
 		PartitionCount() int
 		LongPollExpirationInterval() time.Duration
@@ -90,6 +90,21 @@ func (mr *MocktaskQueuePartitionManagerMockRecorder) Describe(ctx, buildIds, inc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).Describe), ctx, buildIds, includeAllActive, reportStats, reportPollers, internalTaskQueueStatus)
 }
 
+// DescribeFairnessKeys mocks base method.
+func (m *MocktaskQueuePartitionManager) DescribeFairnessKeys(topN int) (*matchingservice.DescribeFairnessKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeFairnessKeys", topN)
+	ret0, _ := ret[0].(*matchingservice.DescribeFairnessKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFairnessKeys indicates an expected call of DescribeFairnessKeys.
+func (mr *MocktaskQueuePartitionManagerMockRecorder) DescribeFairnessKeys(topN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFairnessKeys", reflect.TypeOf((*MocktaskQueuePartitionManager)(nil).DescribeFairnessKeys), topN)
+}
+
 // DispatchNexusTask mocks base method.
 func (m *MocktaskQueuePartitionManager) DispatchNexusTask(ctx context.Context, taskId string, request *matchingservice.DispatchNexusTaskRequest) (*matchingservice.DispatchNexusTaskResponse, error) {
 	m.ctrl.T.Helper()
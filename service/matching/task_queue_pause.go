@@ -0,0 +1,233 @@
+package matching
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+type (
+	// blockablePoll is a poll waiting in the matcher, that is evicted when tasks can no longer be
+	// dispatched to it.
+	blockablePoll struct {
+		identity string
+		cancel   context.CancelCauseFunc
+	}
+)
+
+// errPollEvicted is the cause of the cancellation of a poll that was evicted from the matcher
+// because tasks can no longer be dispatched to it.
+var errPollEvicted = errors.New("poll evicted from matcher")
+
+// errTaskQueueDraining is not a ResourceExhausted error, so that history doesn't retry the task
+// like a throttled one.
+var errTaskQueueDraining = serviceerrors.NewTaskQueueDraining("Task queue is draining and does not accept new tasks")
+
+// getEffectivePauseMode returns the pause mode in effect at the given time, and the time at which
+// it changes next. The returned time is zero if the mode only changes with a user data update.
+func getEffectivePauseMode(pause *persistencespb.TaskQueuePause, now time.Time) (enumsspb.TaskQueuePauseMode, time.Time) {
+	mode := pause.GetMode()
+	if mode == enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED {
+		return mode, time.Time{}
+	}
+	if start := pause.GetStartTime(); start != nil && now.Before(start.AsTime()) {
+		return enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED, start.AsTime()
+	}
+	end := pause.GetEndTime()
+	if end == nil {
+		return mode, time.Time{}
+	}
+	if !now.Before(end.AsTime()) {
+		return enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED, time.Time{}
+	}
+	return mode, end.AsTime()
+}
+
+// checkNotDraining returns an error if an operator set the task queue to drain its backlog.
+func (pm *taskQueuePartitionManagerImpl) checkNotDraining() error {
+	perType, _, err := pm.getPerTypeUserData()
+	if err != nil {
+		return err
+	}
+	mode, _ := getEffectivePauseMode(perType.GetPause(), pm.engine.timeSource.Now())
+	if mode == enumsspb.TASK_QUEUE_PAUSE_MODE_DRAINING {
+		return errTaskQueueDraining
+	}
+	return nil
+}
+
+// getPollerBlockExpiry returns when the block of a poller identity ends, or zero if it isn't
+// blocked at the given time.
+func getPollerBlockExpiry(blocked []*persistencespb.BlockedPoller, identity string, now time.Time) time.Time {
+	if identity == "" {
+		return time.Time{}
+	}
+	for _, b := range blocked {
+		if b.GetIdentity() == identity && now.Before(b.GetExpireTime().AsTime()) {
+			return b.GetExpireTime().AsTime()
+		}
+	}
+	return time.Time{}
+}
+
+// waitUntilDispatchAllowed blocks while the task queue is paused or the poller identity is
+// blocked. Returns errNoTasks if the context is done first, so that the poll returns an empty
+// response.
+func (c *physicalTaskQueueManagerImpl) waitUntilDispatchAllowed(ctx context.Context, identity string) error {
+	timeSource := c.partitionMgr.engine.timeSource
+	for {
+		allowed, nextChange, userDataChanged, err := c.getDispatchState(identity)
+		if err != nil {
+			return err
+		}
+		if allowed {
+			return nil
+		}
+
+		var timerC <-chan time.Time
+		var timer clock.Timer
+		if !nextChange.IsZero() {
+			timerC, timer = timeSource.NewTimer(nextChange.Sub(timeSource.Now()))
+		}
+		done := false
+		select {
+		case <-ctx.Done():
+			done = true
+		case <-c.tqCtx.Done():
+			done = true
+		case <-userDataChanged:
+		case <-timerC:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if done {
+			return errNoTasks
+		}
+	}
+}
+
+// getDispatchState returns whether tasks can be dispatched to a poller with the given identity,
+// the time at which that changes next (zero if it only changes with a user data update), and a
+// channel that is closed on the next user data update.
+func (c *physicalTaskQueueManagerImpl) getDispatchState(identity string) (bool, time.Time, <-chan struct{}, error) {
+	perType, userDataChanged, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		return false, time.Time{}, nil, err
+	}
+	now := c.partitionMgr.engine.timeSource.Now()
+	_, nextChange := getEffectivePauseMode(perType.GetPause(), now)
+	blockExpiry := getPollerBlockExpiry(perType.GetBlockedPollers(), identity, now)
+	if !blockExpiry.IsZero() && (nextChange.IsZero() || blockExpiry.Before(nextChange)) {
+		nextChange = blockExpiry
+	}
+	return isDispatchAllowed(perType, identity, now), nextChange, userDataChanged, nil
+}
+
+// isDispatchAllowed returns whether tasks can be dispatched to a poller with the given identity at
+// the given time.
+func isDispatchAllowed(perType *persistencespb.TaskQueueTypeUserData, identity string, now time.Time) bool {
+	mode, _ := getEffectivePauseMode(perType.GetPause(), now)
+	return mode != enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED &&
+		getPollerBlockExpiry(perType.GetBlockedPollers(), identity, now).IsZero()
+}
+
+// evictWhenDispatchBlocked returns a context for a poll waiting in the matcher, that is canceled
+// with errPollEvicted as soon as tasks can no longer be dispatched to the poll, e.g. when an
+// operator pauses the task queue or blocks the poller after the poll started. Polls are evicted by
+// evictBlockedPolls on user data changes and when a scheduled pause starts. The returned function
+// must be called when the poll returns.
+func (c *physicalTaskQueueManagerImpl) evictWhenDispatchBlocked(ctx context.Context, identity string) (context.Context, func()) {
+	pollCtx, cancel := context.WithCancelCause(ctx)
+	poll := &blockablePoll{identity: identity, cancel: cancel}
+
+	c.blockablePollsLock.Lock()
+	if c.blockablePolls == nil {
+		c.blockablePolls = make(map[*blockablePoll]struct{})
+	}
+	c.blockablePolls[poll] = struct{}{}
+	c.blockablePollsLock.Unlock()
+
+	// Check after registering the poll: a user data change is either seen here, or evicts the poll.
+	perType, _, err := c.partitionMgr.getPerTypeUserData()
+	if err == nil {
+		now := c.partitionMgr.engine.timeSource.Now()
+		c.blockablePollsLock.Lock()
+		c.schedulePauseStartLocked(perType.GetPause(), now)
+		c.blockablePollsLock.Unlock()
+		if !isDispatchAllowed(perType, identity, now) {
+			cancel(errPollEvicted)
+		}
+	}
+
+	return pollCtx, func() {
+		c.blockablePollsLock.Lock()
+		delete(c.blockablePolls, poll)
+		c.blockablePollsLock.Unlock()
+		cancel(nil)
+	}
+}
+
+// evictBlockedPolls evicts the polls waiting in the matcher that tasks can no longer be
+// dispatched to. It is called on every user data change, and when a scheduled pause starts.
+func (c *physicalTaskQueueManagerImpl) evictBlockedPolls() {
+	perType, _, err := c.partitionMgr.getPerTypeUserData()
+	if err != nil {
+		return
+	}
+	now := c.partitionMgr.engine.timeSource.Now()
+
+	c.blockablePollsLock.Lock()
+	defer c.blockablePollsLock.Unlock()
+	c.schedulePauseStartLocked(perType.GetPause(), now)
+	for poll := range c.blockablePolls {
+		if !isDispatchAllowed(perType, poll.identity, now) {
+			poll.cancel(errPollEvicted)
+			delete(c.blockablePolls, poll)
+		}
+	}
+}
+
+// schedulePauseStartLocked makes sure that evictBlockedPolls runs when a scheduled pause starts.
+// Block expiry and the end of a pause only allow dispatch again, so they don't need a timer.
+func (c *physicalTaskQueueManagerImpl) schedulePauseStartLocked(pause *persistencespb.TaskQueuePause, now time.Time) {
+	var start time.Time
+	if mode, nextChange := getEffectivePauseMode(pause, now); pause.GetMode() == enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED &&
+		mode != enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED {
+		start = nextChange
+	}
+	if start.Equal(c.pauseStart) {
+		return
+	}
+	if c.pauseStartTimer != nil {
+		c.pauseStartTimer.Stop()
+		c.pauseStartTimer = nil
+	}
+	c.pauseStart = start
+	if !start.IsZero() {
+		c.pauseStartTimer = c.partitionMgr.engine.timeSource.AfterFunc(start.Sub(now), c.evictBlockedPolls)
+	}
+}
+
+// stopPollEviction stops the timer of a scheduled pause. Polls are canceled with tqCtx.
+func (c *physicalTaskQueueManagerImpl) stopPollEviction() {
+	c.blockablePollsLock.Lock()
+	defer c.blockablePollsLock.Unlock()
+	if c.pauseStartTimer != nil {
+		c.pauseStartTimer.Stop()
+		c.pauseStartTimer = nil
+	}
+}
+
+// isDispatchBlocked returns true if a task that was matched with a poll can't be dispatched to it
+// anymore. This covers the task queue being paused or the poller blocked after the match and
+// before the poll was evicted.
+func (c *physicalTaskQueueManagerImpl) isDispatchBlocked(identity string) bool {
+	allowed, _, _, err := c.getDispatchState(identity)
+	return err == nil && !allowed
+}
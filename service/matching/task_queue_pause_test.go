@@ -0,0 +1,55 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetEffectivePauseMode(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+	paused := enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED
+	unspecified := enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED
+	for _, tc := range []struct {
+		name           string
+		pause          *persistencespb.TaskQueuePause
+		expectedMode   enumsspb.TaskQueuePauseMode
+		expectedChange time.Time
+	}{
+		{"no_pause", nil, unspecified, time.Time{}},
+		{"indefinite", &persistencespb.TaskQueuePause{Mode: paused}, paused, time.Time{}},
+		{"not_started", &persistencespb.TaskQueuePause{Mode: paused, StartTime: timestamppb.New(after)}, unspecified, after},
+		{"started", &persistencespb.TaskQueuePause{Mode: paused, StartTime: timestamppb.New(before)}, paused, time.Time{}},
+		{"ends", &persistencespb.TaskQueuePause{Mode: paused, EndTime: timestamppb.New(after)}, paused, after},
+		{"ended", &persistencespb.TaskQueuePause{Mode: paused, EndTime: timestamppb.New(before)}, unspecified, time.Time{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mode, nextChange := getEffectivePauseMode(tc.pause, now)
+			require.Equal(t, tc.expectedMode, mode)
+			require.True(t, tc.expectedChange.Equal(nextChange))
+		})
+	}
+}
+
+func TestGetPollerBlockExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	after := now.Add(time.Hour)
+	blocked := []*persistencespb.BlockedPoller{
+		{Identity: "expired", ExpireTime: timestamppb.New(now.Add(-time.Hour))},
+		{Identity: "blocked", ExpireTime: timestamppb.New(after)},
+	}
+	require.True(t, after.Equal(getPollerBlockExpiry(blocked, "blocked", now)))
+	require.True(t, getPollerBlockExpiry(blocked, "expired", now).IsZero())
+	require.True(t, getPollerBlockExpiry(blocked, "other", now).IsZero())
+	require.True(t, getPollerBlockExpiry(blocked, "", now).IsZero())
+}
@@ -0,0 +1,93 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/tqid"
+)
+
+const (
+	handoverBatchSize = 100
+	handoverTimeout   = 10 * time.Second
+)
+
+func (m *registryImpl) listenerKey() string {
+	return fmt.Sprintf("workersRegistry[%p]", m)
+}
+
+// watchMembership hands the heartbeats of namespaces that are now owned by another matching host
+// over to that host as soon as membership changes, so that ListWorkers and DescribeWorker keep
+// returning the same workers. The heartbeats are persisted first, so that the new owner still
+// loads them if the handover fails.
+func (m *registryImpl) watchMembership() {
+	for {
+		select {
+		case <-m.quit:
+			return
+		case <-m.membershipChangedCh:
+		}
+
+		self := m.hostInfoProvider.HostInfo().Identity()
+		for _, nsID := range m.knownNamespaceIDs() {
+			if m.isOwner(nsID, self) {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+			persistErr := m.flushNamespace(ctx, nsID)
+			cancel()
+			if persistErr != nil {
+				m.logger.Warn("Failed to persist worker heartbeats before handover",
+					tag.WorkflowNamespaceID(nsID.String()), tag.Error(persistErr))
+			}
+			if err := m.handoverNamespace(nsID); err != nil {
+				m.logger.Warn("Failed to hand over worker heartbeats",
+					tag.WorkflowNamespaceID(nsID.String()), tag.Error(err))
+				if persistErr != nil {
+					// keep them to retry on the next membership change
+					continue
+				}
+			}
+			// maybe ownership changed again
+			if !m.isOwner(nsID, self) {
+				m.removeNamespace(nsID)
+			}
+		}
+	}
+}
+
+func (m *registryImpl) isOwner(nsID namespace.ID, self string) bool {
+	p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, nsID.String(), enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return true
+	}
+	owner, err := m.serviceResolver.Lookup(p.RoutingKey())
+	return err != nil || owner.Identity() == self
+}
+
+func (m *registryImpl) handoverNamespace(nsID namespace.ID) error {
+	heartbeats := m.filterWorkers(nsID, func(_ *workerpb.WorkerHeartbeat) bool { return true })
+	for i := 0; i < len(heartbeats); i += handoverBatchSize {
+		batch := heartbeats[i:min(len(heartbeats), i+handoverBatchSize)]
+		ctx, cancel := context.WithTimeout(context.Background(), handoverTimeout)
+		_, err := m.matchingClient.RecordWorkerHeartbeat(ctx, &matchingservice.RecordWorkerHeartbeatRequest{
+			NamespaceId: nsID.String(),
+			HeartbeartRequest: &workflowservice.RecordWorkerHeartbeatRequest{
+				WorkerHeartbeat: batch,
+			},
+			Handover: true,
+		})
+		cancel()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package workers
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	persistReadPageSize = 100
+	persistTimeout      = 10 * time.Second
+)
+
+// namespaceLoad tracks whether the persisted heartbeats of a namespace were loaded into memory.
+type namespaceLoad struct {
+	sync.Mutex
+	done bool
+}
+
+// addPending buffers heartbeats received for a namespace until they are persisted by the next flush.
+func (m *registryImpl) addPending(nsID namespace.ID, heartbeats []*workerpb.WorkerHeartbeat) {
+	if m.heartbeatManager == nil {
+		return
+	}
+	m.pendingMu.Lock()
+	defer m.pendingMu.Unlock()
+
+	mp, ok := m.pending[nsID]
+	if !ok {
+		mp = make(map[string]*workerpb.WorkerHeartbeat)
+		m.pending[nsID] = mp
+	}
+	for _, hb := range heartbeats {
+		mp[hb.WorkerInstanceKey] = hb
+	}
+}
+
+// takePending returns and clears the heartbeats buffered for a namespace.
+func (m *registryImpl) takePending(nsID namespace.ID) []*workerpb.WorkerHeartbeat {
+	m.pendingMu.Lock()
+	defer m.pendingMu.Unlock()
+
+	mp := m.pending[nsID]
+	delete(m.pending, nsID)
+	return slices.Collect(maps.Values(mp))
+}
+
+// restorePending buffers again heartbeats that failed to be persisted, unless newer ones were received since.
+func (m *registryImpl) restorePending(nsID namespace.ID, heartbeats []*workerpb.WorkerHeartbeat) {
+	m.pendingMu.Lock()
+	defer m.pendingMu.Unlock()
+
+	mp, ok := m.pending[nsID]
+	if !ok {
+		mp = make(map[string]*workerpb.WorkerHeartbeat)
+		m.pending[nsID] = mp
+	}
+	for _, hb := range heartbeats {
+		if _, exists := mp[hb.WorkerInstanceKey]; !exists {
+			mp[hb.WorkerInstanceKey] = hb
+		}
+	}
+}
+
+func (m *registryImpl) pendingNamespaceIDs() []namespace.ID {
+	m.pendingMu.Lock()
+	defer m.pendingMu.Unlock()
+	return slices.Collect(maps.Keys(m.pending))
+}
+
+// flushNamespace persists the heartbeats received for a namespace since its last flush.
+func (m *registryImpl) flushNamespace(ctx context.Context, nsID namespace.ID) error {
+	if m.heartbeatManager == nil {
+		return nil
+	}
+	heartbeats := m.takePending(nsID)
+	if len(heartbeats) == 0 {
+		return nil
+	}
+	_, err := m.heartbeatManager.AppendWorkerHeartbeats(ctx, &persistence.AppendWorkerHeartbeatsRequest{
+		NamespaceID: nsID.String(),
+		Heartbeats:  heartbeats,
+	})
+	if err != nil {
+		m.restorePending(nsID, heartbeats)
+	}
+	return err
+}
+
+// flushAll persists the heartbeats received for all namespaces since their last flush.
+func (m *registryImpl) flushAll() {
+	for _, nsID := range m.pendingNamespaceIDs() {
+		ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+		err := m.flushNamespace(ctx, nsID)
+		cancel()
+		if err != nil {
+			m.logger.Warn("Failed to persist worker heartbeats",
+				tag.WorkflowNamespaceID(nsID.String()), tag.Error(err))
+		}
+	}
+}
+
+// persistLoop periodically persists the heartbeats received since the last flush.
+func (m *registryImpl) persistLoop() {
+	ticker := time.NewTicker(m.persistInterval)
+	for {
+		select {
+		case <-ticker.C:
+			m.flushAll()
+		case <-m.quit:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func (m *registryImpl) getNamespaceLoad(nsID namespace.ID) *namespaceLoad {
+	m.loadsMu.Lock()
+	defer m.loadsMu.Unlock()
+
+	l, ok := m.loads[nsID]
+	if !ok {
+		l = &namespaceLoad{}
+		m.loads[nsID] = l
+	}
+	return l
+}
+
+// forgetNamespaceLoad makes the next read of the namespace load its persisted heartbeats again.
+func (m *registryImpl) forgetNamespaceLoad(nsID namespace.ID) {
+	m.loadsMu.Lock()
+	defer m.loadsMu.Unlock()
+	delete(m.loads, nsID)
+}
+
+func (m *registryImpl) loadedNamespaceIDs() []namespace.ID {
+	m.loadsMu.Lock()
+	defer m.loadsMu.Unlock()
+	return slices.Collect(maps.Keys(m.loads))
+}
+
+// ensureLoaded loads the persisted heartbeats of a namespace the first time it is read since this
+// host started owning it. Loaded heartbeats don't replace heartbeats that were already recorded.
+func (m *registryImpl) ensureLoaded(ctx context.Context, nsID namespace.ID) error {
+	if m.heartbeatManager == nil {
+		return nil
+	}
+	l := m.getNamespaceLoad(nsID)
+	l.Lock()
+	defer l.Unlock()
+	if l.done {
+		return nil
+	}
+
+	heartbeats, err := m.readPersisted(ctx, nsID)
+	if err != nil {
+		return err
+	}
+	m.handoverHeartbeats(nsID, heartbeats)
+	l.done = true
+	return nil
+}
+
+// readPersisted returns the latest persisted heartbeat of each worker of a namespace that isn't
+// older than the TTL.
+func (m *registryImpl) readPersisted(ctx context.Context, nsID namespace.ID) ([]*workerpb.WorkerHeartbeat, error) {
+	latest := make(map[string]*workerpb.WorkerHeartbeat)
+	var nextPageToken []byte
+	for {
+		resp, err := m.heartbeatManager.ReadWorkerHeartbeats(ctx, &persistence.ReadWorkerHeartbeatsRequest{
+			NamespaceID:   nsID.String(),
+			PageSize:      persistReadPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, message := range resp.Messages {
+			for _, hb := range message.Heartbeats {
+				// messages of a previous owner may interleave with ours during membership changes
+				prev, ok := latest[hb.WorkerInstanceKey]
+				if !ok || !hb.GetHeartbeatTime().AsTime().Before(prev.GetHeartbeatTime().AsTime()) {
+					latest[hb.WorkerInstanceKey] = hb
+				}
+			}
+		}
+		if nextPageToken = resp.NextPageToken; len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	expireBefore := time.Now().Add(-m.ttl)
+	out := make([]*workerpb.WorkerHeartbeat, 0, len(latest))
+	for _, hb := range latest {
+		if hb.GetHeartbeatTime() == nil || !hb.GetHeartbeatTime().AsTime().Before(expireBefore) {
+			out = append(out, hb)
+		}
+	}
+	return out, nil
+}
+
+// compactNamespace replaces the persisted heartbeats of a namespace with a snapshot of the workers
+// in memory, so that the heartbeats of evicted workers are not retained in persistence either.
+func (m *registryImpl) compactNamespace(ctx context.Context, nsID namespace.ID) error {
+	if err := m.ensureLoaded(ctx, nsID); err != nil {
+		return err
+	}
+	if err := m.flushNamespace(ctx, nsID); err != nil {
+		return err
+	}
+	heartbeats := m.filterWorkers(nsID, func(_ *workerpb.WorkerHeartbeat) bool { return true })
+	resp, err := m.heartbeatManager.AppendWorkerHeartbeats(ctx, &persistence.AppendWorkerHeartbeatsRequest{
+		NamespaceID: nsID.String(),
+		Heartbeats:  heartbeats,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Metadata.ID > persistence.FirstQueueMessageID {
+		err = m.heartbeatManager.DeleteWorkerHeartbeats(ctx, &persistence.DeleteWorkerHeartbeatsRequest{
+			NamespaceID:                 nsID.String(),
+			InclusiveMaxMessageMetadata: persistence.MessageMetadata{ID: resp.Metadata.ID - 1},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(heartbeats) == 0 {
+		// nothing left to compact until the namespace is read again
+		m.forgetNamespaceLoad(nsID)
+	}
+	return nil
+}
+
+// compactAll compacts the persisted heartbeats of the namespaces owned by this host.
+func (m *registryImpl) compactAll() {
+	var self string
+	if m.serviceResolver != nil {
+		self = m.hostInfoProvider.HostInfo().Identity()
+	}
+	for _, nsID := range m.knownNamespaceIDs() {
+		if m.serviceResolver != nil && !m.isOwner(nsID, self) {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+		err := m.compactNamespace(ctx, nsID)
+		cancel()
+		if err != nil {
+			m.logger.Warn("Failed to compact persisted worker heartbeats",
+				tag.WorkflowNamespaceID(nsID.String()), tag.Error(err))
+		}
+	}
+}
@@ -1,14 +1,34 @@
 package workers
 
 import (
+	"context"
+
 	workerpb "go.temporal.io/api/worker/v1"
 	"go.temporal.io/server/common/namespace"
 )
 
 type (
+	// Registry keeps the worker heartbeats of the namespaces owned by this matching host. Heartbeats
+	// are served from memory and periodically persisted, so that a host that starts owning a namespace,
+	// e.g. after a restart or a membership change, loads the workers seen by the previous owner.
+	// Heartbeats are retained until they are older than the registry's entry TTL.
 	Registry interface {
 		RecordWorkerHeartbeats(nsID namespace.ID, workerHeartbeat []*workerpb.WorkerHeartbeat)
-		ListWorkers(nsID namespace.ID, queue string, nextPageToken []byte) ([]*workerpb.WorkerHeartbeat, error)
-		DescribeWorker(nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error)
+		// HandoverWorkerHeartbeats records heartbeats handed over by the previous owner of the namespace.
+		// They don't replace heartbeats that were already recorded.
+		HandoverWorkerHeartbeats(nsID namespace.ID, workerHeartbeat []*workerpb.WorkerHeartbeat)
+		// ListWorkers returns a page of the workers matching the query, in the order of its ORDER BY clause
+		// and then of their instance key, and the token of the next page. The token is nil on the last page.
+		ListWorkers(ctx context.Context, nsID namespace.ID, query string, pageSize int, nextPageToken []byte) ([]*workerpb.WorkerHeartbeat, []byte, error)
+		// CountWorkers returns the number of workers matching the query and, if the query has a GROUP BY
+		// clause, the number of workers in each group, largest first.
+		CountWorkers(ctx context.Context, nsID namespace.ID, query string) (int64, []WorkerGroup, error)
+		DescribeWorker(ctx context.Context, nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error)
+	}
+
+	// WorkerGroup is a group of workers with the same values of the GROUP BY columns of a query.
+	WorkerGroup struct {
+		Values []string
+		Count  int64
 	}
 )
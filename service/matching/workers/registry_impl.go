@@ -1,15 +1,26 @@
 package workers
 
 import (
+	"cmp"
 	"container/list"
+	"context"
 	"hash/maphash"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/serviceerror"
 	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	tokenspb "go.temporal.io/server/api/token/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/resource"
 	"go.uber.org/fx"
 )
 
@@ -19,6 +30,7 @@ const (
 	defaultMinEvictAge      = 10 * time.Minute
 	defaultMaxEntries       = 1_000_000
 	defaultEvictionInterval = 1 * time.Hour
+	defaultPersistInterval  = 1 * time.Minute
 )
 
 type (
@@ -37,9 +49,10 @@ type (
 		order      *list.List // front = oldest, back = newest
 	}
 
-	// registryImpl implements Registry interface. It contains all worker heartbeats.
+	// registryImpl implements Registry interface. It contains all worker heartbeats of the
+	// namespaces owned by this host in memory, and persists them if it has a heartbeat manager.
 	// It partitions the keyspace into buckets and enforces TTL and capacity.
-	// Eviction runs in the background.
+	// Eviction, persistence and compaction of the persisted heartbeats run in the background.
 	registryImpl struct {
 		buckets          []*bucket     // buckets for partitioning the keyspace
 		maxItems         int64         // maximum number of entries allowed across all buckets
@@ -49,6 +62,39 @@ type (
 		total            atomic.Int64  // atomic counter of total entries
 		quit             chan struct{} // channel to signal shutdown of the eviction loop
 		seed             maphash.Seed  // seed for the hasher, used to ensure consistent hashing
+
+		// persistence of the heartbeats, disabled if heartbeatManager is nil
+		heartbeatManager persistence.WorkerHeartbeatManager
+		persistInterval  time.Duration
+		pendingMu        sync.Mutex
+		pending          map[namespace.ID]map[string]*workerpb.WorkerHeartbeat // heartbeats received since the last flush
+		loadsMu          sync.Mutex
+		loads            map[namespace.ID]*namespaceLoad
+
+		// handover of namespaces to their new owner after membership changes, disabled if serviceResolver is nil
+		serviceResolver     membership.ServiceResolver
+		hostInfoProvider    membership.HostInfoProvider
+		matchingClient      matchingservice.MatchingServiceClient
+		membershipChangedCh chan *membership.ChangedEvent
+		logger              log.Logger
+	}
+
+	// sortedWorker is a heartbeat with the encoded values of the ORDER BY columns of a query.
+	sortedWorker struct {
+		hb         *workerpb.WorkerHeartbeat
+		sortValues []string
+	}
+
+	RegistryParams struct {
+		fx.In
+
+		Lifecycle               fx.Lifecycle
+		DynamicCollection       *dynamicconfig.Collection
+		MatchingServiceResolver membership.ServiceResolver
+		HostInfoProvider        membership.HostInfoProvider
+		MatchingRawClient       resource.MatchingRawClient
+		WorkerHeartbeatManager  persistence.WorkerHeartbeatManager
+		Logger                  log.Logger
 	}
 )
 
@@ -95,6 +141,82 @@ func (b *bucket) upsertHeartbeats(nsID namespace.ID, heartbeats []*workerpb.Work
 	return newEntries
 }
 
+// handoverHeartbeats inserts WorkerHeartbeats handed over by another host under the given
+// namespace. They are ordered by their heartbeat time in the recency list, and dropped if the
+// worker is already known. Returns the number of new entries.
+func (b *bucket) handoverHeartbeats(nsID namespace.ID, heartbeats []*workerpb.WorkerHeartbeat) int64 {
+	now := time.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var newEntries int64
+
+	mp, ok := b.namespaces[nsID]
+	if !ok {
+		mp = make(map[string]*entry)
+		b.namespaces[nsID] = mp
+	}
+
+	for _, hb := range heartbeats {
+		key := hb.WorkerInstanceKey
+		if _, exists := mp[key]; exists {
+			continue
+		}
+		lastSeen := now
+		if t := hb.GetHeartbeatTime(); t != nil && t.AsTime().Before(now) {
+			lastSeen = t.AsTime()
+		}
+		e := &entry{
+			nsID:     nsID,
+			hb:       hb,
+			lastSeen: lastSeen,
+		}
+		// handed over entries are older than most of the entries recorded since the membership
+		// change, so search from the back
+		mark := b.order.Back()
+		for mark != nil && mark.Value.(*entry).lastSeen.After(lastSeen) { //nolint:revive
+			mark = mark.Prev()
+		}
+		if mark == nil {
+			e.elem = b.order.PushFront(e)
+		} else {
+			e.elem = b.order.InsertAfter(e, mark)
+		}
+		mp[key] = e
+		newEntries += 1
+	}
+
+	return newEntries
+}
+
+// namespaceIDs returns the namespaces that have entries in this bucket.
+func (b *bucket) namespaceIDs() []namespace.ID {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make([]namespace.ID, 0, len(b.namespaces))
+	for nsID, mp := range b.namespaces {
+		if len(mp) > 0 {
+			out = append(out, nsID)
+		}
+	}
+	return out
+}
+
+// removeNamespace removes all entries of a namespace from this bucket.
+// Returns the number of entries removed.
+func (b *bucket) removeNamespace(nsID namespace.ID) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	mp := b.namespaces[nsID]
+	for _, e := range mp {
+		b.order.Remove(e.elem)
+	}
+	delete(b.namespaces, nsID)
+	return len(mp)
+}
+
 // filterWorkers returns all WorkerHeartbeats in a namespace
 // for which predicate(hb) returns true.
 func (b *bucket) filterWorkers(
@@ -175,16 +297,22 @@ func (b *bucket) evictByCapacity(threshold time.Time) bool {
 }
 
 // NewRegistry creates a workers heartbeat registry with the given parameters.
-func NewRegistry(lc fx.Lifecycle) Registry {
+func NewRegistry(params RegistryParams) Registry {
 	m := newRegistryImpl(
 		defaultBuckets,
-		defaultEntryTTL,
+		dynamicconfig.MatchingWorkerRegistryEntryTTL.Get(params.DynamicCollection)(),
 		defaultMinEvictAge,
-		defaultMaxEntries,
+		int64(dynamicconfig.MatchingWorkerRegistryMaxEntries.Get(params.DynamicCollection)()),
 		defaultEvictionInterval,
 	)
+	m.serviceResolver = params.MatchingServiceResolver
+	m.hostInfoProvider = params.HostInfoProvider
+	m.matchingClient = params.MatchingRawClient
+	m.heartbeatManager = params.WorkerHeartbeatManager
+	m.persistInterval = dynamicconfig.MatchingWorkerRegistryPersistInterval.Get(params.DynamicCollection)()
+	m.logger = params.Logger
 
-	lc.Append(fx.StartStopHook(m.Start, m.Stop))
+	params.Lifecycle.Append(fx.StartStopHook(m.Start, m.Stop))
 
 	return m
 }
@@ -203,6 +331,12 @@ func newRegistryImpl(numBuckets int,
 		evictionInterval: evictionInterval,
 		seed:             maphash.MakeSeed(),
 		quit:             make(chan struct{}),
+		persistInterval:  defaultPersistInterval,
+		pending:          make(map[namespace.ID]map[string]*workerpb.WorkerHeartbeat),
+		loads:            make(map[namespace.ID]*namespaceLoad),
+		logger:           log.NewNoopLogger(),
+		// allow one signal to be buffered while we're working
+		membershipChangedCh: make(chan *membership.ChangedEvent, 1),
 	}
 
 	for i := range m.buckets {
@@ -230,6 +364,43 @@ func (m *registryImpl) upsertHeartbeats(nsID namespace.ID, heartbeats []*workerp
 	m.total.Add(newEntries)
 }
 
+// handoverHeartbeats records WorkerHeartbeats handed over by another host under the given namespace.
+// New entries increment the global counter.
+func (m *registryImpl) handoverHeartbeats(nsID namespace.ID, heartbeats []*workerpb.WorkerHeartbeat) {
+	b := m.getBucket(nsID)
+	newEntries := b.handoverHeartbeats(nsID, heartbeats)
+	m.total.Add(newEntries)
+}
+
+// namespaceIDs returns all namespaces that have entries.
+func (m *registryImpl) namespaceIDs() []namespace.ID {
+	var out []namespace.ID
+	for _, b := range m.buckets {
+		out = append(out, b.namespaceIDs()...)
+	}
+	return out
+}
+
+// knownNamespaceIDs returns all namespaces that have entries or whose persisted heartbeats were loaded.
+func (m *registryImpl) knownNamespaceIDs() []namespace.ID {
+	out := m.namespaceIDs()
+	for _, nsID := range m.loadedNamespaceIDs() {
+		if !slices.Contains(out, nsID) {
+			out = append(out, nsID)
+		}
+	}
+	return out
+}
+
+// removeNamespace removes all entries of a namespace and decrements the global counter. Its
+// persisted heartbeats are loaded again if it's read later.
+func (m *registryImpl) removeNamespace(nsID namespace.ID) {
+	m.forgetNamespaceLoad(nsID)
+	m.takePending(nsID)
+	removed := m.getBucket(nsID).removeNamespace(nsID)
+	m.total.Add(-int64(removed))
+}
+
 // filterWorkers returns all WorkerHeartbeats in a namespace
 // for which predicate(hb) returns true.
 func (m *registryImpl) filterWorkers(
@@ -244,7 +415,8 @@ func (m *registryImpl) filterWorkers(
 	return b.filterWorkers(nsID, predicate)
 }
 
-// evictLoop periodically triggers TTL and capacity-based eviction.
+// evictLoop periodically triggers TTL and capacity-based eviction, and compacts the persisted
+// heartbeats accordingly.
 func (m *registryImpl) evictLoop() {
 	ticker := time.NewTicker(m.evictionInterval)
 	for {
@@ -252,6 +424,9 @@ func (m *registryImpl) evictLoop() {
 		case <-ticker.C:
 			m.evictByTTL()
 			m.evictByCapacity()
+			if m.heartbeatManager != nil {
+				m.compactAll()
+			}
 		case <-m.quit:
 			ticker.Stop()
 			return
@@ -291,41 +466,189 @@ func (m *registryImpl) evictByCapacity() {
 	}
 }
 
-// Start begins the background eviction process.
+// Start begins the background eviction, persistence and handover processes.
 func (m *registryImpl) Start() {
 	go m.evictLoop()
+	if m.heartbeatManager != nil {
+		go m.persistLoop()
+	}
+	if m.serviceResolver != nil {
+		_ = m.serviceResolver.AddListener(m.listenerKey(), m.membershipChangedCh)
+		go m.watchMembership()
+	}
 }
 
-// Stop halts background eviction.
+// Stop halts background eviction, persistence and handover, and persists the heartbeats received
+// since the last flush.
 func (m *registryImpl) Stop() {
+	if m.serviceResolver != nil {
+		_ = m.serviceResolver.RemoveListener(m.listenerKey())
+	}
 	close(m.quit)
+	if m.heartbeatManager != nil {
+		m.flushAll()
+	}
 }
 
 func (m *registryImpl) RecordWorkerHeartbeats(nsID namespace.ID, workerHeartbeat []*workerpb.WorkerHeartbeat) {
 	m.upsertHeartbeats(nsID, workerHeartbeat)
+	m.addPending(nsID, workerHeartbeat)
 }
 
-func (m *registryImpl) ListWorkers(nsID namespace.ID, query string, _ []byte) ([]*workerpb.WorkerHeartbeat, error) {
-	if query == "" {
-		return m.filterWorkers(nsID, func(_ *workerpb.WorkerHeartbeat) bool { return true }), nil
+func (m *registryImpl) HandoverWorkerHeartbeats(nsID namespace.ID, workerHeartbeat []*workerpb.WorkerHeartbeat) {
+	m.handoverHeartbeats(nsID, workerHeartbeat)
+}
+
+func (m *registryImpl) ListWorkers(
+	ctx context.Context,
+	nsID namespace.ID,
+	query string,
+	pageSize int,
+	nextPageToken []byte,
+) ([]*workerpb.WorkerHeartbeat, []byte, error) {
+	if err := m.ensureLoaded(ctx, nsID); err != nil {
+		return nil, nil, err
+	}
+	var queryEngine *workerQueryEngine
+	if query != "" {
+		var err error
+		if queryEngine, err = newWorkerQueryEngine(nsID.String(), query); err != nil {
+			return nil, nil, err
+		}
+		if len(queryEngine.groupBy) > 0 {
+			return nil, nil, serviceerror.NewInvalidArgumentf("%s: 'group by' clause", notSupportedErrMessage)
+		}
+	}
+	sortValues := func(heartbeat *workerpb.WorkerHeartbeat) []string {
+		if queryEngine == nil {
+			return nil
+		}
+		return queryEngine.sortValues(heartbeat)
+	}
+	var desc []bool
+	if queryEngine != nil {
+		for _, o := range queryEngine.orderBy {
+			desc = append(desc, o.desc)
+		}
+	}
+
+	var token *tokenspb.ListWorkersNextPageToken
+	if len(nextPageToken) > 0 {
+		token = &tokenspb.ListWorkersNextPageToken{}
+		if err := token.Unmarshal(nextPageToken); err != nil || len(token.GetLastSortValues()) != len(desc) {
+			return nil, nil, serviceerror.NewInvalidArgument("invalid next page token")
+		}
 	}
 
+	heartbeats := m.filterWorkers(nsID, func(heartbeat *workerpb.WorkerHeartbeat) bool {
+		if queryEngine != nil {
+			if result, err := queryEngine.EvaluateWorker(heartbeat); err != nil || !result {
+				return false
+			}
+		}
+		return token == nil || compareSortedWorkers(sortValues(heartbeat), heartbeat.GetWorkerInstanceKey(),
+			token.GetLastSortValues(), token.GetLastWorkerInstanceKey(), desc) > 0
+	})
+	workers := make([]sortedWorker, len(heartbeats))
+	for i, heartbeat := range heartbeats {
+		workers[i] = sortedWorker{hb: heartbeat, sortValues: sortValues(heartbeat)}
+	}
+	slices.SortFunc(workers, func(a, b sortedWorker) int {
+		return compareSortedWorkers(a.sortValues, a.hb.GetWorkerInstanceKey(), b.sortValues, b.hb.GetWorkerInstanceKey(), desc)
+	})
+
+	var nextToken []byte
+	if pageSize > 0 && len(workers) > pageSize {
+		workers = workers[:pageSize]
+		last := workers[pageSize-1]
+		var err error
+		nextToken, err = (&tokenspb.ListWorkersNextPageToken{
+			LastWorkerInstanceKey: last.hb.GetWorkerInstanceKey(),
+			LastSortValues:        last.sortValues,
+		}).Marshal()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	result := make([]*workerpb.WorkerHeartbeat, len(workers))
+	for i, w := range workers {
+		result[i] = w.hb
+	}
+	return result, nextToken, nil
+}
+
+func (m *registryImpl) CountWorkers(ctx context.Context, nsID namespace.ID, query string) (int64, []WorkerGroup, error) {
+	if err := m.ensureLoaded(ctx, nsID); err != nil {
+		return 0, nil, err
+	}
+	if query == "" {
+		return int64(len(m.filterWorkers(nsID, func(_ *workerpb.WorkerHeartbeat) bool { return true }))), nil, nil
+	}
 	queryEngine, err := newWorkerQueryEngine(nsID.String(), query)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
+	}
+	if len(queryEngine.orderBy) > 0 {
+		return 0, nil, serviceerror.NewInvalidArgumentf("%s: 'order by' clause", notSupportedErrMessage)
 	}
 
-	predicate := func(heartbeat *workerpb.WorkerHeartbeat) bool {
-		result, err := queryEngine.EvaluateWorker(heartbeat)
-		return err == nil && result
+	var count int64
+	groups := make(map[string]*WorkerGroup)
+	m.filterWorkers(nsID, func(heartbeat *workerpb.WorkerHeartbeat) bool {
+		if result, err := queryEngine.EvaluateWorker(heartbeat); err != nil || !result {
+			return false
+		}
+		count++
+		if len(queryEngine.groupBy) > 0 {
+			values := queryEngine.groupValues(heartbeat)
+			key := strings.Join(values, "\x00")
+			if g, ok := groups[key]; ok {
+				g.Count++
+			} else {
+				groups[key] = &WorkerGroup{Values: values, Count: 1}
+			}
+		}
+		return false
+	})
+
+	var result []WorkerGroup
+	for _, g := range groups {
+		result = append(result, *g)
 	}
-	return m.filterWorkers(nsID, predicate), nil
+	slices.SortFunc(result, func(a, b WorkerGroup) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return slices.Compare(a.Values, b.Values)
+	})
+	return count, result, nil
 }
 
-func (m *registryImpl) DescribeWorker(nsID namespace.ID, workerInstanceKey string) (*workerpb.WorkerHeartbeat, error) {
+func (m *registryImpl) DescribeWorker(
+	ctx context.Context,
+	nsID namespace.ID,
+	workerInstanceKey string,
+) (*workerpb.WorkerHeartbeat, error) {
+	if err := m.ensureLoaded(ctx, nsID); err != nil {
+		return nil, err
+	}
 	b := m.getBucket(nsID)
 	if b == nil {
 		return nil, serviceerror.NewNotFoundf("namespace not found: %s", nsID.String())
 	}
 	return b.getWorkerHeartbeat(nsID, workerInstanceKey)
 }
+
+// compareSortedWorkers compares workers by their sort values, then by their instance key.
+func compareSortedWorkers(aValues []string, aKey string, bValues []string, bKey string, desc []bool) int {
+	for i := range desc {
+		if c := strings.Compare(aValues[i], bValues[i]); c != 0 {
+			if desc[i] {
+				return -c
+			}
+			return c
+		}
+	}
+	return strings.Compare(aKey, bKey)
+}
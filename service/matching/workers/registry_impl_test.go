@@ -1,15 +1,27 @@
 package workers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
 	workerpb "go.temporal.io/api/worker/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
+	"go.temporal.io/server/api/matchingservicemock/v1"
+	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // alwaysTrue predicate for convenience
@@ -112,6 +124,224 @@ func TestEvictByCapacity(t *testing.T) {
 	assert.LessOrEqual(t, m.total.Load(), maxItems, "total counter should not exceed maxItems")
 }
 
+func TestHandoverHeartbeats(t *testing.T) {
+	m := newRegistryImpl(1, time.Hour, 0, 10, time.Hour)
+	defer m.Stop()
+
+	now := time.Now()
+	m.upsertHeartbeats("ns", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "live", TaskQueue: "new"}})
+	m.handoverHeartbeats("ns", []*workerpb.WorkerHeartbeat{
+		{WorkerInstanceKey: "live", TaskQueue: "old"},
+		{WorkerInstanceKey: "recent", HeartbeatTime: timestamppb.New(now.Add(-time.Minute))},
+		{WorkerInstanceKey: "expired", HeartbeatTime: timestamppb.New(now.Add(-2 * time.Hour))},
+	})
+	assert.Equal(t, int64(3), m.total.Load())
+
+	// handed over heartbeats don't replace newer ones
+	hb, err := m.DescribeWorker(context.Background(), "ns", "live")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", hb.TaskQueue)
+
+	// and are evicted based on their heartbeat time
+	m.evictByTTL()
+	var keys []string
+	for _, hb := range m.filterWorkers("ns", alwaysTrue) {
+		keys = append(keys, hb.WorkerInstanceKey)
+	}
+	assert.ElementsMatch(t, []string{"live", "recent"}, keys)
+	assert.Equal(t, int64(2), m.total.Load())
+}
+
+func TestWatchMembership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resolver := membership.NewMockServiceResolver(ctrl)
+	hostInfoProvider := membership.NewMockHostInfoProvider(ctrl)
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(ctrl)
+
+	store := newFakeHeartbeatManager()
+
+	m := newRegistryImpl(1, time.Hour, 0, 10, time.Hour)
+	m.serviceResolver = resolver
+	m.hostInfoProvider = hostInfoProvider
+	m.matchingClient = matchingClient
+	m.heartbeatManager = store
+
+	self := membership.NewHostInfoFromAddress("self")
+	other := membership.NewHostInfoFromAddress("other")
+	ownedKey := func(nsID string) string {
+		p, err := tqid.NormalPartitionFromRpcName(tqid.NotApplicableName, nsID, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+		assert.NoError(t, err)
+		return p.RoutingKey()
+	}
+	hostInfoProvider.EXPECT().HostInfo().Return(self).AnyTimes()
+	resolver.EXPECT().AddListener(gomock.Any(), gomock.Any()).Return(nil)
+	resolver.EXPECT().RemoveListener(gomock.Any()).Return(nil)
+	resolver.EXPECT().Lookup(ownedKey("kept")).Return(self, nil).AnyTimes()
+	resolver.EXPECT().Lookup(ownedKey("moved")).Return(other, nil).AnyTimes()
+	resolver.EXPECT().Lookup(ownedKey("unreachable")).Return(other, nil).AnyTimes()
+
+	hb := &workerpb.WorkerHeartbeat{WorkerInstanceKey: "worker"}
+	m.RecordWorkerHeartbeats("kept", []*workerpb.WorkerHeartbeat{hb})
+	m.RecordWorkerHeartbeats("moved", []*workerpb.WorkerHeartbeat{hb})
+	m.RecordWorkerHeartbeats("unreachable", []*workerpb.WorkerHeartbeat{hb})
+	matchingClient.EXPECT().RecordWorkerHeartbeat(gomock.Any(), &matchingservice.RecordWorkerHeartbeatRequest{
+		NamespaceId: "moved",
+		HeartbeartRequest: &workflowservice.RecordWorkerHeartbeatRequest{
+			WorkerHeartbeat: []*workerpb.WorkerHeartbeat{hb},
+		},
+		Handover: true,
+	}).Return(&matchingservice.RecordWorkerHeartbeatResponse{}, nil)
+	matchingClient.EXPECT().RecordWorkerHeartbeat(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	m.Start()
+	defer m.Stop()
+	m.membershipChangedCh <- &membership.ChangedEvent{}
+
+	// namespaces are removed once their heartbeats are persisted, even if the handover fails
+	assert.Eventually(t, func() bool {
+		return len(m.filterWorkers("moved", alwaysTrue)) == 0 && len(m.filterWorkers("unreachable", alwaysTrue)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Len(t, m.filterWorkers("kept", alwaysTrue), 1)
+	assert.Equal(t, int64(1), m.total.Load())
+	assert.Len(t, store.heartbeats("moved"), 1)
+	assert.Len(t, store.heartbeats("unreachable"), 1)
+	assert.Empty(t, store.heartbeats("kept"), "heartbeats of owned namespaces are persisted by the next flush")
+}
+
+func TestPersistAndLoad(t *testing.T) {
+	store := newFakeHeartbeatManager()
+	now := time.Now()
+
+	m := newRegistryImpl(1, time.Hour, 0, 10, time.Hour)
+	m.heartbeatManager = store
+	m.RecordWorkerHeartbeats("ns", []*workerpb.WorkerHeartbeat{
+		{WorkerInstanceKey: "worker1", TaskQueue: "old", HeartbeatTime: timestamppb.New(now.Add(-time.Minute))},
+		{WorkerInstanceKey: "worker2", HeartbeatTime: timestamppb.New(now)},
+	})
+	m.flushAll()
+	m.RecordWorkerHeartbeats("ns", []*workerpb.WorkerHeartbeat{
+		{WorkerInstanceKey: "worker1", TaskQueue: "new", HeartbeatTime: timestamppb.New(now)},
+	})
+	m.Stop()
+	_, err := store.AppendWorkerHeartbeats(context.Background(), &persistence.AppendWorkerHeartbeatsRequest{
+		NamespaceID: "ns",
+		Heartbeats: []*workerpb.WorkerHeartbeat{
+			{WorkerInstanceKey: "expired", HeartbeatTime: timestamppb.New(now.Add(-2 * time.Hour))},
+		},
+	})
+	assert.NoError(t, err)
+
+	// a restarted host loads the heartbeats on the first read of the namespace
+	restarted := newRegistryImpl(1, time.Hour, 0, 10, time.Hour)
+	restarted.heartbeatManager = store
+	defer restarted.Stop()
+	restarted.RecordWorkerHeartbeats("ns", []*workerpb.WorkerHeartbeat{
+		{WorkerInstanceKey: "worker2", TaskQueue: "recorded", HeartbeatTime: timestamppb.New(now.Add(-time.Second))},
+	})
+
+	result, _, err := restarted.ListWorkers(context.Background(), "ns", "", 0, nil)
+	assert.NoError(t, err)
+	taskQueues := make(map[string]string)
+	for _, hb := range result {
+		taskQueues[hb.WorkerInstanceKey] = hb.TaskQueue
+	}
+	assert.Equal(t, map[string]string{"worker1": "new", "worker2": "recorded"}, taskQueues)
+}
+
+func TestCompactAll(t *testing.T) {
+	store := newFakeHeartbeatManager()
+	now := time.Now()
+
+	m := newRegistryImpl(1, time.Hour, 0, 10, time.Hour)
+	m.heartbeatManager = store
+	defer m.Stop()
+	for i := range 3 {
+		m.RecordWorkerHeartbeats("ns", []*workerpb.WorkerHeartbeat{
+			{WorkerInstanceKey: "worker", CurrentStickyCacheSize: int32(i), HeartbeatTime: timestamppb.New(now)},
+		})
+		m.flushAll()
+	}
+	_, err := store.AppendWorkerHeartbeats(context.Background(), &persistence.AppendWorkerHeartbeatsRequest{
+		NamespaceID: "expired",
+		Heartbeats: []*workerpb.WorkerHeartbeat{
+			{WorkerInstanceKey: "worker", HeartbeatTime: timestamppb.New(now.Add(-2 * time.Hour))},
+		},
+	})
+	assert.NoError(t, err)
+	count, _, err := m.CountWorkers(context.Background(), "expired", "")
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+
+	m.compactAll()
+
+	assert.Len(t, store.messages["ns"], 1, "older messages are replaced by a snapshot")
+	assert.Len(t, store.heartbeats("ns"), 1)
+	assert.Equal(t, int32(2), store.heartbeats("ns")[0].CurrentStickyCacheSize)
+	assert.Empty(t, store.heartbeats("expired"), "expired workers are not retained")
+	assert.NotContains(t, m.loadedNamespaceIDs(), namespace.ID("expired"))
+}
+
+// fakeHeartbeatManager is an in-memory persistence.WorkerHeartbeatManager.
+type fakeHeartbeatManager struct {
+	mu       sync.Mutex
+	nextID   int64
+	messages map[string][]persistence.WorkerHeartbeatsMessage
+}
+
+func newFakeHeartbeatManager() *fakeHeartbeatManager {
+	return &fakeHeartbeatManager{messages: make(map[string][]persistence.WorkerHeartbeatsMessage)}
+}
+
+func (f *fakeHeartbeatManager) AppendWorkerHeartbeats(
+	_ context.Context,
+	request *persistence.AppendWorkerHeartbeatsRequest,
+) (*persistence.AppendWorkerHeartbeatsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	metadata := persistence.MessageMetadata{ID: f.nextID}
+	f.nextID++
+	f.messages[request.NamespaceID] = append(f.messages[request.NamespaceID], persistence.WorkerHeartbeatsMessage{
+		MessageMetadata: metadata,
+		Heartbeats:      request.Heartbeats,
+	})
+	return &persistence.AppendWorkerHeartbeatsResponse{Metadata: metadata}, nil
+}
+
+func (f *fakeHeartbeatManager) ReadWorkerHeartbeats(
+	_ context.Context,
+	request *persistence.ReadWorkerHeartbeatsRequest,
+) (*persistence.ReadWorkerHeartbeatsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &persistence.ReadWorkerHeartbeatsResponse{Messages: slices.Clone(f.messages[request.NamespaceID])}, nil
+}
+
+func (f *fakeHeartbeatManager) DeleteWorkerHeartbeats(
+	_ context.Context,
+	request *persistence.DeleteWorkerHeartbeatsRequest,
+) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.messages[request.NamespaceID] = slices.DeleteFunc(f.messages[request.NamespaceID],
+		func(message persistence.WorkerHeartbeatsMessage) bool {
+			return message.MessageMetadata.ID <= request.InclusiveMaxMessageMetadata.ID
+		})
+	return nil
+}
+
+func (f *fakeHeartbeatManager) Close() {}
+
+// heartbeats returns all persisted heartbeats of a namespace.
+func (f *fakeHeartbeatManager) heartbeats(nsID string) []*workerpb.WorkerHeartbeat {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*workerpb.WorkerHeartbeat
+	for _, message := range f.messages[nsID] {
+		out = append(out, message.Heartbeats...)
+	}
+	return out
+}
+
 func BenchmarkUpdate(b *testing.B) {
 	m := newRegistryImpl(16, time.Hour, time.Minute, int64(b.N), time.Hour)
 	defer m.Stop()
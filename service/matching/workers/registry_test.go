@@ -1,6 +1,7 @@
 package workers
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -168,7 +169,7 @@ func TestRegistryImpl_ListWorkers(t *testing.T) {
 			)
 			tt.setup(r)
 
-			result, err := r.ListWorkers(tt.nsID, "", nil)
+			result, _, err := r.ListWorkers(context.Background(), tt.nsID, "", 0, nil)
 			if tt.expectError {
 				assert.Error(t, err, "expected an error for non-existent namespace")
 				assert.Nil(t, result, "result should be nil when an error occurs")
@@ -195,6 +196,120 @@ func TestRegistryImpl_ListWorkers(t *testing.T) {
 	}
 }
 
+func TestRegistryImpl_ListWorkersPagination(t *testing.T) {
+	r := newRegistryImpl(
+		defaultBuckets, defaultEntryTTL, defaultMinEvictAge, defaultMaxEntries, defaultEvictionInterval,
+	)
+	for _, key := range []string{"worker3", "worker1", "worker5", "worker2", "worker4"} {
+		r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{{
+			WorkerInstanceKey: key,
+			TaskQueue:         "tq1",
+		}})
+	}
+	r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{{
+		WorkerInstanceKey: "worker0",
+		TaskQueue:         "tq2",
+	}})
+
+	var pages [][]string
+	var token []byte
+	for {
+		result, nextToken, err := r.ListWorkers(context.Background(), "namespace1", "TaskQueue = 'tq1'", 2, token)
+		assert.NoError(t, err)
+		var keys []string
+		for _, worker := range result {
+			keys = append(keys, worker.WorkerInstanceKey)
+		}
+		pages = append(pages, keys)
+		if nextToken == nil {
+			break
+		}
+		token = nextToken
+	}
+	assert.Equal(t, [][]string{{"worker1", "worker2"}, {"worker3", "worker4"}, {"worker5"}}, pages)
+
+	// workers added between pages are returned if they sort after the token
+	_, token, err := r.ListWorkers(context.Background(), "namespace1", "", 3, nil)
+	assert.NoError(t, err)
+	r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker6"}})
+	result, token, err := r.ListWorkers(context.Background(), "namespace1", "", 4, token)
+	assert.NoError(t, err)
+	assert.Nil(t, token)
+	assert.Len(t, result, 4)
+
+	_, _, err = r.ListWorkers(context.Background(), "namespace1", "", 3, []byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestRegistryImpl_ListWorkersOrderBy(t *testing.T) {
+	r := newRegistryImpl(
+		defaultBuckets, defaultEntryTTL, defaultMinEvictAge, defaultMaxEntries, defaultEvictionInterval,
+	)
+	for key, used := range map[string]int32{"worker1": 5, "worker2": 7, "worker3": 5, "worker4": 1} {
+		r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{{
+			WorkerInstanceKey:     key,
+			WorkflowTaskSlotsInfo: &workerpb.WorkerSlotsInfo{CurrentUsedSlots: used},
+		}})
+	}
+	r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{{WorkerInstanceKey: "worker0"}})
+
+	var keys []string
+	var token []byte
+	for {
+		result, nextToken, err := r.ListWorkers(context.Background(), "namespace1", "ORDER BY WorkflowTaskSlotsUsed DESC", 2, token)
+		assert.NoError(t, err)
+		for _, worker := range result {
+			keys = append(keys, worker.WorkerInstanceKey)
+		}
+		if nextToken == nil {
+			break
+		}
+		token = nextToken
+	}
+	// unset values sort first, so last in descending order
+	assert.Equal(t, []string{"worker2", "worker1", "worker3", "worker4", "worker0"}, keys)
+
+	// a token of another ordering is rejected
+	_, _, err := r.ListWorkers(context.Background(), "namespace1", "", 2, token)
+	assert.Error(t, err)
+	_, _, err = r.ListWorkers(context.Background(), "namespace1", "GROUP BY SdkVersion", 2, nil)
+	assert.Error(t, err)
+}
+
+func TestRegistryImpl_CountWorkers(t *testing.T) {
+	r := newRegistryImpl(
+		defaultBuckets, defaultEntryTTL, defaultMinEvictAge, defaultMaxEntries, defaultEvictionInterval,
+	)
+	r.upsertHeartbeats("namespace1", []*workerpb.WorkerHeartbeat{
+		{WorkerInstanceKey: "worker1", SdkVersion: "1.0", TaskQueue: "tq1"},
+		{WorkerInstanceKey: "worker2", SdkVersion: "1.1", TaskQueue: "tq1"},
+		{WorkerInstanceKey: "worker3", SdkVersion: "1.1", TaskQueue: "tq1"},
+		{WorkerInstanceKey: "worker4", SdkVersion: "1.1", TaskQueue: "tq2"},
+	})
+
+	count, groups, err := r.CountWorkers(context.Background(), "namespace1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+	assert.Empty(t, groups)
+
+	count, groups, err = r.CountWorkers(context.Background(), "namespace1", "TaskQueue = 'tq1' GROUP BY SdkVersion")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, []WorkerGroup{{Values: []string{"1.1"}, Count: 2}, {Values: []string{"1.0"}, Count: 1}}, groups)
+
+	count, groups, err = r.CountWorkers(context.Background(), "namespace1", "GROUP BY SdkVersion, TaskQueue")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+	assert.Equal(t, []WorkerGroup{
+		{Values: []string{"1.1", "tq1"}, Count: 2},
+		{Values: []string{"1.0", "tq1"}, Count: 1},
+		{Values: []string{"1.1", "tq2"}, Count: 1},
+	}, groups)
+
+	_, _, err = r.CountWorkers(context.Background(), "namespace1", "ORDER BY SdkVersion")
+	assert.Error(t, err)
+}
+
 func TestRegistryImpl_DescribeWorker(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -274,7 +389,7 @@ func TestRegistryImpl_DescribeWorker(t *testing.T) {
 			)
 			tt.setup(r)
 
-			result, err := r.DescribeWorker(tt.nsID, tt.workerInstanceKey)
+			result, err := r.DescribeWorker(context.Background(), tt.nsID, tt.workerInstanceKey)
 			if tt.expectError {
 				assert.Error(t, err, "expected an error for non-existent namespace")
 				assert.Nil(t, result, "result should be nil when an error occurs")
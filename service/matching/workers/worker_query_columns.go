@@ -0,0 +1,182 @@
+package workers
+
+import (
+	"fmt"
+
+	workerpb "go.temporal.io/api/worker/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	workerBuildIdColName = "BuildId"
+	workerPluginColName  = "Plugin"
+
+	workerWorkflowTaskSlotsAvailableColName  = "WorkflowTaskSlotsAvailable"
+	workerWorkflowTaskSlotsUsedColName       = "WorkflowTaskSlotsUsed"
+	workerWorkflowTaskSlotSupplierColName    = "WorkflowTaskSlotSupplierKind"
+	workerActivityTaskSlotsAvailableColName  = "ActivityTaskSlotsAvailable"
+	workerActivityTaskSlotsUsedColName       = "ActivityTaskSlotsUsed"
+	workerActivityTaskSlotSupplierColName    = "ActivityTaskSlotSupplierKind"
+	workerNexusTaskSlotsAvailableColName     = "NexusTaskSlotsAvailable"
+	workerNexusTaskSlotsUsedColName          = "NexusTaskSlotsUsed"
+	workerNexusTaskSlotSupplierColName       = "NexusTaskSlotSupplierKind"
+	workerLocalActivitySlotsAvailableColName = "LocalActivitySlotsAvailable"
+	workerLocalActivitySlotsUsedColName      = "LocalActivitySlotsUsed"
+	workerLocalActivitySlotSupplierColName   = "LocalActivitySlotSupplierKind"
+)
+
+type columnKind int
+
+const (
+	stringColumn columnKind = iota
+	// stringListColumn matches a condition if any of its values matches it.
+	stringListColumn
+	timeColumn
+	intColumn
+)
+
+// workerColumn describes a queryable property of a worker heartbeat. Only the value function
+// matching the kind is set.
+type workerColumn struct {
+	kind         columnKind
+	stringValue  func(*workerpb.WorkerHeartbeat) string
+	stringsValue func(*workerpb.WorkerHeartbeat) []string
+	timeValue    func(*workerpb.WorkerHeartbeat) *timestamppb.Timestamp
+	// intValue returns false if the value is not set.
+	intValue func(*workerpb.WorkerHeartbeat) (int64, bool)
+}
+
+var workerColumns = map[string]workerColumn{
+	workerInstanceKeyColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetWorkerInstanceKey()
+	}),
+	workerIdentityColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetWorkerIdentity()
+	}),
+	workerHostNameColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetHostInfo().GetHostName()
+	}),
+	workerTaskQueueColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetTaskQueue()
+	}),
+	workerDeploymentNameColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetDeploymentVersion().GetDeploymentName()
+	}),
+	workerBuildIdColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetDeploymentVersion().GetBuildId()
+	}),
+	workerSdkNameColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetSdkName()
+	}),
+	workerSdkVersionColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetSdkVersion()
+	}),
+	workerStatusColName: stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+		return hb.GetStatus().String()
+	}),
+	workerPluginColName: {
+		kind: stringListColumn,
+		stringsValue: func(hb *workerpb.WorkerHeartbeat) []string {
+			names := make([]string, len(hb.GetPlugins()))
+			for i, plugin := range hb.GetPlugins() {
+				names[i] = plugin.GetName()
+			}
+			return names
+		},
+	},
+	workerStartTimeColName: timeCol(func(hb *workerpb.WorkerHeartbeat) *timestamppb.Timestamp {
+		return hb.GetStartTime()
+	}),
+	workerHeartbeatTimeColName: timeCol(func(hb *workerpb.WorkerHeartbeat) *timestamppb.Timestamp {
+		return hb.GetHeartbeatTime()
+	}),
+}
+
+func init() {
+	for _, slots := range []struct {
+		available, used, supplier string
+		info                      func(*workerpb.WorkerHeartbeat) *workerpb.WorkerSlotsInfo
+	}{
+		{
+			workerWorkflowTaskSlotsAvailableColName, workerWorkflowTaskSlotsUsedColName, workerWorkflowTaskSlotSupplierColName,
+			(*workerpb.WorkerHeartbeat).GetWorkflowTaskSlotsInfo,
+		},
+		{
+			workerActivityTaskSlotsAvailableColName, workerActivityTaskSlotsUsedColName, workerActivityTaskSlotSupplierColName,
+			(*workerpb.WorkerHeartbeat).GetActivityTaskSlotsInfo,
+		},
+		{
+			workerNexusTaskSlotsAvailableColName, workerNexusTaskSlotsUsedColName, workerNexusTaskSlotSupplierColName,
+			(*workerpb.WorkerHeartbeat).GetNexusTaskSlotsInfo,
+		},
+		{
+			workerLocalActivitySlotsAvailableColName, workerLocalActivitySlotsUsedColName, workerLocalActivitySlotSupplierColName,
+			(*workerpb.WorkerHeartbeat).GetLocalActivitySlotsInfo,
+		},
+	} {
+		info := slots.info
+		workerColumns[slots.available] = intCol(func(hb *workerpb.WorkerHeartbeat) (int64, bool) {
+			return int64(info(hb).GetCurrentAvailableSlots()), info(hb) != nil
+		})
+		workerColumns[slots.used] = intCol(func(hb *workerpb.WorkerHeartbeat) (int64, bool) {
+			return int64(info(hb).GetCurrentUsedSlots()), info(hb) != nil
+		})
+		workerColumns[slots.supplier] = stringCol(func(hb *workerpb.WorkerHeartbeat) string {
+			return info(hb).GetSlotSupplierKind()
+		})
+	}
+}
+
+func stringCol(f func(*workerpb.WorkerHeartbeat) string) workerColumn {
+	return workerColumn{kind: stringColumn, stringValue: f}
+}
+
+func timeCol(f func(*workerpb.WorkerHeartbeat) *timestamppb.Timestamp) workerColumn {
+	return workerColumn{kind: timeColumn, timeValue: f}
+}
+
+func intCol(f func(*workerpb.WorkerHeartbeat) (int64, bool)) workerColumn {
+	return workerColumn{kind: intColumn, intValue: f}
+}
+
+// isNull returns true if the column has no value for the heartbeat.
+func (c workerColumn) isNull(hb *workerpb.WorkerHeartbeat) bool {
+	switch c.kind {
+	case stringColumn:
+		return c.stringValue(hb) == ""
+	case stringListColumn:
+		return len(c.stringsValue(hb)) == 0
+	case timeColumn:
+		return c.timeValue(hb) == nil
+	case intColumn:
+		_, ok := c.intValue(hb)
+		return !ok
+	default:
+		return true
+	}
+}
+
+// sortValue encodes the value of the column so that encoded values sort in the same order as the
+// values themselves. Unset values sort first.
+func (c workerColumn) sortValue(hb *workerpb.WorkerHeartbeat) string {
+	switch c.kind {
+	case stringColumn:
+		return c.stringValue(hb)
+	case timeColumn:
+		if ts := c.timeValue(hb); ts != nil {
+			return encodeSortInt(ts.AsTime().UnixNano())
+		}
+	case intColumn:
+		if v, ok := c.intValue(hb); ok {
+			return encodeSortInt(v)
+		}
+	default:
+	}
+	return ""
+}
+
+// encodeSortInt encodes an int64 as a fixed width string that sorts like the number. Flipping the
+// sign bit orders negative numbers before positive ones.
+func encodeSortInt(v int64) string {
+	return fmt.Sprintf("%020d", uint64(v)^(1<<63))
+}
@@ -2,6 +2,8 @@ package workers
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -45,8 +47,11 @@ The following worker status attributes are expected are supported as part of the
 * SdkName
 * SdkVersion
 * StartTime
-* LastHeartbeatTime
-* Status
+* HeartbeatTime
+* WorkerStatus
+* Plugin (matches if any plugin name of the worker matches)
+* WorkflowTaskSlotsAvailable, WorkflowTaskSlotsUsed, WorkflowTaskSlotSupplierKind, and the same
+  for ActivityTask, NexusTask and LocalActivity slots
 Currently metrics are not supported as a part of ListWorkers query.
 
 Field names are case-sensitive.
@@ -56,15 +61,20 @@ The query can have conditions on multiple fields.
 Date time fields should be in RFC3339 format.
 Example query:
 
-	"TaskQueue = 'my_task_queue' AND LastHeartbeatTime < '2023-10-27T10:30:00Z' "
+	"TaskQueue = 'my_task_queue' AND HeartbeatTime < '2023-10-27T10:30:00Z' ORDER BY StartTime DESC"
 
 Different fields can support different operators.
-  - string fields (e.g., WorkerIdentity, HostName, TaskQueue, DeploymentName, SdkName, SdkVersion):
-		starts_with, not starts_with
-  - time fields (e.g., StartTime, LastHeartbeatTime):
+  - string fields (e.g., WorkerIdentity, HostName, TaskQueue, DeploymentName, SdkName, SdkVersion, Plugin):
+		=, !=, starts_with, not starts_with, like, not like, in, not in
+  - time fields (e.g., StartTime, HeartbeatTime):
 		 =, !=, >, >=, <, <=, between
-  - metric fields (e.g., total_sticky_cache_hit):
-		=, !=, >, >=, <, <=
+  - slot fields (e.g., WorkflowTaskSlotsUsed):
+		=, !=, >, >=, <, <=, between, in, not in
+All fields support IS NULL and IS NOT NULL. Empty strings and lists are NULL.
+
+The query can end with ORDER BY over any field but Plugin. Workers with the same values are ordered
+by WorkerInstanceKey. The query can also consist of only an ORDER BY clause.
+CountWorkers queries can end with GROUP BY over string fields but Plugin instead.
 
 Returns the list of workers for which the query matches the worker heartbeat, or an error,
 Errors are:
@@ -75,8 +85,9 @@ Errors are:
 
 func newWorkerQueryEngine(nsID string, query string) (*workerQueryEngine, error) {
 	engine := &workerQueryEngine{
-		nsID:  nsID,
-		query: query,
+		nsID:         nsID,
+		query:        query,
+		likePatterns: make(map[string]*regexp.Regexp),
 	}
 
 	err := engine.validateQuery()
@@ -86,70 +97,103 @@ func newWorkerQueryEngine(nsID string, query string) (*workerQueryEngine, error)
 	return engine, nil
 }
 
-type WorkerHeartbeatPropertyFunc func(*workerpb.WorkerHeartbeat) string
-
-var (
-	propertyMapFuncs = map[string]WorkerHeartbeatPropertyFunc{
-		workerInstanceKeyColName: func(hb *workerpb.WorkerHeartbeat) string {
-			return hb.WorkerInstanceKey
-		},
-		workerIdentityColName: func(hb *workerpb.WorkerHeartbeat) string {
-			return hb.WorkerIdentity
-		},
-		workerHostNameColName: func(hb *workerpb.WorkerHeartbeat) string {
-			if hb.HostInfo == nil {
-				return ""
-			}
-			return hb.HostInfo.HostName
-		},
-		workerTaskQueueColName: func(hb *workerpb.WorkerHeartbeat) string {
-			return hb.TaskQueue
-		},
-		workerDeploymentNameColName: func(hb *workerpb.WorkerHeartbeat) string {
-			if hb.DeploymentVersion == nil {
-				return ""
-			}
-			return hb.DeploymentVersion.DeploymentName
-		},
-		workerSdkNameColName: func(hb *workerpb.WorkerHeartbeat) string {
-			return hb.SdkName
-		},
-		workerSdkVersionColName: func(hb *workerpb.WorkerHeartbeat) string {
-			return hb.SdkVersion
-		},
-		workerStatusColName: func(hb *workerpb.WorkerHeartbeat) string {
-			return hb.Status.String()
-		},
+type (
+	orderByColumn struct {
+		column workerColumn
+		desc   bool
 	}
-)
 
-type workerQueryEngine struct {
-	nsID                  string // Namespace ID
-	query                 string
-	parsedWhereExpression sqlparser.Expr
-	currentWorker         *workerpb.WorkerHeartbeat // Current worker heartbeat being evaluated
-}
+	workerQueryEngine struct {
+		nsID  string // Namespace ID
+		query string
+		// nil if the query only has an ORDER BY or GROUP BY clause
+		parsedWhereExpression sqlparser.Expr
+		orderBy               []orderByColumn
+		groupBy               []workerColumn
+		likePatterns          map[string]*regexp.Regexp // compiled LIKE patterns by pattern
+		currentWorker         *workerpb.WorkerHeartbeat // Current worker heartbeat being evaluated
+	}
+)
 
 func (w *workerQueryEngine) EvaluateWorker(hb *workerpb.WorkerHeartbeat) (bool, error) {
+	if w.parsedWhereExpression == nil {
+		return true, nil
+	}
 
 	w.currentWorker = hb
 	return w.evaluateExpression(w.parsedWhereExpression)
 }
 
+// sortValues returns the encoded values of the ORDER BY columns for the heartbeat.
+func (w *workerQueryEngine) sortValues(hb *workerpb.WorkerHeartbeat) []string {
+	values := make([]string, len(w.orderBy))
+	for i, o := range w.orderBy {
+		values[i] = o.column.sortValue(hb)
+	}
+	return values
+}
+
+// groupValues returns the values of the GROUP BY columns for the heartbeat.
+func (w *workerQueryEngine) groupValues(hb *workerpb.WorkerHeartbeat) []string {
+	values := make([]string, len(w.groupBy))
+	for i, column := range w.groupBy {
+		values[i] = column.stringValue(hb)
+	}
+	return values
+}
+
 func (w *workerQueryEngine) validateQuery() error {
 	query, err := prepareQuery(w.query)
 	if err != nil {
 		return err
 	}
 
-	w.parsedWhereExpression, err = getWhereCause(query)
+	selectStmt, err := parseSelect(query)
 	if err != nil {
 		return err
 	}
+	if selectStmt.Where != nil {
+		w.parsedWhereExpression = selectStmt.Where.Expr
+	}
+
+	for _, order := range selectStmt.OrderBy {
+		column, err := getColumn(order.Expr)
+		if err != nil {
+			return err
+		}
+		if column.kind == stringListColumn {
+			return serviceerror.NewInvalidArgumentf("%s: 'order by' %s", notSupportedErrMessage, sqlparser.String(order.Expr))
+		}
+		w.orderBy = append(w.orderBy, orderByColumn{column: column, desc: order.Direction == sqlparser.DescScr})
+	}
+
+	for _, expr := range selectStmt.GroupBy {
+		column, err := getColumn(expr)
+		if err != nil {
+			return err
+		}
+		if column.kind != stringColumn {
+			return serviceerror.NewInvalidArgumentf("%s: 'group by' %s", notSupportedErrMessage, sqlparser.String(expr))
+		}
+		w.groupBy = append(w.groupBy, column)
+	}
 	return nil
 }
 
-func getWhereCause(query string) (sqlparser.Expr, error) {
+func getColumn(expr sqlparser.Expr) (workerColumn, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return workerColumn{}, serviceerror.NewInvalidArgumentf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	column, ok := workerColumns[sqlparser.String(colName)]
+	if !ok {
+		return workerColumn{}, serviceerror.NewInvalidArgumentf(
+			"unknown or unsupported worker heartbeat search field: %s", sqlparser.String(colName))
+	}
+	return column, nil
+}
+
+func parseSelect(query string) (*sqlparser.Select, error) {
 	stmt, err := sqlparser.Parse(query)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgumentf("%s: %v", malformedSqlQueryErrMessage, err)
@@ -164,6 +208,19 @@ func getWhereCause(query string) (sqlparser.Expr, error) {
 		return nil, serviceerror.NewInvalidArgumentf("%s: 'limit' clause", notSupportedErrMessage)
 	}
 
+	if selectStmt.Having != nil {
+		return nil, serviceerror.NewInvalidArgumentf("%s: 'having' clause", notSupportedErrMessage)
+	}
+
+	return selectStmt, nil
+}
+
+func getWhereCause(query string) (sqlparser.Expr, error) {
+	selectStmt, err := parseSelect(query)
+	if err != nil {
+		return nil, err
+	}
+
 	if selectStmt.Where == nil {
 		return nil, serviceerror.NewInvalidArgumentf("%s: 'where' clause is missing", notSupportedErrMessage)
 	}
@@ -177,12 +234,16 @@ func prepareQuery(query string) (string, error) {
 		return "", nil
 	}
 
-	if strings.HasPrefix(strings.ToLower(query), "where ") ||
-		strings.HasPrefix(strings.ToLower(query), "select ") {
+	lowerQuery := strings.ToLower(query)
+	if strings.HasPrefix(lowerQuery, "where ") ||
+		strings.HasPrefix(lowerQuery, "select ") {
 		return "", fmt.Errorf("invalid filter: %s", query)
 	}
 
 	// sqlparser can't parse just WHERE clause but instead accepts only valid SQL statement.
+	if strings.HasPrefix(lowerQuery, "order by ") || strings.HasPrefix(lowerQuery, "group by ") {
+		return fmt.Sprintf("select * from table1 %s", query), nil
+	}
 	query = fmt.Sprintf("select * from table1 where %s", query)
 
 	return query, nil
@@ -206,7 +267,7 @@ func (w *workerQueryEngine) evaluateExpression(expr sqlparser.Expr) (bool, error
 	case *sqlparser.RangeCond:
 		return w.evaluateRange(e)
 	case *sqlparser.IsExpr:
-		return false, serviceerror.NewInvalidArgumentf("%s: 'is' expression", notSupportedErrMessage)
+		return w.evaluateIs(e)
 	case *sqlparser.NotExpr:
 		return false, serviceerror.NewInvalidArgumentf("%s: 'not' expression", notSupportedErrMessage)
 	case *sqlparser.FuncExpr:
@@ -251,50 +312,120 @@ func (w *workerQueryEngine) evaluateComparison(expr *sqlparser.ComparisonExpr) (
 		return false, serviceerror.NewInvalidArgumentf("invalid filter name: %s", sqlparser.String(expr.Left))
 	}
 	colName := sqlparser.String(colNameExpr)
+	column, ok := workerColumns[colName]
+	if !ok {
+		return false, serviceerror.NewInvalidArgumentf("unknown or unsupported worker heartbeat search field: %s", colName)
+	}
+
+	if expr.Operator == sqlparser.InStr || expr.Operator == sqlparser.NotInStr {
+		return w.evaluateIn(expr, column, colName)
+	}
+
 	valExpr, ok := expr.Right.(*sqlparser.SQLVal)
 	if !ok {
 		return false, serviceerror.NewInvalidArgumentf("invalid value: %s", sqlparser.String(expr.Right))
 	}
 	valStr := sqlparser.String(valExpr)
 
-	switch colName {
-	case workerInstanceKeyColName,
-		workerIdentityColName,
-		workerHostNameColName,
-		workerTaskQueueColName,
-		workerDeploymentNameColName,
-		workerSdkNameColName,
-		workerSdkVersionColName,
-		workerStatusColName:
-		propertyFunc, ok := propertyMapFuncs[colName]
-		if !ok {
-			return false, serviceerror.NewInvalidArgumentf("unknown or unsupported worker heartbeat search field: %s", colName)
-		}
+	switch column.kind {
+	case stringColumn, stringListColumn:
 		val, err := sqlquery.ExtractStringValue(valStr)
 		if err != nil {
 			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
 		}
-		existingVal := propertyFunc(w.currentWorker)
-		return compareQueryString(val, existingVal, expr.Operator, colName)
-	case workerStartTimeColName:
+		var existingVals []string
+		if column.kind == stringColumn {
+			existingVals = []string{column.stringValue(w.currentWorker)}
+		} else {
+			existingVals = column.stringsValue(w.currentWorker)
+		}
+		return w.compareQueryStrings(val, existingVals, expr.Operator, colName)
+	case timeColumn:
 		expectedTime, err := sqlquery.ConvertToTime(valStr)
 		if err != nil {
 			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
 		}
-		receivedTime := w.currentWorker.GetStartTime().AsTime()
+		receivedTime := column.timeValue(w.currentWorker).AsTime()
 		return w.compareTime(receivedTime, expectedTime, expr.Operator)
-	case workerHeartbeatTimeColName:
-		expectedTime, err := sqlquery.ConvertToTime(valStr)
+	case intColumn:
+		expected, err := sqlquery.ExtractIntValue(valStr)
 		if err != nil {
 			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
 		}
-		receivedTime := w.currentWorker.GetHeartbeatTime().AsTime()
-		return w.compareTime(receivedTime, expectedTime, expr.Operator)
+		received, ok := column.intValue(w.currentWorker)
+		if !ok {
+			return false, nil
+		}
+		return compareInt(received, int64(expected), expr.Operator)
 	default:
 		return false, serviceerror.NewInvalidArgumentf("unknown or unsupported worker heartbeat search field: %s", colName)
 	}
 }
 
+func (w *workerQueryEngine) evaluateIn(expr *sqlparser.ComparisonExpr, column workerColumn, colName string) (bool, error) {
+	tuple, ok := expr.Right.(sqlparser.ValTuple)
+	if !ok {
+		return false, serviceerror.NewInvalidArgumentf("invalid value: %s", sqlparser.String(expr.Right))
+	}
+
+	var match bool
+	switch column.kind {
+	case stringColumn, stringListColumn:
+		values := make([]string, len(tuple))
+		for i, valExpr := range tuple {
+			val, err := sqlquery.ExtractStringValue(sqlparser.String(valExpr))
+			if err != nil {
+				return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
+			}
+			values[i] = val
+		}
+		existingVals := column.stringsValue
+		if column.kind == stringColumn {
+			existingVals = func(hb *workerpb.WorkerHeartbeat) []string { return []string{column.stringValue(hb)} }
+		}
+		match = slices.ContainsFunc(existingVals(w.currentWorker), func(existing string) bool {
+			return slices.Contains(values, existing)
+		})
+	case intColumn:
+		values := make([]int64, len(tuple))
+		for i, valExpr := range tuple {
+			val, err := sqlquery.ExtractIntValue(sqlparser.String(valExpr))
+			if err != nil {
+				return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
+			}
+			values[i] = int64(val)
+		}
+		received, ok := column.intValue(w.currentWorker)
+		if !ok {
+			return false, nil
+		}
+		match = slices.Contains(values, received)
+	default:
+		return false, serviceerror.NewInvalidArgumentf("%s: operation %s is not supported for %s column",
+			invalidExpressionErrMessage, expr.Operator, colName)
+	}
+
+	if expr.Operator == sqlparser.NotInStr {
+		return !match, nil
+	}
+	return match, nil
+}
+
+func (w *workerQueryEngine) evaluateIs(expr *sqlparser.IsExpr) (bool, error) {
+	column, err := getColumn(expr.Expr)
+	if err != nil {
+		return false, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return column.isNull(w.currentWorker), nil
+	case sqlparser.IsNotNullStr:
+		return !column.isNull(w.currentWorker), nil
+	default:
+		return false, serviceerror.NewInvalidArgumentf("%s: '%s' expression", notSupportedErrMessage, expr.Operator)
+	}
+}
+
 func (w *workerQueryEngine) evaluateRange(expr *sqlparser.RangeCond) (bool, error) {
 	if expr == nil {
 		return false, serviceerror.NewInvalidArgumentf("RangeCond input expression cannot be nil")
@@ -304,9 +435,14 @@ func (w *workerQueryEngine) evaluateRange(expr *sqlparser.RangeCond) (bool, erro
 		return false, serviceerror.NewInvalidArgumentf("unknown or unsupported column name: %s", sqlparser.String(expr.Left))
 	}
 	colNameStr := sqlparser.String(colName)
+	if expr.Operator != sqlparser.BetweenStr && expr.Operator != sqlparser.NotBetweenStr {
+		return false, serviceerror.NewInvalidArgumentf("%s: range condition operator must be 'between' or 'not between'. Got %s",
+			invalidExpressionErrMessage, expr.Operator)
+	}
 
-	switch colNameStr {
-	case workerStartTimeColName, workerHeartbeatTimeColName:
+	var inRange bool
+	switch column := workerColumns[colNameStr]; column.kind {
+	case timeColumn:
 		fromValue, err := sqlquery.ConvertToTime(sqlparser.String(expr.From))
 		if err != nil {
 			return false, err
@@ -325,21 +461,98 @@ func (w *workerQueryEngine) evaluateRange(expr *sqlparser.RangeCond) (bool, erro
 			// In this case, we return false for the range condition.
 			return false, nil
 		}
-
-		switch expr.Operator {
-		case sqlparser.BetweenStr:
-			return w.compareTimeBetween(fromValue, toValue, timeValue), nil
-		case sqlparser.NotBetweenStr:
-			result := w.compareTimeBetween(fromValue, toValue, timeValue)
-			return !result, nil
-		default:
-			return false, serviceerror.NewInvalidArgumentf("%s: range condition operator must be 'between' or 'not between'. Got %s",
-				invalidExpressionErrMessage, expr.Operator)
+		inRange = w.compareTimeBetween(fromValue, toValue, timeValue)
+	case intColumn:
+		fromValue, err := sqlquery.ExtractIntValue(sqlparser.String(expr.From))
+		if err != nil {
+			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colNameStr, err)
 		}
-
+		toValue, err := sqlquery.ExtractIntValue(sqlparser.String(expr.To))
+		if err != nil {
+			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colNameStr, err)
+		}
+		value, ok := column.intValue(w.currentWorker)
+		if !ok {
+			return false, nil
+		}
+		inRange = value >= int64(fromValue) && value <= int64(toValue)
 	default:
 		return false, serviceerror.NewInvalidArgumentf("unknown or unsupported column name: %s", colNameStr)
 	}
+
+	if expr.Operator == sqlparser.NotBetweenStr {
+		return !inRange, nil
+	}
+	return inRange, nil
+}
+
+// compareQueryStrings matches the value against the values of a column. Positive operators match if
+// any of the values matches, negated operators match if none of them matches the positive operator.
+func (w *workerQueryEngine) compareQueryStrings(inStr string, existingStrs []string, operation string, colName string) (bool, error) {
+	negated := false
+	switch operation {
+	case sqlparser.NotEqualStr:
+		operation, negated = sqlparser.EqualStr, true
+	case sqlparser.NotStartsWithStr:
+		operation, negated = sqlparser.StartsWithStr, true
+	case sqlparser.NotLikeStr:
+		operation, negated = sqlparser.LikeStr, true
+	default:
+	}
+
+	var match bool
+	if operation == sqlparser.LikeStr {
+		if len(inStr) == 0 {
+			return false, serviceerror.NewInvalidArgumentf("%s cannot be empty", colName)
+		}
+		re, err := w.getLikePattern(inStr)
+		if err != nil {
+			return false, serviceerror.NewInvalidArgumentf("invalid value for %s: %v", colName, err)
+		}
+		match = slices.ContainsFunc(existingStrs, re.MatchString)
+	} else {
+		for _, existingStr := range existingStrs {
+			m, err := compareQueryString(inStr, existingStr, operation, colName)
+			if err != nil {
+				return false, err
+			}
+			match = match || m
+		}
+		if len(existingStrs) == 0 {
+			// still validate the operation
+			if _, err := compareQueryString(inStr, "", operation, colName); err != nil {
+				return false, err
+			}
+		}
+	}
+	return match != negated, nil
+}
+
+// getLikePattern compiles a LIKE pattern, where '%' matches any sequence of characters and '_'
+// matches a single character.
+func (w *workerQueryEngine) getLikePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := w.likePatterns[pattern]; ok {
+		return re, nil
+	}
+	var b strings.Builder
+	b.WriteString("(?s)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, err
+	}
+	w.likePatterns[pattern] = re
+	return re, nil
 }
 
 func compareQueryString(inStr string, expectedStr string, operation string, colName string) (bool, error) {
@@ -361,22 +574,15 @@ func compareQueryString(inStr string, expectedStr string, operation string, colN
 }
 
 func (w *workerQueryEngine) getTimeValue(colName string) (time.Time, error) {
-	var zeroTime time.Time
-	switch colName {
-	case workerStartTimeColName:
-		if w.currentWorker.GetStartTime() == nil {
-			return zeroTime, nil
-		}
-		return w.currentWorker.GetStartTime().AsTime(), nil
-	case workerHeartbeatTimeColName:
-		if w.currentWorker.GetHeartbeatTime() == nil {
-			return zeroTime, nil
-		}
-		return w.currentWorker.GetHeartbeatTime().AsTime(), nil
-
-	default:
-		return zeroTime, serviceerror.NewInvalidArgumentf("unknown or unsupported column name: %s", colName)
+	column, ok := workerColumns[colName]
+	if !ok || column.kind != timeColumn {
+		return time.Time{}, serviceerror.NewInvalidArgumentf("unknown or unsupported column name: %s", colName)
+	}
+	ts := column.timeValue(w.currentWorker)
+	if ts == nil {
+		return time.Time{}, nil
 	}
+	return ts.AsTime(), nil
 }
 
 func (w *workerQueryEngine) compareTimeBetween(
@@ -403,3 +609,22 @@ func (w *workerQueryEngine) compareTime(receivedTime time.Time, expectedTime tim
 		return false, serviceerror.NewInvalidArgumentf("%s: operation %s is not supported", invalidExpressionErrMessage, operation)
 	}
 }
+
+func compareInt(received int64, expected int64, operation string) (bool, error) {
+	switch operation {
+	case sqlparser.GreaterEqualStr:
+		return received >= expected, nil
+	case sqlparser.LessEqualStr:
+		return received <= expected, nil
+	case sqlparser.GreaterThanStr:
+		return received > expected, nil
+	case sqlparser.LessThanStr:
+		return received < expected, nil
+	case sqlparser.EqualStr:
+		return received == expected, nil
+	case sqlparser.NotEqualStr:
+		return received != expected, nil
+	default:
+		return false, serviceerror.NewInvalidArgumentf("%s: operation %s is not supported", invalidExpressionErrMessage, operation)
+	}
+}
@@ -328,3 +328,93 @@ func TestActivityInfoMatchEvaluator_SupportedTimeFields(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkerQueryEngine_ExtendedOperators(t *testing.T) {
+	hb := &workerpb.WorkerHeartbeat{
+		TaskQueue:  "task_queue",
+		SdkVersion: "1.30.1",
+		DeploymentVersion: &deploymentpb.WorkerDeploymentVersion{
+			DeploymentName: "deployment_name",
+			BuildId:        "build_id",
+		},
+		Plugins: []*workerpb.PluginInfo{{Name: "plugin_a"}, {Name: "plugin_b"}},
+		WorkflowTaskSlotsInfo: &workerpb.WorkerSlotsInfo{
+			CurrentAvailableSlots: 5,
+			CurrentUsedSlots:      10,
+			SlotSupplierKind:      "Fixed",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedMatch bool
+	}{
+		{"like prefix", "SdkVersion LIKE '1.30.%'", true},
+		{"like single char", "SdkVersion LIKE '1.3_.1'", true},
+		{"like escaped", "SdkVersion LIKE '1_30_1'", true},
+		{"like miss", "SdkVersion LIKE '1.29%'", false},
+		{"not like", "SdkVersion NOT LIKE '1.29%'", true},
+		{"in", "TaskQueue IN ('other', 'task_queue')", true},
+		{"in miss", "TaskQueue IN ('other')", false},
+		{"not in", "TaskQueue NOT IN ('other')", true},
+		{"is null", "HostName IS NULL", true},
+		{"is not null", "DeploymentName IS NOT NULL", true},
+		{"build id", "BuildId = 'build_id'", true},
+		{"plugin equal", "Plugin = 'plugin_b'", true},
+		{"plugin not equal", "Plugin != 'plugin_b'", false},
+		{"plugin starts with", "Plugin STARTS_WITH 'plugin_'", true},
+		{"plugin in", "Plugin IN ('plugin_c', 'plugin_a')", true},
+		{"plugin is not null", "Plugin IS NOT NULL", true},
+		{"slots used", "WorkflowTaskSlotsUsed >= 10", true},
+		{"slots available", "WorkflowTaskSlotsAvailable < 5", false},
+		{"slots between", "WorkflowTaskSlotsUsed BETWEEN 5 AND 10", true},
+		{"slots in", "WorkflowTaskSlotsAvailable IN (1, 5)", true},
+		{"slot supplier", "WorkflowTaskSlotSupplierKind = 'Fixed'", true},
+		{"unset slots never match", "ActivityTaskSlotsUsed >= 0", false},
+		{"unset slots are null", "ActivityTaskSlotsUsed IS NULL", true},
+		{"with order by", "TaskQueue = 'task_queue' ORDER BY StartTime DESC", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, err := newWorkerQueryEngine("nsID", tt.query)
+			assert.NoError(t, err)
+
+			match, err := engine.EvaluateWorker(hb)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMatch, match)
+		})
+	}
+}
+
+func TestWorkerQueryEngine_OrderAndGroupBy(t *testing.T) {
+	engine, err := newWorkerQueryEngine("nsID", "ORDER BY WorkflowTaskSlotsUsed DESC, StartTime")
+	assert.NoError(t, err)
+	assert.Nil(t, engine.parsedWhereExpression)
+	assert.Len(t, engine.orderBy, 2)
+	assert.True(t, engine.orderBy[0].desc)
+	assert.False(t, engine.orderBy[1].desc)
+
+	hb := &workerpb.WorkerHeartbeat{WorkflowTaskSlotsInfo: &workerpb.WorkerSlotsInfo{CurrentUsedSlots: 3}}
+	match, err := engine.EvaluateWorker(hb)
+	assert.NoError(t, err)
+	assert.True(t, match)
+	assert.Equal(t, []string{encodeSortInt(3), ""}, engine.sortValues(hb))
+	assert.Less(t, encodeSortInt(-1), encodeSortInt(0))
+	assert.Less(t, encodeSortInt(2), encodeSortInt(10))
+
+	engine, err = newWorkerQueryEngine("nsID", "TaskQueue = 'tq' GROUP BY SdkVersion, DeploymentName")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.0", ""}, engine.groupValues(&workerpb.WorkerHeartbeat{SdkVersion: "1.0"}))
+
+	for _, query := range []string{
+		"ORDER BY Plugin",
+		"ORDER BY Unknown",
+		"GROUP BY StartTime",
+		"TaskQueue = 'tq' GROUP BY SdkVersion HAVING count(*) > 1",
+	} {
+		_, err = newWorkerQueryEngine("nsID", query)
+		assert.Error(t, err, query)
+	}
+}
@@ -0,0 +1,270 @@
+package backlogmigration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/tqid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+type (
+	// AdminClient contains the subset of methods from [adminservice.AdminServiceClient] that we need, to make it
+	// easier to implement in tests.
+	AdminClient interface {
+		DeleteTaskQueueTasks(
+			ctx context.Context,
+			in *adminservice.DeleteTaskQueueTasksRequest,
+			opts ...grpc.CallOption,
+		) (*adminservice.DeleteTaskQueueTasksResponse, error)
+		ForceUnloadTaskQueuePartition(
+			ctx context.Context,
+			in *adminservice.ForceUnloadTaskQueuePartitionRequest,
+			opts ...grpc.CallOption,
+		) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		UpdateTaskQueuePause(
+			ctx context.Context,
+			in *adminservice.UpdateTaskQueuePauseRequest,
+			opts ...grpc.CallOption,
+		) (*adminservice.UpdateTaskQueuePauseResponse, error)
+	}
+
+	activities struct {
+		adminClient        AdminClient
+		taskManager        persistence.TaskManager
+		fairTaskManager    persistence.FairTaskManager
+		numReadPartitions  dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		numWritePartitions dynamicconfig.IntPropertyFnWithTaskQueueFilter
+	}
+)
+
+var backlogTaskTypes = []enumspb.TaskQueueType{
+	enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+}
+
+// pauseSource pauses every task type of the source task queue that isn't paused already, so that matching stops
+// dispatching the tasks it has loaded while they are moved. Returns the task types it paused, which are resumed once
+// the migration is done. Pauses set by the migration itself, e.g. by an earlier attempt of this activity, are
+// returned too. An operator pause is kept and left in place after the migration, but only if it blocks dispatch for
+// the whole migration: a draining, scheduled or expiring pause is rejected, since the source would dispatch tasks
+// while they are moved. Task queues that use worker versioning are rejected, because their versioned backlogs are
+// stored in separate partitions that are not migrated.
+func (a *activities) pauseSource(ctx context.Context, params WorkflowParams) (_ []enumspb.TaskQueueType, retErr error) {
+	resp, err := a.taskManager.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: params.NamespaceID,
+		TaskQueue:   params.SourceTaskQueue,
+	})
+	var userData *persistencespb.TaskQueueUserData
+	if err == nil {
+		userData = resp.UserData.GetData()
+	} else if _, ok := err.(*serviceerror.NotFound); !ok {
+		return nil, err
+	}
+	if isVersioned(userData) {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("task queue %s uses worker versioning, only unversioned backlogs can be migrated", params.SourceTaskQueue),
+			errorTypeInvalidRequest,
+			nil,
+		)
+	}
+
+	// Check every task type before pausing any, so that a rejected migration leaves the source as it was.
+	var toPause, paused []enumspb.TaskQueueType
+	for _, taskType := range backlogTaskTypes {
+		pause := userData.GetPerType()[int32(taskType)].GetPause()
+		switch {
+		case pause == nil:
+			toPause = append(toPause, taskType)
+		case pause.GetUpdateIdentity() == WorkflowName:
+			paused = append(paused, taskType)
+		case !isBlockingPause(pause, time.Now()):
+			return nil, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("%s task queue %s has a pause that doesn't block dispatch for the whole migration, remove it or pause it without an end time",
+					taskType, params.SourceTaskQueue),
+				errorTypeInvalidRequest,
+				nil,
+			)
+		}
+	}
+
+	defer func() {
+		if retErr != nil && len(paused) > 0 {
+			// Best effort, a retry recognizes the pauses that are left by their identity.
+			_ = a.resumeSource(ctx, params, paused)
+		}
+	}()
+	for _, taskType := range toPause {
+		_, err := a.adminClient.UpdateTaskQueuePause(ctx, &adminservice.UpdateTaskQueuePauseRequest{
+			Namespace:     params.Namespace,
+			TaskQueue:     params.SourceTaskQueue,
+			TaskQueueType: taskType,
+			Mode:          enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED,
+			Reason:        fmt.Sprintf("migrating backlog to %s", params.DestinationTaskQueue),
+			Identity:      WorkflowName,
+		})
+		if err != nil {
+			return nil, convertServerErr(err, "UpdateTaskQueuePause failed")
+		}
+		paused = append(paused, taskType)
+	}
+	slices.Sort(paused)
+	return paused, nil
+}
+
+// isBlockingPause returns whether an operator pause stops all dispatch from now until it is removed.
+func isBlockingPause(pause *persistencespb.TaskQueuePause, now time.Time) bool {
+	return pause.GetMode() == enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED &&
+		(pause.GetStartTime() == nil || !pause.GetStartTime().AsTime().After(now)) &&
+		pause.GetEndTime() == nil
+}
+
+// resumeSource removes the pause that pauseSource set on the given task types.
+func (a *activities) resumeSource(ctx context.Context, params WorkflowParams, taskTypes []enumspb.TaskQueueType) error {
+	for _, taskType := range taskTypes {
+		_, err := a.adminClient.UpdateTaskQueuePause(ctx, &adminservice.UpdateTaskQueuePauseRequest{
+			Namespace:     params.Namespace,
+			TaskQueue:     params.SourceTaskQueue,
+			TaskQueueType: taskType,
+			Mode:          enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED,
+			Identity:      WorkflowName,
+		})
+		if err != nil {
+			return convertServerErr(err, "UpdateTaskQueuePause failed")
+		}
+	}
+	return nil
+}
+
+func isVersioned(userData *persistencespb.TaskQueueUserData) bool {
+	versioningData := userData.GetVersioningData()
+	if len(versioningData.GetVersionSets()) > 0 ||
+		len(versioningData.GetAssignmentRules()) > 0 ||
+		len(versioningData.GetRedirectRules()) > 0 {
+		return true
+	}
+	for _, perType := range userData.GetPerType() {
+		deploymentData := perType.GetDeploymentData()
+		//nolint:staticcheck // SA1019: deprecated deployments are still versioned.
+		if len(deploymentData.GetVersions()) > 0 || len(deploymentData.GetDeployments()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// listBacklogs returns every non-empty subqueue of every unversioned partition of the source task queue, in both the
+// classic and the fairness task tables. The partition count is taken from dynamic config, using the larger of the read
+// and write partition counts so that partitions that are being scaled down are drained too.
+func (a *activities) listBacklogs(ctx context.Context, params WorkflowParams) ([]Backlog, error) {
+	family, err := tqid.NewTaskQueueFamily(params.NamespaceID, params.SourceTaskQueue)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+
+	var backlogs []Backlog
+	for _, taskType := range backlogTaskTypes {
+		numPartitions := max(
+			a.numReadPartitions(params.Namespace, params.SourceTaskQueue, taskType),
+			a.numWritePartitions(params.Namespace, params.SourceTaskQueue, taskType),
+		)
+		for partitionID := 0; partitionID < numPartitions; partitionID++ {
+			partition := family.TaskQueue(taskType).NormalPartition(partitionID)
+			for _, fair := range []bool{false, true} {
+				taskManager := a.taskManager
+				if fair {
+					taskManager = a.fairTaskManager
+				}
+				resp, err := taskManager.GetTaskQueue(ctx, &persistence.GetTaskQueueRequest{
+					NamespaceID: params.NamespaceID,
+					TaskQueue:   partition.RpcName(),
+					TaskType:    taskType,
+				})
+				if _, ok := err.(*serviceerror.NotFound); ok {
+					continue
+				} else if err != nil {
+					return nil, err
+				}
+				numSubqueues := max(1, len(resp.TaskQueueInfo.GetSubqueues()))
+				for subqueue := 0; subqueue < numSubqueues; subqueue++ {
+					backlogs = append(backlogs, Backlog{
+						PartitionID: partitionID,
+						TaskType:    taskType,
+						Fair:        fair,
+						Subqueue:    subqueue,
+					})
+				}
+			}
+		}
+	}
+	return backlogs, nil
+}
+
+// migrateTasks moves one page of a backlog to the destination task queue.
+func (a *activities) migrateTasks(ctx context.Context, request MigrateTasksRequest) (MigrateTasksResponse, error) {
+	partition := tqid.UnsafeTaskQueueFamily("", request.SourceTaskQueue).
+		TaskQueue(request.Backlog.TaskType).
+		NormalPartition(request.Backlog.PartitionID)
+	var minPass int64
+	if request.Backlog.Fair {
+		minPass = 1
+	}
+	resp, err := a.adminClient.DeleteTaskQueueTasks(ctx, &adminservice.DeleteTaskQueueTasksRequest{
+		Namespace:     request.Namespace,
+		TaskQueue:     partition.RpcName(),
+		TaskQueueType: request.Backlog.TaskType,
+		Subqueue:      int32(request.Backlog.Subqueue),
+		MinPass:       minPass,
+		// An empty filter matches every task.
+		Filter:               &adminservice.TaskQueueTaskFilter{},
+		DestinationTaskQueue: request.DestinationTaskQueue,
+		BatchSize:            int32(request.BatchSize),
+		NextPageToken:        request.NextPageToken,
+	})
+	if err != nil {
+		return MigrateTasksResponse{}, convertServerErr(err, "DeleteTaskQueueTasks failed")
+	}
+	return MigrateTasksResponse{
+		TasksMigrated: int64(len(resp.GetTasks())),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// unloadPartition unloads a partition so that it drops the tasks it had buffered and reloads its user data and backlog
+// counters. Partitions are unloaded after the source is paused, before their backlog is moved, so that they don't
+// dispatch tasks they loaded before the pause, and again once drained.
+func (a *activities) unloadPartition(ctx context.Context, namespaceName string, taskQueue string, backlog Backlog) error {
+	_, err := a.adminClient.ForceUnloadTaskQueuePartition(ctx, &adminservice.ForceUnloadTaskQueuePartitionRequest{
+		Namespace: namespaceName,
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     taskQueue,
+			TaskQueueType: backlog.TaskType,
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(backlog.PartitionID)},
+		},
+	})
+	if err != nil {
+		return convertServerErr(err, fmt.Sprintf("ForceUnloadTaskQueuePartition failed for partition %d", backlog.PartitionID))
+	}
+	return nil
+}
+
+func convertServerErr(err error, msg string) error {
+	if code := serviceerror.ToStatus(err).Code(); code == codes.InvalidArgument || code == codes.NotFound {
+		// Don't retry invalid-argument or not-found errors.
+		return temporal.NewNonRetryableApplicationError(msg, errorTypeInvalidRequest, err)
+	}
+	// All other errors will be retried.
+	return err
+}
@@ -0,0 +1,275 @@
+package backlogmigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
+	deploymentspb "go.temporal.io/server/api/deployment/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fakeAdminClient struct {
+	AdminClient
+	pauseRequests  []*adminservice.UpdateTaskQueuePauseRequest
+	deleteRequests []*adminservice.DeleteTaskQueueTasksRequest
+	// pauseErr is returned when pausing a task type
+	pauseErr map[enumspb.TaskQueueType]error
+}
+
+func (c *fakeAdminClient) UpdateTaskQueuePause(
+	_ context.Context, req *adminservice.UpdateTaskQueuePauseRequest, _ ...grpc.CallOption,
+) (*adminservice.UpdateTaskQueuePauseResponse, error) {
+	c.pauseRequests = append(c.pauseRequests, req)
+	if req.GetMode() != enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED {
+		if err := c.pauseErr[req.GetTaskQueueType()]; err != nil {
+			return nil, err
+		}
+	}
+	return &adminservice.UpdateTaskQueuePauseResponse{}, nil
+}
+
+func (c *fakeAdminClient) DeleteTaskQueueTasks(
+	_ context.Context, req *adminservice.DeleteTaskQueueTasksRequest, _ ...grpc.CallOption,
+) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+	c.deleteRequests = append(c.deleteRequests, req)
+	return &adminservice.DeleteTaskQueueTasksResponse{
+		Tasks:         []*persistencespb.AllocatedTaskInfo{{TaskId: 1}, {TaskId: 2}},
+		NextPageToken: []byte{42},
+	}, nil
+}
+
+func TestPauseSource(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		userData       *persistencespb.TaskQueueUserData
+		expectedPaused []enumspb.TaskQueueType
+		// expectedRequests are the task types that are paused by this call, defaults to expectedPaused
+		expectedRequests []enumspb.TaskQueueType
+		expectedErr      string
+	}{
+		{
+			name:           "no_user_data",
+			expectedPaused: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		},
+		{
+			name:           "keeps_operator_pause",
+			userData:       newPausedUserData(&persistencespb.TaskQueuePause{Mode: enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED}),
+			expectedPaused: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		},
+		{
+			// an earlier attempt paused the workflow type and failed to pause the activity type
+			name: "returns_own_pause",
+			userData: newPausedUserData(&persistencespb.TaskQueuePause{
+				Mode:           enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED,
+				UpdateIdentity: WorkflowName,
+			}),
+			expectedPaused:   []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+			expectedRequests: []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+		},
+		{
+			name:        "rejects_draining_pause",
+			userData:    newPausedUserData(&persistencespb.TaskQueuePause{Mode: enumsspb.TASK_QUEUE_PAUSE_MODE_DRAINING}),
+			expectedErr: "doesn't block dispatch",
+		},
+		{
+			name: "rejects_expiring_pause",
+			userData: newPausedUserData(&persistencespb.TaskQueuePause{
+				Mode:    enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED,
+				EndTime: timestamppb.New(time.Now().Add(time.Hour)),
+			}),
+			expectedErr: "doesn't block dispatch",
+		},
+		{
+			name: "rejects_scheduled_pause",
+			userData: newPausedUserData(&persistencespb.TaskQueuePause{
+				Mode:      enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED,
+				StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+			}),
+			expectedErr: "doesn't block dispatch",
+		},
+		{
+			name: "rejects_build_id_versioning",
+			userData: &persistencespb.TaskQueueUserData{
+				VersioningData: &persistencespb.VersioningData{
+					VersionSets: []*persistencespb.CompatibleVersionSet{{SetIds: []string{"set"}}},
+				},
+			},
+			expectedErr: "uses worker versioning",
+		},
+		{
+			name: "rejects_deployment_versioning",
+			userData: &persistencespb.TaskQueueUserData{
+				PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+					int32(enumspb.TASK_QUEUE_TYPE_ACTIVITY): {
+						DeploymentData: &persistencespb.DeploymentData{
+							Versions: []*deploymentspb.DeploymentVersionData{{}},
+						},
+					},
+				},
+			},
+			expectedErr: "uses worker versioning",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			taskManager := persistence.NewMockTaskManager(gomock.NewController(t))
+			if tc.userData == nil {
+				taskManager.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).
+					Return(nil, serviceerror.NewNotFound("no user data"))
+			} else {
+				taskManager.EXPECT().GetTaskQueueUserData(gomock.Any(), &persistence.GetTaskQueueUserDataRequest{
+					NamespaceID: "my-namespace-id",
+					TaskQueue:   "source",
+				}).Return(&persistence.GetTaskQueueUserDataResponse{
+					UserData: &persistencespb.VersionedTaskQueueUserData{Data: tc.userData},
+				}, nil)
+			}
+			client := &fakeAdminClient{}
+			a := &activities{adminClient: client, taskManager: taskManager}
+
+			paused, err := a.pauseSource(context.Background(), testWorkflowParams())
+			if tc.expectedErr != "" {
+				var applicationErr *temporal.ApplicationError
+				require.ErrorAs(t, err, &applicationErr)
+				assert.True(t, applicationErr.NonRetryable())
+				assert.ErrorContains(t, err, tc.expectedErr)
+				assert.Empty(t, client.pauseRequests)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPaused, paused)
+			expectedRequests := tc.expectedRequests
+			if expectedRequests == nil {
+				expectedRequests = tc.expectedPaused
+			}
+			require.Len(t, client.pauseRequests, len(expectedRequests))
+			for i, req := range client.pauseRequests {
+				assert.Equal(t, expectedRequests[i], req.GetTaskQueueType())
+				assert.Equal(t, enumsspb.TASK_QUEUE_PAUSE_MODE_PAUSED, req.GetMode())
+				assert.Equal(t, WorkflowName, req.GetIdentity())
+			}
+
+			numRequests := len(client.pauseRequests)
+			require.NoError(t, a.resumeSource(context.Background(), testWorkflowParams(), paused))
+			require.Len(t, client.pauseRequests, numRequests+len(paused))
+			for _, req := range client.pauseRequests[numRequests:] {
+				assert.Equal(t, enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED, req.GetMode())
+			}
+		})
+	}
+}
+
+func TestPauseSource_ResumesOnError(t *testing.T) {
+	t.Parallel()
+
+	taskManager := persistence.NewMockTaskManager(gomock.NewController(t))
+	taskManager.EXPECT().GetTaskQueueUserData(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("no user data"))
+	client := &fakeAdminClient{pauseErr: map[enumspb.TaskQueueType]error{
+		enumspb.TASK_QUEUE_TYPE_ACTIVITY: serviceerror.NewInvalidArgument("invalid pause"),
+	}}
+	a := &activities{adminClient: client, taskManager: taskManager}
+
+	_, err := a.pauseSource(context.Background(), testWorkflowParams())
+	var applicationErr *temporal.ApplicationError
+	require.ErrorAs(t, err, &applicationErr)
+	assert.True(t, applicationErr.NonRetryable())
+	// the workflow type that was paused is resumed again
+	require.Len(t, client.pauseRequests, 3)
+	assert.Equal(t, enumspb.TASK_QUEUE_TYPE_WORKFLOW, client.pauseRequests[2].GetTaskQueueType())
+	assert.Equal(t, enumsspb.TASK_QUEUE_PAUSE_MODE_UNSPECIFIED, client.pauseRequests[2].GetMode())
+}
+
+// newPausedUserData returns user data with the workflow task type paused.
+func newPausedUserData(pause *persistencespb.TaskQueuePause) *persistencespb.TaskQueueUserData {
+	return &persistencespb.TaskQueueUserData{
+		PerType: map[int32]*persistencespb.TaskQueueTypeUserData{
+			int32(enumspb.TASK_QUEUE_TYPE_WORKFLOW): {Pause: pause},
+		},
+	}
+}
+
+func TestListBacklogs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	taskManager := persistence.NewMockTaskManager(ctrl)
+	fairTaskManager := persistence.NewMockTaskManager(ctrl)
+	notFound := serviceerror.NewNotFound("task queue not found")
+	taskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.GetTaskQueueRequest) (*persistence.GetTaskQueueResponse, error) {
+			if req.TaskType != enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+				return nil, notFound
+			}
+			return &persistence.GetTaskQueueResponse{TaskQueueInfo: &persistencespb.TaskQueueInfo{}}, nil
+		},
+	).AnyTimes()
+	fairTaskManager.EXPECT().GetTaskQueue(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.GetTaskQueueRequest) (*persistence.GetTaskQueueResponse, error) {
+			if req.TaskType != enumspb.TASK_QUEUE_TYPE_ACTIVITY || req.TaskQueue != "source" {
+				return nil, notFound
+			}
+			return &persistence.GetTaskQueueResponse{TaskQueueInfo: &persistencespb.TaskQueueInfo{
+				Subqueues: []*persistencespb.SubqueueInfo{{}, {}},
+			}}, nil
+		},
+	).AnyTimes()
+	a := &activities{
+		taskManager:        taskManager,
+		fairTaskManager:    fairTaskManager,
+		numReadPartitions:  dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(2),
+		numWritePartitions: dynamicconfig.GetIntPropertyFnFilteredByTaskQueue(1),
+	}
+
+	backlogs, err := a.listBacklogs(context.Background(), testWorkflowParams())
+	require.NoError(t, err)
+	assert.Equal(t, []Backlog{
+		// the larger of the read and write partition counts is used
+		{PartitionID: 0, TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+		{PartitionID: 1, TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+		// fair backlogs are listed per subqueue, only the root partition has one
+		{PartitionID: 0, TaskType: enumspb.TASK_QUEUE_TYPE_ACTIVITY, Fair: true, Subqueue: 0},
+		{PartitionID: 0, TaskType: enumspb.TASK_QUEUE_TYPE_ACTIVITY, Fair: true, Subqueue: 1},
+	}, backlogs)
+}
+
+func TestMigrateTasks(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeAdminClient{}
+	a := &activities{adminClient: client}
+	resp, err := a.migrateTasks(context.Background(), MigrateTasksRequest{
+		Namespace:            "my-namespace",
+		SourceTaskQueue:      "source",
+		DestinationTaskQueue: "destination",
+		Backlog:              Backlog{PartitionID: 3, TaskType: enumspb.TASK_QUEUE_TYPE_ACTIVITY, Fair: true, Subqueue: 1},
+		BatchSize:            10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, MigrateTasksResponse{TasksMigrated: 2, NextPageToken: []byte{42}}, resp)
+	require.Len(t, client.deleteRequests, 1)
+	req := client.deleteRequests[0]
+	assert.Equal(t, "/_sys/source/3", req.GetTaskQueue())
+	assert.Equal(t, "destination", req.GetDestinationTaskQueue())
+	assert.EqualValues(t, 1, req.GetSubqueue())
+	// fair backlogs are read from the first pass
+	assert.EqualValues(t, 1, req.GetMinPass())
+	assert.EqualValues(t, 10, req.GetBatchSize())
+	assert.NotNil(t, req.GetFilter())
+}
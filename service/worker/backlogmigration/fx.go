@@ -0,0 +1,73 @@
+package backlogmigration
+
+import (
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	componentParams struct {
+		fx.In
+		AdminClient       AdminClient
+		TaskManager       persistence.TaskManager
+		FairTaskManager   persistence.FairTaskManager
+		DynamicCollection *dynamicconfig.Collection
+	}
+
+	component struct {
+		activities *activities
+	}
+)
+
+// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph,
+// given an [AdminClient] for the current cluster.
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &component{
+		activities: &activities{
+			adminClient:        params.AdminClient,
+			taskManager:        params.TaskManager,
+			fairTaskManager:    params.FairTaskManager,
+			numReadPartitions:  dynamicconfig.MatchingNumTaskqueueReadPartitions.Get(params.DynamicCollection),
+			numWritePartitions: dynamicconfig.MatchingNumTaskqueueWritePartitions.Get(params.DynamicCollection),
+		},
+	}
+}
+
+func (c *component) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (c *component) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (c *component) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivityWithOptions(c.activities.pauseSource, activity.RegisterOptions{
+		Name: pauseActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.resumeSource, activity.RegisterOptions{
+		Name: resumeActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.listBacklogs, activity.RegisterOptions{
+		Name: listBacklogsActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.migrateTasks, activity.RegisterOptions{
+		Name: migrateTasksActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.unloadPartition, activity.RegisterOptions{
+		Name: unloadActivityName,
+	})
+}
+
+func (c *component) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
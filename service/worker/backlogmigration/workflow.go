@@ -0,0 +1,258 @@
+// Package backlogmigration contains the system workflow that moves the persisted backlog of a task queue to another
+// task queue, e.g. after a task queue was renamed or split.
+package backlogmigration
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// WorkflowParams is the single argument to the backlog migration workflow.
+	WorkflowParams struct {
+		Namespace            string
+		NamespaceID          string
+		SourceTaskQueue      string
+		DestinationTaskQueue string
+		// BatchSize controls the number of tasks read and moved at a time. The maximum is MaxBatchSize. The default is
+		// DefaultBatchSize.
+		BatchSize int
+		// TasksPerSecond limits how fast tasks are moved. Zero means no limit.
+		TasksPerSecond float64
+		// Reason is only used for logging.
+		Reason string
+
+		// Backlogs, Progress, NextPageToken and PausedTaskTypes carry the state of the migration over ContinueAsNew.
+		Backlogs      []Backlog
+		Progress      Progress
+		NextPageToken []byte
+		// PausedTaskTypes are the task types of the source task queue that the migration paused and resumes when done.
+		PausedTaskTypes []enumspb.TaskQueueType
+	}
+
+	// Backlog identifies one persisted backlog of the source task queue. Each partition has one backlog per task type,
+	// task table and subqueue.
+	Backlog struct {
+		PartitionID int
+		TaskType    enumspb.TaskQueueType
+		// Fair is set for backlogs stored in the fairness task table.
+		Fair     bool
+		Subqueue int
+	}
+
+	// Progress is the response to QueryTypeProgress and the result of the workflow.
+	Progress struct {
+		DestinationTaskQueue string
+		// BacklogsTotal is zero until the backlogs of the source task queue have been listed.
+		BacklogsTotal   int
+		BacklogsDrained int
+		TasksMigrated   int64
+	}
+
+	// MigrateTasksRequest is the argument of the activity that moves one page of a backlog.
+	MigrateTasksRequest struct {
+		Namespace            string
+		SourceTaskQueue      string
+		DestinationTaskQueue string
+		Backlog              Backlog
+		BatchSize            int
+		NextPageToken        []byte
+	}
+
+	// MigrateTasksResponse is the result of the activity that moves one page of a backlog.
+	MigrateTasksResponse struct {
+		TasksMigrated int64
+		NextPageToken []byte
+	}
+)
+
+const (
+	// WorkflowName is the name of the backlog migration workflow.
+	WorkflowName = "temporal-sys-backlog-migration-workflow"
+	// MaxBatchSize is the maximum value for WorkflowParams.BatchSize.
+	MaxBatchSize = 1000
+	// DefaultBatchSize is the default value for WorkflowParams.BatchSize.
+	DefaultBatchSize = 100
+	// QueryTypeProgress is the query to get the Progress of the workflow.
+	QueryTypeProgress = "backlog-migration-progress-query"
+
+	errorTypeInvalidRequest  = "backlog-migration-error-type-invalid-request"
+	pauseActivityName        = "backlog-migration-pause-source-activity"
+	resumeActivityName       = "backlog-migration-resume-source-activity"
+	listBacklogsActivityName = "backlog-migration-list-backlogs-activity"
+	migrateTasksActivityName = "backlog-migration-migrate-tasks-activity"
+	unloadActivityName       = "backlog-migration-unload-partition-activity"
+
+	// activityTimeout is short because every activity only processes a single page of tasks.
+	activityTimeout = 30 * time.Second * debug.TimeoutMultiplier
+)
+
+var (
+	ErrNegativeBatchSize     = errors.New("BatchSize must be positive or 0 to use the default")
+	ErrBatchSizeTooLarge     = errors.New("BatchSize too large")
+	ErrNegativeRate          = errors.New("TasksPerSecond must not be negative")
+	ErrSameTaskQueue         = errors.New("SourceTaskQueue and DestinationTaskQueue must differ")
+	ErrTaskQueueNameRequired = errors.New("SourceTaskQueue and DestinationTaskQueue are required")
+
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+	}
+)
+
+// WorkflowID returns the ID of the backlog migration workflow of a task queue. Using a fixed ID guarantees that only one
+// migration runs per source task queue.
+func WorkflowID(namespaceName string, sourceTaskQueue string) string {
+	return fmt.Sprintf("%s/%s/%s", WorkflowName, namespaceName, sourceTaskQueue)
+}
+
+// Workflow pauses the source task queue, lists all its backlogs and then drains them one page at a time, sleeping
+// between pages to stay under the requested rate. Every partition is unloaded once the pause is in place, so that
+// matching drops the tasks it had already buffered, and again once drained, so that it reloads its backlog counters.
+// The pause is removed when the migration ends, whether it succeeded or not.
+func Workflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	params, err := parseParams(params)
+	if err != nil {
+		return params.Progress, err
+	}
+	progress := &params.Progress
+	progress.DestinationTaskQueue = params.DestinationTaskQueue
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return *progress, nil
+	}); err != nil {
+		return *progress, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: activityTimeout,
+		RetryPolicy:         activityRetryPolicy,
+	})
+
+	err = migrateBacklogs(ctx, &params)
+	if workflow.IsContinueAsNewError(err) {
+		return *progress, err
+	}
+	if len(params.PausedTaskTypes) > 0 {
+		// Resume the source even if the migration was canceled.
+		resumeCtx, cancel := workflow.NewDisconnectedContext(ctx)
+		defer cancel()
+		if resumeErr := workflow.ExecuteActivity(resumeCtx, resumeActivityName, params, params.PausedTaskTypes).Get(resumeCtx, nil); resumeErr != nil && err == nil {
+			err = resumeErr
+		}
+	}
+	return *progress, err
+}
+
+func migrateBacklogs(ctx workflow.Context, params *WorkflowParams) error {
+	logger := workflow.GetLogger(ctx)
+	progress := &params.Progress
+
+	if params.Backlogs == nil {
+		err := workflow.ExecuteActivity(ctx, pauseActivityName, *params).Get(ctx, &params.PausedTaskTypes)
+		if err != nil {
+			return err
+		}
+		if err := workflow.ExecuteActivity(ctx, listBacklogsActivityName, *params).Get(ctx, &params.Backlogs); err != nil {
+			return err
+		}
+		progress.BacklogsTotal = len(params.Backlogs)
+		logger.Info("Starting task queue backlog migration.",
+			tag.WorkflowNamespace(params.Namespace),
+			tag.WorkflowTaskQueueName(params.SourceTaskQueue),
+			tag.Counter(progress.BacklogsTotal),
+			tag.NewStringTag("reason", params.Reason),
+		)
+		if err := unloadPartitions(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	for progress.BacklogsDrained < len(params.Backlogs) {
+		backlog := params.Backlogs[progress.BacklogsDrained]
+		var response MigrateTasksResponse
+		err := workflow.ExecuteActivity(ctx, migrateTasksActivityName, MigrateTasksRequest{
+			Namespace:            params.Namespace,
+			SourceTaskQueue:      params.SourceTaskQueue,
+			DestinationTaskQueue: params.DestinationTaskQueue,
+			Backlog:              backlog,
+			BatchSize:            params.BatchSize,
+			NextPageToken:        params.NextPageToken,
+		}).Get(ctx, &response)
+		if err != nil {
+			return err
+		}
+		progress.TasksMigrated += response.TasksMigrated
+		params.NextPageToken = response.NextPageToken
+
+		if len(response.NextPageToken) == 0 {
+			if err := workflow.ExecuteActivity(ctx, unloadActivityName, params.Namespace, params.SourceTaskQueue, backlog).Get(ctx, nil); err != nil {
+				return err
+			}
+			progress.BacklogsDrained++
+		}
+
+		if params.TasksPerSecond > 0 && response.TasksMigrated > 0 {
+			wait := time.Duration(float64(response.TasksMigrated) / params.TasksPerSecond * float64(time.Second))
+			if err := workflow.Sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+
+		if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			return workflow.NewContinueAsNewError(ctx, WorkflowName, *params)
+		}
+	}
+	return nil
+}
+
+// unloadPartitions unloads every partition that has a backlog once.
+func unloadPartitions(ctx workflow.Context, params *WorkflowParams) error {
+	type partitionKey struct {
+		taskType    enumspb.TaskQueueType
+		partitionID int
+	}
+	unloaded := make(map[partitionKey]struct{})
+	for _, backlog := range params.Backlogs {
+		key := partitionKey{taskType: backlog.TaskType, partitionID: backlog.PartitionID}
+		if _, ok := unloaded[key]; ok {
+			continue
+		}
+		unloaded[key] = struct{}{}
+		if err := workflow.ExecuteActivity(ctx, unloadActivityName, params.Namespace, params.SourceTaskQueue, backlog).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseParams(params WorkflowParams) (WorkflowParams, error) {
+	var err error
+	switch {
+	case params.SourceTaskQueue == "" || params.DestinationTaskQueue == "":
+		err = ErrTaskQueueNameRequired
+	case params.SourceTaskQueue == params.DestinationTaskQueue:
+		err = ErrSameTaskQueue
+	case params.BatchSize < 0:
+		err = ErrNegativeBatchSize
+	case params.BatchSize > MaxBatchSize:
+		err = ErrBatchSizeTooLarge
+	case params.TasksPerSecond < 0:
+		err = ErrNegativeRate
+	}
+	if err != nil {
+		return params, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+
+	if params.BatchSize == 0 {
+		params.BatchSize = DefaultBatchSize
+	}
+	return params, nil
+}
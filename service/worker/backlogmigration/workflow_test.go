@@ -0,0 +1,190 @@
+package backlogmigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		modify   func(params *WorkflowParams)
+		expected error
+	}{
+		{"missing_destination", func(p *WorkflowParams) { p.DestinationTaskQueue = "" }, ErrTaskQueueNameRequired},
+		{"same_task_queue", func(p *WorkflowParams) { p.DestinationTaskQueue = p.SourceTaskQueue }, ErrSameTaskQueue},
+		{"negative_batch_size", func(p *WorkflowParams) { p.BatchSize = -1 }, ErrNegativeBatchSize},
+		{"batch_size_too_large", func(p *WorkflowParams) { p.BatchSize = MaxBatchSize + 1 }, ErrBatchSizeTooLarge},
+		{"negative_rate", func(p *WorkflowParams) { p.TasksPerSecond = -1 }, ErrNegativeRate},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			params := testWorkflowParams()
+			tc.modify(&params)
+			env.ExecuteWorkflow(Workflow, params)
+
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+			assert.True(t, applicationErr.NonRetryable())
+			assert.ErrorContains(t, applicationErr, tc.expected.Error())
+		})
+	}
+}
+
+func TestWorkflow_PausesUnloadsAndResumesSource(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	backlogs := []Backlog{
+		{PartitionID: 0, TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW},
+		{PartitionID: 0, TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW, Fair: true},
+		{PartitionID: 1, TaskType: enumspb.TASK_QUEUE_TYPE_ACTIVITY},
+	}
+	var calls []string
+	record := func(call string) func(mock.Arguments) {
+		return func(mock.Arguments) { calls = append(calls, call) }
+	}
+	env.OnActivity(pauseActivityName, mock.Anything, mock.Anything).
+		Run(record("pause")).
+		Return([]enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW}, nil).Once()
+	env.OnActivity(listBacklogsActivityName, mock.Anything, mock.Anything).
+		Run(record("list")).Return(backlogs, nil).Once()
+	env.OnActivity(unloadActivityName, mock.Anything, "my-namespace", "source", mock.Anything).
+		Run(func(args mock.Arguments) {
+			calls = append(calls, "unload "+args.Get(3).(Backlog).TaskType.String())
+		}).Return(nil)
+	env.OnActivity(migrateTasksActivityName, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, request MigrateTasksRequest) (MigrateTasksResponse, error) {
+			calls = append(calls, "migrate "+request.Backlog.TaskType.String())
+			if len(request.NextPageToken) == 0 {
+				return MigrateTasksResponse{TasksMigrated: 2, NextPageToken: []byte{1}}, nil
+			}
+			return MigrateTasksResponse{TasksMigrated: 1}, nil
+		})
+	env.OnActivity(resumeActivityName, mock.Anything, mock.Anything, []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW}).
+		Run(record("resume")).Return(nil).Once()
+
+	env.ExecuteWorkflow(Workflow, testWorkflowParams())
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	assert.Equal(t, Progress{
+		DestinationTaskQueue: "destination",
+		BacklogsTotal:        3,
+		BacklogsDrained:      3,
+		TasksMigrated:        9,
+	}, progress)
+	assert.Equal(t, []string{
+		"pause",
+		"list",
+		// every partition is unloaded once after the pause, before any task is moved
+		"unload Workflow",
+		"unload Activity",
+		"migrate Workflow", "migrate Workflow", "unload Workflow",
+		"migrate Workflow", "migrate Workflow", "unload Workflow",
+		"migrate Activity", "migrate Activity", "unload Activity",
+		"resume",
+	}, calls)
+}
+
+func TestWorkflow_ResumesSourceOnFailure(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name         string
+		pausedTypes  []enumspb.TaskQueueType
+		expectResume bool
+	}{
+		{"paused_by_migration", []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_ACTIVITY}, true},
+		// a pause set by an operator before the migration is left in place
+		{"paused_by_operator", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			env.OnActivity(pauseActivityName, mock.Anything, mock.Anything).Return(tc.pausedTypes, nil)
+			env.OnActivity(listBacklogsActivityName, mock.Anything, mock.Anything).
+				Return([]Backlog{{TaskType: enumspb.TASK_QUEUE_TYPE_ACTIVITY}}, nil)
+			env.OnActivity(unloadActivityName, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			env.OnActivity(migrateTasksActivityName, mock.Anything, mock.Anything).Return(
+				MigrateTasksResponse{},
+				temporal.NewNonRetryableApplicationError("invalid destination", errorTypeInvalidRequest, nil),
+			)
+			resumed := false
+			env.OnActivity(resumeActivityName, mock.Anything, mock.Anything, tc.pausedTypes).
+				Run(func(mock.Arguments) { resumed = true }).Return(nil).Maybe()
+
+			env.ExecuteWorkflow(Workflow, testWorkflowParams())
+			require.ErrorContains(t, env.GetWorkflowError(), "invalid destination")
+			assert.Equal(t, tc.expectResume, resumed)
+		})
+	}
+}
+
+func TestWorkflow_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	env.OnActivity(pauseActivityName, mock.Anything, mock.Anything).Return(nil, nil)
+	env.OnActivity(listBacklogsActivityName, mock.Anything, mock.Anything).
+		Return([]Backlog{{TaskType: enumspb.TASK_QUEUE_TYPE_WORKFLOW}}, nil)
+	env.OnActivity(unloadActivityName, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(migrateTasksActivityName, mock.Anything, mock.Anything).
+		Return(MigrateTasksResponse{TasksMigrated: 50}, nil)
+
+	params := testWorkflowParams()
+	params.TasksPerSecond = 10
+	start := env.Now()
+	env.ExecuteWorkflow(Workflow, params)
+	require.NoError(t, env.GetWorkflowError())
+	assert.GreaterOrEqual(t, env.Now().Sub(start).Seconds(), 5.0)
+}
+
+func TestWorkflowID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"temporal-sys-backlog-migration-workflow/my-namespace/my-task-queue",
+		WorkflowID("my-namespace", "my-task-queue"),
+	)
+}
+
+// newTestEnv returns a test environment with all activities registered, to be mocked by each test.
+func newTestEnv() *testsuite.TestWorkflowEnvironment {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+	a := &activities{}
+	for name, fn := range map[string]any{
+		pauseActivityName:        a.pauseSource,
+		resumeActivityName:       a.resumeSource,
+		listBacklogsActivityName: a.listBacklogs,
+		migrateTasksActivityName: a.migrateTasks,
+		unloadActivityName:       a.unloadPartition,
+	} {
+		env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+	}
+	return env
+}
+
+func testWorkflowParams() WorkflowParams {
+	return WorkflowParams{
+		Namespace:            "my-namespace",
+		NamespaceID:          "my-namespace-id",
+		SourceTaskQueue:      "source",
+		DestinationTaskQueue: "destination",
+	}
+}
@@ -17,18 +17,23 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/service"
+	"go.temporal.io/server/service/worker/backlogmigration"
 	"go.temporal.io/server/service/worker/batcher"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.temporal.io/server/service/worker/deletenamespace"
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/dlq"
 	"go.temporal.io/server/service/worker/migration"
+	"go.temporal.io/server/service/worker/savedqueryalert"
 	"go.temporal.io/server/service/worker/scheduler"
+	"go.temporal.io/server/service/worker/searchattributemigration"
+	"go.temporal.io/server/service/worker/visibilityreindex"
 	"go.temporal.io/server/service/worker/workerdeployment"
 	"go.uber.org/fx"
 )
@@ -42,6 +47,10 @@ var Module = fx.Options(
 	deployment.Module, // [cleanup-wv-pre-release]
 	workerdeployment.Module,
 	dlq.Module,
+	backlogmigration.Module,
+	visibilityreindex.Module,
+	searchattributemigration.Module,
+	savedqueryalert.Module,
 	fx.Provide(
 		func(c resource.HistoryClient) dlq.HistoryClient {
 			return c
@@ -63,9 +72,13 @@ var Module = fx.Options(
 				}), nil
 			})
 		},
+		func(b client.Bean, m cluster.Metadata) (backlogmigration.AdminClient, error) {
+			return b.GetRemoteAdminClient(m.GetCurrentClusterName())
+		},
 	),
 	fx.Provide(HostInfoProvider),
 	fx.Provide(VisibilityManagerProvider),
+	fx.Provide(VisibilityReindexWriterFactoryProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
 	fx.Provide(ConfigProvider),
 	fx.Provide(PersistenceRateLimitingParamsProvider),
@@ -160,11 +173,59 @@ func VisibilityManagerProvider(
 		dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff), // worker visibility never write
 		serviceConfig.VisibilityDisableOrderByClause,
 		serviceConfig.VisibilityEnableManualPagination,
+		serviceConfig.VisibilityQueryCostLimit,
 		metricsHandler,
 		logger,
 	)
 }
 
+// VisibilityReindexWriterFactoryProvider provides the factory for the visibility manager that the visibility reindex
+// workflow writes through. Unlike the visibility manager of the worker service, it has an Elasticsearch bulk processor.
+func VisibilityReindexWriterFactoryProvider(
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+	persistenceConfig *config.Persistence,
+	customVisibilityStoreFactory visibility.VisibilityStoreFactory,
+	serviceConfig *Config,
+	dc *dynamicconfig.Collection,
+	persistenceServiceResolver resolver.ServiceResolver,
+	searchAttributesMapperProvider searchattribute.MapperProvider,
+	saProvider searchattribute.Provider,
+	namespaceRegistry namespace.Registry,
+) visibilityreindex.WriterFactory {
+	return func() (manager.VisibilityManager, error) {
+		return visibility.NewManager(
+			*persistenceConfig,
+			persistenceServiceResolver,
+			customVisibilityStoreFactory,
+			&elasticsearch.ProcessorConfig{
+				IndexerConcurrency:       dynamicconfig.WorkerIndexerConcurrency.Get(dc),
+				ESProcessorNumOfWorkers:  dynamicconfig.WorkerESProcessorNumOfWorkers.Get(dc),
+				ESProcessorBulkActions:   dynamicconfig.WorkerESProcessorBulkActions.Get(dc),
+				ESProcessorBulkSize:      dynamicconfig.WorkerESProcessorBulkSize.Get(dc),
+				ESProcessorFlushInterval: dynamicconfig.WorkerESProcessorFlushInterval.Get(dc),
+				ESProcessorAckTimeout:    dynamicconfig.WorkerESProcessorAckTimeout.Get(dc),
+			},
+			saProvider,
+			searchAttributesMapperProvider,
+			namespaceRegistry,
+			serviceConfig.VisibilityPersistenceMaxReadQPS,
+			serviceConfig.VisibilityPersistenceMaxWriteQPS,
+			serviceConfig.OperatorRPSRatio,
+			serviceConfig.VisibilityPersistenceSlowQueryThreshold,
+			serviceConfig.EnableReadFromSecondaryVisibility,
+			serviceConfig.VisibilityEnableShadowReadMode,
+			// The reindex writes to a single store directly, so the writing mode doesn't matter.
+			dynamicconfig.GetStringPropertyFn(visibility.SecondaryVisibilityWritingModeOff),
+			serviceConfig.VisibilityDisableOrderByClause,
+			serviceConfig.VisibilityEnableManualPagination,
+			serviceConfig.VisibilityQueryCostLimit,
+			metricsHandler,
+			logger,
+		)
+	}
+}
+
 func ServiceLifetimeHooks(lc fx.Lifecycle, svc *Service) {
 	lc.Append(fx.StartStopHook(svc.Start, svc.Stop))
 }
@@ -0,0 +1,141 @@
+package savedqueryalert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/savedquery"
+)
+
+const errorTypeInvalidQuery = "saved-query-alert-error-type-invalid-query"
+
+type activities struct {
+	savedQueryManager savedquery.Manager
+	namespaceRegistry namespace.Registry
+	frontendClient    workflowservice.WorkflowServiceClient
+	httpClient        *http.Client
+	logger            log.Logger
+}
+
+// evaluate counts the executions matching the saved query. The query is reloaded every time, so that the workflow
+// always evaluates the latest version of the saved query.
+func (a *activities) evaluate(ctx context.Context, request EvaluateRequest) (EvaluateResponse, error) {
+	query, nsName, err := a.getQuery(ctx, request.NamespaceID, request.Name)
+	if err != nil || query.GetAlert() == nil {
+		return EvaluateResponse{}, err
+	}
+
+	resp, err := a.frontendClient.CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: nsName.String(),
+		Query:     query.GetQuery(),
+	})
+	if err != nil {
+		var invalidArgumentErr *serviceerror.InvalidArgument
+		if errors.As(err, &invalidArgumentErr) {
+			// The query became invalid, e.g. because a search attribute was renamed. Retrying won't help, the alert
+			// is restarted when the saved query is fixed.
+			return EvaluateResponse{}, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidQuery, err)
+		}
+		return EvaluateResponse{}, err
+	}
+
+	alert := query.GetAlert()
+	return EvaluateResponse{
+		Found:              true,
+		Namespace:          nsName.String(),
+		Count:              resp.GetCount(),
+		Threshold:          alert.GetThreshold(),
+		FireBelowThreshold: alert.GetFireBelowThreshold(),
+		Interval:           alert.GetInterval().AsDuration(),
+	}, nil
+}
+
+// notify posts a Notification to the webhook of the alert. The webhook settings are not part of the request, so that
+// secrets in the webhook headers don't end up in the workflow history.
+func (a *activities) notify(ctx context.Context, request NotifyRequest) error {
+	query, _, err := a.getQuery(ctx, request.NamespaceID, request.Name)
+	if err != nil {
+		return err
+	}
+	alert := query.GetAlert()
+	if alert == nil {
+		// The alert was removed since the evaluation.
+		return nil
+	}
+
+	state := StateResolved
+	if request.Firing {
+		state = StateFiring
+	}
+	body, err := json.Marshal(Notification{
+		Namespace: request.Namespace,
+		Name:      request.Name,
+		Query:     query.GetQuery(),
+		Count:     request.Count,
+		Threshold: alert.GetThreshold(),
+		State:     state,
+		Time:      request.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, alert.GetWebhookUrl(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	for key, value := range alert.GetWebhookHeaders() {
+		httpRequest.Header.Set(key, value)
+	}
+	httpResponse, err := a.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	_ = httpResponse.Body.Close()
+	if httpResponse.StatusCode < 200 || httpResponse.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s returned status %s", alert.GetWebhookUrl(), httpResponse.Status)
+	}
+
+	a.logger.Info("Notified saved query alert webhook.",
+		tag.WorkflowNamespace(request.Namespace),
+		tag.NewStringTag("saved-query", request.Name),
+		tag.NewStringTag("state", state),
+		tag.NewInt64("count", request.Count),
+	)
+	return nil
+}
+
+// getQuery returns a nil query if the saved query or its namespace doesn't exist anymore.
+func (a *activities) getQuery(
+	ctx context.Context,
+	namespaceID string,
+	name string,
+) (*persistencespb.SavedVisibilityQuery, namespace.Name, error) {
+	var nsNotFoundErr *serviceerror.NamespaceNotFound
+	nsName, err := a.namespaceRegistry.GetNamespaceName(namespace.ID(namespaceID))
+	if errors.As(err, &nsNotFoundErr) {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+	var notFoundErr *serviceerror.NotFound
+	query, err := a.savedQueryManager.GetQuery(ctx, namespace.ID(namespaceID), name)
+	if errors.As(err, &notFoundErr) {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+	return query, nsName, nil
+}
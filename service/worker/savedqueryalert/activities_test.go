@@ -0,0 +1,254 @@
+package savedqueryalert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/savedquery"
+	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const testQuery = "WorkflowType = 'PaymentWorkflow' AND ExecutionStatus = 'Failed'"
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		// modify changes the default saved query, which has an alert
+		modify           func(query *persistencespb.SavedVisibilityQuery)
+		namespaceDeleted bool
+		queryDeleted     bool
+		countErr         error
+		expected         EvaluateResponse
+		// expectedErrType is the type of the application error returned by evaluate, if any
+		expectedErrType string
+	}{
+		{
+			name: "found",
+			modify: func(query *persistencespb.SavedVisibilityQuery) {
+				query.Alert.FireBelowThreshold = true
+			},
+			expected: EvaluateResponse{
+				Found:              true,
+				Namespace:          "my-namespace",
+				Count:              42,
+				Threshold:          10,
+				FireBelowThreshold: true,
+				Interval:           time.Minute,
+			},
+		},
+		{
+			name:   "alert_removed",
+			modify: func(query *persistencespb.SavedVisibilityQuery) { query.Alert = nil },
+		},
+		{
+			name:         "query_deleted",
+			queryDeleted: true,
+		},
+		{
+			name:             "namespace_deleted",
+			namespaceDeleted: true,
+		},
+		{
+			// e.g. a search attribute of the query was renamed
+			name:            "invalid_query",
+			countErr:        serviceerror.NewInvalidArgument("unknown search attribute"),
+			expectedErrType: errorTypeInvalidQuery,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			query := newTestQuery("")
+			if tc.modify != nil {
+				tc.modify(query)
+			}
+			a := &activities{
+				savedQueryManager: newTestSavedQueryManager(ctrl, query, tc.queryDeleted),
+				namespaceRegistry: newTestRegistry(ctrl, tc.namespaceDeleted),
+			}
+			frontendClient := workflowservicemock.NewMockWorkflowServiceClient(ctrl)
+			frontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &workflowservice.CountWorkflowExecutionsRequest{
+				Namespace: "my-namespace",
+				Query:     testQuery,
+			}).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 42}, tc.countErr).MaxTimes(1)
+			a.frontendClient = frontendClient
+
+			resp, err := a.evaluate(context.Background(), EvaluateRequest{NamespaceID: "my-namespace-id", Name: "failed-payments"})
+			if tc.expectedErrType != "" {
+				var applicationErr *temporal.ApplicationError
+				require.ErrorAs(t, err, &applicationErr)
+				assert.True(t, applicationErr.NonRetryable())
+				assert.Equal(t, tc.expectedErrType, applicationErr.Type())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resp)
+		})
+	}
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	notificationTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name          string
+		firing        bool
+		alertRemoved  bool
+		webhookStatus int
+		// expected is the notification received by the webhook, nil if there is none
+		expected  *Notification
+		expectErr bool
+	}{
+		{
+			name:          "firing",
+			firing:        true,
+			webhookStatus: http.StatusOK,
+			expected: &Notification{
+				Namespace: "my-namespace",
+				Name:      "failed-payments",
+				Query:     testQuery,
+				Count:     20,
+				Threshold: 10,
+				State:     StateFiring,
+				Time:      notificationTime,
+			},
+		},
+		{
+			name:          "resolved",
+			webhookStatus: http.StatusNoContent,
+			expected: &Notification{
+				Namespace: "my-namespace",
+				Name:      "failed-payments",
+				Query:     testQuery,
+				Count:     20,
+				Threshold: 10,
+				State:     StateResolved,
+				Time:      notificationTime,
+			},
+		},
+		{
+			name:          "webhook_failed",
+			firing:        true,
+			webhookStatus: http.StatusInternalServerError,
+			expected: &Notification{
+				Namespace: "my-namespace",
+				Name:      "failed-payments",
+				Query:     testQuery,
+				Count:     20,
+				Threshold: 10,
+				State:     StateFiring,
+				Time:      notificationTime,
+			},
+			expectErr: true,
+		},
+		{
+			name:         "alert_removed",
+			firing:       true,
+			alertRemoved: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var notifications []Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				var notification Notification
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+				notifications = append(notifications, notification)
+				w.WriteHeader(tc.webhookStatus)
+			}))
+			defer server.Close()
+
+			ctrl := gomock.NewController(t)
+			query := newTestQuery(server.URL)
+			if tc.alertRemoved {
+				query.Alert = nil
+			}
+			a := &activities{
+				savedQueryManager: newTestSavedQueryManager(ctrl, query, false),
+				namespaceRegistry: newTestRegistry(ctrl, false),
+				httpClient:        server.Client(),
+				logger:            log.NewNoopLogger(),
+			}
+
+			err := a.notify(context.Background(), NotifyRequest{
+				NamespaceID: "my-namespace-id",
+				Namespace:   "my-namespace",
+				Name:        "failed-payments",
+				Count:       20,
+				Firing:      tc.firing,
+				Time:        notificationTime,
+			})
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expected == nil {
+				assert.Empty(t, notifications)
+				return
+			}
+			assert.Equal(t, []Notification{*tc.expected}, notifications)
+		})
+	}
+}
+
+func newTestQuery(webhookURL string) *persistencespb.SavedVisibilityQuery {
+	return &persistencespb.SavedVisibilityQuery{
+		Name:  "failed-payments",
+		Query: testQuery,
+		Alert: &persistencespb.SavedVisibilityQueryAlert{
+			Threshold:      10,
+			Interval:       durationpb.New(time.Minute),
+			WebhookUrl:     webhookURL,
+			WebhookHeaders: map[string]string{"Authorization": "Bearer secret"},
+		},
+	}
+}
+
+func newTestSavedQueryManager(
+	ctrl *gomock.Controller,
+	query *persistencespb.SavedVisibilityQuery,
+	deleted bool,
+) savedquery.Manager {
+	manager := savedquery.NewMockManager(ctrl)
+	call := manager.EXPECT().GetQuery(gomock.Any(), namespace.ID("my-namespace-id"), "failed-payments").AnyTimes()
+	if deleted {
+		call.Return(nil, serviceerror.NewNotFound("saved query not found"))
+	} else {
+		call.Return(query, nil)
+	}
+	return manager
+}
+
+func newTestRegistry(ctrl *gomock.Controller, deleted bool) namespace.Registry {
+	registry := namespace.NewMockRegistry(ctrl)
+	call := registry.EXPECT().GetNamespaceName(namespace.ID("my-namespace-id")).AnyTimes()
+	if deleted {
+		call.Return(namespace.EmptyName, serviceerror.NewNamespaceNotFound("my-namespace-id"))
+	} else {
+		call.Return(namespace.Name("my-namespace"), nil)
+	}
+	return registry
+}
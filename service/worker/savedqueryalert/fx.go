@@ -0,0 +1,74 @@
+package savedqueryalert
+
+import (
+	"net/http"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/savedquery"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	componentParams struct {
+		fx.In
+		ClusterMetadataManager persistence.ClusterMetadataManager
+		TimeSource             clock.TimeSource
+		NamespaceRegistry      namespace.Registry
+		FrontendClient         workflowservice.WorkflowServiceClient
+		Logger                 log.Logger
+	}
+
+	component struct {
+		activities *activities
+	}
+)
+
+// webhookTimeout bounds a single webhook request.
+const webhookTimeout = 10 * time.Second
+
+// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph.
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &component{
+		activities: &activities{
+			savedQueryManager: savedquery.NewManager(params.TimeSource, params.ClusterMetadataManager),
+			namespaceRegistry: params.NamespaceRegistry,
+			frontendClient:    params.FrontendClient,
+			httpClient:        &http.Client{Timeout: webhookTimeout},
+			logger:            params.Logger,
+		},
+	}
+}
+
+func (c *component) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (c *component) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (c *component) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivityWithOptions(c.activities.evaluate, activity.RegisterOptions{
+		Name: evaluateActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.notify, activity.RegisterOptions{
+		Name: notifyActivityName,
+	})
+}
+
+func (c *component) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
@@ -0,0 +1,226 @@
+// Package savedqueryalert contains the system workflow that evaluates the alert of a saved visibility query. There is
+// one workflow per saved query with an alert. It periodically counts the executions matching the query and notifies
+// the webhook of the alert whenever the count crosses the threshold in either direction.
+package savedqueryalert
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// WorkflowParams is the single argument to the saved query alert workflow.
+	WorkflowParams struct {
+		NamespaceID string
+		// Name is the name of the saved query.
+		Name string
+
+		// Firing carries the state of the alert over ContinueAsNew.
+		Firing bool
+	}
+
+	// State is the response to QueryTypeState.
+	State struct {
+		Firing bool
+		// Count is the result of the last evaluation.
+		Count              int64
+		LastEvaluationTime time.Time
+	}
+
+	// EvaluateRequest is the argument of the activity that counts the executions matching the saved query.
+	EvaluateRequest struct {
+		NamespaceID string
+		Name        string
+	}
+
+	// EvaluateResponse is the result of the activity that counts the executions matching the saved query.
+	EvaluateResponse struct {
+		// Found is false if the saved query, its alert or its namespace doesn't exist anymore.
+		Found              bool
+		Namespace          string
+		Count              int64
+		Threshold          int64
+		FireBelowThreshold bool
+		Interval           time.Duration
+	}
+
+	// NotifyRequest is the argument of the activity that calls the webhook of the alert.
+	NotifyRequest struct {
+		NamespaceID string
+		Namespace   string
+		Name        string
+		Count       int64
+		Firing      bool
+		Time        time.Time
+	}
+
+	// Notification is the JSON body posted to the webhook of the alert.
+	Notification struct {
+		Namespace string    `json:"namespace"`
+		Name      string    `json:"name"`
+		Query     string    `json:"query"`
+		Count     int64     `json:"count"`
+		Threshold int64     `json:"threshold"`
+		State     string    `json:"state"`
+		Time      time.Time `json:"time"`
+	}
+)
+
+const (
+	// WorkflowName is the name of the saved query alert workflow.
+	WorkflowName = "temporal-sys-saved-query-alert-workflow"
+	// SignalNameRefresh makes the workflow evaluate the alert right away. It is sent whenever the saved query is
+	// changed or deleted, so that the workflow doesn't keep using an outdated interval.
+	SignalNameRefresh = "refresh"
+	// QueryTypeState is the query to get the State of the workflow.
+	QueryTypeState = "saved-query-alert-state-query"
+
+	StateFiring   = "firing"
+	StateResolved = "resolved"
+
+	errorTypeInvalidRequest = "saved-query-alert-error-type-invalid-request"
+	evaluateActivityName    = "saved-query-alert-evaluate-activity"
+	notifyActivityName      = "saved-query-alert-notify-activity"
+
+	activityTimeout = 30 * time.Second * debug.TimeoutMultiplier
+	// notifyMaxAttempts limits the retries of a failing webhook. The notification is sent again on the next
+	// evaluation if the alert is still in the same state.
+	notifyMaxAttempts = 5
+)
+
+var (
+	ErrNamespaceIDNotSet = errors.New("NamespaceID is not set")
+	ErrNameNotSet        = errors.New("Name is not set")
+
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+	}
+)
+
+// WorkflowID returns the ID of the alert workflow of a saved query. Using a fixed ID per saved query guarantees that
+// every alert is evaluated by a single workflow.
+func WorkflowID(namespaceID string, name string) string {
+	return fmt.Sprintf("%s-%s-%s", WorkflowName, namespaceID, name)
+}
+
+// Workflow evaluates the alert of a saved query every interval until the query, its alert or its namespace is
+// deleted. The settings of the alert are read from cluster metadata on every evaluation, so changes to the saved query
+// are picked up without restarting the workflow.
+func Workflow(ctx workflow.Context, params WorkflowParams) error {
+	logger := workflow.GetLogger(ctx)
+	if err := validateParams(params); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+	state := State{Firing: params.Firing}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeState, func() (State, error) {
+		return state, nil
+	}); err != nil {
+		return err
+	}
+
+	evaluateCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: activityTimeout,
+		RetryPolicy:         activityRetryPolicy,
+	})
+	notifyRetryPolicy := *activityRetryPolicy
+	notifyRetryPolicy.MaximumAttempts = notifyMaxAttempts
+	notifyCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: activityTimeout,
+		RetryPolicy:         &notifyRetryPolicy,
+	})
+	refreshCh := workflow.GetSignalChannel(ctx, SignalNameRefresh)
+
+	for {
+		var response EvaluateResponse
+		request := EvaluateRequest{NamespaceID: params.NamespaceID, Name: params.Name}
+		if err := workflow.ExecuteActivity(evaluateCtx, evaluateActivityName, request).Get(ctx, &response); err != nil {
+			return err
+		}
+		if !response.Found {
+			logger.Info("Saved query alert removed, stopping evaluation.",
+				tag.WorkflowNamespaceID(params.NamespaceID),
+				tag.NewStringTag("saved-query", params.Name),
+			)
+			return nil
+		}
+
+		now := workflow.Now(ctx)
+		firing := isFiring(response)
+		state.Count = response.Count
+		state.LastEvaluationTime = now
+		if firing != state.Firing {
+			err := workflow.ExecuteActivity(notifyCtx, notifyActivityName, NotifyRequest{
+				NamespaceID: params.NamespaceID,
+				Namespace:   response.Namespace,
+				Name:        params.Name,
+				Count:       response.Count,
+				Firing:      firing,
+				Time:        now,
+			}).Get(ctx, nil)
+			if err != nil {
+				// Keep the previous state, so that the notification is sent again on the next evaluation.
+				logger.Warn("Unable to notify saved query alert webhook.",
+					tag.WorkflowNamespace(response.Namespace),
+					tag.NewStringTag("saved-query", params.Name),
+					tag.Error(err),
+				)
+			} else {
+				state.Firing = firing
+			}
+		}
+
+		if err := waitForNextEvaluation(ctx, refreshCh, response.Interval); err != nil {
+			return err
+		}
+		if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			params.Firing = state.Firing
+			return workflow.NewContinueAsNewError(ctx, WorkflowName, params)
+		}
+	}
+}
+
+// waitForNextEvaluation waits for the interval of the alert or until the refresh signal is received, whichever comes
+// first.
+func waitForNextEvaluation(ctx workflow.Context, refreshCh workflow.ReceiveChannel, interval time.Duration) error {
+	timerCtx, cancelTimer := workflow.WithCancel(ctx)
+	defer cancelTimer()
+
+	var timerErr error
+	selector := workflow.NewSelector(ctx)
+	selector.AddFuture(workflow.NewTimer(timerCtx, interval), func(f workflow.Future) {
+		timerErr = f.Get(timerCtx, nil)
+	})
+	selector.AddReceive(refreshCh, func(c workflow.ReceiveChannel, _ bool) {
+		c.Receive(ctx, nil)
+	})
+	selector.Select(ctx)
+	// Drain signals that arrived in the meantime, one evaluation covers all of them.
+	for refreshCh.ReceiveAsync(nil) {
+	}
+	return timerErr
+}
+
+func isFiring(response EvaluateResponse) bool {
+	if response.FireBelowThreshold {
+		return response.Count < response.Threshold
+	}
+	return response.Count > response.Threshold
+}
+
+func validateParams(params WorkflowParams) error {
+	switch {
+	case params.NamespaceID == "":
+		return ErrNamespaceIDNotSet
+	case params.Name == "":
+		return ErrNameNotSet
+	}
+	return nil
+}
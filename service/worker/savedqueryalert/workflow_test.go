@@ -0,0 +1,216 @@
+package savedqueryalert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestWorkflowID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"temporal-sys-saved-query-alert-workflow-my-namespace-id-failed-payments",
+		WorkflowID("my-namespace-id", "failed-payments"),
+	)
+}
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		params   WorkflowParams
+		expected error
+	}{
+		{"namespace_id_not_set", WorkflowParams{Name: "failed-payments"}, ErrNamespaceIDNotSet},
+		{"name_not_set", WorkflowParams{NamespaceID: "my-namespace-id"}, ErrNameNotSet},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			env.ExecuteWorkflow(Workflow, tc.params)
+
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+			assert.True(t, applicationErr.NonRetryable())
+			assert.ErrorContains(t, applicationErr, tc.expected.Error())
+		})
+	}
+}
+
+func TestWorkflow_Notify(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name               string
+		firing             bool
+		fireBelowThreshold bool
+		// counts are returned by one evaluation after the other, the alert is removed afterwards
+		counts   []int64
+		expected []NotifyRequest
+	}{
+		{
+			name:   "fire_and_resolve",
+			counts: []int64{5, 20, 30, 3, 3},
+			expected: []NotifyRequest{
+				{Count: 20, Firing: true},
+				{Count: 3, Firing: false},
+			},
+		},
+		{
+			name:               "fire_below_threshold",
+			fireBelowThreshold: true,
+			counts:             []int64{20, 5, 5},
+			expected:           []NotifyRequest{{Count: 5, Firing: true}},
+		},
+		{
+			// the state is carried over ContinueAsNew
+			name:     "firing_after_continue_as_new",
+			firing:   true,
+			counts:   []int64{20, 3, 3},
+			expected: []NotifyRequest{{Count: 3, Firing: false}},
+		},
+		{
+			name:   "alert_removed",
+			counts: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			evaluations := 0
+			env.OnActivity(evaluateActivityName, mock.Anything, mock.Anything).
+				Return(func(_ context.Context, request EvaluateRequest) (EvaluateResponse, error) {
+					assert.Equal(t, EvaluateRequest{NamespaceID: "my-namespace-id", Name: "failed-payments"}, request)
+					if evaluations == len(tc.counts) {
+						return EvaluateResponse{}, nil
+					}
+					evaluations++
+					return EvaluateResponse{
+						Found:              true,
+						Namespace:          "my-namespace",
+						Count:              tc.counts[evaluations-1],
+						Threshold:          10,
+						FireBelowThreshold: tc.fireBelowThreshold,
+						Interval:           time.Minute,
+					}, nil
+				})
+			var notifications []NotifyRequest
+			env.OnActivity(notifyActivityName, mock.Anything, mock.Anything).
+				Return(func(_ context.Context, request NotifyRequest) error {
+					assert.Equal(t, "my-namespace-id", request.NamespaceID)
+					assert.Equal(t, "my-namespace", request.Namespace)
+					assert.Equal(t, "failed-payments", request.Name)
+					assert.False(t, request.Time.IsZero())
+					notifications = append(notifications, NotifyRequest{Count: request.Count, Firing: request.Firing})
+					return nil
+				}).Maybe()
+
+			params := testWorkflowParams()
+			params.Firing = tc.firing
+			env.ExecuteWorkflow(Workflow, params)
+			require.NoError(t, env.GetWorkflowError())
+			assert.Equal(t, tc.expected, notifications)
+		})
+	}
+}
+
+func TestWorkflow_NotifyFailed(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	evaluations := 0
+	env.OnActivity(evaluateActivityName, mock.Anything, mock.Anything).
+		Return(func(context.Context, EvaluateRequest) (EvaluateResponse, error) {
+			evaluations++
+			return EvaluateResponse{Found: evaluations <= 2, Count: 20, Threshold: 10, Interval: time.Minute}, nil
+		})
+	attempts := 0
+	env.OnActivity(notifyActivityName, mock.Anything, mock.Anything).
+		Return(func(context.Context, NotifyRequest) error {
+			attempts++
+			return errors.New("webhook returned status 500 Internal Server Error")
+		})
+
+	env.ExecuteWorkflow(Workflow, testWorkflowParams())
+	require.NoError(t, env.GetWorkflowError())
+	// the state doesn't change while the webhook fails, so every evaluation tries to notify again
+	assert.Equal(t, 2*notifyMaxAttempts, attempts)
+}
+
+func TestWorkflow_Refresh(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	var evaluationTimes []time.Time
+	env.OnActivity(evaluateActivityName, mock.Anything, mock.Anything).
+		Return(func(context.Context, EvaluateRequest) (EvaluateResponse, error) {
+			evaluationTimes = append(evaluationTimes, env.Now())
+			return EvaluateResponse{Found: len(evaluationTimes) < 3, Count: 3, Threshold: 10, Interval: time.Hour}, nil
+		})
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(SignalNameRefresh, nil)
+	}, time.Minute)
+
+	env.ExecuteWorkflow(Workflow, testWorkflowParams())
+	require.NoError(t, env.GetWorkflowError())
+	require.Len(t, evaluationTimes, 3)
+	assert.Equal(t, time.Minute, evaluationTimes[1].Sub(evaluationTimes[0]).Round(time.Second))
+	assert.Equal(t, time.Hour, evaluationTimes[2].Sub(evaluationTimes[1]).Round(time.Second))
+}
+
+func TestWorkflow_State(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	evaluations := 0
+	env.OnActivity(evaluateActivityName, mock.Anything, mock.Anything).
+		Return(func(context.Context, EvaluateRequest) (EvaluateResponse, error) {
+			evaluations++
+			return EvaluateResponse{Found: evaluations == 1, Count: 20, Threshold: 10, Interval: time.Minute}, nil
+		})
+	env.OnActivity(notifyActivityName, mock.Anything, mock.Anything).Return(nil)
+	var state State
+	env.RegisterDelayedCallback(func() {
+		resp, err := env.QueryWorkflow(QueryTypeState)
+		require.NoError(t, err)
+		require.NoError(t, resp.Get(&state))
+	}, time.Second)
+
+	start := env.Now()
+	env.ExecuteWorkflow(Workflow, testWorkflowParams())
+	require.NoError(t, env.GetWorkflowError())
+	assert.True(t, state.Firing)
+	assert.EqualValues(t, 20, state.Count)
+	assert.WithinDuration(t, start, state.LastEvaluationTime, time.Second)
+}
+
+// newTestEnv returns a test environment with all activities registered, to be mocked by each test.
+func newTestEnv() *testsuite.TestWorkflowEnvironment {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+	a := &activities{}
+	for name, fn := range map[string]any{
+		evaluateActivityName: a.evaluate,
+		notifyActivityName:   a.notify,
+	} {
+		env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+	}
+	return env
+}
+
+func testWorkflowParams() WorkflowParams {
+	return WorkflowParams{NamespaceID: "my-namespace-id", Name: "failed-payments"}
+}
@@ -15,6 +15,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
@@ -40,6 +41,8 @@ type (
 		perShardQPS                   dynamicconfig.IntPropertyFn
 		executionDataDurationBuffer   dynamicconfig.DurationPropertyFn
 		enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn
+		visibilityManager             manager.VisibilityManager
+		visibilityValidatorConfig     VisibilityValidatorConfig
 		metricsHandler                metrics.Handler
 		logger                        log.Logger
 
@@ -53,6 +56,8 @@ type (
 // returned object. Calling the Start() method will result in one
 // complete iteration over all of the open workflow executions in the system. For
 // each executions, will attempt to validate the workflow execution and emit metrics/logs on validation failures.
+// If enabled, the visibility record of the execution is compared with its mutable state and
+// optionally repaired by regenerating the tasks of the execution.
 //
 // The scavenger will retry on all persistence errors infinitely and will only stop under
 // two conditions
@@ -66,7 +71,9 @@ func NewScavenger(
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	executionTaskWorker dynamicconfig.IntPropertyFn,
 	enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn,
+	visibilityValidatorConfig VisibilityValidatorConfig,
 	executionManager persistence.ExecutionManager,
+	visibilityManager manager.VisibilityManager,
 	registry namespace.Registry,
 	historyClient historyservice.HistoryServiceClient,
 	adminClient adminservice.AdminServiceClient,
@@ -92,6 +99,8 @@ func NewScavenger(
 		perShardQPS:                   perShardQPS,
 		executionDataDurationBuffer:   executionDataDurationBuffer,
 		enableHistoryEventIDValidator: enableHistoryEventIDValidator,
+		visibilityManager:             visibilityManager,
+		visibilityValidatorConfig:     visibilityValidatorConfig,
 		metricsHandler:                metricsHandler.WithTags(metrics.OperationTag(metrics.ExecutionsScavengerScope)),
 		logger:                        logger,
 
@@ -164,6 +173,8 @@ func (s *Scavenger) run() {
 			}),
 			s.executionDataDurationBuffer,
 			s.enableHistoryEventIDValidator,
+			s.visibilityManager,
+			s.visibilityValidatorConfig,
 		))
 		if !submitted {
 			s.logger.Error("unable to submit task to executor", tag.ShardID(shardID))
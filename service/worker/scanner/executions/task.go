@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/worker/scanner/executor"
 )
@@ -45,6 +46,8 @@ type (
 		rateLimiter                   quotas.RateLimiter
 		executionDataDurationBuffer   dynamicconfig.DurationPropertyFn
 		enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn
+		visibilityManager             manager.VisibilityManager
+		visibilityValidatorConfig     VisibilityValidatorConfig
 		paginationToken               []byte
 	}
 )
@@ -63,6 +66,8 @@ func newTask(
 	rateLimiter quotas.RateLimiter,
 	executionDataDurationBuffer dynamicconfig.DurationPropertyFn,
 	enableHistoryEventIDValidator dynamicconfig.BoolPropertyFn,
+	visibilityManager manager.VisibilityManager,
+	visibilityValidatorConfig VisibilityValidatorConfig,
 ) executor.Task {
 	return &task{
 		shardID:          shardID,
@@ -79,6 +84,8 @@ func newTask(
 		rateLimiter:                   rateLimiter,
 		executionDataDurationBuffer:   executionDataDurationBuffer,
 		enableHistoryEventIDValidator: enableHistoryEventIDValidator,
+		visibilityManager:             visibilityManager,
+		visibilityValidatorConfig:     visibilityValidatorConfig,
 	}
 }
 
@@ -181,6 +188,30 @@ func (t *task) validate(
 		}
 	}
 
+	// Visibility is only checked for executions that are otherwise valid.
+	if len(results) > 0 {
+		return results
+	}
+
+	if t.visibilityValidatorConfig.Enabled() {
+		if validationResults, err := NewVisibilityValidator(
+			t.registry,
+			t.visibilityManager,
+			t.visibilityValidatorConfig.SampleRate,
+			t.visibilityValidatorConfig.MinAge,
+		).Validate(t.ctx, mutableState); err != nil {
+			t.logger.Error("unable to validate visibility record",
+				tag.ShardID(t.shardID),
+				tag.WorkflowNamespaceID(mutableState.GetExecutionInfo().GetNamespaceId()),
+				tag.WorkflowID(mutableState.GetExecutionInfo().GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionState().GetRunId()),
+				tag.Error(err),
+			)
+		} else {
+			results = append(results, validationResults...)
+		}
+	}
+
 	return results
 }
 
@@ -205,7 +236,18 @@ func (t *task) handleFailures(
 	mutableState *MutableState,
 	results []MutableStateValidationResult,
 ) error {
+	var visibilityRepaired bool
 	for _, failure := range results {
+		if isVisibilityFailure(failure.failureType) {
+			if visibilityRepaired || !t.visibilityValidatorConfig.Repair() {
+				continue
+			}
+			if err := t.repairVisibility(mutableState); err != nil {
+				return err
+			}
+			visibilityRepaired = true
+			continue
+		}
 		switch failure.failureType {
 		case mutableStateRetentionFailureType:
 			executionInfo := mutableState.GetExecutionInfo()
@@ -247,6 +289,32 @@ func (t *task) handleFailures(
 	return nil
 }
 
+// repairVisibility regenerates the tasks of an execution, which includes the visibility task that
+// writes its current state to visibility.
+func (t *task) repairVisibility(
+	mutableState *MutableState,
+) error {
+	executionInfo := mutableState.GetExecutionInfo()
+	_, err := t.adminClient.RefreshWorkflowTasks(t.ctx, &adminservice.RefreshWorkflowTasksRequest{
+		NamespaceId: executionInfo.GetNamespaceId(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      mutableState.GetExecutionState().GetRunId(),
+		},
+	})
+	switch err.(type) {
+	case *serviceerror.NotFound,
+		*serviceerror.NamespaceNotFound:
+		// The execution was deleted in the meantime.
+		return nil
+	case nil:
+		metrics.ScavengerVisibilityRepairsCount.With(t.metricsHandler).Record(1)
+		return nil
+	default:
+		return err
+	}
+}
+
 func printValidationResult(
 	mutableState *MutableState,
 	results []MutableStateValidationResult,
@@ -0,0 +1,170 @@
+package executions
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+const (
+	visibilityMissingFailureType          = "visibility_validator_missing"
+	visibilityStatusFailureType           = "visibility_validator_status"
+	visibilityCloseTimeFailureType        = "visibility_validator_close_time"
+	visibilitySearchAttributesFailureType = "visibility_validator_search_attributes"
+)
+
+type (
+	// VisibilityValidatorConfig configures the visibility validator of the executions scavenger.
+	VisibilityValidatorConfig struct {
+		// Enabled turns on the validation of visibility records.
+		Enabled dynamicconfig.BoolPropertyFn
+		// SampleRate is the fraction of executions whose visibility record is validated, 1 validates all of them.
+		SampleRate dynamicconfig.FloatPropertyFn
+		// MinAge is the time since the last update of an execution before its visibility record is validated.
+		MinAge dynamicconfig.DurationPropertyFn
+		// Repair turns on regenerating the tasks of executions whose visibility record doesn't match.
+		Repair dynamicconfig.BoolPropertyFn
+	}
+
+	// visibilityValidator is a validator that checks that the visibility record of an execution
+	// agrees with its mutable state on
+	// * existence
+	// * status
+	// * close time
+	// * search attribute names
+	visibilityValidator struct {
+		registry          namespace.Registry
+		visibilityManager manager.VisibilityManager
+		sampleRate        dynamicconfig.FloatPropertyFn
+		minAge            dynamicconfig.DurationPropertyFn
+	}
+)
+
+var _ Validator = (*visibilityValidator)(nil)
+
+// NewVisibilityValidator returns new instance.
+func NewVisibilityValidator(
+	registry namespace.Registry,
+	visibilityManager manager.VisibilityManager,
+	sampleRate dynamicconfig.FloatPropertyFn,
+	minAge dynamicconfig.DurationPropertyFn,
+) *visibilityValidator {
+	return &visibilityValidator{
+		registry:          registry,
+		visibilityManager: visibilityManager,
+		sampleRate:        sampleRate,
+		minAge:            minAge,
+	}
+}
+
+// Validate compares the visibility record of a sample of executions with their mutable state.
+// Executions updated less than minAge ago are skipped, since the visibility queue may not have
+// processed their latest update yet.
+func (v *visibilityValidator) Validate(
+	ctx context.Context,
+	mutableState *MutableState,
+) ([]MutableStateValidationResult, error) {
+	executionInfo := mutableState.GetExecutionInfo()
+	executionState := mutableState.GetExecutionState()
+
+	if !visibility.HasRecord(executionState.GetState()) {
+		return nil, nil
+	}
+	if rand.Float64() >= v.sampleRate() {
+		return nil, nil
+	}
+	if time.Since(timestamp.TimeValue(executionInfo.GetLastUpdateTime())) < v.minAge() {
+		return nil, nil
+	}
+
+	ns, err := v.registry.GetNamespaceByID(namespace.ID(executionInfo.GetNamespaceId()))
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound, *serviceerror.NamespaceNotFound:
+		// The namespace is being deleted, its executions are cleaned up separately.
+		return nil, nil
+	default:
+		return nil, err
+	}
+
+	resp, err := v.visibilityManager.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		WorkflowID:  executionInfo.GetWorkflowId(),
+		RunID:       executionState.GetRunId(),
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return []MutableStateValidationResult{{
+			failureType:    visibilityMissingFailureType,
+			failureDetails: "Visibility record not found",
+		}}, nil
+	default:
+		return nil, err
+	}
+	record := resp.Execution
+
+	var results []MutableStateValidationResult
+	if record.GetStatus() != executionState.GetStatus() {
+		results = append(results, MutableStateValidationResult{
+			failureType: visibilityStatusFailureType,
+			failureDetails: fmt.Sprintf(
+				"Visibility status: %v is not equal to mutable state status: %v",
+				record.GetStatus(),
+				executionState.GetStatus(),
+			),
+		})
+	}
+
+	if closeTime := executionInfo.GetCloseTime(); closeTime != nil {
+		// Elasticsearch only stores timestamps with millisecond precision.
+		recordCloseTime := timestamp.TimeValue(record.GetCloseTime()).Truncate(time.Millisecond)
+		if !recordCloseTime.Equal(closeTime.AsTime().Truncate(time.Millisecond)) {
+			results = append(results, MutableStateValidationResult{
+				failureType: visibilityCloseTimeFailureType,
+				failureDetails: fmt.Sprintf(
+					"Visibility close time: %v is not equal to mutable state close time: %v",
+					recordCloseTime,
+					closeTime.AsTime(),
+				),
+			})
+		}
+	}
+
+	// The search attributes of closed executions are removed from mutable state once they were written to
+	// visibility, so this only checks the ones that are still there. Values are not compared because the
+	// stores don't return them with the encoding they were written with.
+	for name := range executionInfo.GetSearchAttributes() {
+		if _, ok := record.GetSearchAttributes().GetIndexedFields()[name]; !ok {
+			results = append(results, MutableStateValidationResult{
+				failureType:    visibilitySearchAttributesFailureType,
+				failureDetails: fmt.Sprintf("Search attribute %s is missing in visibility", name),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// isVisibilityFailure returns true if the failure can be repaired by regenerating the visibility
+// tasks of the execution.
+func isVisibilityFailure(failureType string) bool {
+	switch failureType {
+	case visibilityMissingFailureType,
+		visibilityStatusFailureType,
+		visibilityCloseTimeFailureType,
+		visibilitySearchAttributesFailureType:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,253 @@
+package executions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testNamespace = namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "my-namespace-id", Name: "my-namespace"}, nil, "",
+	)
+	testCloseTime = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestVisibilityValidator(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		// modify changes the default mutable state, which is a closed execution with a search attribute
+		modify func(ms *persistencespb.WorkflowMutableState)
+		// record is the visibility record of the execution, nil if there is none
+		record *workflowpb.WorkflowExecutionInfo
+		// expected are the failure types of the results
+		expected []string
+	}{
+		{
+			name:   "consistent",
+			record: newTestRecord(),
+		},
+		{
+			name:     "missing_record",
+			expected: []string{visibilityMissingFailureType},
+		},
+		{
+			name: "stale_status",
+			record: func() *workflowpb.WorkflowExecutionInfo {
+				record := newTestRecord()
+				record.Status = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+				return record
+			}(),
+			expected: []string{visibilityStatusFailureType},
+		},
+		{
+			name: "stale_close_time",
+			record: func() *workflowpb.WorkflowExecutionInfo {
+				record := newTestRecord()
+				record.CloseTime = timestamppb.New(testCloseTime.Add(time.Second))
+				return record
+			}(),
+			expected: []string{visibilityCloseTimeFailureType},
+		},
+		{
+			name: "close_time_with_millisecond_precision",
+			modify: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ExecutionInfo.CloseTime = timestamppb.New(testCloseTime.Add(time.Microsecond))
+			},
+			record: newTestRecord(),
+		},
+		{
+			name: "stale_search_attribute",
+			record: func() *workflowpb.WorkflowExecutionInfo {
+				record := newTestRecord()
+				record.SearchAttributes = nil
+				return record
+			}(),
+			expected: []string{visibilitySearchAttributesFailureType},
+		},
+		{
+			name: "zombie",
+			modify: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ExecutionState.State = enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE
+			},
+		},
+		{
+			name: "recently_updated",
+			modify: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ExecutionInfo.LastUpdateTime = timestamppb.Now()
+			},
+		},
+		{
+			name: "deleted_namespace",
+			modify: func(ms *persistencespb.WorkflowMutableState) {
+				ms.ExecutionInfo.NamespaceId = "deleted-namespace-id"
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			registry := namespace.NewMockRegistry(ctrl)
+			registry.EXPECT().GetNamespaceByID(testNamespace.ID()).Return(testNamespace, nil).AnyTimes()
+			registry.EXPECT().GetNamespaceByID(namespace.ID("deleted-namespace-id")).
+				Return(nil, serviceerror.NewNamespaceNotFound("deleted-namespace-id")).AnyTimes()
+			visibilityManager := manager.NewMockVisibilityManager(ctrl)
+			visibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), &manager.GetWorkflowExecutionRequest{
+				NamespaceID: testNamespace.ID(),
+				Namespace:   testNamespace.Name(),
+				WorkflowID:  "my-workflow-id",
+				RunID:       "my-run-id",
+			}).DoAndReturn(func(context.Context, *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+				if tc.record == nil {
+					return nil, serviceerror.NewNotFound("workflow execution not found")
+				}
+				return &manager.GetWorkflowExecutionResponse{Execution: tc.record}, nil
+			}).AnyTimes()
+
+			ms := newTestMutableState()
+			if tc.modify != nil {
+				tc.modify(ms.WorkflowMutableState)
+			}
+			validator := NewVisibilityValidator(
+				registry,
+				visibilityManager,
+				dynamicconfig.GetFloatPropertyFn(1),
+				dynamicconfig.GetDurationPropertyFn(time.Minute),
+			)
+			results, err := validator.Validate(context.Background(), ms)
+			require.NoError(t, err)
+			var failureTypes []string
+			for _, result := range results {
+				failureTypes = append(failureTypes, result.failureType)
+			}
+			assert.Equal(t, tc.expected, failureTypes)
+		})
+	}
+}
+
+func TestTask_RepairVisibility(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		repair        bool
+		results       []MutableStateValidationResult
+		refreshErr    error
+		expectRefresh bool
+		expectErr     bool
+	}{
+		{
+			name:    "repair_disabled",
+			results: []MutableStateValidationResult{{failureType: visibilityMissingFailureType}},
+		},
+		{
+			// the tasks are regenerated once per execution, however many fields are stale
+			name:   "repair_once",
+			repair: true,
+			results: []MutableStateValidationResult{
+				{failureType: visibilityStatusFailureType},
+				{failureType: visibilitySearchAttributesFailureType},
+			},
+			expectRefresh: true,
+		},
+		{
+			name:          "execution_deleted",
+			repair:        true,
+			results:       []MutableStateValidationResult{{failureType: visibilityMissingFailureType}},
+			refreshErr:    serviceerror.NewNotFound("workflow execution not found"),
+			expectRefresh: true,
+		},
+		{
+			name:          "refresh_failed",
+			repair:        true,
+			results:       []MutableStateValidationResult{{failureType: visibilityMissingFailureType}},
+			refreshErr:    serviceerror.NewUnavailable("unavailable"),
+			expectRefresh: true,
+			expectErr:     true,
+		},
+		{
+			name:    "other_failure",
+			repair:  true,
+			results: []MutableStateValidationResult{{failureType: historyEventIDFailureType}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			adminClient := adminservicemock.NewMockAdminServiceClient(gomock.NewController(t))
+			if tc.expectRefresh {
+				adminClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), &adminservice.RefreshWorkflowTasksRequest{
+					NamespaceId: testNamespace.ID().String(),
+					Execution:   &commonpb.WorkflowExecution{WorkflowId: "my-workflow-id", RunId: "my-run-id"},
+				}).Return(&adminservice.RefreshWorkflowTasksResponse{}, tc.refreshErr).Times(1)
+			}
+			task := &task{
+				ctx:            context.Background(),
+				adminClient:    adminClient,
+				metricsHandler: metrics.NoopMetricsHandler,
+				logger:         log.NewNoopLogger(),
+				visibilityValidatorConfig: VisibilityValidatorConfig{
+					Repair: dynamicconfig.GetBoolPropertyFn(tc.repair),
+				},
+			}
+
+			err := task.handleFailures(newTestMutableState(), tc.results)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func newTestMutableState() *MutableState {
+	return &MutableState{WorkflowMutableState: &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:    testNamespace.ID().String(),
+			WorkflowId:     "my-workflow-id",
+			CloseTime:      timestamppb.New(testCloseTime),
+			LastUpdateTime: timestamppb.New(testCloseTime),
+			SearchAttributes: map[string]*commonpb.Payload{
+				"CustomKeywordField": payload.EncodeString("value"),
+			},
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:  "my-run-id",
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		},
+	}}
+}
+
+func newTestRecord() *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+		CloseTime: timestamppb.New(testCloseTime),
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+			"CustomKeywordField": payload.EncodeString("value"),
+		}},
+	}
+}
@@ -64,6 +64,15 @@ type (
 		ExecutionScannerWorkerCount dynamicconfig.IntPropertyFn
 		// ExecutionScannerHistoryEventIdValidator indicates if the execution scavenger to validate history event id.
 		ExecutionScannerHistoryEventIdValidator dynamicconfig.BoolPropertyFn
+		// ExecutionScannerVisibilityValidator indicates if the execution scavenger to validate visibility records.
+		ExecutionScannerVisibilityValidator dynamicconfig.BoolPropertyFn
+		// ExecutionScannerVisibilitySampleRate is the fraction of executions whose visibility record is validated
+		ExecutionScannerVisibilitySampleRate dynamicconfig.FloatPropertyFn
+		// ExecutionScannerVisibilityMinAge is the time since the last update of an execution before its visibility
+		// record is validated
+		ExecutionScannerVisibilityMinAge dynamicconfig.DurationPropertyFn
+		// ExecutionScannerVisibilityRepair indicates if the execution scavenger to repair mismatching visibility records
+		ExecutionScannerVisibilityRepair dynamicconfig.BoolPropertyFn
 
 		// RemovableBuildIdDurationSinceDefault is the minimum duration since a build ID was last default in its
 		// containing set for it to be considered for removal.
@@ -172,7 +172,14 @@ func ExecutionsScavengerActivity(
 		ctx.cfg.ExecutionDataDurationBuffer,
 		ctx.cfg.ExecutionScannerWorkerCount,
 		ctx.cfg.ExecutionScannerHistoryEventIdValidator,
+		executions.VisibilityValidatorConfig{
+			Enabled:    ctx.cfg.ExecutionScannerVisibilityValidator,
+			SampleRate: ctx.cfg.ExecutionScannerVisibilitySampleRate,
+			MinAge:     ctx.cfg.ExecutionScannerVisibilityMinAge,
+			Repair:     ctx.cfg.ExecutionScannerVisibilityRepair,
+		},
 		ctx.executionManager,
+		ctx.visibilityManager,
 		ctx.namespaceRegistry,
 		ctx.historyClient,
 		ctx.adminClient,
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/util"
 	"google.golang.org/protobuf/proto"
@@ -37,6 +38,7 @@ type (
 		startWorkflowRateLimiter quotas.RateLimiter
 		maxBlobSize              dynamicconfig.IntPropertyFn
 		localActivitySleepLimit  dynamicconfig.DurationPropertyFn
+		maxSkippedActionBatches  dynamicconfig.IntPropertyFn
 	}
 
 	errFollow string
@@ -257,6 +259,34 @@ func (a *activities) TerminateWorkflow(ctx context.Context, req *schedulespb.Ter
 	return translateError(err, "TerminateWorkflowExecution")
 }
 
+func (a *activities) CheckDependency(ctx context.Context, req *schedulespb.CheckDependencyRequest) (*schedulespb.CheckDependencyResponse, error) {
+	// TODO: remove after https://github.com/temporalio/sdk-go/issues/1066
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(ctx, defaultLocalActivityOptions.StartToCloseTimeout)
+	defer cancel()
+
+	status, reason, err := UpstreamActionStatus(ctx, a.FrontendClient, a.namespace.String(), req.Dependency, req.NominalTime.AsTime())
+	if err != nil {
+		return nil, translateError(err, "CheckDependency")
+	}
+	return &schedulespb.CheckDependencyResponse{Status: status, Reason: reason}, nil
+}
+
+func (a *activities) RecordSkippedActions(ctx context.Context, req *schedulespb.RecordSkippedActionsRequest) error {
+	// TODO: remove after https://github.com/temporalio/sdk-go/issues/1066
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithTimeout(ctx, defaultLocalActivityOptions.StartToCloseTimeout)
+	defer cancel()
+
+	err := a.SkippedActionManager.AppendSkippedScheduleActions(ctx, &persistence.AppendSkippedScheduleActionsRequest{
+		NamespaceID:    a.namespaceID.String(),
+		ScheduleID:     req.ScheduleId,
+		SkippedActions: req.SkippedActions,
+		MaxBatches:     a.maxSkippedActionBatches(),
+	})
+	return translateError(err, "AppendSkippedScheduleActions")
+}
+
 func translateError(err error, msgPrefix string) error {
 	if err == nil {
 		return nil
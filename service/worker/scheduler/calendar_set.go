@@ -0,0 +1,257 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	schedulepb "go.temporal.io/api/schedule/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+)
+
+const (
+	calendarSetKey   = "calendar-set"
+	calendarShiftKey = "shift"
+
+	calendarShiftNone            = "none"
+	calendarShiftNextBusinessDay = "next-business-day"
+
+	calendarDayLayout = "2006-01-02"
+
+	// maxCalendarShiftDays bounds the search for the next business day, in case a calendar set
+	// covers every weekday.
+	maxCalendarShiftDays = 366
+)
+
+type calendarDay struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dayOf(t time.Time) calendarDay {
+	y, m, d := t.Date()
+	return calendarDay{year: y, month: m, day: d}
+}
+
+// SplitCalendarSetReferences moves the calendar set references out of the exclude calendars of
+// spec, and copies the days of the referenced sets from lookup into them. ScheduleSpec has no
+// field for calendar sets, so clients reference a set with an exclude calendar that has no ranges
+// and a comment of "calendar-set=<name>", or "calendar-set=<name>;shift=next-business-day" to move
+// actions that land on the days of the set to the next business day. spec isn't modified.
+func SplitCalendarSetReferences(
+	spec *schedulepb.ScheduleSpec,
+	lookup func(name string) (*persistencespb.ScheduleCalendarSet, bool),
+) (*schedulepb.ScheduleSpec, []*schedulespb.CalendarSetReference, error) {
+	var refs []*schedulespb.CalendarSetReference
+	var excludes []*schedulepb.StructuredCalendarSpec
+	for _, excal := range spec.GetExcludeStructuredCalendar() {
+		ref, isRef, err := parseCalendarSetRef(excal)
+		if err != nil {
+			return nil, nil, err
+		} else if !isRef {
+			excludes = append(excludes, excal)
+			continue
+		}
+		set, ok := lookup(ref.Name)
+		if !ok {
+			return nil, nil, fmt.Errorf("calendar set %q is not defined", ref.Name)
+		}
+		ref.Days = set.GetDays()
+		refs = append(refs, ref)
+	}
+	if len(refs) == 0 {
+		return spec, nil, nil
+	}
+	spec = common.CloneProto(spec)
+	spec.ExcludeStructuredCalendar = excludes
+	return spec, refs, nil
+}
+
+// JoinCalendarSetReferences adds the calendar set references back to the exclude calendars of
+// spec, in the form accepted by SplitCalendarSetReferences.
+func JoinCalendarSetReferences(spec *schedulepb.ScheduleSpec, refs []*schedulespb.CalendarSetReference) {
+	for _, ref := range refs {
+		comment := calendarSetKey + "=" + ref.GetName()
+		if ref.GetShiftToNextBusinessDay() {
+			comment += ";" + calendarShiftKey + "=" + calendarShiftNextBusinessDay
+		}
+		spec.ExcludeStructuredCalendar = append(spec.ExcludeStructuredCalendar, &schedulepb.StructuredCalendarSpec{
+			Comment: comment,
+		})
+	}
+}
+
+// ValidateCalendarSetDays returns an error if any of the days of a calendar set isn't in
+// "2006-01-02" format.
+func ValidateCalendarSetDays(days []string) error {
+	for _, day := range days {
+		if _, err := time.Parse(calendarDayLayout, day); err != nil {
+			return fmt.Errorf("invalid calendar set day %q", day)
+		}
+	}
+	return nil
+}
+
+// parseCalendarSetRef returns the calendar set referenced by an exclude calendar. Calendars with
+// ranges, or with a comment that doesn't start with "calendar-set=", are regular calendars.
+func parseCalendarSetRef(scs *schedulepb.StructuredCalendarSpec) (*schedulespb.CalendarSetReference, bool, error) {
+	if !strings.HasPrefix(scs.Comment, calendarSetKey+"=") ||
+		len(scs.Second) > 0 || len(scs.Minute) > 0 || len(scs.Hour) > 0 || len(scs.DayOfMonth) > 0 ||
+		len(scs.Month) > 0 || len(scs.Year) > 0 || len(scs.DayOfWeek) > 0 {
+		return nil, false, nil
+	}
+
+	ref := &schedulespb.CalendarSetReference{}
+	for _, field := range strings.Split(scs.Comment, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return nil, false, fmt.Errorf("invalid calendar set reference %q", scs.Comment)
+		}
+		switch key {
+		case calendarSetKey:
+			ref.Name = value
+		case calendarShiftKey:
+			switch value {
+			case calendarShiftNone:
+				ref.ShiftToNextBusinessDay = false
+			case calendarShiftNextBusinessDay:
+				ref.ShiftToNextBusinessDay = true
+			default:
+				return nil, false, fmt.Errorf("invalid calendar set shift %q", value)
+			}
+		default:
+			return nil, false, fmt.Errorf("unknown calendar set reference field %q", key)
+		}
+	}
+	if ref.Name == "" {
+		return nil, false, errors.New("calendar set name is empty")
+	}
+	return ref, true, nil
+}
+
+// compileCalendarSets collects the days of the referenced calendar sets. Days of sets that exclude
+// are returned in excludeDays and days of sets that shift in shiftDays.
+func compileCalendarSets(
+	refs []*schedulespb.CalendarSetReference,
+) (excludeDays, shiftDays map[calendarDay]struct{}, err error) {
+	for _, ref := range refs {
+		target := &excludeDays
+		if ref.GetShiftToNextBusinessDay() {
+			target = &shiftDays
+		}
+		if *target == nil {
+			*target = make(map[calendarDay]struct{})
+		}
+		for _, day := range ref.GetDays() {
+			t, err := time.Parse(calendarDayLayout, day)
+			if err != nil {
+				return nil, nil, fmt.Errorf("calendar set %q has invalid day %q", ref.GetName(), day)
+			}
+			(*target)[dayOf(t)] = struct{}{}
+		}
+	}
+	return excludeDays, shiftDays, nil
+}
+
+func (cs *CompiledSpec) inExcludedCalendarSet(t time.Time) bool {
+	_, excluded := cs.excludeDays[dayOf(t.In(cs.tz))]
+	return excluded
+}
+
+func (cs *CompiledSpec) onShiftDay(t time.Time) bool {
+	_, shift := cs.shiftDays[dayOf(t.In(cs.tz))]
+	return shift
+}
+
+// isBusinessDay returns true if the local day of t is a weekday that isn't in any referenced
+// calendar set.
+func (cs *CompiledSpec) isBusinessDay(t time.Time) bool {
+	t = t.In(cs.tz)
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return false
+	}
+	_, excluded := cs.excludeDays[dayOf(t)]
+	_, shift := cs.shiftDays[dayOf(t)]
+	return !excluded && !shift
+}
+
+// shiftToBusinessDay returns the same local time of day as t on the next business day, or the
+// zero time if there is none within maxCalendarShiftDays.
+func (cs *CompiledSpec) shiftToBusinessDay(t time.Time) time.Time {
+	local := t.In(cs.tz)
+	y, mo, d := local.Date()
+	h, m, s := local.Clock()
+	for i := 1; i <= maxCalendarShiftDays; i++ {
+		if next := time.Date(y, mo, d+i, h, m, s, 0, cs.tz); cs.isBusinessDay(next) {
+			return next.UTC()
+		}
+	}
+	return time.Time{}
+}
+
+// nextShiftedTime is the equivalent of the loop over raw times in GetNextTime for specs that shift
+// actions on some days to the next business day. Since a shifted action can come after later
+// actions, it looks both at the raw times after the given time and at the raw times of the
+// preceding non-business days that shift past it.
+func (cs *CompiledSpec) nextShiftedTime(after time.Time, pastEndTime func(time.Time) bool) time.Time {
+	var best time.Time
+	consider := func(t time.Time) {
+		if !t.IsZero() && !pastEndTime(t) && (best.IsZero() || t.Before(best)) {
+			best = t
+		}
+	}
+
+	for nominal := after; ; {
+		nominal = cs.rawNextTime(nominal)
+		if nominal.IsZero() || pastEndTime(nominal) || !best.IsZero() && !nominal.Before(best) {
+			break
+		}
+		if cs.excluded(nominal) {
+			continue
+		}
+		if !cs.onShiftDay(nominal) {
+			consider(nominal)
+			break
+		}
+		consider(cs.shiftToBusinessDay(nominal))
+	}
+
+	// Actions on the day of after and on the non-business days right before it can shift past it.
+	local := after.In(cs.tz)
+	for i := 0; i < maxCalendarShiftDays; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()-i, 0, 0, 0, 0, cs.tz)
+		if i > 0 && cs.isBusinessDay(day) {
+			break
+		}
+		if cs.onShiftDay(day) {
+			consider(cs.firstShiftedOnDay(day, after))
+		}
+	}
+	return best
+}
+
+// firstShiftedOnDay returns the earliest shifted time of the actions on the given local day that
+// is after the given time.
+func (cs *CompiledSpec) firstShiftedOnDay(day time.Time, after time.Time) time.Time {
+	nominal := day.Add(-time.Second)
+	if start := cs.spec.StartTime.AsTime().Add(-time.Second); nominal.Before(start) {
+		nominal = start
+	}
+	for {
+		nominal = cs.rawNextTime(nominal)
+		if nominal.IsZero() || dayOf(nominal.In(cs.tz)) != dayOf(day) {
+			return time.Time{}
+		}
+		if cs.excluded(nominal) {
+			continue
+		}
+		shifted := cs.shiftToBusinessDay(nominal)
+		if shifted.IsZero() || shifted.After(after) {
+			return shifted
+		}
+	}
+}
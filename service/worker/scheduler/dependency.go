@@ -0,0 +1,294 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	dependencyKey        = "depends-on"
+	dependencyTimeoutKey = "timeout"
+	dependencyPolicyKey  = "policy"
+	dependencyWindowKey  = "window"
+
+	dependencyPolicySkip = "skip"
+	dependencyPolicyFail = "fail"
+
+	// maxScheduleDependencies bounds the number of upstream schedules that are described every
+	// time a buffered start checks its dependencies.
+	maxScheduleDependencies = 10
+
+	// defaultDependencyWindow fits daily schedules, e.g. nightly ETL stages.
+	defaultDependencyWindow = 24 * time.Hour
+	// upstreamActionsPageSize is the page size used to list the upstream actions in a window.
+	upstreamActionsPageSize = 100
+)
+
+// SplitScheduleDependencies moves the dependency references out of the exclude calendars of spec.
+// ScheduleSpec has no field for dependencies, so clients make a schedule depend on another one
+// with an exclude calendar that has no ranges and a comment of "depends-on=<schedule id>",
+// optionally followed by ";timeout=<duration>", ";window=<duration>" and ";policy=skip" or
+// ";policy=fail". scheduleID is the id of the schedule that spec belongs to. spec isn't modified.
+func SplitScheduleDependencies(
+	spec *schedulepb.ScheduleSpec,
+	scheduleID string,
+) (*schedulepb.ScheduleSpec, []*schedulespb.ScheduleDependency, error) {
+	var deps []*schedulespb.ScheduleDependency
+	var excludes []*schedulepb.StructuredCalendarSpec
+	for _, excal := range spec.GetExcludeStructuredCalendar() {
+		dep, isDep, err := parseDependency(excal)
+		if err != nil {
+			return nil, nil, err
+		} else if !isDep {
+			excludes = append(excludes, excal)
+			continue
+		}
+		if dep.ScheduleId == scheduleID {
+			return nil, nil, errors.New("schedule can't depend on itself")
+		}
+		deps = append(deps, dep)
+	}
+	if len(deps) == 0 {
+		return spec, nil, nil
+	} else if len(deps) > maxScheduleDependencies {
+		return nil, nil, fmt.Errorf("schedule can't have more than %d dependencies", maxScheduleDependencies)
+	}
+	spec = common.CloneProto(spec)
+	spec.ExcludeStructuredCalendar = excludes
+	return spec, deps, nil
+}
+
+// JoinScheduleDependencies adds the dependencies back to the exclude calendars of spec, in the
+// form accepted by SplitScheduleDependencies.
+func JoinScheduleDependencies(spec *schedulepb.ScheduleSpec, deps []*schedulespb.ScheduleDependency) {
+	for _, dep := range deps {
+		comment := dependencyKey + "=" + dep.GetScheduleId()
+		if timeout := dep.GetTimeout().AsDuration(); timeout > 0 {
+			comment += ";" + dependencyTimeoutKey + "=" + timeout.String()
+		}
+		if window := dep.GetWindow().AsDuration(); window > 0 {
+			comment += ";" + dependencyWindowKey + "=" + window.String()
+		}
+		if dep.GetPolicy() == enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL {
+			comment += ";" + dependencyPolicyKey + "=" + dependencyPolicyFail
+		}
+		spec.ExcludeStructuredCalendar = append(spec.ExcludeStructuredCalendar, &schedulepb.StructuredCalendarSpec{
+			Comment: comment,
+		})
+	}
+}
+
+// parseDependency returns the dependency referenced by an exclude calendar. Calendars with
+// ranges, or with a comment that doesn't start with "depends-on=", aren't dependencies.
+func parseDependency(scs *schedulepb.StructuredCalendarSpec) (*schedulespb.ScheduleDependency, bool, error) {
+	if !strings.HasPrefix(scs.Comment, dependencyKey+"=") ||
+		len(scs.Second) > 0 || len(scs.Minute) > 0 || len(scs.Hour) > 0 || len(scs.DayOfMonth) > 0 ||
+		len(scs.Month) > 0 || len(scs.Year) > 0 || len(scs.DayOfWeek) > 0 {
+		return nil, false, nil
+	}
+
+	dep := &schedulespb.ScheduleDependency{Policy: enumsspb.SCHEDULE_DEPENDENCY_POLICY_SKIP}
+	for _, field := range strings.Split(scs.Comment, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(field), "=")
+		if !ok {
+			return nil, false, fmt.Errorf("invalid schedule dependency %q", scs.Comment)
+		}
+		switch key {
+		case dependencyKey:
+			dep.ScheduleId = value
+		case dependencyTimeoutKey:
+			timeout, err := time.ParseDuration(value)
+			if err != nil || timeout <= 0 {
+				return nil, false, fmt.Errorf("invalid schedule dependency timeout %q", value)
+			}
+			dep.Timeout = durationpb.New(timeout)
+		case dependencyWindowKey:
+			window, err := time.ParseDuration(value)
+			if err != nil || window <= 0 {
+				return nil, false, fmt.Errorf("invalid schedule dependency window %q", value)
+			}
+			dep.Window = durationpb.New(window)
+		case dependencyPolicyKey:
+			switch value {
+			case dependencyPolicySkip:
+				dep.Policy = enumsspb.SCHEDULE_DEPENDENCY_POLICY_SKIP
+			case dependencyPolicyFail:
+				dep.Policy = enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL
+			default:
+				return nil, false, fmt.Errorf("invalid schedule dependency policy %q", value)
+			}
+		default:
+			return nil, false, fmt.Errorf("unknown schedule dependency field %q", key)
+		}
+	}
+	if dep.ScheduleId == "" {
+		return nil, false, errors.New("schedule dependency id is empty")
+	}
+	return dep, true, nil
+}
+
+// ScheduledWorkflowID returns the ID of the workflow that a schedule starting workflows with the
+// given base ID starts for a nominal time. It must match the ID set by startWorkflow.
+func ScheduledWorkflowID(baseID string, nominal time.Time) string {
+	return baseID + "-" + nominal.UTC().Truncate(time.Second).Format(time.RFC3339)
+}
+
+// ScheduledSearchAttributes returns the search attributes of a workflow that a schedule starts
+// for a nominal time: the given attributes, plus the schedule id and the nominal time. The
+// upstream action of a dependency is found by these.
+func ScheduledSearchAttributes(
+	attributes *commonpb.SearchAttributes,
+	scheduleID string,
+	nominal time.Time,
+) *commonpb.SearchAttributes {
+	fields := util.CloneMapNonNil(attributes.GetIndexedFields())
+	if p, err := payload.Encode(nominal); err == nil {
+		fields[searchattribute.TemporalScheduledStartTime] = p
+	}
+	if p, err := payload.Encode(scheduleID); err == nil {
+		fields[searchattribute.TemporalScheduledById] = p
+	}
+	return &commonpb.SearchAttributes{
+		IndexedFields: fields,
+	}
+}
+
+// UpstreamActionStatus returns the status of the upstream action of a dependency for a nominal
+// time, and why it failed if it did. The upstream action is the workflow with the latest
+// scheduled start time in the window of the dependency that ends at the nominal time, so the
+// upstream schedule can have a different spec or offset. Workflows that continued as new are
+// still pending, since the action completes with the last run of the chain.
+func UpstreamActionStatus(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	namespace string,
+	dependency *schedulespb.ScheduleDependency,
+	nominal time.Time,
+) (enumsspb.ScheduleDependencyStatus, string, error) {
+	upstream, err := frontendClient.DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: dependency.GetScheduleId(),
+	})
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED, fmt.Sprintf("schedule %q not found", dependency.GetScheduleId()), nil
+	} else if err != nil {
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_UNSPECIFIED, "", err
+	}
+	if upstream.GetSchedule().GetAction().GetStartWorkflow() == nil {
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED, fmt.Sprintf("schedule %q doesn't start workflows", dependency.GetScheduleId()), nil
+	}
+
+	workflowID, err := latestUpstreamAction(ctx, frontendClient, namespace, dependency, nominal)
+	if err != nil {
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_UNSPECIFIED, "", err
+	} else if workflowID == "" {
+		// The upstream schedule hasn't started its action yet, or it isn't visible yet.
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING, "", nil
+	}
+
+	// Without a run id, this describes the latest run, which is the end of the chain for
+	// workflows that continued as new.
+	workflow, err := frontendClient.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID},
+	})
+	if err != nil {
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_UNSPECIFIED, "", err
+	}
+
+	switch workflowStatus := workflow.GetWorkflowExecutionInfo().GetStatus(); workflowStatus {
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_SATISFIED, "", nil
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW:
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING, "", nil
+	default:
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED, fmt.Sprintf("workflow %q of schedule %q closed with status %s",
+			workflowID, dependency.GetScheduleId(), workflowStatus), nil
+	}
+}
+
+// latestUpstreamAction returns the id of the workflow that the upstream schedule of a dependency
+// started for the latest nominal time in the window that ends at the given nominal time, or an
+// empty string if there is none.
+func latestUpstreamAction(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	namespace string,
+	dependency *schedulespb.ScheduleDependency,
+	nominal time.Time,
+) (string, error) {
+	window := dependency.GetWindow().AsDuration()
+	if window <= 0 {
+		window = defaultDependencyWindow
+	}
+	query := fmt.Sprintf("%s = '%s' AND %s > '%s' AND %s <= '%s'",
+		searchattribute.TemporalScheduledById,
+		strings.ReplaceAll(dependency.GetScheduleId(), "'", "\\'"),
+		searchattribute.TemporalScheduledStartTime,
+		nominal.Add(-window).UTC().Format(time.RFC3339Nano),
+		searchattribute.TemporalScheduledStartTime,
+		nominal.UTC().Format(time.RFC3339Nano),
+	)
+
+	var workflowID string
+	var latest time.Time
+	var nextPageToken []byte
+	for {
+		resp, err := frontendClient.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     namespace,
+			PageSize:      upstreamActionsPageSize,
+			NextPageToken: nextPageToken,
+			Query:         query,
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, execution := range resp.GetExecutions() {
+			var scheduledTime time.Time
+			p := execution.GetSearchAttributes().GetIndexedFields()[searchattribute.TemporalScheduledStartTime]
+			if err := payload.Decode(p, &scheduledTime); err != nil {
+				continue
+			}
+			if workflowID == "" || scheduledTime.After(latest) {
+				workflowID = execution.GetExecution().GetWorkflowId()
+				latest = scheduledTime
+			}
+		}
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return workflowID, nil
+		}
+	}
+}
+
+// DependencyDeadline returns the latest time at which a start with the given actual time waits
+// for the upstream action of a dependency. Dependencies without a timeout wait for the catchup
+// window of the schedule.
+func DependencyDeadline(
+	dependency *schedulespb.ScheduleDependency,
+	actualTime time.Time,
+	catchupWindow time.Duration,
+) time.Time {
+	timeout := dependency.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = catchupWindow
+	}
+	return actualTime.Add(timeout)
+}
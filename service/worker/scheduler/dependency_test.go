@@ -0,0 +1,153 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestUpstreamActionStatus(t *testing.T) {
+	t.Parallel()
+
+	// The upstream schedule runs daily at 00:30 and the downstream schedule at 01:00, so their
+	// nominal times never match.
+	specBuilder := NewSpecBuilder()
+	upstreamSpec, err := specBuilder.NewCompiledSpec(&schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(24 * time.Hour), Phase: durationpb.New(30 * time.Minute)}},
+	})
+	require.NoError(t, err)
+	downstreamSpec, err := specBuilder.NewCompiledSpec(&schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(24 * time.Hour), Phase: durationpb.New(time.Hour)}},
+	})
+	require.NoError(t, err)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	yesterday := upstreamSpec.GetNextTime("", start).Nominal
+	today := upstreamSpec.GetNextTime("", yesterday).Nominal
+	nominal := downstreamSpec.GetNextTime("", today).Nominal
+	require.Equal(t, time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC), nominal)
+
+	for _, tc := range []struct {
+		name string
+		// upstreamActions are the nominal times of the actions returned by visibility, one page each
+		upstreamActions []time.Time
+		upstreamStatus  enumspb.WorkflowExecutionStatus
+		window          time.Duration
+		notFound        bool
+		expected        enumsspb.ScheduleDependencyStatus
+		// expectedAction is the nominal time of the upstream action that is described
+		expectedAction time.Time
+		expectedFrom   time.Time
+	}{
+		{
+			name:            "completed",
+			upstreamActions: []time.Time{today},
+			upstreamStatus:  enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			expected:        enumsspb.SCHEDULE_DEPENDENCY_STATUS_SATISFIED,
+			expectedAction:  today,
+			expectedFrom:    nominal.Add(-24 * time.Hour),
+		},
+		{
+			// a longer window includes yesterday's action, the latest one is used
+			name:            "latest_in_window",
+			upstreamActions: []time.Time{today, yesterday},
+			upstreamStatus:  enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			window:          48 * time.Hour,
+			expected:        enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING,
+			expectedAction:  today,
+			expectedFrom:    nominal.Add(-48 * time.Hour),
+		},
+		{
+			name:            "failed",
+			upstreamActions: []time.Time{today},
+			upstreamStatus:  enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			expected:        enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED,
+			expectedAction:  today,
+			expectedFrom:    nominal.Add(-24 * time.Hour),
+		},
+		{
+			name:         "not_started",
+			expected:     enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING,
+			expectedFrom: nominal.Add(-24 * time.Hour),
+		},
+		{
+			name:     "schedule_not_found",
+			notFound: true,
+			expected: enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			frontendClient := workflowservicemock.NewMockWorkflowServiceClient(gomock.NewController(t))
+			describeSchedule := frontendClient.EXPECT().DescribeSchedule(gomock.Any(), &workflowservice.DescribeScheduleRequest{
+				Namespace:  "my-namespace",
+				ScheduleId: "extract",
+			})
+			if tc.notFound {
+				describeSchedule.Return(nil, serviceerror.NewNotFound("schedule not found"))
+			} else {
+				describeSchedule.Return(&workflowservice.DescribeScheduleResponse{
+					Schedule: &schedulepb.Schedule{Action: &schedulepb.ScheduleAction{
+						Action: &schedulepb.ScheduleAction_StartWorkflow{StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{WorkflowId: "etl-extract"}},
+					}},
+				}, nil)
+				expectedQuery := fmt.Sprintf(
+					"TemporalScheduledById = 'extract' AND TemporalScheduledStartTime > '%s' AND TemporalScheduledStartTime <= '%s'",
+					tc.expectedFrom.Format(time.RFC3339Nano), nominal.Format(time.RFC3339Nano),
+				)
+				pages := max(1, len(tc.upstreamActions))
+				frontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Times(pages).DoAndReturn(
+					func(_ context.Context, req *workflowservice.ListWorkflowExecutionsRequest, _ ...any) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+						assert.Equal(t, expectedQuery, req.GetQuery())
+						page := len(req.GetNextPageToken())
+						resp := &workflowservice.ListWorkflowExecutionsResponse{}
+						if page < len(tc.upstreamActions) {
+							action := tc.upstreamActions[page]
+							resp.Executions = []*workflowpb.WorkflowExecutionInfo{{
+								Execution:        &commonpb.WorkflowExecution{WorkflowId: ScheduledWorkflowID("etl-extract", action)},
+								SearchAttributes: ScheduledSearchAttributes(nil, "extract", action),
+							}}
+						}
+						if page+1 < pages {
+							resp.NextPageToken = make([]byte, page+1)
+						}
+						return resp, nil
+					})
+			}
+			if !tc.expectedAction.IsZero() {
+				frontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &workflowservice.DescribeWorkflowExecutionRequest{
+					Namespace: "my-namespace",
+					Execution: &commonpb.WorkflowExecution{WorkflowId: ScheduledWorkflowID("etl-extract", tc.expectedAction)},
+				}).Return(&workflowservice.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: tc.upstreamStatus},
+				}, nil)
+			}
+
+			dependency := &schedulespb.ScheduleDependency{ScheduleId: "extract"}
+			if tc.window > 0 {
+				dependency.Window = durationpb.New(tc.window)
+			}
+			status, reason, err := UpstreamActionStatus(context.Background(), frontendClient, "my-namespace", dependency, nominal)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, status)
+			if tc.expected == enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED {
+				assert.NotEmpty(t, reason)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
@@ -46,6 +47,7 @@ type (
 		globalNSStartWorkflowRPS dynamicconfig.TypedSubscribableWithNamespaceFilter[float64]
 		maxBlobSize              dynamicconfig.IntPropertyFnWithNamespaceFilter
 		localActivitySleepLimit  dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		maxSkippedActionBatches  dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 
 	activityDeps struct {
@@ -54,6 +56,8 @@ type (
 		Logger         log.Logger
 		HistoryClient  resource.HistoryClient
 		FrontendClient workflowservice.WorkflowServiceClient
+		// SkippedActionManager stores the skipped actions listed by ListScheduleActions.
+		SkippedActionManager persistence.SkippedScheduleActionManager
 	}
 
 	fxResult struct {
@@ -80,6 +84,7 @@ func NewResult(
 			globalNSStartWorkflowRPS: dynamicconfig.SchedulerNamespaceStartWorkflowRPS.Subscribe(dc),
 			maxBlobSize:              dynamicconfig.BlobSizeLimitError.Get(dc),
 			localActivitySleepLimit:  dynamicconfig.SchedulerLocalActivitySleepLimit.Get(dc),
+			maxSkippedActionBatches:  dynamicconfig.ScheduleMaxSkippedActionBatches.Get(dc),
 		},
 	}
 }
@@ -120,5 +125,6 @@ func (s *workerComponent) newActivities(name namespace.Name, id namespace.ID, de
 		startWorkflowRateLimiter: lim,
 		maxBlobSize:              func() int { return s.maxBlobSize(name.String()) },
 		localActivitySleepLimit:  func() time.Duration { return s.localActivitySleepLimit(name.String()) },
+		maxSkippedActionBatches:  func() int { return s.maxSkippedActionBatches(name.String()) },
 	}, cancel
 }
@@ -0,0 +1,167 @@
+package scheduler
+
+import (
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// PreviewAction is an action that a schedule would take, as computed by PreviewActions.
+	PreviewAction struct {
+		Nominal time.Time // time that matches the spec
+		Actual  time.Time // nominal time with jitter applied
+		// Start is when the workflow starts, after Actual if the overlap policy buffered it. It's
+		// zero if the action is skipped.
+		Start               time.Time
+		OverlapSkipped      bool
+		CancelsRunning      bool
+		MissedCatchupWindow bool
+	}
+
+	// PreviewParams describe the schedule and the range to preview.
+	PreviewParams struct {
+		JitterSeed string
+		Policies   *schedulepb.SchedulePolicies
+		// Actions are taken after Start and up to End, like in processTimeRange.
+		Start, End time.Time
+		// Now is when the schedule would be created. Actions before it are taken at Now, or missed
+		// if they're further in the past than the catchup window.
+		Now time.Time
+		// RunDuration is how long each started workflow runs.
+		RunDuration time.Duration
+		MaxActions  int
+	}
+
+	// previewer simulates the buffer of a schedule. Workflows that are canceled or terminated by
+	// the overlap policy are assumed to close right away.
+	previewer struct {
+		params  PreviewParams
+		actions []PreviewAction
+		buffer  []*schedulespb.BufferedStart
+		index   map[*schedulespb.BufferedStart]int
+		// runEnd is when the running non-overlapping workflow closes, or zero if there is none.
+		runEnd time.Time
+	}
+)
+
+// JitterSeed returns the seed of the jitter of a schedule, for CompiledSpec.GetNextTime.
+func JitterSeed(namespaceID, scheduleID string) string {
+	return namespaceID + "-" + scheduleID
+}
+
+// PreviewActions returns the actions that a schedule with the spec and the policies would take in
+// a time range, using the same time computation and overlap handling as the schedulers. Returns
+// true if there are more than MaxActions actions in the range.
+func PreviewActions(cspec *CompiledSpec, params PreviewParams) ([]PreviewAction, bool) {
+	p := &previewer{
+		params: params,
+		index:  make(map[*schedulespb.BufferedStart]int),
+	}
+	catchupWindow := p.catchupWindow()
+	truncated := false
+
+	var next GetNextTimeResult
+	for next = cspec.GetNextTime(params.JitterSeed, params.Start); !(next.Next.IsZero() || next.Next.After(params.End)); next = cspec.GetNextTime(params.JitterSeed, next.Next) {
+		if len(p.actions) >= params.MaxActions {
+			truncated = true
+			break
+		}
+		action := PreviewAction{Nominal: next.Nominal, Actual: next.Next}
+		if params.Now.Sub(next.Next) > catchupWindow {
+			action.MissedCatchupWindow = true
+			p.actions = append(p.actions, action)
+			continue
+		}
+		processTime := next.Next
+		if processTime.Before(params.Now) {
+			processTime = params.Now
+		}
+		p.closeRunningUntil(processTime)
+
+		start := &schedulespb.BufferedStart{
+			NominalTime: timestamppb.New(next.Nominal),
+			ActualTime:  timestamppb.New(next.Next),
+		}
+		p.index[start] = len(p.actions)
+		p.actions = append(p.actions, action)
+		p.buffer = append(p.buffer, start)
+		p.processBuffer(processTime)
+	}
+
+	// starts that are still buffered at the end of the range start when the running workflows close
+	for len(p.buffer) > 0 && !p.runEnd.IsZero() {
+		closeTime := p.runEnd
+		p.runEnd = time.Time{}
+		p.processBuffer(closeTime)
+	}
+	return p.actions, truncated
+}
+
+func (p *previewer) catchupWindow() time.Duration {
+	cw := p.params.Policies.GetCatchupWindow()
+	if cw == nil {
+		return CurrentTweakablePolicies.DefaultCatchupWindow
+	}
+	return max(cw.AsDuration(), CurrentTweakablePolicies.MinCatchupWindow)
+}
+
+func (p *previewer) resolveOverlapPolicy(overlapPolicy enumspb.ScheduleOverlapPolicy) enumspb.ScheduleOverlapPolicy {
+	if overlapPolicy == enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		overlapPolicy = p.params.Policies.GetOverlapPolicy()
+	}
+	if overlapPolicy == enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		overlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_SKIP
+	}
+	return overlapPolicy
+}
+
+// closeRunningUntil closes the running workflows that close before or at the given time, and
+// starts buffered actions when they do.
+func (p *previewer) closeRunningUntil(t time.Time) {
+	for !p.runEnd.IsZero() && !p.runEnd.After(t) {
+		closeTime := p.runEnd
+		p.runEnd = time.Time{}
+		p.processBuffer(closeTime)
+	}
+}
+
+func (p *previewer) processBuffer(t time.Time) {
+	cancelsRunning := false
+	for {
+		isRunning := !p.runEnd.IsZero() && p.runEnd.After(t)
+		result := ProcessBuffer(p.buffer, isRunning, p.resolveOverlapPolicy)
+
+		kept := make(map[*schedulespb.BufferedStart]bool, len(result.NewBuffer))
+		for _, start := range result.NewBuffer {
+			kept[start] = true
+		}
+		for _, start := range result.OverlappingStarts {
+			kept[start] = true
+			p.actions[p.index[start]].Start = t
+		}
+		if start := result.NonOverlappingStart; start != nil {
+			kept[start] = true
+			p.actions[p.index[start]].Start = t
+			p.actions[p.index[start]].CancelsRunning = cancelsRunning
+			if p.params.RunDuration > 0 {
+				p.runEnd = t.Add(p.params.RunDuration)
+			}
+		}
+		for _, start := range p.buffer {
+			if !kept[start] {
+				p.actions[p.index[start]].OverlapSkipped = true
+			}
+		}
+		p.buffer = result.NewBuffer
+
+		if !result.NeedCancel && !result.NeedTerminate {
+			return
+		}
+		p.runEnd = time.Time{}
+		cancelsRunning = true
+	}
+}
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func previewHourly(t *testing.T, params PreviewParams) ([]PreviewAction, bool) {
+	cspec, err := NewSpecBuilder().NewCompiledSpec(&schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(time.Hour)}},
+	})
+	require.NoError(t, err)
+	if params.Start.IsZero() {
+		params.Start = time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC)
+		params.End = params.Start.Add(6 * time.Hour)
+	}
+	if params.MaxActions == 0 {
+		params.MaxActions = 100
+	}
+	return PreviewActions(cspec, params)
+}
+
+func previewStarts(actions []PreviewAction) []time.Time {
+	starts := make([]time.Time, len(actions))
+	for i, action := range actions {
+		starts[i] = action.Start
+	}
+	return starts
+}
+
+func TestPreviewActions_OverlapPolicies(t *testing.T) {
+	t.Parallel()
+
+	at := func(h, m int) time.Time { return time.Date(2027, 1, 5, h, m, 0, 0, time.UTC) }
+	for _, tc := range []struct {
+		policy         enumspb.ScheduleOverlapPolicy
+		starts         []time.Time
+		cancelsRunning []bool
+	}{
+		{
+			policy: enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED,
+			starts: []time.Time{at(1, 0), {}, at(3, 0), {}, at(5, 0), {}},
+		},
+		{
+			policy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+			starts: []time.Time{at(1, 0), at(2, 30), at(4, 0), at(5, 30), {}, at(7, 0)},
+		},
+		{
+			policy:         enumspb.SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER,
+			starts:         []time.Time{at(1, 0), at(2, 0), at(3, 0), at(4, 0), at(5, 0), at(6, 0)},
+			cancelsRunning: []bool{false, true, true, true, true, true},
+		},
+		{
+			policy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			starts: []time.Time{at(1, 0), at(2, 0), at(3, 0), at(4, 0), at(5, 0), at(6, 0)},
+		},
+	} {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			actions, truncated := previewHourly(t, PreviewParams{
+				Policies:    &schedulepb.SchedulePolicies{OverlapPolicy: tc.policy},
+				RunDuration: 90 * time.Minute,
+			})
+			require.False(t, truncated)
+			require.Equal(t, tc.starts, previewStarts(actions))
+			for i, action := range actions {
+				require.Equal(t, at(i+1, 0), action.Nominal)
+				require.Equal(t, action.Start.IsZero(), action.OverlapSkipped)
+				if tc.cancelsRunning != nil {
+					require.Equal(t, tc.cancelsRunning[i], action.CancelsRunning)
+				}
+			}
+		})
+	}
+}
+
+func TestPreviewActions_CatchupWindow(t *testing.T) {
+	t.Parallel()
+
+	at := func(h, m int) time.Time { return time.Date(2027, 1, 5, h, m, 0, 0, time.UTC) }
+	actions, _ := previewHourly(t, PreviewParams{
+		Policies: &schedulepb.SchedulePolicies{CatchupWindow: durationpb.New(time.Hour)},
+		Now:      at(3, 30),
+	})
+	require.Len(t, actions, 6)
+	require.True(t, actions[0].MissedCatchupWindow)
+	require.True(t, actions[1].MissedCatchupWindow)
+	require.False(t, actions[2].MissedCatchupWindow)
+	// actions in the past are taken when the schedule is created
+	require.Equal(t, []time.Time{{}, {}, at(3, 30), at(4, 0), at(5, 0), at(6, 0)}, previewStarts(actions))
+}
+
+func TestPreviewActions_MaxActions(t *testing.T) {
+	t.Parallel()
+
+	actions, truncated := previewHourly(t, PreviewParams{MaxActions: 2})
+	require.Len(t, actions, 2)
+	require.True(t, truncated)
+}
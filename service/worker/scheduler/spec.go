@@ -9,6 +9,7 @@ import (
 
 	"github.com/dgryski/go-farm"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -21,6 +22,10 @@ type (
 		tz       *time.Location
 		calendar []*compiledCalendar
 		excludes []*compiledCalendar
+		// excludeDays and shiftDays are the days of the referenced calendar sets, in the time zone
+		// of the spec.
+		excludeDays map[calendarDay]struct{}
+		shiftDays   map[calendarDay]struct{}
 	}
 
 	GetNextTimeResult struct {
@@ -54,6 +59,16 @@ func NewSpecBuilder() *SpecBuilder {
 }
 
 func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledSpec, error) {
+	return b.NewCompiledSpecWithCalendarSets(spec, nil)
+}
+
+// NewCompiledSpecWithCalendarSets compiles a spec that also excludes or shifts actions on the days
+// of the given calendar sets. The days are part of the schedule, so the result only depends on its
+// arguments.
+func (b *SpecBuilder) NewCompiledSpecWithCalendarSets(
+	spec *schedulepb.ScheduleSpec,
+	calendarSets []*schedulespb.CalendarSetReference,
+) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -77,11 +92,18 @@ func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledS
 		excludes[i] = newCompiledCalendar(excal, tz)
 	}
 
+	excludeDays, shiftDays, err := compileCalendarSets(calendarSets)
+	if err != nil {
+		return nil, err
+	}
+
 	cspec := &CompiledSpec{
-		spec:     spec,
-		tz:       tz,
-		calendar: ccs,
-		excludes: excludes,
+		spec:        spec,
+		tz:          tz,
+		calendar:    ccs,
+		excludes:    excludes,
+		excludeDays: excludeDays,
+		shiftDays:   shiftDays,
 	}
 
 	return cspec, nil
@@ -279,13 +301,19 @@ func (cs *CompiledSpec) GetNextTime(jitterSeed string, after time.Time) GetNextT
 		return cs.spec.EndTime != nil && t.After(cs.spec.EndTime.AsTime()) || t.Year() > maxCalendarYear
 	}
 	var nominal time.Time
-	for nominal.IsZero() || cs.excluded(nominal) {
-		nominal = cs.rawNextTime(after)
-		after = nominal
-
-		if nominal.IsZero() || pastEndTime(nominal) {
+	if len(cs.shiftDays) > 0 {
+		if nominal = cs.nextShiftedTime(after, pastEndTime); nominal.IsZero() {
 			return GetNextTimeResult{}
 		}
+	} else {
+		for nominal.IsZero() || cs.excluded(nominal) {
+			nominal = cs.rawNextTime(after)
+			after = nominal
+
+			if nominal.IsZero() || pastEndTime(nominal) {
+				return GetNextTimeResult{}
+			}
+		}
 	}
 
 	maxJitter := timestamp.DurationValue(cs.spec.Jitter)
@@ -338,8 +366,11 @@ func (cs *CompiledSpec) nextIntervalTime(iv *schedulepb.IntervalSpec, ts int64)
 	return (((ts-phase)/interval)+1)*interval + phase
 }
 
-// Returns true if any exclude spec matches the time.
+// Returns true if any exclude spec or excluding calendar set matches the time.
 func (cs *CompiledSpec) excluded(nominal time.Time) bool {
+	if cs.inExcludedCalendarSet(nominal) {
+		return true
+	}
 	for _, excal := range cs.excludes {
 		if excal.matches(nominal) {
 			return true
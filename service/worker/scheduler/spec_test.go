@@ -1,11 +1,15 @@
 package scheduler
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/testing/protorequire"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -494,3 +498,250 @@ func (s *specSuite) TestSpecJitterSeed() {
 		time.Date(2022, 3, 24, 0, 39, 16, 922000000, time.UTC),
 	)
 }
+
+// calendarSets are the calendar sets of the namespace in the calendar set tests. 2027-01-01 is a
+// Friday and 2027-01-04 a Monday.
+var calendarSets = map[string]*persistencespb.ScheduleCalendarSet{
+	"holidays":  {Days: []string{"2027-01-01", "2027-01-04"}},
+	"wednesday": {Days: []string{"2027-01-06"}},
+	"invalid":   {Days: []string{"2027-13-01"}},
+}
+
+func lookupCalendarSet(name string) (*persistencespb.ScheduleCalendarSet, bool) {
+	set, ok := calendarSets[name]
+	return set, ok
+}
+
+func (s *specSuite) compileWithCalendarSets(spec *schedulepb.ScheduleSpec) (*CompiledSpec, error) {
+	spec, refs, err := SplitCalendarSetReferences(spec, lookupCalendarSet)
+	if err != nil {
+		return nil, err
+	}
+	return s.specBuilder.NewCompiledSpecWithCalendarSets(spec, refs)
+}
+
+func (s *specSuite) checkSequenceWithCalendarSets(spec *schedulepb.ScheduleSpec, start time.Time, seq ...time.Time) {
+	s.T().Helper()
+	cs, err := s.compileWithCalendarSets(spec)
+	s.Require().NoError(err)
+	for _, exp := range seq {
+		next := cs.GetNextTime("", start).Next
+		s.Equal(exp, next)
+		start = next
+	}
+}
+
+func (s *specSuite) TestSpecCalendarSetExclude() {
+	s.checkSequenceWithCalendarSets(
+		&schedulepb.ScheduleSpec{
+			Calendar: []*schedulepb.CalendarSpec{
+				{Hour: "9", DayOfWeek: "*"},
+			},
+			ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+				{Comment: "calendar-set=holidays"},
+			},
+		},
+		time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 3, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 5, 9, 0, 0, 0, time.UTC),
+	)
+}
+
+func (s *specSuite) TestSpecCalendarSetShift() {
+	// Friday's action skips the weekend and the Monday holiday, and merges with Tuesday's.
+	s.checkSequenceWithCalendarSets(
+		&schedulepb.ScheduleSpec{
+			Calendar: []*schedulepb.CalendarSpec{
+				{Hour: "9", DayOfWeek: "1-5"},
+			},
+			ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+				{Comment: "calendar-set=holidays;shift=next-business-day"},
+			},
+		},
+		time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 5, 9, 0, 0, 0, time.UTC),
+		time.Date(2027, 1, 6, 9, 0, 0, 0, time.UTC),
+	)
+}
+
+func (s *specSuite) TestSpecCalendarSetShiftAfterNominalTime() {
+	cs, err := s.compileWithCalendarSets(&schedulepb.ScheduleSpec{
+		Calendar: []*schedulepb.CalendarSpec{
+			{Hour: "10", DayOfWeek: "3"},
+		},
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Comment: "calendar-set=wednesday;shift=next-business-day"},
+		},
+	})
+	s.NoError(err)
+	// the shifted action of the Wednesday comes after its nominal time
+	s.Equal(
+		time.Date(2027, 1, 7, 10, 0, 0, 0, time.UTC),
+		cs.GetNextTime("", time.Date(2027, 1, 6, 12, 0, 0, 0, time.UTC)).Next,
+	)
+	s.Equal(
+		time.Date(2027, 1, 13, 10, 0, 0, 0, time.UTC),
+		cs.GetNextTime("", time.Date(2027, 1, 7, 10, 0, 0, 0, time.UTC)).Next,
+	)
+}
+
+func (s *specSuite) TestSpecCalendarSetErrors() {
+	compile := func(comment string) error {
+		_, err := s.compileWithCalendarSets(&schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{
+				{Interval: durationpb.New(time.Hour)},
+			},
+			ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+				{Comment: comment},
+			},
+		})
+		return err
+	}
+
+	s.Error(compile("calendar-set=holidays;shift=previous-business-day"))
+	s.Error(compile("calendar-set="))
+	s.Error(compile("calendar-set=holidays;color=red"))
+	s.ErrorContains(compile("calendar-set=unknown"), `calendar set "unknown" is not defined`)
+	s.ErrorContains(compile("calendar-set=invalid"), `invalid day "2027-13-01"`)
+
+	// a calendar with ranges is a regular calendar, whatever its comment
+	spec, refs, err := SplitCalendarSetReferences(&schedulepb.ScheduleSpec{
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Hour: []*schedulepb.Range{{Start: 1}}, Comment: "calendar-set=unknown"},
+		},
+	}, lookupCalendarSet)
+	s.NoError(err)
+	s.Empty(refs)
+	s.Len(spec.ExcludeStructuredCalendar, 1)
+}
+
+func (s *specSuite) TestSplitAndJoinCalendarSetReferences() {
+	spec := &schedulepb.ScheduleSpec{
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Hour: []*schedulepb.Range{{Start: 1}}},
+			{Comment: "calendar-set=holidays;shift=next-business-day"},
+			{Comment: "calendar-set=wednesday"},
+		},
+	}
+	split, refs, err := SplitCalendarSetReferences(spec, lookupCalendarSet)
+	s.NoError(err)
+	s.Len(spec.ExcludeStructuredCalendar, 3, "spec must not be modified")
+	s.ProtoEqual(&schedulepb.ScheduleSpec{
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Hour: []*schedulepb.Range{{Start: 1}}},
+		},
+	}, split)
+	s.Len(refs, 2)
+	s.ProtoEqual(&schedulespb.CalendarSetReference{
+		Name:                   "holidays",
+		ShiftToNextBusinessDay: true,
+		Days:                   []string{"2027-01-01", "2027-01-04"},
+	}, refs[0])
+	s.ProtoEqual(&schedulespb.CalendarSetReference{
+		Name: "wednesday",
+		Days: []string{"2027-01-06"},
+	}, refs[1])
+
+	JoinCalendarSetReferences(split, refs)
+	s.ProtoEqual(spec, split)
+}
+
+func (s *specSuite) TestSplitAndJoinScheduleDependencies() {
+	spec := &schedulepb.ScheduleSpec{
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Hour: []*schedulepb.Range{{Start: 1}}},
+			{Comment: "depends-on=extract"},
+			{Comment: "depends-on=load;timeout=1h0m0s;window=48h0m0s;policy=fail"},
+			{Comment: "calendar-set=holidays"},
+		},
+	}
+	split, deps, err := SplitScheduleDependencies(spec, "transform")
+	s.NoError(err)
+	s.Len(spec.ExcludeStructuredCalendar, 4, "spec must not be modified")
+	s.ProtoEqual(&schedulepb.ScheduleSpec{
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Hour: []*schedulepb.Range{{Start: 1}}},
+			{Comment: "calendar-set=holidays"},
+		},
+	}, split)
+	s.Len(deps, 2)
+	s.ProtoEqual(&schedulespb.ScheduleDependency{
+		ScheduleId: "extract",
+		Policy:     enumsspb.SCHEDULE_DEPENDENCY_POLICY_SKIP,
+	}, deps[0])
+	s.ProtoEqual(&schedulespb.ScheduleDependency{
+		ScheduleId: "load",
+		Timeout:    durationpb.New(time.Hour),
+		Window:     durationpb.New(48 * time.Hour),
+		Policy:     enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL,
+	}, deps[1])
+
+	// Calendar sets split first leave dependencies alone, and the other way around.
+	_, refs, err := SplitCalendarSetReferences(spec, lookupCalendarSet)
+	s.NoError(err)
+	s.Len(refs, 1)
+
+	split.ExcludeStructuredCalendar = split.ExcludeStructuredCalendar[:1]
+	JoinScheduleDependencies(split, deps)
+	s.ProtoEqual(&schedulepb.ScheduleSpec{
+		ExcludeStructuredCalendar: spec.ExcludeStructuredCalendar[:3],
+	}, split)
+}
+
+func (s *specSuite) TestSplitScheduleDependenciesErrors() {
+	tooMany := &schedulepb.ScheduleSpec{}
+	for i := 0; i <= maxScheduleDependencies; i++ {
+		tooMany.ExcludeStructuredCalendar = append(tooMany.ExcludeStructuredCalendar,
+			&schedulepb.StructuredCalendarSpec{Comment: fmt.Sprintf("depends-on=upstream%d", i)})
+	}
+
+	for _, tc := range []struct {
+		name string
+		spec *schedulepb.ScheduleSpec
+		err  string
+	}{
+		{
+			name: "self",
+			spec: &schedulepb.ScheduleSpec{ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{Comment: "depends-on=transform"}}},
+			err:  "can't depend on itself",
+		},
+		{
+			name: "empty id",
+			spec: &schedulepb.ScheduleSpec{ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{Comment: "depends-on="}}},
+			err:  "id is empty",
+		},
+		{
+			name: "invalid timeout",
+			spec: &schedulepb.ScheduleSpec{ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{Comment: "depends-on=load;timeout=-1h"}}},
+			err:  "invalid schedule dependency timeout",
+		},
+		{
+			name: "invalid window",
+			spec: &schedulepb.ScheduleSpec{ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{Comment: "depends-on=load;window=0s"}}},
+			err:  "invalid schedule dependency window",
+		},
+		{
+			name: "invalid policy",
+			spec: &schedulepb.ScheduleSpec{ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{Comment: "depends-on=load;policy=retry"}}},
+			err:  "invalid schedule dependency policy",
+		},
+		{
+			name: "unknown field",
+			spec: &schedulepb.ScheduleSpec{ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{Comment: "depends-on=load;shift=none"}}},
+			err:  "unknown schedule dependency field",
+		},
+		{
+			name: "too many",
+			spec: tooMany,
+			err:  "more than 10 dependencies",
+		},
+	} {
+		s.Run(tc.name, func() {
+			_, _, err := SplitScheduleDependencies(tc.spec, "transform")
+			s.ErrorContains(err, tc.err)
+		})
+	}
+}
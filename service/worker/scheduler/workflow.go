@@ -18,6 +18,7 @@ import (
 	sdklog "go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
@@ -64,6 +65,8 @@ const (
 	LimitMemoSpecSize = 11
 	// trigger immediately timestamp is added to the PatchRequest
 	TriggerImmediatelyTimestamp = 12
+	// persist skipped actions with a local activity instead of only keeping them in state
+	PersistSkippedActions = 13
 )
 
 const (
@@ -90,6 +93,9 @@ const (
 	// query so it can be changed without breaking history.)
 	maxListMatchingTimesCount = 1000
 
+	// Maximum number of skipped actions to keep in state until they're persisted.
+	maxPendingSkippedActions = 100
+
 	rateLimitedErrorType            = "RateLimited"
 	workflowExecutionAlreadyStarted = "serviceerror.WorkflowExecutionAlreadyStarted"
 
@@ -153,6 +159,7 @@ type (
 		ReuseTimer                        bool                     // Whether to reuse timer. Used for workflow compatibility.
 		NextTimeCacheV2Size               int                      // Size of next time cache (v2)
 		SpecFieldLengthLimit              int                      // item limit per spec field on the ScheduleInfo memo
+		DependencyPollInterval            time.Duration            // How often a buffered start checks whether the upstream actions it waits for completed
 		Version                           SchedulerWorkflowVersion // Used to keep track of schedules version to release new features and for backward compatibility
 		// version 0 corresponds to the schedule version that comes before introducing the Version parameter
 
@@ -203,7 +210,8 @@ var (
 		ReuseTimer:                        true,
 		NextTimeCacheV2Size:               14, // see note below
 		SpecFieldLengthLimit:              10,
-		Version:                           TriggerImmediatelyTimestamp,
+		DependencyPollInterval:            30 * time.Second,
+		Version:                           PersistSkippedActions,
 	}
 
 	// Note on NextTimeCacheV2Size: This value must be > FutureActionCountForList. Each
@@ -311,6 +319,8 @@ func (s *scheduler) run() error {
 		//nolint:revive
 		for s.processBuffer() {
 		}
+		nextWakeup = s.nextDependencyCheck(nextWakeup)
+		s.persistSkippedActions()
 		s.updateMemoAndSearchAttributes()
 
 		// if schedule is not paused and out of actions or do not have anything scheduled, exit the schedule workflow after retention period has passed
@@ -373,7 +383,7 @@ func (s *scheduler) compileSpec() {
 	s.nextTimeCacheV1 = nil
 	s.nextTimeCacheV2 = nil
 
-	cspec, err := s.specBuilder.NewCompiledSpec(s.Schedule.Spec)
+	cspec, err := s.specBuilder.NewCompiledSpecWithCalendarSets(s.Schedule.Spec, s.CalendarSets)
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Error("Invalid schedule", "error", err)
@@ -651,6 +661,11 @@ func (s *scheduler) processTimeRange(
 			s.logger.Warn("Schedule missed catchup window", "now", end, "time", next.Next)
 			s.metrics.Counter(metrics.ScheduleMissedCatchupWindow.Name()).Inc(1)
 			s.Info.MissedCatchupWindow++
+			s.recordSkippedAction(
+				timestamppb.New(next.Nominal),
+				timestamppb.New(next.Next),
+				enumsspb.SCHEDULE_ACTION_SKIP_REASON_CATCHUP_WINDOW,
+			)
 			continue
 		}
 		s.addStart(next.Nominal, next.Next, overlapPolicy, manual)
@@ -881,6 +896,8 @@ func (s *scheduler) processUpdate(req *schedulespb.FullUpdateRequest) {
 	s.Schedule.Action = req.Schedule.GetAction()
 	s.Schedule.Policies = req.Schedule.GetPolicies()
 	s.Schedule.State = req.Schedule.GetState()
+	s.CalendarSets = req.CalendarSets
+	s.Dependencies = req.Dependencies
 	// don't touch Info
 
 	s.ensureFields()
@@ -989,6 +1006,8 @@ func (s *scheduler) handleDescribeQuery() (*schedulespb.DescribeResponse, error)
 		Schedule:      s.Schedule,
 		Info:          infoCopy,
 		ConflictToken: s.State.ConflictToken,
+		CalendarSets:  s.CalendarSets,
+		Dependencies:  s.Dependencies,
 	}, nil
 }
 
@@ -1013,6 +1032,42 @@ func (s *scheduler) handleListMatchingTimesQuery(req *workflowservice.ListSchedu
 	return &workflowservice.ListScheduleMatchingTimesResponse{StartTime: out}, nil
 }
 
+// recordSkippedAction adds an action to the skipped actions to persist, dropping the oldest ones
+// beyond maxPendingSkippedActions if they can't be persisted.
+func (s *scheduler) recordSkippedAction(nominalTime, actualTime *timestamppb.Timestamp, reason enumsspb.ScheduleActionSkipReason) {
+	s.State.PendingSkippedActions = append(s.State.PendingSkippedActions, &schedulespb.SkippedAction{
+		NominalTime: nominalTime,
+		ActualTime:  actualTime,
+		Reason:      reason,
+	})
+	if extra := len(s.State.PendingSkippedActions) - maxPendingSkippedActions; extra > 0 {
+		s.logger.Warn("Dropping skipped actions that weren't persisted", "count", extra)
+		s.State.PendingSkippedActions = slices.Delete(s.State.PendingSkippedActions, 0, extra)
+	}
+}
+
+// persistSkippedActions appends the skipped actions recorded since the last call to the skipped
+// actions of the schedule listed by ListScheduleActions.
+func (s *scheduler) persistSkippedActions() {
+	if !s.hasMinVersion(PersistSkippedActions) || len(s.State.PendingSkippedActions) == 0 {
+		return
+	}
+	// don't hold up the schedule retrying, they're tried again on the next iteration
+	options := defaultLocalActivityOptions
+	options.ScheduleToCloseTimeout = options.StartToCloseTimeout
+	ctx := workflow.WithLocalActivityOptions(s.ctx, options)
+	req := &schedulespb.RecordSkippedActionsRequest{
+		ScheduleId:     s.State.ScheduleId,
+		SkippedActions: s.State.PendingSkippedActions,
+	}
+	err := workflow.ExecuteLocalActivity(ctx, s.a.RecordSkippedActions, req).Get(s.ctx, nil)
+	if err != nil {
+		s.logger.Error("Failed to persist skipped actions", "error", err)
+		return
+	}
+	s.State.PendingSkippedActions = nil
+}
+
 func (s *scheduler) incSeqNo() {
 	s.State.ConflictToken++
 }
@@ -1214,6 +1269,15 @@ func (s *scheduler) processBuffer() bool {
 	tryAgain := false
 	action := ProcessBuffer(s.State.BufferedStarts, isRunning, s.resolveOverlapPolicy)
 
+	if action.OverlapSkipped > 0 {
+		for _, start := range s.State.BufferedStarts {
+			if start != action.NonOverlappingStart &&
+				!slices.Contains(action.OverlappingStarts, start) &&
+				!slices.Contains(action.NewBuffer, start) {
+				s.recordSkippedAction(start.NominalTime, start.ActualTime, enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP)
+			}
+		}
+	}
 	s.State.BufferedStarts = action.NewBuffer
 	s.Info.OverlapSkipped += action.OverlapSkipped
 
@@ -1222,7 +1286,21 @@ func (s *scheduler) processBuffer() bool {
 	if action.NonOverlappingStart != nil {
 		allStarts = append(allStarts, action.NonOverlappingStart)
 	}
+	var waiting []*schedulespb.BufferedStart
 	for _, start := range allStarts {
+		// Scheduled starts wait for the actions of upstream schedules for the same nominal
+		// time. Check that the start could be taken first, so that a paused schedule doesn't
+		// check dependencies, and a waiting start doesn't use up a remaining action.
+		if !start.Manual && len(s.Dependencies) > 0 && s.canTakeScheduledAction(false, false) {
+			switch s.awaitDependencies(start) {
+			case enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING:
+				waiting = append(waiting, start)
+				continue
+			case enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED:
+				continue
+			default:
+			}
+		}
 		if !s.canTakeScheduledAction(start.Manual, true) {
 			// try again to drain the buffer if paused or out of actions
 			tryAgain = true
@@ -1244,6 +1322,10 @@ func (s *scheduler) processBuffer() bool {
 		nonOverlapping := start == action.NonOverlappingStart
 		s.recordAction(result, nonOverlapping)
 	}
+	// Starts that wait for their dependencies go back to the front of the buffer, in order.
+	if len(waiting) > 0 {
+		s.State.BufferedStarts = append(waiting, s.State.BufferedStarts...)
+	}
 
 	// Terminate or cancel if required (terminate overrides cancel if both are present)
 	if action.NeedTerminate {
@@ -1263,7 +1345,7 @@ func (s *scheduler) processBuffer() bool {
 	if len(s.State.BufferedStarts) > 0 && s.watchingFuture == nil {
 		if len(s.Info.RunningWorkflows) > 0 {
 			s.startLongPollWatcher(s.Info.RunningWorkflows[0])
-		} else {
+		} else if len(waiting) == 0 {
 			s.logger.Error("have buffered workflows but none running")
 		}
 	}
@@ -1271,6 +1353,96 @@ func (s *scheduler) processBuffer() bool {
 	return tryAgain
 }
 
+// awaitDependencies checks the upstream actions of a scheduled start. Starts whose upstream
+// actions are pending wait in the buffer until their BackoffTime, and starts whose upstream
+// actions failed or timed out are skipped, pausing the schedule with the fail policy.
+func (s *scheduler) awaitDependencies(start *schedulespb.BufferedStart) enumsspb.ScheduleDependencyStatus {
+	if start.BackoffTime != nil && s.now().Before(start.BackoffTime.AsTime()) {
+		return enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING
+	}
+
+	status := enumsspb.SCHEDULE_DEPENDENCY_STATUS_SATISFIED
+	for _, dependency := range s.Dependencies {
+		res, err := s.checkDependency(start, dependency)
+		if err != nil {
+			// check again after the poll interval, the timeout still applies
+			s.logger.Error("Failed to check schedule dependency", "upstream-schedule-id", dependency.ScheduleId, "error", err)
+			res = &schedulespb.CheckDependencyResponse{Status: enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING}
+		}
+		deadline := DependencyDeadline(dependency, start.ActualTime.AsTime(), s.getCatchupWindow())
+		if res.Status == enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING && s.now().After(deadline) {
+			res.Status = enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED
+			res.Reason = fmt.Sprintf("timed out waiting for the action of schedule %q", dependency.ScheduleId)
+		}
+
+		switch res.Status {
+		case enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED:
+			s.skipForDependency(start, dependency, res.Reason)
+			return res.Status
+		case enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING:
+			status = res.Status
+		default:
+		}
+	}
+
+	if status == enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING {
+		start.BackoffTime = timestamppb.New(s.now().Add(s.tweakables.DependencyPollInterval))
+	}
+	return status
+}
+
+func (s *scheduler) checkDependency(
+	start *schedulespb.BufferedStart,
+	dependency *schedulespb.ScheduleDependency,
+) (*schedulespb.CheckDependencyResponse, error) {
+	options := defaultLocalActivityOptions
+	options.ScheduleToCloseTimeout = max(s.tweakables.DependencyPollInterval, options.StartToCloseTimeout)
+	ctx := workflow.WithLocalActivityOptions(s.ctx, options)
+	req := &schedulespb.CheckDependencyRequest{
+		Dependency:  dependency,
+		NominalTime: start.NominalTime,
+	}
+	var res schedulespb.CheckDependencyResponse
+	if err := workflow.ExecuteLocalActivity(ctx, s.a.CheckDependency, req).Get(s.ctx, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *scheduler) skipForDependency(
+	start *schedulespb.BufferedStart,
+	dependency *schedulespb.ScheduleDependency,
+	reason string,
+) {
+	s.logger.Warn("Skipping scheduled action after upstream schedule action failed",
+		"upstream-schedule-id", dependency.ScheduleId, "reason", reason)
+	s.metrics.Counter(metrics.ScheduleDependencySkipped.Name()).Inc(1)
+	s.recordSkippedAction(start.NominalTime, start.ActualTime, enumsspb.SCHEDULE_ACTION_SKIP_REASON_DEPENDENCY)
+	if dependency.Policy == enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL && !s.Schedule.State.Paused {
+		s.Schedule.State.Paused = true
+		s.Schedule.State.Notes = fmt.Sprintf(
+			"Paused because the action for %s was skipped: %s",
+			start.NominalTime.AsTime().Format(time.RFC3339),
+			reason,
+		)
+		s.incSeqNo()
+	}
+}
+
+// nextDependencyCheck returns the earlier of nextWakeup and the time at which a buffered start
+// checks its dependencies again.
+func (s *scheduler) nextDependencyCheck(nextWakeup time.Time) time.Time {
+	for _, start := range s.State.BufferedStarts {
+		if start.BackoffTime == nil {
+			continue
+		}
+		if check := start.BackoffTime.AsTime(); nextWakeup.IsZero() || check.Before(nextWakeup) {
+			nextWakeup = check
+		}
+	}
+	return nextWakeup
+}
+
 func (s *scheduler) recordAction(result *schedulepb.ScheduleActionResult, nonOverlapping bool) {
 	s.Info.ActionCount++
 	s.Info.RecentActions = util.SliceTail(append(s.Info.RecentActions, result), s.tweakables.RecentActionCount)
@@ -1388,7 +1560,7 @@ func (s *scheduler) identity() string {
 
 func (s *scheduler) jitterSeed() string {
 	if s.hasMinVersion(NewCacheAndJitter) {
-		return fmt.Sprintf("%s-%s", s.State.NamespaceId, s.State.ScheduleId)
+		return JitterSeed(s.State.NamespaceId, s.State.ScheduleId)
 	}
 	return ""
 }
@@ -1397,16 +1569,7 @@ func (s *scheduler) addSearchAttributes(
 	attributes *commonpb.SearchAttributes,
 	nominal time.Time,
 ) *commonpb.SearchAttributes {
-	fields := util.CloneMapNonNil(attributes.GetIndexedFields())
-	if p, err := payload.Encode(nominal); err == nil {
-		fields[searchattribute.TemporalScheduledStartTime] = p
-	}
-	if p, err := payload.Encode(s.State.ScheduleId); err == nil {
-		fields[searchattribute.TemporalScheduledById] = p
-	}
-	return &commonpb.SearchAttributes{
-		IndexedFields: fields,
-	}
+	return ScheduledSearchAttributes(attributes, s.State.ScheduleId, nominal)
 }
 
 func (s *scheduler) refreshWorkflows(executions []*commonpb.WorkflowExecution) {
@@ -19,6 +19,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -33,6 +34,8 @@ type (
 		suite.Suite
 		testsuite.WorkflowTestSuite
 		env *testsuite.TestWorkflowEnvironment
+
+		recordedSkippedActions []*schedulespb.SkippedAction
 	}
 )
 
@@ -45,7 +48,18 @@ func TestWorkflow(t *testing.T) {
 }
 
 func (s *workflowSuite) SetupTest() {
+	s.recordedSkippedActions = nil
+	s.newEnv()
+}
+
+// newEnv replaces the test environment, keeping the skipped actions recorded so far.
+func (s *workflowSuite) newEnv() {
 	s.env = s.NewTestWorkflowEnvironment()
+	s.env.OnActivity(new(activities).RecordSkippedActions, mock.Anything, mock.Anything).Maybe().Return(
+		func(_ context.Context, req *schedulespb.RecordSkippedActionsRequest) error {
+			s.recordedSkippedActions = append(s.recordedSkippedActions, req.SkippedActions...)
+			return nil
+		})
 }
 
 func (s *workflowSuite) AfterTest(suiteName, testName string) {
@@ -81,6 +95,10 @@ func (s *workflowSuite) defaultAction(id string) *schedulepb.ScheduleAction {
 }
 
 func (s *workflowSuite) run(sched *schedulepb.Schedule, iterations int) {
+	s.runWithDependencies(sched, nil, iterations)
+}
+
+func (s *workflowSuite) runWithDependencies(sched *schedulepb.Schedule, deps []*schedulespb.ScheduleDependency, iterations int) {
 	// test workflows will run until "completion", in our case that means until
 	// continue-as-new. we only need a small number of iterations to test, though.
 	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = iterations
@@ -101,6 +119,7 @@ func (s *workflowSuite) run(sched *schedulepb.Schedule, iterations int) {
 			ScheduleId:    "myschedule",
 			ConflictToken: InitialConflictToken,
 		},
+		Dependencies: deps,
 	})
 }
 
@@ -112,6 +131,10 @@ func (s *workflowSuite) describe() *schedulespb.DescribeResponse {
 	return &resp
 }
 
+func (s *workflowSuite) skippedActions() []*schedulespb.SkippedAction {
+	return s.recordedSkippedActions
+}
+
 func (s *workflowSuite) runningWorkflows() []string {
 	desc := s.describe()
 	var out []string
@@ -159,6 +182,13 @@ func (s *workflowSuite) expectTerminate(f func(req *schedulespb.TerminateWorkflo
 		})
 }
 
+func (s *workflowSuite) expectCheckDependency(f func(req *schedulespb.CheckDependencyRequest) *schedulespb.CheckDependencyResponse) *testsuite.MockCallWrapper {
+	return s.env.OnActivity(new(activities).CheckDependency, mock.Anything, mock.Anything).Once().Return(
+		func(_ context.Context, req *schedulespb.CheckDependencyRequest) (*schedulespb.CheckDependencyResponse, error) {
+			return f(req), nil
+		})
+}
+
 // High-level mock helpers. This is a small meta-test-framework: it runs a schedule across
 // multiple workflow executions with continue-as-new, breaking at a variety of points. To do
 // this it has to reinitialize the workflow test framework each time, since the framework only
@@ -271,11 +301,12 @@ func (s *workflowSuite) runAcrossContinue(
 			},
 		}
 		CurrentTweakablePolicies.IterationsBeforeContinueAsNew = every
+		s.recordedSkippedActions = nil
 		state := runAcrossContinueState{
 			started: make(map[string]time.Time),
 		}
 		for {
-			s.env = s.NewTestWorkflowEnvironment()
+			s.newEnv()
 			s.env.SetStartTime(startTime)
 
 			s.setupMocksForWorkflows(runs, &state)
@@ -424,6 +455,10 @@ func (s *workflowSuite) TestCatchupWindow() {
 	})
 	s.env.RegisterDelayedCallback(func() {
 		s.Equal(int64(5), s.describe().Info.MissedCatchupWindow)
+		skipped := s.skippedActions()
+		s.Len(skipped, 5)
+		s.Equal(time.Date(2022, 5, 31, 18, 17, 0, 0, time.UTC), skipped[0].NominalTime.AsTime())
+		s.Equal(enumsspb.SCHEDULE_ACTION_SKIP_REASON_CATCHUP_WINDOW, skipped[0].Reason)
 	}, 18*time.Minute)
 
 	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 2
@@ -523,6 +558,10 @@ func (s *workflowSuite) TestOverlapSkip() {
 				f: func() {
 					s.Equal(int64(1), s.describe().Info.OverlapSkipped)
 					s.Equal([]string{"myid-2022-06-01T00:05:00Z"}, s.runningWorkflows())
+					skipped := s.skippedActions()
+					s.Len(skipped, 1)
+					s.Equal(time.Date(2022, 6, 1, 0, 10, 0, 0, time.UTC), skipped[0].NominalTime.AsTime())
+					s.Equal(enumsspb.SCHEDULE_ACTION_SKIP_REASON_OVERLAP, skipped[0].Reason)
 				},
 			},
 			{
@@ -1112,6 +1151,107 @@ func (s *workflowSuite) TestPauseOnFailure() {
 	// doesn't end properly since it sleeps forever after pausing
 }
 
+func (s *workflowSuite) TestDependencyWait() {
+	// written using low-level mocks so we can control the upstream status
+
+	s.expectCheckDependency(func(req *schedulespb.CheckDependencyRequest) *schedulespb.CheckDependencyResponse {
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("upstream", req.Dependency.ScheduleId)
+		s.True(time.Date(2022, 6, 1, 0, 5, 0, 0, time.UTC).Equal(req.NominalTime.AsTime()))
+		return &schedulespb.CheckDependencyResponse{Status: enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING}
+	})
+	s.expectCheckDependency(func(req *schedulespb.CheckDependencyRequest) *schedulespb.CheckDependencyResponse {
+		s.True(time.Date(2022, 6, 1, 0, 5, 30, 0, time.UTC).Equal(s.now()))
+		return &schedulespb.CheckDependencyResponse{Status: enumsspb.SCHEDULE_DEPENDENCY_STATUS_SATISFIED}
+	})
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 1, 0, 5, 30, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-01T00:05:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		// the waiting start stays in the buffer and doesn't use up a remaining action
+		desc := s.describe()
+		s.Equal(int64(1), desc.Info.BufferSize)
+		s.Equal(int64(2), desc.Schedule.State.RemainingActions)
+	}, 5*time.Minute+10*time.Second)
+
+	s.runWithDependencies(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+		State: &schedulepb.ScheduleState{
+			LimitedActions:   true,
+			RemainingActions: 2,
+		},
+	}, []*schedulespb.ScheduleDependency{{ScheduleId: "upstream"}}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
+func (s *workflowSuite) TestDependencyFailed() {
+	// written using low-level mocks so we can control the upstream status
+
+	s.expectCheckDependency(func(req *schedulespb.CheckDependencyRequest) *schedulespb.CheckDependencyResponse {
+		return &schedulespb.CheckDependencyResponse{
+			Status: enumsspb.SCHEDULE_DEPENDENCY_STATUS_FAILED,
+			Reason: "upstream failed",
+		}
+	})
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Schedule.State.Paused)
+		s.Contains(desc.Schedule.State.Notes, "upstream failed")
+		s.Zero(desc.Info.BufferSize)
+		skipped := s.skippedActions()
+		s.Len(skipped, 1)
+		s.Equal(enumsspb.SCHEDULE_ACTION_SKIP_REASON_DEPENDENCY, skipped[0].Reason)
+	}, 6*time.Minute)
+
+	s.runWithDependencies(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, []*schedulespb.ScheduleDependency{{
+		ScheduleId: "upstream",
+		Policy:     enumsspb.SCHEDULE_DEPENDENCY_POLICY_FAIL,
+	}}, 2)
+	s.True(s.env.IsWorkflowCompleted())
+	// doesn't end properly since it sleeps forever after pausing
+}
+
+func (s *workflowSuite) TestDependencyTimeout() {
+	// written using low-level mocks so we can control the upstream status
+
+	s.env.OnActivity(new(activities).CheckDependency, mock.Anything, mock.Anything).Times(4).Return(
+		&schedulespb.CheckDependencyResponse{Status: enumsspb.SCHEDULE_DEPENDENCY_STATUS_PENDING}, nil)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Schedule.State.Paused)
+		s.Zero(desc.Info.BufferSize)
+		skipped := s.skippedActions()
+		s.Len(skipped, 1)
+		s.Equal(enumsspb.SCHEDULE_ACTION_SKIP_REASON_DEPENDENCY, skipped[0].Reason)
+	}, 7*time.Minute)
+
+	s.runWithDependencies(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(5 * time.Minute),
+			}},
+		},
+	}, []*schedulespb.ScheduleDependency{{
+		ScheduleId: "upstream",
+		Timeout:    durationpb.New(time.Minute),
+	}}, 5)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
+
 func (s *workflowSuite) TestCompileError() {
 	// written using low-level mocks since it sleeps forever
 
@@ -0,0 +1,352 @@
+package searchattributemigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/util"
+)
+
+type activities struct {
+	visibilityManager manager.VisibilityManager
+	saManager         searchattribute.Manager
+	// esClient is nil if Elasticsearch is not configured.
+	esClient       esclient.Client
+	frontendClient workflowservice.WorkflowServiceClient
+	logger         log.Logger
+}
+
+// prepare resolves the current type of the search attribute and the source and target field in every visibility
+// store. The result only has Type and Stores set.
+func (a *activities) prepare(ctx context.Context, params WorkflowParams) (Progress, error) {
+	var aliases map[string]string
+	var result Progress
+	for _, store := range storeManagers(a.visibilityManager) {
+		storeName := store.GetStoreNames()[0]
+		indexName := store.GetIndexName()
+		saTypes, err := a.saManager.GetSearchAttributes(indexName, true)
+		if err != nil {
+			return Progress{}, err
+		}
+
+		var plan StorePlan
+		var saType enumspb.IndexedValueType
+		if storeName == elasticsearch.PersistenceName {
+			plan, saType, err = prepareElasticsearch(params, saTypes)
+		} else {
+			if aliases == nil {
+				if aliases, err = a.getAliases(ctx, params); err != nil {
+					return Progress{}, err
+				}
+			}
+			plan, saType, err = prepareSQL(params, saTypes, aliases)
+		}
+		if err != nil {
+			return Progress{}, newInvalidRequestError(err)
+		}
+		plan.StoreName = storeName
+		plan.IndexName = indexName
+
+		if result.Type != enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED && result.Type != saType {
+			return Progress{}, newInvalidRequestError(fmt.Errorf(
+				"search attribute %s has type %v in one visibility store and %v in another", params.Name, result.Type, saType))
+		}
+		result.Type = saType
+		result.Stores = append(result.Stores, plan)
+	}
+
+	newType := params.NewType
+	if newType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		newType = result.Type
+	}
+	if err := searchattribute.ValidateTypeChange(result.Type, newType); err != nil {
+		return Progress{}, newInvalidRequestError(err)
+	}
+	if newType == result.Type && (params.NewName == "" || params.NewName == params.Name) {
+		return Progress{}, newInvalidRequestError(ErrNothingToMigrate)
+	}
+	return result, nil
+}
+
+// prepareElasticsearch plans the migration for a store without aliases. Values are always copied to a new field
+// because the type of an Elasticsearch field can't change.
+func prepareElasticsearch(
+	params WorkflowParams,
+	saTypes searchattribute.NameTypeMap,
+) (StorePlan, enumspb.IndexedValueType, error) {
+	saType, ok := saTypes.Custom()[params.Name]
+	if !ok {
+		return StorePlan{}, 0, fmt.Errorf("custom search attribute %s not found", params.Name)
+	}
+	if params.NewName == "" || params.NewName == params.Name {
+		return StorePlan{}, 0, errors.New("NewName is required because the type of an Elasticsearch field can't change")
+	}
+	if saTypes.IsDefined(params.NewName) {
+		return StorePlan{}, 0, fmt.Errorf("search attribute %s already exists", params.NewName)
+	}
+	return StorePlan{
+		SourceField: params.Name,
+		TargetField: params.NewName,
+	}, saType, nil
+}
+
+// prepareSQL plans the migration for a store whose custom search attributes are pre-allocated fields aliased per
+// namespace. Values are copied to the first free field of the new type. If the type doesn't change only the alias
+// is renamed.
+func prepareSQL(
+	params WorkflowParams,
+	saTypes searchattribute.NameTypeMap,
+	aliases map[string]string,
+) (StorePlan, enumspb.IndexedValueType, error) {
+	aliasToField := util.InverseMap(aliases)
+	sourceField, ok := aliasToField[params.Name]
+	if !ok {
+		return StorePlan{}, 0, fmt.Errorf("custom search attribute %s not found in namespace %s", params.Name, params.Namespace)
+	}
+	saType, ok := saTypes.Custom()[sourceField]
+	if !ok {
+		return StorePlan{}, 0, fmt.Errorf("field %s of search attribute %s not found", sourceField, params.Name)
+	}
+	alias := params.Name
+	if params.NewName != "" && params.NewName != params.Name {
+		alias = params.NewName
+		if _, ok := aliasToField[alias]; ok || saTypes.IsDefined(alias) {
+			return StorePlan{}, 0, fmt.Errorf("search attribute %s already exists", alias)
+		}
+	}
+
+	plan := StorePlan{
+		AliasMode:   true,
+		SourceField: sourceField,
+		TargetField: sourceField,
+		Alias:       alias,
+	}
+	if params.NewType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED || params.NewType == saType {
+		return plan, saType, nil
+	}
+
+	remappedFields := saTypes.RemappedFields(params.NamespaceID)
+	dbCustomSearchAttributes := searchattribute.GetSqlDbIndexSearchAttributes().CustomSearchAttributes
+	for _, fieldName := range slices.Sorted(maps.Keys(dbCustomSearchAttributes)) {
+		if dbCustomSearchAttributes[fieldName] != params.NewType {
+			continue
+		}
+		// make sure the pre-allocated custom search attributes are created in cluster metadata
+		if _, ok := saTypes.Custom()[fieldName]; !ok {
+			continue
+		}
+		if _, ok := aliases[fieldName]; ok {
+			continue
+		}
+		if _, ok := remappedFields[fieldName]; ok {
+			continue
+		}
+		plan.TargetField = fieldName
+		return plan, saType, nil
+	}
+	return StorePlan{}, 0, fmt.Errorf("no free custom search attribute field of type %v left in namespace %s", params.NewType, params.Namespace)
+}
+
+// migratePage validates or copies the values of one page of executions of a store.
+func (a *activities) migratePage(ctx context.Context, request PageRequest) (PageResponse, error) {
+	store := findStore(storeManagers(a.visibilityManager), request.Plan.StoreName)
+	if store == nil {
+		return PageResponse{}, newInvalidRequestError(fmt.Errorf("unknown visibility store %q", request.Plan.StoreName))
+	}
+
+	resp, err := store.MigrateSearchAttributeValues(ctx, &manager.MigrateSearchAttributeValuesRequest{
+		StoreName:     request.Plan.StoreName,
+		NamespaceID:   namespace.ID(planNamespaceID(request.Plan, request.NamespaceID)),
+		Namespace:     namespace.Name(request.Namespace),
+		SourceField:   request.Plan.SourceField,
+		SourceType:    request.SourceType,
+		TargetField:   request.Plan.TargetField,
+		TargetType:    request.TargetType,
+		DryRun:        request.DryRun,
+		PageSize:      request.PageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	if errors.As(err, &invalidArgument) {
+		return PageResponse{}, newInvalidRequestError(err)
+	} else if err != nil {
+		return PageResponse{}, err
+	}
+
+	invalidValues := make([]InvalidValue, 0, len(resp.InvalidValues))
+	for _, value := range resp.InvalidValues {
+		invalidValues = append(invalidValues, InvalidValue{
+			WorkflowID: value.WorkflowID,
+			RunID:      value.RunID,
+			Value:      fmt.Sprint(value.Value),
+			Error:      value.Error,
+		})
+	}
+	return PageResponse{
+		Processed:     resp.Processed,
+		InvalidValues: invalidValues,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// install adds the target field if needed and the field remap from the source to the target field. It is idempotent.
+func (a *activities) install(ctx context.Context, request StoreRequest) error {
+	plan := request.Plan
+	if plan.SourceField == plan.TargetField {
+		return nil
+	}
+
+	if !plan.AliasMode {
+		saTypes, err := a.saManager.GetSearchAttributes(plan.IndexName, true)
+		if err != nil {
+			return err
+		}
+		if _, ok := saTypes.Custom()[plan.TargetField]; !ok {
+			if a.esClient == nil {
+				return newInvalidRequestError(errors.New("Elasticsearch client is not configured"))
+			}
+			newField := map[string]enumspb.IndexedValueType{plan.TargetField: request.TargetType}
+			if _, err := a.esClient.PutMapping(ctx, plan.IndexName, newField); err != nil {
+				return err
+			}
+			customSearchAttributes := util.CloneMapNonNil(saTypes.Custom())
+			maps.Copy(customSearchAttributes, newField)
+			if err := a.saManager.SaveSearchAttributes(ctx, plan.IndexName, customSearchAttributes); err != nil {
+				return err
+			}
+		}
+	}
+
+	err := a.saManager.SaveFieldRemap(ctx, plan.IndexName, searchattribute.FieldRemap{
+		NamespaceID: planNamespaceID(plan, request.NamespaceID),
+		SourceField: plan.SourceField,
+		SourceType:  request.SourceType,
+		TargetField: plan.TargetField,
+	})
+	if err != nil {
+		return err
+	}
+	a.logger.Info("Search attribute field remap installed.",
+		tag.ESIndex(plan.IndexName),
+		tag.WorkflowNamespace(request.Namespace),
+		tag.NewStringTag("source-field", plan.SourceField),
+		tag.NewStringTag("target-field", plan.TargetField),
+	)
+	return nil
+}
+
+// finish points the search attribute name to the target field. In AliasMode the alias moves from the source to the
+// target field, otherwise the old search attribute is removed from cluster metadata. The field remap is kept, so that
+// values of the old field that are still in mutable state keep ending up in the target field. It is idempotent.
+func (a *activities) finish(ctx context.Context, request StoreRequest) error {
+	plan := request.Plan
+	if !plan.AliasMode {
+		saTypes, err := a.saManager.GetSearchAttributes(plan.IndexName, true)
+		if err != nil {
+			return err
+		}
+		if _, ok := saTypes.Custom()[plan.SourceField]; !ok {
+			return nil
+		}
+		customSearchAttributes := util.CloneMapNonNil(saTypes.Custom())
+		delete(customSearchAttributes, plan.SourceField)
+		return a.saManager.SaveSearchAttributes(ctx, plan.IndexName, customSearchAttributes)
+	}
+
+	aliases, err := a.getAliases(ctx, WorkflowParams{NamespaceID: request.NamespaceID})
+	if err != nil {
+		return err
+	}
+	if plan.SourceField == plan.TargetField {
+		// An alias of a field can't be changed in a single update, it has to be removed first.
+		if aliases[plan.SourceField] == request.Name && plan.Alias != request.Name {
+			if err := a.updateAliases(ctx, request.Namespace, map[string]string{plan.SourceField: ""}); err != nil {
+				return err
+			}
+			delete(aliases, plan.SourceField)
+		}
+		if _, ok := aliases[plan.SourceField]; !ok {
+			return a.updateAliases(ctx, request.Namespace, map[string]string{plan.SourceField: plan.Alias})
+		}
+		return nil
+	}
+
+	upsert := make(map[string]string)
+	if aliases[plan.SourceField] == request.Name {
+		upsert[plan.SourceField] = ""
+	}
+	if _, ok := aliases[plan.TargetField]; !ok {
+		upsert[plan.TargetField] = plan.Alias
+	}
+	if len(upsert) == 0 {
+		return nil
+	}
+	return a.updateAliases(ctx, request.Namespace, upsert)
+}
+
+func (a *activities) getAliases(ctx context.Context, params WorkflowParams) (map[string]string, error) {
+	if params.NamespaceID == "" {
+		return nil, newInvalidRequestError(errors.New("namespace is required because custom search attributes are aliased per namespace"))
+	}
+	resp, err := a.frontendClient.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Id: params.NamespaceID})
+	if err != nil {
+		return nil, err
+	}
+	return util.CloneMapNonNil(resp.GetConfig().GetCustomSearchAttributeAliases()), nil
+}
+
+func (a *activities) updateAliases(ctx context.Context, nsName string, upsert map[string]string) error {
+	_, err := a.frontendClient.UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+		Namespace: nsName,
+		Config: &namespacepb.NamespaceConfig{
+			CustomSearchAttributeAliases: upsert,
+		},
+	})
+	return err
+}
+
+// planNamespaceID returns the namespace the store is migrated for. Stores without aliases define custom search
+// attributes for the whole cluster, so they are migrated for all namespaces.
+func planNamespaceID(plan StorePlan, namespaceID string) string {
+	if !plan.AliasMode {
+		return ""
+	}
+	return namespaceID
+}
+
+func newInvalidRequestError(err error) error {
+	return temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+}
+
+// storeManagers returns the managers of the primary and, if configured, the secondary visibility store.
+func storeManagers(m manager.VisibilityManager) []manager.VisibilityManager {
+	if dual, ok := m.(*visibility.VisibilityManagerDual); ok {
+		return []manager.VisibilityManager{dual.GetPrimaryVisibility(), dual.GetSecondaryVisibility()}
+	}
+	return []manager.VisibilityManager{m}
+}
+
+func findStore(stores []manager.VisibilityManager, storeName string) manager.VisibilityManager {
+	for _, store := range stores {
+		if store.HasStoreName(storeName) {
+			return store
+		}
+	}
+	return nil
+}
@@ -0,0 +1,393 @@
+package searchattributemigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+const testIndexName = "my-index"
+
+// fakeFrontendClient serves and updates the custom search attribute aliases of a single namespace.
+type fakeFrontendClient struct {
+	workflowservice.WorkflowServiceClient
+	aliases      map[string]string
+	aliasUpdates []map[string]string
+}
+
+func (c *fakeFrontendClient) DescribeNamespace(
+	_ context.Context, _ *workflowservice.DescribeNamespaceRequest, _ ...grpc.CallOption,
+) (*workflowservice.DescribeNamespaceResponse, error) {
+	aliases := make(map[string]string, len(c.aliases))
+	for field, alias := range c.aliases {
+		aliases[field] = alias
+	}
+	return &workflowservice.DescribeNamespaceResponse{
+		Config: &namespacepb.NamespaceConfig{CustomSearchAttributeAliases: aliases},
+	}, nil
+}
+
+func (c *fakeFrontendClient) UpdateNamespace(
+	_ context.Context, req *workflowservice.UpdateNamespaceRequest, _ ...grpc.CallOption,
+) (*workflowservice.UpdateNamespaceResponse, error) {
+	upsert := req.GetConfig().GetCustomSearchAttributeAliases()
+	c.aliasUpdates = append(c.aliasUpdates, upsert)
+	for field, alias := range upsert {
+		if alias == "" {
+			delete(c.aliases, field)
+		} else {
+			c.aliases[field] = alias
+		}
+	}
+	return &workflowservice.UpdateNamespaceResponse{}, nil
+}
+
+// fakeSearchAttributeManager serves and saves the custom search attributes of a single index.
+type fakeSearchAttributeManager struct {
+	searchattribute.Manager
+	custom      map[string]enumspb.IndexedValueType
+	savedCustom []map[string]enumspb.IndexedValueType
+	savedRemaps []searchattribute.FieldRemap
+}
+
+func (m *fakeSearchAttributeManager) GetSearchAttributes(string, bool) (searchattribute.NameTypeMap, error) {
+	return searchattribute.NewNameTypeMapStub(m.custom), nil
+}
+
+func (m *fakeSearchAttributeManager) SaveSearchAttributes(
+	_ context.Context, _ string, custom map[string]enumspb.IndexedValueType,
+) error {
+	m.savedCustom = append(m.savedCustom, custom)
+	m.custom = custom
+	return nil
+}
+
+func (m *fakeSearchAttributeManager) SaveFieldRemap(_ context.Context, _ string, remap searchattribute.FieldRemap) error {
+	m.savedRemaps = append(m.savedRemaps, remap)
+	return nil
+}
+
+func TestPrepare(t *testing.T) {
+	t.Parallel()
+
+	sqlSearchAttributes := map[string]enumspb.IndexedValueType{
+		"Keyword01": enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+		"Bool01":    enumspb.INDEXED_VALUE_TYPE_BOOL,
+		"Int01":     enumspb.INDEXED_VALUE_TYPE_INT,
+		"Int02":     enumspb.INDEXED_VALUE_TYPE_INT,
+	}
+	esSearchAttributes := map[string]enumspb.IndexedValueType{"MyAttribute": enumspb.INDEXED_VALUE_TYPE_KEYWORD}
+	for _, tc := range []struct {
+		name      string
+		storeName string
+		custom    map[string]enumspb.IndexedValueType
+		// aliases are added to the default aliases of the namespace
+		aliases     map[string]string
+		modify      func(params *WorkflowParams)
+		expected    StorePlan
+		expectedErr string
+	}{
+		{
+			name:      "sql_type_change",
+			storeName: "mysql",
+			custom:    sqlSearchAttributes,
+			// Int01 is taken, so the value is copied to the next free field of the new type
+			expected: StorePlan{AliasMode: true, SourceField: "Keyword01", TargetField: "Int02", Alias: "MyAttribute"},
+		},
+		{
+			name:      "sql_rename",
+			storeName: "mysql",
+			custom:    sqlSearchAttributes,
+			modify: func(p *WorkflowParams) {
+				p.NewType = enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED
+				p.NewName = "MyRenamedAttribute"
+			},
+			expected: StorePlan{AliasMode: true, SourceField: "Keyword01", TargetField: "Keyword01", Alias: "MyRenamedAttribute"},
+		},
+		{
+			name:        "sql_no_free_field",
+			storeName:   "mysql",
+			custom:      sqlSearchAttributes,
+			aliases:     map[string]string{"Int02": "YetAnotherAttribute"},
+			expectedErr: "no free custom search attribute field",
+		},
+		{
+			name:        "sql_unknown_search_attribute",
+			storeName:   "mysql",
+			custom:      sqlSearchAttributes,
+			modify:      func(p *WorkflowParams) { p.Name = "UnknownAttribute" },
+			expectedErr: "UnknownAttribute not found",
+		},
+		{
+			name:        "sql_invalid_type_change",
+			storeName:   "mysql",
+			custom:      sqlSearchAttributes,
+			aliases:     map[string]string{"Bool01": "MyBoolAttribute"},
+			modify:      func(p *WorkflowParams) { p.Name = "MyBoolAttribute" },
+			expectedErr: searchattribute.ErrInvalidType.Error(),
+		},
+		{
+			name:      "sql_same_type",
+			storeName: "mysql",
+			custom:    sqlSearchAttributes,
+			modify: func(p *WorkflowParams) {
+				p.NewType = enumspb.INDEXED_VALUE_TYPE_KEYWORD
+			},
+			expectedErr: ErrNothingToMigrate.Error(),
+		},
+		{
+			name:      "elasticsearch",
+			storeName: elasticsearch.PersistenceName,
+			custom:    esSearchAttributes,
+			modify: func(p *WorkflowParams) {
+				p.NewType = enumspb.INDEXED_VALUE_TYPE_TEXT
+				p.NewName = "MyTextAttribute"
+			},
+			expected: StorePlan{SourceField: "MyAttribute", TargetField: "MyTextAttribute"},
+		},
+		{
+			name:        "elasticsearch_without_new_name",
+			storeName:   elasticsearch.PersistenceName,
+			custom:      esSearchAttributes,
+			expectedErr: "NewName is required",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			aliases := map[string]string{"Keyword01": "MyAttribute", "Int01": "OtherAttribute"}
+			for field, alias := range tc.aliases {
+				aliases[field] = alias
+			}
+			a := &activities{
+				visibilityManager: newTestStore(gomock.NewController(t), tc.storeName),
+				saManager:         &fakeSearchAttributeManager{custom: tc.custom},
+				frontendClient:    &fakeFrontendClient{aliases: aliases},
+			}
+			params := testWorkflowParams()
+			if tc.modify != nil {
+				tc.modify(&params)
+			}
+
+			progress, err := a.prepare(context.Background(), params)
+			if tc.expectedErr != "" {
+				var applicationErr *temporal.ApplicationError
+				require.ErrorAs(t, err, &applicationErr)
+				assert.True(t, applicationErr.NonRetryable())
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			tc.expected.StoreName = tc.storeName
+			tc.expected.IndexName = testIndexName
+			assert.Equal(t, Progress{Type: enumspb.INDEXED_VALUE_TYPE_KEYWORD, Stores: []StorePlan{tc.expected}}, progress)
+		})
+	}
+}
+
+func TestMigratePage(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		plan     StorePlan
+		storeErr error
+		// expectedNS is the namespace ID passed to the store
+		expectedNS  string
+		expectedErr bool
+	}{
+		{
+			name:       "alias_mode",
+			plan:       StorePlan{StoreName: "mysql", AliasMode: true, SourceField: "Keyword01", TargetField: "Int02"},
+			expectedNS: "my-namespace-id",
+		},
+		{
+			// custom search attributes of Elasticsearch are defined for all namespaces
+			name: "elasticsearch",
+			plan: StorePlan{StoreName: elasticsearch.PersistenceName, SourceField: "MyAttribute", TargetField: "MyTextAttribute"},
+		},
+		{
+			name:        "invalid_argument",
+			plan:        StorePlan{StoreName: "mysql", AliasMode: true, SourceField: "Keyword01", TargetField: "Int02"},
+			storeErr:    serviceerror.NewInvalidArgument("invalid page token"),
+			expectedNS:  "my-namespace-id",
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := newTestStore(gomock.NewController(t), tc.plan.StoreName)
+			store.EXPECT().MigrateSearchAttributeValues(gomock.Any(), &manager.MigrateSearchAttributeValuesRequest{
+				StoreName:     tc.plan.StoreName,
+				NamespaceID:   namespace.ID(tc.expectedNS),
+				Namespace:     "my-namespace",
+				SourceField:   tc.plan.SourceField,
+				SourceType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetField:   tc.plan.TargetField,
+				TargetType:    enumspb.INDEXED_VALUE_TYPE_INT,
+				DryRun:        true,
+				PageSize:      DefaultPageSize,
+				NextPageToken: []byte{1},
+			}).DoAndReturn(func(
+				context.Context, *manager.MigrateSearchAttributeValuesRequest,
+			) (*manager.MigrateSearchAttributeValuesResponse, error) {
+				if tc.storeErr != nil {
+					return nil, tc.storeErr
+				}
+				return &manager.MigrateSearchAttributeValuesResponse{
+					Processed: 2,
+					InvalidValues: []manager.InvalidSearchAttributeValue{
+						{WorkflowID: "workflow-id", RunID: "run-id", Value: "abc", Error: "invalid syntax"},
+					},
+					NextPageToken: []byte{42},
+				}, nil
+			}).Times(1)
+			a := &activities{visibilityManager: store}
+
+			resp, err := a.migratePage(context.Background(), PageRequest{
+				NamespaceID:   "my-namespace-id",
+				Namespace:     "my-namespace",
+				Plan:          tc.plan,
+				SourceType:    enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetType:    enumspb.INDEXED_VALUE_TYPE_INT,
+				DryRun:        true,
+				PageSize:      DefaultPageSize,
+				NextPageToken: []byte{1},
+			})
+			if tc.expectedErr {
+				var applicationErr *temporal.ApplicationError
+				require.ErrorAs(t, err, &applicationErr)
+				assert.True(t, applicationErr.NonRetryable())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, PageResponse{
+				Processed:     2,
+				InvalidValues: []InvalidValue{{WorkflowID: "workflow-id", RunID: "run-id", Value: "abc", Error: "invalid syntax"}},
+				NextPageToken: []byte{42},
+			}, resp)
+		})
+	}
+}
+
+func TestInstallAndFinish(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		plan   StorePlan
+		custom map[string]enumspb.IndexedValueType
+		// expectedRemap is saved by every install, the other fields list the calls of install followed by finish
+		expectedRemap        *searchattribute.FieldRemap
+		expectedMappings     []map[string]enumspb.IndexedValueType
+		expectedCustom       []map[string]enumspb.IndexedValueType
+		expectedAliasUpdates []map[string]string
+	}{
+		{
+			name: "sql_type_change",
+			plan: StorePlan{AliasMode: true, SourceField: "Keyword01", TargetField: "Int02", Alias: "MyAttribute"},
+			expectedRemap: &searchattribute.FieldRemap{
+				NamespaceID: "my-namespace-id",
+				SourceField: "Keyword01",
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetField: "Int02",
+			},
+			expectedAliasUpdates: []map[string]string{{"Keyword01": "", "Int02": "MyAttribute"}},
+		},
+		{
+			name: "sql_rename",
+			plan: StorePlan{AliasMode: true, SourceField: "Keyword01", TargetField: "Keyword01", Alias: "MyRenamedAttribute"},
+			// the alias of a field has to be removed before it can be set again
+			expectedAliasUpdates: []map[string]string{{"Keyword01": ""}, {"Keyword01": "MyRenamedAttribute"}},
+		},
+		{
+			name:   "elasticsearch",
+			plan:   StorePlan{SourceField: "MyAttribute", TargetField: "MyTextAttribute"},
+			custom: map[string]enumspb.IndexedValueType{"MyAttribute": enumspb.INDEXED_VALUE_TYPE_KEYWORD},
+			expectedRemap: &searchattribute.FieldRemap{
+				SourceField: "MyAttribute",
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetField: "MyTextAttribute",
+			},
+			expectedMappings: []map[string]enumspb.IndexedValueType{{"MyTextAttribute": enumspb.INDEXED_VALUE_TYPE_INT}},
+			expectedCustom: []map[string]enumspb.IndexedValueType{
+				{"MyAttribute": enumspb.INDEXED_VALUE_TYPE_KEYWORD, "MyTextAttribute": enumspb.INDEXED_VALUE_TYPE_INT},
+				{"MyTextAttribute": enumspb.INDEXED_VALUE_TYPE_INT},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mappings []map[string]enumspb.IndexedValueType
+			esClient := esclient.NewMockClient(gomock.NewController(t))
+			esClient.EXPECT().PutMapping(gomock.Any(), testIndexName, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
+					mappings = append(mappings, mapping)
+					return true, nil
+				},
+			).AnyTimes()
+			saManager := &fakeSearchAttributeManager{custom: tc.custom}
+			frontendClient := &fakeFrontendClient{aliases: map[string]string{"Keyword01": "MyAttribute"}}
+			a := &activities{
+				saManager:      saManager,
+				esClient:       esClient,
+				frontendClient: frontendClient,
+				logger:         log.NewNoopLogger(),
+			}
+			tc.plan.IndexName = testIndexName
+			request := StoreRequest{
+				NamespaceID: "my-namespace-id",
+				Namespace:   "my-namespace",
+				Name:        "MyAttribute",
+				Plan:        tc.plan,
+				SourceType:  enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+				TargetType:  enumspb.INDEXED_VALUE_TYPE_INT,
+			}
+
+			// both activities are retried, so running them twice must not change anything
+			for range 2 {
+				require.NoError(t, a.install(context.Background(), request))
+			}
+			for range 2 {
+				require.NoError(t, a.finish(context.Background(), request))
+			}
+			if tc.expectedRemap != nil {
+				assert.Equal(t, []searchattribute.FieldRemap{*tc.expectedRemap, *tc.expectedRemap}, saManager.savedRemaps)
+			} else {
+				assert.Empty(t, saManager.savedRemaps)
+			}
+			assert.Equal(t, tc.expectedMappings, mappings)
+			assert.Equal(t, tc.expectedCustom, saManager.savedCustom)
+			assert.Equal(t, tc.expectedAliasUpdates, frontendClient.aliasUpdates)
+		})
+	}
+}
+
+// newTestStore returns a visibility store with the given name, whose custom search attributes are defined in
+// testIndexName.
+func newTestStore(ctrl *gomock.Controller, storeName string) *manager.MockVisibilityManager {
+	store := manager.NewMockVisibilityManager(ctrl)
+	store.EXPECT().GetStoreNames().Return([]string{storeName}).AnyTimes()
+	store.EXPECT().GetIndexName().Return(testIndexName).AnyTimes()
+	store.EXPECT().HasStoreName(gomock.Any()).DoAndReturn(func(name string) bool {
+		return name == storeName
+	}).AnyTimes()
+	return store
+}
@@ -0,0 +1,73 @@
+package searchattributemigration
+
+import (
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	esclient "go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	componentParams struct {
+		fx.In
+		VisibilityManager      manager.VisibilityManager
+		SearchAttributeManager searchattribute.Manager
+		EsClient               esclient.Client
+		FrontendClient         workflowservice.WorkflowServiceClient
+		Logger                 log.Logger
+	}
+
+	component struct {
+		activities *activities
+	}
+)
+
+// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph.
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	return &component{
+		activities: &activities{
+			visibilityManager: params.VisibilityManager,
+			saManager:         params.SearchAttributeManager,
+			esClient:          params.EsClient,
+			frontendClient:    params.FrontendClient,
+			logger:            params.Logger,
+		},
+	}
+}
+
+func (c *component) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (c *component) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (c *component) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivityWithOptions(c.activities.prepare, activity.RegisterOptions{
+		Name: prepareActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.migratePage, activity.RegisterOptions{
+		Name: migratePageActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.install, activity.RegisterOptions{
+		Name: installActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.finish, activity.RegisterOptions{
+		Name: finishActivityName,
+	})
+}
+
+func (c *component) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
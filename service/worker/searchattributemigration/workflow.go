@@ -0,0 +1,385 @@
+// Package searchattributemigration contains the system workflow that changes the type or the name of a custom search
+// attribute. Since neither SQL columns nor Elasticsearch fields can change their type in place, the values are copied
+// to a new field and converted to the new type on the way. A field remap in cluster metadata makes sure that values
+// written under the old field while the migration runs end up in the new field as well.
+package searchattributemigration
+
+import (
+	"errors"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// WorkflowParams is the single argument to the search attribute migration workflow.
+	WorkflowParams struct {
+		// NamespaceID and Namespace identify the namespace whose search attribute aliases are migrated. They are
+		// required if custom search attributes are aliased per namespace and ignored by Elasticsearch, where custom
+		// search attributes are defined for the whole cluster.
+		NamespaceID string
+		Namespace   string
+		// Name is the name of the custom search attribute to migrate.
+		Name string
+		// NewName is the name of the search attribute after the migration. Empty keeps the current name, which is only
+		// possible if custom search attributes are aliased per namespace.
+		NewName string
+		// NewType is the type of the search attribute after the migration. Unspecified keeps the current type.
+		NewType enumspb.IndexedValueType
+		// SkipInvalidValues drops values that can't be converted to NewType instead of failing the migration.
+		SkipInvalidValues bool
+		// DryRun stops after validating that the existing values can be converted.
+		DryRun bool
+		// PageSize controls the number of executions read at a time. The maximum is MaxPageSize. The default is
+		// DefaultPageSize.
+		PageSize int
+		// Reason is only used for logging.
+		Reason string
+
+		// Progress and NextPageToken carry the state of the migration over ContinueAsNew.
+		Progress      Progress
+		NextPageToken []byte
+	}
+
+	// Progress is the response to QueryTypeProgress and the result of the workflow.
+	Progress struct {
+		// Namespace, Name, NewName and NewType repeat the parameters of the workflow.
+		Namespace string
+		Name      string
+		NewName   string
+		NewType   enumspb.IndexedValueType
+
+		Phase string
+		// Type is the type of the search attribute before the migration. It is unspecified until the workflow has
+		// validated its parameters.
+		Type enumspb.IndexedValueType
+		// Stores holds one plan for every configured visibility store.
+		Stores []StorePlan
+
+		StoresValidated     int
+		ExecutionsValidated int64
+		InvalidValues       int64
+		// InvalidValueSamples holds the first MaxInvalidValueSamples values that can't be converted.
+		InvalidValueSamples []InvalidValue
+
+		StoresMigrated     int
+		ExecutionsMigrated int64
+	}
+
+	// StorePlan describes how the search attribute is migrated in a single visibility store.
+	StorePlan struct {
+		StoreName string
+		IndexName string
+		// AliasMode is set if custom search attributes of the store are pre-allocated fields that are aliased per
+		// namespace.
+		AliasMode   bool
+		SourceField string
+		TargetField string
+		// Alias is the name of the search attribute after the migration. Only used in AliasMode.
+		Alias string
+	}
+
+	// InvalidValue describes a value that can't be converted to the new type.
+	InvalidValue struct {
+		WorkflowID string
+		RunID      string
+		Value      string
+		Error      string
+	}
+
+	// PageRequest is the argument of the activities that validate or migrate one page of executions of a store.
+	PageRequest struct {
+		NamespaceID   string
+		Namespace     string
+		Plan          StorePlan
+		SourceType    enumspb.IndexedValueType
+		TargetType    enumspb.IndexedValueType
+		DryRun        bool
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// PageResponse is the result of the activities that validate or migrate one page of executions.
+	PageResponse struct {
+		Processed     int64
+		InvalidValues []InvalidValue
+		NextPageToken []byte
+	}
+
+	// StoreRequest is the argument of the activities that install the field remap of a store and finish the migration.
+	StoreRequest struct {
+		NamespaceID string
+		Namespace   string
+		Name        string
+		Plan        StorePlan
+		SourceType  enumspb.IndexedValueType
+		TargetType  enumspb.IndexedValueType
+	}
+)
+
+const (
+	// WorkflowName is the name of the search attribute migration workflow.
+	WorkflowName = "temporal-sys-search-attribute-migration-workflow"
+	// WorkflowID is the ID of the search attribute migration workflow. Using a fixed ID guarantees that only one
+	// migration runs at a time, so that two migrations can't pick the same free field.
+	WorkflowID = WorkflowName
+	// MaxPageSize is the maximum value for WorkflowParams.PageSize.
+	MaxPageSize = 1000
+	// DefaultPageSize is the default value for WorkflowParams.PageSize.
+	DefaultPageSize = 100
+	// MaxInvalidValueSamples is the maximum number of values kept in Progress.InvalidValueSamples.
+	MaxInvalidValueSamples = 100
+	// QueryTypeProgress is the query to get the Progress of the workflow.
+	QueryTypeProgress = "search-attribute-migration-progress-query"
+
+	PhasePreparing  = "Preparing"
+	PhaseValidating = "Validating"
+	PhaseInstalling = "Installing"
+	PhaseMigrating  = "Migrating"
+	PhaseFinishing  = "Finishing"
+	PhaseCompleted  = "Completed"
+
+	errorTypeInvalidRequest = "search-attribute-migration-error-type-invalid-request"
+	errorTypeInvalidValues  = "search-attribute-migration-error-type-invalid-values"
+	prepareActivityName     = "search-attribute-migration-prepare-activity"
+	migratePageActivityName = "search-attribute-migration-migrate-page-activity"
+	installActivityName     = "search-attribute-migration-install-activity"
+	finishActivityName      = "search-attribute-migration-finish-activity"
+
+	// activityTimeout is short because every activity only processes a single page of executions.
+	activityTimeout = 30 * time.Second * debug.TimeoutMultiplier
+	// cacheRefreshWait is how long the workflow waits after installing the field remaps until all hosts picked them
+	// up. It is twice the refresh interval of the search attribute and namespace caches.
+	cacheRefreshWait = 2 * time.Minute
+)
+
+var (
+	ErrNameNotSet        = errors.New("Name is not set")
+	ErrNegativePageSize  = errors.New("PageSize must be positive or 0 to use the default")
+	ErrPageSizeTooLarge  = errors.New("PageSize too large")
+	ErrInvalidNewType    = errors.New("NewType is not a valid search attribute type")
+	ErrNothingToMigrate  = errors.New("NewName or NewType must be different from the current name and type")
+	ErrInvalidValuesLeft = errors.New("some values can't be converted to the new type, set SkipInvalidValues to drop them")
+
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+	}
+)
+
+// Workflow migrates a custom search attribute in five phases:
+//  1. prepare resolves the current type of the search attribute and picks the target field in every store,
+//  2. validate walks all values in dry run mode and fails if some of them can't be converted,
+//  3. install adds the target field and a field remap from the source to the target field, and waits until all hosts
+//     picked them up, so that new writes also go to the target field,
+//  4. migrate copies the existing values to the target field,
+//  5. finish points the search attribute name to the target field.
+//
+// Until the last phase queries keep using the source field, so the search attribute keeps working during the
+// migration. Every phase can be resumed after ContinueAsNew.
+func Workflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	logger := workflow.GetLogger(ctx)
+	params, err := parseParams(params)
+	if err != nil {
+		return params.Progress, err
+	}
+	progress := &params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return *progress, nil
+	}); err != nil {
+		return *progress, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: activityTimeout,
+		RetryPolicy:         activityRetryPolicy,
+	})
+
+	if progress.Phase == PhasePreparing {
+		var response Progress
+		if err := workflow.ExecuteActivity(ctx, prepareActivityName, params).Get(ctx, &response); err != nil {
+			return *progress, err
+		}
+		progress.Type = response.Type
+		progress.Stores = response.Stores
+		progress.Phase = PhaseValidating
+		logger.Info("Starting search attribute migration.",
+			tag.WorkflowNamespace(params.Namespace),
+			tag.NewStringTag("search-attribute", params.Name),
+			tag.NewStringTag("new-name", params.NewName),
+			tag.NewStringerTag("type", progress.Type),
+			tag.NewStringerTag("new-type", params.NewType),
+			tag.NewBoolTag("dry-run", params.DryRun),
+			tag.NewStringTag("reason", params.Reason),
+		)
+	}
+
+	if progress.Phase == PhaseValidating {
+		for progress.StoresValidated < len(progress.Stores) {
+			plan := progress.Stores[progress.StoresValidated]
+			if plan.SourceField == plan.TargetField {
+				// Only the alias changes, so there is nothing to convert.
+				progress.StoresValidated++
+				continue
+			}
+			var response PageResponse
+			request := newPageRequest(params, plan, true)
+			if err := workflow.ExecuteActivity(ctx, migratePageActivityName, request).Get(ctx, &response); err != nil {
+				return *progress, err
+			}
+			progress.ExecutionsValidated += response.Processed
+			progress.InvalidValues += int64(len(response.InvalidValues))
+			for _, value := range response.InvalidValues {
+				if len(progress.InvalidValueSamples) >= MaxInvalidValueSamples {
+					break
+				}
+				progress.InvalidValueSamples = append(progress.InvalidValueSamples, value)
+			}
+			if len(response.NextPageToken) == 0 {
+				progress.StoresValidated++
+			}
+			if err := nextPage(ctx, &params, response.NextPageToken); err != nil {
+				return *progress, err
+			}
+		}
+		if progress.InvalidValues > 0 && !params.SkipInvalidValues {
+			return *progress, temporal.NewNonRetryableApplicationError(
+				ErrInvalidValuesLeft.Error(), errorTypeInvalidValues, ErrInvalidValuesLeft)
+		}
+		if params.DryRun {
+			progress.Phase = PhaseCompleted
+			return *progress, nil
+		}
+		progress.Phase = PhaseInstalling
+	}
+
+	if progress.Phase == PhaseInstalling {
+		for _, plan := range progress.Stores {
+			if err := workflow.ExecuteActivity(ctx, installActivityName, newStoreRequest(params, plan)).Get(ctx, nil); err != nil {
+				return *progress, err
+			}
+		}
+		if err := workflow.Sleep(ctx, cacheRefreshWait); err != nil {
+			return *progress, err
+		}
+		progress.Phase = PhaseMigrating
+	}
+
+	if progress.Phase == PhaseMigrating {
+		for progress.StoresMigrated < len(progress.Stores) {
+			plan := progress.Stores[progress.StoresMigrated]
+			if plan.SourceField == plan.TargetField {
+				// Only the alias changes.
+				progress.StoresMigrated++
+				continue
+			}
+			var response PageResponse
+			request := newPageRequest(params, plan, false)
+			if err := workflow.ExecuteActivity(ctx, migratePageActivityName, request).Get(ctx, &response); err != nil {
+				return *progress, err
+			}
+			progress.ExecutionsMigrated += response.Processed
+			if len(response.NextPageToken) == 0 {
+				progress.StoresMigrated++
+			}
+			if err := nextPage(ctx, &params, response.NextPageToken); err != nil {
+				return *progress, err
+			}
+		}
+		progress.Phase = PhaseFinishing
+	}
+
+	if progress.Phase == PhaseFinishing {
+		for _, plan := range progress.Stores {
+			if err := workflow.ExecuteActivity(ctx, finishActivityName, newStoreRequest(params, plan)).Get(ctx, nil); err != nil {
+				return *progress, err
+			}
+		}
+		progress.Phase = PhaseCompleted
+		logger.Info("Search attribute migration completed.",
+			tag.WorkflowNamespace(params.Namespace),
+			tag.NewStringTag("search-attribute", params.Name),
+			tag.NewInt64("executions-migrated", progress.ExecutionsMigrated),
+			tag.NewInt64("invalid-values", progress.InvalidValues),
+		)
+	}
+
+	return *progress, nil
+}
+
+func newPageRequest(params WorkflowParams, plan StorePlan, dryRun bool) PageRequest {
+	return PageRequest{
+		NamespaceID:   params.NamespaceID,
+		Namespace:     params.Namespace,
+		Plan:          plan,
+		SourceType:    params.Progress.Type,
+		TargetType:    targetType(params),
+		DryRun:        dryRun,
+		PageSize:      params.PageSize,
+		NextPageToken: params.NextPageToken,
+	}
+}
+
+func newStoreRequest(params WorkflowParams, plan StorePlan) StoreRequest {
+	return StoreRequest{
+		NamespaceID: params.NamespaceID,
+		Namespace:   params.Namespace,
+		Name:        params.Name,
+		Plan:        plan,
+		SourceType:  params.Progress.Type,
+		TargetType:  targetType(params),
+	}
+}
+
+func targetType(params WorkflowParams) enumspb.IndexedValueType {
+	if params.NewType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return params.Progress.Type
+	}
+	return params.NewType
+}
+
+// nextPage stores the page token of the next page and continues as new when the history of the workflow gets too
+// large.
+func nextPage(ctx workflow.Context, params *WorkflowParams, nextPageToken []byte) error {
+	params.NextPageToken = nextPageToken
+	if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+		return workflow.NewContinueAsNewError(ctx, WorkflowName, *params)
+	}
+	return nil
+}
+
+func parseParams(params WorkflowParams) (WorkflowParams, error) {
+	var err error
+	switch {
+	case params.Name == "":
+		err = ErrNameNotSet
+	case params.PageSize < 0:
+		err = ErrNegativePageSize
+	case params.PageSize > MaxPageSize:
+		err = ErrPageSizeTooLarge
+	case params.NewType != enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED && enumspb.IndexedValueType_name[int32(params.NewType)] == "":
+		err = ErrInvalidNewType
+	case params.NewType == enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED && (params.NewName == "" || params.NewName == params.Name):
+		err = ErrNothingToMigrate
+	}
+	if err != nil {
+		return params, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	if params.Progress.Phase == "" {
+		params.Progress.Namespace = params.Namespace
+		params.Progress.Name = params.Name
+		params.Progress.NewName = params.NewName
+		params.Progress.NewType = params.NewType
+		params.Progress.Phase = PhasePreparing
+	}
+	return params, nil
+}
@@ -0,0 +1,210 @@
+package searchattributemigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		modify   func(params *WorkflowParams)
+		expected error
+	}{
+		{"missing_name", func(p *WorkflowParams) { p.Name = "" }, ErrNameNotSet},
+		{"negative_page_size", func(p *WorkflowParams) { p.PageSize = -1 }, ErrNegativePageSize},
+		{"page_size_too_large", func(p *WorkflowParams) { p.PageSize = MaxPageSize + 1 }, ErrPageSizeTooLarge},
+		{"invalid_new_type", func(p *WorkflowParams) { p.NewType = 100 }, ErrInvalidNewType},
+		{"nothing_to_migrate", func(p *WorkflowParams) { p.NewType = enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED }, ErrNothingToMigrate},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			params := testWorkflowParams()
+			tc.modify(&params)
+			env.ExecuteWorkflow(Workflow, params)
+
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+			assert.True(t, applicationErr.NonRetryable())
+			assert.ErrorContains(t, applicationErr, tc.expected.Error())
+		})
+	}
+}
+
+func TestWorkflow_Phases(t *testing.T) {
+	t.Parallel()
+
+	typeChange := StorePlan{StoreName: "mysql", AliasMode: true, SourceField: "Keyword01", TargetField: "Int02", Alias: "MyAttribute"}
+	rename := StorePlan{StoreName: "mysql", AliasMode: true, SourceField: "Keyword01", TargetField: "Keyword01", Alias: "MyRenamedAttribute"}
+	for _, tc := range []struct {
+		name          string
+		plan          StorePlan
+		dryRun        bool
+		expectedCalls []string
+		expected      Progress
+	}{
+		{
+			name: "type_change",
+			plan: typeChange,
+			expectedCalls: []string{
+				"prepare",
+				"validate", "validate",
+				"install",
+				"migrate", "migrate",
+				"finish",
+			},
+			expected: Progress{ExecutionsValidated: 3, ExecutionsMigrated: 3, StoresValidated: 1, StoresMigrated: 1},
+		},
+		{
+			name:          "dry_run",
+			plan:          typeChange,
+			dryRun:        true,
+			expectedCalls: []string{"prepare", "validate", "validate"},
+			expected:      Progress{ExecutionsValidated: 3, StoresValidated: 1},
+		},
+		{
+			// only the alias changes, so there are no values to validate or migrate
+			name:          "rename",
+			plan:          rename,
+			expectedCalls: []string{"prepare", "install", "finish"},
+			expected:      Progress{StoresValidated: 1, StoresMigrated: 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			var calls []string
+			env.OnActivity(prepareActivityName, mock.Anything, mock.Anything).
+				Run(func(mock.Arguments) { calls = append(calls, "prepare") }).
+				Return(Progress{Type: enumspb.INDEXED_VALUE_TYPE_KEYWORD, Stores: []StorePlan{tc.plan}}, nil).Once()
+			env.OnActivity(migratePageActivityName, mock.Anything, mock.Anything).
+				Return(func(_ context.Context, request PageRequest) (PageResponse, error) {
+					if request.DryRun {
+						calls = append(calls, "validate")
+					} else {
+						calls = append(calls, "migrate")
+					}
+					assert.Equal(t, tc.plan, request.Plan)
+					assert.Equal(t, enumspb.INDEXED_VALUE_TYPE_KEYWORD, request.SourceType)
+					assert.Equal(t, enumspb.INDEXED_VALUE_TYPE_INT, request.TargetType)
+					assert.Equal(t, DefaultPageSize, request.PageSize)
+					if len(request.NextPageToken) == 0 {
+						return PageResponse{Processed: 2, NextPageToken: []byte{42}}, nil
+					}
+					return PageResponse{Processed: 1}, nil
+				}).Maybe()
+			env.OnActivity(installActivityName, mock.Anything, mock.Anything).
+				Run(func(mock.Arguments) { calls = append(calls, "install") }).Return(nil).Maybe()
+			env.OnActivity(finishActivityName, mock.Anything, mock.Anything).
+				Run(func(mock.Arguments) { calls = append(calls, "finish") }).Return(nil).Maybe()
+
+			params := testWorkflowParams()
+			params.DryRun = tc.dryRun
+			env.ExecuteWorkflow(Workflow, params)
+			require.NoError(t, env.GetWorkflowError())
+			var progress Progress
+			require.NoError(t, env.GetWorkflowResult(&progress))
+			assert.Equal(t, tc.expectedCalls, calls)
+			assert.Equal(t, PhaseCompleted, progress.Phase)
+			assert.Equal(t, tc.expected.StoresValidated, progress.StoresValidated)
+			assert.Equal(t, tc.expected.ExecutionsValidated, progress.ExecutionsValidated)
+			assert.Equal(t, tc.expected.StoresMigrated, progress.StoresMigrated)
+			assert.Equal(t, tc.expected.ExecutionsMigrated, progress.ExecutionsMigrated)
+		})
+	}
+}
+
+func TestWorkflow_InvalidValues(t *testing.T) {
+	t.Parallel()
+
+	invalidValue := InvalidValue{WorkflowID: "workflow-id", RunID: "run-id", Value: "abc", Error: "invalid syntax"}
+	for _, tc := range []struct {
+		name              string
+		skipInvalidValues bool
+		expectedPhase     string
+	}{
+		{"fail", false, PhaseValidating},
+		{"skip", true, PhaseCompleted},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			env.OnActivity(prepareActivityName, mock.Anything, mock.Anything).
+				Return(Progress{
+					Type:   enumspb.INDEXED_VALUE_TYPE_KEYWORD,
+					Stores: []StorePlan{{StoreName: "mysql", SourceField: "Keyword01", TargetField: "Int02"}},
+				}, nil)
+			env.OnActivity(migratePageActivityName, mock.Anything, mock.Anything).
+				Return(func(_ context.Context, request PageRequest) (PageResponse, error) {
+					if request.DryRun {
+						return PageResponse{Processed: 1, InvalidValues: []InvalidValue{invalidValue}}, nil
+					}
+					return PageResponse{Processed: 1}, nil
+				})
+			installed := false
+			env.OnActivity(installActivityName, mock.Anything, mock.Anything).
+				Run(func(mock.Arguments) { installed = true }).Return(nil).Maybe()
+			env.OnActivity(finishActivityName, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			params := testWorkflowParams()
+			params.SkipInvalidValues = tc.skipInvalidValues
+			env.ExecuteWorkflow(Workflow, params)
+			if tc.skipInvalidValues {
+				require.NoError(t, env.GetWorkflowError())
+			} else {
+				var applicationErr *temporal.ApplicationError
+				require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+				assert.Equal(t, errorTypeInvalidValues, applicationErr.Type())
+			}
+			assert.Equal(t, tc.skipInvalidValues, installed)
+
+			resp, err := env.QueryWorkflow(QueryTypeProgress)
+			require.NoError(t, err)
+			var progress Progress
+			require.NoError(t, resp.Get(&progress))
+			assert.Equal(t, tc.expectedPhase, progress.Phase)
+			assert.EqualValues(t, 1, progress.InvalidValues)
+			assert.Equal(t, []InvalidValue{invalidValue}, progress.InvalidValueSamples)
+		})
+	}
+}
+
+// newTestEnv returns a test environment with all activities registered, to be mocked by each test.
+func newTestEnv() *testsuite.TestWorkflowEnvironment {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+	a := &activities{}
+	for name, fn := range map[string]any{
+		prepareActivityName:     a.prepare,
+		migratePageActivityName: a.migratePage,
+		installActivityName:     a.install,
+		finishActivityName:      a.finish,
+	} {
+		env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+	}
+	return env
+}
+
+func testWorkflowParams() WorkflowParams {
+	return WorkflowParams{
+		NamespaceID: "my-namespace-id",
+		Namespace:   "my-namespace",
+		Name:        "MyAttribute",
+		NewType:     enumspb.INDEXED_VALUE_TYPE_INT,
+	}
+}
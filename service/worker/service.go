@@ -87,6 +87,7 @@ type (
 		VisibilityEnableShadowReadMode          dynamicconfig.BoolPropertyFn
 		VisibilityDisableOrderByClause          dynamicconfig.BoolPropertyFnWithNamespaceFilter
 		VisibilityEnableManualPagination        dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityQueryCostLimit                dynamicconfig.IntPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -179,6 +180,10 @@ func NewConfig(
 			ExecutionDataDurationBuffer:             dynamicconfig.ExecutionDataDurationBuffer.Get(dc),
 			ExecutionScannerWorkerCount:             dynamicconfig.ExecutionScannerWorkerCount.Get(dc),
 			ExecutionScannerHistoryEventIdValidator: dynamicconfig.ExecutionScannerHistoryEventIdValidator.Get(dc),
+			ExecutionScannerVisibilityValidator:     dynamicconfig.ExecutionScannerVisibilityValidator.Get(dc),
+			ExecutionScannerVisibilitySampleRate:    dynamicconfig.ExecutionScannerVisibilitySampleRate.Get(dc),
+			ExecutionScannerVisibilityMinAge:        dynamicconfig.ExecutionScannerVisibilityMinAge.Get(dc),
+			ExecutionScannerVisibilityRepair:        dynamicconfig.ExecutionScannerVisibilityRepair.Get(dc),
 			RemovableBuildIdDurationSinceDefault:    dynamicconfig.RemovableBuildIdDurationSinceDefault.Get(dc),
 			BuildIdScavengerVisibilityRPS:           dynamicconfig.BuildIdScavengerVisibilityRPS.Get(dc),
 		},
@@ -205,6 +210,7 @@ func NewConfig(
 		VisibilityEnableShadowReadMode:          dynamicconfig.VisibilityEnableShadowReadMode.Get(dc),
 		VisibilityDisableOrderByClause:          dynamicconfig.VisibilityDisableOrderByClause.Get(dc),
 		VisibilityEnableManualPagination:        dynamicconfig.VisibilityEnableManualPagination.Get(dc),
+		VisibilityQueryCostLimit:                dynamicconfig.VisibilityQueryCostLimit.Get(dc),
 	}
 	return config
 }
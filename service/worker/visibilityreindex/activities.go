@@ -0,0 +1,640 @@
+package visibilityreindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	chasmworkflow "go.temporal.io/server/chasm/lib/workflow"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/primitives/timestamp"
+	"golang.org/x/sync/errgroup"
+)
+
+type (
+	// WriterFactory creates a visibility manager that can write to the configured visibility stores. The visibility
+	// manager of the worker service can't be used for that because it is created without an Elasticsearch bulk
+	// processor.
+	WriterFactory func() (manager.VisibilityManager, error)
+
+	activities struct {
+		numShards         int32
+		executionManager  persistence.ExecutionManager
+		namespaceRegistry namespace.Registry
+		// visibilityManager is only used to read.
+		visibilityManager manager.VisibilityManager
+		writer            *lazyWriter
+		archiverProvider  provider.ArchiverProvider
+
+		indexCloseFailure dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		indexedMemoFields dynamicconfig.TypedPropertyFnWithNamespaceFilter[[]string]
+		fullTextMaxBytes  dynamicconfig.IntPropertyFnWithNamespaceFilter
+	}
+
+	// lazyWriter creates the writing visibility manager on first use, so that workers that never run a reindex don't
+	// start an Elasticsearch bulk processor.
+	lazyWriter struct {
+		factory WriterFactory
+
+		mu      sync.Mutex
+		manager manager.VisibilityManager
+	}
+)
+
+const (
+	// maxConcurrentExecutions limits the number of executions of a page that are processed concurrently. Writes to
+	// Elasticsearch wait for the bulk processor to flush, so processing a page sequentially would take too long.
+	maxConcurrentExecutions = 100
+	// historyPageSize is the number of event batches read from the history store or archival at once.
+	historyPageSize = 100
+)
+
+// errHistoryNotAvailable is returned when the history of an execution is neither in the history store nor archived.
+var errHistoryNotAvailable = errors.New("history is neither in the history store nor archived")
+
+func (w *lazyWriter) get() (manager.VisibilityManager, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.manager == nil {
+		m, err := w.factory()
+		if err != nil {
+			return nil, err
+		}
+		w.manager = m
+	}
+	return w.manager, nil
+}
+
+func (w *lazyWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.manager != nil {
+		w.manager.Close()
+		w.manager = nil
+	}
+}
+
+// prepare validates the target store and returns the number of history shards to walk.
+func (a *activities) prepare(_ context.Context, params WorkflowParams) (PrepareResponse, error) {
+	stores := storeManagers(a.visibilityManager)
+	targetStore := params.TargetStore
+	if targetStore == "" {
+		targetStore = stores[len(stores)-1].GetStoreNames()[0]
+	} else if findStore(stores, targetStore) == nil {
+		err := fmt.Errorf("unknown visibility store %q, configured stores are %v", targetStore, a.visibilityManager.GetStoreNames())
+		return PrepareResponse{}, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+	if params.Verify && len(stores) < 2 {
+		err := errors.New("verification requires a secondary visibility store")
+		return PrepareResponse{}, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+	return PrepareResponse{
+		TargetStore: targetStore,
+		NumShards:   a.numShards,
+	}, nil
+}
+
+// reindexPage writes a visibility record to the target store for every execution of one page of a shard. Records that
+// the target store already has with a newer version are left untouched.
+func (a *activities) reindexPage(ctx context.Context, request PageRequest) (ReindexPageResponse, error) {
+	writer, err := a.writer.get()
+	if err != nil {
+		return ReindexPageResponse{}, err
+	}
+	target := findStore(storeManagers(writer), request.TargetStore)
+	if target == nil {
+		err := fmt.Errorf("unknown visibility store %q", request.TargetStore)
+		return ReindexPageResponse{}, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+
+	resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   request.ShardID,
+		PageSize:  request.PageSize,
+		PageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return ReindexPageResponse{}, err
+	}
+
+	var (
+		reindexed, skipped atomic.Int64
+		mu                 sync.Mutex
+		unrecoverable      []UnrecoverableExecution
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentExecutions)
+	for _, state := range resp.States {
+		g.Go(func() error {
+			ok, reason, err := a.reindexExecution(gctx, target, request, state)
+			switch {
+			case err != nil:
+				return err
+			case reason != "":
+				mu.Lock()
+				defer mu.Unlock()
+				unrecoverable = append(unrecoverable, UnrecoverableExecution{
+					NamespaceID: state.GetExecutionInfo().GetNamespaceId(),
+					WorkflowID:  state.GetExecutionInfo().GetWorkflowId(),
+					RunID:       state.GetExecutionState().GetRunId(),
+					Reason:      reason,
+				})
+			case ok:
+				reindexed.Add(1)
+			default:
+				skipped.Add(1)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return ReindexPageResponse{}, err
+	}
+	return ReindexPageResponse{
+		ExecutionsReindexed: reindexed.Load(),
+		ExecutionsSkipped:   skipped.Load(),
+		Unrecoverable:       unrecoverable,
+		NextPageToken:       resp.PageToken,
+	}, nil
+}
+
+// reindexExecution writes the visibility record of a single execution and returns false if the execution was skipped.
+// The record is built the same way the visibility queue of the history service builds it. If the record of a closed
+// execution can't be rebuilt, it also returns the reason.
+func (a *activities) reindexExecution(
+	ctx context.Context,
+	target manager.VisibilityManager,
+	request PageRequest,
+	state *persistencespb.WorkflowMutableState,
+) (bool, string, error) {
+	nsEntry, err := a.getNamespace(state, request.NamespaceID)
+	if err != nil || nsEntry == nil {
+		return false, "", err
+	}
+
+	executionInfo := state.GetExecutionInfo()
+	nsName := nsEntry.Name().String()
+	base := newVisibilityRequestBase(nsEntry, request.ShardID, state)
+	if state.GetExecutionState().GetState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		visibility.AddMemoText(base, a.indexedMemoFields(nsName), a.fullTextMaxBytes(nsName))
+		return true, "", target.UpsertWorkflowExecution(ctx, &manager.UpsertWorkflowExecutionRequest{
+			VisibilityRequestBase: base,
+		})
+	}
+
+	// The memo and search attributes of closed executions are removed from mutable state once the close record was
+	// written, so they are rebuilt from the full history. Otherwise only the completion event is read, if needed.
+	history := &executionHistory{
+		activities: a,
+		nsEntry:    nsEntry,
+		shardID:    request.ShardID,
+		state:      state,
+	}
+	closeTime := timestamp.TimeValue(executionInfo.GetCloseTime())
+	err = func() error {
+		if executionInfo.GetRelocatableAttributesRemoved() {
+			events, err := history.read(ctx, common.FirstEventID)
+			if err != nil {
+				return err
+			}
+			base.Memo, base.SearchAttributes = relocatableAttributes(events)
+		}
+		if executionInfo.GetCloseTime() == nil {
+			// Executions closed before v1.16 have no close time in mutable state.
+			event, err := history.completionEvent(ctx)
+			if err != nil {
+				return err
+			}
+			closeTime = timestamp.TimeValue(event.GetEventTime())
+		}
+		visibility.AddMemoText(base, a.indexedMemoFields(nsName), a.fullTextMaxBytes(nsName))
+		if a.indexCloseFailure(nsName) {
+			return visibility.AddCloseFailure(ctx, base, history.completionEvent, a.fullTextMaxBytes(nsName))
+		}
+		return nil
+	}()
+	if errors.Is(err, errHistoryNotAvailable) {
+		return false, err.Error(), nil
+	} else if err != nil {
+		return false, "", err
+	}
+	if closeVisibilityTaskID := executionInfo.GetCloseVisibilityTaskId(); closeVisibilityTaskID != 0 {
+		base.TaskID = closeVisibilityTaskID
+	}
+
+	var executionDuration time.Duration
+	if !closeTime.IsZero() && executionInfo.GetExecutionTime() != nil {
+		executionDuration = closeTime.Sub(executionInfo.GetExecutionTime().AsTime())
+	}
+	return true, "", target.RecordWorkflowExecutionClosed(ctx, &manager.RecordWorkflowExecutionClosedRequest{
+		VisibilityRequestBase: base,
+		CloseTime:             closeTime,
+		ExecutionDuration:     executionDuration,
+		HistoryLength:         state.GetNextEventId() - 1,
+		HistorySizeBytes:      executionInfo.GetExecutionStats().GetHistorySize(),
+		StateTransitionCount:  executionInfo.GetStateTransitionCount(),
+	})
+}
+
+// executionHistory reads the history of a closed execution from the history store or, if it's not there anymore,
+// from the history archival of the namespace. Events that were already read are kept.
+type executionHistory struct {
+	activities *activities
+	nsEntry    *namespace.Namespace
+	shardID    int32
+	state      *persistencespb.WorkflowMutableState
+
+	events       []*historypb.HistoryEvent
+	firstEventID int64
+}
+
+// read returns the events of the execution starting at firstEventID.
+func (h *executionHistory) read(ctx context.Context, firstEventID int64) ([]*historypb.HistoryEvent, error) {
+	if h.events != nil && h.firstEventID <= firstEventID {
+		return eventsFrom(h.events, firstEventID), nil
+	}
+	events, err := h.readFromStore(ctx, firstEventID)
+	var notFound *serviceerror.NotFound
+	if errors.As(err, &notFound) {
+		events, err = h.readFromArchival(ctx, firstEventID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, errHistoryNotAvailable
+	}
+	h.events, h.firstEventID = events, firstEventID
+	return events, nil
+}
+
+// completionEvent returns the last event of the execution.
+func (h *executionHistory) completionEvent(ctx context.Context) (*historypb.HistoryEvent, error) {
+	firstEventID := h.state.GetExecutionInfo().GetCompletionEventBatchId()
+	if firstEventID == 0 {
+		firstEventID = common.FirstEventID
+	}
+	events, err := h.read(ctx, firstEventID)
+	if err != nil {
+		return nil, err
+	}
+	return events[len(events)-1], nil
+}
+
+func (h *executionHistory) readFromStore(ctx context.Context, firstEventID int64) ([]*historypb.HistoryEvent, error) {
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(h.state.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return nil, serviceerror.NewNotFound(err.Error())
+	}
+	request := &persistence.ReadHistoryBranchRequest{
+		ShardID:     h.shardID,
+		BranchToken: currentVersionHistory.GetBranchToken(),
+		MinEventID:  firstEventID,
+		MaxEventID:  h.state.GetNextEventId(),
+		PageSize:    historyPageSize,
+	}
+	var events []*historypb.HistoryEvent
+	for {
+		resp, err := h.activities.executionManager.ReadHistoryBranch(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.HistoryEvents...)
+		if len(resp.NextPageToken) == 0 {
+			return events, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+func (h *executionHistory) readFromArchival(ctx context.Context, firstEventID int64) ([]*historypb.HistoryEvent, error) {
+	uriString := h.nsEntry.HistoryArchivalState().URI
+	if uriString == "" || h.activities.archiverProvider == nil {
+		return nil, errHistoryNotAvailable
+	}
+	uri, err := archiver.NewURI(uriString)
+	if err != nil {
+		return nil, err
+	}
+	historyArchiver, err := h.activities.archiverProvider.GetHistoryArchiver(uri.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	request := &archiver.GetHistoryRequest{
+		NamespaceID: h.nsEntry.ID().String(),
+		WorkflowID:  h.state.GetExecutionInfo().GetWorkflowId(),
+		RunID:       h.state.GetExecutionState().GetRunId(),
+		PageSize:    historyPageSize,
+	}
+	var events []*historypb.HistoryEvent
+	for {
+		resp, err := historyArchiver.Get(ctx, uri, request)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil, errHistoryNotAvailable
+		} else if err != nil {
+			return nil, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			events = append(events, eventsFrom(batch.GetEvents(), firstEventID)...)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return events, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// eventsFrom returns the events starting at firstEventID.
+func eventsFrom(events []*historypb.HistoryEvent, firstEventID int64) []*historypb.HistoryEvent {
+	for i, event := range events {
+		if event.GetEventId() >= firstEventID {
+			return events[i:]
+		}
+	}
+	return nil
+}
+
+// relocatableAttributes rebuilds the memo and search attributes of an execution from its history, the same way
+// mutable state applies the events.
+func relocatableAttributes(events []*historypb.HistoryEvent) (*commonpb.Memo, *commonpb.SearchAttributes) {
+	var memo, searchAttributes map[string]*commonpb.Payload
+	for _, event := range events {
+		switch event.GetEventType() {
+		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+			attributes := event.GetWorkflowExecutionStartedEventAttributes()
+			memo = attributes.GetMemo().GetFields()
+			searchAttributes = attributes.GetSearchAttributes().GetIndexedFields()
+		case enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES:
+			searchAttributes = payload.MergeMapOfPayload(
+				searchAttributes,
+				event.GetUpsertWorkflowSearchAttributesEventAttributes().GetSearchAttributes().GetIndexedFields(),
+			)
+		case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED:
+			memo = payload.MergeMapOfPayload(
+				memo,
+				event.GetWorkflowPropertiesModifiedEventAttributes().GetUpsertedMemo().GetFields(),
+			)
+		}
+	}
+	var visibilityMemo *commonpb.Memo
+	if memo != nil {
+		visibilityMemo = &commonpb.Memo{Fields: copyMapPayload(memo)}
+	}
+	var visibilitySearchAttributes *commonpb.SearchAttributes
+	if searchAttributes != nil {
+		visibilitySearchAttributes = &commonpb.SearchAttributes{IndexedFields: copyMapPayload(searchAttributes)}
+	}
+	return visibilityMemo, visibilitySearchAttributes
+}
+
+// getRecord returns the first record of the execution found in the given stores, or nil if none of them has one.
+func (a *activities) getRecord(
+	ctx context.Context,
+	stores []manager.VisibilityManager,
+	base *manager.VisibilityRequestBase,
+) (*workflowpb.WorkflowExecutionInfo, error) {
+	for _, store := range stores {
+		resp, err := store.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+			NamespaceID: base.NamespaceID,
+			Namespace:   base.Namespace,
+			WorkflowID:  base.Execution.GetWorkflowId(),
+			RunID:       base.Execution.GetRunId(),
+		})
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		return resp.Execution, nil
+	}
+	return nil, nil
+}
+
+// verifyPage compares the records of the primary and secondary store for every execution of one page of a shard.
+// Mismatches of running executions can be transient since the two stores are not updated atomically.
+func (a *activities) verifyPage(ctx context.Context, request PageRequest) (VerifyPageResponse, error) {
+	stores := storeManagers(a.visibilityManager)
+	if len(stores) < 2 {
+		err := errors.New("verification requires a secondary visibility store")
+		return VerifyPageResponse{}, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+
+	resp, err := a.executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+		ShardID:   request.ShardID,
+		PageSize:  request.PageSize,
+		PageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return VerifyPageResponse{}, err
+	}
+
+	var (
+		verified   atomic.Int64
+		mu         sync.Mutex
+		mismatches []Mismatch
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentExecutions)
+	for _, state := range resp.States {
+		g.Go(func() error {
+			nsEntry, err := a.getNamespace(state, request.NamespaceID)
+			if err != nil || nsEntry == nil {
+				return err
+			}
+			base := newVisibilityRequestBase(nsEntry, request.ShardID, state)
+			primary, err := a.getRecord(gctx, stores[:1], base)
+			if err != nil {
+				return err
+			}
+			secondary, err := a.getRecord(gctx, stores[1:], base)
+			if err != nil {
+				return err
+			}
+			verified.Add(1)
+			if reason := compareRecords(primary, secondary); reason != "" {
+				mu.Lock()
+				defer mu.Unlock()
+				mismatches = append(mismatches, Mismatch{
+					NamespaceID: base.NamespaceID.String(),
+					WorkflowID:  base.Execution.GetWorkflowId(),
+					RunID:       base.Execution.GetRunId(),
+					Reason:      reason,
+				})
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return VerifyPageResponse{}, err
+	}
+	return VerifyPageResponse{
+		ExecutionsVerified: verified.Load(),
+		Mismatches:         mismatches,
+		NextPageToken:      resp.PageToken,
+	}, nil
+}
+
+// getNamespace returns the namespace of an execution, or nil if the execution doesn't have a visibility record or is
+// filtered out.
+func (a *activities) getNamespace(
+	state *persistencespb.WorkflowMutableState,
+	namespaceID string,
+) (*namespace.Namespace, error) {
+	executionNamespaceID := state.GetExecutionInfo().GetNamespaceId()
+	if namespaceID != "" && executionNamespaceID != namespaceID {
+		return nil, nil
+	}
+	if !visibility.HasRecord(state.GetExecutionState().GetState()) {
+		return nil, nil
+	}
+	if archetype := state.GetChasmNodes()[""].GetMetadata().GetComponentAttributes().GetType(); archetype != "" &&
+		archetype != string(chasmworkflow.Archetype) {
+		// The visibility of other CHASM archetypes is maintained by their own components.
+		return nil, nil
+	}
+
+	nsEntry, err := a.namespaceRegistry.GetNamespaceByID(namespace.ID(executionNamespaceID))
+	var notFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &notFound) {
+		// The namespace is being deleted.
+		return nil, nil
+	}
+	return nsEntry, err
+}
+
+// newVisibilityRequestBase builds the visibility request of an execution from its mutable state, the same way the
+// visibility queue of the history service does.
+func newVisibilityRequestBase(
+	nsEntry *namespace.Namespace,
+	shardID int32,
+	state *persistencespb.WorkflowMutableState,
+) *manager.VisibilityRequestBase {
+	executionInfo := state.GetExecutionInfo()
+	executionState := state.GetExecutionState()
+
+	var memo *commonpb.Memo
+	if executionInfo.GetMemo() != nil {
+		memo = &commonpb.Memo{Fields: copyMapPayload(executionInfo.GetMemo())}
+	}
+	var searchAttributes *commonpb.SearchAttributes
+	if executionInfo.GetSearchAttributes() != nil {
+		searchAttributes = &commonpb.SearchAttributes{IndexedFields: copyMapPayload(executionInfo.GetSearchAttributes())}
+	}
+	var parentExecution *commonpb.WorkflowExecution
+	if executionInfo.GetParentWorkflowId() != "" && executionInfo.GetParentRunId() != "" {
+		parentExecution = &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetParentWorkflowId(),
+			RunId:      executionInfo.GetParentRunId(),
+		}
+	}
+
+	return &manager.VisibilityRequestBase{
+		NamespaceID: nsEntry.ID(),
+		Namespace:   nsEntry.Name(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetWorkflowId(),
+			RunId:      executionState.GetRunId(),
+		},
+		WorkflowTypeName: executionInfo.GetWorkflowTypeName(),
+		StartTime:        timestamp.TimeValue(executionState.GetStartTime()),
+		Status:           executionState.GetStatus(),
+		ExecutionTime:    timestamp.TimeValue(executionInfo.GetExecutionTime()),
+		// The transaction ID of the last event batch is allocated from the same sequence as task IDs. Using it as the
+		// version makes sure that records written by the visibility queue since then are not overwritten.
+		TaskID:           executionInfo.GetLastFirstEventTxnId(),
+		ShardID:          shardID,
+		Memo:             memo,
+		TaskQueue:        executionInfo.GetTaskQueue(),
+		SearchAttributes: searchAttributes,
+		ParentExecution:  parentExecution,
+		RootExecution: &commonpb.WorkflowExecution{
+			WorkflowId: executionInfo.GetRootWorkflowId(),
+			RunId:      executionInfo.GetRootRunId(),
+		},
+	}
+}
+
+// compareRecords returns why the records of the primary and secondary store differ, or an empty string if they match.
+// Only the fields that both stores persist with the same precision are compared.
+func compareRecords(primary, secondary *workflowpb.WorkflowExecutionInfo) string {
+	switch {
+	case primary == nil && secondary == nil:
+		return ""
+	case secondary == nil:
+		return "missing in secondary store"
+	case primary == nil:
+		return "missing in primary store"
+	case primary.GetStatus() != secondary.GetStatus():
+		return fmt.Sprintf("status %v != %v", primary.GetStatus(), secondary.GetStatus())
+	case primary.GetType().GetName() != secondary.GetType().GetName():
+		return fmt.Sprintf("workflow type %q != %q", primary.GetType().GetName(), secondary.GetType().GetName())
+	case primary.GetTaskQueue() != secondary.GetTaskQueue():
+		return fmt.Sprintf("task queue %q != %q", primary.GetTaskQueue(), secondary.GetTaskQueue())
+	case !equalTime(primary.GetStartTime().AsTime(), secondary.GetStartTime().AsTime()):
+		return fmt.Sprintf("start time %v != %v", primary.GetStartTime().AsTime(), secondary.GetStartTime().AsTime())
+	case !equalTime(primary.GetCloseTime().AsTime(), secondary.GetCloseTime().AsTime()):
+		return fmt.Sprintf("close time %v != %v", primary.GetCloseTime().AsTime(), secondary.GetCloseTime().AsTime())
+	}
+	for name := range primary.GetSearchAttributes().GetIndexedFields() {
+		if _, ok := secondary.GetSearchAttributes().GetIndexedFields()[name]; !ok {
+			return fmt.Sprintf("search attribute %s missing in secondary store", name)
+		}
+	}
+	for name := range secondary.GetSearchAttributes().GetIndexedFields() {
+		if _, ok := primary.GetSearchAttributes().GetIndexedFields()[name]; !ok {
+			return fmt.Sprintf("search attribute %s missing in primary store", name)
+		}
+	}
+	return ""
+}
+
+// equalTime compares timestamps with millisecond precision, which is the precision of Elasticsearch.
+func equalTime(a, b time.Time) bool {
+	return a.Truncate(time.Millisecond).Equal(b.Truncate(time.Millisecond))
+}
+
+// storeManagers returns the managers of the primary and, if configured, the secondary visibility store.
+func storeManagers(m manager.VisibilityManager) []manager.VisibilityManager {
+	if dual, ok := m.(*visibility.VisibilityManagerDual); ok {
+		return []manager.VisibilityManager{dual.GetPrimaryVisibility(), dual.GetSecondaryVisibility()}
+	}
+	return []manager.VisibilityManager{m}
+}
+
+func findStore(stores []manager.VisibilityManager, storeName string) manager.VisibilityManager {
+	for _, store := range stores {
+		if store.HasStoreName(storeName) {
+			return store
+		}
+	}
+	return nil
+}
+
+func copyMapPayload(input map[string]*commonpb.Payload) map[string]*commonpb.Payload {
+	result := make(map[string]*commonpb.Payload, len(input))
+	for k, v := range input {
+		result[k] = common.CloneProto(v)
+	}
+	return result
+}
@@ -0,0 +1,544 @@
+package visibilityreindex
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/temporal"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	testNamespace = namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "my-namespace-id", Name: "my-namespace"}, nil, "",
+	)
+	archivedNamespace = namespace.NewLocalNamespaceForTest(
+		&persistencespb.NamespaceInfo{Id: "archived-namespace-id", Name: "archived-namespace"},
+		&persistencespb.NamespaceConfig{HistoryArchivalUri: "test:///archive"},
+		"",
+	)
+	// nilPayload removes a search attribute when upserted
+	nilPayload, _ = payload.Encode(nil)
+	startTime     = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	closeTime     = startTime.Add(time.Minute)
+)
+
+func TestPrepare(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		secondary     bool
+		params        WorkflowParams
+		expectedStore string
+		expectedErr   string
+	}{
+		{name: "defaults_to_secondary", secondary: true, expectedStore: "secondary"},
+		{name: "defaults_to_primary", expectedStore: "primary"},
+		{name: "explicit_target", secondary: true, params: WorkflowParams{TargetStore: "primary"}, expectedStore: "primary"},
+		{name: "unknown_target", params: WorkflowParams{TargetStore: "unknown"}, expectedErr: "unknown visibility store"},
+		{name: "verify_requires_secondary", params: WorkflowParams{Verify: true}, expectedErr: "secondary visibility store"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			stores := newTestStores(gomock.NewController(t), tc.secondary)
+			a := &activities{numShards: 4, visibilityManager: stores.manager()}
+
+			resp, err := a.prepare(context.Background(), tc.params)
+			if tc.expectedErr != "" {
+				var applicationErr *temporal.ApplicationError
+				require.ErrorAs(t, err, &applicationErr)
+				assert.True(t, applicationErr.NonRetryable())
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, PrepareResponse{TargetStore: tc.expectedStore, NumShards: 4}, resp)
+		})
+	}
+}
+
+func TestReindexPage(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		// state is the single execution on the page
+		state       *persistencespb.WorkflowMutableState
+		namespaceID string
+		// history is the history of the execution in the history store, if any
+		history []*historypb.HistoryEvent
+		// archivedHistory is the history of the execution in the history archival, if any
+		archivedHistory []*historypb.HistoryEvent
+		expected        ReindexPageResponse
+		// expectedWrite is the request written to the secondary store, if any
+		expectedWrite func(t *testing.T, request any)
+	}{
+		{
+			name:     "running",
+			state:    newRunningState(),
+			expected: ReindexPageResponse{ExecutionsReindexed: 1},
+			expectedWrite: func(t *testing.T, request any) {
+				upsert, ok := request.(*manager.UpsertWorkflowExecutionRequest)
+				require.True(t, ok)
+				assert.Equal(t, "running-run-id", upsert.Execution.GetRunId())
+				assert.Equal(t, testNamespace.Name(), upsert.Namespace)
+				assert.Equal(t, "my-workflow-type", upsert.WorkflowTypeName)
+				assert.EqualValues(t, 7, upsert.TaskID)
+				assert.EqualValues(t, 1, upsert.ShardID)
+				assert.Contains(t, upsert.SearchAttributes.GetIndexedFields(), "CustomKeywordField")
+			},
+		},
+		{
+			name:     "closed_with_attributes_removed",
+			state:    newClosedState(),
+			history:  newClosedHistory(),
+			expected: ReindexPageResponse{ExecutionsReindexed: 1},
+			expectedWrite: func(t *testing.T, request any) {
+				closed, ok := request.(*manager.RecordWorkflowExecutionClosedRequest)
+				require.True(t, ok)
+				assert.Equal(t, "closed-run-id", closed.Execution.GetRunId())
+				assert.Equal(t, closeTime, closed.CloseTime)
+				assert.Equal(t, time.Minute, closed.ExecutionDuration)
+				assert.EqualValues(t, 9, closed.HistoryLength)
+				assert.EqualValues(t, 11, closed.TaskID)
+				// the memo and search attributes were removed from mutable state, so they are rebuilt from history
+				assert.Equal(t, []string{"memo-key", "upserted-memo-key"}, slices.Sorted(maps.Keys(closed.Memo.GetFields())))
+				searchAttributes := closed.SearchAttributes.GetIndexedFields()
+				assert.Contains(t, searchAttributes, "CustomKeywordField")
+				assert.Contains(t, searchAttributes, "UpsertedKeywordField")
+				assert.NotContains(t, searchAttributes, "RemovedKeywordField")
+			},
+		},
+		{
+			name: "closed_without_close_time",
+			state: func() *persistencespb.WorkflowMutableState {
+				state := newClosedState()
+				state.ExecutionInfo.RelocatableAttributesRemoved = false
+				state.ExecutionInfo.CloseTime = nil
+				return state
+			}(),
+			// only the completion event is read
+			history:  newClosedHistory()[3:],
+			expected: ReindexPageResponse{ExecutionsReindexed: 1},
+			expectedWrite: func(t *testing.T, request any) {
+				closed, ok := request.(*manager.RecordWorkflowExecutionClosedRequest)
+				require.True(t, ok)
+				assert.Equal(t, closeTime, closed.CloseTime)
+			},
+		},
+		{
+			name: "closed_from_archival",
+			state: func() *persistencespb.WorkflowMutableState {
+				state := newClosedState()
+				state.ExecutionInfo.NamespaceId = archivedNamespace.ID().String()
+				return state
+			}(),
+			archivedHistory: newClosedHistory(),
+			expected:        ReindexPageResponse{ExecutionsReindexed: 1},
+			expectedWrite: func(t *testing.T, request any) {
+				closed, ok := request.(*manager.RecordWorkflowExecutionClosedRequest)
+				require.True(t, ok)
+				assert.Contains(t, closed.Memo.GetFields(), "memo-key")
+			},
+		},
+		{
+			name: "failed",
+			state: func() *persistencespb.WorkflowMutableState {
+				state := newClosedState()
+				state.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+				return state
+			}(),
+			history: func() []*historypb.HistoryEvent {
+				history := newClosedHistory()
+				history[3] = &historypb.HistoryEvent{
+					EventId:   9,
+					EventTime: timestamppb.New(closeTime),
+					EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED,
+					Attributes: &historypb.HistoryEvent_WorkflowExecutionFailedEventAttributes{
+						WorkflowExecutionFailedEventAttributes: &historypb.WorkflowExecutionFailedEventAttributes{
+							Failure: &failurepb.Failure{Message: "Payment declined"},
+						},
+					},
+				}
+				return history
+			}(),
+			expected: ReindexPageResponse{ExecutionsReindexed: 1},
+			expectedWrite: func(t *testing.T, request any) {
+				closed, ok := request.(*manager.RecordWorkflowExecutionClosedRequest)
+				require.True(t, ok)
+				// the full-text search attributes are added the same way the visibility queue adds them
+				searchAttributes := closed.SearchAttributes.GetIndexedFields()
+				assert.Equal(t, payload.EncodeString("payment declined"), searchAttributes[searchattribute.TemporalCloseFailureMessage])
+				assert.Equal(t, payload.EncodeString("memo value"), searchAttributes[searchattribute.TemporalMemoText])
+			},
+		},
+		{
+			name:  "closed_without_history",
+			state: newClosedState(),
+			expected: ReindexPageResponse{Unrecoverable: []UnrecoverableExecution{{
+				NamespaceID: testNamespace.ID().String(),
+				WorkflowID:  "closed-workflow-id",
+				RunID:       "closed-run-id",
+				Reason:      "history is neither in the history store nor archived",
+			}}},
+		},
+		{
+			name:     "zombie",
+			state:    newMutableState("zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE),
+			expected: ReindexPageResponse{ExecutionsSkipped: 1},
+		},
+		{
+			name:        "other_namespace",
+			state:       newRunningState(),
+			namespaceID: "other-namespace-id",
+			expected:    ReindexPageResponse{ExecutionsSkipped: 1},
+		},
+		{
+			name: "deleted_namespace",
+			state: func() *persistencespb.WorkflowMutableState {
+				state := newRunningState()
+				state.ExecutionInfo.NamespaceId = "deleted-namespace-id"
+				return state
+			}(),
+			expected: ReindexPageResponse{ExecutionsSkipped: 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			executionManager := persistence.NewMockExecutionManager(ctrl)
+			executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{
+				ShardID:  1,
+				PageSize: DefaultPageSize,
+			}).Return(&persistence.ListConcreteExecutionsResponse{
+				States: []*persistencespb.WorkflowMutableState{tc.state},
+			}, nil)
+			executionManager.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *persistence.ReadHistoryBranchRequest) (*persistence.ReadHistoryBranchResponse, error) {
+					assert.Equal(t, []byte("branch-token"), request.BranchToken)
+					if tc.history == nil {
+						return nil, serviceerror.NewNotFound("history not found")
+					}
+					// every test history starts at the first requested event
+					assert.Equal(t, tc.history[0].GetEventId(), request.MinEventID)
+					return &persistence.ReadHistoryBranchResponse{HistoryEvents: tc.history}, nil
+				},
+			).AnyTimes()
+			historyArchiver := archiver.NewMockHistoryArchiver(ctrl)
+			historyArchiver.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ archiver.URI, request *archiver.GetHistoryRequest) (*archiver.GetHistoryResponse, error) {
+					assert.Equal(t, "closed-run-id", request.RunID)
+					return &archiver.GetHistoryResponse{
+						HistoryBatches: []*historypb.History{{Events: tc.archivedHistory}},
+					}, nil
+				},
+			).AnyTimes()
+			archiverProvider := provider.NewMockArchiverProvider(ctrl)
+			archiverProvider.EXPECT().GetHistoryArchiver("test").Return(historyArchiver, nil).AnyTimes()
+			writer := newTestStores(ctrl, true)
+			a := &activities{
+				executionManager:  executionManager,
+				namespaceRegistry: newTestNamespaceRegistry(ctrl),
+				visibilityManager: newTestStores(ctrl, true).manager(),
+				writer: &lazyWriter{factory: func() (manager.VisibilityManager, error) {
+					return writer.manager(), nil
+				}},
+				archiverProvider:  archiverProvider,
+				indexCloseFailure: dynamicconfig.GetBoolPropertyFnFilteredByNamespace(true),
+				indexedMemoFields: func(string) []string { return []string{"memo-key"} },
+				fullTextMaxBytes:  dynamicconfig.GetIntPropertyFnFilteredByNamespace(0),
+			}
+
+			resp, err := a.reindexPage(context.Background(), PageRequest{
+				ShardID:     1,
+				NamespaceID: tc.namespaceID,
+				TargetStore: "secondary",
+				PageSize:    DefaultPageSize,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, resp)
+			assert.Empty(t, writer.written["primary"])
+			if tc.expectedWrite == nil {
+				assert.Empty(t, writer.written["secondary"])
+				return
+			}
+			require.Len(t, writer.written["secondary"], 1)
+			tc.expectedWrite(t, writer.written["secondary"][0])
+		})
+	}
+}
+
+func TestVerifyPage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			States: []*persistencespb.WorkflowMutableState{
+				newRunningState(),
+				newClosedState(),
+				newMutableState("zombie", enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE),
+			},
+			PageToken: []byte{42},
+		}, nil)
+	stores := newTestStores(ctrl, true)
+	stores.records["primary"] = map[string]*workflowpb.WorkflowExecutionInfo{
+		"running-run-id": newRecord(),
+		"closed-run-id":  newRecord(),
+	}
+	stores.records["secondary"] = map[string]*workflowpb.WorkflowExecutionInfo{
+		"running-run-id": newRecord(),
+	}
+	a := &activities{
+		executionManager:  executionManager,
+		namespaceRegistry: newTestNamespaceRegistry(ctrl),
+		visibilityManager: stores.manager(),
+	}
+
+	resp, err := a.verifyPage(context.Background(), PageRequest{ShardID: 1, PageSize: DefaultPageSize})
+	require.NoError(t, err)
+	assert.Equal(t, VerifyPageResponse{
+		ExecutionsVerified: 2,
+		Mismatches: []Mismatch{{
+			NamespaceID: testNamespace.ID().String(),
+			WorkflowID:  "closed-workflow-id",
+			RunID:       "closed-run-id",
+			Reason:      "missing in secondary store",
+		}},
+		NextPageToken: []byte{42},
+	}, resp)
+}
+
+func TestCompareRecords(t *testing.T) {
+	t.Parallel()
+
+	withSearchAttribute := newRecord()
+	withSearchAttribute.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("value"),
+	}}
+	closed := newRecord()
+	closed.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	// Elasticsearch stores timestamps with millisecond precision
+	truncatedStart := newRecord()
+	truncatedStart.StartTime = timestamppb.New(startTime.Add(time.Microsecond))
+
+	for _, tc := range []struct {
+		name      string
+		primary   *workflowpb.WorkflowExecutionInfo
+		secondary *workflowpb.WorkflowExecutionInfo
+		expected  string
+	}{
+		{"both_missing", nil, nil, ""},
+		{"equal", newRecord(), truncatedStart, ""},
+		{"missing_in_secondary", newRecord(), nil, "missing in secondary store"},
+		{"missing_in_primary", nil, newRecord(), "missing in primary store"},
+		{"status", newRecord(), closed, "status Running != Completed"},
+		{"search_attribute", withSearchAttribute, newRecord(), "search attribute CustomKeywordField missing in secondary store"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, compareRecords(tc.primary, tc.secondary))
+		})
+	}
+}
+
+// testStores fakes the primary and, if configured, the secondary visibility store. Reads are served from records and
+// writes are collected in written, both keyed by store name.
+type testStores struct {
+	ctrl      *gomock.Controller
+	secondary bool
+	records   map[string]map[string]*workflowpb.WorkflowExecutionInfo
+	written   map[string][]any
+}
+
+func newTestStores(ctrl *gomock.Controller, secondary bool) *testStores {
+	return &testStores{
+		ctrl:      ctrl,
+		secondary: secondary,
+		records:   map[string]map[string]*workflowpb.WorkflowExecutionInfo{},
+		written:   map[string][]any{},
+	}
+}
+
+func (s *testStores) manager() manager.VisibilityManager {
+	if !s.secondary {
+		return s.newStore("primary")
+	}
+	return visibility.NewVisibilityManagerDual(s.newStore("primary"), s.newStore("secondary"), nil, nil)
+}
+
+func (s *testStores) newStore(storeName string) manager.VisibilityManager {
+	store := manager.NewMockVisibilityManager(s.ctrl)
+	store.EXPECT().GetStoreNames().Return([]string{storeName}).AnyTimes()
+	store.EXPECT().HasStoreName(gomock.Any()).DoAndReturn(func(name string) bool {
+		return name == storeName
+	}).AnyTimes()
+	store.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+			record, ok := s.records[storeName][request.RunID]
+			if !ok {
+				return nil, serviceerror.NewNotFound("workflow execution not found")
+			}
+			return &manager.GetWorkflowExecutionResponse{Execution: record}, nil
+		},
+	).AnyTimes()
+	// every page in these tests has a single execution, so writes don't need to be synchronized
+	store.EXPECT().UpsertWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.UpsertWorkflowExecutionRequest) error {
+			s.written[storeName] = append(s.written[storeName], request)
+			return nil
+		},
+	).AnyTimes()
+	store.EXPECT().RecordWorkflowExecutionClosed(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.RecordWorkflowExecutionClosedRequest) error {
+			s.written[storeName] = append(s.written[storeName], request)
+			return nil
+		},
+	).AnyTimes()
+	return store
+}
+
+func newTestNamespaceRegistry(ctrl *gomock.Controller) namespace.Registry {
+	namespaceRegistry := namespace.NewMockRegistry(ctrl)
+	namespaceRegistry.EXPECT().GetNamespaceByID(testNamespace.ID()).Return(testNamespace, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(archivedNamespace.ID()).Return(archivedNamespace, nil).AnyTimes()
+	namespaceRegistry.EXPECT().GetNamespaceByID(namespace.ID("deleted-namespace-id")).
+		Return(nil, serviceerror.NewNamespaceNotFound("deleted-namespace-id")).AnyTimes()
+	return namespaceRegistry
+}
+
+func newRunningState() *persistencespb.WorkflowMutableState {
+	state := newMutableState("running", enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING)
+	state.ExecutionInfo.LastFirstEventTxnId = 7
+	state.ExecutionInfo.SearchAttributes = map[string]*commonpb.Payload{
+		"CustomKeywordField": payload.EncodeString("value"),
+	}
+	return state
+}
+
+// newClosedState returns a closed execution whose memo and search attributes were removed from mutable state.
+func newClosedState() *persistencespb.WorkflowMutableState {
+	state := newMutableState("closed", enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED)
+	state.ExecutionState.Status = enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+	state.ExecutionInfo.CloseTime = timestamppb.New(closeTime)
+	state.ExecutionInfo.CloseVisibilityTaskId = 11
+	state.ExecutionInfo.RelocatableAttributesRemoved = true
+	state.ExecutionInfo.CompletionEventBatchId = 9
+	state.ExecutionInfo.VersionHistories = &historyspb.VersionHistories{
+		Histories: []*historyspb.VersionHistory{{BranchToken: []byte("branch-token")}},
+	}
+	state.NextEventId = 10
+	return state
+}
+
+// newClosedHistory returns the history of the execution of newClosedState.
+func newClosedHistory() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+						"memo-key": payload.EncodeString("memo-value"),
+					}},
+					SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+						"CustomKeywordField":  payload.EncodeString("value"),
+						"RemovedKeywordField": payload.EncodeString("value"),
+					}},
+				},
+			},
+		},
+		{
+			EventId:   5,
+			EventType: enumspb.EVENT_TYPE_UPSERT_WORKFLOW_SEARCH_ATTRIBUTES,
+			Attributes: &historypb.HistoryEvent_UpsertWorkflowSearchAttributesEventAttributes{
+				UpsertWorkflowSearchAttributesEventAttributes: &historypb.UpsertWorkflowSearchAttributesEventAttributes{
+					SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+						"UpsertedKeywordField": payload.EncodeString("value"),
+						"RemovedKeywordField":  nilPayload,
+					}},
+				},
+			},
+		},
+		{
+			EventId:   6,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED,
+			Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedEventAttributes{
+				WorkflowPropertiesModifiedEventAttributes: &historypb.WorkflowPropertiesModifiedEventAttributes{
+					UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+						"upserted-memo-key": payload.EncodeString("value"),
+					}},
+				},
+			},
+		},
+		{
+			EventId:   9,
+			EventTime: timestamppb.New(closeTime),
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+				WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{},
+			},
+		},
+	}
+}
+
+func newMutableState(prefix string, state enumsspb.WorkflowExecutionState) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			NamespaceId:      testNamespace.ID().String(),
+			WorkflowId:       prefix + "-workflow-id",
+			WorkflowTypeName: "my-workflow-type",
+			TaskQueue:        "my-task-queue",
+			ExecutionTime:    timestamppb.New(startTime),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			RunId:     prefix + "-run-id",
+			State:     state,
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			StartTime: timestamppb.New(startTime),
+		},
+	}
+}
+
+func newRecord() *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Type:      &commonpb.WorkflowType{Name: "my-workflow-type"},
+		StartTime: timestamppb.New(startTime),
+		CloseTime: timestamppb.New(closeTime),
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		TaskQueue: "my-task-queue",
+		Memo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			"memo-key": payload.EncodeString("memo-value"),
+		}},
+	}
+}
@@ -0,0 +1,81 @@
+package visibilityreindex
+
+import (
+	"go.temporal.io/sdk/activity"
+	sdkworker "go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/archiver/provider"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	workercommon "go.temporal.io/server/service/worker/common"
+	"go.uber.org/fx"
+)
+
+type (
+	componentParams struct {
+		fx.In
+		PersistenceConfig *config.Persistence
+		ExecutionManager  persistence.ExecutionManager
+		NamespaceRegistry namespace.Registry
+		VisibilityManager manager.VisibilityManager
+		WriterFactory     WriterFactory
+		ArchiverProvider  provider.ArchiverProvider
+		DynamicCollection *dynamicconfig.Collection
+		Lifecycle         fx.Lifecycle
+	}
+
+	component struct {
+		activities *activities
+	}
+)
+
+// Module provides a [workercommon.WorkerComponent] annotated with [workercommon.WorkerComponentTag] to the graph,
+// given a [WriterFactory].
+var Module = workercommon.AnnotateWorkerComponentProvider(newComponent)
+
+func newComponent(params componentParams) workercommon.WorkerComponent {
+	writer := &lazyWriter{factory: params.WriterFactory}
+	params.Lifecycle.Append(fx.StopHook(writer.close))
+	return &component{
+		activities: &activities{
+			numShards:         params.PersistenceConfig.NumHistoryShards,
+			executionManager:  params.ExecutionManager,
+			namespaceRegistry: params.NamespaceRegistry,
+			visibilityManager: params.VisibilityManager,
+			writer:            writer,
+			archiverProvider:  params.ArchiverProvider,
+			indexCloseFailure: dynamicconfig.VisibilityIndexCloseFailure.Get(params.DynamicCollection),
+			indexedMemoFields: dynamicconfig.VisibilityIndexedMemoFields.Get(params.DynamicCollection),
+			fullTextMaxBytes:  dynamicconfig.VisibilityFullTextMaxBytes.Get(params.DynamicCollection),
+		},
+	}
+}
+
+func (c *component) RegisterWorkflow(registry sdkworker.Registry) {
+	registry.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+}
+
+func (c *component) DedicatedWorkflowWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
+
+func (c *component) RegisterActivities(registry sdkworker.Registry) {
+	registry.RegisterActivityWithOptions(c.activities.prepare, activity.RegisterOptions{
+		Name: prepareActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.reindexPage, activity.RegisterOptions{
+		Name: reindexPageActivityName,
+	})
+	registry.RegisterActivityWithOptions(c.activities.verifyPage, activity.RegisterOptions{
+		Name: verifyPageActivityName,
+	})
+}
+
+func (c *component) DedicatedActivityWorkerOptions() *workercommon.DedicatedWorkerOptions {
+	// use default worker
+	return nil
+}
@@ -0,0 +1,296 @@
+// Package visibilityreindex contains the system workflow that rebuilds visibility records from mutable state, e.g.
+// to backfill a new visibility store before switching reads to it, and optionally verifies that the primary and
+// secondary visibility stores agree.
+package visibilityreindex
+
+import (
+	"errors"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// WorkflowParams is the single argument to the visibility reindex workflow.
+	WorkflowParams struct {
+		// NamespaceID limits the reindex to a single namespace. Empty means all namespaces.
+		NamespaceID string
+		// TargetStore is the name of the visibility store the records are written to. Empty means the secondary store
+		// if one is configured, and the primary store otherwise.
+		TargetStore string
+		// PageSize controls the number of executions read from a shard at a time. The maximum is MaxPageSize. The
+		// default is DefaultPageSize.
+		PageSize int
+		// ExecutionsPerSecond limits how fast executions are processed. Zero means no limit.
+		ExecutionsPerSecond float64
+		// Verify runs a verification pass after the reindex that compares the records of the primary and secondary
+		// visibility stores.
+		Verify bool
+		// SkipReindex only runs the verification pass.
+		SkipReindex bool
+		// Reason is only used for logging.
+		Reason string
+
+		// Progress and NextPageToken carry the state of the reindex over ContinueAsNew.
+		Progress      Progress
+		NextPageToken []byte
+	}
+
+	// Progress is the response to QueryTypeProgress and the result of the workflow.
+	Progress struct {
+		// TargetStore and NumShards are empty until the workflow has validated its parameters.
+		TargetStore string
+		NumShards   int32
+
+		ShardsReindexed     int32
+		ExecutionsReindexed int64
+		// ExecutionsSkipped counts executions that have no visibility record, e.g. zombie runs and executions of
+		// deleted namespaces.
+		ExecutionsSkipped int64
+		// ExecutionsUnrecoverable counts closed executions that were skipped because their record can't be rebuilt:
+		// the history that is needed to rebuild it is neither in the history store nor archived.
+		ExecutionsUnrecoverable int64
+		// UnrecoverableSamples holds the first MaxUnrecoverableSamples unrecoverable executions.
+		UnrecoverableSamples []UnrecoverableExecution
+
+		ShardsVerified     int32
+		ExecutionsVerified int64
+		Mismatches         int64
+		// MismatchSamples holds the first MaxMismatchSamples mismatches.
+		MismatchSamples []Mismatch
+	}
+
+	// Mismatch describes an execution whose records in the primary and secondary visibility stores differ.
+	Mismatch struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		Reason      string
+	}
+
+	// UnrecoverableExecution describes a closed execution whose visibility record can't be rebuilt.
+	UnrecoverableExecution struct {
+		NamespaceID string
+		WorkflowID  string
+		RunID       string
+		Reason      string
+	}
+
+	// PrepareResponse is the result of the activity that validates the parameters of the workflow.
+	PrepareResponse struct {
+		TargetStore string
+		NumShards   int32
+	}
+
+	// PageRequest is the argument of the activities that reindex or verify one page of executions of a shard.
+	PageRequest struct {
+		ShardID       int32
+		NamespaceID   string
+		TargetStore   string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// ReindexPageResponse is the result of the activity that reindexes one page of executions.
+	ReindexPageResponse struct {
+		ExecutionsReindexed int64
+		ExecutionsSkipped   int64
+		Unrecoverable       []UnrecoverableExecution
+		NextPageToken       []byte
+	}
+
+	// VerifyPageResponse is the result of the activity that verifies one page of executions.
+	VerifyPageResponse struct {
+		ExecutionsVerified int64
+		Mismatches         []Mismatch
+		NextPageToken      []byte
+	}
+)
+
+const (
+	// WorkflowName is the name of the visibility reindex workflow.
+	WorkflowName = "temporal-sys-visibility-reindex-workflow"
+	// WorkflowID is the ID of the visibility reindex workflow. Using a fixed ID guarantees that only one reindex runs
+	// at a time.
+	WorkflowID = WorkflowName
+	// MaxPageSize is the maximum value for WorkflowParams.PageSize.
+	MaxPageSize = 1000
+	// DefaultPageSize is the default value for WorkflowParams.PageSize.
+	DefaultPageSize = 100
+	// MaxMismatchSamples is the maximum number of mismatches kept in Progress.MismatchSamples.
+	MaxMismatchSamples = 100
+	// MaxUnrecoverableSamples is the maximum number of executions kept in Progress.UnrecoverableSamples.
+	MaxUnrecoverableSamples = 100
+	// QueryTypeProgress is the query to get the Progress of the workflow.
+	QueryTypeProgress = "visibility-reindex-progress-query"
+
+	errorTypeInvalidRequest = "visibility-reindex-error-type-invalid-request"
+	prepareActivityName     = "visibility-reindex-prepare-activity"
+	reindexPageActivityName = "visibility-reindex-reindex-page-activity"
+	verifyPageActivityName  = "visibility-reindex-verify-page-activity"
+
+	// activityTimeout is short because every activity only processes a single page of executions.
+	activityTimeout = 30 * time.Second * debug.TimeoutMultiplier
+)
+
+var (
+	ErrNegativePageSize = errors.New("PageSize must be positive or 0 to use the default")
+	ErrPageSizeTooLarge = errors.New("PageSize too large")
+	ErrNegativeRate     = errors.New("ExecutionsPerSecond must not be negative")
+	ErrNothingToDo      = errors.New("SkipReindex requires Verify")
+
+	activityRetryPolicy = &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+	}
+)
+
+// Workflow walks the executions of every history shard one page at a time and writes a visibility record for each of
+// them to the target store, sleeping between pages to stay under the requested rate. If requested, it then walks all
+// shards a second time and compares the records of the primary and secondary stores. Both passes can be resumed from
+// the last page after ContinueAsNew.
+func Workflow(ctx workflow.Context, params WorkflowParams) (Progress, error) {
+	logger := workflow.GetLogger(ctx)
+	params, err := parseParams(params)
+	if err != nil {
+		return params.Progress, err
+	}
+	progress := &params.Progress
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (Progress, error) {
+		return *progress, nil
+	}); err != nil {
+		return *progress, err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: activityTimeout,
+		RetryPolicy:         activityRetryPolicy,
+	})
+
+	if progress.NumShards == 0 {
+		var response PrepareResponse
+		if err := workflow.ExecuteActivity(ctx, prepareActivityName, params).Get(ctx, &response); err != nil {
+			return *progress, err
+		}
+		progress.TargetStore = response.TargetStore
+		progress.NumShards = response.NumShards
+		if params.SkipReindex {
+			progress.ShardsReindexed = progress.NumShards
+		}
+		logger.Info("Starting visibility reindex.",
+			tag.WorkflowNamespaceID(params.NamespaceID),
+			tag.NewStringTag("target-store", progress.TargetStore),
+			tag.NewInt32("num-shards", progress.NumShards),
+			tag.NewBoolTag("verify", params.Verify),
+			tag.NewStringTag("reason", params.Reason),
+		)
+	}
+
+	for progress.ShardsReindexed < progress.NumShards {
+		var response ReindexPageResponse
+		err := workflow.ExecuteActivity(ctx, reindexPageActivityName, newPageRequest(params, progress.ShardsReindexed)).
+			Get(ctx, &response)
+		if err != nil {
+			return *progress, err
+		}
+		progress.ExecutionsReindexed += response.ExecutionsReindexed
+		progress.ExecutionsSkipped += response.ExecutionsSkipped
+		progress.ExecutionsUnrecoverable += int64(len(response.Unrecoverable))
+		for _, execution := range response.Unrecoverable {
+			if len(progress.UnrecoverableSamples) >= MaxUnrecoverableSamples {
+				break
+			}
+			progress.UnrecoverableSamples = append(progress.UnrecoverableSamples, execution)
+		}
+		if len(response.NextPageToken) == 0 {
+			progress.ShardsReindexed++
+		}
+		processed := response.ExecutionsReindexed + response.ExecutionsSkipped + int64(len(response.Unrecoverable))
+		if err := nextPage(ctx, &params, response.NextPageToken, processed); err != nil {
+			return *progress, err
+		}
+	}
+
+	if !params.Verify {
+		return *progress, nil
+	}
+
+	for progress.ShardsVerified < progress.NumShards {
+		var response VerifyPageResponse
+		err := workflow.ExecuteActivity(ctx, verifyPageActivityName, newPageRequest(params, progress.ShardsVerified)).
+			Get(ctx, &response)
+		if err != nil {
+			return *progress, err
+		}
+		progress.ExecutionsVerified += response.ExecutionsVerified
+		progress.Mismatches += int64(len(response.Mismatches))
+		for _, mismatch := range response.Mismatches {
+			if len(progress.MismatchSamples) >= MaxMismatchSamples {
+				break
+			}
+			progress.MismatchSamples = append(progress.MismatchSamples, mismatch)
+		}
+		if len(response.NextPageToken) == 0 {
+			progress.ShardsVerified++
+		}
+		if err := nextPage(ctx, &params, response.NextPageToken, response.ExecutionsVerified); err != nil {
+			return *progress, err
+		}
+	}
+
+	return *progress, nil
+}
+
+// newPageRequest returns the request for the next page of a shard, given the number of shards that are done. Shard
+// IDs start at 1.
+func newPageRequest(params WorkflowParams, shardsDone int32) PageRequest {
+	return PageRequest{
+		ShardID:       shardsDone + 1,
+		NamespaceID:   params.NamespaceID,
+		TargetStore:   params.Progress.TargetStore,
+		PageSize:      params.PageSize,
+		NextPageToken: params.NextPageToken,
+	}
+}
+
+// nextPage stores the page token of the next page, sleeps to stay under the requested rate and continues as new when
+// the history of the workflow gets too large.
+func nextPage(ctx workflow.Context, params *WorkflowParams, nextPageToken []byte, processed int64) error {
+	params.NextPageToken = nextPageToken
+	if params.ExecutionsPerSecond > 0 && processed > 0 {
+		wait := time.Duration(float64(processed) / params.ExecutionsPerSecond * float64(time.Second))
+		if err := workflow.Sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+	if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+		return workflow.NewContinueAsNewError(ctx, WorkflowName, *params)
+	}
+	return nil
+}
+
+func parseParams(params WorkflowParams) (WorkflowParams, error) {
+	var err error
+	switch {
+	case params.PageSize < 0:
+		err = ErrNegativePageSize
+	case params.PageSize > MaxPageSize:
+		err = ErrPageSizeTooLarge
+	case params.ExecutionsPerSecond < 0:
+		err = ErrNegativeRate
+	case params.SkipReindex && !params.Verify:
+		err = ErrNothingToDo
+	}
+	if err != nil {
+		return params, temporal.NewNonRetryableApplicationError(err.Error(), errorTypeInvalidRequest, err)
+	}
+
+	if params.PageSize == 0 {
+		params.PageSize = DefaultPageSize
+	}
+	return params, nil
+}
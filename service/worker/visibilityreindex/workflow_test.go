@@ -0,0 +1,178 @@
+package visibilityreindex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestWorkflow_InvalidParams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		params   WorkflowParams
+		expected error
+	}{
+		{"negative_page_size", WorkflowParams{PageSize: -1}, ErrNegativePageSize},
+		{"page_size_too_large", WorkflowParams{PageSize: MaxPageSize + 1}, ErrPageSizeTooLarge},
+		{"negative_rate", WorkflowParams{ExecutionsPerSecond: -1}, ErrNegativeRate},
+		{"skip_reindex_without_verify", WorkflowParams{SkipReindex: true}, ErrNothingToDo},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			env.ExecuteWorkflow(Workflow, tc.params)
+
+			var applicationErr *temporal.ApplicationError
+			require.ErrorAs(t, env.GetWorkflowError(), &applicationErr)
+			assert.True(t, applicationErr.NonRetryable())
+			assert.ErrorContains(t, applicationErr, tc.expected.Error())
+		})
+	}
+}
+
+func TestWorkflow_Reindex(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	env.OnActivity(prepareActivityName, mock.Anything, mock.Anything).
+		Return(PrepareResponse{TargetStore: "secondary", NumShards: 2}, nil).Once()
+	var requests []PageRequest
+	env.OnActivity(reindexPageActivityName, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, request PageRequest) (ReindexPageResponse, error) {
+			requests = append(requests, request)
+			if request.ShardID == 1 && len(request.NextPageToken) == 0 {
+				return ReindexPageResponse{
+					ExecutionsReindexed: 2,
+					ExecutionsSkipped:   1,
+					Unrecoverable:       []UnrecoverableExecution{{RunID: "unrecoverable-run-id", Reason: "no record"}},
+					NextPageToken:       []byte{42},
+				}, nil
+			}
+			return ReindexPageResponse{ExecutionsReindexed: 1}, nil
+		})
+
+	env.ExecuteWorkflow(Workflow, WorkflowParams{NamespaceID: "my-namespace-id"})
+	require.NoError(t, env.GetWorkflowError())
+	var progress Progress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	assert.Equal(t, Progress{
+		TargetStore:             "secondary",
+		NumShards:               2,
+		ShardsReindexed:         2,
+		ExecutionsReindexed:     4,
+		ExecutionsSkipped:       1,
+		ExecutionsUnrecoverable: 1,
+		UnrecoverableSamples:    []UnrecoverableExecution{{RunID: "unrecoverable-run-id", Reason: "no record"}},
+	}, progress)
+	assert.Equal(t, []PageRequest{
+		{ShardID: 1, NamespaceID: "my-namespace-id", TargetStore: "secondary", PageSize: DefaultPageSize},
+		{ShardID: 1, NamespaceID: "my-namespace-id", TargetStore: "secondary", PageSize: DefaultPageSize, NextPageToken: []byte{42}},
+		{ShardID: 2, NamespaceID: "my-namespace-id", TargetStore: "secondary", PageSize: DefaultPageSize},
+	}, requests)
+}
+
+func TestWorkflow_Verify(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		skipReindex bool
+	}{
+		{"reindex_and_verify", false},
+		{"verify_only", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := newTestEnv()
+			env.OnActivity(prepareActivityName, mock.Anything, mock.Anything).
+				Return(PrepareResponse{TargetStore: "secondary", NumShards: 1}, nil).Once()
+			reindexed := false
+			env.OnActivity(reindexPageActivityName, mock.Anything, mock.Anything).
+				Run(func(mock.Arguments) { reindexed = true }).
+				Return(ReindexPageResponse{ExecutionsReindexed: 1}, nil).Maybe()
+			// more mismatches than samples are kept
+			mismatches := make([]Mismatch, MaxMismatchSamples+1)
+			for i := range mismatches {
+				mismatches[i] = Mismatch{RunID: fmt.Sprintf("run-id-%d", i), Reason: "missing in secondary store"}
+			}
+			env.OnActivity(verifyPageActivityName, mock.Anything, mock.Anything).
+				Return(VerifyPageResponse{ExecutionsVerified: 200, Mismatches: mismatches}, nil).Once()
+
+			env.ExecuteWorkflow(Workflow, WorkflowParams{Verify: true, SkipReindex: tc.skipReindex})
+			require.NoError(t, env.GetWorkflowError())
+			var progress Progress
+			require.NoError(t, env.GetWorkflowResult(&progress))
+			assert.Equal(t, !tc.skipReindex, reindexed)
+			assert.EqualValues(t, 1, progress.ShardsReindexed)
+			assert.EqualValues(t, 1, progress.ShardsVerified)
+			assert.EqualValues(t, 200, progress.ExecutionsVerified)
+			assert.EqualValues(t, MaxMismatchSamples+1, progress.Mismatches)
+			assert.Equal(t, mismatches[:MaxMismatchSamples], progress.MismatchSamples)
+		})
+	}
+}
+
+func TestWorkflow_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	env.OnActivity(prepareActivityName, mock.Anything, mock.Anything).
+		Return(PrepareResponse{TargetStore: "primary", NumShards: 1}, nil)
+	env.OnActivity(reindexPageActivityName, mock.Anything, mock.Anything).
+		Return(ReindexPageResponse{ExecutionsReindexed: 40, ExecutionsSkipped: 10}, nil)
+
+	start := env.Now()
+	env.ExecuteWorkflow(Workflow, WorkflowParams{ExecutionsPerSecond: 10})
+	require.NoError(t, env.GetWorkflowError())
+	assert.GreaterOrEqual(t, env.Now().Sub(start).Seconds(), 5.0)
+}
+
+func TestWorkflow_Progress(t *testing.T) {
+	t.Parallel()
+
+	env := newTestEnv()
+	env.OnActivity(prepareActivityName, mock.Anything, mock.Anything).
+		Return(PrepareResponse{TargetStore: "primary", NumShards: 1}, nil)
+	env.OnActivity(reindexPageActivityName, mock.Anything, mock.Anything).
+		Return(ReindexPageResponse{ExecutionsReindexed: 3}, nil)
+
+	env.ExecuteWorkflow(Workflow, WorkflowParams{})
+	require.NoError(t, env.GetWorkflowError())
+	resp, err := env.QueryWorkflow(QueryTypeProgress)
+	require.NoError(t, err)
+	var progress Progress
+	require.NoError(t, resp.Get(&progress))
+	assert.Equal(t, Progress{
+		TargetStore:         "primary",
+		NumShards:           1,
+		ShardsReindexed:     1,
+		ExecutionsReindexed: 3,
+	}, progress)
+}
+
+// newTestEnv returns a test environment with all activities registered, to be mocked by each test.
+func newTestEnv() *testsuite.TestWorkflowEnvironment {
+	env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(Workflow, workflow.RegisterOptions{Name: WorkflowName})
+	a := &activities{}
+	for name, fn := range map[string]any{
+		prepareActivityName:     a.prepare,
+		reindexPageActivityName: a.reindexPage,
+		verifyPageActivityName:  a.verifyPage,
+	} {
+		env.RegisterActivityWithOptions(fn, activity.RegisterOptions{Name: name})
+	}
+	return env
+}
@@ -1094,17 +1094,39 @@ func (s *AdvancedVisibilitySuite) TestCountGroupByWorkflow() {
 		resp.Groups[1],
 	)
 
-	query = `GROUP BY WorkflowType`
+	query = fmt.Sprintf(`WorkflowType = %q GROUP BY ExecutionStatus, WorkflowType`, wt)
+	countRequest.Query = query
+	resp, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
+	s.NoError(err)
+	s.Equal(int64(numWorkflows), resp.GetCount())
+	s.Equal(2, len(resp.Groups))
+	wtPayload, _ := searchattribute.EncodeValue(wt, enumspb.INDEXED_VALUE_TYPE_KEYWORD)
+	s.ProtoEqual(
+		&workflowservice.CountWorkflowExecutionsResponse_AggregationGroup{
+			GroupValues: []*commonpb.Payload{runningStatusPayload, wtPayload},
+			Count:       int64(numWorkflows - numClosedWorkflows),
+		},
+		resp.Groups[0],
+	)
+
+	query = fmt.Sprintf(`WorkflowType = %q GROUP BY date_trunc('month', StartTime)`, wt)
+	countRequest.Query = query
+	resp, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
+	s.NoError(err)
+	s.Equal(int64(numWorkflows), resp.GetCount())
+	s.NotEmpty(resp.Groups)
+
+	query = `GROUP BY StartTime`
 	countRequest.Query = query
 	_, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause is only supported for ExecutionStatus search attribute")
+	s.Contains(err.Error(), "'group by' clause is only supported for search attributes of type")
 
-	query = `GROUP BY ExecutionStatus, WorkflowType`
+	query = `GROUP BY ExecutionStatus, ExecutionStatus`
 	countRequest.Query = query
 	_, err = s.FrontendClient().CountWorkflowExecutions(testcore.NewContext(), countRequest)
 	s.Error(err)
-	s.Contains(err.Error(), "'group by' clause supports only a single field")
+	s.Contains(err.Error(), "appears more than once in 'group by' clause")
 }
 
 func (s *AdvancedVisibilitySuite) createStartWorkflowExecutionRequest(id, wt, tl string) *workflowservice.StartWorkflowExecutionRequest {
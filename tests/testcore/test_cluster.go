@@ -410,6 +410,9 @@ func setupIndex(esConfig *esclient.Config, logger log.Logger) error {
 	}
 
 	indexTemplateFile := path.Join(testutils.GetRepoRootDirectory(), "schema/elasticsearch/visibility/index_template_v7.json")
+	if esConfig.IsOpenSearch() {
+		indexTemplateFile = path.Join(testutils.GetRepoRootDirectory(), "schema/opensearch/visibility/index_template_v2.json")
+	}
 	logger.Info("Creating index template.", tag.NewStringTag("templatePath", indexTemplateFile))
 	template, err := os.ReadFile(indexTemplateFile)
 	if err != nil {
@@ -0,0 +1,73 @@
+package tdbg
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+// AdminListCallbacks lists the callbacks of the workflows matching a visibility query
+func AdminListCallbacks(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	var states []enumsspb.CallbackState
+	for _, s := range c.StringSlice(FlagCallbackState) {
+		state, err := StringToEnum(s, enumsspb.CallbackState_value)
+		if err != nil {
+			return fmt.Errorf("invalid callback state: %v", err)
+		}
+		states = append(states, enumsspb.CallbackState(state))
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	var nextPageToken []byte
+	for {
+		response, err := client.ListCallbacks(ctx, &adminservice.ListCallbacksRequest{
+			Namespace:     namespace,
+			Query:         c.String(FlagQuery),
+			States:        states,
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list callbacks: %v", err)
+		}
+		prettyPrintJSONObject(c, response)
+		nextPageToken = response.GetNextPageToken()
+		if len(nextPageToken) == 0 || !c.Bool(FlagMore) {
+			return nil
+		}
+	}
+}
+
+// AdminRetryCallback schedules a failed callback for delivery again
+func AdminRetryCallback(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.RetryCallback(ctx, &adminservice.RetryCallbackRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: c.String(FlagWorkflowID),
+			RunId:      c.String(FlagRunID),
+		},
+		CallbackId: c.String(FlagCallbackID),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to retry callback: %v", err)
+	}
+	fmt.Fprintln(c.App.Writer, "Callback scheduled for retry.")
+	return nil
+}
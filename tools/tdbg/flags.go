@@ -70,4 +70,55 @@ var (
 	FlagAllActive                  = "select-all-active"
 	FlagFair                       = "fair"
 	FlagMinPass                    = "min-pass"
+	FlagWorkflowType               = "workflow-type"
+	FlagActivityType               = "activity-type"
+	FlagPriorityKey                = "priority-key"
+	FlagFairnessKey                = "fairness-key"
+	FlagDestinationTaskQueue       = "destination-task-queue"
+	FlagDryRun                     = "dry-run"
+	FlagTasksPerSecond             = "tasks-per-second"
+	FlagSetWeight                  = "set-weight"
+	FlagUnsetWeight                = "unset-weight"
+	FlagDefaultWeight              = "default-weight"
+	FlagTopN                       = "top"
+	FlagPauseMode                  = "mode"
+	FlagStartTime                  = "start-time"
+	FlagEndTime                    = "end-time"
+	FlagBlockIdentity              = "block"
+	FlagUnblockIdentity            = "unblock"
+	FlagBlockDuration              = "block-duration"
+	FlagScheduleID                 = "schedule-id"
+	FlagScheduleSpec               = "spec"
+	FlagCronString                 = "cron"
+	FlagOverlapPolicy              = "overlap-policy"
+	FlagCatchupWindow              = "catchup-window"
+	FlagRunDuration                = "run-duration"
+	FlagMaxActions                 = "max-actions"
+	FlagCalendarSet                = "calendar-set"
+	FlagCalendarSetDay             = "day"
+	FlagQuery                      = "query"
+	FlagCallbackState              = "state"
+	FlagCallbackID                 = "callback-id"
+	FlagEndpoint                   = "endpoint"
+	FlagCircuitBreakerOverride     = "override"
+	FlagDuration                   = "duration"
+	FlagAllowedNamespace           = "allowed-namespace"
+	FlagAllowedWorkflowType        = "allowed-workflow-type"
+	FlagSearchAttribute            = "search-attribute"
+	FlagPercentile                 = "percentile"
+	FlagTargetStore                = "target-store"
+	FlagExecutionsPerSecond        = "executions-per-second"
+	FlagVerify                     = "verify"
+	FlagSkipReindex                = "skip-reindex"
+	FlagNewName                    = "new-name"
+	FlagNewType                    = "new-type"
+	FlagSkipInvalidValues          = "skip-invalid-values"
+	FlagSavedQueryName             = "name"
+	FlagDescription                = "description"
+	FlagThreshold                  = "threshold"
+	FlagFireBelowThreshold         = "fire-below-threshold"
+	FlagInterval                   = "interval"
+	FlagWebhookURL                 = "webhook-url"
+	FlagWebhookHeader              = "webhook-header"
+	FlagCountOnly                  = "count-only"
 )
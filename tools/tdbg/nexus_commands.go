@@ -0,0 +1,90 @@
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// AdminGetNexusEndpoint shows a Nexus endpoint and the health of calls to it
+func AdminGetNexusEndpoint(c *cli.Context, clientFactory ClientFactory) error {
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.GetNexusEndpoint(ctx, &adminservice.GetNexusEndpointRequest{
+		Endpoint: c.String(FlagEndpoint),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to get Nexus endpoint: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminListNexusEndpoints lists Nexus endpoints and the health of calls to them
+func AdminListNexusEndpoints(c *cli.Context, clientFactory ClientFactory) error {
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	var nextPageToken []byte
+	for {
+		response, err := client.ListNexusEndpoints(ctx, &adminservice.ListNexusEndpointsRequest{
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list Nexus endpoints: %v", err)
+		}
+		prettyPrintJSONObject(c, response)
+		nextPageToken = response.GetNextPageToken()
+		if len(nextPageToken) == 0 || !c.Bool(FlagMore) {
+			return nil
+		}
+	}
+}
+
+// AdminSetNexusEndpointCircuitBreaker manually opens or closes the circuit breakers of a Nexus endpoint
+func AdminSetNexusEndpointCircuitBreaker(c *cli.Context, clientFactory ClientFactory) error {
+	override, err := StringToEnum(
+		"CIRCUIT_BREAKER_OVERRIDE_"+strings.ToUpper(c.String(FlagCircuitBreakerOverride)),
+		enumsspb.CircuitBreakerOverride_value,
+	)
+	if err != nil {
+		return fmt.Errorf("invalid override: %v", err)
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.SetNexusEndpointCircuitBreaker(ctx, &adminservice.SetNexusEndpointCircuitBreakerRequest{
+		Endpoint: c.String(FlagEndpoint),
+		Override: enumsspb.CircuitBreakerOverride(override),
+		Duration: durationpb.New(c.Duration(FlagDuration)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set Nexus endpoint circuit breaker: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminSetNexusEndpointAccessPolicy replaces the allowlist of callers of a Nexus endpoint
+func AdminSetNexusEndpointAccessPolicy(c *cli.Context, clientFactory ClientFactory) error {
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.SetNexusEndpointAccessPolicy(ctx, &adminservice.SetNexusEndpointAccessPolicyRequest{
+		Endpoint:                c.String(FlagEndpoint),
+		AllowedCallerNamespaces: c.StringSlice(FlagAllowedNamespace),
+		AllowedWorkflowTypes:    c.StringSlice(FlagAllowedWorkflowType),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set Nexus endpoint access policy: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
@@ -0,0 +1,157 @@
+package tdbg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultSchedulePreviewRange = 7 * 24 * time.Hour
+
+// AdminPreviewSchedule shows the actions that a schedule would take in a time range
+func AdminPreviewSchedule(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	spec := &schedulepb.ScheduleSpec{}
+	if c.IsSet(FlagScheduleSpec) {
+		if err := protojson.Unmarshal([]byte(c.String(FlagScheduleSpec)), spec); err != nil {
+			return fmt.Errorf("invalid --%s: %v", FlagScheduleSpec, err)
+		}
+	}
+	spec.CronString = append(spec.CronString, c.StringSlice(FlagCronString)...)
+
+	startTime, err := parseOptionalTimestamp(c, FlagStartTime)
+	if err != nil {
+		return err
+	}
+	if startTime == nil {
+		startTime = timestamppb.Now()
+	}
+	endTime, err := parseOptionalTimestamp(c, FlagEndTime)
+	if err != nil {
+		return err
+	}
+	if endTime == nil {
+		endTime = timestamppb.New(startTime.AsTime().Add(defaultSchedulePreviewRange))
+	}
+
+	policies := &schedulepb.SchedulePolicies{}
+	if c.IsSet(FlagOverlapPolicy) {
+		overlapPolicy, err := StringToEnum(c.String(FlagOverlapPolicy), enumspb.ScheduleOverlapPolicy_value)
+		if err != nil {
+			return fmt.Errorf("invalid overlap policy: %v", err)
+		}
+		policies.OverlapPolicy = enumspb.ScheduleOverlapPolicy(overlapPolicy)
+	}
+	if c.IsSet(FlagCatchupWindow) {
+		policies.CatchupWindow = durationpb.New(c.Duration(FlagCatchupWindow))
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.PreviewSchedule(ctx, &adminservice.PreviewScheduleRequest{
+		Namespace:          namespace,
+		ScheduleId:         c.String(FlagScheduleID),
+		Spec:               spec,
+		Policies:           policies,
+		StartTime:          startTime,
+		EndTime:            endTime,
+		RunDuration:        durationpb.New(c.Duration(FlagRunDuration)),
+		MaximumActionCount: int32(c.Int(FlagMaxActions)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to preview schedule: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminListScheduleActions lists the started and recently skipped actions of a schedule
+func AdminListScheduleActions(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	scheduleID, err := getRequiredOption(c, FlagScheduleID)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	var nextPageToken []byte
+	for {
+		response, err := client.ListScheduleActions(ctx, &adminservice.ListScheduleActionsRequest{
+			Namespace:     namespace,
+			ScheduleId:    scheduleID,
+			PageSize:      int32(c.Int(FlagPageSize)),
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list schedule actions: %v", err)
+		}
+		prettyPrintJSONObject(c, response)
+		nextPageToken = response.GetNextPageToken()
+		if len(nextPageToken) == 0 || !c.Bool(FlagMore) {
+			return nil
+		}
+	}
+}
+
+// AdminUpdateScheduleCalendarSet creates, replaces or deletes a calendar set of a namespace
+func AdminUpdateScheduleCalendarSet(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	name, err := getRequiredOption(c, FlagCalendarSet)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.UpdateScheduleCalendarSet(ctx, &adminservice.UpdateScheduleCalendarSetRequest{
+		Namespace: namespace,
+		Name:      name,
+		Days:      c.StringSlice(FlagCalendarSetDay),
+		Identity:  "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update calendar set: %v", err)
+	}
+	return nil
+}
+
+// AdminListScheduleCalendarSets lists the calendar sets of a namespace
+func AdminListScheduleCalendarSets(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.ListScheduleCalendarSets(ctx, &adminservice.ListScheduleCalendarSetsRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list calendar sets: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
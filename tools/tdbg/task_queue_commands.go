@@ -3,12 +3,19 @@ package tdbg
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // AdminListTaskQueueTasks displays task information
@@ -29,8 +36,6 @@ func AdminListTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error
 	minTaskID := c.Int64(FlagMinTaskID)
 	maxTaskID := c.Int64(FlagMaxTaskID)
 	pageSize := c.Int(FlagPageSize)
-	workflowID := c.String(FlagWorkflowID)
-	runID := c.String(FlagRunID)
 	subqueue := c.Int(FlagSubqueue)
 	var minPass int64
 	if c.Bool(FlagFair) {
@@ -49,6 +54,7 @@ func AdminListTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error
 		BatchSize:     int32(pageSize),
 		Subqueue:      int32(subqueue),
 		MinPass:       minPass,
+		Filter:        getTaskQueueTaskFilter(c),
 	}
 
 	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
@@ -61,25 +67,8 @@ func AdminListTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error
 			return nil, nil, err
 		}
 
-		tasks := response.Tasks
-		if workflowID != "" {
-			filteredTasks := tasks[:0]
-
-			for _, task := range tasks {
-				if task.Data.WorkflowId != workflowID {
-					continue
-				}
-				if runID != "" && task.Data.RunId != runID {
-					continue
-				}
-				filteredTasks = append(filteredTasks, task)
-			}
-
-			tasks = filteredTasks
-		}
-
 		var items []interface{}
-		for _, task := range tasks {
+		for _, task := range response.Tasks {
 			items = append(items, task)
 		}
 		return items, response.NextPageToken, nil
@@ -91,6 +80,378 @@ func AdminListTaskQueueTasks(c *cli.Context, clientFactory ClientFactory) error
 	return nil
 }
 
+// AdminDeleteTaskQueueTasks deletes tasks matching the given filter from a task queue backlog,
+// optionally moving them to another task queue
+func AdminDeleteTaskQueueTasks(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return fmt.Errorf("missing Task Queue type")
+	}
+	var minPass int64
+	if c.Bool(FlagFair) {
+		minPass = c.Int64(FlagMinPass)
+	} else if c.IsSet(FlagMinPass) {
+		return fmt.Errorf("flag --%s is only valid with --%s", FlagMinPass, FlagFair)
+	}
+	filter := getTaskQueueTaskFilter(c)
+	taskIDs := c.Int64Slice(FlagTaskID)
+	if filter == nil && len(taskIDs) == 0 {
+		return fmt.Errorf("at least one filter flag or --%s is required", FlagTaskID)
+	}
+	destination := c.String(FlagDestinationTaskQueue)
+	dryRun := c.Bool(FlagDryRun)
+
+	if !dryRun {
+		action := "Delete"
+		if destination != "" {
+			action = fmt.Sprintf("Move to task queue %s", destination)
+		}
+		prompter.Prompt(fmt.Sprintf("Namespace: %s TaskQueue: %s Type: %s\n%s all matching tasks?", namespace, tqName, tqType, action))
+	}
+
+	client := clientFactory.AdminClient(c)
+	req := &adminservice.DeleteTaskQueueTasksRequest{
+		Namespace:            namespace,
+		TaskQueue:            tqName,
+		TaskQueueType:        tqType,
+		Subqueue:             int32(c.Int(FlagSubqueue)),
+		MinPass:              minPass,
+		MinTaskId:            c.Int64(FlagMinTaskID),
+		MaxTaskId:            c.Int64(FlagMaxTaskID),
+		Filter:               filter,
+		TaskIds:              taskIDs,
+		DestinationTaskQueue: destination,
+		DryRun:               dryRun,
+		BatchSize:            int32(c.Int(FlagPageSize)),
+	}
+
+	var count int
+	for {
+		ctx, cancel := newContext(c)
+		response, err := client.DeleteTaskQueueTasks(ctx, req)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("unable to delete Task Queue Tasks: %v", err)
+		}
+		for _, task := range response.Tasks {
+			prettyPrintJSONObject(c, task)
+		}
+		count += len(response.Tasks)
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = response.NextPageToken
+	}
+
+	if dryRun {
+		_, _ = fmt.Fprintf(c.App.Writer, "%d tasks would be deleted\n", count)
+	} else {
+		_, _ = fmt.Fprintf(c.App.Writer, "%d tasks deleted. Unload the task queue partition to drop tasks it has already buffered.\n", count)
+	}
+	return nil
+}
+
+// AdminStartTaskQueueBacklogMigration starts moving the backlog of a task queue to another task queue
+func AdminStartTaskQueueBacklogMigration(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	source, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	destination, err := getRequiredOption(c, FlagDestinationTaskQueue)
+	if err != nil {
+		return err
+	}
+	prompter.Prompt(fmt.Sprintf("Namespace: %s\nMove all tasks of task queue %s to task queue %s?", namespace, source, destination))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.StartTaskQueueBacklogMigration(ctx, &adminservice.StartTaskQueueBacklogMigrationRequest{
+		Namespace:            namespace,
+		SourceTaskQueue:      source,
+		DestinationTaskQueue: destination,
+		BatchSize:            int32(c.Int(FlagPageSize)),
+		TasksPerSecond:       float32(c.Float64(FlagTasksPerSecond)),
+		Reason:               c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start Task Queue backlog migration: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminDescribeTaskQueueBacklogMigration displays the progress of the latest backlog migration of a task queue
+func AdminDescribeTaskQueueBacklogMigration(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	source, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DescribeTaskQueueBacklogMigration(ctx, &adminservice.DescribeTaskQueueBacklogMigrationRequest{
+		Namespace:       namespace,
+		SourceTaskQueue: source,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe Task Queue backlog migration: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminCancelTaskQueueBacklogMigration stops a running backlog migration of a task queue
+func AdminCancelTaskQueueBacklogMigration(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	source, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	prompter.Prompt(fmt.Sprintf("Namespace: %s\nCancel the backlog migration of task queue %s?", namespace, source))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.CancelTaskQueueBacklogMigration(ctx, &adminservice.CancelTaskQueueBacklogMigrationRequest{
+		Namespace:       namespace,
+		SourceTaskQueue: source,
+		Reason:          c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel Task Queue backlog migration: %v", err)
+	}
+	if !response.GetCanceled() {
+		_, _ = fmt.Fprintln(c.App.Writer, "No backlog migration is running for this task queue")
+	}
+	return nil
+}
+
+// AdminUpdateTaskQueueFairnessWeights updates the fairness weights of a task queue
+func AdminUpdateTaskQueueFairnessWeights(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := getTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+	setOverrides := make(map[string]float32)
+	for _, kv := range c.StringSlice(FlagSetWeight) {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return fmt.Errorf("invalid --%s %q, expected fairness-key=weight", FlagSetWeight, kv)
+		}
+		weight, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return fmt.Errorf("invalid weight for fairness key %q: %v", key, err)
+		}
+		setOverrides[key] = float32(weight)
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.UpdateTaskQueueFairnessWeights(ctx, &adminservice.UpdateTaskQueueFairnessWeightsRequest{
+		Namespace:           namespace,
+		TaskQueue:           tqName,
+		TaskQueueType:       tqType,
+		SetOverrides:        setOverrides,
+		UnsetOverrides:      c.StringSlice(FlagUnsetWeight),
+		UpdateDefaultWeight: c.IsSet(FlagDefaultWeight),
+		DefaultWeight:       float32(c.Float64(FlagDefaultWeight)),
+		Identity:            "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update fairness weights: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminDescribeTaskQueueFairnessKeys displays the top fairness keys of a task queue partition
+func AdminDescribeTaskQueueFairnessKeys(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := getTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DescribeTaskQueueFairnessKeys(ctx, &adminservice.DescribeTaskQueueFairnessKeysRequest{
+		Namespace: namespace,
+		TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+			TaskQueue:     tqName,
+			TaskQueueType: tqType,
+			PartitionId:   &taskqueuespb.TaskQueuePartition_NormalPartitionId{NormalPartitionId: int32(c.Int(FlagPartitionID))},
+		},
+		TopN: int32(c.Int(FlagTopN)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe fairness keys: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminUpdateTaskQueuePause pauses or drains a task queue, or removes its pause
+func AdminUpdateTaskQueuePause(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := getTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+	mode, err := StringToEnum(c.String(FlagPauseMode), enumsspb.TaskQueuePauseMode_value)
+	if err != nil {
+		return fmt.Errorf("invalid pause mode: %v", err)
+	}
+	startTime, err := parseOptionalTimestamp(c, FlagStartTime)
+	if err != nil {
+		return err
+	}
+	endTime, err := parseOptionalTimestamp(c, FlagEndTime)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.UpdateTaskQueuePause(ctx, &adminservice.UpdateTaskQueuePauseRequest{
+		Namespace:     namespace,
+		TaskQueue:     tqName,
+		TaskQueueType: tqType,
+		Mode:          enumsspb.TaskQueuePauseMode(mode),
+		StartTime:     startTime,
+		EndTime:       endTime,
+		Reason:        c.String(FlagReason),
+		Identity:      "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update task queue pause: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminUpdateTaskQueueBlockedPollers blocks or unblocks poller identities of a task queue
+func AdminUpdateTaskQueueBlockedPollers(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	tqName, err := getRequiredOption(c, FlagTaskQueue)
+	if err != nil {
+		return err
+	}
+	tqType, err := getTaskQueueType(c)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.UpdateTaskQueueBlockedPollers(ctx, &adminservice.UpdateTaskQueueBlockedPollersRequest{
+		Namespace:         namespace,
+		TaskQueue:         tqName,
+		TaskQueueType:     tqType,
+		BlockIdentities:   c.StringSlice(FlagBlockIdentity),
+		BlockDuration:     durationpb.New(c.Duration(FlagBlockDuration)),
+		Reason:            c.String(FlagReason),
+		UnblockIdentities: c.StringSlice(FlagUnblockIdentity),
+		Identity:          "tdbg",
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update blocked pollers: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+func parseOptionalTimestamp(c *cli.Context, flag string) (*timestamppb.Timestamp, error) {
+	if !c.IsSet(flag) {
+		return nil, nil
+	}
+	t, err := time.Parse(defaultDateTimeFormat, c.String(flag))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %v", flag, err)
+	}
+	return timestamppb.New(t), nil
+}
+
+func getTaskQueueType(c *cli.Context) (enumspb.TaskQueueType, error) {
+	tlTypeInt, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return enumspb.TASK_QUEUE_TYPE_UNSPECIFIED, fmt.Errorf("invalid task queue type: %v", err)
+	}
+	tqType := enumspb.TaskQueueType(tlTypeInt)
+	if tqType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		return tqType, fmt.Errorf("missing Task Queue type")
+	}
+	return tqType, nil
+}
+
+func getTaskQueueTaskFilter(c *cli.Context) *adminservice.TaskQueueTaskFilter {
+	filter := &adminservice.TaskQueueTaskFilter{
+		WorkflowId:   c.String(FlagWorkflowID),
+		RunId:        c.String(FlagRunID),
+		WorkflowType: c.String(FlagWorkflowType),
+		ActivityType: c.String(FlagActivityType),
+		PriorityKey:  int32(c.Int(FlagPriorityKey)),
+		FairnessKey:  c.String(FlagFairnessKey),
+	}
+	if proto.Equal(filter, &adminservice.TaskQueueTaskFilter{}) {
+		return nil
+	}
+	return filter
+}
+
 // AdminDescribeTaskQueuePartition displays task queue partition information
 func AdminDescribeTaskQueuePartition(c *cli.Context, clientFactory ClientFactory) error {
 	// extracting the namespace
@@ -10,6 +10,7 @@ import (
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/common"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 )
@@ -19,6 +20,7 @@ type (
 		adminservice.AdminServiceClient
 		describeTaskQueuePartitionFn    func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error)
 		forceUnloadTaskQueuePartitionFn func(request *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error)
+		deleteTaskQueueTasksFn          func(request *adminservice.DeleteTaskQueueTasksRequest) (*adminservice.DeleteTaskQueueTasksResponse, error)
 	}
 )
 
@@ -82,12 +84,16 @@ func (t *testClient) ForceUnloadTaskQueuePartition(_ context.Context, request *a
 	return t.forceUnloadTaskQueuePartitionFn(request)
 }
 
+func (t *testClient) DeleteTaskQueueTasks(_ context.Context, request *adminservice.DeleteTaskQueueTasksRequest, opts ...grpc.CallOption) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+	return t.deleteTaskQueueTasksFn(request)
+}
+
 func (s *taskQueueCommandTestSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.controller = gomock.NewController(s.T())
 
 	// injecting a test admin client
-	client := &testClient{
+	s.client = &testClient{
 		describeTaskQueuePartitionFn: func(request *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 			return &adminservice.DescribeTaskQueuePartitionResponse{}, nil
 		},
@@ -96,7 +102,7 @@ func (s *taskQueueCommandTestSuite) SetupTest() {
 		},
 	}
 	s.app = NewCliApp(func(params *Params) {
-		params.ClientFactory = client
+		params.ClientFactory = s.client
 	})
 	s.app.ExitErrHandler = func(context *cli.Context, err error) {}
 }
@@ -109,6 +115,7 @@ type taskQueueCommandTestSuite struct {
 	suite.Suite
 	controller *gomock.Controller
 	app        *cli.App
+	client     *testClient
 }
 
 // TestDescribeTaskQueuePartitionWithArgs tests that the cli accepts the various arguments for
@@ -163,3 +170,28 @@ func (s *taskQueueCommandTestSuite) TestForceUnloadTaskQueuePartition() {
 		}
 	}
 }
+
+// TestDeleteTaskQueueTasks tests that delete-tasks sends the filter and follows page tokens
+func (s *taskQueueCommandTestSuite) TestDeleteTaskQueueTasks() {
+	var requests []*adminservice.DeleteTaskQueueTasksRequest
+	s.client.deleteTaskQueueTasksFn = func(request *adminservice.DeleteTaskQueueTasksRequest) (*adminservice.DeleteTaskQueueTasksResponse, error) {
+		requests = append(requests, common.CloneProto(request))
+		if len(request.NextPageToken) == 0 {
+			return &adminservice.DeleteTaskQueueTasksResponse{NextPageToken: []byte("next")}, nil
+		}
+		return &adminservice.DeleteTaskQueueTasksResponse{}, nil
+	}
+
+	err := s.app.Run([]string{"tdbg", "taskqueue", "delete-tasks", "--task-queue", "test"})
+	s.ErrorContains(err, "at least one filter flag")
+	s.Empty(requests)
+
+	err = s.app.Run([]string{"tdbg", "--yes", "taskqueue", "delete-tasks", "--task-queue", "test",
+		"--workflow-type", "wf-type", "--activity-type", "poison", "--destination-task-queue", "other"})
+	s.NoError(err)
+	s.Len(requests, 2)
+	s.Equal("wf-type", requests[0].Filter.GetWorkflowType())
+	s.Equal("poison", requests[0].Filter.GetActivityType())
+	s.Equal("other", requests[0].DestinationTaskQueue)
+	s.Equal([]byte("next"), requests[1].NextPageToken)
+}
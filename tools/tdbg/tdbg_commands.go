@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 	commonpb "go.temporal.io/api/common/v1"
@@ -43,7 +44,27 @@ func getCommands(
 			Name:        "taskqueue",
 			Aliases:     []string{"tq"},
 			Usage:       "Run admin operation on taskQueue",
-			Subcommands: newAdminTaskQueueCommands(clientFactory),
+			Subcommands: newAdminTaskQueueCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "schedule",
+			Usage:       "Run admin operation on schedules",
+			Subcommands: newAdminScheduleCommands(clientFactory),
+		},
+		{
+			Name:        "callback",
+			Usage:       "Run admin operation on workflow callbacks",
+			Subcommands: newAdminCallbackCommands(clientFactory),
+		},
+		{
+			Name:        "nexus",
+			Usage:       "Run admin operation on Nexus endpoints",
+			Subcommands: newAdminNexusCommands(clientFactory),
+		},
+		{
+			Name:        "visibility",
+			Usage:       "Run admin operation on workflow visibility",
+			Subcommands: newAdminVisibilityCommands(clientFactory, prompterFactory),
 		},
 		{
 			Name:        "membership",
@@ -364,226 +385,839 @@ func getTaskCategoryFlag(taskCategoryRegistry tasks.TaskCategoryRegistry) *cli.S
 	return flag
 }
 
-func newAdminMembershipCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminScheduleCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
-			Name:  "list-gossip",
-			Usage: "List ringpop membership items",
+			Name:  "preview",
+			Usage: "Show the actions that a schedule with the given spec and policies would take in a time range, without creating it",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagClusterMembershipRole,
-					Value: "all",
-					Usage: "Membership role filter: all (default), frontend, history, matching, worker",
+					Name:  FlagScheduleSpec,
+					Usage: "ScheduleSpec in JSON format",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagCronString,
+					Usage: "Cron string to add to the spec, can be repeated",
+				},
+				&cli.StringFlag{
+					Name:  FlagScheduleID,
+					Usage: "Optional schedule id, to apply the same jitter as the schedule with this id",
+				},
+				&cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Start of the time range in RFC3339 format, defaults to now",
+				},
+				&cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "End of the time range in RFC3339 format, defaults to a week after the start",
+				},
+				&cli.StringFlag{
+					Name:  FlagOverlapPolicy,
+					Usage: "Overlap policy, e.g. SCHEDULE_OVERLAP_POLICY_BUFFER_ONE",
+				},
+				&cli.DurationFlag{
+					Name:  FlagCatchupWindow,
+					Usage: "Catchup window",
+				},
+				&cli.DurationFlag{
+					Name:  FlagRunDuration,
+					Usage: "How long each workflow is assumed to run, to apply the overlap policy",
+				},
+				&cli.IntFlag{
+					Name:  FlagMaxActions,
+					Value: 100,
+					Usage: "Maximum number of actions to show",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminListGossipMembers(c, clientFactory)
+				return AdminPreviewSchedule(c, clientFactory)
 			},
 		},
 		{
-			Name:  "list-db",
-			Usage: "List cluster membership items",
+			Name:  "list-actions",
+			Usage: "List the actions that a schedule started, with the status of their workflows, and the actions it skipped recently",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagHeartbeatedWithin,
-					Value: "15m",
-					Usage: "Filter by last heartbeat date time. Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
-						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
-						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+					Name:     FlagScheduleID,
+					Usage:    "Schedule id",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of started actions per page",
 				},
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List all pages, default is to list one page",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListScheduleActions(c, clientFactory)
+			},
+		},
+		{
+			Name:  "update-calendar-set",
+			Usage: "Create or replace a calendar set of the namespace that schedules can exclude, or delete it if no days are given",
+			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagClusterMembershipRole,
-					Value: "all",
-					Usage: "Membership role filter: all (default), frontend, history, matching, worker",
+					Name:     FlagCalendarSet,
+					Usage:    "Calendar set name",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagCalendarSetDay,
+					Usage: "Day of the set in 2006-01-02 format, can be repeated",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminListClusterMembers(c, clientFactory)
+				return AdminUpdateScheduleCalendarSet(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list-calendar-sets",
+			Usage: "List the calendar sets of the namespace",
+			Action: func(c *cli.Context) error {
+				return AdminListScheduleCalendarSets(c, clientFactory)
 			},
 		},
 	}
 }
 
-func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminCallbackCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
-			Name:    "describe",
-			Aliases: []string{"d"},
-			Usage:   "Describe internal information of history host",
+			Name:  "list",
+			Usage: "List the callbacks of the workflows matching a visibility query",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:    FlagWorkflowID,
-					Aliases: FlagWorkflowIDAlias,
-					Usage:   "Workflow ID",
+					Name:  FlagQuery,
+					Usage: "Visibility query selecting the workflows to inspect",
 				},
-				&cli.StringFlag{
-					Name:  FlagHistoryAddress,
-					Usage: "History Host address(IP:PORT)",
+				&cli.StringSliceFlag{
+					Name:  FlagCallbackState,
+					Usage: "Only list callbacks in this state, e.g. CALLBACK_STATE_FAILED, can be repeated",
 				},
 				&cli.IntFlag{
-					Name:  FlagShardID,
-					Usage: "ShardId",
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of workflows inspected per page",
 				},
 				&cli.BoolFlag{
-					Name:  FlagPrintFullyDetail,
-					Usage: "Print fully detail",
+					Name:  FlagMore,
+					Usage: "List all pages, default is to list one page",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminDescribeHistoryHost(c, clientFactory)
+				return AdminListCallbacks(c, clientFactory)
 			},
 		},
 		{
-			Name:  "get-shardid",
-			Usage: "Get shardId for a namespaceId and workflowId combination",
+			Name:  "retry",
+			Usage: "Schedule a failed callback for delivery again",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagNamespaceID,
-					Usage: "NamespaceId",
+					Name:     FlagWorkflowID,
+					Aliases:  FlagWorkflowIDAlias,
+					Usage:    "Workflow ID",
+					Required: true,
 				},
 				&cli.StringFlag{
-					Name:    FlagWorkflowID,
-					Aliases: FlagWorkflowIDAlias,
-					Usage:   "Workflow ID",
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
 				},
-				&cli.IntFlag{
-					Name:  FlagNumberOfShards,
-					Usage: "NumberOfShards for the temporal cluster(see config for numHistoryShards)",
+				&cli.StringFlag{
+					Name:     FlagCallbackID,
+					Usage:    "Callback ID, as returned by the list command",
+					Required: true,
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminGetShardID(c)
+				return AdminRetryCallback(c, clientFactory)
 			},
 		},
 	}
 }
 
-func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
+func newAdminNexusCommands(clientFactory ClientFactory) []*cli.Command {
 	return []*cli.Command{
 		{
-			Name:  "list-tasks",
-			Usage: "List tasks of a task queue. Use --fair to list fairness tasks.",
+			Name:  "get",
+			Usage: "Show a Nexus endpoint with the recent success rate, latency and circuit breaker state of calls to it",
 			Flags: []cli.Flag{
-				&cli.BoolFlag{
-					Name:  FlagMore,
-					Usage: "List more pages, default is to list one page of default page size 10",
+				&cli.StringFlag{
+					Name:     FlagEndpoint,
+					Usage:    "Endpoint name",
+					Required: true,
 				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetNexusEndpoint(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List Nexus endpoints with the recent success rate, latency and circuit breaker state of calls to them",
+			Flags: []cli.Flag{
 				&cli.IntFlag{
 					Name:  FlagPageSize,
-					Value: 10,
-					Usage: "Result page size",
+					Value: 100,
+					Usage: "Number of endpoints per page",
 				},
-				&cli.StringFlag{
-					Name:  FlagTaskQueueType,
-					Value: "activity",
-					Usage: "Task Queue type: activity, workflow",
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List all pages, default is to list one page",
 				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListNexusEndpoints(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set-circuit-breaker",
+			Usage: "Manually open or close the circuit breakers of a Nexus endpoint",
+			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagTaskQueue,
-					Usage: "Task Queue name",
-				},
-				&cli.Int64Flag{
-					Name:  FlagMinTaskID,
-					Usage: "Minimum task ID",
-					Value: -12346, // include default task id
+					Name:     FlagEndpoint,
+					Usage:    "Endpoint name",
+					Required: true,
 				},
-				&cli.Int64Flag{
-					Name:  FlagMaxTaskID,
-					Usage: "Maximum task ID",
+				&cli.StringFlag{
+					Name:     FlagCircuitBreakerOverride,
+					Usage:    "Override: force_open, force_closed or unspecified to clear a previous override",
+					Required: true,
 				},
-				&cli.IntFlag{
-					Name:  FlagSubqueue,
-					Usage: "Subqueue to query",
-					Value: 0,
+				&cli.DurationFlag{
+					Name:  FlagDuration,
+					Value: time.Hour,
+					Usage: "How long the override is kept",
 				},
-				&cli.BoolFlag{
-					Name:  FlagPrintJSON,
-					Usage: "Print in raw json format",
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetNexusEndpointCircuitBreaker(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set-access-policy",
+			Usage: "Restrict which namespaces and workflow types may call a Nexus endpoint, replacing the previous policy",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagEndpoint,
+					Usage:    "Endpoint name",
+					Required: true,
 				},
-				&cli.BoolFlag{
-					Name:  FlagFair,
-					Usage: "Query fairness tasks",
+				&cli.StringSliceFlag{
+					Name:  FlagAllowedNamespace,
+					Usage: "Namespace allowed to call the endpoint, can be repeated. Any namespace is allowed if not set",
 				},
-				&cli.Int64Flag{
-					Name:  FlagMinPass,
-					Usage: "Minimum pass (fairness task only)",
-					Value: 1,
+				&cli.StringSliceFlag{
+					Name:  FlagAllowedWorkflowType,
+					Usage: "Workflow type allowed to call the endpoint, can be repeated. Any workflow type is allowed if not set",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminListTaskQueueTasks(c, clientFactory)
+				return AdminSetNexusEndpointAccessPolicy(c, clientFactory)
 			},
 		},
+	}
+}
+
+func newAdminVisibilityCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	return []*cli.Command{
 		{
-			Name:  "describe-task-queue-partition",
-			Usage: "Describe information related to a task queue partition",
+			Name:  "aggregate",
+			Usage: "Compute the count, sum, average, minimum, maximum and percentiles of a numeric search attribute",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagNamespaceID,
-					Usage: "NamespaceId",
-					Value: "default",
+					Name:  FlagQuery,
+					Usage: "Visibility query selecting the workflows to aggregate",
 				},
 				&cli.StringFlag{
-					Name:     FlagTaskQueue,
-					Usage:    "Task Queue name",
+					Name:     FlagSearchAttribute,
+					Usage:    "Search attribute of type Int or Double to aggregate, e.g. ExecutionDuration",
 					Required: true,
 				},
+				&cli.Float64SliceFlag{
+					Name:  FlagPercentile,
+					Usage: "Percentile to compute, between 0 and 100, can be repeated",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminAggregateWorkflowExecutions(c, clientFactory)
+			},
+		},
+		{
+			Name:  "explain",
+			Usage: "Show the query a visibility query translates to in the visibility store and its estimated cost",
+			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagTaskQueueType,
-					Value: "TASK_QUEUE_TYPE_WORKFLOW",
-					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+					Name:     FlagQuery,
+					Usage:    "Visibility query to explain",
+					Required: true,
 				},
-				&cli.Int64Flag{
-					Name:  FlagPartitionID,
-					Usage: "Partition ID",
-					Value: 0,
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Page size of the list request to explain, defaults to the maximum page size of the namespace",
 				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExplainWorkflowExecutions(c, clientFactory)
+			},
+		},
+		{
+			Name:        "reindex",
+			Usage:       "Rebuild visibility records from mutable state and compare the primary and secondary stores",
+			Subcommands: newAdminVisibilityReindexCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "search-attribute",
+			Usage:       "Rename custom search attributes and migrate them to another type",
+			Subcommands: newAdminSearchAttributeMigrationCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "saved-query",
+			Usage:       "Save visibility queries of a namespace, run them by name and alert when their count crosses a threshold",
+			Subcommands: newAdminSavedVisibilityQueryCommands(clientFactory, prompterFactory),
+		},
+	}
+}
+
+func newAdminVisibilityReindexCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name: "start",
+			Usage: "Start writing the visibility records of all executions to a visibility store. " +
+				"Only the namespace given with --namespace is reindexed if the flag is set",
+			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagStickyName,
-					Usage: "Sticky Name for a task queue partition, if present",
-					Value: "",
+					Name:  FlagTargetStore,
+					Usage: "Visibility store to write to, defaults to the secondary store if one is configured",
 				},
-				&cli.StringSliceFlag{
-					Name:  FlagBuildIDs,
-					Value: &cli.StringSlice{},
-					Usage: "Build IDs",
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of executions read per request, 0 uses the server default",
+				},
+				&cli.Float64Flag{
+					Name:  FlagExecutionsPerSecond,
+					Usage: "Maximum number of executions processed per second, 0 means no limit",
 				},
 				&cli.BoolFlag{
-					Name:  FlagUnversioned,
-					Usage: "Unversioned task queue partition",
-					Value: true,
+					Name:  FlagVerify,
+					Usage: "Compare the records of the primary and secondary store after the reindex",
 				},
 				&cli.BoolFlag{
-					Name:  FlagAllActive,
-					Usage: "All active task queue versions",
-					Value: true,
+					Name:  FlagSkipReindex,
+					Usage: "Only compare the records of the primary and secondary store, requires --" + FlagVerify,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the reindex",
 				},
 			},
 			Action: func(c *cli.Context) error {
-				return AdminDescribeTaskQueuePartition(c, clientFactory)
+				return AdminStartVisibilityReindex(c, clientFactory, prompterFactory(c))
 			},
 		},
 		{
-			Name:  "force-unload-task-queue-partition",
-			Usage: "Forcefully unload a task queue partition",
+			Name:  "describe",
+			Usage: "Show the progress of the latest visibility reindex",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeVisibilityReindex(c, clientFactory)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Stop a running visibility reindex. Records that were already written are kept",
 			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:  FlagNamespaceID,
-					Usage: "NamespaceId",
-					Value: "default",
+					Name:  FlagReason,
+					Usage: "Reason for canceling the reindex",
 				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCancelVisibilityReindex(c, clientFactory, prompterFactory(c))
+			},
+		},
+	}
+}
+
+func newAdminSearchAttributeMigrationCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name: "rename",
+			Usage: "Rename the alias of a custom search attribute of the namespace given with --namespace. " +
+				"Not supported by Elasticsearch, use migrate with --" + FlagNewName + " instead",
+			Flags: []cli.Flag{
 				&cli.StringFlag{
-					Name:     FlagTaskQueue,
-					Usage:    "Task Queue name",
+					Name:     FlagSearchAttribute,
+					Usage:    "Name of the custom search attribute",
 					Required: true,
 				},
 				&cli.StringFlag{
-					Name:  FlagTaskQueueType,
-					Value: "TASK_QUEUE_TYPE_WORKFLOW",
-					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+					Name:     FlagNewName,
+					Usage:    "New name of the custom search attribute",
+					Required: true,
 				},
-				&cli.Int64Flag{
-					Name:  FlagPartitionID,
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRenameSearchAttribute(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name: "migrate",
+			Usage: "Start copying the values of a custom search attribute to a new field of another type. " +
+				"--namespace is required unless Elasticsearch is used",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagSearchAttribute,
+					Usage:    "Name of the custom search attribute",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagNewType,
+					Usage: "Type of the search attribute after the migration, e.g. Text or Double",
+				},
+				&cli.StringFlag{
+					Name:  FlagNewName,
+					Usage: "Name of the search attribute after the migration, required by Elasticsearch",
+				},
+				&cli.BoolFlag{
+					Name:  FlagSkipInvalidValues,
+					Usage: "Drop values that can't be converted to the new type instead of failing the migration",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only validate that the existing values can be converted",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of executions read per request, 0 uses the server default",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the migration",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartSearchAttributeMigration(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "describe-migration",
+			Usage: "Show the progress of the latest search attribute migration",
+			Action: func(c *cli.Context) error {
+				return AdminDescribeSearchAttributeMigration(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminSavedVisibilityQueryCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
+	nameFlag := &cli.StringFlag{
+		Name:     FlagSavedQueryName,
+		Usage:    "Name of the saved query",
+		Required: true,
+	}
+	return []*cli.Command{
+		{
+			Name: "put",
+			Usage: "Create or replace a saved query of the namespace given with --namespace. " +
+				"The query is alerted on if --" + FlagWebhookURL + " is set",
+			Flags: []cli.Flag{
+				nameFlag,
+				&cli.StringFlag{
+					Name:     FlagQuery,
+					Usage:    "Visibility query, e.g. WorkflowType = 'PaymentWorkflow' AND ExecutionStatus = 'Failed'",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagDescription,
+					Usage: "Description of the saved query",
+				},
+				&cli.Int64Flag{
+					Name:  FlagThreshold,
+					Usage: "The alert fires when the number of matching executions is greater than the threshold",
+				},
+				&cli.BoolFlag{
+					Name:  FlagFireBelowThreshold,
+					Usage: "Fire the alert when the number of matching executions is less than the threshold instead",
+				},
+				&cli.DurationFlag{
+					Name:  FlagInterval,
+					Value: 5 * time.Minute,
+					Usage: "How often the alert is evaluated, at least one minute",
+				},
+				&cli.StringFlag{
+					Name:  FlagWebhookURL,
+					Usage: "URL that receives a POST request when the alert fires or resolves",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagWebhookHeader,
+					Usage: "Header added to webhook requests in the format name=value, can be repeated",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminPutSavedVisibilityQuery(c, clientFactory)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a saved query and its alert",
+			Flags: []cli.Flag{nameFlag},
+			Action: func(c *cli.Context) error {
+				return AdminDeleteSavedVisibilityQuery(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List the saved queries of the namespace given with --namespace",
+			Action: func(c *cli.Context) error {
+				return AdminListSavedVisibilityQueries(c, clientFactory)
+			},
+		},
+		{
+			Name:  "run",
+			Usage: "List or count the executions matching a saved query",
+			Flags: []cli.Flag{
+				nameFlag,
+				&cli.BoolFlag{
+					Name:  FlagCountOnly,
+					Usage: "Only count the matching executions",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of executions returned, 0 uses the server default",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRunSavedVisibilityQuery(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminMembershipCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "list-gossip",
+			Usage: "List ringpop membership items",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagClusterMembershipRole,
+					Value: "all",
+					Usage: "Membership role filter: all (default), frontend, history, matching, worker",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListGossipMembers(c, clientFactory)
+			},
+		},
+		{
+			Name:  "list-db",
+			Usage: "List cluster membership items",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagHeartbeatedWithin,
+					Value: "15m",
+					Usage: "Filter by last heartbeat date time. Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+				},
+				&cli.StringFlag{
+					Name:  FlagClusterMembershipRole,
+					Value: "all",
+					Usage: "Membership role filter: all (default), frontend, history, matching, worker",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListClusterMembers(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminHistoryHostCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe internal information of history host",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagHistoryAddress,
+					Usage: "History Host address(IP:PORT)",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "ShardId",
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintFullyDetail,
+					Usage: "Print fully detail",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeHistoryHost(c, clientFactory)
+			},
+		},
+		{
+			Name:  "get-shardid",
+			Usage: "Get shardId for a namespaceId and workflowId combination",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "NamespaceId",
+				},
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.IntFlag{
+					Name:  FlagNumberOfShards,
+					Usage: "NumberOfShards for the temporal cluster(see config for numHistoryShards)",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetShardID(c)
+			},
+		},
+	}
+}
+
+func newAdminTaskQueueCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	taskFilterFlags := []cli.Flag{
+		&cli.StringFlag{
+			Name:    FlagWorkflowID,
+			Aliases: FlagWorkflowIDAlias,
+			Usage:   "Only include tasks of this workflow ID",
+		},
+		&cli.StringFlag{
+			Name:    FlagRunID,
+			Aliases: FlagRunIDAlias,
+			Usage:   "Only include tasks of this run ID",
+		},
+		&cli.StringFlag{
+			Name:  FlagWorkflowType,
+			Usage: "Only include tasks of this workflow type (requires a mutable state lookup per workflow)",
+		},
+		&cli.StringFlag{
+			Name:  FlagActivityType,
+			Usage: "Only include activity tasks of this activity type (requires a mutable state lookup per workflow)",
+		},
+		&cli.IntFlag{
+			Name:  FlagPriorityKey,
+			Usage: "Only include tasks with this priority key",
+		},
+		&cli.StringFlag{
+			Name:  FlagFairnessKey,
+			Usage: "Only include tasks with this fairness key",
+		},
+	}
+	return []*cli.Command{
+		{
+			Name:  "list-tasks",
+			Usage: "List tasks of a task queue. Use --fair to list fairness tasks.",
+			Flags: append([]cli.Flag{
+				&cli.BoolFlag{
+					Name:  FlagMore,
+					Usage: "List more pages, default is to list one page of default page size 10",
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 10,
+					Usage: "Result page size",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "activity",
+					Usage: "Task Queue type: activity, workflow",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "Minimum task ID",
+					Value: -12346, // include default task id
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "Maximum task ID",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to query",
+					Value: 0,
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+				&cli.BoolFlag{
+					Name:  FlagFair,
+					Usage: "Query fairness tasks",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinPass,
+					Usage: "Minimum pass (fairness task only)",
+					Value: 1,
+				},
+			}, taskFilterFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminListTaskQueueTasks(c, clientFactory)
+			},
+		},
+		{
+			Name: "delete-tasks",
+			Usage: "Delete tasks matching the given filters from a task queue, or move them to another task queue. " +
+				"Use --fair to delete fairness tasks.",
+			Flags: append([]cli.Flag{
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Value: 100,
+					Usage: "Number of tasks scanned per request",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_ACTIVITY",
+					Usage: "Task Queue type: TASK_QUEUE_TYPE_ACTIVITY, TASK_QUEUE_TYPE_WORKFLOW",
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinTaskID,
+					Usage: "Minimum task ID",
+					Value: -12346, // include default task id
+				},
+				&cli.Int64Flag{
+					Name:  FlagMaxTaskID,
+					Usage: "Maximum task ID, default is no limit",
+				},
+				&cli.IntFlag{
+					Name:  FlagSubqueue,
+					Usage: "Subqueue to delete from",
+					Value: 0,
+				},
+				&cli.BoolFlag{
+					Name:  FlagPrintJSON,
+					Usage: "Print in raw json format",
+				},
+				&cli.BoolFlag{
+					Name:  FlagFair,
+					Usage: "Delete fairness tasks",
+				},
+				&cli.Int64Flag{
+					Name:  FlagMinPass,
+					Usage: "Minimum pass (fairness task only)",
+					Value: 1,
+				},
+				&cli.Int64SliceFlag{
+					Name:  FlagTaskID,
+					Usage: "Only include tasks with these task IDs",
+				},
+				&cli.StringFlag{
+					Name:  FlagDestinationTaskQueue,
+					Usage: "Add matching tasks to this task queue before deleting them",
+				},
+				&cli.BoolFlag{
+					Name:  FlagDryRun,
+					Usage: "Only print the tasks that would be deleted",
+				},
+			}, taskFilterFlags...),
+			Action: func(c *cli.Context) error {
+				return AdminDeleteTaskQueueTasks(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "describe-task-queue-partition",
+			Usage: "Describe information related to a task queue partition",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "NamespaceId",
+					Value: "default",
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+				},
+				&cli.Int64Flag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+					Value: 0,
+				},
+				&cli.StringFlag{
+					Name:  FlagStickyName,
+					Usage: "Sticky Name for a task queue partition, if present",
+					Value: "",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagBuildIDs,
+					Value: &cli.StringSlice{},
+					Usage: "Build IDs",
+				},
+				&cli.BoolFlag{
+					Name:  FlagUnversioned,
+					Usage: "Unversioned task queue partition",
+					Value: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagAllActive,
+					Usage: "All active task queue versions",
+					Value: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskQueuePartition(c, clientFactory)
+			},
+		},
+		{
+			Name:  "force-unload-task-queue-partition",
+			Usage: "Forcefully unload a task queue partition",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "NamespaceId",
+					Value: "default",
+				},
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: activity, workflow, nexus (experimental)",
+				},
+				&cli.Int64Flag{
+					Name:  FlagPartitionID,
 					Usage: "Partition ID",
 					Value: 0,
 				},
@@ -597,6 +1231,214 @@ func newAdminTaskQueueCommands(clientFactory ClientFactory) []*cli.Command {
 				return AdminForceUnloadTaskQueuePartition(c, clientFactory)
 			},
 		},
+		{
+			Name:  "update-fairness-weights",
+			Usage: "Set fairness key weight overrides and the default fairness weight of a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagSetWeight,
+					Usage: "Weight override in the form fairness-key=weight, can be repeated",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagUnsetWeight,
+					Usage: "Fairness key whose weight override is removed, can be repeated",
+				},
+				&cli.Float64Flag{
+					Name:  FlagDefaultWeight,
+					Usage: "Weight of fairness keys without an override for tasks that don't set a weight, 0 resets it to 1.0",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateTaskQueueFairnessWeights(c, clientFactory)
+			},
+		},
+		{
+			Name:  "describe-fairness-keys",
+			Usage: "Show the fairness keys of a task queue partition with the largest backlogs and dispatch rates",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY",
+				},
+				&cli.IntFlag{
+					Name:  FlagPartitionID,
+					Usage: "Partition ID",
+					Value: 0,
+				},
+				&cli.IntFlag{
+					Name:  FlagTopN,
+					Usage: "Number of keys to show in each list",
+					Value: 10,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskQueueFairnessKeys(c, clientFactory)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause or drain a task queue, or remove its pause",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY",
+				},
+				&cli.StringFlag{
+					Name:     FlagPauseMode,
+					Usage:    "TASK_QUEUE_PAUSE_MODE_PAUSED holds dispatch and accepts new tasks, TASK_QUEUE_PAUSE_MODE_DRAINING rejects new tasks and dispatches the backlog, TASK_QUEUE_PAUSE_MODE_UNSPECIFIED removes the pause",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagStartTime,
+					Usage: "Optional time at which the pause takes effect, in RFC3339 format",
+				},
+				&cli.StringFlag{
+					Name:  FlagEndTime,
+					Usage: "Optional time at which the pause ends, in RFC3339 format",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the pause",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateTaskQueuePause(c, clientFactory)
+			},
+		},
+		{
+			Name:  "block-pollers",
+			Usage: "Block poller identities from receiving tasks from a task queue for some time, or unblock them, and show the blocked pollers",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Value: "TASK_QUEUE_TYPE_WORKFLOW",
+					Usage: "Task Queue type: TASK_QUEUE_TYPE_WORKFLOW, TASK_QUEUE_TYPE_ACTIVITY",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagBlockIdentity,
+					Usage: "Poller identity to block, can be repeated",
+				},
+				&cli.DurationFlag{
+					Name:  FlagBlockDuration,
+					Value: time.Hour,
+					Usage: "How long the pollers are blocked",
+				},
+				&cli.StringSliceFlag{
+					Name:  FlagUnblockIdentity,
+					Usage: "Poller identity to unblock, can be repeated",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for blocking the pollers",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminUpdateTaskQueueBlockedPollers(c, clientFactory)
+			},
+		},
+		{
+			Name:        "migrate-backlog",
+			Usage:       "Move the backlog of a task queue to another task queue",
+			Subcommands: newAdminTaskQueueBacklogMigrationCommands(clientFactory, prompterFactory),
+		},
+	}
+}
+
+func newAdminTaskQueueBacklogMigrationCommands(
+	clientFactory ClientFactory,
+	prompterFactory PrompterFactory,
+) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "start",
+			Usage: "Start moving all tasks of every partition of a task queue to another task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Source Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     FlagDestinationTaskQueue,
+					Usage:    "Destination Task Queue name",
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Number of tasks moved per request, 0 uses the server default",
+				},
+				&cli.Float64Flag{
+					Name:  FlagTasksPerSecond,
+					Usage: "Maximum number of tasks moved per second, 0 means no limit",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the migration",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminStartTaskQueueBacklogMigration(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "describe",
+			Usage: "Show the progress of the latest backlog migration of a task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Source Task Queue name",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeTaskQueueBacklogMigration(c, clientFactory)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Stop a running backlog migration. Tasks that were already moved stay in the destination task queue",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagTaskQueue,
+					Usage:    "Source Task Queue name",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for canceling the migration",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminCancelTaskQueueBacklogMigration(c, clientFactory, prompterFactory(c))
+			},
+		},
 	}
 }
 
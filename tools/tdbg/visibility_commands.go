@@ -0,0 +1,298 @@
+package tdbg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// AdminAggregateWorkflowExecutions computes statistics of a numeric search attribute over the workflows matching a
+// visibility query
+func AdminAggregateWorkflowExecutions(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.AggregateWorkflowExecutions(ctx, &adminservice.AggregateWorkflowExecutionsRequest{
+		Namespace:       namespace,
+		Query:           c.String(FlagQuery),
+		SearchAttribute: c.String(FlagSearchAttribute),
+		Percentiles:     c.Float64Slice(FlagPercentile),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to aggregate workflow executions: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminExplainWorkflowExecutions shows the query a visibility query translates to in the visibility store, with its
+// estimated cost
+func AdminExplainWorkflowExecutions(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.ExplainWorkflowExecutions(ctx, &adminservice.ExplainWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     c.String(FlagQuery),
+		PageSize:  int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to explain workflow executions query: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminStartVisibilityReindex starts rebuilding visibility records from mutable state
+func AdminStartVisibilityReindex(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	var namespace string
+	if c.IsSet(FlagNamespace) {
+		namespace = c.String(FlagNamespace)
+		prompter.Prompt(fmt.Sprintf("Namespace: %s\nReindex the visibility records of this namespace?", namespace))
+	} else {
+		prompter.Prompt("Reindex the visibility records of all namespaces?")
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.StartVisibilityReindex(ctx, &adminservice.StartVisibilityReindexRequest{
+		Namespace:           namespace,
+		TargetStore:         c.String(FlagTargetStore),
+		PageSize:            int32(c.Int(FlagPageSize)),
+		ExecutionsPerSecond: float32(c.Float64(FlagExecutionsPerSecond)),
+		Verify:              c.Bool(FlagVerify),
+		SkipReindex:         c.Bool(FlagSkipReindex),
+		Reason:              c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start visibility reindex: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminDescribeVisibilityReindex displays the progress of the latest visibility reindex
+func AdminDescribeVisibilityReindex(c *cli.Context, clientFactory ClientFactory) error {
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DescribeVisibilityReindex(ctx, &adminservice.DescribeVisibilityReindexRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe visibility reindex: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminCancelVisibilityReindex stops a running visibility reindex
+func AdminCancelVisibilityReindex(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	prompter.Prompt("Cancel the running visibility reindex?")
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.CancelVisibilityReindex(ctx, &adminservice.CancelVisibilityReindexRequest{
+		Reason: c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to cancel visibility reindex: %v", err)
+	}
+	if !response.GetCanceled() {
+		_, _ = fmt.Fprintln(c.App.Writer, "No visibility reindex is running")
+	}
+	return nil
+}
+
+// AdminRenameSearchAttribute renames the alias of a custom search attribute
+func AdminRenameSearchAttribute(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	name := c.String(FlagSearchAttribute)
+	newName := c.String(FlagNewName)
+	prompter.Prompt(fmt.Sprintf("Namespace: %s\nRename search attribute %s to %s?", namespace, name, newName))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.RenameSearchAttribute(ctx, &adminservice.RenameSearchAttributeRequest{
+		Namespace: namespace,
+		Name:      name,
+		NewName:   newName,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to rename search attribute: %v", err)
+	}
+	return nil
+}
+
+// AdminStartSearchAttributeMigration starts migrating a custom search attribute to another type or name
+func AdminStartSearchAttributeMigration(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	var newType enumspb.IndexedValueType
+	if c.IsSet(FlagNewType) {
+		var err error
+		if newType, err = enumspb.IndexedValueTypeFromString(c.String(FlagNewType)); err != nil {
+			return fmt.Errorf("invalid --%s: %v", FlagNewType, err)
+		}
+	}
+	name := c.String(FlagSearchAttribute)
+	if !c.Bool(FlagDryRun) {
+		prompter.Prompt(fmt.Sprintf("Migrate search attribute %s? Queries keep using the current field until the migration is finished", name))
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.StartSearchAttributeMigration(ctx, &adminservice.StartSearchAttributeMigrationRequest{
+		Namespace:         c.String(FlagNamespace),
+		Name:              name,
+		NewType:           newType,
+		NewName:           c.String(FlagNewName),
+		SkipInvalidValues: c.Bool(FlagSkipInvalidValues),
+		DryRun:            c.Bool(FlagDryRun),
+		PageSize:          int32(c.Int(FlagPageSize)),
+		Reason:            c.String(FlagReason),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to start search attribute migration: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminDescribeSearchAttributeMigration displays the progress of the latest search attribute migration
+func AdminDescribeSearchAttributeMigration(c *cli.Context, clientFactory ClientFactory) error {
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.DescribeSearchAttributeMigration(ctx, &adminservice.DescribeSearchAttributeMigrationRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to describe search attribute migration: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminPutSavedVisibilityQuery creates or replaces a saved visibility query
+func AdminPutSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	query := &persistencespb.SavedVisibilityQuery{
+		Name:        c.String(FlagSavedQueryName),
+		Query:       c.String(FlagQuery),
+		Description: c.String(FlagDescription),
+	}
+	if c.IsSet(FlagWebhookURL) {
+		headers := make(map[string]string)
+		for _, kv := range c.StringSlice(FlagWebhookHeader) {
+			key, value, ok := strings.Cut(kv, "=")
+			if !ok {
+				return fmt.Errorf("invalid --%s %q, expected name=value", FlagWebhookHeader, kv)
+			}
+			headers[key] = value
+		}
+		query.Alert = &persistencespb.SavedVisibilityQueryAlert{
+			Threshold:          c.Int64(FlagThreshold),
+			FireBelowThreshold: c.Bool(FlagFireBelowThreshold),
+			Interval:           durationpb.New(c.Duration(FlagInterval)),
+			WebhookUrl:         c.String(FlagWebhookURL),
+			WebhookHeaders:     headers,
+		}
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.PutSavedVisibilityQuery(ctx, &adminservice.PutSavedVisibilityQueryRequest{
+		Namespace: namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to save visibility query: %v", err)
+	}
+	return nil
+}
+
+// AdminDeleteSavedVisibilityQuery deletes a saved visibility query
+func AdminDeleteSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	name := c.String(FlagSavedQueryName)
+	prompter.Prompt(fmt.Sprintf("Namespace: %s\nDelete saved query %s?", namespace, name))
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err = client.DeleteSavedVisibilityQuery(ctx, &adminservice.DeleteSavedVisibilityQueryRequest{
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete saved visibility query: %v", err)
+	}
+	return nil
+}
+
+// AdminListSavedVisibilityQueries displays the saved visibility queries of a namespace
+func AdminListSavedVisibilityQueries(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.ListSavedVisibilityQueries(ctx, &adminservice.ListSavedVisibilityQueriesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list saved visibility queries: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}
+
+// AdminRunSavedVisibilityQuery lists or counts the executions matching a saved visibility query
+func AdminRunSavedVisibilityQuery(c *cli.Context, clientFactory ClientFactory) error {
+	namespace, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+
+	client := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := client.RunSavedVisibilityQuery(ctx, &adminservice.RunSavedVisibilityQueryRequest{
+		Namespace: namespace,
+		Name:      c.String(FlagSavedQueryName),
+		CountOnly: c.Bool(FlagCountOnly),
+		PageSize:  int32(c.Int(FlagPageSize)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to run saved visibility query: %v", err)
+	}
+	prettyPrintJSONObject(c, response)
+	return nil
+}